@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: Apache-2.0
+// Address objects and nested address groups referenced by rules
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// AddressObject is a named set of prefixes. Objects listing Members are
+// groups; members may themselves be groups.
+type AddressObject struct {
+	Name        string   `json:"name" yaml:"name,omitempty"`
+	Prefixes    []string `json:"prefixes" yaml:"prefixes,omitempty"` // CIDR notation
+	Members     []string `json:"members" yaml:"members,omitempty"`   // Nested address object names
+	Description string   `json:"description" yaml:"description,omitempty"`
+}
+
+// SetAddressObject creates or replaces an address object and re-pushes every
+// rule that resolves through it
+func (s *Server) SetAddressObject(ctx context.Context, req *pb.SetAddressObjectRequest) (*pb.StatusResponse, error) {
+	if req.GetObject() == nil {
+		return &pb.StatusResponse{Success: false, Message: "Address object is required"}, nil
+	}
+
+	object := addressObjectFromProto(req.Object)
+	if err := validateAddressObject(object); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Address object validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, member := range object.Members {
+		if _, exists := s.addressObjects[member]; !exists {
+			return &pb.StatusResponse{
+				Success: false,
+				Message: fmt.Sprintf("Unknown member address object: %s", member),
+			}, nil
+		}
+	}
+
+	previous := s.addressObjects[object.Name]
+	s.addressObjects[object.Name] = object
+	if s.addressGroupHasCycle(object.Name, map[string]bool{}) {
+		if previous != nil {
+			s.addressObjects[object.Name] = previous
+		} else {
+			delete(s.addressObjects, object.Name)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Address group %s would contain itself", object.Name),
+		}, nil
+	}
+
+	users := s.rulesUsingAddress(object.Name)
+
+	if err := s.applyPolicy(); err != nil {
+		if previous != nil {
+			s.addressObjects[object.Name] = previous
+		} else {
+			delete(s.addressObjects, object.Name)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to push rules to data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Set address object: %s (%d prefixes, %d members, %d rules updated)",
+		object.Name, len(object.Prefixes), len(object.Members), len(users))
+
+	return &pb.StatusResponse{
+		Success: true,
+		Message: fmt.Sprintf("Address object saved successfully, %d rules updated", len(users)),
+	}, nil
+}
+
+// GetAddressObject returns an address object, the prefixes it resolves to
+// and the rules referencing it
+func (s *Server) GetAddressObject(ctx context.Context, req *pb.GetAddressObjectRequest) (*pb.AddressObjectResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	object, exists := s.addressObjects[req.Name]
+	if !exists {
+		return &pb.AddressObjectResponse{Success: false, Message: "Address object not found"}, nil
+	}
+	resp := &pb.AddressObjectResponse{
+		Success:  true,
+		Message:  "Address object found",
+		Object:   addressObjectToProto(object),
+		Prefixes: resolveAddress(s.addressObjects, req.Name),
+	}
+	for _, rule := range s.rulesUsingAddress(req.Name) {
+		resp.RuleIds = append(resp.RuleIds, rule.ID)
+	}
+	return resp, nil
+}
+
+// DeleteAddressObject removes an address object unless rules or groups
+// still reference it
+func (s *Server) DeleteAddressObject(ctx context.Context, req *pb.DeleteAddressObjectRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.addressObjects[req.Name]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Address object not found"}, nil
+	}
+
+	var refs []string
+	for _, group := range s.groupsContaining(req.Name) {
+		refs = append(refs, "group "+group)
+	}
+	for _, rule := range s.rulesUsingAddress(req.Name) {
+		refs = append(refs, "rule "+rule.ID)
+	}
+	if len(refs) > 0 {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Address object is referenced by: %s", strings.Join(refs, ", ")),
+		}, nil
+	}
+
+	delete(s.addressObjects, req.Name)
+	s.persistPolicy()
+	log.Printf("Deleted address object: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Address object deleted successfully"}, nil
+}
+
+// ListAddressObjects returns all address objects and groups
+func (s *Server) ListAddressObjects(ctx context.Context, req *pb.Empty) (*pb.AddressObjectsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	names := make([]string, 0, len(s.addressObjects))
+	for name := range s.addressObjects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &pb.AddressObjectsResponse{}
+	for _, name := range names {
+		resp.Objects = append(resp.Objects, addressObjectToProto(s.addressObjects[name]))
+	}
+
+	return resp, nil
+}
+
+// resolveAddress flattens an address object and its nested groups into a
+// sorted, de-duplicated prefix list
+func resolveAddress(addressObjects map[string]*AddressObject, name string) []string {
+	seen := make(map[string]bool)
+	var walk func(string, map[string]bool)
+	walk = func(name string, visiting map[string]bool) {
+		object, exists := addressObjects[name]
+		if !exists || visiting[name] {
+			return
+		}
+		visiting[name] = true
+		for _, prefix := range object.Prefixes {
+			seen[prefix] = true
+		}
+		for _, member := range object.Members {
+			walk(member, visiting)
+		}
+		delete(visiting, name)
+	}
+	walk(name, map[string]bool{})
+
+	prefixes := make([]string, 0, len(seen))
+	for prefix := range seen {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// addressDependsOn reports whether object name resolves through target.
+// Caller must hold s.mutex.
+func (s *Server) addressDependsOn(name, target string) bool {
+	if name == target {
+		return true
+	}
+	object, exists := s.addressObjects[name]
+	if !exists {
+		return false
+	}
+	for _, member := range object.Members {
+		if s.addressDependsOn(member, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// addressGroupHasCycle detects groups that (transitively) contain themselves.
+// Caller must hold s.mutex.
+func (s *Server) addressGroupHasCycle(name string, visiting map[string]bool) bool {
+	if visiting[name] {
+		return true
+	}
+	object, exists := s.addressObjects[name]
+	if !exists {
+		return false
+	}
+	visiting[name] = true
+	for _, member := range object.Members {
+		if s.addressGroupHasCycle(member, visiting) {
+			return true
+		}
+	}
+	delete(visiting, name)
+	return false
+}
+
+// groupsContaining lists groups that directly contain an object.
+// Caller must hold s.mutex.
+func (s *Server) groupsContaining(name string) []string {
+	var groups []string
+	for _, object := range s.addressObjects {
+		for _, member := range object.Members {
+			if member == name {
+				groups = append(groups, object.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// rulesUsingAddress lists rules resolving through an address object,
+// directly or via groups, ordered by ID. Caller must hold s.mutex.
+func (s *Server) rulesUsingAddress(name string) []*FirewallRule {
+	var users []*FirewallRule
+	for _, rule := range s.rules {
+		if (rule.SrcAddress != "" && s.addressDependsOn(rule.SrcAddress, name)) ||
+			(rule.DstAddress != "" && s.addressDependsOn(rule.DstAddress, name)) {
+			users = append(users, rule)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func validateAddressObject(object *AddressObject) error {
+	if object.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(object.Prefixes) == 0 && len(object.Members) == 0 {
+		return fmt.Errorf("address object must contain at least one prefix or member")
+	}
+	for _, prefix := range object.Prefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return fmt.Errorf("invalid prefix %s: %v", prefix, err)
+		}
+	}
+	for _, member := range object.Members {
+		if member == object.Name {
+			return fmt.Errorf("address group cannot contain itself")
+		}
+	}
+	return nil
+}
+
+func addressObjectFromProto(p *pb.AddressObject) *AddressObject {
+	return &AddressObject{
+		Name:        p.Name,
+		Prefixes:    p.Prefixes,
+		Members:     p.Members,
+		Description: p.Description,
+	}
+}
+
+func addressObjectToProto(object *AddressObject) *pb.AddressObject {
+	return &pb.AddressObject{
+		Name:        object.Name,
+		Prefixes:    object.Prefixes,
+		Members:     object.Members,
+		Description: object.Description,
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy analysis: entries that can never match and overlapping entries with contradictory actions
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Kinds of analysis findings
+const (
+	FindingShadowed  = "shadowed"  // Never matches, an earlier entry with another action covers it
+	FindingRedundant = "redundant" // Never matches, an earlier entry with the same action covers it
+	FindingConflict  = "conflict"  // Partly overlaps an earlier entry with another action
+)
+
+// entryCovers reports whether every packet matching b also matches a
+func entryCovers(a, b *FirewallRule) bool {
+	if !a.Enabled || !b.Enabled || a.Namespace != b.Namespace || a.VF != b.VF {
+		return false
+	}
+	aIngress, aEgress := ruleHooks(a)
+	bIngress, bEgress := ruleHooks(b)
+	if (bIngress && !aIngress) || (bEgress && !aEgress) {
+		return false
+	}
+
+	aFamily, bFamily := ruleFamily(a), ruleFamily(b)
+	if aFamily != familyAny {
+		if aFamily != bFamily {
+			return false
+		}
+		aSrc, bSrc := rulePrefix(a.SrcIP, aFamily), rulePrefix(b.SrcIP, aFamily)
+		aDst, bDst := rulePrefix(a.DstIP, aFamily), rulePrefix(b.DstIP, aFamily)
+		if aSrc.Bits() > bSrc.Bits() || !aSrc.Contains(bSrc.Addr()) ||
+			aDst.Bits() > bDst.Bits() || !aDst.Contains(bDst.Addr()) {
+			return false
+		}
+	}
+	if (a.SrcSet != 0 && a.SrcSet != b.SrcSet) || (a.DstSet != 0 && a.DstSet != b.DstSet) {
+		return false
+	}
+
+	aProtocol, bProtocol := protocolToUint8(a.Protocol), protocolToUint8(b.Protocol)
+	if aProtocol != 0 && aProtocol != bProtocol {
+		return false
+	}
+	if !portRangeCovers(a.SrcPort, a.SrcPortEnd, b.SrcPort, b.SrcPortEnd) ||
+		!portRangeCovers(a.DstPort, a.DstPortEnd, b.DstPort, b.DstPortEnd) {
+		return false
+	}
+	aFlags, aMask, _ := parseTCPFlags(a.TCPFlags)
+	bFlags, bMask, _ := parseTCPFlags(b.TCPFlags)
+	if aMask&^bMask != 0 || bFlags&aMask != aFlags {
+		return false
+	}
+	if (a.VLAN != 0 && a.VLAN != b.VLAN) || (a.SrcMAC != "" && a.SrcMAC != b.SrcMAC) || (a.DstMAC != "" && a.DstMAC != b.DstMAC) {
+		return false
+	}
+	if !namesCover(a.Interfaces, b.Interfaces) ||
+		!namesCover(a.SrcCountry, b.SrcCountry) || !namesCover(a.DstCountry, b.DstCountry) ||
+		!namesCover(a.SrcASN, b.SrcASN) || !namesCover(a.DstASN, b.DstASN) {
+		return false
+	}
+	aStates, bStates := connStateMask(a.ConnState), connStateMask(b.ConnState)
+	return aStates == 0 || (bStates != 0 && bStates&^aStates == 0)
+}
+
+// namesCover reports whether a match on a list of names, such as
+// interfaces, countries or ASNs, includes match b; empty is any name
+func namesCover[T comparable](a, b []T) bool {
+	if len(a) == 0 {
+		return true
+	}
+	if len(b) == 0 {
+		return false
+	}
+	for _, name := range b {
+		if !slices.Contains(a, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// portRangeCovers reports whether port match a includes port match b; a
+// zero start is any port
+func portRangeCovers(aStart, aEnd, bStart, bEnd int32) bool {
+	if aStart == 0 {
+		return true
+	}
+	if bStart == 0 {
+		return false
+	}
+	if aEnd == 0 {
+		aEnd = aStart
+	}
+	if bEnd == 0 {
+		bEnd = bStart
+	}
+	return aStart <= bStart && bEnd <= aEnd
+}
+
+// analyzeEntries compares every enabled entry with the entries evaluated
+// before it. An entry is shadowed or redundant when a single earlier entry
+// covers it; entries only covered by several earlier entries together are
+// not detected. Overlaps with another action are conflicts unless one entry
+// covers the other, the usual exception before a broader rule.
+func analyzeEntries(sorted []*FirewallRule) []*pb.RuleFinding {
+	var findings []*pb.RuleFinding
+	for j, entry := range sorted {
+		if !entry.Enabled {
+			continue
+		}
+		var conflict *FirewallRule
+		var covered bool
+		for _, earlier := range sorted[:j] {
+			if entryCovers(earlier, entry) {
+				kind, verb := FindingShadowed, "shadows"
+				if earlier.Action == entry.Action {
+					kind, verb = FindingRedundant, "already covers"
+				}
+				findings = append(findings, ruleFinding(kind, entry, earlier,
+					fmt.Sprintf("%s (%s, priority %d) %s %s (%s, priority %d), which never matches",
+						earlier.ID, earlier.Action, earlier.Priority, verb, entry.ID, entry.Action, entry.Priority)))
+				covered = true
+				break
+			}
+			if conflict == nil && earlier.Action != entry.Action && entriesOverlap(earlier, entry) && !entryCovers(entry, earlier) {
+				conflict = earlier
+			}
+		}
+		if !covered && conflict != nil {
+			findings = append(findings, ruleFinding(FindingConflict, entry, conflict,
+				fmt.Sprintf("%s (%s) partly overlaps %s (%s, priority %d), which wins where both match",
+					entry.ID, entry.Action, conflict.ID, conflict.Action, conflict.Priority)))
+		}
+	}
+	return findings
+}
+
+func ruleFinding(kind string, entry, other *FirewallRule, message string) *pb.RuleFinding {
+	return &pb.RuleFinding{
+		Kind:         kind,
+		RuleId:       entryRuleID(entry.ID),
+		EntryId:      entry.ID,
+		OtherRuleId:  entryRuleID(other.ID),
+		OtherEntryId: other.ID,
+		Message:      message,
+	}
+}
+
+// AnalyzeRules reports entries of the running policy, or of a candidate
+// policy, that can never match or that overlap an earlier entry with a
+// contradictory action. Nothing is applied.
+func (s *Server) AnalyzeRules(ctx context.Context, req *pb.AnalyzeRulesRequest) (*pb.AnalyzeRulesResponse, error) {
+	policy := s.compiledPolicy()
+	if req.GetPolicy() != nil {
+		candidate, errs := newCandidateServer(req.Policy)
+		if len(errs) > 0 {
+			return &pb.AnalyzeRulesResponse{Valid: false, Errors: errs}, nil
+		}
+		policy = compilePolicy(candidate.policySnapshot(), 0, s.clock.Now())
+	}
+
+	return &pb.AnalyzeRulesResponse{
+		Valid:    true,
+		Findings: analyzeEntries(policy.Sorted),
+		Entries:  int32(len(policy.Sorted)),
+		Version:  policy.Version,
+	}, nil
+}
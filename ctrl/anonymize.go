@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+// Telemetry anonymization: addresses in exported events and flows are
+// truncated or encrypted prefix-preservingly, and payload stripped, so the
+// telemetry can be shared without the full customer addresses
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"fmt"
+	"net/netip"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Anonymization methods of CERBERUS_ANONYMIZE
+const (
+	AnonymizeTruncate = "truncate" // Keep only the network part of addresses
+	AnonymizeEncrypt  = "encrypt"  // Crypto-PAn prefix-preserving encryption
+	AnonymizeStrip    = "strip"    // Drop event messages and hardware addresses
+)
+
+// Which exports are anonymized, set with CERBERUS_ANONYMIZE_EXPORTS
+const (
+	AnonymizeExportsRequested = "requested" // Those whose consumer asks for it
+	AnonymizeExportsAll       = "all"
+)
+
+const (
+	// Address bits kept by truncation, unless set with
+	// CERBERUS_ANONYMIZE_IPV4_PREFIX and CERBERUS_ANONYMIZE_IPV6_PREFIX
+	DefaultAnonymizeIPv4Prefix = 24
+	DefaultAnonymizeIPv6Prefix = 48
+
+	// Crypto-PAn key: an AES-128 key followed by the pad secret
+	anonymizeKeySize = 32
+)
+
+// anonymizeCandidate finds the text that may be an address, prefix or
+// address:port in messages and metadata; what does not parse is kept
+var anonymizeCandidate = regexp.MustCompile(`[0-9A-Fa-f:.]*[:.][0-9A-Fa-f:.]*[0-9A-Fa-f](/[0-9]{1,3})?`)
+
+// Anonymizer rewrites exported telemetry. The zero methods leave it
+// unchanged.
+type Anonymizer struct {
+	truncate bool
+	ipv4Bits int // Kept by truncation
+	ipv6Bits int
+	block    cipher.Block // Crypto-PAn cipher, nil = no encryption
+	pad      [aes.BlockSize]byte
+	strip    bool
+	all      bool // Every export, not only requested ones
+}
+
+// defaultAnonymizer truncates addresses of the exports that ask for it
+func defaultAnonymizer() *Anonymizer {
+	return &Anonymizer{truncate: true, ipv4Bits: DefaultAnonymizeIPv4Prefix, ipv6Bits: DefaultAnonymizeIPv6Prefix}
+}
+
+// anonymizerFromEnv reads the anonymization of exported telemetry:
+// CERBERUS_ANONYMIZE lists the methods (truncate, encrypt, strip; default
+// truncate), CERBERUS_ANONYMIZE_KEY the encryption key and
+// CERBERUS_ANONYMIZE_EXPORTS whether every export is anonymized or only
+// those asking for it
+func anonymizerFromEnv() (*Anonymizer, error) {
+	a := defaultAnonymizer()
+	if value := os.Getenv("CERBERUS_ANONYMIZE"); value != "" {
+		a.truncate = false
+		for _, method := range strings.Split(value, ",") {
+			switch strings.TrimSpace(method) {
+			case AnonymizeTruncate:
+				a.truncate = true
+			case AnonymizeEncrypt:
+				key, err := anonymizeKeyFromEnv()
+				if err != nil {
+					return nil, err
+				}
+				a.block, _ = aes.NewCipher(key[:16])
+				a.block.Encrypt(a.pad[:], key[16:])
+			case AnonymizeStrip:
+				a.strip = true
+			default:
+				return nil, fmt.Errorf("invalid CERBERUS_ANONYMIZE method %q, expected %s, %s or %s",
+					method, AnonymizeTruncate, AnonymizeEncrypt, AnonymizeStrip)
+			}
+		}
+	}
+	for _, setting := range []struct {
+		name string
+		bits *int
+		max  int
+	}{
+		{"CERBERUS_ANONYMIZE_IPV4_PREFIX", &a.ipv4Bits, 32},
+		{"CERBERUS_ANONYMIZE_IPV6_PREFIX", &a.ipv6Bits, 128},
+	} {
+		if value := os.Getenv(setting.name); value != "" {
+			bits, err := strconv.Atoi(value)
+			if err != nil || bits < 0 || bits > setting.max {
+				return nil, fmt.Errorf("invalid %s %q, expected 0 to %d", setting.name, value, setting.max)
+			}
+			*setting.bits = bits
+		}
+	}
+	switch exports := os.Getenv("CERBERUS_ANONYMIZE_EXPORTS"); exports {
+	case "", AnonymizeExportsRequested:
+	case AnonymizeExportsAll:
+		a.all = true
+	default:
+		return nil, fmt.Errorf("invalid CERBERUS_ANONYMIZE_EXPORTS %q, expected %s or %s",
+			exports, AnonymizeExportsRequested, AnonymizeExportsAll)
+	}
+	return a, nil
+}
+
+// anonymizeKeyFromEnv reads CERBERUS_ANONYMIZE_KEY: 32 base64 bytes, or a
+// file holding them. The same key maps an address to the same anonymized
+// address everywhere, so shared telemetry can be correlated.
+func anonymizeKeyFromEnv() ([]byte, error) {
+	value := strings.TrimSpace(os.Getenv("CERBERUS_ANONYMIZE_KEY"))
+	if value == "" {
+		return nil, fmt.Errorf("CERBERUS_ANONYMIZE=%s needs CERBERUS_ANONYMIZE_KEY", AnonymizeEncrypt)
+	}
+	if data, err := os.ReadFile(value); err == nil {
+		value = strings.TrimSpace(string(data))
+	}
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(key) != anonymizeKeySize {
+		return nil, fmt.Errorf("invalid CERBERUS_ANONYMIZE_KEY, expected %d base64 bytes or a file holding them, e.g. from head -c %d /dev/urandom | base64",
+			anonymizeKeySize, anonymizeKeySize)
+	}
+	return key, nil
+}
+
+// applies reports whether an export is anonymized
+func (a *Anonymizer) applies(requested bool) bool {
+	return a != nil && (requested || a.all)
+}
+
+// methods lists the configured methods, for the startup banner
+func (a *Anonymizer) methods() []string {
+	var methods []string
+	if a.truncate {
+		methods = append(methods, fmt.Sprintf("%s to /%d and /%d", AnonymizeTruncate, a.ipv4Bits, a.ipv6Bits))
+	}
+	if a.block != nil {
+		methods = append(methods, AnonymizeEncrypt)
+	}
+	if a.strip {
+		methods = append(methods, AnonymizeStrip)
+	}
+	return methods
+}
+
+// addr anonymizes an address: truncation first, so encryption maps every
+// address of a kept network to the same one
+func (a *Anonymizer) addr(addr netip.Addr) netip.Addr {
+	zone := addr.Zone()
+	addr = addr.WithZone("").Unmap()
+	if a.truncate {
+		bits := a.ipv6Bits
+		if addr.Is4() {
+			bits = a.ipv4Bits
+		}
+		prefix, _ := addr.Prefix(bits)
+		addr = prefix.Addr()
+	}
+	if a.block != nil {
+		addr = a.encrypt(addr)
+	}
+	return addr.WithZone(zone)
+}
+
+// encrypt is Crypto-PAn: bit i of the result is bit i of the address
+// flipped by a bit drawn from the cipher over the address bits before it,
+// so addresses sharing a prefix share an anonymized prefix of that length
+func (a *Anonymizer) encrypt(addr netip.Addr) netip.Addr {
+	orig := addr.AsSlice()
+	bits := len(orig) * 8
+	result := make([]byte, len(orig))
+	var input, output [aes.BlockSize]byte
+	for i := 0; i < bits; i++ {
+		// The first i bits of the address, the rest of the pad
+		input = a.pad
+		for b := 0; b < i/8; b++ {
+			input[b] = orig[b]
+		}
+		if rest := i % 8; rest > 0 {
+			mask := byte(0xff) << (8 - rest)
+			input[i/8] = orig[i/8]&mask | a.pad[i/8]&^mask
+		}
+		a.block.Encrypt(output[:], input[:])
+		result[i/8] |= (output[0] >> 7) << (7 - i%8)
+	}
+	for b := range result {
+		result[b] ^= orig[b]
+	}
+	anonymized, _ := netip.AddrFromSlice(result)
+	return anonymized
+}
+
+// prefix anonymizes a prefix, shortened to the truncation length
+func (a *Anonymizer) prefix(prefix netip.Prefix) netip.Prefix {
+	bits := prefix.Bits()
+	if a.truncate && prefix.Addr().Is4() {
+		bits = min(bits, a.ipv4Bits)
+	} else if a.truncate {
+		bits = min(bits, a.ipv6Bits)
+	}
+	anonymized, _ := a.addr(prefix.Masked().Addr()).Prefix(bits)
+	return anonymized
+}
+
+// text anonymizes the addresses, prefixes and address:ports in text
+func (a *Anonymizer) text(text string) string {
+	if text == "" {
+		return text
+	}
+	return anonymizeCandidate.ReplaceAllStringFunc(text, func(candidate string) string {
+		if addr, err := netip.ParseAddr(candidate); err == nil {
+			return a.addr(addr).String()
+		}
+		if prefix, err := netip.ParsePrefix(candidate); err == nil {
+			return a.prefix(prefix).String()
+		}
+		if addrPort, err := netip.ParseAddrPort(candidate); err == nil {
+			return netip.AddrPortFrom(a.addr(addrPort.Addr()), addrPort.Port()).String()
+		}
+		return candidate
+	})
+}
+
+// event returns an anonymized copy of an event; the original is shared
+// with other subscribers
+func (a *Anonymizer) event(event *pb.Event) *pb.Event {
+	event = proto.Clone(event).(*pb.Event)
+	event.Source = a.text(event.Source)
+	event.Target = a.text(event.Target)
+	if a.strip {
+		event.Message = ""
+	} else {
+		event.Message = a.text(event.Message)
+	}
+	for key, value := range event.Metadata {
+		if a.strip && strings.HasSuffix(key, "_mac") {
+			delete(event.Metadata, key)
+			continue
+		}
+		event.Metadata[key] = a.text(value)
+	}
+	return event
+}
+
+// connection anonymizes the addresses of a listed flow
+func (a *Anonymizer) connection(conn *pb.Connection) {
+	conn.SrcIp = a.text(conn.SrcIp)
+	conn.DstIp = a.text(conn.DstIp)
+	conn.NatIp = a.text(conn.NatIp)
+}
@@ -0,0 +1,465 @@
+// SPDX-License-Identifier: Apache-2.0
+// ASN database: the prefixes each autonomous system announces, for ASN
+// rules, downloaded and reloaded in the background
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ASN database formats, detected by LoadASNDatabase
+const (
+	ASNFormatPrefixes = "prefixes" // prefix,ASN lines, as in pyasn or RouteViews prefix-to-AS files
+	ASNFormatRanges   = "ranges"   // first,last,ASN lines, as in iptoasn.com ip2asn files
+	ASNFormatMaxMind  = "mmdb"     // MaxMind GeoIP2/GeoLite2 ASN database
+
+	// How often the database is downloaded or checked for changes, unless
+	// set with CERBERUS_ASN_REFRESH
+	DefaultASNRefresh = time.Hour
+)
+
+// ASNDatabase holds the origin ASN of announced prefixes, loaded from the
+// file named by CERBERUS_ASN_DB
+type ASNDatabase struct {
+	path     string
+	format   string
+	origins  map[netip.Prefix]uint32   // Origin ASN of each prefix
+	asns     map[uint32][]netip.Prefix // Prefixes of each ASN, in address order
+	sorted   []netip.Prefix            // Every prefix, in address order
+	names    map[uint32]string         // AS names, where the database has them
+	counts   map[int]int               // Prefixes by family
+	builtAt  time.Time                 // Build time of a MaxMind database, else modification time
+	loadedAt time.Time
+	stamp    geoIPStamp // File as loaded, to notice changes
+}
+
+// asnSource is the configured database file, where it is downloaded from
+// and how its updates went
+type asnSource struct {
+	path      string
+	update    *dbUpdate // nil = the file is updated by other means
+	downloads uint64    // Downloads that changed the file
+	refreshes uint64    // Database changes loaded
+	failures  uint64    // Downloads and database changes that failed
+}
+
+// LoadASNDatabase reads an ASN database, in the format given by its name
+// or first line:
+//   - a .mmdb file: a MaxMind ASN database
+//   - lines starting with a prefix: prefix and ASN, such as
+//     "192.0.2.0/24 64496" or "192.0.2.0/24,AS64496"
+//   - lines starting with two addresses: first and last address of a
+//     range, ASN, and optionally country and AS name, tab separated as in
+//     ip2asn-combined.tsv; ASN 0 marks unannounced ranges
+//
+// Text files may be gzip compressed. Fields are separated by tabs, commas
+// or spaces; empty lines, lines starting with # or ; and a header line are
+// ignored.
+func LoadASNDatabase(path string) (*ASNDatabase, error) {
+	stamp, err := geoIPStampOf(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ASN database: %v", err)
+	}
+	db := &ASNDatabase{
+		path:    path,
+		origins: make(map[netip.Prefix]uint32),
+		asns:    make(map[uint32][]netip.Prefix),
+		names:   make(map[uint32]string),
+		counts:  make(map[int]int),
+		builtAt: stamp.modTime,
+		stamp:   stamp,
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".mmdb") {
+		db.format = ASNFormatMaxMind
+		err = db.loadMaxMind(path)
+	} else {
+		err = db.loadText(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(db.origins) == 0 {
+		return nil, fmt.Errorf("%s holds no prefixes", path)
+	}
+
+	db.sorted = make([]netip.Prefix, 0, len(db.origins))
+	for prefix, asn := range db.origins {
+		db.sorted = append(db.sorted, prefix)
+		db.asns[asn] = append(db.asns[asn], prefix)
+		if prefix.Addr().Is4() {
+			db.counts[familyIPv4]++
+		} else {
+			db.counts[familyIPv6]++
+		}
+	}
+	sortPrefixes(db.sorted)
+	for asn, prefixes := range db.asns {
+		db.asns[asn] = sortPrefixes(prefixes)
+	}
+	return db, nil
+}
+
+// loadText reads a prefix or range file, told apart by its first line
+func (db *ASNDatabase) loadText(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open ASN database: %v", err)
+	}
+	defer file.Close()
+
+	buffered := bufio.NewReader(file)
+	var reader io.Reader = buffered
+	if magic, _ := buffered.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		unzipped, err := gzip.NewReader(buffered)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		defer unzipped.Close()
+		reader = unzipped
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' || text[0] == ';' {
+			continue
+		}
+		if err := db.addLine(text, line); err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read ASN database: %v", err)
+	}
+	return nil
+}
+
+// addLine adds the prefixes of one line of a text file; the format is set
+// by the first line that is not a header
+func (db *ASNDatabase) addLine(text string, line int) error {
+	var fields []string
+	if strings.Contains(text, "\t") {
+		fields = strings.Split(text, "\t")
+	} else {
+		fields = strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	if db.format == "" {
+		_, prefixErr := netip.ParsePrefix(fields[0])
+		_, addrErr := netip.ParseAddr(fields[0])
+		switch {
+		case prefixErr == nil:
+			db.format = ASNFormatPrefixes
+		case addrErr == nil && len(fields) >= 3:
+			db.format = ASNFormatRanges
+		case line == 1:
+			return nil // Header
+		default:
+			return fmt.Errorf("expected prefix,ASN or first,last,ASN")
+		}
+	}
+
+	if db.format == ASNFormatPrefixes {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected prefix,ASN")
+		}
+		prefix, err := netip.ParsePrefix(fields[0])
+		if err != nil {
+			return fmt.Errorf("invalid prefix: %v", err)
+		}
+		asn, err := parseASN(fields[1])
+		if err != nil {
+			return err
+		}
+		db.origins[prefix.Masked()] = asn
+		return nil
+	}
+
+	if len(fields) < 3 {
+		return fmt.Errorf("expected first,last,ASN")
+	}
+	from, err := netip.ParseAddr(fields[0])
+	if err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+	to, err := netip.ParseAddr(fields[1])
+	if err != nil {
+		return fmt.Errorf("invalid address: %v", err)
+	}
+	from, to = from.Unmap(), to.Unmap()
+	if from.BitLen() != to.BitLen() || to.Less(from) {
+		return fmt.Errorf("invalid range %s-%s", fields[0], fields[1])
+	}
+	asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(fields[2]), "AS"), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid ASN %q", fields[2])
+	}
+	if asn == 0 {
+		return nil // Not announced
+	}
+	for _, prefix := range rangePrefixes(from, to) {
+		db.origins[prefix] = uint32(asn)
+	}
+	if len(fields) >= 5 && fields[4] != "" {
+		db.names[uint32(asn)] = fields[4]
+	}
+	return nil
+}
+
+// asnRecord is what the ASN database keeps of a MaxMind data record
+type asnRecord struct {
+	asn  uint32
+	name string
+}
+
+// loadMaxMind reads a MaxMind ASN database
+func (db *ASNDatabase) loadMaxMind(path string) error {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read ASN database: %v", err)
+	}
+	reader, buildEpoch, err := newMMDBReader(file, func(record map[string]any) any {
+		asn, _ := record["autonomous_system_number"].(uint64)
+		if asn == 0 || asn > 0xffffffff {
+			return nil
+		}
+		name, _ := record["autonomous_system_organization"].(string)
+		return asnRecord{asn: uint32(asn), name: name}
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if buildEpoch > 0 {
+		db.builtAt = time.Unix(int64(buildEpoch), 0)
+	}
+	err = reader.networks(func(prefix netip.Prefix, value any) {
+		record := value.(asnRecord)
+		db.origins[prefix] = record.asn
+		if record.name != "" {
+			db.names[record.asn] = record.name
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// parseASN parses an AS number, plain or with an AS prefix ("AS64496")
+func parseASN(value string) (uint32, error) {
+	digits := strings.TrimSpace(value)
+	if len(digits) > 2 && strings.EqualFold(digits[:2], "AS") {
+		digits = digits[2:]
+	}
+	asn, err := strconv.ParseUint(digits, 10, 32)
+	if err != nil || asn == 0 {
+		return 0, fmt.Errorf("invalid ASN %q, expected e.g. AS64496", value)
+	}
+	return uint32(asn), nil
+}
+
+// has reports whether the database knows prefixes of an ASN
+func (db *ASNDatabase) has(asn uint32) bool {
+	return len(db.asns[asn]) > 0
+}
+
+// name returns the AS name of an ASN, empty when the database has none
+func (db *ASNDatabase) name(asn uint32) string {
+	return db.names[asn]
+}
+
+// origin returns the origin ASN of the longest prefix holding an address,
+// 0 when no prefix holds it
+func (db *ASNDatabase) origin(addr netip.Addr) uint32 {
+	addr = addr.Unmap()
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		if asn, ok := db.origins[netip.PrefixFrom(addr, bits).Masked()]; ok {
+			return asn
+		}
+	}
+	return 0
+}
+
+// prefixes returns the prefixes announced by the ASNs, and every more
+// specific prefix within them with its own origin, so that a longest
+// prefix lookup of the result finds the origin the full database would
+func (db *ASNDatabase) prefixes(asns []uint32) map[netip.Prefix]uint32 {
+	prefixes := make(map[netip.Prefix]uint32)
+	for _, asn := range asns {
+		for _, prefix := range db.asns[asn] {
+			prefixes[prefix] = asn
+			// More specific prefixes follow the prefix in address order
+			first := sort.Search(len(db.sorted), func(i int) bool {
+				c := db.sorted[i].Addr().Compare(prefix.Addr())
+				return c > 0 || (c == 0 && db.sorted[i].Bits() > prefix.Bits())
+			})
+			for _, within := range db.sorted[first:] {
+				if !prefix.Contains(within.Addr()) {
+					break
+				}
+				prefixes[within] = db.origins[within]
+			}
+		}
+	}
+	return prefixes
+}
+
+// asnConfigFromEnv returns how often the ASN database is downloaded or
+// checked for changes, 0 = never, and the URL it is downloaded from
+func asnConfigFromEnv() (time.Duration, string, error) {
+	url := os.Getenv("CERBERUS_ASN_URL")
+	if url != "" && !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return 0, "", fmt.Errorf("invalid CERBERUS_ASN_URL %q, expected an http:// or https:// URL", url)
+	}
+	if url != "" && os.Getenv("CERBERUS_ASN_DB") == "" {
+		return 0, "", fmt.Errorf("CERBERUS_ASN_URL needs CERBERUS_ASN_DB, the file it is downloaded to")
+	}
+	value := os.Getenv("CERBERUS_ASN_REFRESH")
+	if value == "" {
+		return DefaultASNRefresh, url, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		return 0, "", fmt.Errorf("invalid CERBERUS_ASN_REFRESH %q, expected a duration such as 24h, or 0 for never", value)
+	}
+	return interval, url, nil
+}
+
+// refreshASN downloads the ASN database, if it has a URL, and reloads it
+// whenever its file changes, until ctx is done. Downloads go in use once
+// verified and loaded, see dbUpdate.run. A database that fails to
+// load leaves the previous one in use; it is retried once its file changes
+// again.
+func (s *Server) refreshASN(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var failed geoIPStamp
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.asnSource.update != nil {
+			s.mutex.RLock()
+			var inUse int
+			if s.asnDB != nil {
+				inUse = len(s.asnDB.sorted)
+			}
+			s.mutex.RUnlock()
+			result, err := s.asnSource.update.run(ctx, inUse, s.stageASN)
+			s.mutex.Lock()
+			if err != nil {
+				s.asnSource.failures++
+			} else if result == UpdateResultUpdated {
+				s.asnSource.downloads++
+			}
+			s.mutex.Unlock()
+			s.publishUpdate(s.asnSource.update, result, err)
+		}
+
+		s.mutex.RLock()
+		var loaded geoIPStamp
+		if s.asnDB != nil {
+			loaded = s.asnDB.stamp
+		}
+		s.mutex.RUnlock()
+
+		stamp, err := geoIPStampOf(s.asnSource.path)
+		if err != nil {
+			log.Printf("⚠️  Failed to check ASN database: %v", err)
+			continue
+		}
+		if stamp == loaded || stamp == failed {
+			continue
+		}
+
+		db, err := LoadASNDatabase(s.asnSource.path)
+		if err == nil {
+			err = s.reloadASN(db)
+		}
+		s.mutex.Lock()
+		if err != nil {
+			s.asnSource.failures++
+			failed = stamp
+		} else {
+			s.asnSource.refreshes++
+		}
+		s.mutex.Unlock()
+		if err != nil {
+			log.Printf("⚠️  ASN database not reloaded, keeping the previous one: %v", err)
+			continue
+		}
+		log.Printf("Reloaded ASN database %s (%s, %d ASNs, built %s)",
+			db.path, db.format, len(db.asns), db.builtAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// stageASN loads a downloaded ASN database for dbUpdate, to go in use
+// from the configured path
+func (s *Server) stageASN(path string) (*stagedDatabase, error) {
+	db, err := LoadASNDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	db.path = s.asnSource.path
+	return &stagedDatabase{prefixes: len(db.sorted), install: func() error {
+		if err := s.reloadASN(db); err != nil {
+			return err
+		}
+		s.mutex.Lock()
+		s.asnSource.refreshes++
+		s.mutex.Unlock()
+		log.Printf("Reloaded ASN database %s (%s, %d ASNs, built %s)",
+			db.path, db.format, len(db.asns), db.builtAt.UTC().Format(time.RFC3339))
+		return nil
+	}}, nil
+}
+
+// reloadASN puts a new database in use, rewriting the prefixes of the ASNs
+// rules match
+func (s *Server) reloadASN(db *ASNDatabase) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous := s.asnDB
+	s.asnDB = db
+	if err := s.addRuleASNs(s.compiledPolicy().Sorted); err != nil {
+		s.asnDB = previous
+		if previous != nil {
+			if err := s.addRuleASNs(s.compiledPolicy().Sorted); err != nil {
+				log.Printf("⚠️  Failed to restore ASN prefixes of the previous database: %v", err)
+			}
+		}
+		s.pruneRuleASNs()
+		return fmt.Errorf("failed to push ASN prefixes: %v", err)
+	}
+	s.pruneRuleASNs()
+	db.loadedAt = s.clock.Now()
+	for _, asn := range s.ruleASNs() {
+		if !db.has(asn) {
+			log.Printf("⚠️  AS%d of rules has no prefixes in the reloaded ASN database, its rules match nothing", asn)
+		}
+	}
+	return nil
+}
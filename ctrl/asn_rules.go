@@ -0,0 +1,391 @@
+// SPDX-License-Identifier: Apache-2.0
+// ASN rules: rules matching the origin ASN of a packet's source or
+// destination, resolved to prefixes through the ASN database
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned ASN maps (must match eBPF program)
+	ASN4MapName       = "cerberus_asn4"
+	ASN6MapName       = "cerberus_asn6"
+	RuleASNsMapName   = "cerberus_rule_asns"
+	MaxASNPrefixes    = 524288 // Per family, must match MAX_ASN_PREFIXES
+	MaxRuleASNEntries = 16384  // Must match MAX_ASN_SET_ENTRIES
+
+	// ASNBlockLabel marks the rules added by BlockASN, its value is the
+	// blocked ASN, e.g. AS64496
+	ASNBlockLabel = "asn-block"
+)
+
+// ruleASNKey mirrors struct asn_set_key in ebpf/xdp_filter.c
+type ruleASNKey struct {
+	Set uint32
+	ASN uint32
+}
+
+// asnMaps holds the pinned ASN maps and the prefixes written to them
+type asnMaps struct {
+	asn4, asn6 *ebpf.Map
+	sets       *ebpf.Map
+	installed  map[netip.Prefix]uint32 // Origin ASN by prefix
+}
+
+// canonicalASNs sorts an ASN list and removes duplicates
+func canonicalASNs(asns []uint32) []uint32 {
+	if len(asns) == 0 {
+		return nil
+	}
+	canonical := slices.Clone(asns)
+	slices.Sort(canonical)
+	return slices.Compact(canonical)
+}
+
+// ruleASNSetID is the data plane ID of a rule's ASN set, the same for
+// every rule with the same ASNs; 0 = any ASN
+func ruleASNSetID(asns []uint32) uint32 {
+	if len(asns) == 0 {
+		return 0
+	}
+	hash := fnv.New32a()
+	for _, asn := range canonicalASNs(asns) {
+		hash.Write([]byte(strconv.FormatUint(uint64(asn), 10) + ","))
+	}
+	if id := hash.Sum32(); id != 0 {
+		return id
+	}
+	return 1
+}
+
+// formatASNs writes ASNs as AS64496, AS64497
+func formatASNs(asns []uint32) string {
+	names := make([]string, len(asns))
+	for i, asn := range asns {
+		names[i] = fmt.Sprintf("AS%d", asn)
+	}
+	return strings.Join(names, ", ")
+}
+
+// validateRuleASNs checks the ASNs of one side of a rule, adding problems
+// to errs, and returns them canonical. Caller must hold s.mutex.
+func (s *Server) validateRuleASNs(rule *FirewallRule, field string, asns []uint32, errs *ruleValidationError) []uint32 {
+	asns = canonicalASNs(asns)
+	if len(asns) == 0 {
+		return nil
+	}
+	if s.asnDB == nil {
+		errs.addCode(ErrCodeASNMissing, field, "%s needs an ASN database (CERBERUS_ASN_DB)", field)
+		return asns
+	}
+	for _, asn := range asns {
+		switch {
+		case asn == 0:
+			errs.add(field, "invalid ASN 0")
+		case !s.asnDB.has(asn):
+			errs.add(field, "no prefixes of AS%d in %s", asn, s.asnDB.path)
+		}
+	}
+	if rule.Namespace != "" || rule.VF != "" {
+		errs.add(field, "ASNs are only matched by the host data plane")
+	}
+	return asns
+}
+
+// ruleASNs returns the ASNs host entries of the running version match,
+// sorted. Caller must hold s.mutex.
+func (s *Server) ruleASNs() []uint32 {
+	_, asns := ruleASNKeys(s.compiledPolicy().Sorted)
+	return asns
+}
+
+// ruleASNKeys resolves the ASN sets of host entries to map keys, and
+// returns the ASNs they reference
+func ruleASNKeys(entries []*FirewallRule) (map[ruleASNKey]bool, []uint32) {
+	keys := make(map[ruleASNKey]bool)
+	seen := make(map[uint32]bool)
+	var asns []uint32
+	for _, entry := range entries {
+		if ruleScope(entry) != "" {
+			continue
+		}
+		for _, set := range [][]uint32{entry.SrcASN, entry.DstASN} {
+			id := ruleASNSetID(set)
+			for _, asn := range set {
+				keys[ruleASNKey{Set: id, ASN: asn}] = true
+				if !seen[asn] {
+					seen[asn] = true
+					asns = append(asns, asn)
+				}
+			}
+		}
+	}
+	slices.Sort(asns)
+	return keys, asns
+}
+
+// asnPrefixes returns the prefixes of asns in the ASN database, with the
+// more specific prefixes of other ASNs within them. Caller must hold
+// s.mutex.
+func (s *Server) asnPrefixes(asns []uint32) map[netip.Prefix]uint32 {
+	if s.asnDB == nil {
+		return make(map[netip.Prefix]uint32)
+	}
+	return s.asnDB.prefixes(asns)
+}
+
+// addRuleASNs writes the ASN sets of a version's entries, and the prefixes
+// of their ASNs, to the host data plane before its entries are installed.
+// Caller must hold s.mutex.
+func (s *Server) addRuleASNs(entries []*FirewallRule) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	keys, asns := ruleASNKeys(entries)
+	return s.bpfManager.AddRuleASNs(keys, s.asnPrefixes(asns))
+}
+
+// pruneRuleASNs removes the ASN sets and prefixes no entry of the running
+// version uses any more. Caller must hold s.mutex.
+func (s *Server) pruneRuleASNs() {
+	if s.bpfManager == nil {
+		return
+	}
+	keys, asns := ruleASNKeys(s.compiledPolicy().Sorted)
+	if err := s.bpfManager.PruneRuleASNs(keys, s.asnPrefixes(asns)); err != nil {
+		log.Printf("⚠️  Failed to prune rule ASNs: %v", err)
+	}
+}
+
+// BlockASN adds a drop rule for the prefixes an ASN announces: traffic
+// from them when inbound, to them when outbound. The rule follows the ASN
+// database as it is updated and is labelled with the ASN.
+func (s *Server) BlockASN(ctx context.Context, req *pb.BlockASNRequest) (*pb.RuleResponse, error) {
+	var errs ruleValidationError
+	asn, err := parseASN(req.Asn)
+	if err != nil {
+		errs.add("asn", "%v", err)
+	}
+	direction := req.Direction
+	if direction == "" {
+		direction = "inbound"
+	}
+	if direction != "inbound" && direction != "outbound" {
+		errs.add("direction", "invalid direction: %s, expected inbound or outbound", direction)
+	}
+	if len(errs) > 0 {
+		return &pb.RuleResponse{Success: false, Message: fmt.Sprintf("ASN block validation failed: %v", errs), Errors: fieldErrors(errs)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.clock.Now()
+	rule := &FirewallRule{
+		ID:          s.ids.NewID("rule"),
+		Action:      "drop",
+		Protocol:    "any",
+		Direction:   direction,
+		Priority:    req.Priority,
+		Enabled:     true,
+		Description: req.Description,
+		Owner:       req.Owner,
+		Labels:      map[string]string{ASNBlockLabel: fmt.Sprintf("AS%d", asn)},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if direction == "inbound" {
+		rule.SrcASN = []uint32{asn}
+	} else {
+		rule.DstASN = []uint32{asn}
+	}
+	if rule.Description == "" {
+		rule.Description = fmt.Sprintf("Block AS%d", asn)
+		if s.asnDB != nil && s.asnDB.name(asn) != "" {
+			rule.Description += fmt.Sprintf(" (%s)", s.asnDB.name(asn))
+		}
+	}
+	if err := s.validateRule(rule); err != nil {
+		return &pb.RuleResponse{Success: false, Message: fmt.Sprintf("ASN block validation failed: %v", err), Errors: fieldErrors(err)}, nil
+	}
+	if existing := duplicateRule(rule, s.rules); existing != nil {
+		return &pb.RuleResponse{
+			Success:   true,
+			Message:   fmt.Sprintf("AS%d is already blocked by rule %s", asn, existing.ID),
+			RuleId:    existing.ID,
+			Duplicate: true,
+		}, nil
+	}
+	if err := s.limits.checkRules(len(s.rules) + 1); err != nil {
+		return nil, err
+	}
+
+	s.rules[rule.ID] = rule
+	if err := s.applyPolicy(); err != nil {
+		delete(s.rules, rule.ID)
+		return &pb.RuleResponse{Success: false, Message: fmt.Sprintf("Failed to push ASN block to data plane: %v", withRemediation(err))}, nil
+	}
+	s.persistPolicy()
+	s.events.Publish(ruleEvent(EventRuleAdded, rule))
+
+	prefixes := len(s.asnDB.asns[asn])
+	log.Printf("🚫 Blocked AS%d %s: %d prefixes (rule %s)", asn, direction, prefixes, rule.ID)
+	return &pb.RuleResponse{
+		Success: true,
+		Message: fmt.Sprintf("Blocked AS%d, %d prefixes", asn, prefixes),
+		RuleId:  rule.ID,
+		Rule:    toProtoRule(rule),
+	}, nil
+}
+
+// openASNs opens the pinned ASN maps, left nil if the program predates
+// them, and clears prefixes left by a previous control plane run; those of
+// the restored rules are written when the policy is pushed
+func (bm *BPFMapManager) openASNs() {
+	maps := &asnMaps{installed: make(map[netip.Prefix]uint32)}
+	for _, m := range []struct {
+		name   string
+		target **ebpf.Map
+	}{{ASN4MapName, &maps.asn4}, {ASN6MapName, &maps.asn6}, {RuleASNsMapName, &maps.sets}} {
+		path := filepath.Join(bm.pinPath, m.name)
+		pinned, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  ASN rules not available at %s: %v", path, err)
+			maps.close()
+			return
+		}
+		*m.target = pinned
+	}
+
+	for _, trie := range []*ebpf.Map{maps.asn4, maps.asn6} {
+		var stale [][]byte
+		key := make([]byte, trie.KeySize())
+		var asn uint32
+		entries := trie.Iterate()
+		for entries.Next(&key, &asn) {
+			stale = append(stale, slices.Clone(key))
+		}
+		if err := entries.Err(); err != nil {
+			log.Printf("⚠️  Failed to read stale ASN prefixes: %v", err)
+		}
+		for _, key := range stale {
+			if err := trie.Delete(key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				log.Printf("⚠️  Failed to clear ASN prefix %s: %v", lpmKeyPrefix(key), err)
+			}
+		}
+	}
+	bm.asns = maps
+}
+
+// trie returns the ASN map of a prefix's family
+func (maps *asnMaps) trie(prefix netip.Prefix) *ebpf.Map {
+	if prefix.Addr().Is6() {
+		return maps.asn6
+	}
+	return maps.asn4
+}
+
+// AddRuleASNs writes ASN set entries, and prefixes whose origin is new or
+// changed
+func (bm *BPFMapManager) AddRuleASNs(keys map[ruleASNKey]bool, prefixes map[netip.Prefix]uint32) error {
+	if bm.simulated {
+		return nil
+	}
+	if bm.asns == nil {
+		if len(keys) > 0 {
+			return withCode(ErrCodeDataPlaneFeature, fmt.Errorf("data plane has no %s map, ASN rules unavailable", RuleASNsMapName))
+		}
+		return nil
+	}
+
+	present := uint8(1)
+	for key := range keys {
+		if err := bm.asns.sets.Put(&key, &present); err != nil {
+			return fmt.Errorf("failed to add AS%d to set %d: %v", key.ASN, key.Set, err)
+		}
+	}
+	for prefix, asn := range prefixes {
+		if installed, ok := bm.asns.installed[prefix]; ok && installed == asn {
+			continue
+		}
+		if err := bm.asns.trie(prefix).Put(lpmKey(prefix), &asn); err != nil {
+			return fmt.Errorf("failed to add ASN prefix %s: %v", prefix, err)
+		}
+		bm.asns.installed[prefix] = asn
+	}
+	return nil
+}
+
+// PruneRuleASNs deletes ASN set entries not in keep and the prefixes not
+// in keepPrefixes
+func (bm *BPFMapManager) PruneRuleASNs(keep map[ruleASNKey]bool, keepPrefixes map[netip.Prefix]uint32) error {
+	if bm.simulated || bm.asns == nil {
+		return nil
+	}
+	var key ruleASNKey
+	var present uint8
+	var stale []ruleASNKey
+	entries := bm.asns.sets.Iterate()
+	for entries.Next(&key, &present) {
+		if !keep[key] {
+			stale = append(stale, key)
+		}
+	}
+	if err := entries.Err(); err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := bm.asns.sets.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove AS%d from set %d: %v", key.ASN, key.Set, err)
+		}
+	}
+	for prefix := range bm.asns.installed {
+		if _, ok := keepPrefixes[prefix]; ok {
+			continue
+		}
+		if err := bm.asns.trie(prefix).Delete(lpmKey(prefix)); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove ASN prefix %s: %v", prefix, err)
+		}
+		delete(bm.asns.installed, prefix)
+	}
+	return nil
+}
+
+// ASNPrefixes returns the number of prefixes written to the ASN map of
+// each family
+func (bm *BPFMapManager) ASNPrefixes() map[int]int {
+	counts := map[int]int{familyIPv4: 0, familyIPv6: 0}
+	if bm.asns == nil {
+		return counts
+	}
+	for prefix := range bm.asns.installed {
+		if prefix.Addr().Is6() {
+			counts[familyIPv6]++
+		} else {
+			counts[familyIPv4]++
+		}
+	}
+	return counts
+}
+
+func (maps *asnMaps) close() {
+	for _, m := range []*ebpf.Map{maps.asn4, maps.asn6, maps.sets} {
+		if m != nil {
+			m.Close()
+		}
+	}
+}
@@ -0,0 +1,475 @@
+// SPDX-License-Identifier: Apache-2.0
+// API authentication: pluggable providers, chained per listener, that turn
+// the credentials of a gRPC or REST request into an authenticated principal
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Listeners a provider chain is selected for
+const (
+	ListenerGRPC = "grpc" // gRPC API on gRPCPort
+	ListenerREST = "rest" // REST API on restPort
+	ListenerUnix = "unix" // gRPC API on CERBERUS_UNIX_SOCKET
+)
+
+// EventAuthFailed reports a request rejected for lack of accepted
+// credentials; its source is the client's address
+const EventAuthFailed = "AUTH_FAILED"
+
+// errNoCredentials is returned by a provider for a request that carries
+// none of the credentials it handles, so the next provider in the chain
+// gets to try
+var errNoCredentials = errors.New("no credentials")
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	Subject  string // Certificate subject, token name, OIDC subject or Unix user
+	Provider string // Name of the provider that authenticated it
+}
+
+// AuthRequest is what a provider sees of a request on any listener
+type AuthRequest struct {
+	Listener      string
+	Peer          net.Addr
+	TLS           *tls.ConnectionState // nil on plaintext connections
+	Authorization string               // Authorization header or gRPC metadata
+	PeerCred      *unix.Ucred          // Process on the other end of a Unix socket
+}
+
+// bearerToken returns the token of a Bearer authorization, if any
+func (r *AuthRequest) bearerToken() (string, bool) {
+	scheme, token, found := strings.Cut(r.Authorization, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// AuthProvider authenticates requests by one kind of credentials.
+// Authenticate returns errNoCredentials, possibly wrapped with the reason,
+// when the request carries none of them that it accepts; any other error
+// rejects the request.
+type AuthProvider interface {
+	Name() string
+	Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error)
+}
+
+// AuthProviderFactory creates a provider from its CERBERUS_* variables
+// and the server's TLS settings
+type AuthProviderFactory func(tlsConfig *tls.Config) (AuthProvider, error)
+
+// authProviderFactories are the providers CERBERUS_AUTH_* can select, by name
+var authProviderFactories = map[string]AuthProviderFactory{
+	"mtls":     newMTLSAuthProvider,
+	"token":    newTokenAuthProvider,
+	"oidc":     newOIDCAuthProvider,
+	"peercred": newPeerCredAuthProvider,
+}
+
+// RegisterAuthProvider makes a provider selectable by name. It must be
+// called before the configuration is read, from an init function.
+func RegisterAuthProvider(name string, factory AuthProviderFactory) {
+	if _, exists := authProviderFactories[name]; exists {
+		panic(fmt.Sprintf("auth provider %q registered twice", name))
+	}
+	authProviderFactories[name] = factory
+}
+
+// AuthChain tries its providers in order; the first one that recognizes
+// the request's credentials decides. An empty chain lets every request
+// through unauthenticated.
+type AuthChain []AuthProvider
+
+// Authenticate returns the principal of a request
+func (c AuthChain) Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error) {
+	var reasons []string
+	for _, provider := range c {
+		principal, err := provider.Authenticate(ctx, req)
+		if errors.Is(err, errNoCredentials) {
+			if err != errNoCredentials {
+				reasons = append(reasons, fmt.Sprintf("%s: %v", provider.Name(), err))
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s authentication failed: %v", provider.Name(), err)
+		}
+		principal.Provider = provider.Name()
+		return principal, nil
+	}
+	if len(reasons) > 0 {
+		return nil, fmt.Errorf("no credentials accepted by %s (%s)", strings.Join(c.names(), ", "), strings.Join(reasons, "; "))
+	}
+	return nil, fmt.Errorf("no credentials accepted by %s", strings.Join(c.names(), ", "))
+}
+
+func (c AuthChain) names() []string {
+	names := make([]string, len(c))
+	for i, provider := range c {
+		names[i] = provider.Name()
+	}
+	return names
+}
+
+// AuthConfig is the authentication of each listener and the TLS settings
+// of the TCP ones
+type AuthConfig struct {
+	Chains     map[string]AuthChain // By listener, missing = unauthenticated
+	TLS        *tls.Config          // Served on the gRPC and REST ports, nil = plaintext
+	UnixSocket string               // Path of the Unix socket gRPC listener, empty = none
+
+	events *EventBus // AUTH_FAILED events are published on, if set
+}
+
+// authConfigFromEnv reads the listeners' provider chains from
+// CERBERUS_AUTH_GRPC, CERBERUS_AUTH_REST and CERBERUS_AUTH_UNIX, comma
+// separated provider names tried in order, and the server certificate
+// from CERBERUS_TLS_CERT and CERBERUS_TLS_KEY, with the client CAs of mTLS
+// in CERBERUS_TLS_CLIENT_CA
+func authConfigFromEnv() (AuthConfig, error) {
+	config := AuthConfig{Chains: make(map[string]AuthChain), UnixSocket: os.Getenv("CERBERUS_UNIX_SOCKET")}
+
+	certFile, keyFile := os.Getenv("CERBERUS_TLS_CERT"), os.Getenv("CERBERUS_TLS_KEY")
+	if (certFile == "") != (keyFile == "") {
+		return config, fmt.Errorf("CERBERUS_TLS_CERT and CERBERUS_TLS_KEY must be set together")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return config, fmt.Errorf("invalid CERBERUS_TLS_CERT or CERBERUS_TLS_KEY: %v", err)
+		}
+		config.TLS = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+	if caFile := os.Getenv("CERBERUS_TLS_CLIENT_CA"); caFile != "" {
+		if config.TLS == nil {
+			return config, fmt.Errorf("CERBERUS_TLS_CLIENT_CA needs CERBERUS_TLS_CERT and CERBERUS_TLS_KEY")
+		}
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return config, fmt.Errorf("failed to read CERBERUS_TLS_CLIENT_CA: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return config, fmt.Errorf("invalid CERBERUS_TLS_CLIENT_CA %s: no PEM certificates", caFile)
+		}
+		// Clients without a certificate may still authenticate otherwise
+		config.TLS.ClientCAs = pool
+		config.TLS.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	providers := make(map[string]AuthProvider) // Shared by the listeners naming them
+	for listener, name := range map[string]string{
+		ListenerGRPC: "CERBERUS_AUTH_GRPC",
+		ListenerREST: "CERBERUS_AUTH_REST",
+		ListenerUnix: "CERBERUS_AUTH_UNIX",
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		var chain AuthChain
+		for _, providerName := range strings.Split(raw, ",") {
+			providerName = strings.TrimSpace(providerName)
+			factory, ok := authProviderFactories[providerName]
+			if !ok {
+				return config, fmt.Errorf("invalid %s %q: unknown provider %q, expected %s", name, raw, providerName, strings.Join(authProviderNames(), ", "))
+			}
+			if providerName == "peercred" && listener != ListenerUnix {
+				return config, fmt.Errorf("invalid %s %q: peercred only authenticates the Unix socket", name, raw)
+			}
+			if providerName == "mtls" && listener == ListenerUnix {
+				return config, fmt.Errorf("invalid %s %q: the Unix socket does not serve TLS", name, raw)
+			}
+			provider, exists := providers[providerName]
+			if !exists {
+				var err error
+				if provider, err = factory(config.TLS); err != nil {
+					return config, fmt.Errorf("invalid %s provider: %v", providerName, err)
+				}
+				providers[providerName] = provider
+			}
+			chain = append(chain, provider)
+		}
+		config.Chains[listener] = chain
+	}
+	if len(config.Chains[ListenerUnix]) > 0 && config.UnixSocket == "" {
+		return config, fmt.Errorf("CERBERUS_AUTH_UNIX needs CERBERUS_UNIX_SOCKET")
+	}
+	return config, nil
+}
+
+// authProviderNames returns the registered provider names, sorted
+func authProviderNames() []string {
+	names := make([]string, 0, len(authProviderFactories))
+	for name := range authProviderFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// logSummary logs the authentication of each listener at startup
+func (c AuthConfig) logSummary() {
+	for _, listener := range []string{ListenerGRPC, ListenerREST, ListenerUnix} {
+		if listener == ListenerUnix && c.UnixSocket == "" {
+			continue
+		}
+		chain := c.Chains[listener]
+		if len(chain) == 0 {
+			log.Printf("⚠️  %s API is unauthenticated", listener)
+			continue
+		}
+		log.Printf("%s API authenticates with %s", listener, strings.Join(chain.names(), ", "))
+	}
+	if c.TLS != nil && c.TLS.ClientCAs != nil {
+		log.Printf("gRPC and REST APIs serve TLS, verifying client certificates")
+	} else if c.TLS != nil {
+		log.Printf("gRPC and REST APIs serve TLS")
+	}
+}
+
+// serverOptions returns the gRPC server options of a listener: TLS on the
+// TCP port and the listener's authentication ahead of every interceptor
+// added after them
+func (c AuthConfig) serverOptions(listener string) []grpc.ServerOption {
+	var options []grpc.ServerOption
+	if c.TLS != nil && listener != ListenerUnix {
+		options = append(options, grpc.Creds(credentials.NewTLS(c.TLS)))
+	}
+	if len(c.Chains[listener]) > 0 {
+		options = append(options,
+			grpc.ChainUnaryInterceptor(c.unaryInterceptor(listener)),
+			grpc.ChainStreamInterceptor(c.streamInterceptor(listener)))
+	}
+	return options
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the authenticated caller of a request, nil
+// on unauthenticated listeners
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// grpcAuthRequest collects the credentials of a gRPC call
+func grpcAuthRequest(ctx context.Context, listener string) *AuthRequest {
+	req := &AuthRequest{Listener: listener}
+	if p, ok := peer.FromContext(ctx); ok {
+		req.Peer = p.Addr
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			req.TLS = &info.State
+		}
+		if addr, ok := p.Addr.(*peerCredAddr); ok {
+			req.PeerCred = &addr.cred
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			req.Authorization = values[0]
+		}
+	}
+	return req
+}
+
+// authenticate returns the context of a call with its principal, or the
+// Unauthenticated error rejecting it
+func (c AuthConfig) authenticate(ctx context.Context, listener, method string) (context.Context, error) {
+	req := grpcAuthRequest(ctx, listener)
+	principal, err := c.Chains[listener].Authenticate(ctx, req)
+	if err != nil {
+		c.rejected(req, method, err)
+		return nil, withCode(ErrCodeUnauthenticated, err)
+	}
+	return context.WithValue(ctx, principalKey{}, principal), nil
+}
+
+// rejected logs a rejected request and publishes it as AUTH_FAILED
+func (c AuthConfig) rejected(req *AuthRequest, request string, err error) {
+	log.Printf("⚠️  Rejected %s request %s from %v: %v", req.Listener, request, req.Peer, err)
+	if c.events == nil {
+		return
+	}
+	source := ""
+	switch addr := req.Peer.(type) {
+	case *net.TCPAddr:
+		if ip, ok := netip.AddrFromSlice(addr.IP); ok {
+			source = ip.Unmap().String()
+		}
+	case *peerCredAddr:
+		source = "uid:" + strconv.FormatUint(uint64(addr.cred.Uid), 10)
+	}
+	c.events.Publish(&pb.Event{
+		Type:     EventAuthFailed,
+		Source:   source,
+		Message:  fmt.Sprintf("Rejected %s request %s from %s: %v", req.Listener, request, source, err),
+		Severity: "medium",
+		Metadata: map[string]string{"listener": req.Listener, "request": request},
+	})
+}
+
+// unaryInterceptor authenticates unary gRPC calls
+func (c AuthConfig) unaryInterceptor(listener string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := c.authenticate(ctx, listener, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor authenticates streaming gRPC calls
+func (c AuthConfig) streamInterceptor(listener string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := c.authenticate(stream.Context(), listener, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticatedStream carries the principal in its context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authREST authenticates REST requests; /health answers probes without
+// credentials
+func (c AuthConfig) authREST(next http.Handler) http.Handler {
+	chain := c.Chains[ListenerREST]
+	if len(chain) == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		req := &AuthRequest{Listener: ListenerREST, TLS: r.TLS, Authorization: r.Header.Get("Authorization")}
+		if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+			req.Peer = addr
+		}
+		principal, err := chain.Authenticate(r.Context(), req)
+		if err != nil {
+			c.rejected(req, r.Method+" "+r.URL.Path, err)
+			err = withCode(ErrCodeUnauthenticated, err)
+			setErrorCode(w, err)
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+	})
+}
+
+// peerCredAddr is the remote address of a Unix socket connection with the
+// credentials of the process that connected
+type peerCredAddr struct {
+	*net.UnixAddr
+	cred unix.Ucred
+}
+
+// peerCredConn reports its peer's credentials through RemoteAddr, which
+// gRPC hands to interceptors as the peer address
+type peerCredConn struct {
+	net.Conn
+	addr *peerCredAddr
+}
+
+func (c *peerCredConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// peerCredListener reads the peer credentials of every accepted connection
+type peerCredListener struct {
+	*net.UnixListener
+}
+
+// Accept returns the next connection whose credentials could be read;
+// the others are closed
+func (l *peerCredListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.AcceptUnix()
+		if err != nil {
+			return nil, err
+		}
+		cred, err := peerCred(conn)
+		if err != nil {
+			log.Printf("⚠️  Closing Unix socket connection: failed to read peer credentials: %v", err)
+			conn.Close()
+			continue
+		}
+		addr, _ := conn.RemoteAddr().(*net.UnixAddr)
+		if addr == nil {
+			addr = &net.UnixAddr{Net: "unix"}
+		}
+		return &peerCredConn{Conn: conn, addr: &peerCredAddr{UnixAddr: addr, cred: *cred}}, nil
+	}
+}
+
+// peerCred reads SO_PEERCRED of a Unix socket connection
+func peerCred(conn *net.UnixConn) (*unix.Ucred, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+	var cred *unix.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return nil, err
+	}
+	return cred, credErr
+}
+
+// listenUnix listens on a Unix socket, replacing a stale socket file.
+// The socket is accessible to its owner and group only.
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		os.Remove(path)
+	}
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0660); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return &peerCredListener{UnixListener: listener}, nil
+}
@@ -0,0 +1,373 @@
+// SPDX-License-Identifier: Apache-2.0
+// OIDC authentication provider: bearer JWTs issued by an OpenID Connect
+// provider, verified against the keys it publishes
+
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Keys are fetched again after this long, or when a token names an
+	// unknown key but not more often than oidcKeyRetryInterval
+	oidcKeyRefreshInterval = time.Hour
+	oidcKeyRetryInterval   = time.Minute
+
+	oidcFetchTimeout = 10 * time.Second
+	oidcMaxBytes     = 1 << 20
+
+	// Leeway for the clocks of the issuer and the control plane
+	oidcClockSkew = time.Minute
+)
+
+// oidcAuthProvider authenticates JWTs of CERBERUS_OIDC_ISSUER for
+// CERBERUS_OIDC_AUDIENCE. The keys are taken from CERBERUS_OIDC_JWKS_URL,
+// else from the issuer's discovery document; the subject is the claim
+// named by CERBERUS_OIDC_SUBJECT_CLAIM, sub by default.
+type oidcAuthProvider struct {
+	issuer       string
+	audience     string
+	subjectClaim string
+	clock        Clock
+
+	mutex     sync.Mutex
+	jwksURL   string                      // Discovered on the first fetch if not configured
+	keys      map[string]crypto.PublicKey // By key ID
+	fetchedAt time.Time
+}
+
+func newOIDCAuthProvider(tlsConfig *tls.Config) (AuthProvider, error) {
+	provider := &oidcAuthProvider{
+		issuer:       os.Getenv("CERBERUS_OIDC_ISSUER"),
+		audience:     os.Getenv("CERBERUS_OIDC_AUDIENCE"),
+		subjectClaim: os.Getenv("CERBERUS_OIDC_SUBJECT_CLAIM"),
+		jwksURL:      os.Getenv("CERBERUS_OIDC_JWKS_URL"),
+		clock:        systemClock{},
+	}
+	if provider.issuer == "" || provider.audience == "" {
+		return nil, fmt.Errorf("oidc needs CERBERUS_OIDC_ISSUER and CERBERUS_OIDC_AUDIENCE")
+	}
+	for name, raw := range map[string]string{"CERBERUS_OIDC_ISSUER": provider.issuer, "CERBERUS_OIDC_JWKS_URL": provider.jwksURL} {
+		if raw == "" {
+			continue
+		}
+		if parsed, err := url.Parse(raw); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected an https URL", name, raw)
+		}
+	}
+	if provider.subjectClaim == "" {
+		provider.subjectClaim = "sub"
+	}
+	return provider, nil
+}
+
+func (p *oidcAuthProvider) Name() string {
+	return "oidc"
+}
+
+// jwtHeader is the part of a JWT header verification needs
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// Authenticate verifies a JWT of the issuer: its signature, expiry and
+// audience. Bearer tokens that are not JWTs, or are JWTs of another
+// issuer, are left to the next provider.
+func (p *oidcAuthProvider) Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error) {
+	token, ok := req.bearerToken()
+	if !ok {
+		return nil, errNoCredentials
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errNoCredentials
+	}
+	var header jwtHeader
+	var claims map[string]interface{}
+	if jwtDecode(parts[0], &header) != nil || jwtDecode(parts[1], &claims) != nil {
+		return nil, errNoCredentials
+	}
+	if issuer, _ := claims["iss"].(string); issuer != p.issuer {
+		return nil, errNoCredentials
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding")
+	}
+	key, err := p.key(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	now := p.clock.Now()
+	expires, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("token has no expiry")
+	}
+	if now.After(time.Unix(int64(expires), 0).Add(oidcClockSkew)) {
+		return nil, fmt.Errorf("token expired at %s", time.Unix(int64(expires), 0).UTC().Format(time.RFC3339))
+	}
+	if notBefore, ok := claims["nbf"].(float64); ok && now.Add(oidcClockSkew).Before(time.Unix(int64(notBefore), 0)) {
+		return nil, fmt.Errorf("token is not valid before %s", time.Unix(int64(notBefore), 0).UTC().Format(time.RFC3339))
+	}
+	if !jwtAudienceContains(claims["aud"], p.audience) {
+		return nil, fmt.Errorf("token is not issued for audience %s", p.audience)
+	}
+	subject, _ := claims[p.subjectClaim].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("token has no %s claim", p.subjectClaim)
+	}
+	return &Principal{Subject: subject}, nil
+}
+
+// jwtDecode decodes a base64url JSON part of a JWT
+func jwtDecode(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jwtAudienceContains reports whether an aud claim, a string or a list of
+// them, names audience
+func jwtAudienceContains(claim interface{}, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, entry := range aud {
+			if entry == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifyJWTSignature checks the signature of a JWT's signing input with
+// the RS*, PS* and ES* algorithms
+func verifyJWTSignature(algorithm string, key crypto.PublicKey, input string, signature []byte) error {
+	var hash crypto.Hash
+	switch algorithm[min(2, len(algorithm)):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+	digest := hash.New()
+	digest.Write([]byte(input))
+	sum := digest.Sum(nil)
+
+	switch algorithm[:2] {
+	case "RS", "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key does not match signing algorithm %s", algorithm)
+		}
+		var err error
+		if algorithm[:2] == "RS" {
+			err = rsa.VerifyPKCS1v15(rsaKey, hash, sum, signature)
+		} else {
+			err = rsa.VerifyPSS(rsaKey, hash, sum, signature, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key does not match signing algorithm %s", algorithm)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("invalid signature")
+		}
+		r, s := new(big.Int).SetBytes(signature[:size]), new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, sum, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported signing algorithm %q", algorithm)
+}
+
+// key returns the issuer's key of an ID, fetching the keys when they are
+// stale or the ID is unknown. Tokens without a key ID use the only key.
+func (p *oidcAuthProvider) key(ctx context.Context, id string) (crypto.PublicKey, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.clock.Now()
+	age := now.Sub(p.fetchedAt)
+	_, known := p.keys[id]
+	if p.keys == nil || age > oidcKeyRefreshInterval || !known && id != "" && age > oidcKeyRetryInterval {
+		keys, err := p.fetchKeys(ctx)
+		if err != nil {
+			if p.keys == nil {
+				return nil, fmt.Errorf("failed to fetch issuer keys: %v", err)
+			}
+		} else {
+			p.keys = keys
+		}
+		p.fetchedAt = now
+	}
+
+	if id == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, nil
+		}
+	}
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", id)
+	}
+	return key, nil
+}
+
+// fetchKeys downloads the issuer's JSON Web Key Set, discovering its URL
+// first if needed. Caller must hold p.mutex.
+func (p *oidcAuthProvider) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	if p.jwksURL == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURL string `json:"jwks_uri"`
+		}
+		if err := oidcFetchJSON(ctx, strings.TrimSuffix(p.issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return nil, err
+		}
+		if discovery.Issuer != p.issuer || discovery.JWKSURL == "" {
+			return nil, fmt.Errorf("discovery document of %s names issuer %q and keys %q", p.issuer, discovery.Issuer, discovery.JWKSURL)
+		}
+		p.jwksURL = discovery.JWKSURL
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := oidcFetchJSON(ctx, p.jwksURL, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%s has no usable signing keys", p.jwksURL)
+	}
+	return keys, nil
+}
+
+// oidcFetchJSON downloads and decodes a JSON document
+func oidcFetchJSON(ctx context.Context, url string, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, oidcFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, oidcMaxBytes)).Decode(v); err != nil {
+		return fmt.Errorf("%s: %v", url, err)
+	}
+	return nil
+}
+
+// jsonWebKey is an RSA or EC public key of a JSON Web Key Set (RFC 7517)
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	number := func(field string) (*big.Int, error) {
+		data, err := base64.RawURLEncoding.DecodeString(field)
+		if err != nil || len(data) == 0 {
+			return nil, fmt.Errorf("invalid key parameter")
+		}
+		return new(big.Int).SetBytes(data), nil
+	}
+
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := number(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := number(jwk.E)
+		if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := number(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := number(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point not on curve %s", jwk.Curve)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.KeyType)
+}
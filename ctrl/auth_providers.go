@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Built-in authentication providers: client certificates, static bearer
+// tokens and Unix socket peer credentials (OIDC is in auth_oidc.go)
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+)
+
+// mtlsAuthProvider authenticates clients by a certificate signed by one
+// of CERBERUS_TLS_CLIENT_CA, optionally only the subjects listed in
+// CERBERUS_AUTH_MTLS_SUBJECTS
+type mtlsAuthProvider struct {
+	subjects map[string]bool // Empty = any verified certificate
+}
+
+func newMTLSAuthProvider(tlsConfig *tls.Config) (AuthProvider, error) {
+	if tlsConfig == nil || tlsConfig.ClientCAs == nil {
+		return nil, fmt.Errorf("mtls needs CERBERUS_TLS_CERT, CERBERUS_TLS_KEY and CERBERUS_TLS_CLIENT_CA")
+	}
+	provider := &mtlsAuthProvider{subjects: make(map[string]bool)}
+	for _, subject := range strings.Split(os.Getenv("CERBERUS_AUTH_MTLS_SUBJECTS"), ",") {
+		if subject = strings.TrimSpace(subject); subject != "" {
+			provider.subjects[subject] = true
+		}
+	}
+	return provider, nil
+}
+
+func (p *mtlsAuthProvider) Name() string {
+	return "mtls"
+}
+
+// Authenticate takes the subject of a verified client certificate: its
+// common name, else its first URI or DNS name
+func (p *mtlsAuthProvider) Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil, errNoCredentials
+	}
+	cert := req.TLS.VerifiedChains[0][0]
+	subject := cert.Subject.CommonName
+	if subject == "" && len(cert.URIs) > 0 {
+		subject = cert.URIs[0].String()
+	}
+	if subject == "" && len(cert.DNSNames) > 0 {
+		subject = cert.DNSNames[0]
+	}
+	if len(p.subjects) > 0 && !p.subjects[subject] {
+		return nil, fmt.Errorf("certificate subject %q is not permitted", subject)
+	}
+	return &Principal{Subject: subject}, nil
+}
+
+// tokenAuthProvider authenticates bearer tokens listed in
+// CERBERUS_AUTH_TOKENS_FILE, one "name token" pair per line
+type tokenAuthProvider struct {
+	tokens []namedToken
+}
+
+// namedToken is a token kept as its SHA-256, compared in constant time
+type namedToken struct {
+	name string
+	hash [sha256.Size]byte
+}
+
+func newTokenAuthProvider(tlsConfig *tls.Config) (AuthProvider, error) {
+	path := os.Getenv("CERBERUS_AUTH_TOKENS_FILE")
+	if path == "" {
+		return nil, fmt.Errorf("token needs CERBERUS_AUTH_TOKENS_FILE")
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CERBERUS_AUTH_TOKENS_FILE: %v", err)
+	}
+	defer file.Close()
+
+	provider := &tokenAuthProvider{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected a name and a token", path, line)
+		}
+		provider.tokens = append(provider.tokens, namedToken{name: fields[0], hash: sha256.Sum256([]byte(fields[1]))})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	if len(provider.tokens) == 0 {
+		return nil, fmt.Errorf("%s lists no tokens", path)
+	}
+	return provider, nil
+}
+
+func (p *tokenAuthProvider) Name() string {
+	return "token"
+}
+
+// Authenticate takes the name of a listed token. Unlisted tokens are left
+// to the next provider, which may know them as JWTs.
+func (p *tokenAuthProvider) Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error) {
+	token, ok := req.bearerToken()
+	if !ok {
+		return nil, errNoCredentials
+	}
+	hash := sha256.Sum256([]byte(token))
+	var found *namedToken
+	for i := range p.tokens {
+		if subtle.ConstantTimeCompare(hash[:], p.tokens[i].hash[:]) == 1 {
+			found = &p.tokens[i]
+		}
+	}
+	if found == nil {
+		return nil, errNoCredentials
+	}
+	return &Principal{Subject: found.name}, nil
+}
+
+// peerCredAuthProvider authenticates Unix socket clients by the user or
+// group of their process, those in CERBERUS_AUTH_UNIX_USERS and
+// CERBERUS_AUTH_UNIX_GROUPS (names or IDs); by default root and the
+// user running the control plane
+type peerCredAuthProvider struct {
+	uids map[uint32]bool
+	gids map[uint32]bool
+}
+
+func newPeerCredAuthProvider(tlsConfig *tls.Config) (AuthProvider, error) {
+	provider := &peerCredAuthProvider{uids: make(map[uint32]bool), gids: make(map[uint32]bool)}
+	for _, list := range []struct {
+		variable string
+		ids      map[uint32]bool
+		lookup   func(string) (string, error)
+	}{
+		{"CERBERUS_AUTH_UNIX_USERS", provider.uids, lookupUID},
+		{"CERBERUS_AUTH_UNIX_GROUPS", provider.gids, lookupGID},
+	} {
+		for _, entry := range strings.Split(os.Getenv(list.variable), ",") {
+			if entry = strings.TrimSpace(entry); entry == "" {
+				continue
+			}
+			raw, err := list.lookup(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s entry %q: %v", list.variable, entry, err)
+			}
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s entry %q: %v", list.variable, entry, err)
+			}
+			list.ids[uint32(id)] = true
+		}
+	}
+	if len(provider.uids) == 0 && len(provider.gids) == 0 {
+		provider.uids[0] = true
+		provider.uids[uint32(os.Getuid())] = true
+	}
+	return provider, nil
+}
+
+// lookupUID returns the ID of a user name or ID
+func lookupUID(name string) (string, error) {
+	if _, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return name, nil
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return u.Uid, nil
+}
+
+// lookupGID returns the ID of a group name or ID
+func lookupGID(name string) (string, error) {
+	if _, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return name, nil
+	}
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		return "", err
+	}
+	return g.Gid, nil
+}
+
+func (p *peerCredAuthProvider) Name() string {
+	return "peercred"
+}
+
+// Authenticate takes the user name of a permitted process, else its UID.
+// Other processes are left to the next provider.
+func (p *peerCredAuthProvider) Authenticate(ctx context.Context, req *AuthRequest) (*Principal, error) {
+	if req.PeerCred == nil {
+		return nil, errNoCredentials
+	}
+	uid, gid := req.PeerCred.Uid, req.PeerCred.Gid
+	if !p.uids[uid] && !p.gids[gid] {
+		return nil, fmt.Errorf("uid %d (gid %d) is not permitted: %w", uid, gid, errNoCredentials)
+	}
+	subject := "uid:" + strconv.FormatUint(uint64(uid), 10)
+	if u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10)); err == nil {
+		subject = u.Username
+	}
+	return &Principal{Subject: subject}, nil
+}
@@ -0,0 +1,672 @@
+// SPDX-License-Identifier: Apache-2.0
+// Automatic blocking: sources repeatedly dropped or failing authentication
+// blocked by temporary rules that last longer every time they come back
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// EventAutoBlocked reports a source blocked for exceeding a threshold,
+	// EventAutoUnblocked the end of its block
+	EventAutoBlocked   = "AUTO_BLOCKED"
+	EventAutoUnblocked = "AUTO_UNBLOCKED"
+
+	// AutoBlockLabel marks the rule of an automatic block, its value is the
+	// reason; AutoBlockOffenseLabel counts the blocks of the source it
+	// backed off from
+	AutoBlockLabel        = "auto-block"
+	AutoBlockOffenseLabel = "auto-block-offense"
+
+	// Owner of automatic block rules
+	AutoBlockOwner = "auto-block"
+
+	// Reasons of a block
+	AutoBlockReasonDrops        = "drops"
+	AutoBlockReasonAuthFailures = "auth_failures"
+	AutoBlockReasonPortScan     = "port_scan" // Quarantined by port scan detection
+
+	// Settings of a config leaving them at 0
+	DefaultAutoBlockWindowSeconds   = 60
+	DefaultAutoBlockSeconds         = 600
+	DefaultAutoBlockBackoffFactor   = 2
+	DefaultAutoBlockMaxBlockSeconds = 7 * 24 * 3600
+	DefaultAutoBlockForgetSeconds   = 24 * 3600
+	DefaultAutoBlockMaxBlocks       = 10000
+
+	// Longest window, block and memory of a block, highest backoff factor
+	// and most exempt prefixes
+	MaxAutoBlockSeconds       = 30 * 24 * 3600
+	MaxAutoBlockBackoffFactor = 16
+	MaxAutoBlockExempt        = 1024
+
+	// Sources counted at once; sources beyond are not counted until idle
+	// ones are pruned
+	MaxAutoBlockSources = 65536
+
+	autoBlockPruneInterval = time.Minute
+)
+
+// AutoBlockConfig is when a source is blocked automatically and for how
+// long. A source exceeding a threshold within a window is blocked for
+// BlockSeconds, multiplied by BackoffFactor for every earlier block ended
+// less than ForgetSeconds before, up to MaxBlockSeconds.
+type AutoBlockConfig struct {
+	Enabled              bool           `json:"enabled"`
+	DropThreshold        uint32         `json:"drop_threshold"`         // Estimated dropped packets, 0 = not counted
+	AuthFailureThreshold uint32         `json:"auth_failure_threshold"` // 0 = not counted
+	WindowSeconds        uint32         `json:"window_seconds"`
+	BlockSeconds         uint32         `json:"block_seconds"`
+	BackoffFactor        uint32         `json:"backoff_factor"`
+	MaxBlockSeconds      uint32         `json:"max_block_seconds"`
+	ForgetSeconds        uint32         `json:"forget_seconds"`
+	Exempt               []netip.Prefix `json:"exempt,omitempty"` // Never blocked
+	MaxBlocks            uint32         `json:"max_blocks"`       // Blocks in effect at most
+}
+
+// validateAutoBlockConfig checks a config and fills in the settings left
+// at 0
+func validateAutoBlockConfig(config *AutoBlockConfig) error {
+	var errs ruleValidationError
+	if config.Enabled && config.DropThreshold == 0 && config.AuthFailureThreshold == 0 {
+		errs.add("drop_threshold", "drop_threshold or auth_failure_threshold is required")
+	}
+	for _, setting := range []struct {
+		field string
+		value uint32
+	}{
+		{"window_seconds", config.WindowSeconds},
+		{"block_seconds", config.BlockSeconds},
+		{"max_block_seconds", config.MaxBlockSeconds},
+		{"forget_seconds", config.ForgetSeconds},
+	} {
+		if setting.value > MaxAutoBlockSeconds {
+			errs.add(setting.field, "%s must be at most %d", setting.field, MaxAutoBlockSeconds)
+		}
+	}
+	if config.BackoffFactor > MaxAutoBlockBackoffFactor {
+		errs.add("backoff_factor", "backoff_factor must be at most %d", MaxAutoBlockBackoffFactor)
+	}
+	if config.MaxBlockSeconds != 0 && config.MaxBlockSeconds < config.BlockSeconds {
+		errs.add("max_block_seconds", "max_block_seconds must not be below block_seconds")
+	}
+	if len(config.Exempt) > MaxAutoBlockExempt {
+		errs.add("exempt", "at most %d prefixes can be exempt", MaxAutoBlockExempt)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	defaults := []struct {
+		value    *uint32
+		fallback uint32
+	}{
+		{&config.WindowSeconds, DefaultAutoBlockWindowSeconds},
+		{&config.BlockSeconds, DefaultAutoBlockSeconds},
+		{&config.BackoffFactor, DefaultAutoBlockBackoffFactor},
+		{&config.MaxBlockSeconds, max(DefaultAutoBlockMaxBlockSeconds, config.BlockSeconds)},
+		{&config.ForgetSeconds, DefaultAutoBlockForgetSeconds},
+		{&config.MaxBlocks, DefaultAutoBlockMaxBlocks},
+	}
+	for _, setting := range defaults {
+		if *setting.value == 0 {
+			*setting.value = setting.fallback
+		}
+	}
+	return nil
+}
+
+// duration returns how long the offense-th block of a source lasts
+func (config AutoBlockConfig) duration(offense uint32) time.Duration {
+	duration := time.Duration(config.BlockSeconds) * time.Second
+	longest := time.Duration(config.MaxBlockSeconds) * time.Second
+	for i := uint32(1); i < offense && duration < longest && config.BackoffFactor > 1; i++ {
+		duration *= time.Duration(config.BackoffFactor)
+	}
+	return min(duration, longest)
+}
+
+// exempts reports whether a source is never blocked
+func (config AutoBlockConfig) exempts(addr netip.Addr) bool {
+	return sourceExempt(config.Exempt, addr)
+}
+
+// sourceExempt reports whether a source is exempt from detection:
+// loopback, unspecified and multicast addresses and those in a prefix
+func sourceExempt(prefixes []netip.Prefix, addr netip.Addr) bool {
+	if addr.IsLoopback() || addr.IsUnspecified() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseExemptPrefixes parses prefixes or host addresses exempt from
+// detection
+func parseExemptPrefixes(raw []string, errs *ruleValidationError) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, text := range raw {
+		prefix, err := netip.ParsePrefix(text)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(text)
+			if addrErr != nil {
+				errs.add("exempt", "invalid exempt prefix %q: %v", text, err)
+				continue
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes
+}
+
+// AutoBlocker counts the drops and authentication failures of each source
+// over fixed windows and remembers its blocks for the backoff
+type AutoBlocker struct {
+	mutex   sync.Mutex
+	sources map[netip.Addr]*autoBlockSource
+	blocks  map[string]uint64 // Blocks since start by reason
+}
+
+// autoBlockSource is the count of a source in its current window
+type autoBlockSource struct {
+	windowStart  time.Time
+	drops        float64
+	authFailures float64
+	offenses     uint32    // Blocks remembered
+	blockedUntil time.Time // End of the last block, zero = never blocked
+}
+
+func NewAutoBlocker() *AutoBlocker {
+	return &AutoBlocker{sources: make(map[netip.Addr]*autoBlockSource), blocks: make(map[string]uint64)}
+}
+
+// count adds weight to the count of reason of a source, unless blocked,
+// and reports whether it reached its threshold. The count then starts
+// over, and offense is the number the block would take.
+func (b *AutoBlocker) count(config AutoBlockConfig, addr netip.Addr, reason string, weight float64, now time.Time) (float64, uint32, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	source := b.sources[addr]
+	if source == nil {
+		if len(b.sources) >= MaxAutoBlockSources {
+			return 0, 0, false
+		}
+		source = &autoBlockSource{windowStart: now}
+		b.sources[addr] = source
+	}
+	if now.Before(source.blockedUntil) {
+		return 0, 0, false
+	}
+	if now.Sub(source.windowStart) >= time.Duration(config.WindowSeconds)*time.Second {
+		source.windowStart, source.drops, source.authFailures = now, 0, 0
+	}
+	if source.offenses > 0 && now.Sub(source.blockedUntil) >= time.Duration(config.ForgetSeconds)*time.Second {
+		source.offenses = 0
+	}
+
+	counted, threshold := &source.drops, config.DropThreshold
+	if reason == AutoBlockReasonAuthFailures {
+		counted, threshold = &source.authFailures, config.AuthFailureThreshold
+	}
+	*counted += weight
+	if threshold == 0 || *counted < float64(threshold) {
+		return 0, 0, false
+	}
+	total := *counted
+	source.drops, source.authFailures = 0, 0
+	return total, source.offenses + 1, true
+}
+
+// offense returns the number a new block of a source takes, false while
+// it is blocked
+func (b *AutoBlocker) offense(config AutoBlockConfig, addr netip.Addr, now time.Time) (uint32, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	source := b.sources[addr]
+	if source == nil {
+		return 1, true
+	}
+	if now.Before(source.blockedUntil) {
+		return 0, false
+	}
+	if source.offenses > 0 && now.Sub(source.blockedUntil) >= time.Duration(config.ForgetSeconds)*time.Second {
+		source.offenses = 0
+	}
+	return source.offenses + 1, true
+}
+
+// blocked records the block of a source until until
+func (b *AutoBlocker) blocked(addr netip.Addr, offense uint32, until time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	source := b.sources[addr]
+	if source == nil {
+		source = &autoBlockSource{}
+		b.sources[addr] = source
+	}
+	source.offenses = max(source.offenses, offense)
+	source.blockedUntil = until
+}
+
+// counted adds a block for reason to the totals
+func (b *AutoBlocker) counted(reason string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.blocks[reason]++
+}
+
+// release forgets a source, its block lifted by an operator
+func (b *AutoBlocker) release(addr netip.Addr) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.sources, addr)
+}
+
+// prune forgets the sources idle for a window and with no block to
+// remember
+func (b *AutoBlocker) prune(config AutoBlockConfig, now time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	window := time.Duration(config.WindowSeconds) * time.Second
+	forget := time.Duration(config.ForgetSeconds) * time.Second
+	for addr, source := range b.sources {
+		if now.Sub(source.windowStart) >= window && now.Sub(source.blockedUntil) >= forget {
+			delete(b.sources, addr)
+		}
+	}
+}
+
+// Stats returns the sources counted and the blocks since start by reason
+func (b *AutoBlocker) Stats() (int, map[string]uint64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	blocks := make(map[string]uint64, len(b.blocks))
+	for reason, count := range b.blocks {
+		blocks[reason] = count
+	}
+	return len(b.sources), blocks
+}
+
+// runAutoBlock counts the sampled drops and authentication failures of
+// each source and blocks those exceeding the thresholds until ctx is done
+func (s *Server) runAutoBlock(ctx context.Context) {
+	events, cancel := s.events.Subscribe([]string{EventPacketSample, EventAuthFailed})
+	defer cancel()
+	ticker := time.NewTicker(autoBlockPruneInterval)
+	defer ticker.Stop()
+
+	// Blocks restored with the policy still back off
+	s.mutex.RLock()
+	for _, rule := range s.autoBlockRules() {
+		offense, _ := strconv.ParseUint(rule.Labels[AutoBlockOffenseLabel], 10, 32)
+		s.autoBlocker.blocked(netip.MustParseAddr(rule.SrcIP), uint32(offense), rule.ExpiresAt)
+	}
+	s.mutex.RUnlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.autoBlocker.prune(s.autoBlockConfig(), s.clock.Now())
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			s.observeAutoBlock(event)
+		}
+	}
+}
+
+// observeAutoBlock counts a dropped packet sample or an authentication
+// failure against its source and blocks the source once it reaches the
+// threshold
+func (s *Server) observeAutoBlock(event *pb.Event) {
+	config := s.autoBlockConfig()
+	if !config.Enabled {
+		return
+	}
+	addr, reason, weight, ok := autoBlockCounted(config, event)
+	if !ok {
+		return
+	}
+
+	now := s.clock.Now()
+	count, offense, exceeded := s.autoBlocker.count(config, addr, reason, weight, now)
+	if !exceeded {
+		return
+	}
+	trigger := autoBlockTrigger{addr: addr, reason: reason, count: count, window: config.WindowSeconds, offense: offense}
+	if err := s.blockAutomatically(config, trigger, now); err != nil {
+		log.Printf("⚠️  Failed to block %s automatically: %v", addr, err)
+	}
+}
+
+// autoBlockCounted returns the source an event counts against, the reason
+// and its weight: the sample rate of a dropped packet sample, 1 for an
+// authentication failure. Other events and exempt sources are not counted.
+func autoBlockCounted(config AutoBlockConfig, event *pb.Event) (netip.Addr, string, float64, bool) {
+	reason, weight := AutoBlockReasonAuthFailures, 1.0
+	switch event.Type {
+	case EventPacketSample:
+		if event.Metadata["verdict"] != "drop" || config.DropThreshold == 0 {
+			return netip.Addr{}, "", 0, false
+		}
+		reason = AutoBlockReasonDrops
+		fmt.Sscan(event.Metadata["sample_rate"], &weight)
+	case EventAuthFailed:
+		if config.AuthFailureThreshold == 0 {
+			return netip.Addr{}, "", 0, false
+		}
+	default:
+		return netip.Addr{}, "", 0, false
+	}
+	addr, err := netip.ParseAddr(event.Source)
+	if err != nil {
+		return netip.Addr{}, "", 0, false
+	}
+	addr = addr.Unmap()
+	if config.exempts(addr) {
+		return netip.Addr{}, "", 0, false
+	}
+	return addr, reason, weight, true
+}
+
+// autoBlockTrigger is what a source is blocked for
+type autoBlockTrigger struct {
+	addr    netip.Addr
+	reason  string
+	count   float64 // Drops, authentication failures or ports counted
+	window  uint32  // Seconds they were counted over
+	offense uint32  // Number the block takes, see AutoBlocker.offense
+}
+
+// blockAutomatically blocks a source with an inbound drop rule ahead of
+// every rule matching its traffic, expiring after the duration of its
+// offense-th block under config
+func (s *Server) blockAutomatically(config AutoBlockConfig, trigger autoBlockTrigger, now time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	addr, reason, count, offense := trigger.addr, trigger.reason, trigger.count, trigger.offense
+	blocks := s.autoBlockRules()
+	for _, rule := range blocks {
+		if rule.SrcIP == addr.String() {
+			offense, _ := strconv.ParseUint(rule.Labels[AutoBlockOffenseLabel], 10, 32)
+			s.autoBlocker.blocked(addr, uint32(offense), rule.ExpiresAt)
+			return nil
+		}
+	}
+	if len(blocks) >= int(config.MaxBlocks) {
+		return fmt.Errorf("%d automatic blocks are in effect, the most allowed", len(blocks))
+	}
+
+	duration := config.duration(offense)
+	rule := &FirewallRule{
+		ID:          s.ids.NewID("rule"),
+		Action:      "drop",
+		SrcIP:       addr.String(),
+		Protocol:    "any",
+		Direction:   "inbound",
+		Enabled:     true,
+		Description: fmt.Sprintf("Automatic block of %s: %.0f %s in %ds", addr, count, autoBlockReasonText(reason), trigger.window),
+		Owner:       AutoBlockOwner,
+		ExpiresAt:   now.Add(duration),
+		Labels:      map[string]string{AutoBlockLabel: reason, AutoBlockOffenseLabel: strconv.FormatUint(uint64(offense), 10)},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.validateRule(rule); err != nil {
+		return err
+	}
+	priority, ok := s.priorityAhead([]*FirewallRule{rule})
+	if !ok {
+		return fmt.Errorf("no priority is ahead of the rules matching its traffic")
+	}
+	rule.Priority = priority
+	if err := s.limits.checkRules(len(s.rules) + 1); err != nil {
+		return err
+	}
+
+	s.rules[rule.ID] = rule
+	if err := s.applyPolicy(); err != nil {
+		delete(s.rules, rule.ID)
+		return withRemediation(err)
+	}
+	s.persistPolicy()
+	s.autoBlocker.blocked(addr, offense, rule.ExpiresAt)
+	s.autoBlocker.counted(reason)
+
+	expiresAt := rule.ExpiresAt.UTC().Format(time.RFC3339)
+	s.events.Publish(ruleEvent(EventRuleAdded, rule))
+	event := ruleEvent(EventAutoBlocked, rule)
+	event.Message = fmt.Sprintf("%s blocked until %s after %.0f %s in %ds (block %d)",
+		addr, expiresAt, count, autoBlockReasonText(reason), trigger.window, offense)
+	event.Severity = "high"
+	event.Metadata = map[string]string{
+		"reason":           reason,
+		"count":            strconv.FormatFloat(count, 'f', 0, 64),
+		"offense":          strconv.FormatUint(uint64(offense), 10),
+		"duration_seconds": strconv.FormatInt(int64(duration/time.Second), 10),
+		"expires_at":       expiresAt,
+	}
+	s.events.Publish(event)
+	log.Printf("🚫 Blocked %s automatically for %s (%.0f %s, block %d): %s", addr, duration, count, autoBlockReasonText(reason), offense, rule.ID)
+	return nil
+}
+
+// autoUnblockEvent reports the end of the block of rule, expired or
+// released
+func autoUnblockEvent(rule *FirewallRule, why string) *pb.Event {
+	event := ruleEvent(EventAutoUnblocked, rule)
+	event.Message = fmt.Sprintf("%s unblocked: block %s %s", rule.SrcIP, rule.ID, why)
+	event.Severity = "medium"
+	event.Metadata = map[string]string{"reason": rule.Labels[AutoBlockLabel], "unblocked": why}
+	return event
+}
+
+func autoBlockReasonText(reason string) string {
+	switch reason {
+	case AutoBlockReasonAuthFailures:
+		return "authentication failures"
+	case AutoBlockReasonPortScan:
+		return "distinct ports scanned"
+	}
+	return "dropped packets"
+}
+
+// SetAutoBlockConfig replaces the automatic blocking config. Blocks in
+// effect stay until they expire or are released.
+func (s *Server) SetAutoBlockConfig(ctx context.Context, req *pb.SetAutoBlockConfigRequest) (*pb.AutoBlockStatusResponse, error) {
+	if req.GetConfig() == nil {
+		return &pb.AutoBlockStatusResponse{Success: false, Message: "Auto-block config is required"}, nil
+	}
+
+	config, err := autoBlockConfigFromProto(req.Config)
+	if err == nil {
+		err = validateAutoBlockConfig(&config)
+	}
+	if err != nil {
+		return &pb.AutoBlockStatusResponse{Success: false, Message: fmt.Sprintf("Auto-block config validation failed: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	s.autoBlock = config
+	s.persistPolicy()
+	s.mutex.Unlock()
+	log.Printf("Set auto-block: enabled=%v, %d drops or %d auth failures in %ds block for %ds",
+		config.Enabled, config.DropThreshold, config.AuthFailureThreshold, config.WindowSeconds, config.BlockSeconds)
+
+	resp := s.autoBlockStatus()
+	resp.Success = true
+	resp.Message = "Auto-block config saved successfully"
+	return resp, nil
+}
+
+// GetAutoBlockStatus returns the automatic blocking config and the blocks
+// in effect
+func (s *Server) GetAutoBlockStatus(ctx context.Context, req *pb.Empty) (*pb.AutoBlockStatusResponse, error) {
+	resp := s.autoBlockStatus()
+	resp.Success = true
+	return resp, nil
+}
+
+// autoBlockStatus returns the config, the blocks in effect ordered by
+// address and the counts of the engine
+func (s *Server) autoBlockStatus() *pb.AutoBlockStatusResponse {
+	s.mutex.RLock()
+	resp := &pb.AutoBlockStatusResponse{Config: autoBlockConfigToProto(s.autoBlock)}
+	for _, rule := range s.autoBlockRules() {
+		offense, _ := strconv.ParseUint(rule.Labels[AutoBlockOffenseLabel], 10, 32)
+		resp.Blocks = append(resp.Blocks, &pb.AutoBlock{
+			Address:   rule.SrcIP,
+			Reason:    rule.Labels[AutoBlockLabel],
+			RuleId:    rule.ID,
+			BlockedAt: rule.CreatedAt.Unix(),
+			ExpiresAt: rule.ExpiresAt.Unix(),
+			Offense:   uint32(offense),
+		})
+	}
+	s.mutex.RUnlock()
+
+	tracked, blocks := s.autoBlocker.Stats()
+	resp.TrackedSources = uint64(tracked)
+	for _, count := range blocks {
+		resp.BlockedTotal += count
+	}
+	return resp
+}
+
+// ReleaseAutoBlock lifts the automatic block of a source and forgets its
+// earlier blocks
+func (s *Server) ReleaseAutoBlock(ctx context.Context, req *pb.ReleaseAutoBlockRequest) (*pb.StatusResponse, error) {
+	addr, err := netip.ParseAddr(req.Address)
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("address must be a host address: %v", err)}, nil
+	}
+	addr = addr.Unmap()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var released []*FirewallRule
+	for _, rule := range s.autoBlockRules() {
+		if rule.SrcIP == addr.String() {
+			released = append(released, rule)
+			delete(s.rules, rule.ID)
+		}
+	}
+	if len(released) == 0 {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Source %s is not blocked automatically", addr)}, nil
+	}
+	if err := s.applyPolicy(); err != nil {
+		for _, rule := range released {
+			s.rules[rule.ID] = rule
+		}
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove automatic block from data plane: %v", withRemediation(err))}, nil
+	}
+	s.persistPolicy()
+	s.autoBlocker.release(addr)
+	for _, rule := range released {
+		s.events.Publish(ruleEvent(EventRuleDeleted, rule))
+		s.events.Publish(autoUnblockEvent(rule, "released"))
+	}
+	log.Printf("Released automatic block of %s", addr)
+	return &pb.StatusResponse{Success: true, Message: "Automatic block released successfully"}, nil
+}
+
+// autoBlockConfig returns the automatic blocking config
+func (s *Server) autoBlockConfig() AutoBlockConfig {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.autoBlock
+}
+
+// quarantineConfig returns the automatic blocking config with its
+// defaults filled in, enabled or not, for the blocks of other detectors
+func (s *Server) quarantineConfig() AutoBlockConfig {
+	config := s.autoBlockConfig()
+	config.Enabled = false
+	validateAutoBlockConfig(&config)
+	return config
+}
+
+// autoBlockRules returns the rules of automatic blocks ordered by source.
+// Caller must hold s.mutex.
+func (s *Server) autoBlockRules() []*FirewallRule {
+	var rules []*FirewallRule
+	for _, rule := range s.rules {
+		if _, ok := rule.Labels[AutoBlockLabel]; ok && rule.Owner == AutoBlockOwner {
+			if _, err := netip.ParseAddr(rule.SrcIP); err == nil {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		a, b := netip.MustParseAddr(rules[i].SrcIP), netip.MustParseAddr(rules[j].SrcIP)
+		if a != b {
+			return a.Less(b)
+		}
+		return rules[i].ID < rules[j].ID
+	})
+	return rules
+}
+
+func autoBlockConfigFromProto(config *pb.AutoBlockConfig) (AutoBlockConfig, error) {
+	converted := AutoBlockConfig{
+		Enabled:              config.Enabled,
+		DropThreshold:        config.DropThreshold,
+		AuthFailureThreshold: config.AuthFailureThreshold,
+		WindowSeconds:        config.WindowSeconds,
+		BlockSeconds:         config.BlockSeconds,
+		BackoffFactor:        config.BackoffFactor,
+		MaxBlockSeconds:      config.MaxBlockSeconds,
+		ForgetSeconds:        config.ForgetSeconds,
+		MaxBlocks:            config.MaxBlocks,
+	}
+	var errs ruleValidationError
+	converted.Exempt = parseExemptPrefixes(config.Exempt, &errs)
+	if len(errs) > 0 {
+		return converted, errs
+	}
+	return converted, nil
+}
+
+func autoBlockConfigToProto(config AutoBlockConfig) *pb.AutoBlockConfig {
+	converted := &pb.AutoBlockConfig{
+		Enabled:              config.Enabled,
+		DropThreshold:        config.DropThreshold,
+		AuthFailureThreshold: config.AuthFailureThreshold,
+		WindowSeconds:        config.WindowSeconds,
+		BlockSeconds:         config.BlockSeconds,
+		BackoffFactor:        config.BackoffFactor,
+		MaxBlockSeconds:      config.MaxBlockSeconds,
+		ForgetSeconds:        config.ForgetSeconds,
+		MaxBlocks:            config.MaxBlocks,
+	}
+	for _, prefix := range config.Exempt {
+		converted.Exempt = append(converted.Exempt, prefix.String())
+	}
+	return converted
+}
@@ -0,0 +1,454 @@
+// SPDX-License-Identifier: Apache-2.0
+// Bloom filter pre-check: packets that cannot match any ingress rule skip the prefix tries and rule maps
+
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cilium/ebpf"
+)
+
+// Pinned bloom filter maps (must match eBPF program)
+const (
+	BloomActiveMapName = "cerberus_bloom_active"
+	BloomConfigMapName = "cerberus_bloom_config"
+	BloomMapName       = "cerberus_bloom"
+	BloomStatsMapName  = "cerberus_bloom_stats"
+
+	// Prefix lengths checked per family (must match BLOOM_MAX_CHECKS)
+	BloomMaxChecks = 8
+)
+
+const (
+	bloomSlots = 2 // Filters the data plane switches between (BLOOM_SLOTS)
+
+	// BPF_MAP_TYPE_BLOOM_FILTER, which cilium/ebpf v0.16 does not name
+	bloomFilterMap = ebpf.MapType(30)
+	// Hash functions of a filter created without map_extra
+	bloomHashes = 5
+
+	// Address sides of a check (must match struct bloom_check)
+	bloomSideSrc = 0
+	bloomSideDst = 1
+
+	// Counters of cerberus_bloom_stats
+	bloomStatChecked  = 0
+	bloomStatNegative = 1
+)
+
+// bloomCheck is one prefix length a packet is checked at, mirroring
+// struct bloom_check
+type bloomCheck struct {
+	Side      uint8
+	PrefixLen uint8
+}
+
+// bloomFamily mirrors struct bloom_family
+type bloomFamily struct {
+	Complete uint8 // Every enabled entry of the family is in the filter
+	Checks   uint8
+	Check    [BloomMaxChecks]bloomCheck
+}
+
+// bloomConfig mirrors struct bloom_config
+type bloomConfig struct {
+	Family4 bloomFamily
+	Family6 bloomFamily
+}
+
+// bloomKey mirrors struct bloom_key: a prefix with its address masked
+type bloomKey struct {
+	Family    uint8
+	Side      uint8
+	PrefixLen uint8
+	Pad       uint8
+	Addr      [16]byte
+}
+
+// bloomPlan is the content of one filter: what packets are checked at and
+// the elements they are checked against
+type bloomPlan struct {
+	config   bloomConfig
+	elements map[bloomKey]bool
+}
+
+// bloomPrefix returns the side and prefix an entry is entered under in a
+// family's filter: the longer of its source and destination prefixes,
+// source on a tie. An entry matching any address on both sides cannot be
+// entered.
+func bloomPrefix(entry *FirewallRule, family int) (uint8, netip.Prefix, bool) {
+	src := rulePrefix(entry.SrcIP, family)
+	dst := rulePrefix(entry.DstIP, family)
+	switch {
+	case src.Bits() == 0 && dst.Bits() == 0:
+		return 0, netip.Prefix{}, false
+	case dst.Bits() > src.Bits():
+		return bloomSideDst, dst, true
+	default:
+		return bloomSideSrc, src, true
+	}
+}
+
+// planBloom computes the filter covering the enabled ingress entries. When
+// more prefix lengths are in use than can be checked, the closest lengths
+// of a side merge into the shorter one, and the entries of the longer are
+// entered cut to it.
+func planBloom(entries []*FirewallRule) *bloomPlan {
+	plan := &bloomPlan{elements: make(map[bloomKey]bool)}
+	for _, family := range []int{familyIPv4, familyIPv6} {
+		config := &plan.config.Family4
+		if family == familyIPv6 {
+			config = &plan.config.Family6
+		}
+
+		type bloomEntry struct {
+			side   uint8
+			prefix netip.Prefix
+		}
+		var covered []bloomEntry
+		lengths := [2]map[int]bool{{}, {}}
+		complete := true
+		for _, entry := range entries {
+			if !entry.Enabled || !tableAccepts(family, false, entry) {
+				continue
+			}
+			side, prefix, ok := bloomPrefix(entry, family)
+			if !ok {
+				complete = false
+				break
+			}
+			covered = append(covered, bloomEntry{side, prefix})
+			lengths[side][prefix.Bits()] = true
+		}
+		if !complete {
+			continue
+		}
+
+		checks := bloomChecks(lengths)
+		config.Complete, config.Checks = 1, uint8(len(checks))
+		copy(config.Check[:], checks)
+		for _, entry := range covered {
+			bits := 0
+			for _, check := range checks {
+				if check.Side == entry.side && int(check.PrefixLen) <= entry.prefix.Bits() {
+					bits = max(bits, int(check.PrefixLen))
+				}
+			}
+			prefix := netip.PrefixFrom(entry.prefix.Addr(), bits).Masked()
+			key := bloomKey{Family: uint8(family), Side: entry.side, PrefixLen: uint8(bits)}
+			copy(key.Addr[:], prefix.Addr().AsSlice())
+			plan.elements[key] = true
+		}
+	}
+	return plan
+}
+
+// bloomChecks picks the prefix lengths of each side to check, merging the
+// closest lengths of a side until at most BloomMaxChecks remain. The
+// shortest length of each side is kept, so every entry has a check at or
+// below its prefix length.
+func bloomChecks(lengths [2]map[int]bool) []bloomCheck {
+	var sides [2][]int
+	for side, set := range lengths {
+		for bits := range set {
+			sides[side] = append(sides[side], bits)
+		}
+		sort.Ints(sides[side])
+	}
+	for len(sides[0])+len(sides[1]) > BloomMaxChecks {
+		mergeSide, mergeAt, gap := -1, 0, math.MaxInt
+		for side, bits := range sides {
+			for i := 1; i < len(bits); i++ {
+				if bits[i]-bits[i-1] < gap {
+					mergeSide, mergeAt, gap = side, i, bits[i]-bits[i-1]
+				}
+			}
+		}
+		sides[mergeSide] = append(sides[mergeSide][:mergeAt], sides[mergeSide][mergeAt+1:]...)
+	}
+
+	var checks []bloomCheck
+	for side, bits := range sides {
+		for _, length := range bits {
+			checks = append(checks, bloomCheck{Side: uint8(side), PrefixLen: uint8(length)})
+		}
+	}
+	return checks
+}
+
+// equal reports whether two plans build the same filter
+func (p *bloomPlan) equal(other *bloomPlan) bool {
+	if other == nil || p.config != other.config || len(p.elements) != len(other.elements) {
+		return false
+	}
+	for key := range p.elements {
+		if !other.elements[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomFilter is the pre-check of a data plane
+type bloomFilter struct {
+	active  *ebpf.Map
+	config  *ebpf.Map
+	filters *ebpf.Map
+	stats   *ebpf.Map
+
+	slot        int                      // Active slot, -1 when no filter is active
+	suspended   bool                     // Tables in an unknown state until the next replace
+	plan        *bloomPlan               // Filter of the active slot
+	entries     map[string]*FirewallRule // Ingress entries written, by ID
+	rebuilds    uint64
+	lastRebuild time.Time
+}
+
+// openBloom opens the pinned bloom filter maps and activates a filter for
+// the empty rule tables. Programs predating them walk the rule maps for
+// every packet.
+func (bm *BPFMapManager) openBloom() {
+	bloom := &bloomFilter{slot: -1, entries: make(map[string]*FirewallRule)}
+	var opened []*ebpf.Map
+	for _, m := range []struct {
+		name string
+		dst  **ebpf.Map
+	}{
+		{BloomActiveMapName, &bloom.active},
+		{BloomConfigMapName, &bloom.config},
+		{BloomMapName, &bloom.filters},
+		{BloomStatsMapName, &bloom.stats},
+	} {
+		path := filepath.Join(bm.pinPath, m.name)
+		pinned, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  Bloom filter pre-check not available at %s: %v", path, err)
+			for _, m := range opened {
+				m.Close()
+			}
+			return
+		}
+		*m.dst = pinned
+		opened = append(opened, pinned)
+	}
+	bm.bloom = bloom
+	bloom.sync(nil, bm.clock.Now())
+}
+
+// sync makes the active filter cover entries, building a new one in the
+// inactive slot when the content changes, rebuilt at now. When no filter
+// can be built the pre-check is switched off, which is always safe.
+func (b *bloomFilter) sync(entries []*FirewallRule, now time.Time) {
+	if b.suspended {
+		return
+	}
+	plan := planBloom(entries)
+	if plan.equal(b.plan) {
+		return
+	}
+	if err := b.install(plan, now); err != nil {
+		b.disable(err)
+	}
+}
+
+// disable switches the pre-check off until the next sync
+func (b *bloomFilter) disable(reason error) {
+	log.Printf("⚠️  Bloom filter pre-check disabled: %v", reason)
+	key, off := uint32(0), uint32(0)
+	if err := b.active.Put(&key, &off); err != nil {
+		log.Printf("⚠️  Failed to switch off bloom filter pre-check: %v", err)
+	}
+	b.slot, b.plan = -1, nil
+}
+
+// install builds a filter in the inactive slot and switches to it
+func (b *bloomFilter) install(plan *bloomPlan, now time.Time) error {
+	slot := uint32((b.slot + 1) % bloomSlots)
+	filter, err := ebpf.NewMap(&ebpf.MapSpec{
+		Type:       bloomFilterMap,
+		ValueSize:  uint32(binary.Size(bloomKey{})),
+		MaxEntries: uint32(max(len(plan.elements), 1)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create filter: %v", err)
+	}
+	defer filter.Close()
+	for key := range plan.elements {
+		if err := filter.Put(nil, key); err != nil {
+			return fmt.Errorf("failed to fill filter: %v", err)
+		}
+	}
+
+	if err := b.config.Put(&slot, plan.config); err != nil {
+		return fmt.Errorf("failed to write configuration of slot %d: %v", slot, err)
+	}
+	if err := b.filters.Put(&slot, filter); err != nil {
+		return fmt.Errorf("failed to write filter of slot %d: %v", slot, err)
+	}
+	key, active := uint32(0), slot+1
+	if err := b.active.Put(&key, &active); err != nil {
+		return fmt.Errorf("failed to activate slot %d: %v", slot, err)
+	}
+	b.slot, b.plan = int(slot), plan
+	b.rebuilds++
+	b.lastRebuild = now
+	return nil
+}
+
+// covered lists the entries the filter covers, plus extra
+func (b *bloomFilter) covered(extra ...*FirewallRule) []*FirewallRule {
+	entries := make([]*FirewallRule, 0, len(b.entries)+len(extra))
+	for _, entry := range b.entries {
+		entries = append(entries, entry)
+	}
+	return append(entries, extra...)
+}
+
+// bloomBeforePut extends the filter to an entry about to be written, while
+// the entry it replaces may still match
+func (bm *BPFMapManager) bloomBeforePut(rule *FirewallRule) {
+	if bm.bloom != nil {
+		bm.bloom.sync(bm.bloom.covered(rule), bm.clock.Now())
+	}
+}
+
+// bloomAfterPut records the ingress entry written under an ID, or its
+// removal, and drops what no longer matches from the filter
+func (bm *BPFMapManager) bloomAfterPut(id string, rule *FirewallRule) {
+	if bm.bloom == nil {
+		return
+	}
+	if rule != nil {
+		bm.bloom.entries[id] = rule
+	} else {
+		delete(bm.bloom.entries, id)
+	}
+	bm.bloom.sync(bm.bloom.covered(), bm.clock.Now())
+}
+
+// bloomSuspend switches the pre-check off after a failed write left the
+// ingress tables partly updated, until a replace rewrites them whole
+func (bm *BPFMapManager) bloomSuspend(cause error) {
+	if bm.bloom != nil && !bm.bloom.suspended {
+		bm.bloom.disable(fmt.Errorf("until the next rule set replace, after %v", cause))
+		bm.bloom.suspended = true
+	}
+}
+
+// bloomBeforeReplace extends the filter to a rule set about to be staged
+// next to the running one
+func (bm *BPFMapManager) bloomBeforeReplace(entries []*FirewallRule) {
+	if bm.bloom != nil {
+		bm.bloom.sync(bm.bloom.covered(entries...), bm.clock.Now())
+	}
+}
+
+// bloomAfterReplace narrows the filter to the rule set now running
+func (bm *BPFMapManager) bloomAfterReplace(entries []*FirewallRule) {
+	if bm.bloom == nil {
+		return
+	}
+	bm.bloom.suspended = false
+	bm.bloom.entries = make(map[string]*FirewallRule)
+	for _, entry := range entries {
+		if ingress, _ := ruleHooks(entry); ingress {
+			bm.bloom.entries[entry.ID] = entry
+		}
+	}
+	bm.bloom.sync(bm.bloom.covered(), bm.clock.Now())
+}
+
+func (b *bloomFilter) close() {
+	b.active.Close()
+	b.config.Close()
+	b.filters.Close()
+	b.stats.Close()
+}
+
+// BloomFamilyStatus describes the pre-check of one family
+type BloomFamilyStatus struct {
+	Family   string   `json:"family"`
+	Complete bool     `json:"complete"` // False while a rule matches any address on both sides
+	Checks   []string `json:"checks"`   // Prefixes checked, e.g. "src/32"
+}
+
+// BloomStatus describes the bloom filter pre-check of a data plane
+type BloomStatus struct {
+	Enabled           bool                `json:"enabled"`
+	Active            bool                `json:"active"`
+	Elements          int                 `json:"elements"`
+	FalsePositiveRate float64             `json:"false_positive_rate"` // Estimated, per check
+	Families          []BloomFamilyStatus `json:"families,omitempty"`
+	Checked           uint64              `json:"checked"`  // Packets pre-checked
+	Negative          uint64              `json:"negative"` // Packets that skipped the rule maps
+	Rebuilds          uint64              `json:"rebuilds"`
+	LastRebuild       time.Time           `json:"last_rebuild,omitempty"`
+}
+
+// BloomStatus reports the filter and its counters; it is not enabled when
+// simulated or when the program predates it
+func (bm *BPFMapManager) BloomStatus() (*BloomStatus, error) {
+	b := bm.bloom
+	if b == nil {
+		return &BloomStatus{}, nil
+	}
+	status := &BloomStatus{Enabled: true, Active: b.plan != nil, Rebuilds: b.rebuilds, LastRebuild: b.lastRebuild}
+	if b.plan != nil {
+		status.Elements = len(b.plan.elements)
+		status.FalsePositiveRate = bloomFalsePositiveRate(len(b.plan.elements))
+		for _, family := range []struct {
+			family int
+			config bloomFamily
+		}{{familyIPv4, b.plan.config.Family4}, {familyIPv6, b.plan.config.Family6}} {
+			familyStatus := BloomFamilyStatus{Family: familyName(family.family), Complete: family.config.Complete != 0}
+			for _, check := range family.config.Check[:family.config.Checks] {
+				side := "src"
+				if check.Side == bloomSideDst {
+					side = "dst"
+				}
+				familyStatus.Checks = append(familyStatus.Checks, fmt.Sprintf("%s/%d", side, check.PrefixLen))
+			}
+			status.Families = append(status.Families, familyStatus)
+		}
+	}
+
+	var err error
+	if status.Checked, err = sumPerCPU(b.stats, bloomStatChecked); err != nil {
+		return nil, fmt.Errorf("failed to read bloom filter counters: %v", err)
+	}
+	if status.Negative, err = sumPerCPU(b.stats, bloomStatNegative); err != nil {
+		return nil, fmt.Errorf("failed to read bloom filter counters: %v", err)
+	}
+	return status, nil
+}
+
+// bloomFalsePositiveRate estimates the false positive rate of one lookup
+// in a filter of n elements. The kernel sizes the bit array at about
+// n*hashes*7/5 bits rounded up to a power of two.
+func bloomFalsePositiveRate(n int) float64 {
+	if n == 0 {
+		return 0
+	}
+	bits := math.Exp2(math.Ceil(math.Log2(float64(n) * bloomHashes * 7 / 5)))
+	return math.Pow(1-math.Exp(-bloomHashes*float64(n)/bits), bloomHashes)
+}
+
+// BloomStatus reports the bloom filter pre-check of a data plane scope
+func (s *Server) BloomStatus(scope string) (*BloomStatus, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	manager := s.dataPlaneFor(scope)
+	if manager == nil {
+		return nil, fmt.Errorf("no data plane for scope %q", scope)
+	}
+	return manager.BloomStatus()
+}
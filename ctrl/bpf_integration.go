@@ -4,12 +4,15 @@
 package main
 
 import (
+	"encoding/binary"
 	"fmt"
 	"log"
+	"net"
+	"net/netip"
 	"os"
 	"path/filepath"
-	"syscall"
-	"unsafe"
+	"strconv"
+	"strings"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/link"
@@ -20,6 +23,23 @@ type BPFManager struct {
 	program *ebpf.Program
 	link    link.Link
 	maps    map[string]*ebpf.Map
+
+	// TC egress program and the interfaces it is attached to
+	tcProgram *ebpf.Program
+	tcFilters []*tcFilter
+
+	// XDP attach mode (see xdp_mode.go): requested, and achieved by the
+	// last AttachXDP. tcIngress is the fallback when XDP is unavailable;
+	// tcMirror sends the copies of mirror rules next to XDP.
+	xdpMode    string
+	attachMode string
+	tcIngress  *ebpf.Program
+	tcMirror   *ebpf.Program
+	pinPath    string // Maps are shared through pins here, empty = private
+
+	// Pipeline stage programs by stage (see pipeline.go), empty in older
+	// objects
+	stages map[string]*ebpf.Program
 }
 
 // Initialize BPF subsystem
@@ -30,7 +50,8 @@ func NewBPFManager() (*BPFManager, error) {
 	}
 
 	bm := &BPFManager{
-		maps: make(map[string]*ebpf.Map),
+		maps:    make(map[string]*ebpf.Map),
+		xdpMode: XDPModeAuto,
 	}
 
 	return bm, nil
@@ -44,18 +65,28 @@ func (bm *BPFManager) LoadXDPProgram(programPath string) error {
 		return fmt.Errorf("failed to load XDP program spec: %v", err)
 	}
 
-	coll, err := ebpf.NewCollection(spec)
+	coll, err := ebpf.NewCollectionWithOptions(spec, ebpf.CollectionOptions{
+		Maps: ebpf.MapOptions{PinPath: bm.pinPath},
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create collection: %v", err)
 	}
 
 	// Get the XDP program
-	program, exists := coll.Programs["xdp_filter"]
+	program, exists := coll.Programs["xdp_firewall"]
 	if !exists {
-		return fmt.Errorf("XDP program 'xdp_filter' not found")
+		return fmt.Errorf("XDP program 'xdp_firewall' not found")
 	}
 
 	bm.program = program
+	bm.tcIngress = coll.Programs["tc_ingress"] // Absent in older objects
+	bm.tcMirror = coll.Programs["tc_mirror"]
+	bm.stages = make(map[string]*ebpf.Program)
+	for _, stage := range pipelineStages {
+		if stageProgram, exists := coll.Programs[pipelineProgramName(stage)]; exists {
+			bm.stages[stage] = stageProgram
+		}
+	}
 
 	// Store maps for later use
 	for name, m := range coll.Maps {
@@ -72,53 +103,169 @@ func (bm *BPFManager) AttachXDP(interfaceName string) error {
 		return fmt.Errorf("no XDP program loaded")
 	}
 
-	// Attach to interface
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to find interface %s: %v", interfaceName, err)
+	}
+
+	// Try the requested mode first and fall back to slower ones
+	var failures []string
+	for _, mode := range xdpModeFallbacks(bm.xdpMode) {
+		if err := bm.attachInMode(iface.Index, mode); err != nil {
+			log.Printf("⚠️  %s attach on %s failed: %v", mode, interfaceName, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", mode, err))
+			continue
+		}
+		bm.attachMode = mode
+		log.Printf("✅ XDP program attached to interface %s in %s mode", interfaceName, mode)
+		if mode != XDPModeTC {
+			bm.attachMirror(iface.Index, interfaceName)
+		}
+		return nil
+	}
+	return fmt.Errorf("failed to attach XDP program: %s", strings.Join(failures, "; "))
+}
+
+// attachInMode attaches the filter in one attach mode
+func (bm *BPFManager) attachInMode(ifindex int, mode string) error {
+	if mode == XDPModeTC {
+		if bm.tcIngress == nil {
+			return fmt.Errorf("TC program 'tc_ingress' not found")
+		}
+		filter, err := attachIngressFilter(ifindex, bm.tcIngress)
+		if err != nil {
+			return err
+		}
+		bm.tcFilters = append(bm.tcFilters, filter)
+		return nil
+	}
+
 	l, err := link.AttachXDP(link.XDPOptions{
 		Program:   bm.program,
-		Interface: interfaceName,
+		Interface: ifindex,
+		Flags:     xdpAttachFlags[mode],
 	})
 	if err != nil {
-		return fmt.Errorf("failed to attach XDP program: %v", err)
+		return err
 	}
-
 	bm.link = l
-	log.Printf("✅ XDP program attached to interface %s", interfaceName)
 	return nil
 }
 
-// Update BPF map with firewall rules
-func (bm *BPFManager) UpdateFirewallRules(rules []FirewallRule) error {
-	firewallMap, exists := bm.maps["firewall_rules"]
+// Load TC egress program. Maps already loaded with the XDP program are
+// reused, so both hooks share the flow table.
+func (bm *BPFManager) LoadTCProgram(programPath string) error {
+	spec, err := ebpf.LoadCollectionSpec(programPath)
+	if err != nil {
+		return fmt.Errorf("failed to load TC program spec: %v", err)
+	}
+
+	replacements := make(map[string]*ebpf.Map)
+	for name := range spec.Maps {
+		if m, exists := bm.maps[name]; exists {
+			replacements[name] = m
+		}
+	}
+	coll, err := ebpf.NewCollectionWithOptions(spec, ebpf.CollectionOptions{MapReplacements: replacements})
+	if err != nil {
+		return fmt.Errorf("failed to create collection: %v", err)
+	}
+
+	program, exists := coll.Programs["tc_egress"]
 	if !exists {
-		return fmt.Errorf("firewall_rules map not found")
+		coll.Close()
+		return fmt.Errorf("TC program 'tc_egress' not found")
 	}
+	delete(coll.Programs, "tc_egress")
+	bm.tcProgram = program
 
-	// Clear existing rules
-	var key, nextKey uint32
-	for {
-		err := firewallMap.NextKey(&key, &nextKey)
-		if err != nil {
-			break
+	// Keep the maps only the TC program uses; replaced ones are clones
+	for name, m := range coll.Maps {
+		if _, exists := bm.maps[name]; exists {
+			continue
 		}
-		firewallMap.Delete(&nextKey)
-		key = nextKey
+		bm.maps[name] = m
+		delete(coll.Maps, name)
+	}
+	coll.Close()
+
+	log.Printf("✅ TC egress program loaded successfully")
+	return nil
+}
+
+// Attach TC egress program to interface, adding its clsact qdisc if needed
+func (bm *BPFManager) AttachTC(interfaceName string) error {
+	if bm.tcProgram == nil {
+		return fmt.Errorf("no TC program loaded")
+	}
+
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to find interface %s: %v", interfaceName, err)
+	}
+
+	filter, err := attachEgressFilter(iface.Index, bm.tcProgram)
+	if err != nil {
+		return fmt.Errorf("failed to attach TC program: %v", err)
 	}
 
-	// Add new rules
-	for i, rule := range rules {
-		key := uint32(i)
-		bpfRule := BPFFirewallRule{
-			SrcIP:    rule.SourceIP,
-			DstIP:    rule.DestIP,
-			SrcPort:  rule.SourcePort,
-			DstPort:  rule.DestPort,
-			Protocol: rule.Protocol,
-			Action:   rule.Action,
+	bm.tcFilters = append(bm.tcFilters, filter)
+	log.Printf("✅ TC egress program attached to interface %s", interfaceName)
+	return nil
+}
+
+// Update BPF maps with firewall rules. Each rule goes to the hooks of its
+// direction; rules without addresses go to both families. Slots are assigned
+// in list order.
+func (bm *BPFManager) UpdateFirewallRules(rules []FirewallRule) error {
+	tables := []struct {
+		family int
+		egress bool
+	}{
+		{familyIPv4, false},
+		{familyIPv6, false},
+		{familyIPv4, true},
+		{familyIPv6, true},
+	}
+
+	for _, table := range tables {
+		var values []interface{}
+		srcPrefixes := make(map[uint32]netip.Prefix)
+		dstPrefixes := make(map[uint32]netip.Prefix)
+		for i := range rules {
+			if family := ruleFamily(&rules[i]); family != familyAny && family != table.family {
+				continue
+			}
+			if ingress, egress := ruleHooks(&rules[i]); (table.egress && !egress) || (!table.egress && !ingress) {
+				continue
+			}
+			slot := uint32(len(values))
+			values = append(values, encodeRule(&rules[i], table.family))
+			srcPrefixes[slot] = rulePrefix(rules[i].SrcIP, table.family)
+			dstPrefixes[slot] = rulePrefix(rules[i].DstIP, table.family)
 		}
 
-		err := firewallMap.Put(&key, &bpfRule)
-		if err != nil {
-			return fmt.Errorf("failed to update rule %d: %v", i, err)
+		names := ruleMapNames(table.family, table.egress)
+		rulesMap, srcTrie, dstTrie := bm.maps[names[0]], bm.maps[names[1]], bm.maps[names[2]]
+		if rulesMap == nil || srcTrie == nil || dstTrie == nil {
+			hook := hookNames(!table.egress, table.egress)
+			if table.family == familyIPv4 && !table.egress {
+				return fmt.Errorf("%s %s maps not found", familyName(table.family), hook)
+			}
+			if len(values) > 0 {
+				log.Printf("⚠️ %s %s maps not found, %d rules not installed", familyName(table.family), hook, len(values))
+			}
+			continue
+		}
+
+		if err := writeRuleSlots(rulesMap, values); err != nil {
+			return err
+		}
+		if err := syncPrefixTrie(srcTrie, buildPrefixTrie(srcPrefixes, table.family)); err != nil {
+			return err
+		}
+		if err := syncPrefixTrie(dstTrie, buildPrefixTrie(dstPrefixes, table.family)); err != nil {
+			return err
 		}
 	}
 
@@ -126,6 +273,98 @@ func (bm *BPFManager) UpdateFirewallRules(rules []FirewallRule) error {
 	return nil
 }
 
+// writeRuleSlots writes encoded rules to the leading slots of an array map
+// and zeroes the rest; array map entries cannot be deleted
+func writeRuleSlots(rulesMap *ebpf.Map, values []interface{}) error {
+	if len(values) > int(rulesMap.MaxEntries()) {
+		return fmt.Errorf("%d rules exceed map capacity of %d", len(values), rulesMap.MaxEntries())
+	}
+
+	empty := make([]byte, rulesMap.ValueSize())
+	for i := uint32(0); i < rulesMap.MaxEntries(); i++ {
+		var value interface{} = empty
+		if int(i) < len(values) {
+			value = values[i]
+		}
+
+		if err := rulesMap.Put(&i, value); err != nil {
+			return fmt.Errorf("failed to update rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// ruleSet mirrors struct rule_set: bit n selects rule slot n
+type ruleSet [MaxRules / 64]uint64
+
+func (rs *ruleSet) add(slot uint32) {
+	rs[slot/64] |= 1 << (slot % 64)
+}
+
+// buildPrefixTrie computes the trie content for rule slots and their
+// prefixes. An LPM lookup only returns the longest matching entry, so each
+// entry carries every slot whose prefix contains it. The /0 root is always
+// present so lookups of uncovered addresses still find rules for any address.
+func buildPrefixTrie(prefixes map[uint32]netip.Prefix, family int) map[netip.Prefix]ruleSet {
+	trie := map[netip.Prefix]ruleSet{rulePrefix("", family): {}}
+	for _, prefix := range prefixes {
+		trie[prefix] = ruleSet{}
+	}
+
+	for entry, set := range trie {
+		for slot, prefix := range prefixes {
+			if prefix.Bits() <= entry.Bits() && prefix.Contains(entry.Addr()) {
+				set.add(slot)
+			}
+		}
+		trie[entry] = set
+	}
+	return trie
+}
+
+// syncPrefixTrie makes an LPM trie map hold exactly the given entries.
+// Entries are written before stale ones are deleted, so a lookup always
+// finds a covering prefix.
+func syncPrefixTrie(trie *ebpf.Map, entries map[netip.Prefix]ruleSet) error {
+	for prefix, set := range entries {
+		if err := trie.Put(lpmKey(prefix), set); err != nil {
+			return fmt.Errorf("failed to write prefix %s: %v", prefix, err)
+		}
+	}
+
+	var stale [][]byte
+	key := make([]byte, trie.KeySize())
+	var set ruleSet
+	iter := trie.Iterate()
+	for iter.Next(&key, &set) {
+		if _, exists := entries[lpmKeyPrefix(key)]; !exists {
+			stale = append(stale, append([]byte(nil), key...))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to list prefixes: %v", err)
+	}
+
+	for _, key := range stale {
+		if err := trie.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete prefix %s: %v", lpmKeyPrefix(key), err)
+		}
+	}
+	return nil
+}
+
+// lpmKey encodes struct lpm_key4/lpm_key6: prefix length in host byte order
+// followed by the address in network byte order
+func lpmKey(prefix netip.Prefix) []byte {
+	key := binary.NativeEndian.AppendUint32(nil, uint32(prefix.Bits()))
+	return append(key, prefix.Addr().AsSlice()...)
+}
+
+func lpmKeyPrefix(key []byte) netip.Prefix {
+	addr, _ := netip.AddrFromSlice(key[4:])
+	return netip.PrefixFrom(addr, int(binary.NativeEndian.Uint32(key)))
+}
+
 // Get statistics from BPF maps
 func (bm *BPFManager) GetStatistics() (*BPFStatistics, error) {
 	statsMap, exists := bm.maps["stats"]
@@ -153,6 +392,24 @@ func (bm *BPFManager) Close() error {
 		bm.program.Close()
 	}
 
+	for _, filter := range bm.tcFilters {
+		if err := filter.detach(); err != nil {
+			log.Printf("⚠️ Failed to detach TC program: %v", err)
+		}
+	}
+	if bm.tcProgram != nil {
+		bm.tcProgram.Close()
+	}
+	if bm.tcIngress != nil {
+		bm.tcIngress.Close()
+	}
+	if bm.tcMirror != nil {
+		bm.tcMirror.Close()
+	}
+	for _, stageProgram := range bm.stages {
+		stageProgram.Close()
+	}
+
 	for _, m := range bm.maps {
 		m.Close()
 	}
@@ -162,13 +419,37 @@ func (bm *BPFManager) Close() error {
 }
 
 // BPF data structures
+
+// BPFFirewallRule mirrors struct fw_rule in ebpf/xdp_filter.c, the value of
+// both rule maps. Addresses are matched by the prefix tries; ports hold host
+// byte order. The layout has no implicit padding, so it encodes byte-for-byte
+// as the C struct.
 type BPFFirewallRule struct {
-	SrcIP    uint32
-	DstIP    uint32
-	SrcPort  uint16
-	DstPort  uint16
-	Protocol uint8
-	Action   uint8
+	SrcPort    uint16
+	SrcPortEnd uint16
+	DstPort    uint16
+	DstPortEnd uint16
+	Protocol   uint8
+	Action     uint8
+	Enabled    uint8
+	CtState    uint8 // Connection state bits the packet must have, 0 = any
+	Priority   int32
+	GenFrom    uint32  // First rule set generation the rule is live in, 0 = any
+	GenUntil   uint32  // Last generation the rule is live in, 0 = no end
+	SrcSet     uint32  // IP set the source address must be in, 0 = none
+	DstSet     uint32  // IP set the destination address must be in, 0 = none
+	LogID      uint32  // Matched packets are logged under this ID, 0 = not logged
+	Mirror     uint32  // Index of the interface mirror rules copy packets to
+	Flags      uint8   // TCP flags that must be set among those in FlagsMask
+	FlagsMask  uint8   // 0 = any flags; else only TCP packets match
+	VLAN       uint16  // VLAN ID of the packet's outer tag, 0 = any
+	SrcMAC     [6]byte // All zero = any
+	DstMAC     [6]byte // All zero = any
+	IfaceSet   uint32  // Interface set the packet's interface must be in, 0 = any
+	SrcCountry uint32  // Country set the source address must be in, 0 = any
+	DstCountry uint32  // Country set the destination address must be in, 0 = any
+	SrcASN     uint32  // ASN set the source address must be announced by, 0 = any
+	DstASN     uint32  // ASN set the destination address must be announced by, 0 = any
 }
 
 type BPFStatistics struct {
@@ -179,10 +460,88 @@ type BPFStatistics struct {
 	LastUpdate       uint64
 }
 
-// Helper function to convert IP string to uint32
-func ipToUint32(ip string) uint32 {
-	// Simple conversion - in production use proper IP parsing
-	return 0
+// rulePrefix returns the prefix a rule address matches in the given family.
+// An empty address, or one of the other family, yields the /0 prefix.
+func rulePrefix(addr string, family int) netip.Prefix {
+	unspecified := netip.PrefixFrom(netip.IPv4Unspecified(), 0)
+	if family == familyIPv6 {
+		unspecified = netip.PrefixFrom(netip.IPv6Unspecified(), 0)
+	}
+	if addressFamily(addr) != family {
+		return unspecified
+	}
+
+	network, err := parseRuleAddress(addr)
+	if err != nil {
+		return unspecified
+	}
+	ip, _ := netip.AddrFromSlice(network.IP)
+	if family == familyIPv4 {
+		ip = ip.Unmap()
+	}
+	bits, _ := network.Mask.Size()
+	return netip.PrefixFrom(ip, bits).Masked()
+}
+
+// Helper function to convert protocol name or number to IP protocol number
+func protocolToUint8(protocol string) uint8 {
+	switch protocol {
+	case "icmp":
+		return 1
+	case "tcp":
+		return 6
+	case "udp":
+		return 17
+	case "igmp":
+		return 2
+	case "ipip":
+		return 4
+	case "ipv6":
+		return 41
+	case "gre":
+		return 47
+	case "sctp":
+		return 132
+	default:
+		if number, err := strconv.ParseUint(protocol, 10, 8); err == nil {
+			return uint8(number)
+		}
+		return 0 // any
+	}
+}
+
+// validateProtocol checks the protocol of a rule: a name, "any", or the
+// number of a protocol without a name
+func validateProtocol(protocol string) error {
+	number := protocolToUint8(protocol)
+	if protocol == "" || protocol == "any" || (number != 0 && protocolName(number) == protocol) {
+		return nil
+	}
+	if number == 0 {
+		return fmt.Errorf("invalid protocol: %s, expected a name or a number from 1 to 255", protocol)
+	}
+	return fmt.Errorf("protocol %s must be written %s", protocol, protocolName(number))
+}
+
+// protocolHasPorts reports whether rules of a protocol match ports
+func protocolHasPorts(protocol string) bool {
+	return protocol == "tcp" || protocol == "udp" || protocol == "sctp"
+}
+
+// Helper function to convert action name to data plane action code
+func actionToUint8(action string) uint8 {
+	switch action {
+	case "drop":
+		return 1
+	case "redirect":
+		return 2
+	case "reject":
+		return 3
+	case "mirror":
+		return 4
+	default:
+		return 0 // allow
+	}
 }
 
 // Pin BPF maps to filesystem
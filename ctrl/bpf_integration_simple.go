@@ -1,21 +1,45 @@
 // SPDX-License-Identifier: Apache-2.0
 // Author: funcybot@gmail.com  Date: 2025-06-26
-// Simplified BPF Integration for Testing (no unix syscalls)
+// BPF map integration: pinned maps when the XDP program is loaded, simulation otherwise
 
 package main
 
 import (
+	"encoding/binary"
 	"fmt"
 	"log"
+	"net/netip"
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
 )
 
 const (
-	// BPF map paths (pinned in /sys/fs/bpf/)
-	StatsMapPath = "/sys/fs/bpf/cerberus_stats"
-	RulesMapPath = "/sys/fs/bpf/cerberus_rules"
+	// bpffs directory the host data plane pins its maps in, by name
+	DefaultPinPath = "/sys/fs/bpf"
+
+	// Pinned map names (must match eBPF program)
+	StatsMapName    = "stats_map"
+	RulesMapName    = "cerberus_rules"
+	SrcTrieMapName  = "cerberus_src4"
+	DstTrieMapName  = "cerberus_dst4"
+	Rules6MapName   = "cerberus_rules6"
+	Src6TrieMapName = "cerberus_src6"
+	Dst6TrieMapName = "cerberus_dst6"
+
+	// Outbound rules of the TC egress program, same layout
+	EgressRulesMapName    = "cerberus_out4"
+	EgressSrcTrieMapName  = "cerberus_osrc4"
+	EgressDstTrieMapName  = "cerberus_odst4"
+	Egress6RulesMapName   = "cerberus_out6"
+	Egress6SrcTrieMapName = "cerberus_osrc6"
+	Egress6DstTrieMapName = "cerberus_odst6"
+
+	// Rule slots per family (must match MAX_RULES in eBPF program)
+	MaxRules = 256
 	
 	// Stats map keys (must match eBPF program)
 	StatPass     = 0
@@ -26,9 +50,190 @@ const (
 
 // BPFMapManager handles interaction with BPF maps
 type BPFMapManager struct {
-	statsMapFD int
-	rulesMapFD int
+	statsMap   *ebpf.Map
+	conntrack  *ebpf.Map      // Flow table, nil if the program predates it
+	sampleRate *ebpf.Map      // Packet sampling rate and ring buffer, nil if
+	samples    *ebpf.Map      // the program predates them (see sampling.go)
+	rejects    *ebpf.Map      // Packets of reject rules, nil if the program predates them (see reject.go)
+	logRate    *ebpf.Map      // Rule log rate and ring buffer, nil if the
+	logEvents  *ebpf.Map      // program predates them (see rule_log.go)
+	mirrors    *ebpf.Map      // Mirror copies sent and failed, nil if the program predates them (see mirror.go)
+	rules      *ruleSlotTable // IPv4 rules
+	rules6     *ruleSlotTable // IPv6 rules, nil if the program predates them
+	egress     *ruleSlotTable // Outbound IPv4 rules, nil without the TC program
+	egress6    *ruleSlotTable // Outbound IPv6 rules, nil without the TC program
 	simulated  bool
+	pinPath    string
+	events     *EventBus // Receives attach/detach notifications, may be nil
+	clock      Clock     // Times pipeline stage changes and filter rebuilds
+
+	// Rule set generation for hit-less replacement (see replace.go), nil
+	// if the program predates it
+	generation       *ebpf.Map
+	activeGeneration uint32
+
+	// Installed policy version stamped onto events (see policy_version.go),
+	// nil if the program predates it
+	policyVersion *ebpf.Map
+
+	// Interface sets of interface-scoped rules (see interface_rules.go),
+	// nil if the program predates them
+	ruleIfaces *ebpf.Map
+
+	// Country sets and GeoIP prefixes of country rules (see
+	// country_rules.go), nil if the program predates them
+	geoIP *geoIPMaps
+
+	// ASN sets and prefixes of ASN rules (see asn_rules.go), nil if the
+	// program predates them
+	asns *asnMaps
+
+	// Degradation flags (see degradation.go), nil if the program predates them
+	degrade *ebpf.Map
+
+	// Persisted counter totals (see counter_snapshots.go), nil = the data
+	// plane's own counters
+	counters *CounterContinuity
+
+	// Tail-call pipeline (see pipeline.go), nil if the program predates it.
+	// Stages are loaded from pipelineObject unless an upgrade names another.
+	pipeline       *ebpf.Map
+	pipelineObject string
+	stages         map[string]*pipelineStage
+
+	// Packet disposition and stage counters (see dispositions.go), nil if
+	// the program predates them
+	dispositions *ebpf.Map
+	stageStats   *ebpf.Map
+
+	// Ingress counters by protocol (see protocol_stats.go), nil if the
+	// program predates them
+	protocolStats *ebpf.Map
+
+	// Hardware offload (see offload.go)
+	offloadMode string
+	offloads    map[string]*InterfaceOffload // By interface
+
+	// XDP attach mode (see xdp_mode.go): requested, achieved per interface
+	// and the loader holding each attachment
+	xdpMode     string
+	attachModes map[string]string
+	loaders     map[string]*BPFManager
+
+	// Ingress rule shards (see shards.go), nil when sharding is off
+	shards *ruleShards
+
+	// Bloom filter pre-check (see bloom.go), nil if the program predates it
+	bloom *bloomFilter
+
+	// IP set maps (see ipsets.go), nil if the program predates them
+	ipSets *ipSetMaps
+
+	// Default policy by interface (see default_policy.go), nil if the
+	// program predates it
+	defaultPolicy *ebpf.Map
+
+	// Protection profiles and counters by destination (see protection.go),
+	// nil if the program predates them
+	protection      *ebpf.Map
+	protectionState *ebpf.Map
+
+	// Tunnel endpoints and counters (see tunnels.go), nil if the program
+	// predates them
+	tunnels     *ebpf.Map
+	tunnelStats *ebpf.Map
+
+	// Multicast group counters and membership reports (see multicast.go),
+	// nil if the program predates them
+	mcastStats   *ebpf.Map
+	mcastReports *ebpf.Map
+
+	// NAT64 prefixes, sessions and counters (see nat64.go), nil if the
+	// program predates them
+	nat64Prefixes *ebpf.Map
+	nat64Sessions *ebpf.Map
+	nat64Returns  *ebpf.Map
+	nat64Stats    *ebpf.Map
+
+	// DNAT rules, source NAT rules and sessions, and NAT rule counters (see
+	// nat.go), nil if the program predates them
+	dnat         *ebpf.Map
+	natStats     *ebpf.Map
+	snat         *ebpf.Map
+	snatSessions *ebpf.Map
+	snatReturns  *ebpf.Map
+
+	// DNS filter settings, domains, query reports and counters (see
+	// dns_filter.go), nil if the program predates them
+	dnsConfig  *ebpf.Map
+	dnsDomains *ebpf.Map
+	dnsQueries *ebpf.Map
+	dnsStats   *ebpf.Map
+
+	// TLS fingerprinting settings, blocked flows, ClientHello reports and
+	// counters (see tls_fingerprint.go), nil if the program predates them
+	tlsConfig  *ebpf.Map
+	tlsBlocked *ebpf.Map
+	tlsHellos  *ebpf.Map
+	tlsStats   *ebpf.Map
+
+	// SYN flood mitigation config, destination rates, verified sources and
+	// counters (see mitigation.go), nil if the program predates them
+	synFloodConfig   *ebpf.Map
+	synFloodDests    *ebpf.Map
+	synFloodVerified *ebpf.Map
+	synFloodStats    *ebpf.Map
+
+	// Per-source rate limit config, tracked sources, exemptions and
+	// counters (see source_limits.go), nil if the program predates them
+	sourceLimitConfig *ebpf.Map
+	sourceLimits      *ebpf.Map
+	sourceLimitExempt *ebpf.Map
+	sourceLimitStats  *ebpf.Map
+
+	// Port scan detection config, sources, ports tried and counters (see
+	// port_scan.go), nil if the program predates them
+	portScanConfig *ebpf.Map
+	portScans      *ebpf.Map
+	portScanPorts  *ebpf.Map
+	portScanStats  *ebpf.Map
+
+	// Packet normalization checks, bogon tries, counters and sources
+	// dropped (see normalize.go), nil if the program predates them
+	normConfig  *ebpf.Map
+	bogons4     *ebpf.Map
+	bogons6     *ebpf.Map
+	normStats   *ebpf.Map
+	normSources *ebpf.Map
+
+	// Per-interface settings, counters and policer buckets (see
+	// interface_config.go), nil if the program predates them
+	ifaceConfigs *ebpf.Map
+	ifaceStats   *ebpf.Map
+	ifacePolice  *ebpf.Map
+
+	// Single reader per ring buffer shared by its consumers (see ring_bus.go)
+	rings *RingBus
+}
+
+// ruleSlotTable tracks which slot of a family's rule array map holds each
+// entry, and keeps the family's prefix tries pointing at those slots. The
+// XDP program picks the best priority among candidate slots; slot order
+// only breaks ties, so entries of equal priority are kept in ID order (see
+// priority.go).
+type ruleSlotTable struct {
+	family   int
+	rulesMap *ebpf.Map
+	srcTrie  *ebpf.Map
+	dstTrie  *ebpf.Map
+	hits     *ebpf.Map                // Per-slot hit counters, nil if the program predates them
+	carried  map[string]ruleHitsValue // Hits of entries before they moved slot
+	slots    map[string]uint32
+	free     []uint32
+
+	// Prefixes of occupied slots
+	srcPrefixes map[uint32]netip.Prefix
+	dstPrefixes map[uint32]netip.Prefix
 }
 
 // FirewallStats represents packet statistics from eBPF
@@ -39,20 +244,119 @@ type FirewallStats struct {
 	Error    uint64 `json:"error"`
 }
 
-// NewBPFMapManager creates a new BPF map manager
+// NewBPFMapManager opens the pinned maps of the host data plane
 func NewBPFMapManager() (*BPFMapManager, error) {
-	manager := &BPFMapManager{
-		statsMapFD: -1,
-		rulesMapFD: -1,
-		simulated:  true, // Always use simulation for testing
+	return NewBPFMapManagerAt(DefaultPinPath)
+}
+
+// NewBPFMapManagerAt opens the maps a loaded XDP program pinned under
+// pinPath, falling back to simulation mode when they are not available
+func NewBPFMapManagerAt(pinPath string) (*BPFMapManager, error) {
+	manager := &BPFMapManager{simulated: true, pinPath: pinPath, offloadMode: OffloadNone, xdpMode: XDPModeAuto, rings: NewRingBus(), clock: systemClock{}}
+
+	rules, err := openRuleSlotTable(pinPath, familyIPv4, false)
+	if err != nil {
+		log.Printf("BPF Map Manager initialized in simulation mode (%v)", withRemediation(err))
+		return manager, nil
 	}
-	
-	log.Printf("BPF Map Manager initialized in simulation mode")
-	
+	manager.rules = rules
+	manager.simulated = false
+
+	if rules6, err := openRuleSlotTable(pinPath, familyIPv6, false); err != nil {
+		log.Printf("⚠️  IPv6 rules disabled: %v", err)
+	} else {
+		manager.rules6 = rules6
+	}
+
+	if egress, err := openRuleSlotTable(pinPath, familyIPv4, true); err != nil {
+		log.Printf("⚠️  Outbound rules disabled: %v", err)
+	} else {
+		manager.egress = egress
+		if egress6, err := openRuleSlotTable(pinPath, familyIPv6, true); err != nil {
+			log.Printf("⚠️  Outbound IPv6 rules disabled: %v", err)
+		} else {
+			manager.egress6 = egress6
+		}
+	}
+
+	statsPath := filepath.Join(pinPath, StatsMapName)
+	if statsMap, err := ebpf.LoadPinnedMap(statsPath, nil); err != nil {
+		log.Printf("⚠️  Stats map not available at %s: %v", statsPath, err)
+	} else {
+		manager.statsMap = statsMap
+	}
+
+	conntrackPath := filepath.Join(pinPath, ConntrackMapName)
+	if conntrack, err := ebpf.LoadPinnedMap(conntrackPath, nil); err != nil {
+		log.Printf("⚠️  Connection tracking not available at %s: %v", conntrackPath, err)
+	} else {
+		manager.conntrack = conntrack
+	}
+
+	sampleRatePath := filepath.Join(pinPath, SampleRateMapName)
+	samplesPath := filepath.Join(pinPath, SamplesMapName)
+	if sampleRate, err := ebpf.LoadPinnedMap(sampleRatePath, nil); err != nil {
+		log.Printf("⚠️  Packet sampling not available at %s: %v", sampleRatePath, err)
+	} else if samples, err := ebpf.LoadPinnedMap(samplesPath, nil); err != nil {
+		log.Printf("⚠️  Packet sampling not available at %s: %v", samplesPath, err)
+		sampleRate.Close()
+	} else {
+		manager.sampleRate, manager.samples = sampleRate, samples
+	}
+	rejectsPath := filepath.Join(pinPath, RejectsMapName)
+	if rejects, err := ebpf.LoadPinnedMap(rejectsPath, nil); err != nil {
+		log.Printf("⚠️  Reject replies not available at %s: %v", rejectsPath, err)
+	} else {
+		manager.rejects = rejects
+	}
+	mirrorsPath := filepath.Join(pinPath, MirrorStatsMapName)
+	if mirrors, err := ebpf.LoadPinnedMap(mirrorsPath, nil); err != nil {
+		log.Printf("⚠️  Mirror counters not available at %s: %v", mirrorsPath, err)
+	} else {
+		manager.mirrors = mirrors
+	}
+	logRatePath := filepath.Join(pinPath, LogRateMapName)
+	logEventsPath := filepath.Join(pinPath, LogEventsMapName)
+	if logRate, err := ebpf.LoadPinnedMap(logRatePath, nil); err != nil {
+		log.Printf("⚠️  Rule logging not available at %s: %v", logRatePath, err)
+	} else if logEvents, err := ebpf.LoadPinnedMap(logEventsPath, nil); err != nil {
+		log.Printf("⚠️  Rule logging not available at %s: %v", logEventsPath, err)
+		logRate.Close()
+	} else {
+		manager.logRate, manager.logEvents = logRate, logEvents
+	}
+
+	manager.openGeneration()
+	manager.openPolicyVersion()
+	manager.openRuleInterfaces()
+	manager.openGeoIP()
+	manager.openASNs()
+	manager.openDegradation()
+	manager.openPipeline()
+	manager.openDispositions()
+	manager.openProtocolStats()
+	manager.openBloom()
+	manager.openIPSets()
+	manager.openDefaultPolicy()
+	manager.openProtection()
+	manager.openTunnels()
+	manager.openMulticast()
+	manager.openNAT64()
+	manager.openNAT()
+	manager.openDNSFilter()
+	manager.openTLSFilter()
+	manager.openSYNFlood()
+	manager.openSourceLimits()
+	manager.openPortScan()
+	manager.openNormalization()
+	manager.openInterfaceConfigs()
+
+	log.Printf("BPF Map Manager using maps pinned in %s (%d rule slots)", pinPath, rules.rulesMap.MaxEntries())
 	return manager, nil
 }
 
-// GetStats retrieves current packet statistics from eBPF
+// GetStats retrieves current packet statistics from eBPF, as totals
+// continued across restarts when counter snapshots are attached
 func (bm *BPFMapManager) GetStats() (*FirewallStats, error) {
 	if bm.simulated {
 		// Return realistic simulated stats
@@ -65,11 +369,41 @@ func (bm *BPFMapManager) GetStats() (*FirewallStats, error) {
 		}, nil
 	}
 	
-	// Real implementation would go here
-	return &FirewallStats{}, fmt.Errorf("real BPF maps not available")
+	if bm.counters != nil {
+		return bm.counters.read(bm.readStats)
+	}
+	return bm.readStats()
+}
+
+// readStats reads the data plane's own counters, which restart from zero
+// when it is reloaded
+func (bm *BPFMapManager) readStats() (*FirewallStats, error) {
+	if bm.statsMap == nil {
+		return &FirewallStats{}, fmt.Errorf("stats map not available")
+	}
+
+	// Per-CPU counters are summed across CPUs
+	counters := make([]uint64, 4)
+	for key := uint32(StatPass); key <= StatError; key++ {
+		var perCPU []uint64
+		if err := bm.statsMap.Lookup(&key, &perCPU); err != nil {
+			return &FirewallStats{}, fmt.Errorf("failed to read stats key %d: %v", key, err)
+		}
+		for _, value := range perCPU {
+			counters[key] += value
+		}
+	}
+
+	return &FirewallStats{
+		Pass:     counters[StatPass],
+		Drop:     counters[StatDrop],
+		Redirect: counters[StatRedirect],
+		Error:    counters[StatError],
+	}, nil
 }
 
-// AddRuleToMap adds a firewall rule to the BPF map
+// AddRuleToMap adds a firewall rule to the BPF maps of the hooks enforcing
+// its direction, removing it from the others
 func (bm *BPFMapManager) AddRuleToMap(rule *FirewallRule) error {
 	if bm.simulated {
 		log.Printf("✅ [SIMULATED] Adding rule to BPF map: %s %s->%s %s", 
@@ -77,27 +411,297 @@ func (bm *BPFMapManager) AddRuleToMap(rule *FirewallRule) error {
 		return nil
 	}
 	
-	// Real BPF map update would go here
-	log.Printf("Adding rule to BPF map: %s", rule.ID)
+	ingress, egress := ruleHooks(rule)
+	if ingress {
+		bm.bloomBeforePut(rule)
+	}
+	if err := bm.putIngress(rule, ingress); err != nil {
+		bm.bloomSuspend(err)
+		return err
+	}
+	if ingress {
+		bm.bloomAfterPut(rule.ID, rule)
+	} else {
+		bm.bloomAfterPut(rule.ID, nil)
+	}
+	if bm.egress != nil {
+		if err := putRuleFamilies(bm.egress, bm.egress6, rule, egress); err != nil {
+			return fmt.Errorf("egress: %w", err)
+		}
+	} else if egress {
+		return fmt.Errorf("egress rules map not available for rule %s", rule.ID)
+	}
+
+	log.Printf("Added rule to BPF map: %s (%s, %s)", rule.ID, familyName(ruleFamily(rule)), hookNames(ingress, egress))
 	return nil
 }
 
-// DeleteRuleFromMap removes a firewall rule from the BPF map
+// putRuleFamilies writes a rule to the family tables of one hook, or
+// removes it from them when the hook does not enforce it. Entries without
+// addresses apply to both families.
+func putRuleFamilies(rules, rules6 *ruleSlotTable, rule *FirewallRule, enforced bool) error {
+	family := ruleFamily(rule)
+	if !enforced || family == familyIPv6 {
+		if err := rules.remove(rule.ID); err != nil {
+			return err
+		}
+	} else if err := rules.put(rule); err != nil {
+		return err
+	}
+
+	switch {
+	case rules6 != nil && (!enforced || family == familyIPv4):
+		return rules6.remove(rule.ID)
+	case rules6 != nil:
+		return rules6.put(rule)
+	case family == familyIPv6:
+		return fmt.Errorf("IPv6 rules map not available for rule %s", rule.ID)
+	}
+	return nil
+}
+
+// DeleteRuleFromMap removes a firewall rule from the BPF maps
 func (bm *BPFMapManager) DeleteRuleFromMap(ruleID string) error {
 	if bm.simulated {
 		log.Printf("✅ [SIMULATED] Deleting rule from BPF map: %s", ruleID)
 		return nil
 	}
 	
-	// Real BPF map deletion would go here
-	log.Printf("Deleting rule from BPF map: %s", ruleID)
+	tables := append([]*ruleSlotTable{bm.rules, bm.rules6, bm.egress, bm.egress6}, bm.shardTables()...)
+	for _, table := range tables {
+		if table == nil {
+			continue
+		}
+		if err := table.remove(ruleID); err != nil {
+			return err
+		}
+	}
+	if bm.shards != nil {
+		delete(bm.shards.placed, ruleID)
+	}
+	bm.bloomAfterPut(ruleID, nil)
+
+	log.Printf("Deleted rule from BPF map: %s", ruleID)
+	return nil
+}
+
+// hookNames describes the hooks enforcing a rule for logs
+func hookNames(ingress, egress bool) string {
+	switch {
+	case ingress && egress:
+		return "ingress+egress"
+	case egress:
+		return "egress"
+	default:
+		return "ingress"
+	}
+}
+
+// ruleMapNames returns the rule map and prefix trie names of a family at
+// the ingress or egress hook
+func ruleMapNames(family int, egress bool) []string {
+	switch {
+	case egress && family == familyIPv6:
+		return []string{Egress6RulesMapName, Egress6SrcTrieMapName, Egress6DstTrieMapName}
+	case egress:
+		return []string{EgressRulesMapName, EgressSrcTrieMapName, EgressDstTrieMapName}
+	case family == familyIPv6:
+		return []string{Rules6MapName, Src6TrieMapName, Dst6TrieMapName}
+	default:
+		return []string{RulesMapName, SrcTrieMapName, DstTrieMapName}
+	}
+}
+
+// openRuleSlotTable opens the pinned rule map and prefix tries of a family
+// and clears them so entries left by a previous control plane run do not
+// linger; the policy is re-pushed on startup
+func openRuleSlotTable(pinPath string, family int, egress bool) (*ruleSlotTable, error) {
+	names := ruleMapNames(family, egress)
+
+	var maps []*ebpf.Map
+	closeAll := func() {
+		for _, m := range maps {
+			m.Close()
+		}
+	}
+	for _, name := range names {
+		path := filepath.Join(pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		maps = append(maps, m)
+	}
+
+	table := &ruleSlotTable{
+		family:      family,
+		rulesMap:    maps[0],
+		srcTrie:     maps[1],
+		dstTrie:     maps[2],
+		slots:       make(map[string]uint32),
+		srcPrefixes: make(map[uint32]netip.Prefix),
+		dstPrefixes: make(map[uint32]netip.Prefix),
+	}
+	if err := table.checkLayout(); err != nil {
+		closeAll()
+		return nil, err
+	}
+
+	empty := make([]byte, table.rulesMap.ValueSize())
+	for slot := table.rulesMap.MaxEntries(); slot > 0; slot-- {
+		key := slot - 1
+		if err := table.rulesMap.Put(&key, empty); err != nil {
+			closeAll()
+			return nil, fmt.Errorf("%s: failed to clear slot %d: %v", names[0], key, err)
+		}
+		table.free = append(table.free, key)
+	}
+	if err := table.syncTries(); err != nil {
+		closeAll()
+		return nil, err
+	}
+	table.openHits(pinPath, egress)
+	return table, nil
+}
+
+// checkLayout verifies the pinned maps were created by a program sharing
+// the control plane's encoding
+func (t *ruleSlotTable) checkLayout() error {
+	if size := binary.Size(BPFFirewallRule{}); t.rulesMap.ValueSize() != uint32(size) {
+		return fmt.Errorf("rule map value size %d does not match the control plane (%d bytes)",
+			t.rulesMap.ValueSize(), size)
+	}
+	if t.rulesMap.MaxEntries() != MaxRules {
+		return fmt.Errorf("rule map has %d slots, the control plane expects %d", t.rulesMap.MaxEntries(), MaxRules)
+	}
+	keySize := uint32(len(lpmKey(rulePrefix("", t.family))))
+	for _, trie := range []*ebpf.Map{t.srcTrie, t.dstTrie} {
+		if trie.Type() != ebpf.LPMTrie || trie.KeySize() != keySize || trie.ValueSize() != uint32(binary.Size(ruleSet{})) {
+			return fmt.Errorf("prefix trie %s does not match the control plane layout", trie)
+		}
+	}
 	return nil
 }
 
+// put writes an encoded entry and its prefixes. Entries being updated keep
+// their slot unless a priority change puts it out of order; a moved entry
+// is written to its new slot and the tries switch over before the old slot
+// is cleared.
+func (t *ruleSlotTable) put(rule *FirewallRule) error {
+	after, before, err := t.tieBounds(rule)
+	if err != nil {
+		return err
+	}
+	slot, exists := t.slots[rule.ID]
+	value := encodeRule(rule, t.family)
+
+	inOrder := exists && int(slot) > after && int(slot) < before
+	index, ordered := 0, inOrder
+	if !inOrder {
+		index, ordered = t.freeSlot(after, before)
+	}
+	if !ordered && !exists {
+		var found bool
+		if index, found = t.freeSlot(-1, int(t.rulesMap.MaxEntries())); !found {
+			return withCode(ErrCodeRulesMapFull, fmt.Errorf("rules map is full (%d entries)", t.rulesMap.MaxEntries()))
+		}
+	}
+	if !ordered {
+		log.Printf("⚠️  No free slot keeps %s in order among rules of priority %d; replace the rule set to restore it", rule.ID, rule.Priority)
+	}
+
+	if exists && (inOrder || !ordered) {
+		if err := t.rulesMap.Put(&slot, value); err != nil {
+			return fmt.Errorf("failed to write rule %s to slot %d: %v", rule.ID, slot, err)
+		}
+		t.srcPrefixes[slot] = rulePrefix(rule.SrcIP, t.family)
+		t.dstPrefixes[slot] = rulePrefix(rule.DstIP, t.family)
+		return t.syncTries()
+	}
+
+	newSlot := t.free[index]
+	if err := t.resetHits(newSlot); err != nil {
+		return err
+	}
+	if err := t.rulesMap.Put(&newSlot, value); err != nil {
+		return fmt.Errorf("failed to write rule %s to slot %d: %v", rule.ID, newSlot, err)
+	}
+	t.free = append(t.free[:index], t.free[index+1:]...)
+	t.slots[rule.ID] = newSlot
+	t.srcPrefixes[newSlot] = rulePrefix(rule.SrcIP, t.family)
+	t.dstPrefixes[newSlot] = rulePrefix(rule.DstIP, t.family)
+	if !exists {
+		return t.syncTries()
+	}
+
+	if err := t.carryHits(rule.ID, slot); err != nil {
+		log.Printf("⚠️  %v", err)
+	}
+	delete(t.srcPrefixes, slot)
+	delete(t.dstPrefixes, slot)
+	if err := t.syncTries(); err != nil {
+		return err
+	}
+	empty := make([]byte, t.rulesMap.ValueSize())
+	if err := t.rulesMap.Put(&slot, empty); err != nil {
+		return fmt.Errorf("failed to clear slot %d of rule %s: %v", slot, rule.ID, err)
+	}
+	t.free = append(t.free, slot)
+	return nil
+}
+
+// remove drops an entry from the tries, then zeroes its slot; array map
+// entries cannot be deleted and a zeroed slot never matches
+func (t *ruleSlotTable) remove(id string) error {
+	slot, exists := t.slots[id]
+	if !exists {
+		return nil
+	}
+
+	delete(t.srcPrefixes, slot)
+	delete(t.dstPrefixes, slot)
+	if err := t.syncTries(); err != nil {
+		return err
+	}
+
+	empty := make([]byte, t.rulesMap.ValueSize())
+	if err := t.rulesMap.Put(&slot, empty); err != nil {
+		return fmt.Errorf("failed to clear slot %d of rule %s: %v", slot, id, err)
+	}
+	delete(t.slots, id)
+	delete(t.carried, id)
+	t.free = append(t.free, slot)
+	return nil
+}
+
+// syncTries rewrites both prefix tries from the occupied slots
+func (t *ruleSlotTable) syncTries() error {
+	if err := syncPrefixTrie(t.srcTrie, buildPrefixTrie(t.srcPrefixes, t.family)); err != nil {
+		return fmt.Errorf("source trie: %v", err)
+	}
+	if err := syncPrefixTrie(t.dstTrie, buildPrefixTrie(t.dstPrefixes, t.family)); err != nil {
+		return fmt.Errorf("destination trie: %v", err)
+	}
+	return nil
+}
+
+func (t *ruleSlotTable) close() {
+	t.rulesMap.Close()
+	t.srcTrie.Close()
+	t.dstTrie.Close()
+	if t.hits != nil {
+		t.hits.Close()
+	}
+}
+
 // LoadXDPProgram loads the XDP program and pins maps
 func (bm *BPFMapManager) LoadXDPProgram(interfaceName string) error {
 	// Get the XDP object file path
 	xdpObjectPath := filepath.Join("..", "ebpf", "xdp_filter.o")
+
+	// Verify the offload mode once the attach has settled
+	defer bm.verifyOffload(interfaceName)
 	
 	// Check if object exists
 	if _, err := os.Stat(xdpObjectPath); os.IsNotExist(err) {
@@ -114,28 +718,171 @@ func (bm *BPFMapManager) LoadXDPProgram(interfaceName string) error {
 	if bm.simulated {
 		log.Printf("✅ [SIMULATED] XDP program loaded successfully")
 		log.Printf("📌 [SIMULATED] Maps pinned to /sys/fs/bpf/cerberus_*")
+		bm.setAttachMode(interfaceName, XDPModeSimulated)
+		bm.publishAttachEvent(EventDataPlaneAttached, interfaceName)
 		return nil
 	}
-	
-	// Real XDP loading would use libbpf here
+
+	// The program shares this manager's pinned maps
+	loader, err := NewBPFManager()
+	if err != nil {
+		return err
+	}
+	loader.pinPath = bm.pinPath
+	loader.xdpMode = bm.xdpMode
+	if err := loader.LoadXDPProgram(xdpObjectPath); err != nil {
+		loader.Close()
+		return err
+	}
+	bm.installPipeline(loader.stages, xdpObjectPath)
+	if err := loader.AttachXDP(interfaceName); err != nil {
+		loader.Close()
+		return err
+	}
+	if bm.loaders == nil {
+		bm.loaders = make(map[string]*BPFManager)
+	}
+	bm.loaders[interfaceName] = loader
+	bm.setAttachMode(interfaceName, loader.attachMode)
+	bm.publishAttachEvent(EventDataPlaneAttached, interfaceName)
 	return nil
 }
 
+// setAttachMode records the mode an interface was attached in
+func (bm *BPFMapManager) setAttachMode(interfaceName, mode string) {
+	if bm.attachModes == nil {
+		bm.attachModes = make(map[string]string)
+	}
+	bm.attachModes[interfaceName] = mode
+}
+
 // UnloadXDPProgram unloads the XDP program
 func (bm *BPFMapManager) UnloadXDPProgram(interfaceName string) error {
 	log.Printf("📤 Unloading XDP program from interface: %s", interfaceName)
+	delete(bm.offloads, interfaceName)
+	delete(bm.attachModes, interfaceName)
+	if loader, exists := bm.loaders[interfaceName]; exists {
+		delete(bm.loaders, interfaceName)
+		loader.Close()
+	}
 	
 	if bm.simulated {
 		log.Printf("✅ [SIMULATED] XDP program unloaded successfully")
+		bm.publishAttachEvent(EventDataPlaneDetached, interfaceName)
 		return nil
 	}
 	
+	bm.publishAttachEvent(EventDataPlaneDetached, interfaceName)
 	return nil
 }
 
+// publishAttachEvent reports an XDP attach or detach on an interface
+func (bm *BPFMapManager) publishAttachEvent(eventType, interfaceName string) {
+	event := &pb.Event{
+		Type:      eventType,
+		Interface: interfaceName,
+		Message:   fmt.Sprintf("XDP program attached to %s in %s mode", interfaceName, bm.attachModes[interfaceName]),
+		Severity:  "low",
+	}
+	if eventType == EventDataPlaneDetached {
+		event.Message = fmt.Sprintf("XDP program detached from %s", interfaceName)
+		event.Severity = "high"
+	}
+	bm.events.Publish(event)
+}
+
 // Close closes all open file descriptors
 func (bm *BPFMapManager) Close() error {
 	log.Printf("🔒 Closing BPF Map Manager")
+	if bm.rings != nil {
+		bm.rings.Close()
+	}
+	if bm.rules != nil {
+		bm.rules.close()
+	}
+	if bm.rules6 != nil {
+		bm.rules6.close()
+	}
+	if bm.egress != nil {
+		bm.egress.close()
+	}
+	if bm.egress6 != nil {
+		bm.egress6.close()
+	}
+	if bm.shards != nil {
+		bm.shards.close()
+	}
+	if bm.bloom != nil {
+		bm.bloom.close()
+	}
+	if bm.ipSets != nil {
+		bm.ipSets.close()
+	}
+	if bm.defaultPolicy != nil {
+		bm.defaultPolicy.Close()
+	}
+	if bm.protection != nil {
+		bm.protection.Close()
+		bm.protectionState.Close()
+	}
+	if bm.tunnels != nil {
+		bm.tunnels.Close()
+		bm.tunnelStats.Close()
+	}
+	if bm.mcastStats != nil {
+		bm.mcastStats.Close()
+		bm.mcastReports.Close()
+	}
+	bm.closeNAT64()
+	bm.closeNAT()
+	bm.closeDNSFilter()
+	bm.closeTLSFilter()
+	bm.closeSYNFlood()
+	bm.closeSourceLimits()
+	bm.closePortScan()
+	bm.closeNormalization()
+	bm.closeInterfaceConfigs()
+	if bm.statsMap != nil {
+		bm.statsMap.Close()
+	}
+	if bm.conntrack != nil {
+		bm.conntrack.Close()
+	}
+	if bm.generation != nil {
+		bm.generation.Close()
+	}
+	if bm.policyVersion != nil {
+		bm.policyVersion.Close()
+	}
+	if bm.ruleIfaces != nil {
+		bm.ruleIfaces.Close()
+	}
+	if bm.geoIP != nil {
+		bm.geoIP.close()
+	}
+	if bm.asns != nil {
+		bm.asns.close()
+	}
+	if bm.degrade != nil {
+		bm.degrade.Close()
+	}
+	bm.closePipeline()
+	bm.closeDispositions()
+	bm.closeProtocolStats()
+	if bm.samples != nil {
+		bm.sampleRate.Close()
+		bm.samples.Close()
+	}
+	if bm.rejects != nil {
+		bm.rejects.Close()
+	}
+	if bm.mirrors != nil {
+		bm.mirrors.Close()
+	}
+	if bm.logEvents != nil {
+		bm.logRate.Close()
+		bm.logEvents.Close()
+	}
 	return nil
 }
 
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// cerberusctl: the administration commands of the control plane binary,
+// run when it is invoked as cerberusctl (a symlink to cerberus-ctrl)
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// CtlName is the program name that selects the administration commands
+const CtlName = "cerberusctl"
+
+// ctlCommands are the cerberusctl subcommands by name
+var ctlCommands = map[string]struct {
+	summary string
+	run     func(args []string, stdin io.Reader, stdout io.Writer) error
+}{
+	"init":   {"interrogate the host and write a configuration and starter policy", runInit},
+	"replay": {"replay archived events through automatic blocking with other thresholds", runReplay},
+}
+
+// runCtl runs a cerberusctl subcommand and returns the exit status
+func runCtl(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		ctlUsage(stderr)
+		return 2
+	}
+	command, ok := ctlCommands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "%s: unknown command %q\n", CtlName, args[0])
+		ctlUsage(stderr)
+		return 2
+	}
+	if err := command.run(args[1:], stdin, stdout); err != nil {
+		fmt.Fprintf(stderr, "%s %s: %v\n", CtlName, args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func ctlUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags]\n\nCommands:\n", CtlName)
+	names := make([]string, 0, len(ctlCommands))
+	for name := range ctlCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-8s %s\n", name, ctlCommands[name].summary)
+	}
+	fmt.Fprintf(w, "\nRun %s <command> -h for the flags of a command.\n", CtlName)
+}
+
+// isCtl reports whether the binary was invoked as cerberusctl
+func isCtl() bool {
+	return filepath.Base(os.Args[0]) == CtlName
+}
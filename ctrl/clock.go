@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Clock and ID generator: injectable so timestamps, expiry and schedules can be driven by tests
+
+package main
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Clock tells the current time. Subsystems ask their clock instead of
+// calling time.Now so that tests can set the time.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers the clock's time every period, like time.Ticker
+type Ticker interface {
+	Chan() <-chan time.Time
+	Stop()
+}
+
+// systemClock is the wall clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// systemTicker is a time.Ticker
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) Chan() <-chan time.Time { return t.C }
+
+// IDGenerator names new objects, e.g. "rule_<n>"
+type IDGenerator interface {
+	NewID(prefix string) string
+}
+
+// clockIDGenerator derives IDs from the clock in nanoseconds, bumped past
+// the last ID when a batch creates objects faster than the clock advances
+type clockIDGenerator struct {
+	clock Clock
+	last  atomic.Int64
+}
+
+func newClockIDGenerator(clock Clock) *clockIDGenerator {
+	return &clockIDGenerator{clock: clock}
+}
+
+func (g *clockIDGenerator) NewID(prefix string) string {
+	now := g.clock.Now().UnixNano()
+	for {
+		last := g.last.Load()
+		id := max(now, last+1)
+		if g.last.CompareAndSwap(last, id) {
+			return fmt.Sprintf("%s_%d", prefix, id)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock that only moves when told to
+type fakeClock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Advance moves the clock by d and fires the tickers due by then, once
+// each like time.Ticker drops ticks for slow receivers
+func (c *fakeClock) Advance(d time.Duration) time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+	for _, ticker := range c.tickers {
+		if ticker.stopped || c.now.Before(ticker.next) {
+			continue
+		}
+		for !c.now.Before(ticker.next) {
+			ticker.next = ticker.next.Add(ticker.period)
+		}
+		select {
+		case ticker.c <- c.now:
+		default:
+		}
+	}
+	return c.now
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) Ticker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	ticker := &fakeTicker{clock: c, c: make(chan time.Time, 1), period: d, next: c.now.Add(d)}
+	c.tickers = append(c.tickers, ticker)
+	return ticker
+}
+
+// fakeTicker ticks when its fakeClock is advanced past the next tick
+type fakeTicker struct {
+	clock   *fakeClock
+	c       chan time.Time
+	period  time.Duration
+	next    time.Time
+	stopped bool
+}
+
+func (t *fakeTicker) Chan() <-chan time.Time { return t.c }
+
+func (t *fakeTicker) Stop() {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	t.stopped = true
+}
+
+// sequentialIDs is an IDGenerator numbering IDs from 1 per prefix
+type sequentialIDs struct {
+	mutex sync.Mutex
+	next  map[string]int
+}
+
+func (g *sequentialIDs) NewID(prefix string) string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.next == nil {
+		g.next = make(map[string]int)
+	}
+	g.next[prefix]++
+	return fmt.Sprintf("%s_%d", prefix, g.next[prefix])
+}
+
+// newTestServer returns a simulated server on a fake clock starting at now
+func newTestServer(now time.Time) (*Server, *fakeClock) {
+	clock := newFakeClock(now)
+	s := NewServer(&BPFMapManager{simulated: true, clock: clock})
+	s.clock = clock
+	s.events.clock = clock
+	s.ids = &sequentialIDs{}
+	return s, clock
+}
+
+func TestClockIDGeneratorStaysUniqueOnStoppedClock(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ids := newClockIDGenerator(newFakeClock(start))
+
+	first := ids.NewID("rule")
+	if want := fmt.Sprintf("rule_%d", start.UnixNano()); first != want {
+		t.Fatalf("first ID = %s, want %s", first, want)
+	}
+	seen := map[string]bool{first: true}
+	for i := 0; i < 100; i++ {
+		id := ids.NewID("rule")
+		if seen[id] {
+			t.Fatalf("ID %s generated twice", id)
+		}
+		seen[id] = true
+	}
+}
@@ -0,0 +1,473 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// releasedSchemas are the API schemas of released clients, frozen in
+// testdata/compat as descriptor sets of firewall.proto at the release tag:
+//
+//	protoc -I proto -o ctrl/testdata/compat/firewall-vX.Y.Z.binpb firewall.proto
+//
+// Clients built against any of them must keep working with this server,
+// and this release's client with servers of those releases.
+var releasedSchemas = []string{"firewall-v1.0.0.binpb"}
+
+// unimplementedRPCs are released RPCs this server has never implemented;
+// clients of every release get Unimplemented from them
+var unimplementedRPCs = []string{
+	"GetRule", "GetInterfaceStats", "GetSystemInfo", "RestartDataPlane", "BackupConfig", "RestoreConfig",
+}
+
+// loadReleasedSchema reads a frozen schema
+func loadReleasedSchema(t *testing.T, name string) protoreflect.FileDescriptor {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "compat", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	file, err := protodesc.NewFile(set.File[0], nil)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return file
+}
+
+// schemaBreaks lists the changes from a released schema to the current
+// one that break clients or servers still speaking the released one.
+// Fields and RPCs may be added; none may be removed, renumbered, renamed
+// or change type. A request or response type may be replaced by another
+// carrying the same fields.
+func schemaBreaks(released, current protoreflect.FileDescriptor) []string {
+	var breaks []string
+	seen := make(map[[2]protoreflect.FullName]bool)
+	var compareMessage func(before, after protoreflect.MessageDescriptor)
+	compareMessage = func(before, after protoreflect.MessageDescriptor) {
+		pair := [2]protoreflect.FullName{before.FullName(), after.FullName()}
+		if seen[pair] {
+			return
+		}
+		seen[pair] = true
+		for i := 0; i < before.Fields().Len(); i++ {
+			old := before.Fields().Get(i)
+			field := after.Fields().ByNumber(old.Number())
+			where := fmt.Sprintf("%s.%s (%d)", before.Name(), old.Name(), old.Number())
+			switch {
+			case field == nil:
+				breaks = append(breaks, where+" removed")
+			case field.Name() != old.Name():
+				breaks = append(breaks, fmt.Sprintf("%s renamed to %s", where, field.Name()))
+			case field.Kind() != old.Kind() || field.Cardinality() != old.Cardinality() || field.IsMap() != old.IsMap():
+				breaks = append(breaks, fmt.Sprintf("%s changed from %s %s to %s %s", where, old.Cardinality(), old.Kind(), field.Cardinality(), field.Kind()))
+			case old.Message() != nil:
+				compareMessage(old.Message(), field.Message())
+			case old.Enum() != nil:
+				for j := 0; j < old.Enum().Values().Len(); j++ {
+					value := old.Enum().Values().Get(j)
+					if field.Enum().Values().ByNumber(value.Number()) == nil {
+						breaks = append(breaks, fmt.Sprintf("%s value %s removed", where, value.Name()))
+					}
+				}
+			}
+		}
+	}
+
+	for i := 0; i < released.Services().Len(); i++ {
+		service := released.Services().Get(i)
+		now := current.Services().ByName(service.Name())
+		if now == nil {
+			breaks = append(breaks, fmt.Sprintf("service %s removed", service.FullName()))
+			continue
+		}
+		for j := 0; j < service.Methods().Len(); j++ {
+			method := service.Methods().Get(j)
+			rpc := now.Methods().ByName(method.Name())
+			path := fmt.Sprintf("%s.%s", service.Name(), method.Name())
+			if rpc == nil {
+				breaks = append(breaks, "rpc "+path+" removed")
+				continue
+			}
+			if rpc.IsStreamingClient() != method.IsStreamingClient() || rpc.IsStreamingServer() != method.IsStreamingServer() {
+				breaks = append(breaks, "rpc "+path+" changed streaming")
+			}
+			compareMessage(method.Input(), rpc.Input())
+			compareMessage(method.Output(), rpc.Output())
+		}
+	}
+	for i := 0; i < released.Messages().Len(); i++ {
+		message := released.Messages().Get(i)
+		now := current.Messages().ByName(message.Name())
+		if now == nil {
+			breaks = append(breaks, fmt.Sprintf("message %s removed", message.FullName()))
+			continue
+		}
+		compareMessage(message, now)
+	}
+	return breaks
+}
+
+func TestSchemaKeepsReleasedAPI(t *testing.T) {
+	for _, name := range releasedSchemas {
+		released := loadReleasedSchema(t, name)
+		if released.Package() != pb.File_firewall_proto.Package() {
+			t.Fatalf("%s: package %s, current %s", name, released.Package(), pb.File_firewall_proto.Package())
+		}
+		for _, problem := range schemaBreaks(released, pb.File_firewall_proto) {
+			t.Errorf("%s: %s", name, problem)
+		}
+	}
+}
+
+func TestSchemaBreaksDetected(t *testing.T) {
+	released := loadReleasedSchema(t, releasedSchemas[0])
+	changed := protodesc.ToFileDescriptorProto(released)
+	for _, message := range changed.MessageType {
+		if message.GetName() != "Rule" {
+			continue
+		}
+		for _, field := range message.Field {
+			switch field.GetName() {
+			case "src_port":
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+			case "description":
+				field.Name = proto.String("comment")
+				field.JsonName = proto.String("comment")
+			}
+		}
+	}
+	changed.Service[0].Method = changed.Service[0].Method[1:]
+	current, err := protodesc.NewFile(changed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	breaks := strings.Join(schemaBreaks(released, current), "\n")
+	for _, want := range []string{
+		"rpc FirewallControl.AddRule removed",
+		"Rule.src_port (5) changed from optional int32 to optional string",
+		"Rule.description (11) renamed to comment",
+	} {
+		if !strings.Contains(breaks, want) {
+			t.Errorf("break %q not reported in:\n%s", want, breaks)
+		}
+	}
+}
+
+// compatConn serves srv over an in-memory listener and dials it
+func compatConn(t *testing.T, register func(*grpc.Server), options ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(options...)
+	register(srv)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///compat",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// releasedClient calls the server the way a client built against a
+// released schema does: messages of that schema, filled from JSON
+type releasedClient struct {
+	t       *testing.T
+	conn    *grpc.ClientConn
+	service protoreflect.ServiceDescriptor
+}
+
+func (c *releasedClient) method(name string) (protoreflect.MethodDescriptor, string) {
+	c.t.Helper()
+	method := c.service.Methods().ByName(protoreflect.Name(name))
+	if method == nil {
+		c.t.Fatalf("released schema has no rpc %s", name)
+	}
+	return method, fmt.Sprintf("/%s/%s", c.service.FullName(), name)
+}
+
+// call invokes a unary RPC with a request given as JSON and returns the
+// response as JSON of the released schema
+func (c *releasedClient) call(name, request string) map[string]any {
+	c.t.Helper()
+	method, path := c.method(name)
+	req := dynamicpb.NewMessage(method.Input())
+	if err := protojson.Unmarshal([]byte(request), req); err != nil {
+		c.t.Fatalf("%s request: %v", name, err)
+	}
+	resp := dynamicpb.NewMessage(method.Output())
+	if err := c.conn.Invoke(context.Background(), path, req, resp); err != nil {
+		c.t.Fatalf("%s: %v", name, err)
+	}
+	return releasedJSON(c.t, resp)
+}
+
+// releasedJSON converts a message to generic JSON; fields the released
+// schema does not know are dropped as they are by a released client
+func releasedJSON(t *testing.T, message proto.Message) map[string]any {
+	t.Helper()
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestReleasedClientAgainstCurrentServer(t *testing.T) {
+	s, _ := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	conn := compatConn(t, func(srv *grpc.Server) { pb.RegisterFirewallControlServer(srv, s) })
+
+	for _, name := range releasedSchemas {
+		released := loadReleasedSchema(t, name)
+		client := &releasedClient{t: t, conn: conn, service: released.Services().ByName("FirewallControl")}
+
+		added := client.call("AddRule", `{"rule": {"action": "drop", "src_ip": "203.0.113.0/24", "dst_port": 22,
+			"protocol": "tcp", "direction": "inbound", "priority": 10, "enabled": true, "description": "compat"}}`)
+		if added["success"] != true {
+			t.Fatalf("%s: AddRule = %v", name, added)
+		}
+		id := added["rule_id"].(string)
+
+		listed := client.call("GetRules", `{}`)
+		rules, _ := listed["rules"].([]any)
+		if len(rules) != 1 || listed["count"] != float64(1) {
+			t.Fatalf("%s: GetRules = %v, want the added rule", name, listed)
+		}
+		rule, _ := rules[0].(map[string]any)
+		for field, want := range map[string]any{
+			"id": id, "action": "drop", "src_ip": "203.0.113.0/24", "dst_port": float64(22),
+			"protocol": "tcp", "priority": float64(10), "enabled": true, "description": "compat",
+		} {
+			if rule[field] != want {
+				t.Errorf("%s: rule %s = %v, want %v", name, field, rule[field], want)
+			}
+		}
+
+		updated := client.call("UpdateRule", fmt.Sprintf(`{"rule_id": %q, "rule": {"action": "allow", "src_ip": "203.0.113.0/24",
+			"dst_port": 22, "protocol": "tcp", "enabled": true}}`, id))
+		if updated["success"] != true {
+			t.Errorf("%s: UpdateRule = %v", name, updated)
+		} else if rule, _ := updated["rule"].(map[string]any); rule["action"] != "allow" {
+			t.Errorf("%s: updated rule = %v", name, rule)
+		}
+
+		stats := client.call("GetStats", `{}`)
+		if stats["active_rules"] != float64(1) {
+			t.Errorf("%s: GetStats = %v, want 1 active rule", name, stats)
+		}
+		for _, rpc := range unimplementedRPCs {
+			method, path := client.method(rpc)
+			err := conn.Invoke(context.Background(), path, dynamicpb.NewMessage(method.Input()), dynamicpb.NewMessage(method.Output()))
+			if status.Code(err) != codes.Unimplemented {
+				t.Errorf("%s: %s error = %v, want Unimplemented", name, rpc, err)
+			}
+		}
+
+		deleted := client.call("DeleteRule", fmt.Sprintf(`{"rule_id": %q}`, id))
+		if deleted["success"] != true {
+			t.Errorf("%s: DeleteRule = %v", name, deleted)
+		}
+	}
+}
+
+func TestReleasedClientStreamsEvents(t *testing.T) {
+	s, _ := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	conn := compatConn(t, func(srv *grpc.Server) { pb.RegisterFirewallControlServer(srv, s) })
+	released := loadReleasedSchema(t, releasedSchemas[0])
+	client := &releasedClient{t: t, conn: conn, service: released.Services().ByName("FirewallControl")}
+
+	method, path := client.method("StreamEvents")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(dynamicpb.NewMessage(method.Input())); err != nil {
+		t.Fatal(err)
+	}
+	stream.CloseSend()
+	for s.events.SubscriberCount() == 0 {
+		if ctx.Err() != nil {
+			t.Fatal("stream never subscribed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	sample := PacketSample{
+		SampleRate:    4,
+		Bytes:         60,
+		Key:           ConntrackKey{SrcAddr: [16]byte{203, 0, 113, 7}, DstAddr: [16]byte{10, 0, 0, 1}, SrcPort: 40000, DstPort: 22, Protocol: 6, Family: 4},
+		Verdict:       1,
+		TCPFlags:      0x02,
+		PolicyVersion: 7,
+	}
+	s.events.Publish(sample.event(s.clock.Now(), 0))
+
+	event := dynamicpb.NewMessage(method.Output())
+	if err := stream.RecvMsg(event); err != nil {
+		t.Fatal(err)
+	}
+	decoded := releasedJSON(t, event)
+	metadata, _ := decoded["metadata"].(map[string]any)
+	if decoded["type"] != EventPacketSample || decoded["protocol"] != "tcp" || decoded["port"] != float64(22) {
+		t.Errorf("event = %v", decoded)
+	}
+	if metadata["verdict"] != "drop" || metadata["policy_version"] != "7" {
+		t.Errorf("event metadata = %v", metadata)
+	}
+}
+
+// releasedServer answers RPCs the way a server built against a released
+// schema does: requests are decoded in that schema and responses built
+// by respond, RPCs the schema lacks are unimplemented
+func releasedServer(service protoreflect.ServiceDescriptor, respond func(method string, req *dynamicpb.Message) proto.Message) grpc.ServerOption {
+	return grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		path, _ := grpc.MethodFromServerStream(stream)
+		serviceName, methodName, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+		method := service.Methods().ByName(protoreflect.Name(methodName))
+		if serviceName != string(service.FullName()) || method == nil {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", path)
+		}
+		req := dynamicpb.NewMessage(method.Input())
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		return stream.SendMsg(respond(methodName, req))
+	})
+}
+
+func TestCurrentClientAgainstReleasedServer(t *testing.T) {
+	for _, name := range releasedSchemas {
+		released := loadReleasedSchema(t, name)
+		service := released.Services().ByName("FirewallControl")
+		var received map[string]any
+		conn := compatConn(t, func(*grpc.Server) {}, releasedServer(service, func(method string, req *dynamicpb.Message) proto.Message {
+			resp := dynamicpb.NewMessage(service.Methods().ByName(protoreflect.Name(method)).Output())
+			switch method {
+			case "AddRule":
+				received = releasedJSON(t, req)
+				// Echo the rule as stored, unknown fields included
+				rule := req.Get(req.Descriptor().Fields().ByName("rule")).Message()
+				rule.Set(rule.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString("rule_1"))
+				resp.Set(resp.Descriptor().Fields().ByName("success"), protoreflect.ValueOfBool(true))
+				resp.Set(resp.Descriptor().Fields().ByName("rule_id"), protoreflect.ValueOfString("rule_1"))
+				resp.Set(resp.Descriptor().Fields().ByName("rule"), protoreflect.ValueOfMessage(rule))
+			case "GetStats":
+				protojson.Unmarshal([]byte(`{"total_packets": 100, "dropped_packets": 40, "active_rules": 1}`), resp)
+			}
+			return resp
+		}))
+		client := pb.NewFirewallControlClient(conn)
+		ctx := context.Background()
+
+		resp, err := client.AddRule(ctx, &pb.AddRuleRequest{Rule: &pb.Rule{
+			Action: "drop", SrcIp: "198.51.100.0/24", DstPort: 443, Protocol: "tcp", Enabled: true,
+			TcpFlags: "syn/syn,ack", Log: true,
+		}})
+		if err != nil {
+			t.Fatalf("%s: AddRule: %v", name, err)
+		}
+		rule, _ := received["rule"].(map[string]any)
+		if rule["action"] != "drop" || rule["src_ip"] != "198.51.100.0/24" || rule["dst_port"] != float64(443) || rule["enabled"] != true {
+			t.Errorf("%s: released server received %v", name, received)
+		}
+		if !resp.Success || resp.RuleId != "rule_1" || resp.Rule.GetSrcIp() != "198.51.100.0/24" {
+			t.Errorf("%s: AddRule = %v", name, resp)
+		}
+		if resp.Rule.GetTcpFlags() != "syn/syn,ack" || !resp.Rule.GetLog() {
+			t.Errorf("%s: fields the released server does not know were not passed through: %v", name, resp.Rule)
+		}
+
+		stats, err := client.GetStats(ctx, &pb.Empty{})
+		if err != nil {
+			t.Fatalf("%s: GetStats: %v", name, err)
+		}
+		if stats.TotalPackets != 100 || stats.DroppedPackets != 40 || stats.ActiveRules != 1 {
+			t.Errorf("%s: GetStats = %v", name, stats)
+		}
+
+		// Newer RPCs fail cleanly so clients can fall back
+		if _, err := client.ExplainVerdict(ctx, &pb.ExplainVerdictRequest{EventId: "evt_1"}); status.Code(err) != codes.Unimplemented {
+			t.Errorf("%s: ExplainVerdict error = %v, want Unimplemented", name, err)
+		}
+	}
+}
+
+func TestReleasedGeoIPCountryReadAsSourceCountry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rule, err := fromProtoRule(&pb.Rule{Action: "drop", GeoipCountry: "US, cn"}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(canonicalCountries(rule.SrcCountry), ","); got != "CN,US" {
+		t.Errorf("geoip_country US, cn read as source countries %s, want CN,US", got)
+	}
+
+	rule, err = fromProtoRule(&pb.Rule{Action: "drop", GeoipCountry: "US", SrcCountry: []string{"NL"}}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(rule.SrcCountry, ","); got != "NL" {
+		t.Errorf("source countries with both fields set = %s, want src_country NL", got)
+	}
+}
+
+func TestReleasedLogLevelReadAsLogFlag(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for level, want := range map[string]bool{"": false, "none": false, "info": true, "debug": true} {
+		rule, err := fromProtoRule(&pb.Rule{Action: "drop", LogLevel: level}, now)
+		if err != nil {
+			t.Fatalf("log_level %q: %v", level, err)
+		}
+		if rule.Log != want {
+			t.Errorf("log_level %q read as log %v, want %v", level, rule.Log, want)
+		}
+	}
+
+	_, err := fromProtoRule(&pb.Rule{Action: "drop", LogLevel: "verbose"}, now)
+	if errs := fieldErrors(err); len(errs) != 1 || errs[0].Field != "log_level" {
+		t.Errorf("log_level verbose: errors = %v, want one log_level error", errs)
+	}
+}
+
+func TestReleasedRateLimitRejected(t *testing.T) {
+	_, err := fromProtoRule(&pb.Rule{Action: "drop", RateLimit: 100}, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	if errs := fieldErrors(err); len(errs) != 1 || errs[0].Field != "rate_limit" {
+		t.Errorf("rate_limit 100: errors = %v, want one rate_limit error", errs)
+	}
+}
@@ -0,0 +1,450 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy compile pipeline: objects resolve → zone expansion → priority sort → encode
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+)
+
+// CompiledPolicy is one immutable version of the data plane rule set. The
+// output of every stage is kept so a version can be inspected for debugging.
+// Versions are never modified after publication; readers load the current
+// one without taking s.mutex.
+type CompiledPolicy struct {
+	Version    uint64          `json:"version"`
+	CompiledAt time.Time       `json:"compiled_at"`
+	Resolved   []*FirewallRule `json:"resolved"` // Rules with object references resolved
+	Expanded   []*FirewallRule `json:"expanded"` // Resolved rules plus zone policy rules
+	Sorted     []*FirewallRule `json:"sorted"`   // Expanded in evaluation order
+	Encoded    []EncodedRule   `json:"encoded"`  // Sorted in data plane encoding
+}
+
+// EncodedRule is the data plane encoding of one compiled entry: a rule map
+// value per family plus the prefixes it is entered under in the source and
+// destination tries. Entries without addresses are encoded for both
+// families under the /0 prefix.
+type EncodedRule struct {
+	ID        string           `json:"id"`
+	Namespace string           `json:"namespace,omitempty"` // Empty = host data plane
+	VF        string           `json:"vf,omitempty"`
+	Family    string           `json:"family"`
+	SrcPrefix string           `json:"src_prefix"`
+	DstPrefix string           `json:"dst_prefix"`
+	Rule      *BPFFirewallRule `json:"rule,omitempty"`  // cerberus_rules value
+	Rule6     *BPFFirewallRule `json:"rule6,omitempty"` // cerberus_rules6 value
+}
+
+// compilePolicy runs every stage over a policy snapshot
+func compilePolicy(snapshot *PolicySnapshot, version uint64, now time.Time) *CompiledPolicy {
+	services := make(map[string]*Service)
+	for _, service := range snapshot.Services {
+		services[service.Name] = service
+	}
+	addressObjects := make(map[string]*AddressObject)
+	for _, object := range snapshot.AddressObjects {
+		addressObjects[object.Name] = object
+	}
+	zones := make(map[string]*Zone)
+	for _, zone := range snapshot.Zones {
+		zones[zone.Name] = zone
+	}
+
+	compiled := &CompiledPolicy{Version: version, CompiledAt: now}
+	compiled.Resolved = resolveObjects(snapshot.Rules, services, addressObjects)
+	compiled.Expanded = expandZones(compiled.Resolved, snapshot.ZonePolicies, zones, now)
+	compiled.Sorted = sortByPriority(compiled.Expanded)
+	compiled.Encoded = encodeRules(compiled.Sorted)
+	return compiled
+}
+
+// resolveObjects is stage 1: every rule becomes the concrete entries its
+// service and address references resolve to
+func resolveObjects(rules []*FirewallRule, services map[string]*Service, addressObjects map[string]*AddressObject) []*FirewallRule {
+	var resolved []*FirewallRule
+	for _, rule := range rules {
+		resolved = append(resolved, resolveRuleObjects(rule, services, addressObjects)...)
+	}
+	return resolved
+}
+
+// expandZones is stage 2: enabled zone policies are appended as concrete
+// prefix-pair rules
+func expandZones(resolved []*FirewallRule, policies []*ZonePolicy, zones map[string]*Zone, now time.Time) []*FirewallRule {
+	expanded := append([]*FirewallRule(nil), resolved...)
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+		expanded = append(expanded, compileZonePolicy(policy, zones, now)...)
+	}
+	return expanded
+}
+
+// sortByPriority is stage 3: entries in evaluation order, lowest priority
+// number first and entry ID as tie-breaker so the order is deterministic
+func sortByPriority(entries []*FirewallRule) []*FirewallRule {
+	sorted := append([]*FirewallRule(nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// encodeRules is stage 4: entries in the layout of the data plane rule map
+func encodeRules(entries []*FirewallRule) []EncodedRule {
+	encoded := make([]EncodedRule, 0, len(entries))
+	for _, entry := range entries {
+		family := ruleFamily(entry)
+		encodedRule := EncodedRule{
+			ID:        entry.ID,
+			Namespace: entry.Namespace,
+			VF:        entry.VF,
+			Family:    familyName(family),
+			SrcPrefix: "any",
+			DstPrefix: "any",
+		}
+		if family != familyAny {
+			encodedRule.SrcPrefix = rulePrefix(entry.SrcIP, family).String()
+			encodedRule.DstPrefix = rulePrefix(entry.DstIP, family).String()
+		}
+		if family != familyIPv6 {
+			rule := encodeRule(entry, familyIPv4)
+			encodedRule.Rule = &rule
+		}
+		if family != familyIPv4 {
+			rule6 := encodeRule(entry, familyIPv6)
+			encodedRule.Rule6 = &rule6
+		}
+		encoded = append(encoded, encodedRule)
+	}
+	return encoded
+}
+
+// encodeRule encodes the L4 match and verdict of an entry for one family's
+// rule map; addresses are encoded as trie prefixes
+func encodeRule(rule *FirewallRule, family int) BPFFirewallRule {
+	encoded := BPFFirewallRule{
+		SrcPort:    uint16(rule.SrcPort),
+		SrcPortEnd: uint16(rule.SrcPortEnd),
+		DstPort:    uint16(rule.DstPort),
+		DstPortEnd: uint16(rule.DstPortEnd),
+		Protocol:   protocolToUint8(rule.Protocol),
+		Action:     actionToUint8(rule.Action),
+		CtState:    connStateMask(rule.ConnState),
+		Priority:   rule.Priority,
+		SrcSet:     rule.SrcSet,
+		DstSet:     rule.DstSet,
+	}
+	if family == familyIPv6 && rule.Protocol == "icmp" {
+		encoded.Protocol = 58 // ICMPv6
+	}
+	if rule.Enabled {
+		encoded.Enabled = 1
+	}
+	if rule.Log {
+		encoded.LogID = ruleLogID(entryRuleID(rule.ID))
+	}
+	if rule.Action == "mirror" {
+		encoded.Mirror = mirrorIfindex(rule.MirrorTo)
+	}
+	encoded.Flags, encoded.FlagsMask, _ = parseTCPFlags(rule.TCPFlags)
+	encoded.SrcMAC, _ = parseMAC(rule.SrcMAC)
+	encoded.DstMAC, _ = parseMAC(rule.DstMAC)
+	encoded.VLAN = uint16(rule.VLAN)
+	encoded.IfaceSet = ruleInterfaceSetID(rule.Interfaces)
+	encoded.SrcCountry = ruleCountrySetID(rule.SrcCountry)
+	encoded.DstCountry = ruleCountrySetID(rule.DstCountry)
+	encoded.SrcASN = ruleASNSetID(rule.SrcASN)
+	encoded.DstASN = ruleASNSetID(rule.DstASN)
+	return encoded
+}
+
+// entryChange is a data plane entry whose content differs between versions
+type entryChange struct {
+	Before *FirewallRule
+	After  *FirewallRule
+}
+
+// entryDiff lists the data plane operations turning one version into another
+type entryDiff struct {
+	Adds      []*FirewallRule
+	Removes   []*FirewallRule
+	Modifies  []entryChange
+	Unchanged int
+}
+
+// diffEntries compares two entry sets by entry ID; results are ordered by ID
+func diffEntries(current, planned []*FirewallRule) entryDiff {
+	before := make(map[string]*FirewallRule, len(current))
+	for _, entry := range current {
+		before[entry.ID] = entry
+	}
+	after := make(map[string]*FirewallRule, len(planned))
+	for _, entry := range planned {
+		after[entry.ID] = entry
+	}
+
+	var diff entryDiff
+	for _, id := range sortedEntryIDs(after) {
+		old, exists := before[id]
+		switch {
+		case !exists:
+			diff.Adds = append(diff.Adds, after[id])
+		case !dataPlaneEqual(old, after[id]):
+			diff.Modifies = append(diff.Modifies, entryChange{Before: old, After: after[id]})
+		default:
+			diff.Unchanged++
+		}
+	}
+	for _, id := range sortedEntryIDs(before) {
+		if _, exists := after[id]; !exists {
+			diff.Removes = append(diff.Removes, before[id])
+		}
+	}
+	return diff
+}
+
+// dataPlaneEqual compares the fields that affect matching and verdicts
+func dataPlaneEqual(a, b *FirewallRule) bool {
+	return a.Action == b.Action &&
+		a.SrcIP == b.SrcIP &&
+		a.DstIP == b.DstIP &&
+		a.SrcPort == b.SrcPort &&
+		a.SrcPortEnd == b.SrcPortEnd &&
+		a.DstPort == b.DstPort &&
+		a.DstPortEnd == b.DstPortEnd &&
+		a.Protocol == b.Protocol &&
+		a.Direction == b.Direction &&
+		a.Priority == b.Priority &&
+		a.Enabled == b.Enabled &&
+		slices.Equal(a.ConnState, b.ConnState) &&
+		a.TCPFlags == b.TCPFlags &&
+		a.SrcMAC == b.SrcMAC &&
+		a.DstMAC == b.DstMAC &&
+		a.VLAN == b.VLAN &&
+		slices.Equal(a.Interfaces, b.Interfaces) &&
+		slices.Equal(a.SrcCountry, b.SrcCountry) &&
+		slices.Equal(a.DstCountry, b.DstCountry) &&
+		slices.Equal(a.SrcASN, b.SrcASN) &&
+		slices.Equal(a.DstASN, b.DstASN) &&
+		a.Log == b.Log &&
+		a.MirrorTo == b.MirrorTo &&
+		a.SrcSet == b.SrcSet &&
+		a.DstSet == b.DstSet &&
+		a.Namespace == b.Namespace &&
+		a.VF == b.VF
+}
+
+func sortedEntryIDs(entries map[string]*FirewallRule) []string {
+	ids := make([]string, 0, len(entries))
+	for id := range entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// replacePolicy compiles the current policy into a new version and swaps
+// every data plane to it with ReplaceRules, so no packet sees a mix of the
+// two versions. A failed swap restores the running entries in the data
+// planes already swapped; so does cancelling ctx, which is checked
+// between data planes. Caller must hold s.mutex.
+func (s *Server) replacePolicy(ctx context.Context) error {
+	running := s.compiled.Load()
+	next := compilePolicy(s.policySnapshot(), running.Version+1, s.clock.Now())
+
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	entriesByScope := func(entries []*FirewallRule) map[string][]*FirewallRule {
+		byScope := make(map[string][]*FirewallRule)
+		for _, entry := range entries {
+			byScope[ruleScope(entry)] = append(byScope[ruleScope(entry)], entry)
+		}
+		return byScope
+	}
+	planned, current := entriesByScope(next.Sorted), entriesByScope(running.Sorted)
+
+	var managed []string
+	for _, scope := range scopes {
+		if s.dataPlaneFor(scope) != nil {
+			managed = append(managed, scope)
+		}
+	}
+	if err := s.addRuleInterfaces(next.Sorted); err != nil {
+		return err
+	}
+	if err := s.addRuleCountries(next.Sorted); err != nil {
+		return err
+	}
+	if err := s.addRuleASNs(next.Sorted); err != nil {
+		return err
+	}
+	var swapped []string
+	for _, scope := range managed {
+		err := checkpoint(ctx, "policy replace", len(swapped), len(managed), "data planes swapped")
+		if err == nil {
+			err = s.dataPlaneFor(scope).ReplaceRules(ctx, planned[scope])
+		}
+		if err == nil {
+			swapped = append(swapped, scope)
+			continue
+		}
+
+		// Restoring must finish even though the request is done
+		for _, done := range swapped {
+			if restoreErr := s.dataPlaneFor(done).ReplaceRules(context.Background(), current[done]); restoreErr != nil {
+				log.Printf("⚠️  Failed to restore policy version %d in %q: %v", running.Version, done, restoreErr)
+			}
+		}
+		if interrupted, ok := asInterrupted(err); ok {
+			log.Printf("⚠️  Policy version %d not applied, rolled back: %v", next.Version, interrupted)
+			return &interruptedError{operation: "policy replace", done: len(swapped), total: len(managed),
+				unit: "data planes swapped, rolled back", err: interrupted.err}
+		}
+		return fmt.Errorf("data plane %q: %w", scope, err)
+	}
+
+	// Simulate pushing the new version to VPP
+	if s.vppClient.connected {
+		log.Printf("Pushing policy version %d to VPP", next.Version)
+		// vpp.ReplaceRules(next.Sorted) - actual VPP API call would go here
+	}
+
+	s.compiled.Store(next)
+	s.pushPolicyVersion()
+	s.pruneRuleInterfaces()
+	s.pruneRuleCountries()
+	s.pruneRuleASNs()
+	log.Printf("Replaced policy version %d with version %d (%d entries)", running.Version, next.Version, len(next.Sorted))
+	return nil
+}
+
+// compiledPolicy returns the version currently enforced by the data plane
+func (s *Server) compiledPolicy() *CompiledPolicy {
+	return s.compiled.Load()
+}
+
+// applyPolicy compiles the current policy into a new version, pushes the
+// difference to the running version to the data plane and publishes it.
+// On failure the entries already written are restored to the running
+// version, which stays published, and the sets only the new version
+// referred to are pruned. Caller must hold s.mutex.
+func (s *Server) applyPolicy() (err error) {
+	if s.slo != nil {
+		start := time.Now()
+		defer func() { s.slo.RecordApply(time.Since(start), err) }()
+	}
+
+	running := s.compiled.Load()
+	next := compilePolicy(s.policySnapshot(), running.Version+1, s.clock.Now())
+	diff := diffEntries(running.Sorted, next.Sorted)
+
+	// Every data plane write records how to undo it
+	var undo []func() error
+	defer func() {
+		if err != nil {
+			s.rollbackApply(next.Version, undo)
+		}
+	}()
+	write := func(entry *FirewallRule) func() error {
+		manager := s.dataPlaneFor(ruleScope(entry))
+		return func() error { return manager.AddRuleToMap(entry) }
+	}
+	withdraw := func(entry *FirewallRule) func() error {
+		manager := s.dataPlaneFor(ruleScope(entry))
+		return func() error { return manager.DeleteRuleFromMap(entry.ID) }
+	}
+
+	// Install new entries before withdrawing old ones so enforcement never
+	// lapses. Each entry goes to the data plane of its scope, after the
+	// interface, country and ASN sets it refers to.
+	if err := s.addRuleInterfaces(next.Sorted); err != nil {
+		return err
+	}
+	if err := s.addRuleCountries(next.Sorted); err != nil {
+		return err
+	}
+	if err := s.addRuleASNs(next.Sorted); err != nil {
+		return err
+	}
+	for _, change := range diff.Modifies {
+		if manager := s.dataPlaneFor(ruleScope(change.After)); manager != nil {
+			if err := manager.AddRuleToMap(change.After); err != nil {
+				return fmt.Errorf("failed to update entry %s: %w", change.After.ID, err)
+			}
+			if ruleScope(change.Before) == ruleScope(change.After) {
+				undo = append(undo, write(change.Before))
+			} else {
+				undo = append(undo, withdraw(change.After))
+			}
+		}
+	}
+	for _, entry := range diff.Adds {
+		if manager := s.dataPlaneFor(ruleScope(entry)); manager != nil {
+			if err := manager.AddRuleToMap(entry); err != nil {
+				return fmt.Errorf("failed to add entry %s: %w", entry.ID, err)
+			}
+			undo = append(undo, withdraw(entry))
+		}
+	}
+	for _, change := range diff.Modifies {
+		if ruleScope(change.Before) == ruleScope(change.After) {
+			continue
+		}
+		// Entry moved to another data plane
+		if manager := s.dataPlaneFor(ruleScope(change.Before)); manager != nil {
+			if err := manager.DeleteRuleFromMap(change.Before.ID); err != nil {
+				return fmt.Errorf("failed to delete entry %s: %w", change.Before.ID, err)
+			}
+			undo = append(undo, write(change.Before))
+		}
+	}
+	for _, entry := range diff.Removes {
+		if manager := s.dataPlaneFor(ruleScope(entry)); manager != nil {
+			if err := manager.DeleteRuleFromMap(entry.ID); err != nil {
+				return fmt.Errorf("failed to delete entry %s: %w", entry.ID, err)
+			}
+			undo = append(undo, write(entry))
+		}
+	}
+
+	// Simulate pushing the new version to VPP
+	if s.vppClient.connected {
+		log.Printf("Pushing policy version %d to VPP", next.Version)
+		// vpp.ReplaceRules(next.Sorted) - actual VPP API call would go here
+	}
+
+	s.compiled.Store(next)
+	s.pushPolicyVersion()
+	s.pruneRuleInterfaces()
+	s.pruneRuleCountries()
+	s.pruneRuleASNs()
+	log.Printf("Applied policy version %d: %d added, %d modified, %d removed, %d unchanged",
+		next.Version, len(diff.Adds), len(diff.Modifies), len(diff.Removes), diff.Unchanged)
+	return nil
+}
+
+// rollbackApply undoes the data plane writes of a failed apply, newest
+// first, and prunes the sets the running version does not use. Caller
+// must hold s.mutex.
+func (s *Server) rollbackApply(version uint64, undo []func() error) {
+	for i := len(undo) - 1; i >= 0; i-- {
+		if err := undo[i](); err != nil {
+			log.Printf("⚠️  Failed to roll back policy version %d: %v", version, err)
+		}
+	}
+	s.pruneRuleInterfaces()
+	s.pruneRuleCountries()
+	s.pruneRuleASNs()
+	log.Printf("⚠️  Policy version %d not applied, rolled back %d entries", version, len(undo))
+}
@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// entryIDs returns the IDs of entries in order
+func entryIDs(entries []*FirewallRule) []string {
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	return ids
+}
+
+func TestResolveObjects(t *testing.T) {
+	services := map[string]*Service{
+		"web": {Name: "web", Ports: []ServicePort{
+			{Protocol: "tcp", PortStart: 80},
+			{Protocol: "tcp", PortStart: 8000, PortEnd: 8080},
+		}},
+	}
+	addressObjects := map[string]*AddressObject{
+		"lan":    {Name: "lan", Prefixes: []string{"10.0.0.0/8"}, Members: []string{"lan6"}},
+		"lan6":   {Name: "lan6", Prefixes: []string{"fd00::/8"}},
+		"dmz":    {Name: "dmz", Prefixes: []string{"192.0.2.0/24"}},
+		"loop":   {Name: "loop", Prefixes: []string{"198.51.100.0/24"}, Members: []string{"loop"}},
+		"empty":  {Name: "empty"},
+		"nested": {Name: "nested", Members: []string{"dmz", "lan"}},
+	}
+
+	type entry struct {
+		id, src, dst, protocol string
+		port, portEnd          int32
+	}
+	tests := []struct {
+		name string
+		rule *FirewallRule
+		want []entry
+	}{
+		{
+			name: "no references",
+			rule: &FirewallRule{ID: "r", SrcIP: "10.0.0.1", Protocol: "udp", DstPort: 53},
+			want: []entry{{"r", "10.0.0.1", "", "udp", 53, 0}},
+		},
+		{
+			name: "service ports",
+			rule: &FirewallRule{ID: "r", Service: "web", DstIP: "192.0.2.1"},
+			want: []entry{
+				{"r#0", "", "192.0.2.1", "tcp", 80, 0},
+				{"r#1", "", "192.0.2.1", "tcp", 8000, 8080},
+			},
+		},
+		{
+			name: "unknown service",
+			rule: &FirewallRule{ID: "r", Service: "mail"},
+		},
+		{
+			name: "nested address object",
+			rule: &FirewallRule{ID: "r", SrcAddress: "nested", Protocol: "tcp", DstPort: 22},
+			want: []entry{
+				{"r#0", "10.0.0.0/8", "", "tcp", 22, 0},
+				{"r#1", "192.0.2.0/24", "", "tcp", 22, 0},
+				{"r#2", "fd00::/8", "", "tcp", 22, 0},
+			},
+		},
+		{
+			name: "mixed families skipped",
+			rule: &FirewallRule{ID: "r", SrcAddress: "lan", DstAddress: "dmz", Protocol: "any"},
+			want: []entry{{"r#0", "10.0.0.0/8", "192.0.2.0/24", "any", 0, 0}},
+		},
+		{
+			name: "cyclic members",
+			rule: &FirewallRule{ID: "r", DstAddress: "loop"},
+			want: []entry{{"r#0", "", "198.51.100.0/24", "", 0, 0}},
+		},
+		{
+			name: "empty address object",
+			rule: &FirewallRule{ID: "r", SrcAddress: "empty"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved := resolveObjects([]*FirewallRule{test.rule}, services, addressObjects)
+			var got []entry
+			for _, r := range resolved {
+				got = append(got, entry{r.ID, r.SrcIP, r.DstIP, r.Protocol, r.DstPort, r.DstPortEnd})
+			}
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("resolved = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExpandZones(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	zones := map[string]*Zone{
+		"lan": {Name: "lan", Prefixes: []string{"10.0.0.0/8", "fd00::/8"}},
+		"wan": {Name: "wan", Interfaces: []string{"eth0"}},
+		"dmz": {Name: "dmz", Prefixes: []string{"192.0.2.0/24"}},
+	}
+	resolved := []*FirewallRule{{ID: "rule_1", Action: "drop"}}
+
+	tests := []struct {
+		name   string
+		policy *ZonePolicy
+		want   [][2]string // Source and destination of each zone entry
+	}{
+		{
+			name:   "prefixes to any",
+			policy: &ZonePolicy{ID: "p", FromZone: "lan", ToZone: "wan", Action: "allow", Enabled: true},
+			want:   [][2]string{{"10.0.0.0/8", ""}, {"fd00::/8", ""}},
+		},
+		{
+			name:   "mixed families skipped",
+			policy: &ZonePolicy{ID: "p", FromZone: "lan", ToZone: "dmz", Action: "allow", Enabled: true},
+			want:   [][2]string{{"10.0.0.0/8", "192.0.2.0/24"}},
+		},
+		{
+			name:   "any to any",
+			policy: &ZonePolicy{ID: "p", FromZone: "wan", ToZone: "wan", Action: "drop", Enabled: true},
+			want:   [][2]string{{"", ""}},
+		},
+		{
+			name:   "disabled",
+			policy: &ZonePolicy{ID: "p", FromZone: "lan", ToZone: "wan", Action: "allow"},
+		},
+		{
+			name:   "unknown zone",
+			policy: &ZonePolicy{ID: "p", FromZone: "lan", ToZone: "guest", Action: "allow", Enabled: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expanded := expandZones(resolved, []*ZonePolicy{test.policy}, zones, now)
+			if len(expanded) == 0 || expanded[0] != resolved[0] {
+				t.Fatal("resolved rules not kept first")
+			}
+			var got [][2]string
+			for i, entry := range expanded[1:] {
+				if want := "zone_p_" + string(rune('0'+i)); entry.ID != want {
+					t.Errorf("entry ID = %s, want %s", entry.ID, want)
+				}
+				if entry.Action != test.policy.Action || entry.Direction != "both" {
+					t.Errorf("entry %s = %s %s, want %s both", entry.ID, entry.Action, entry.Direction, test.policy.Action)
+				}
+				if !entry.CreatedAt.Equal(now) || !entry.UpdatedAt.Equal(now) {
+					t.Errorf("entry %s stamped %v, want %v", entry.ID, entry.CreatedAt, now)
+				}
+				got = append(got, [2]string{entry.SrcIP, entry.DstIP})
+			}
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("zone entries = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortByPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []*FirewallRule
+		want    []string
+	}{
+		{
+			name: "priority",
+			entries: []*FirewallRule{
+				{ID: "a", Priority: 30}, {ID: "b", Priority: 10}, {ID: "c", Priority: 20},
+			},
+			want: []string{"b", "c", "a"},
+		},
+		{
+			name: "ID breaks ties",
+			entries: []*FirewallRule{
+				{ID: "rule_2", Priority: 10}, {ID: "rule_10#1", Priority: 10}, {ID: "rule_10#0", Priority: 10},
+			},
+			want: []string{"rule_10#0", "rule_10#1", "rule_2"},
+		},
+		{
+			name: "negative priority first",
+			entries: []*FirewallRule{
+				{ID: "a", Priority: 0}, {ID: "b", Priority: -5}, {ID: "c", Priority: 0},
+			},
+			want: []string{"b", "a", "c"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := entryIDs(test.entries)
+			if got := entryIDs(sortByPriority(test.entries)); !slices.Equal(got, test.want) {
+				t.Fatalf("order = %v, want %v", got, test.want)
+			}
+			if !slices.Equal(entryIDs(test.entries), original) {
+				t.Fatal("input reordered")
+			}
+		})
+	}
+}
+
+func TestEncodeRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		entry    *FirewallRule
+		family   string
+		src, dst string
+		rule     *BPFFirewallRule
+		rule6    *BPFFirewallRule
+	}{
+		{
+			name:   "IPv4",
+			entry:  &FirewallRule{ID: "r", Action: "drop", SrcIP: "10.1.2.3/8", Protocol: "tcp", DstPort: 22, Priority: 5, Enabled: true},
+			family: "ipv4", src: "10.0.0.0/8", dst: "0.0.0.0/0",
+			rule: &BPFFirewallRule{DstPort: 22, Protocol: 6, Action: 1, Enabled: 1, Priority: 5},
+		},
+		{
+			name:   "IPv6",
+			entry:  &FirewallRule{ID: "r", Action: "allow", DstIP: "2001:db8::1", Protocol: "icmp", Enabled: true},
+			family: "ipv6", src: "::/0", dst: "2001:db8::1/128",
+			rule6: &BPFFirewallRule{Protocol: 58, Enabled: 1},
+		},
+		{
+			name:   "both families",
+			entry:  &FirewallRule{ID: "r", Action: "reject", Protocol: "icmp", DstPort: 1000, DstPortEnd: 2000, ConnState: []string{"new", "related"}},
+			family: "any", src: "any", dst: "any",
+			rule:  &BPFFirewallRule{DstPort: 1000, DstPortEnd: 2000, Protocol: 1, Action: 3, CtState: 5},
+			rule6: &BPFFirewallRule{DstPort: 1000, DstPortEnd: 2000, Protocol: 58, Action: 3, CtState: 5},
+		},
+		{
+			name:   "logged entry of a resolved rule",
+			entry:  &FirewallRule{ID: "rule_7#2", Action: "allow", SrcIP: "192.0.2.0/24", Log: true, SrcSet: 4, VLAN: 100},
+			family: "ipv4", src: "192.0.2.0/24", dst: "0.0.0.0/0",
+			rule: &BPFFirewallRule{LogID: ruleLogID("rule_7"), SrcSet: 4, VLAN: 100},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded := encodeRules([]*FirewallRule{test.entry})
+			if len(encoded) != 1 {
+				t.Fatalf("%d encoded entries, want 1", len(encoded))
+			}
+			got := encoded[0]
+			if got.ID != test.entry.ID || got.Family != test.family || got.SrcPrefix != test.src || got.DstPrefix != test.dst {
+				t.Fatalf("encoded %s %s %s -> %s, want %s %s %s -> %s",
+					got.ID, got.Family, got.SrcPrefix, got.DstPrefix, test.entry.ID, test.family, test.src, test.dst)
+			}
+			for _, value := range []struct {
+				name      string
+				got, want *BPFFirewallRule
+			}{{"rule", got.Rule, test.rule}, {"rule6", got.Rule6, test.rule6}} {
+				switch {
+				case value.want == nil && value.got != nil:
+					t.Errorf("%s encoded, want none", value.name)
+				case value.want != nil && value.got == nil:
+					t.Errorf("%s not encoded", value.name)
+				case value.want != nil && *value.got != *value.want:
+					t.Errorf("%s = %+v, want %+v", value.name, *value.got, *value.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffEntries(t *testing.T) {
+	rule := func(id string, edit func(*FirewallRule)) *FirewallRule {
+		entry := &FirewallRule{ID: id, Action: "drop", SrcIP: "10.0.0.0/8", Priority: 10, Enabled: true}
+		if edit != nil {
+			edit(entry)
+		}
+		return entry
+	}
+
+	tests := []struct {
+		name             string
+		current, planned []*FirewallRule
+		adds, removes    []string
+		modifies         []string
+		moved            []string // Modified entries whose scope changed
+		unchanged        int
+	}{
+		{
+			name:    "add",
+			current: []*FirewallRule{rule("a", nil)},
+			planned: []*FirewallRule{rule("b", nil), rule("a", nil)},
+			adds:    []string{"b"}, unchanged: 1,
+		},
+		{
+			name:    "remove",
+			current: []*FirewallRule{rule("b", nil), rule("a", nil)},
+			planned: nil,
+			removes: []string{"a", "b"},
+		},
+		{
+			name:     "modify",
+			current:  []*FirewallRule{rule("a", nil), rule("b", nil)},
+			planned:  []*FirewallRule{rule("a", func(r *FirewallRule) { r.Action = "allow" }), rule("b", nil)},
+			modifies: []string{"a"}, unchanged: 1,
+		},
+		{
+			name:    "metadata only",
+			current: []*FirewallRule{rule("a", nil)},
+			planned: []*FirewallRule{rule("a", func(r *FirewallRule) {
+				r.Description, r.Owner, r.Labels = "ssh", "netops", map[string]string{"tier": "1"}
+			})},
+			unchanged: 1,
+		},
+		{
+			name:     "move scope",
+			current:  []*FirewallRule{rule("a", nil), rule("b", func(r *FirewallRule) { r.Namespace = "blue" })},
+			planned:  []*FirewallRule{rule("a", func(r *FirewallRule) { r.Namespace = "red" }), rule("b", func(r *FirewallRule) { r.VF = "enp3s0f0/vf3" })},
+			modifies: []string{"a", "b"}, moved: []string{"a", "b"},
+		},
+		{
+			name:    "mixed",
+			current: []*FirewallRule{rule("c", nil), rule("a", nil), rule("d", nil)},
+			planned: []*FirewallRule{rule("d", nil), rule("b", nil), rule("c", func(r *FirewallRule) { r.ConnState = []string{"new"} })},
+			adds:    []string{"b"}, removes: []string{"a"}, modifies: []string{"c"}, unchanged: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diff := diffEntries(test.current, test.planned)
+			if got := entryIDs(diff.Adds); !slices.Equal(got, test.adds) {
+				t.Errorf("adds = %v, want %v", got, test.adds)
+			}
+			if got := entryIDs(diff.Removes); !slices.Equal(got, test.removes) {
+				t.Errorf("removes = %v, want %v", got, test.removes)
+			}
+			var modifies, moved []string
+			for _, change := range diff.Modifies {
+				if change.Before.ID != change.After.ID {
+					t.Errorf("change of %s to %s", change.Before.ID, change.After.ID)
+				}
+				modifies = append(modifies, change.After.ID)
+				if ruleScope(change.Before) != ruleScope(change.After) {
+					moved = append(moved, change.After.ID)
+				}
+			}
+			if !slices.Equal(modifies, test.modifies) {
+				t.Errorf("modifies = %v, want %v", modifies, test.modifies)
+			}
+			if !slices.Equal(moved, test.moved) {
+				t.Errorf("moved = %v, want %v", moved, test.moved)
+			}
+			if diff.Unchanged != test.unchanged {
+				t.Errorf("unchanged = %d, want %d", diff.Unchanged, test.unchanged)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// SPDX-License-Identifier: Apache-2.0
+// Response compression: zstd and gzip for gRPC and REST, negotiated with the client, above a size threshold
+
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip" // Registers the gzip gRPC compressor
+	"google.golang.org/protobuf/proto"
+)
+
+// Content encodings, also the gRPC compressor names
+const (
+	EncodingZstd = "zstd"
+	EncodingGzip = "gzip"
+
+	// Responses smaller than this are sent uncompressed
+	DefaultCompressionMinSize = 1024
+)
+
+// CompressionConfig selects the encodings offered, in order of preference
+type CompressionConfig struct {
+	Encodings []string // Empty = never compress responses
+	MinSize   int
+}
+
+// compressionConfigFromEnv reads CERBERUS_COMPRESSION, a comma-separated
+// list of encodings in order of preference or "off", and
+// CERBERUS_COMPRESSION_MIN_SIZE in bytes
+func compressionConfigFromEnv() (CompressionConfig, error) {
+	config := CompressionConfig{Encodings: []string{EncodingZstd, EncodingGzip}, MinSize: DefaultCompressionMinSize}
+
+	if value, set := os.LookupEnv("CERBERUS_COMPRESSION"); set {
+		config.Encodings = nil
+		if strings.TrimSpace(value) != "off" {
+			for _, name := range strings.Split(value, ",") {
+				name = strings.ToLower(strings.TrimSpace(name))
+				if name == "" {
+					continue
+				}
+				if name != EncodingZstd && name != EncodingGzip {
+					return config, fmt.Errorf("invalid CERBERUS_COMPRESSION encoding %q, expected zstd, gzip or off", name)
+				}
+				config.Encodings = append(config.Encodings, name)
+			}
+		}
+	}
+	if value := os.Getenv("CERBERUS_COMPRESSION_MIN_SIZE"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return config, fmt.Errorf("invalid CERBERUS_COMPRESSION_MIN_SIZE %q, expected a size in bytes", value)
+		}
+		config.MinSize = parsed
+	}
+	return config, nil
+}
+
+// negotiate returns the preferred encoding the client accepts, or "" when
+// the response goes out uncompressed
+func (c CompressionConfig) negotiate(accepted map[string]float64) string {
+	best, bestQ := "", 0.0
+	for _, name := range c.Encodings {
+		q, listed := accepted[name]
+		if !listed {
+			q, listed = accepted["*"]
+		}
+		if listed && q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+// parseAcceptEncoding maps the codings of an Accept-Encoding header to
+// their quality value
+func parseAcceptEncoding(header string) map[string]float64 {
+	accepted := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+		accepted[name] = q
+	}
+	return accepted
+}
+
+// gRPC
+
+func init() {
+	encoding.RegisterCompressor(&zstdCompressor{})
+}
+
+// zstdCompressor is the zstd gRPC compressor; encoders and decoders are
+// pooled since they are costly to create
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (z *zstdCompressor) Name() string {
+	return EncodingZstd
+}
+
+func (z *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	if encoder, ok := z.encoders.Get().(*zstd.Encoder); ok {
+		encoder.Reset(w)
+		return &pooledZstdWriter{Encoder: encoder, pool: &z.encoders}, nil
+	}
+	encoder, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &pooledZstdWriter{Encoder: encoder, pool: &z.encoders}, nil
+}
+
+func (z *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	if decoder, ok := z.decoders.Get().(*zstd.Decoder); ok {
+		if err := decoder.Reset(r); err != nil {
+			z.decoders.Put(decoder)
+			return nil, err
+		}
+		return &pooledZstdReader{Decoder: decoder, pool: &z.decoders}, nil
+	}
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &pooledZstdReader{Decoder: decoder, pool: &z.decoders}, nil
+}
+
+type pooledZstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *pooledZstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+	return err
+}
+
+// pooledZstdReader returns its decoder to the pool at the end of the
+// message
+type pooledZstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *pooledZstdReader) Read(p []byte) (int, error) {
+	if r.Decoder == nil {
+		return 0, io.EOF
+	}
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r.Decoder)
+		r.Decoder = nil
+	}
+	return n, err
+}
+
+// unaryInterceptor picks the compressor of a unary response: the
+// preferred one the client advertises, or none below the size threshold
+func (c CompressionConfig) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	name := encoding.Identity
+	if message, ok := resp.(proto.Message); ok && proto.Size(message) >= c.MinSize {
+		if advertised, err := grpc.ClientSupportedCompressors(ctx); err == nil {
+			accepted := make(map[string]float64)
+			for _, compressor := range advertised {
+				accepted[compressor] = 1
+			}
+			if best := c.negotiate(accepted); best != "" {
+				name = best
+			}
+		}
+	}
+	grpc.SetSendCompressor(ctx, name)
+	return resp, nil
+}
+
+// streamInterceptor sends streamed messages uncompressed when compression
+// is off; otherwise they follow the compressor of the request
+func (c CompressionConfig) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if len(c.Encodings) == 0 {
+		grpc.SetSendCompressor(stream.Context(), encoding.Identity)
+	}
+	return handler(srv, stream)
+}
+
+// REST
+
+// compressREST compresses REST responses for clients sending
+// Accept-Encoding. Responses are buffered up to the size threshold, so
+// small ones go out as they are; event streams are never compressed.
+func (c CompressionConfig) compressREST(next http.Handler) http.Handler {
+	if len(c.Encodings) == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		name := c.negotiate(parseAcceptEncoding(r.Header.Get("Accept-Encoding")))
+		if name == "" || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressWriter{ResponseWriter: w, encoding: name, minSize: c.MinSize}
+		defer cw.Close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// compressWriter holds back the start of a response until it knows
+// whether the response is worth compressing
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+
+	status  int
+	buffer  []byte
+	decided bool           // Headers sent
+	encoder io.WriteCloser // nil = uncompressed
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.status != 0 || cw.decided {
+		return
+	}
+	cw.status = status
+	header := cw.Header()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified ||
+		header.Get("Content-Encoding") != "" || strings.HasPrefix(header.Get("Content-Type"), "text/event-stream") {
+		cw.start(false)
+	}
+}
+
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	if cw.status == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if cw.decided {
+		if cw.encoder != nil {
+			return cw.encoder.Write(p)
+		}
+		return cw.ResponseWriter.Write(p)
+	}
+	cw.buffer = append(cw.buffer, p...)
+	if len(cw.buffer) >= cw.minSize {
+		if err := cw.start(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// start sends the headers and the buffered start of the response
+func (cw *compressWriter) start(compress bool) error {
+	cw.decided = true
+	if compress {
+		header := cw.Header()
+		header.Set("Content-Encoding", cw.encoding)
+		header.Del("Content-Length")
+		switch cw.encoding {
+		case EncodingZstd:
+			encoder, err := zstd.NewWriter(cw.ResponseWriter, zstd.WithEncoderConcurrency(1))
+			if err != nil {
+				return err
+			}
+			cw.encoder = encoder
+		default:
+			cw.encoder = gzip.NewWriter(cw.ResponseWriter)
+		}
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+
+	buffer := cw.buffer
+	cw.buffer = nil
+	if len(buffer) == 0 {
+		return nil
+	}
+	var err error
+	if cw.encoder != nil {
+		_, err = cw.encoder.Write(buffer)
+	} else {
+		_, err = cw.ResponseWriter.Write(buffer)
+	}
+	return err
+}
+
+// Flush sends what was written so far; a response flushed before reaching
+// the threshold goes out uncompressed
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		if cw.status == 0 {
+			cw.status = http.StatusOK
+		}
+		cw.start(false)
+	}
+	if flusher, ok := cw.encoder.(interface{ Flush() error }); ok {
+		flusher.Flush()
+	}
+	if flusher, ok := cw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close ends the response once the handler returns
+func (cw *compressWriter) Close() error {
+	if !cw.decided {
+		if cw.status == 0 {
+			return nil // Nothing written; net/http sends the empty response
+		}
+		if err := cw.start(false); err != nil {
+			return err
+		}
+	}
+	if cw.encoder != nil {
+		return cw.encoder.Close()
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Configuration file: key=value settings named by CERBERUS_CONFIG, applied
+// as the CERBERUS_* environment variables they stand for
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultConfigPath is where cerberusctl init writes the configuration
+const DefaultConfigPath = "/etc/cerberus/ctrl.conf"
+
+// configEnvName maps a configuration key to its environment variable:
+// xdp_mode and CERBERUS_XDP_MODE both name CERBERUS_XDP_MODE
+func configEnvName(key string) string {
+	name := strings.ToUpper(strings.TrimSpace(key))
+	if !strings.HasPrefix(name, "CERBERUS_") {
+		name = "CERBERUS_" + name
+	}
+	return name
+}
+
+// loadConfigFile applies the settings of a configuration file to the
+// environment. Variables already set in the environment win, so a unit's
+// Environment= lines override the file. A missing file is no error.
+func loadConfigFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return fmt.Errorf("%s:%d: expected key=value", path, line)
+		}
+		name := configEnvName(key)
+		if _, set := os.LookupEnv(name); set {
+			continue
+		}
+		os.Setenv(name, strings.Trim(strings.TrimSpace(value), `"'`))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	return nil
+}
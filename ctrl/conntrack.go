@@ -0,0 +1,336 @@
+// SPDX-License-Identifier: Apache-2.0
+// Connection tracking: flow table maintained by the XDP program
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/sys/unix"
+)
+
+// ConntrackMapName is the pinned flow table (must match eBPF program)
+const ConntrackMapName = "cerberus_conntrack"
+
+// Flow states (enum ct_entry_state in the eBPF program)
+const (
+	ctNew         = 1
+	ctEstablished = 2
+	ctClosing     = 3
+)
+
+// Packet states rules can require (CT_STATE_* in the eBPF program)
+var connStateBits = map[string]uint8{
+	"new":         1 << 0,
+	"established": 1 << 1,
+	"related":     1 << 2,
+}
+
+// ConntrackKey mirrors struct ct_key in ebpf/xdp_filter.c
+type ConntrackKey struct {
+	SrcAddr  [16]byte // IPv4 uses the first 4 bytes
+	DstAddr  [16]byte
+	SrcPort  uint16
+	DstPort  uint16
+	Protocol uint8
+	Family   uint8
+	Pad      [2]uint8
+}
+
+// ConntrackEntry mirrors struct ct_entry in ebpf/xdp_filter.c
+type ConntrackEntry struct {
+	LastSeen uint64 // CLOCK_MONOTONIC nanoseconds
+	Packets  uint64
+	Bytes    uint64
+	State    uint8
+	Pad      uint8
+	NATPort  uint16   // Destination port before a DNAT rule rewrote it
+	NATID    uint32   // DNAT rule that rewrote the destination, 0 = none
+	NATAddr  [16]byte // Destination address before the rewrite
+}
+
+// Connection is a decoded flow table entry
+type Connection struct {
+	Key   ConntrackKey
+	Entry ConntrackEntry
+}
+
+// ListConnections returns the flows tracked by a data plane, most recently
+// active first
+func (s *Server) ListConnections(ctx context.Context, req *pb.ListConnectionsRequest) (*pb.ConnectionsResponse, error) {
+	s.mutex.RLock()
+	manager := s.dataPlaneFor(req.Scope)
+	s.mutex.RUnlock()
+	if manager == nil {
+		return nil, fmt.Errorf("no data plane for scope %q", req.Scope)
+	}
+
+	connections, err := manager.Connections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].Entry.LastSeen > connections[j].Entry.LastSeen
+	})
+
+	resp := &pb.ConnectionsResponse{
+		Count:    int32(len(connections)),
+		Capacity: int32(manager.ConntrackCapacity()),
+	}
+	if req.Limit > 0 && int(req.Limit) < len(connections) {
+		connections = connections[:req.Limit]
+	}
+	now := monotonicNow()
+	anonymize := s.anonymizer.applies(req.Anonymize)
+	for _, conn := range connections {
+		connection := conn.toProto(now)
+		if anonymize {
+			s.anonymizer.connection(connection)
+		}
+		resp.Connections = append(resp.Connections, connection)
+	}
+	return resp, nil
+}
+
+// KillConnection removes a flow from the table; its next packet is
+// treated as new
+func (s *Server) KillConnection(ctx context.Context, req *pb.KillConnectionRequest) (*pb.StatusResponse, error) {
+	if req.GetConnection() == nil {
+		return &pb.StatusResponse{Success: false, Message: "Connection is required"}, nil
+	}
+	key, err := conntrackKeyFromProto(req.Connection)
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Invalid connection: %v", err)}, nil
+	}
+
+	s.mutex.RLock()
+	manager := s.dataPlaneFor(req.Scope)
+	s.mutex.RUnlock()
+	if manager == nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("No data plane for scope %q", req.Scope)}, nil
+	}
+
+	found, err := manager.KillConnection(key)
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to kill connection: %v", err)}, nil
+	}
+	if !found {
+		return &pb.StatusResponse{Success: false, Message: "Connection not found"}, nil
+	}
+
+	log.Printf("Killed connection: %s %s:%d -> %s:%d", req.Connection.Protocol,
+		req.Connection.SrcIp, req.Connection.SrcPort, req.Connection.DstIp, req.Connection.DstPort)
+	return &pb.StatusResponse{Success: true, Message: "Connection killed successfully"}, nil
+}
+
+// conntrackDumpCheckpoint is how many flow table entries are read between
+// checks for cancellation
+const conntrackDumpCheckpoint = 4096
+
+// Connections reads every entry of the flow table, stopping early when ctx
+// is done
+func (bm *BPFMapManager) Connections(ctx context.Context) ([]Connection, error) {
+	if bm.conntrack == nil {
+		return nil, nil
+	}
+
+	var connections []Connection
+	var conn Connection
+	iter := bm.conntrack.Iterate()
+	for iter.Next(&conn.Key, &conn.Entry) {
+		connections = append(connections, conn)
+		if len(connections)%conntrackDumpCheckpoint == 0 {
+			if err := checkpoint(ctx, "flow table dump", len(connections), 0, "entries read"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read flow table: %v", err)
+	}
+	return connections, nil
+}
+
+// KillConnection deletes a flow given in either direction
+func (bm *BPFMapManager) KillConnection(key ConntrackKey) (bool, error) {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Killing connection")
+		return true, nil
+	}
+	if bm.conntrack == nil {
+		return false, fmt.Errorf("flow table not available")
+	}
+
+	for _, candidate := range []ConntrackKey{key, key.reverse()} {
+		err := bm.conntrack.Delete(&candidate)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// ConntrackCapacity returns the flow table size, 0 without a table
+func (bm *BPFMapManager) ConntrackCapacity() int {
+	if bm.conntrack == nil {
+		return 0
+	}
+	return int(bm.conntrack.MaxEntries())
+}
+
+// ConntrackOccupancy counts tracked flows by state name
+func (bm *BPFMapManager) ConntrackOccupancy() (map[string]int, error) {
+	connections, err := bm.Connections(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	occupancy := map[string]int{"new": 0, "established": 0, "closing": 0}
+	for _, conn := range connections {
+		occupancy[conntrackStateName(conn.Entry.State)]++
+	}
+	return occupancy, nil
+}
+
+func (k ConntrackKey) reverse() ConntrackKey {
+	reversed := k
+	reversed.SrcAddr, reversed.DstAddr = k.DstAddr, k.SrcAddr
+	reversed.SrcPort, reversed.DstPort = k.DstPort, k.SrcPort
+	return reversed
+}
+
+func (k ConntrackKey) addrs() (net.IP, net.IP) {
+	if k.Family == familyIPv4 {
+		return net.IP(k.SrcAddr[:4]), net.IP(k.DstAddr[:4])
+	}
+	return net.IP(k.SrcAddr[:]), net.IP(k.DstAddr[:])
+}
+
+// natAddr returns an address of the flow's family kept in its entry
+func (k ConntrackKey) natAddr(addr [16]byte) net.IP {
+	if k.Family == familyIPv4 {
+		return net.IP(addr[:4])
+	}
+	return net.IP(addr[:])
+}
+
+func (c Connection) toProto(now uint64) *pb.Connection {
+	src, dst := c.Key.addrs()
+	conn := &pb.Connection{
+		Family:   familyName(int(c.Key.Family)),
+		Protocol: protocolName(c.Key.Protocol),
+		SrcIp:    src.String(),
+		SrcPort:  int32(c.Key.SrcPort),
+		DstIp:    dst.String(),
+		DstPort:  int32(c.Key.DstPort),
+		State:    conntrackStateName(c.Entry.State),
+		Packets:  c.Entry.Packets,
+		Bytes:    c.Entry.Bytes,
+	}
+	if c.Entry.NATID != 0 {
+		conn.NatRuleId = c.Entry.NATID
+		conn.NatIp = c.Key.natAddr(c.Entry.NATAddr).String()
+		conn.NatPort = int32(c.Entry.NATPort)
+	}
+	if now > c.Entry.LastSeen {
+		conn.IdleMs = int64(time.Duration(now - c.Entry.LastSeen).Milliseconds())
+	}
+	return conn
+}
+
+func conntrackKeyFromProto(conn *pb.Connection) (ConntrackKey, error) {
+	var key ConntrackKey
+	src, dst := net.ParseIP(conn.SrcIp), net.ParseIP(conn.DstIp)
+	if src == nil || dst == nil {
+		return key, fmt.Errorf("src_ip and dst_ip must be addresses")
+	}
+
+	if src4, dst4 := src.To4(), dst.To4(); src4 != nil && dst4 != nil {
+		key.Family = familyIPv4
+		copy(key.SrcAddr[:], src4)
+		copy(key.DstAddr[:], dst4)
+	} else if src4 == nil && dst4 == nil {
+		key.Family = familyIPv6
+		copy(key.SrcAddr[:], src)
+		copy(key.DstAddr[:], dst)
+	} else {
+		return key, fmt.Errorf("src_ip and dst_ip must be the same address family")
+	}
+
+	if conn.Protocol == "" {
+		return key, fmt.Errorf("protocol is required")
+	}
+	if key.Protocol = protocolToUint8(conn.Protocol); key.Protocol == 0 {
+		return key, fmt.Errorf("invalid protocol: %s", conn.Protocol)
+	}
+	if key.Protocol == 1 && key.Family == familyIPv6 {
+		key.Protocol = 58 // ICMPv6
+	}
+	key.SrcPort, key.DstPort = uint16(conn.SrcPort), uint16(conn.DstPort)
+	return key, nil
+}
+
+func protocolName(protocol uint8) string {
+	switch protocol {
+	case 1, 58:
+		return "icmp"
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	case 2:
+		return "igmp"
+	case 4:
+		return "ipip"
+	case 41:
+		return "ipv6"
+	case 47:
+		return "gre"
+	case 132:
+		return "sctp"
+	default:
+		return strconv.Itoa(int(protocol))
+	}
+}
+
+func conntrackStateName(state uint8) string {
+	switch state {
+	case ctNew:
+		return "new"
+	case ctEstablished:
+		return "established"
+	case ctClosing:
+		return "closing"
+	default:
+		return "unknown"
+	}
+}
+
+// connStateMask encodes a rule's connection states for the data plane
+func connStateMask(states []string) uint8 {
+	var mask uint8
+	for _, state := range states {
+		mask |= connStateBits[state]
+	}
+	return mask
+}
+
+// monotonicNow returns the clock bpf_ktime_get_ns() reads
+func monotonicNow() uint64 {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return 0
+	}
+	return uint64(ts.Nano())
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Counter continuity: the host packet counters are persisted so that they
+// keep growing across control plane restarts and data plane reloads
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+)
+
+const (
+	counterSnapshotVersion  = 1
+	counterSnapshotFileName = "counters.json"
+
+	// How often the counters are saved; a data plane reset loses at most
+	// what it counted since the last save
+	counterSnapshotInterval = time.Minute
+
+	bootIDPath = "/proc/sys/kernel/random/boot_id"
+)
+
+// counterSnapshot is the persisted state of the totals
+type counterSnapshot struct {
+	Version int           `json:"version"`
+	Since   time.Time     `json:"since"`  // Start of the totals
+	Source  string        `json:"source"` // Data plane counting, see counterSource
+	Offset  FirewallStats `json:"offset"` // Counted by earlier data planes
+	Last    FirewallStats `json:"last"`   // Last read of the current data plane
+	Resets  uint64        `json:"resets"` // Data plane resets folded into Offset
+	SavedAt time.Time     `json:"saved_at"`
+}
+
+// CounterContinuity turns the data plane's packet counters, which restart
+// from zero when the program and its maps are reloaded, into totals that
+// only grow. A reset is noticed by a new boot or stats map, or by a
+// counter going back; what the old data plane counted becomes an offset.
+type CounterContinuity struct {
+	mutex    sync.Mutex
+	path     string // Empty keeps the totals in memory
+	snapshot counterSnapshot
+	source   string // Of the data plane read now
+	dirty    bool   // Changed since the last save
+	clock    Clock
+}
+
+// NewCounterContinuity loads the totals saved under dir; an empty dir keeps
+// them in memory
+func NewCounterContinuity(dir string) (*CounterContinuity, error) {
+	c := &CounterContinuity{clock: systemClock{}}
+	c.snapshot = counterSnapshot{Version: counterSnapshotVersion, Since: c.clock.Now().UTC()}
+	if dir == "" {
+		return c, nil
+	}
+
+	c.path = filepath.Join(dir, counterSnapshotFileName)
+	data, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read counter snapshot: %v", err)
+	}
+
+	var snapshot counterSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse counter snapshot %s: %v", c.path, err)
+	}
+	if snapshot.Version > counterSnapshotVersion {
+		return nil, fmt.Errorf("counter snapshot version %d is newer than supported version %d", snapshot.Version, counterSnapshotVersion)
+	}
+	snapshot.Version = counterSnapshotVersion
+	c.snapshot = snapshot
+	return c, nil
+}
+
+// counterSource identifies the data plane counting into a stats map: the
+// boot and the map's kernel ID, which a reload changes. Empty when unknown,
+// leaving resets to be noticed by counters going back.
+func counterSource(statsMap *ebpf.Map) string {
+	if statsMap == nil {
+		return ""
+	}
+	info, err := statsMap.Info()
+	if err != nil {
+		return ""
+	}
+	id, ok := info.ID()
+	if !ok {
+		return ""
+	}
+	boot, err := os.ReadFile(bootIDPath)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", strings.TrimSpace(string(boot)), id)
+}
+
+// read reads the data plane counters and returns the totals. reads are
+// serialized so that a later read never sees lower counters than an
+// earlier one, which would look like a reset.
+func (c *CounterContinuity) read(raw func() (*FirewallStats, error)) (*FirewallStats, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats, err := raw()
+	if err != nil {
+		return stats, err
+	}
+	return c.apply(stats), nil
+}
+
+// apply folds one read into the totals. Caller must hold c.mutex.
+func (c *CounterContinuity) apply(stats *FirewallStats) *FirewallStats {
+	s := &c.snapshot
+	last := s.Last
+	reset := c.source != "" && s.Source != "" && c.source != s.Source ||
+		stats.Pass < last.Pass || stats.Drop < last.Drop || stats.Redirect < last.Redirect || stats.Error < last.Error
+	if reset {
+		s.Offset.Pass += last.Pass
+		s.Offset.Drop += last.Drop
+		s.Offset.Redirect += last.Redirect
+		s.Offset.Error += last.Error
+		s.Resets++
+		log.Printf("Data plane counters were reset, continuing the totals since %s", s.Since.Format(time.RFC3339))
+	}
+	if c.source != "" {
+		s.Source = c.source
+	}
+	if reset || *stats != last {
+		s.Last = *stats
+		c.dirty = true
+	}
+	return &FirewallStats{
+		Pass:     s.Offset.Pass + stats.Pass,
+		Drop:     s.Offset.Drop + stats.Drop,
+		Redirect: s.Offset.Redirect + stats.Redirect,
+		Error:    s.Offset.Error + stats.Error,
+	}
+}
+
+// since returns the start of the totals and the data plane resets within
+// them
+func (c *CounterContinuity) since() (time.Time, uint64) {
+	if c == nil {
+		return time.Time{}, 0
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.snapshot.Since, c.snapshot.Resets
+}
+
+// Save writes the totals to disk if they changed
+func (c *CounterContinuity) Save() error {
+	if c.path == "" {
+		return nil
+	}
+
+	c.mutex.Lock()
+	if !c.dirty {
+		c.mutex.Unlock()
+		return nil
+	}
+	c.snapshot.SavedAt = c.clock.Now().UTC()
+	data, err := json.Marshal(&c.snapshot)
+	c.dirty = false
+	c.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode counter snapshot: %v", err)
+	}
+	return writeFileAtomic(c.path, data)
+}
+
+// Run reads the counters and saves the totals every counterSnapshotInterval
+// until ctx is done
+func (c *CounterContinuity) Run(ctx context.Context, manager *BPFMapManager) {
+	ticker := time.NewTicker(counterSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := manager.GetStats(); err != nil {
+				log.Printf("Failed to read counters for snapshot: %v", err)
+				continue
+			}
+			if err := c.Save(); err != nil {
+				log.Printf("Failed to save counter snapshot: %v", err)
+			}
+		}
+	}
+}
+
+// attachCounters makes the manager's GetStats return totals continued
+// across restarts
+func (bm *BPFMapManager) attachCounters(counters *CounterContinuity) {
+	if bm.simulated {
+		return
+	}
+	counters.mutex.Lock()
+	counters.source = counterSource(bm.statsMap)
+	counters.mutex.Unlock()
+	bm.counters = counters
+}
@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: Apache-2.0
+// Country rules: rules matching the source or destination country of a
+// packet, resolved to prefixes through the GeoIP database
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+)
+
+const (
+	// Pinned country maps (must match eBPF program)
+	GeoIP4MapName         = "cerberus_geo4"
+	GeoIP6MapName         = "cerberus_geo6"
+	RuleCountriesMapName  = "cerberus_rule_countries"
+	MaxGeoIPPrefixes      = 524288 // Per family, must match MAX_GEO_PREFIXES
+	MaxRuleCountryEntries = 4096   // Must match MAX_COUNTRY_SET_ENTRIES
+)
+
+// ruleCountryKey mirrors struct country_set_key in ebpf/xdp_filter.c
+type ruleCountryKey struct {
+	Set     uint32
+	Country uint16
+	Pad     uint16
+}
+
+// geoIPMaps holds the pinned country maps and the prefixes written to them
+type geoIPMaps struct {
+	geo4, geo6 *ebpf.Map
+	sets       *ebpf.Map
+	installed  map[netip.Prefix]uint16 // Country code by prefix
+}
+
+// countryCode packs an ISO 3166 code the way the data plane stores it
+func countryCode(country string) uint16 {
+	return uint16(country[0])<<8 | uint16(country[1])
+}
+
+// canonicalCountries upper-cases a country list, sorts it and removes
+// duplicates
+func canonicalCountries(countries []string) []string {
+	if len(countries) == 0 {
+		return nil
+	}
+	canonical := make([]string, len(countries))
+	for i, country := range countries {
+		canonical[i] = strings.ToUpper(strings.TrimSpace(country))
+	}
+	sort.Strings(canonical)
+	return slices.Compact(canonical)
+}
+
+// ruleCountrySetID is the data plane ID of a rule's country set, the same
+// for every rule with the same countries; 0 = any country
+func ruleCountrySetID(countries []string) uint32 {
+	if len(countries) == 0 {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.Join(canonicalCountries(countries), ",")))
+	if id := hash.Sum32(); id != 0 {
+		return id
+	}
+	return 1
+}
+
+// validateRuleCountries checks the countries of one side of a rule, adding
+// problems to errs, and returns them canonical. Caller must hold s.mutex.
+func (s *Server) validateRuleCountries(rule *FirewallRule, field string, countries []string, errs *ruleValidationError) []string {
+	countries = canonicalCountries(countries)
+	if len(countries) == 0 {
+		return nil
+	}
+	if s.geoIP == nil {
+		errs.addCode(ErrCodeGeoIPMissing, field, "%s needs a GeoIP database (CERBERUS_GEOIP_DB)", field)
+		return countries
+	}
+	for _, country := range countries {
+		switch {
+		case !validCountryCode(country):
+			errs.add(field, "invalid country code %q", country)
+		case !s.geoIP.has(country):
+			errs.add(field, "no prefixes of country %s in %s", country, s.geoIP.path)
+		}
+	}
+	if rule.Namespace != "" || rule.VF != "" {
+		errs.add(field, "countries are only matched by the host data plane")
+	}
+	return countries
+}
+
+// ruleCountries returns the countries host entries of the running version
+// match, sorted. Caller must hold s.mutex.
+func (s *Server) ruleCountries() []string {
+	_, countries := ruleCountryKeys(s.compiledPolicy().Sorted)
+	return countries
+}
+
+// ruleCountryKeys resolves the country sets of host entries to map keys,
+// and returns the countries they reference
+func ruleCountryKeys(entries []*FirewallRule) (map[ruleCountryKey]bool, []string) {
+	keys := make(map[ruleCountryKey]bool)
+	seen := make(map[string]bool)
+	var countries []string
+	for _, entry := range entries {
+		if ruleScope(entry) != "" {
+			continue
+		}
+		for _, set := range [][]string{entry.SrcCountry, entry.DstCountry} {
+			id := ruleCountrySetID(set)
+			for _, country := range set {
+				keys[ruleCountryKey{Set: id, Country: countryCode(country)}] = true
+				if !seen[country] {
+					seen[country] = true
+					countries = append(countries, country)
+				}
+			}
+		}
+	}
+	sort.Strings(countries)
+	return keys, countries
+}
+
+// geoIPPrefixes returns the prefixes of countries in the GeoIP database
+// with each one's country code; a prefix listed for several countries
+// goes to the last. Caller must hold s.mutex.
+func (s *Server) geoIPPrefixes(countries []string) map[netip.Prefix]uint16 {
+	prefixes := make(map[netip.Prefix]uint16)
+	if s.geoIP == nil {
+		return prefixes
+	}
+	for _, country := range countries {
+		for _, prefix := range s.geoIP.countries[country] {
+			prefixes[prefix] = countryCode(country)
+		}
+	}
+	return prefixes
+}
+
+// addRuleCountries writes the country sets of a version's entries, and
+// the prefixes of their countries, to the host data plane before its
+// entries are installed. Caller must hold s.mutex.
+func (s *Server) addRuleCountries(entries []*FirewallRule) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	keys, countries := ruleCountryKeys(entries)
+	return s.bpfManager.AddRuleCountries(keys, s.geoIPPrefixes(countries))
+}
+
+// pruneRuleCountries removes the country sets and prefixes no entry of the
+// running version uses any more. Caller must hold s.mutex.
+func (s *Server) pruneRuleCountries() {
+	if s.bpfManager == nil {
+		return
+	}
+	keys, countries := ruleCountryKeys(s.compiledPolicy().Sorted)
+	if err := s.bpfManager.PruneRuleCountries(keys, s.geoIPPrefixes(countries)); err != nil {
+		log.Printf("⚠️  Failed to prune rule countries: %v", err)
+	}
+}
+
+// openGeoIP opens the pinned country maps, left nil if the program
+// predates them, and clears prefixes left by a previous control plane run;
+// those of the restored rules are written when the policy is pushed
+func (bm *BPFMapManager) openGeoIP() {
+	maps := &geoIPMaps{installed: make(map[netip.Prefix]uint16)}
+	for _, m := range []struct {
+		name   string
+		target **ebpf.Map
+	}{{GeoIP4MapName, &maps.geo4}, {GeoIP6MapName, &maps.geo6}, {RuleCountriesMapName, &maps.sets}} {
+		path := filepath.Join(bm.pinPath, m.name)
+		pinned, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  Country rules not available at %s: %v", path, err)
+			maps.close()
+			return
+		}
+		*m.target = pinned
+	}
+
+	for _, trie := range []*ebpf.Map{maps.geo4, maps.geo6} {
+		var stale [][]byte
+		key := make([]byte, trie.KeySize())
+		var country uint16
+		entries := trie.Iterate()
+		for entries.Next(&key, &country) {
+			stale = append(stale, slices.Clone(key))
+		}
+		if err := entries.Err(); err != nil {
+			log.Printf("⚠️  Failed to read stale GeoIP prefixes: %v", err)
+		}
+		for _, key := range stale {
+			if err := trie.Delete(key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				log.Printf("⚠️  Failed to clear GeoIP prefix %s: %v", lpmKeyPrefix(key), err)
+			}
+		}
+	}
+	bm.geoIP = maps
+}
+
+// trie returns the country map of a prefix's family
+func (maps *geoIPMaps) trie(prefix netip.Prefix) *ebpf.Map {
+	if prefix.Addr().Is6() {
+		return maps.geo6
+	}
+	return maps.geo4
+}
+
+// AddRuleCountries writes country set entries, and prefixes whose country
+// is new or changed
+func (bm *BPFMapManager) AddRuleCountries(keys map[ruleCountryKey]bool, prefixes map[netip.Prefix]uint16) error {
+	if bm.simulated {
+		return nil
+	}
+	if bm.geoIP == nil {
+		if len(keys) > 0 {
+			return withCode(ErrCodeDataPlaneFeature, fmt.Errorf("data plane has no %s map, country rules unavailable", RuleCountriesMapName))
+		}
+		return nil
+	}
+
+	present := uint8(1)
+	for key := range keys {
+		if err := bm.geoIP.sets.Put(&key, &present); err != nil {
+			return fmt.Errorf("failed to add country %c%c to set %d: %v", key.Country>>8, key.Country&0xff, key.Set, err)
+		}
+	}
+	for prefix, country := range prefixes {
+		if installed, ok := bm.geoIP.installed[prefix]; ok && installed == country {
+			continue
+		}
+		if err := bm.geoIP.trie(prefix).Put(lpmKey(prefix), &country); err != nil {
+			return fmt.Errorf("failed to add GeoIP prefix %s: %v", prefix, err)
+		}
+		bm.geoIP.installed[prefix] = country
+	}
+	return nil
+}
+
+// PruneRuleCountries deletes country set entries not in keep and the
+// prefixes not in keepPrefixes
+func (bm *BPFMapManager) PruneRuleCountries(keep map[ruleCountryKey]bool, keepPrefixes map[netip.Prefix]uint16) error {
+	if bm.simulated || bm.geoIP == nil {
+		return nil
+	}
+	var key ruleCountryKey
+	var present uint8
+	var stale []ruleCountryKey
+	entries := bm.geoIP.sets.Iterate()
+	for entries.Next(&key, &present) {
+		if !keep[key] {
+			stale = append(stale, key)
+		}
+	}
+	if err := entries.Err(); err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := bm.geoIP.sets.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove country %c%c from set %d: %v", key.Country>>8, key.Country&0xff, key.Set, err)
+		}
+	}
+	for prefix := range bm.geoIP.installed {
+		if _, ok := keepPrefixes[prefix]; ok {
+			continue
+		}
+		if err := bm.geoIP.trie(prefix).Delete(lpmKey(prefix)); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove GeoIP prefix %s: %v", prefix, err)
+		}
+		delete(bm.geoIP.installed, prefix)
+	}
+	return nil
+}
+
+// GeoIPPrefixes returns the number of prefixes written to the country map
+// of each family
+func (bm *BPFMapManager) GeoIPPrefixes() map[int]int {
+	counts := map[int]int{familyIPv4: 0, familyIPv6: 0}
+	if bm.geoIP == nil {
+		return counts
+	}
+	for prefix := range bm.geoIP.installed {
+		if prefix.Addr().Is6() {
+			counts[familyIPv6]++
+		} else {
+			counts[familyIPv4]++
+		}
+	}
+	return counts
+}
+
+func (maps *geoIPMaps) close() {
+	for _, m := range []*ebpf.Map{maps.geo4, maps.geo6, maps.sets} {
+		if m != nil {
+			m.Close()
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Request deadlines: long data plane operations stop at safe points when their request is cancelled or times out
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// interruptedError reports an operation stopped by its context at a safe
+// point, with how far it got. Work done up to that point has been rolled
+// back unless the operation says otherwise.
+type interruptedError struct {
+	operation string
+	done      int
+	total     int // 0 = unknown
+	unit      string
+	err       error // The context error
+}
+
+func (e *interruptedError) Error() string {
+	if e.total > 0 {
+		return fmt.Sprintf("%s interrupted after %d of %d %s: %v", e.operation, e.done, e.total, e.unit, e.err)
+	}
+	return fmt.Sprintf("%s interrupted after %d %s: %v", e.operation, e.done, e.unit, e.err)
+}
+
+func (e *interruptedError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus makes gRPC handlers returning the error answer with
+// DeadlineExceeded or Canceled and the progress message
+func (e *interruptedError) GRPCStatus() *status.Status {
+	code := codes.Canceled
+	if errors.Is(e.err, context.DeadlineExceeded) {
+		code = codes.DeadlineExceeded
+	}
+	return status.New(code, e.Error())
+}
+
+// checkpoint returns an interruptedError once ctx is done; long operations
+// call it between steps that can safely be abandoned
+func checkpoint(ctx context.Context, operation string, done, total int, unit string) error {
+	if err := ctx.Err(); err != nil {
+		return &interruptedError{operation: operation, done: done, total: total, unit: unit, err: err}
+	}
+	return nil
+}
+
+// asInterrupted returns the interruption an error wraps, if any
+func asInterrupted(err error) (*interruptedError, bool) {
+	var interrupted *interruptedError
+	return interrupted, errors.As(err, &interrupted)
+}
+
+// writeInterrupted answers a REST request whose operation was interrupted:
+// 504 when its deadline passed, 503 when it was cancelled
+func writeInterrupted(w http.ResponseWriter, err *interruptedError) {
+	code := http.StatusServiceUnavailable
+	if errors.Is(err, context.DeadlineExceeded) {
+		code = http.StatusGatewayTimeout
+	}
+	http.Error(w, withRemediation(err).Error(), code)
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// Default policy: the verdict of packets no rule matches, per direction and interface
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"path/filepath"
+	"sort"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned default policy map name (must match eBPF program)
+	DefaultPolicyMapName = "cerberus_default_policy"
+
+	// EventDefaultPolicyChanged reports a SetDefaultPolicy change
+	EventDefaultPolicyChanged = "DEFAULT_POLICY_CHANGED"
+
+	// Default actions. Built-in keeps the data plane's fixed defaults:
+	// inbound IPv4 ICMP is dropped, TCP redirected to AF_XDP and the rest
+	// passed; outbound packets are passed.
+	DefaultActionBuiltin = "builtin"
+	DefaultActionAllow   = "allow"
+	DefaultActionDrop    = "drop"
+)
+
+// DefaultPolicy is the verdict of packets no rule matches on one interface,
+// or on every interface without its own policy when Interface is empty.
+// An empty action is the built-in default. A drop default also drops
+// replies to outbound connections unless a rule allows established
+// traffic.
+type DefaultPolicy struct {
+	Interface string `json:"interface"`
+	Inbound   string `json:"inbound,omitempty"`
+	Outbound  string `json:"outbound,omitempty"`
+}
+
+// defaultPolicyValue mirrors struct default_policy in the eBPF program
+type defaultPolicyValue struct {
+	Ingress uint8
+	Egress  uint8
+	_       [2]uint8
+}
+
+func defaultActionToUint8(action string) uint8 {
+	switch action {
+	case DefaultActionAllow:
+		return 1
+	case DefaultActionDrop:
+		return 2
+	default:
+		return 0 // built-in
+	}
+}
+
+func defaultActionName(action string) string {
+	if action == "" {
+		return DefaultActionBuiltin
+	}
+	return action
+}
+
+// SetDefaultPolicy sets the verdict of packets no rule matches for one
+// direction or both, on one interface or all of them
+func (s *Server) SetDefaultPolicy(ctx context.Context, req *pb.SetDefaultPolicyRequest) (*pb.DefaultPolicyResponse, error) {
+	var errs ruleValidationError
+	if req.Direction != "inbound" && req.Direction != "outbound" && req.Direction != "both" {
+		errs.add("direction", "invalid direction: %q, expected inbound, outbound or both", req.Direction)
+	}
+	if req.Action != DefaultActionAllow && req.Action != DefaultActionDrop && req.Action != DefaultActionBuiltin {
+		errs.add("action", "invalid action: %q, expected allow, drop or builtin", req.Action)
+	}
+	if req.Interface != "" {
+		if _, err := net.InterfaceByName(req.Interface); err != nil {
+			errs.add("interface", "unknown interface %s: %v", req.Interface, err)
+		}
+	}
+	if len(errs) > 0 {
+		return &pb.DefaultPolicyResponse{Success: false, Message: fmt.Sprintf("Default policy validation failed: %v", errs)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	policy := &DefaultPolicy{Interface: req.Interface}
+	if previous := s.defaultPolicies[req.Interface]; previous != nil {
+		*policy = *previous
+	}
+	action := req.Action
+	if action == DefaultActionBuiltin {
+		action = ""
+	}
+	if req.Direction != "outbound" {
+		policy.Inbound = action
+	}
+	if req.Direction != "inbound" {
+		policy.Outbound = action
+	}
+
+	if err := s.pushDefaultPolicy(policy); err != nil {
+		return &pb.DefaultPolicyResponse{Success: false, Message: fmt.Sprintf("Failed to push default policy to data plane: %v", withRemediation(err))}, nil
+	}
+	if policy.Inbound == "" && policy.Outbound == "" {
+		delete(s.defaultPolicies, policy.Interface)
+	} else {
+		s.defaultPolicies[policy.Interface] = policy
+	}
+	s.persistPolicy()
+
+	scope := "all interfaces"
+	if policy.Interface != "" {
+		scope = policy.Interface
+	}
+	message := fmt.Sprintf("default policy on %s: inbound %s, outbound %s",
+		scope, defaultActionName(policy.Inbound), defaultActionName(policy.Outbound))
+	s.events.Publish(&pb.Event{Type: EventDefaultPolicyChanged, Message: message, Severity: "medium"})
+	log.Printf("🛡️  Set %s", message)
+
+	return &pb.DefaultPolicyResponse{Success: true, Message: "Default policy set successfully", Policies: s.defaultPolicyProtos()}, nil
+}
+
+// pushDefaultPolicy writes a policy to the host data plane, removing its
+// entry when both directions are built-in. Caller must hold s.mutex.
+func (s *Server) pushDefaultPolicy(policy *DefaultPolicy) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	var ifindex uint32
+	if policy.Interface != "" {
+		iface, err := net.InterfaceByName(policy.Interface)
+		if err != nil {
+			return fmt.Errorf("interface %s: %v", policy.Interface, err)
+		}
+		ifindex = uint32(iface.Index)
+	}
+	if policy.Inbound == "" && policy.Outbound == "" {
+		return s.bpfManager.DeleteDefaultPolicy(ifindex)
+	}
+	return s.bpfManager.SetDefaultPolicy(ifindex, defaultPolicyValue{
+		Ingress: defaultActionToUint8(policy.Inbound),
+		Egress:  defaultActionToUint8(policy.Outbound),
+	})
+}
+
+// defaultPolicyProtos lists the policy for all interfaces, built-in when
+// none is set, then each interface's own. Caller must hold s.mutex.
+func (s *Server) defaultPolicyProtos() []*pb.DefaultPolicy {
+	names := make([]string, 0, len(s.defaultPolicies))
+	for name := range s.defaultPolicies {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	policies := []*pb.DefaultPolicy{defaultPolicyToProto(s.defaultPolicyFor(""))}
+	for _, name := range names {
+		policies = append(policies, defaultPolicyToProto(s.defaultPolicies[name]))
+	}
+	return policies
+}
+
+// defaultPolicyFor returns an interface's own policy, or the policy for all
+// interfaces when it has none. Caller must hold s.mutex.
+func (s *Server) defaultPolicyFor(iface string) *DefaultPolicy {
+	if policy := s.defaultPolicies[iface]; policy != nil {
+		return policy
+	}
+	if policy := s.defaultPolicies[""]; policy != nil {
+		return policy
+	}
+	return &DefaultPolicy{Interface: iface}
+}
+
+func defaultPolicyToProto(policy *DefaultPolicy) *pb.DefaultPolicy {
+	return &pb.DefaultPolicy{
+		Interface: policy.Interface,
+		Inbound:   defaultActionName(policy.Inbound),
+		Outbound:  defaultActionName(policy.Outbound),
+	}
+}
+
+// openDefaultPolicy opens the pinned default policy map and clears entries
+// left by a previous control plane run; stored policies are re-pushed on
+// restore
+func (bm *BPFMapManager) openDefaultPolicy() {
+	path := filepath.Join(bm.pinPath, DefaultPolicyMapName)
+	policies, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Default policy not available at %s: %v", path, err)
+		return
+	}
+
+	var ifindex uint32
+	var stale []uint32
+	var value defaultPolicyValue
+	entries := policies.Iterate()
+	for entries.Next(&ifindex, &value) {
+		stale = append(stale, ifindex)
+	}
+	for _, key := range stale {
+		if err := policies.Delete(&key); err != nil {
+			log.Printf("⚠️  Failed to clear default policy of interface %d: %v", key, err)
+		}
+	}
+	bm.defaultPolicy = policies
+}
+
+// SetDefaultPolicy writes the default actions of an interface, 0 = all
+func (bm *BPFMapManager) SetDefaultPolicy(ifindex uint32, value defaultPolicyValue) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting default policy of interface %d: ingress %d, egress %d", ifindex, value.Ingress, value.Egress)
+		return nil
+	}
+	if bm.defaultPolicy == nil {
+		return fmt.Errorf("default policy map not available")
+	}
+	if err := bm.defaultPolicy.Put(&ifindex, &value); err != nil {
+		return fmt.Errorf("failed to write default policy of interface %d: %v", ifindex, err)
+	}
+	return nil
+}
+
+// DeleteDefaultPolicy restores the built-in defaults of an interface
+func (bm *BPFMapManager) DeleteDefaultPolicy(ifindex uint32) error {
+	if bm.simulated || bm.defaultPolicy == nil {
+		return nil
+	}
+	if err := bm.defaultPolicy.Delete(&ifindex); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to clear default policy of interface %d: %v", ifindex, err)
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: Apache-2.0
+// Degradation ladder: shed sampling, slow-path inspection and rules, in configured stages, under resource pressure
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// DegradationMapName holds the data plane degradation flags (must match eBPF program)
+const DegradationMapName = "cerberus_degrade"
+
+// EventDegradationChanged reports a step up or down the ladder
+const EventDegradationChanged = "DEGRADATION_CHANGED"
+
+// Degradation flags, mirroring enum degrade_flags in ebpf/xdp_filter.c
+const (
+	DegradeNoSampling  = 1 << 0
+	DegradeNoSlowPath  = 1 << 1
+	DegradeDefaultOnly = 1 << 2
+)
+
+// Degradation stages. Each stage keeps the flags of the stages before it.
+const (
+	DegradeStageNormal      = "normal"
+	DegradeStageNoSampling  = "no_sampling"  // Stop packet sampling
+	DegradeStageNoSlowPath  = "no_slow_path" // Pass instead of redirecting to AF_XDP inspection
+	DegradeStageDefaultOnly = "default_only" // Enforce the built-in default policy only
+)
+
+var degradeStageFlags = map[string]uint32{
+	DegradeStageNoSampling:  DegradeNoSampling,
+	DegradeStageNoSlowPath:  DegradeNoSlowPath,
+	DegradeStageDefaultOnly: DegradeDefaultOnly,
+}
+
+// Ladder defaults, overridden with CERBERUS_DEGRADE_STAGES,
+// CERBERUS_DEGRADE_CPU and CERBERUS_DEGRADE_MAP
+const (
+	DefaultDegradeStages = DegradeStageNoSampling + "," + DegradeStageNoSlowPath + "," + DegradeStageDefaultOnly
+	DefaultDegradeCPU    = 0.5 // Share of CPU time spent in softirq, where XDP runs
+	DefaultDegradeMap    = 0.9 // Flow table occupancy
+
+	// Pressure must fall below this share of its threshold for
+	// degradeRecoverTicks evaluations before the ladder steps down
+	degradeRecoverRatio = 0.8
+	degradeRecoverTicks = 3
+)
+
+// DegradationConfig is the configured ladder
+type DegradationConfig struct {
+	Stages       []string // Entered in order; empty = never degrade
+	CPUBudget    float64
+	MapThreshold float64
+}
+
+// DegradationStatus is a snapshot of the ladder
+type DegradationStatus struct {
+	Stage    string    `json:"stage"`
+	Level    int       `json:"level"` // 0 = normal, n = Stages[n-1]
+	Stages   []string  `json:"stages"`
+	Flags    uint32    `json:"flags"`
+	Reason   string    `json:"reason,omitempty"`
+	Since    time.Time `json:"since"`
+	CPU      float64   `json:"cpu"`       // Softirq share at the last evaluation
+	MapUsage float64   `json:"map_usage"` // Fullest flow table at the last evaluation
+}
+
+// degradationConfigFromEnv reads the ladder stages and pressure thresholds
+func degradationConfigFromEnv() (DegradationConfig, error) {
+	config := DegradationConfig{CPUBudget: DefaultDegradeCPU, MapThreshold: DefaultDegradeMap}
+
+	stages := DefaultDegradeStages
+	if value, set := os.LookupEnv("CERBERUS_DEGRADE_STAGES"); set {
+		stages = value
+	}
+	seen := make(map[string]bool)
+	for _, stage := range strings.Split(stages, ",") {
+		stage = strings.TrimSpace(stage)
+		if stage == "" {
+			continue
+		}
+		if _, known := degradeStageFlags[stage]; !known {
+			return config, fmt.Errorf("invalid CERBERUS_DEGRADE_STAGES stage %q, expected %s", stage, DefaultDegradeStages)
+		}
+		if seen[stage] {
+			return config, fmt.Errorf("duplicate CERBERUS_DEGRADE_STAGES stage %q", stage)
+		}
+		seen[stage] = true
+		config.Stages = append(config.Stages, stage)
+	}
+
+	for name, value := range map[string]*float64{"CERBERUS_DEGRADE_CPU": &config.CPUBudget, "CERBERUS_DEGRADE_MAP": &config.MapThreshold} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed <= 0 || parsed > 1 {
+			return config, fmt.Errorf("invalid %s %q, expected a fraction in (0, 1]", name, raw)
+		}
+		*value = parsed
+	}
+	return config, nil
+}
+
+// DegradationLadder steps the data planes through the configured stages
+// while CPU or flow table pressure lasts, one stage per evaluation, and
+// back down once pressure has eased for a while. Rule tables are not
+// watched: they only fill through configuration, which is refused when
+// full.
+type DegradationLadder struct {
+	server *Server
+	config DegradationConfig
+
+	mutex    sync.Mutex
+	level    int
+	calm     int // Consecutive evaluations without pressure
+	reason   string
+	since    time.Time
+	cpu      float64
+	mapUsage float64
+	lastCPU  cpuTimes
+}
+
+// cpuTimes are the softirq and total jiffies of all CPUs
+type cpuTimes struct {
+	softirq uint64
+	total   uint64
+}
+
+// NewDegradationLadder creates a ladder over the server's data planes
+func NewDegradationLadder(server *Server, config DegradationConfig) *DegradationLadder {
+	return &DegradationLadder{server: server, config: config, since: server.clock.Now()}
+}
+
+// Run evaluates pressure every interval until ctx is done, then returns
+// the data planes to normal operation
+func (d *DegradationLadder) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	d.evaluate()
+	for {
+		select {
+		case <-ctx.Done():
+			d.server.setDegradation(0)
+			return
+		case <-ticker.C:
+			d.evaluate()
+		}
+	}
+}
+
+// Status returns the current stage and the last pressure readings
+func (d *DegradationLadder) Status() DegradationStatus {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return DegradationStatus{
+		Stage:    d.stage(d.level),
+		Level:    d.level,
+		Stages:   d.config.Stages,
+		Flags:    d.flags(d.level),
+		Reason:   d.reason,
+		Since:    d.since,
+		CPU:      d.cpu,
+		MapUsage: d.mapUsage,
+	}
+}
+
+func (d *DegradationLadder) stage(level int) string {
+	if level == 0 {
+		return DegradeStageNormal
+	}
+	return d.config.Stages[level-1]
+}
+
+func (d *DegradationLadder) flags(level int) uint32 {
+	var flags uint32
+	for _, stage := range d.config.Stages[:level] {
+		flags |= degradeStageFlags[stage]
+	}
+	return flags
+}
+
+// evaluate reads the pressure, moves at most one stage and writes the
+// flags of the current stage to every data plane. They are written on
+// every pass, the pinned maps may hold the flags of an earlier control
+// plane.
+func (d *DegradationLadder) evaluate() {
+	times, err := readCPUTimes()
+	if err != nil {
+		log.Printf("Failed to read CPU time for degradation: %v", err)
+	}
+	mapUsage := d.server.flowTableUsage()
+
+	d.mutex.Lock()
+	var cpu float64
+	if d.lastCPU.total != 0 && times.total > d.lastCPU.total && times.softirq >= d.lastCPU.softirq {
+		cpu = float64(times.softirq-d.lastCPU.softirq) / float64(times.total-d.lastCPU.total)
+	}
+	if err == nil {
+		d.lastCPU = times
+	}
+	d.cpu, d.mapUsage = cpu, mapUsage
+
+	var reasons []string
+	if cpu >= d.config.CPUBudget {
+		reasons = append(reasons, fmt.Sprintf("softirq CPU %.0f%% over budget %.0f%%", cpu*100, d.config.CPUBudget*100))
+	}
+	if mapUsage >= d.config.MapThreshold {
+		reasons = append(reasons, fmt.Sprintf("flow table %.0f%% full, threshold %.0f%%", mapUsage*100, d.config.MapThreshold*100))
+	}
+	eased := cpu < d.config.CPUBudget*degradeRecoverRatio && mapUsage < d.config.MapThreshold*degradeRecoverRatio
+
+	previous := d.level
+	switch {
+	case len(reasons) > 0:
+		d.calm = 0
+		if d.level < len(d.config.Stages) {
+			d.level++
+			d.reason = strings.Join(reasons, "; ")
+		}
+	case eased && d.level > 0:
+		d.calm++
+		if d.calm >= degradeRecoverTicks {
+			d.calm = 0
+			d.level--
+			d.reason = fmt.Sprintf("pressure eased: softirq CPU %.0f%%, flow table %.0f%% full", cpu*100, mapUsage*100)
+		}
+	default:
+		d.calm = 0
+	}
+	level, reason := d.level, d.reason
+	if level != previous {
+		d.since = d.server.clock.Now()
+	}
+	d.mutex.Unlock()
+
+	d.server.setDegradation(d.flags(level))
+	if level != previous {
+		d.announce(previous, level, reason)
+	}
+}
+
+// announce logs and publishes a stage transition
+func (d *DegradationLadder) announce(previous, level int, reason string) {
+	from, to := d.stage(previous), d.stage(level)
+	event := &pb.Event{
+		Type: EventDegradationChanged,
+		Metadata: map[string]string{
+			"stage":    to,
+			"previous": from,
+			"reason":   reason,
+		},
+	}
+	if level > previous {
+		log.Printf("⚠️  Degrading data plane from %s to %s: %s", from, to, reason)
+		event.Message = fmt.Sprintf("Data plane degraded to %s: %s", to, reason)
+		event.Severity = "high"
+	} else {
+		log.Printf("✅ Data plane recovering from %s to %s: %s", from, to, reason)
+		event.Message = fmt.Sprintf("Data plane recovered to %s: %s", to, reason)
+		event.Severity = "medium"
+	}
+	d.server.events.Publish(event)
+}
+
+// readCPUTimes reads the aggregate CPU line of /proc/stat
+func readCPUTimes() (cpuTimes, error) {
+	file, err := os.Open("/proc/stat")
+	if err != nil {
+		return cpuTimes{}, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 || fields[0] != "cpu" {
+			continue
+		}
+		// user nice system idle iowait irq softirq [steal ...]
+		var times cpuTimes
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return cpuTimes{}, fmt.Errorf("malformed /proc/stat: %v", err)
+			}
+			// guest and guest_nice are already part of user and nice
+			if i < 8 {
+				times.total += value
+			}
+			if i == 6 {
+				times.softirq = value
+			}
+		}
+		return times, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return cpuTimes{}, err
+	}
+	return cpuTimes{}, fmt.Errorf("no cpu line in /proc/stat")
+}
+
+// openDegradation opens the pinned degradation flags. Programs predating
+// them cannot degrade.
+func (bm *BPFMapManager) openDegradation() {
+	path := filepath.Join(bm.pinPath, DegradationMapName)
+	degrade, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Degradation ladder not available at %s: %v", path, err)
+		return
+	}
+	bm.degrade = degrade
+}
+
+// SetDegradation writes the degradation flags of the data plane
+func (bm *BPFMapManager) SetDegradation(flags uint32) error {
+	if bm.simulated || bm.degrade == nil {
+		return nil
+	}
+	key := uint32(0)
+	if err := bm.degrade.Put(&key, &flags); err != nil {
+		return fmt.Errorf("failed to set degradation flags: %v", err)
+	}
+	return nil
+}
+
+// FlowTableUsage returns the share of the flow table in use, 0 without one
+func (bm *BPFMapManager) FlowTableUsage() (float64, error) {
+	capacity := bm.ConntrackCapacity()
+	if bm.simulated || capacity == 0 {
+		return 0, nil
+	}
+	connections, err := bm.Connections(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	return float64(len(connections)) / float64(capacity), nil
+}
+
+// allDataPlanes returns every data plane, host first. Caller must hold s.mutex.
+func (s *Server) allDataPlanes() []*BPFMapManager {
+	var managers []*BPFMapManager
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		if manager := s.dataPlaneFor(scope); manager != nil {
+			managers = append(managers, manager)
+		}
+	}
+	return managers
+}
+
+// flowTableUsage returns the usage of the fullest flow table
+func (s *Server) flowTableUsage() float64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var usage float64
+	for _, manager := range s.allDataPlanes() {
+		managerUsage, err := manager.FlowTableUsage()
+		if err != nil {
+			log.Printf("Failed to read flow table usage: %v", err)
+			continue
+		}
+		usage = math.Max(usage, managerUsage)
+	}
+	return usage
+}
+
+// setDegradation writes the degradation flags to every data plane
+func (s *Server) setDegradation(flags uint32) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, manager := range s.allDataPlanes() {
+		if err := manager.SetDegradation(flags); err != nil {
+			log.Printf("⚠️  %v", err)
+		}
+	}
+}
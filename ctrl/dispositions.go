@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Packet dispositions: where in the pipeline each packet got its verdict, and why
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Pinned disposition and stage counters (must match eBPF program)
+const (
+	DispositionsMapName = "cerberus_dispositions"
+	StageStatsMapName   = "cerberus_stage_stats"
+)
+
+// dispositionNames are the dispositions by value (must match enum disposition)
+var dispositionNames = []string{
+	"parse_error", // Truncated header
+	"non_ip",      // Neither IPv4 nor IPv6
+	"rule",        // A rule matched
+	"conntrack",   // A rule matched on the packet's connection state
+	"ipset",       // Addresses hit rule prefixes, no rule matched the rest
+	"default",     // Addresses hit no rule prefix, built-in defaults
+	"degraded",    // Rules skipped by the degradation ladder
+	"normalized",  // Dropped by packet normalization
+	"policed",     // Dropped by the ingress policer of the interface
+}
+
+// dispositionVerdicts are the XDP actions counted per disposition, by value
+var dispositionVerdicts = []string{"aborted", "drop", "pass", "tx", "redirect"}
+
+// DispositionCount is the number of packets of one disposition and verdict
+type DispositionCount struct {
+	Disposition string
+	Verdict     string
+	Packets     uint64
+}
+
+// PipelineCounters are the disposition and stage counters of a data plane,
+// summed across CPUs
+type PipelineCounters struct {
+	Dispositions []DispositionCount // Every disposition and verdict, in order
+	Stages       []uint64           // Packets entering each stage, by index
+	Inline       uint64             // Packets filtered without the pipeline
+}
+
+// openDispositions opens the pinned disposition and stage counters.
+// Programs predating them only count verdicts.
+func (bm *BPFMapManager) openDispositions() {
+	path := filepath.Join(bm.pinPath, DispositionsMapName)
+	dispositions, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Packet dispositions not available at %s: %v", path, err)
+		return
+	}
+	path = filepath.Join(bm.pinPath, StageStatsMapName)
+	stageStats, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Pipeline stage counters not available at %s: %v", path, err)
+		dispositions.Close()
+		return
+	}
+	bm.dispositions, bm.stageStats = dispositions, stageStats
+}
+
+// sumPerCPU reads one per-CPU counter summed across CPUs
+func sumPerCPU(m *ebpf.Map, key uint32) (uint64, error) {
+	var perCPU []uint64
+	if err := m.Lookup(&key, &perCPU); err != nil {
+		return 0, err
+	}
+	var sum uint64
+	for _, value := range perCPU {
+		sum += value
+	}
+	return sum, nil
+}
+
+// PipelineCounters reads the disposition and stage counters; they are all
+// zero when simulated or when the program predates them
+func (bm *BPFMapManager) PipelineCounters() (*PipelineCounters, error) {
+	counters := &PipelineCounters{
+		Dispositions: make([]DispositionCount, 0, len(dispositionNames)*len(dispositionVerdicts)),
+		Stages:       make([]uint64, len(pipelineStages)),
+	}
+	for disposition, name := range dispositionNames {
+		for verdict, verdictName := range dispositionVerdicts {
+			count := DispositionCount{Disposition: name, Verdict: verdictName}
+			if bm.dispositions != nil {
+				key := uint32(disposition*len(dispositionVerdicts) + verdict)
+				packets, err := sumPerCPU(bm.dispositions, key)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read disposition %s/%s: %v", name, verdictName, err)
+				}
+				count.Packets = packets
+			}
+			counters.Dispositions = append(counters.Dispositions, count)
+		}
+	}
+	if bm.stageStats == nil {
+		return counters, nil
+	}
+
+	for index, name := range pipelineStages {
+		packets, err := sumPerCPU(bm.stageStats, uint32(index))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stage %s counter: %v", name, err)
+		}
+		counters.Stages[index] = packets
+	}
+	inline, err := sumPerCPU(bm.stageStats, uint32(len(pipelineStages)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inline counter: %v", err)
+	}
+	counters.Inline = inline
+	return counters, nil
+}
+
+// closeDispositions releases the disposition and stage counters
+func (bm *BPFMapManager) closeDispositions() {
+	if bm.dispositions != nil {
+		bm.dispositions.Close()
+	}
+	if bm.stageStats != nil {
+		bm.stageStats.Close()
+	}
+}
+
+// addPipelineCounters fills the counters of a pipeline response; only
+// dispositions that occurred are listed
+func addPipelineCounters(resp *pb.PipelineResponse, counters *PipelineCounters) {
+	for index, stage := range resp.Stages {
+		if index < len(counters.Stages) {
+			stage.Packets = counters.Stages[index]
+		}
+	}
+	resp.InlinePackets = counters.Inline
+	for _, count := range counters.Dispositions {
+		if count.Packets == 0 {
+			continue
+		}
+		resp.Dispositions = append(resp.Dispositions, &pb.PacketDisposition{
+			Disposition: count.Disposition,
+			Verdict:     count.Verdict,
+			Packets:     count.Packets,
+		})
+	}
+}
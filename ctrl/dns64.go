@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// DNS64 proxy: forwards queries to a resolver and synthesizes AAAA records
+// in a NAT64 prefix for names that only have A records (RFC 6147)
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	dns64MaxMessage      = 65535
+	dns64MaxInFlight     = 256 // Queries answered at once; more are dropped
+	dns64UpstreamTimeout = 5 * time.Second
+)
+
+// DNS64Config configures the DNS64 proxy, read from CERBERUS_DNS64_*
+// variables. The proxy is off unless a listen address is set.
+type DNS64Config struct {
+	Listen   string // UDP host:port to answer on
+	Upstream string // Resolver host:port queries are forwarded to
+}
+
+// dns64ConfigFromEnv reads the DNS64 configuration
+func dns64ConfigFromEnv() (DNS64Config, error) {
+	config := DNS64Config{
+		Listen:   os.Getenv("CERBERUS_DNS64_LISTEN"),
+		Upstream: os.Getenv("CERBERUS_DNS64_UPSTREAM"),
+	}
+	if config.Listen == "" {
+		return config, nil
+	}
+	if _, _, err := net.SplitHostPort(config.Listen); err != nil {
+		return config, fmt.Errorf("invalid CERBERUS_DNS64_LISTEN %q, expected host:port", config.Listen)
+	}
+	if _, _, err := net.SplitHostPort(config.Upstream); err != nil {
+		return config, fmt.Errorf("invalid CERBERUS_DNS64_UPSTREAM %q, expected host:port of a resolver", config.Upstream)
+	}
+	return config, nil
+}
+
+// DNS64Proxy answers DNS queries over UDP by forwarding them upstream.
+// AAAA queries for names without AAAA records are answered with the
+// name's A records mapped into the first NAT64 prefix, so IPv6-only
+// clients connect through NAT64.
+type DNS64Proxy struct {
+	server *Server
+	config DNS64Config
+
+	mutex       sync.Mutex
+	forwarded   uint64 // Upstream answer returned as is
+	synthesized uint64
+	failed      uint64 // Unanswered: upstream failed or too many in flight
+}
+
+// DNS64Status is a snapshot of the proxy's counters
+type DNS64Status struct {
+	Forwarded   uint64 `json:"forwarded"`
+	Synthesized uint64 `json:"synthesized"`
+	Failed      uint64 `json:"failed"`
+}
+
+// NewDNS64Proxy creates a proxy synthesizing addresses in the NAT64
+// prefixes of server
+func NewDNS64Proxy(server *Server, config DNS64Config) *DNS64Proxy {
+	return &DNS64Proxy{server: server, config: config}
+}
+
+// Run answers queries until ctx is done
+func (p *DNS64Proxy) Run(ctx context.Context) {
+	conn, err := net.ListenPacket("udp", p.config.Listen)
+	if err != nil {
+		log.Printf("⚠️  DNS64 proxy disabled: %v", err)
+		return
+	}
+	log.Printf("DNS64 proxy listening on %s, forwarding to %s", p.config.Listen, p.config.Upstream)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	inFlight := make(chan struct{}, dns64MaxInFlight)
+	for {
+		buf := make([]byte, dns64MaxMessage)
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return
+			}
+			log.Printf("⚠️  DNS64 read failed: %v", err)
+			continue
+		}
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			p.count(&p.failed)
+			continue
+		}
+		go func() {
+			defer func() { <-inFlight }()
+			if response := p.answer(ctx, buf[:n]); response != nil {
+				conn.WriteTo(response, addr)
+			}
+		}()
+	}
+}
+
+// Status returns the proxy's counters
+func (p *DNS64Proxy) Status() DNS64Status {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return DNS64Status{Forwarded: p.forwarded, Synthesized: p.synthesized, Failed: p.failed}
+}
+
+func (p *DNS64Proxy) count(counter *uint64) {
+	p.mutex.Lock()
+	*counter++
+	p.mutex.Unlock()
+}
+
+// answer returns the response to a query, nil if there is none
+func (p *DNS64Proxy) answer(ctx context.Context, query []byte) []byte {
+	response, err := p.exchange(ctx, query)
+	if err != nil {
+		p.count(&p.failed)
+		return nil
+	}
+	if synthesized := p.synthesize(ctx, query, response); synthesized != nil {
+		p.count(&p.synthesized)
+		return synthesized
+	}
+	p.count(&p.forwarded)
+	return response
+}
+
+// synthesize returns the DNS64 answer to an AAAA query whose upstream
+// response has no AAAA records, nil when the response stands as is
+func (p *DNS64Proxy) synthesize(ctx context.Context, query, response []byte) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil || header.Response {
+		return nil
+	}
+	question, err := parser.Question()
+	if err != nil || question.Type != dnsmessage.TypeAAAA || question.Class != dnsmessage.ClassINET {
+		return nil
+	}
+	prefix, ok := p.server.dns64Prefix()
+	if !ok {
+		return nil
+	}
+
+	// Only successful answers without AAAA records are synthesized;
+	// NXDOMAIN and errors are the client's answer too (RFC 6147 5.1.2)
+	var upstream dnsmessage.Message
+	if err := upstream.Unpack(response); err != nil || upstream.RCode != dnsmessage.RCodeSuccess || upstream.Truncated {
+		return nil
+	}
+	for _, answer := range upstream.Answers {
+		if answer.Header.Type == dnsmessage.TypeAAAA {
+			return nil
+		}
+	}
+
+	aQuery := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: header.ID ^ 0x6464, RecursionDesired: header.RecursionDesired},
+		Questions: []dnsmessage.Question{{
+			Name:  question.Name,
+			Type:  dnsmessage.TypeA,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	packed, err := aQuery.Pack()
+	if err != nil {
+		return nil
+	}
+	aResponse, err := p.exchange(ctx, packed)
+	if err != nil {
+		return nil
+	}
+	var records dnsmessage.Message
+	if err := records.Unpack(aResponse); err != nil || records.RCode != dnsmessage.RCodeSuccess {
+		return nil
+	}
+
+	// The A answer with each address moved into the prefix, keeping the
+	// CNAME chain and TTLs
+	synthesized := dnsmessage.Message{Header: records.Header, Questions: []dnsmessage.Question{question}}
+	synthesized.ID = header.ID
+	found := false
+	for _, answer := range records.Answers {
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			answer.Header.Type = dnsmessage.TypeAAAA
+			answer.Body = &dnsmessage.AAAAResource{AAAA: nat64Address(prefix, body.A)}
+			found = true
+		case *dnsmessage.CNAMEResource:
+		default:
+			continue
+		}
+		synthesized.Answers = append(synthesized.Answers, answer)
+	}
+	if !found {
+		return nil
+	}
+	packed, err = synthesized.Pack()
+	if err != nil {
+		return nil
+	}
+	return packed
+}
+
+// exchange forwards a query upstream and returns the response with its ID
+func (p *DNS64Proxy) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	if len(query) < 2 {
+		return nil, fmt.Errorf("short query")
+	}
+	ctx, cancel := context.WithTimeout(ctx, dns64UpstreamTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", p.config.Upstream)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, dns64MaxMessage)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		if n >= 2 && buf[0] == query[0] && buf[1] == query[1] {
+			return buf[:n], nil
+		}
+	}
+}
+
+// nat64Address embeds an IPv4 address in the last 32 bits of a /96
+// NAT64 prefix
+func nat64Address(prefix netip.Prefix, v4 [4]byte) [16]byte {
+	addr := prefix.Addr().As16()
+	copy(addr[12:], v4[:])
+	return addr
+}
+
+// dns64Prefix returns the NAT64 prefix DNS64 synthesizes addresses in,
+// the first by prefix order
+func (s *Server) dns64Prefix() (netip.Prefix, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	prefixes := s.sortedNAT64Prefixes()
+	if len(prefixes) == 0 {
+		return netip.Prefix{}, false
+	}
+	return prefixes[0].prefix, true
+}
@@ -0,0 +1,699 @@
+// SPDX-License-Identifier: Apache-2.0
+// DNS filtering: allow and deny domain lists the XDP program checks the
+// queries it passes against, and the reports of the queries it blocks
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// Pinned DNS filter maps (must match eBPF program)
+	DNSConfigMapName  = "cerberus_dns_config"
+	DNSDomainsMapName = "cerberus_dns_domains"
+	DNSQueriesMapName = "cerberus_dns_queries"
+	DNSStatsMapName   = "cerberus_dns_stats"
+
+	// Domains of all lists together (must match MAX_DNS_DOMAINS in eBPF
+	// program)
+	MaxDNSDomains = 256 * 1024
+
+	// Wire name bytes the data plane copies (must match DNS_NAME_MAX in
+	// eBPF program)
+	dnsNameMax = 256
+)
+
+// DNS filter events
+const (
+	EventDNSQueryBlocked = "DNS_QUERY_BLOCKED"
+	EventDNSQuery        = "DNS_QUERY" // Passed, reported with CERBERUS_DNS_REPORT=all
+)
+
+// Domain list actions
+const (
+	DomainListAllow = "allow"
+	DomainListDeny  = "deny"
+)
+
+// Handling of blocked queries, set with CERBERUS_DNS_BLOCK
+const (
+	DNSBlockDrop     = "drop"
+	DNSBlockNXDomain = "nxdomain" // Answered from the interface they came in on
+)
+
+// Queries reported as events, set with CERBERUS_DNS_REPORT
+const (
+	DNSReportNone    = "none"
+	DNSReportBlocked = "blocked"
+	DNSReportAll     = "all"
+)
+
+// FNV-1a, 64-bit, hashing wire names from their end (must match
+// DNS_HASH_OFFSET and DNS_HASH_PRIME in eBPF program)
+const (
+	dnsHashOffset = 0xcbf29ce484222325
+	dnsHashPrime  = 0x100000001b3
+)
+
+// Data plane encodings of the settings above
+var (
+	dnsActionCodes = map[string]uint8{DomainListAllow: 1, DomainListDeny: 2}
+	dnsBlockCodes  = map[string]uint8{DNSBlockDrop: 0, DNSBlockNXDomain: 1}
+	dnsReportCodes = map[string]uint8{DNSReportNone: 0, DNSReportBlocked: 1, DNSReportAll: 2}
+	dnsVerdicts    = []string{"pass", "drop", "nxdomain"}
+)
+
+// DomainList is a named list of domains whose queries are allowed or
+// denied. A domain also covers its subdomains; the longest listed suffix
+// of a queried name decides.
+type DomainList struct {
+	Name        string   `json:"name"`
+	Action      string   `json:"action"` // "allow" or "deny"
+	Domains     []string `json:"domains"`
+	Description string   `json:"description"`
+
+	id uint32 // Reported with the queries it matches, reallocated on restore
+}
+
+// DNSFilterConfig is how the data plane treats queries, the same for
+// every list
+type DNSFilterConfig struct {
+	Block   string // DNSBlockDrop or DNSBlockNXDomain
+	Report  string // DNSReportNone, DNSReportBlocked or DNSReportAll
+	Default string // Action of queries no list matches
+}
+
+// dnsConfig mirrors struct dns_config in the eBPF program
+type dnsConfig struct {
+	Enabled     uint8
+	Block       uint8
+	Punt        uint8
+	DefaultDeny uint8
+}
+
+// dnsDomain mirrors struct dns_domain in the eBPF program
+type dnsDomain struct {
+	ListID uint32
+	Action uint8
+	Pad    [3]uint8
+}
+
+// dnsQuery mirrors struct dns_query in the eBPF program
+type dnsQuery struct {
+	Timestamp uint64 // CLOCK_MONOTONIC nanoseconds
+	Ifindex   uint32
+	ListID    uint32 // 0 = no list matched
+	Key       ConntrackKey
+	QType     uint16
+	Action    uint8
+	Verdict   uint8 // Index in dnsVerdicts
+	NameLen   uint16
+	Pad       [2]uint8
+	Name      [dnsNameMax]byte // Lowercased wire name
+}
+
+// dnsStats mirrors struct dns_stats in the eBPF program
+type dnsStats struct {
+	Queries   uint64
+	Allowed   uint64
+	Blocked   uint64
+	Malformed uint64
+	Lost      uint64
+}
+
+// dnsMatch is the list a compiled domain hash belongs to
+type dnsMatch struct {
+	list   *DomainList
+	domain string
+}
+
+// defaultDNSFilterConfig answers blocked queries with NXDOMAIN and
+// reports them
+func defaultDNSFilterConfig() DNSFilterConfig {
+	return DNSFilterConfig{Block: DNSBlockNXDomain, Report: DNSReportBlocked, Default: DomainListAllow}
+}
+
+// dnsFilterConfigFromEnv reads CERBERUS_DNS_BLOCK (drop or nxdomain),
+// CERBERUS_DNS_REPORT (none, blocked or all) and CERBERUS_DNS_DEFAULT,
+// the action of queries no list matches (allow or deny)
+func dnsFilterConfigFromEnv() (DNSFilterConfig, error) {
+	config := defaultDNSFilterConfig()
+	for _, setting := range []struct {
+		name     string
+		value    *string
+		codes    map[string]uint8
+		expected string
+	}{
+		{"CERBERUS_DNS_BLOCK", &config.Block, dnsBlockCodes, "drop or nxdomain"},
+		{"CERBERUS_DNS_REPORT", &config.Report, dnsReportCodes, "none, blocked or all"},
+		{"CERBERUS_DNS_DEFAULT", &config.Default, dnsActionCodes, "allow or deny"},
+	} {
+		value := os.Getenv(setting.name)
+		if value == "" {
+			continue
+		}
+		if _, ok := setting.codes[value]; !ok {
+			return config, fmt.Errorf("invalid %s %q, expected %s", setting.name, value, setting.expected)
+		}
+		*setting.value = value
+	}
+	return config, nil
+}
+
+// SetDomainList creates a domain list or replaces the one with the same
+// name. The data plane gets every list recompiled.
+func (s *Server) SetDomainList(ctx context.Context, req *pb.SetDomainListRequest) (*pb.DomainListResponse, error) {
+	if req.GetList() == nil {
+		return &pb.DomainListResponse{Success: false, Message: "Domain list is required"}, nil
+	}
+
+	list := domainListFromProto(req.List)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := validateDomainList(list); err != nil {
+		return &pb.DomainListResponse{
+			Success: false,
+			Message: fmt.Sprintf("Domain list validation failed: %v", err),
+		}, nil
+	}
+	lists := make(map[string]*DomainList, len(s.domainLists)+1)
+	for name, existing := range s.domainLists {
+		lists[name] = existing
+	}
+	if previous := s.domainLists[list.Name]; previous != nil {
+		list.id = previous.id
+	} else {
+		list.id = s.freeDomainListID()
+	}
+	lists[list.Name] = list
+
+	if err := s.pushDomainLists(lists); err != nil {
+		return &pb.DomainListResponse{Success: false, Message: fmt.Sprintf("Failed to push domain lists to data plane: %v", withRemediation(err))}, nil
+	}
+	s.domainLists = lists
+	s.persistPolicy()
+	log.Printf("Set domain list: %s (%s, %d domains)", list.Name, list.Action, len(list.Domains))
+
+	return &pb.DomainListResponse{
+		Success: true,
+		Message: "Domain list saved successfully",
+		List:    s.domainListToProto(list),
+	}, nil
+}
+
+// DeleteDomainList removes a domain list; with the last one gone queries
+// are no longer checked
+func (s *Server) DeleteDomainList(ctx context.Context, req *pb.DeleteDomainListRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.domainLists[req.Name]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Domain list not found"}, nil
+	}
+	lists := make(map[string]*DomainList, len(s.domainLists))
+	for name, list := range s.domainLists {
+		if name != req.Name {
+			lists[name] = list
+		}
+	}
+	if err := s.pushDomainLists(lists); err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove domain list from data plane: %v", withRemediation(err))}, nil
+	}
+	s.domainLists = lists
+	s.persistPolicy()
+	log.Printf("Deleted domain list: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Domain list deleted successfully"}, nil
+}
+
+// ListDomainLists returns the domain lists ordered by name, with the
+// filter's settings and the data plane's counters
+func (s *Server) ListDomainLists(ctx context.Context, req *pb.Empty) (*pb.DomainListsResponse, error) {
+	return s.dnsFilterStatus(), nil
+}
+
+// dnsFilterStatus returns the domain lists ordered by name, with the
+// filter's settings and the data plane's counters
+func (s *Server) dnsFilterStatus() *pb.DomainListsResponse {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.DomainListsResponse{
+		Enabled:       len(s.domainLists) > 0,
+		Block:         s.dnsConfig.Block,
+		Report:        s.dnsConfig.Report,
+		DefaultAction: s.dnsConfig.Default,
+	}
+	for _, list := range s.sortedDomainLists() {
+		resp.Lists = append(resp.Lists, s.domainListToProto(list))
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.DNSStats()
+		if err != nil {
+			log.Printf("⚠️  Failed to read DNS filter counters: %v", err)
+		}
+		resp.Queries = stats.Queries
+		resp.Allowed = stats.Allowed
+		resp.Blocked = stats.Blocked
+		resp.Malformed = stats.Malformed
+		resp.Lost = stats.Lost
+	}
+	return resp
+}
+
+// validateDomainList checks a domain list and normalizes its domains:
+// lowercased, without a trailing dot or leading "*.", sorted and unique
+func validateDomainList(list *DomainList) error {
+	var errs ruleValidationError
+	if list.Name == "" {
+		errs.add("name", "name is required")
+	}
+	if _, ok := dnsActionCodes[list.Action]; !ok {
+		errs.add("action", "invalid action: %s, expected %s or %s", list.Action, DomainListAllow, DomainListDeny)
+	}
+	seen := make(map[string]bool, len(list.Domains))
+	domains := make([]string, 0, len(list.Domains))
+	for _, domain := range list.Domains {
+		normalized, err := normalizeDomain(domain)
+		if err != nil {
+			errs.add("domains", "invalid domain %q: %v", domain, err)
+			continue
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			domains = append(domains, normalized)
+		}
+	}
+	if len(domains) > MaxDNSDomains {
+		errs.add("domains", "too many domains: %d, at most %d", len(domains), MaxDNSDomains)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	sort.Strings(domains)
+	list.Domains = domains
+	return nil
+}
+
+// normalizeDomain returns the form a domain is matched in
+func normalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "*."), ".")
+	if domain == "" {
+		return "", fmt.Errorf("empty")
+	}
+	// Wire form: a length byte per label and the terminating zero
+	if len(domain)+2 > dnsNameMax {
+		return "", fmt.Errorf("longer than %d characters", dnsNameMax-3)
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 {
+			return "", fmt.Errorf("labels must be 1 to 63 characters")
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return "", fmt.Errorf("invalid character %q", c)
+			}
+		}
+	}
+	return domain, nil
+}
+
+// dnsWireName encodes a normalized domain as the labels of a DNS name,
+// without the terminating zero
+func dnsWireName(domain string) []byte {
+	wire := make([]byte, 0, len(domain)+1)
+	for _, label := range strings.Split(domain, ".") {
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+	return wire
+}
+
+// dnsSuffixHash hashes a wire name from its last byte to its first, the
+// way the data plane hashes every suffix of a queried name in one pass
+func dnsSuffixHash(wire []byte) uint64 {
+	hash := uint64(dnsHashOffset)
+	for i := len(wire) - 1; i >= 0; i-- {
+		hash ^= uint64(wire[i])
+		hash *= dnsHashPrime
+	}
+	return hash
+}
+
+// compileDomainLists maps the hash of every listed domain to its list. A
+// domain both kinds of list hold is allowed; otherwise the list first by
+// name keeps it.
+func compileDomainLists(lists map[string]*DomainList) map[uint64]dnsMatch {
+	names := make([]string, 0, len(lists))
+	for name := range lists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	compiled := make(map[uint64]dnsMatch)
+	for _, name := range names {
+		list := lists[name]
+		for _, domain := range list.Domains {
+			hash := dnsSuffixHash(dnsWireName(domain))
+			if previous, exists := compiled[hash]; exists &&
+				(previous.list.Action == DomainListAllow || list.Action == DomainListDeny) {
+				continue
+			}
+			compiled[hash] = dnsMatch{list: list, domain: domain}
+		}
+	}
+	return compiled
+}
+
+// pushDomainLists compiles lists and writes them to the host data plane,
+// keeping them as the domains reports are matched against. Caller must
+// hold s.mutex.
+func (s *Server) pushDomainLists(lists map[string]*DomainList) error {
+	compiled := compileDomainLists(lists)
+	if len(compiled) > MaxDNSDomains {
+		return fmt.Errorf("domain limit reached (%d domains in all lists)", MaxDNSDomains)
+	}
+	if s.bpfManager != nil {
+		domains := make(map[uint64]dnsDomain, len(compiled))
+		for hash, match := range compiled {
+			domains[hash] = dnsDomain{ListID: match.list.id, Action: dnsActionCodes[match.list.Action]}
+		}
+		if err := s.bpfManager.SetDNSFilter(s.dnsConfig.encode(len(lists) > 0), domains); err != nil {
+			return err
+		}
+	}
+	s.dnsDomains = compiled
+	return nil
+}
+
+// encode returns the data plane form of the settings
+func (config DNSFilterConfig) encode(enabled bool) dnsConfig {
+	encoded := dnsConfig{Block: dnsBlockCodes[config.Block], Punt: dnsReportCodes[config.Report]}
+	if enabled {
+		encoded.Enabled = 1
+	}
+	if config.Default == DomainListDeny {
+		encoded.DefaultDeny = 1
+	}
+	return encoded
+}
+
+// freeDomainListID returns the lowest domain list ID not in use. Caller
+// must hold s.mutex.
+func (s *Server) freeDomainListID() uint32 {
+	used := make(map[uint32]bool, len(s.domainLists))
+	for _, list := range s.domainLists {
+		used[list.id] = true
+	}
+	id := uint32(1)
+	for used[id] {
+		id++
+	}
+	return id
+}
+
+// sortedDomainLists returns the domain lists ordered by name. Caller must
+// hold s.mutex.
+func (s *Server) sortedDomainLists() []*DomainList {
+	lists := make([]*DomainList, 0, len(s.domainLists))
+	for _, list := range s.domainLists {
+		lists = append(lists, list)
+	}
+	sort.Slice(lists, func(i, j int) bool { return lists[i].Name < lists[j].Name })
+	return lists
+}
+
+func domainListFromProto(list *pb.DomainList) *DomainList {
+	return &DomainList{
+		Name:        list.Name,
+		Action:      list.Action,
+		Domains:     list.Domains,
+		Description: list.Description,
+	}
+}
+
+// domainListToProto converts a list with the count of reported queries it
+// matched. Caller must hold s.mutex.
+func (s *Server) domainListToProto(list *DomainList) *pb.DomainList {
+	resp := &pb.DomainList{
+		Name:        list.Name,
+		Action:      list.Action,
+		Domains:     list.Domains,
+		Description: list.Description,
+		Id:          list.id,
+	}
+	if s.dnsFilter != nil {
+		resp.Matches = s.dnsFilter.Matches(list.Name)
+	}
+	return resp
+}
+
+// DNSFilter reads the queries the data plane reports and publishes them
+// as events, counting those each list matched
+type DNSFilter struct {
+	server  *Server
+	manager *BPFMapManager
+
+	mutex   sync.Mutex
+	matches map[string]uint64 // Reported queries by list name
+}
+
+// NewDNSFilter creates a reader of the queries a data plane reports
+func NewDNSFilter(server *Server, manager *BPFMapManager) *DNSFilter {
+	return &DNSFilter{server: server, manager: manager, matches: make(map[string]uint64)}
+}
+
+// Run publishes reported queries until ctx is done. It returns at once
+// when the data plane has no DNS filter maps.
+func (f *DNSFilter) Run(ctx context.Context) {
+	if f.manager == nil || f.manager.dnsQueries == nil {
+		return
+	}
+
+	records, cancel, err := f.manager.rings.Consume(DNSQueriesMapName, f.manager.dnsQueries, "dns-filter")
+	if err != nil {
+		log.Printf("Failed to open DNS query ring buffer: %v", err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	for record := range records {
+		var query dnsQuery
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &query); err != nil {
+			log.Printf("Malformed DNS query report: %v", err)
+			continue
+		}
+		name := query.Name[:min(int(query.NameLen), dnsNameMax)]
+		f.server.mutex.RLock()
+		match, matched := matchDNSName(f.server.dnsDomains, name)
+		f.server.mutex.RUnlock()
+		if matched {
+			f.mutex.Lock()
+			f.matches[match.list.Name]++
+			f.mutex.Unlock()
+		}
+		f.server.events.Publish(query.event(name, match, f.server.clock.Now(), monotonicNow()))
+	}
+}
+
+// Matches returns the reported queries a list matched
+func (f *DNSFilter) Matches(list string) uint64 {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.matches[list]
+}
+
+// matchDNSName finds the longest suffix of a wire name that is listed
+func matchDNSName(compiled map[uint64]dnsMatch, wire []byte) (dnsMatch, bool) {
+	for i := 0; i < len(wire); i += int(wire[i]) + 1 {
+		if match, exists := compiled[dnsSuffixHash(wire[i:])]; exists {
+			return match, true
+		}
+	}
+	return dnsMatch{}, false
+}
+
+// dnsNameText converts a wire name to dotted text
+func dnsNameText(wire []byte) string {
+	var labels []string
+	for i := 0; i < len(wire); {
+		end := min(i+1+int(wire[i]), len(wire))
+		labels = append(labels, string(wire[i+1:end]))
+		i = end
+	}
+	if len(labels) == 0 {
+		return "."
+	}
+	return strings.Join(labels, ".")
+}
+
+// event converts a reported query to a DNS_QUERY_BLOCKED event, or a
+// DNS_QUERY one if it passed; wall is the clock time at monotonic time now
+func (q *dnsQuery) event(wire []byte, match dnsMatch, wall time.Time, now uint64) *pb.Event {
+	src, dst := q.Key.addrs()
+	timestamp := wall
+	if now > q.Timestamp {
+		timestamp = timestamp.Add(-time.Duration(now - q.Timestamp))
+	}
+	verdict := strconv.Itoa(int(q.Verdict))
+	if int(q.Verdict) < len(dnsVerdicts) {
+		verdict = dnsVerdicts[q.Verdict]
+	}
+	name := dnsNameText(wire)
+	qtype := strings.TrimPrefix(dnsmessage.Type(q.QType).String(), "Type")
+
+	event := &pb.Event{
+		Type:      EventDNSQuery,
+		Timestamp: timestamp.Unix(),
+		Source:    src.String(),
+		Target:    dst.String(),
+		Protocol:  "udp",
+		Port:      int32(q.Key.DstPort),
+		Severity:  "low",
+		Message:   fmt.Sprintf("DNS query for %s %s passed", name, qtype),
+		Metadata: map[string]string{
+			"domain":    name,
+			"qtype":     qtype,
+			"verdict":   verdict,
+			"src_port":  strconv.Itoa(int(q.Key.SrcPort)),
+			"interface": interfaceName(q.Ifindex),
+			"timestamp": timestamp.Format(time.RFC3339Nano),
+		},
+	}
+	if match.list != nil {
+		event.Metadata["list"] = match.list.Name
+		event.Metadata["listed_domain"] = match.domain
+		event.Metadata["action"] = match.list.Action
+	}
+	if verdict != "pass" {
+		event.Type = EventDNSQueryBlocked
+		event.Severity = "medium"
+		if match.list != nil {
+			event.Message = fmt.Sprintf("DNS query for %s %s blocked (%s) by domain list %s", name, qtype, verdict, match.list.Name)
+		} else {
+			event.Message = fmt.Sprintf("DNS query for %s %s blocked (%s): no domain list allows it", name, qtype, verdict)
+		}
+	}
+	return event
+}
+
+// openDNSFilter opens the pinned DNS filter maps and clears the domains
+// left by a previous control plane run; stored lists are re-pushed on
+// restore
+func (bm *BPFMapManager) openDNSFilter() {
+	names := []string{DNSConfigMapName, DNSDomainsMapName, DNSQueriesMapName, DNSStatsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  DNS filtering not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.dnsConfig, bm.dnsDomains, bm.dnsQueries, bm.dnsStats = maps[0], maps[1], maps[2], maps[3]
+
+	if err := bm.SetDNSFilter(dnsConfig{}, nil); err != nil {
+		log.Printf("⚠️  Failed to clear stale DNS filter domains: %v", err)
+	}
+}
+
+// closeDNSFilter closes the DNS filter maps, if open
+func (bm *BPFMapManager) closeDNSFilter() {
+	if bm.dnsConfig == nil {
+		return
+	}
+	bm.dnsConfig.Close()
+	bm.dnsDomains.Close()
+	bm.dnsQueries.Close()
+	bm.dnsStats.Close()
+}
+
+// SetDNSFilter replaces the listed domains and the settings. Domains are
+// added before stale ones go, so a domain in both the old and new lists is
+// never briefly unlisted.
+func (bm *BPFMapManager) SetDNSFilter(config dnsConfig, domains map[uint64]dnsDomain) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting DNS filter (%d domains, enabled: %v)", len(domains), config.Enabled != 0)
+		return nil
+	}
+	if bm.dnsConfig == nil {
+		return fmt.Errorf("DNS filter maps not available")
+	}
+
+	var hash uint64
+	var value dnsDomain
+	var stale []uint64
+	entries := bm.dnsDomains.Iterate()
+	for entries.Next(&hash, &value) {
+		if _, keep := domains[hash]; !keep {
+			stale = append(stale, hash)
+		}
+	}
+	if err := entries.Err(); err != nil {
+		return fmt.Errorf("failed to read DNS filter domains: %v", err)
+	}
+	for hash, domain := range domains {
+		if err := bm.dnsDomains.Put(&hash, &domain); err != nil {
+			return fmt.Errorf("failed to write DNS filter domain: %v", err)
+		}
+	}
+	for _, hash := range stale {
+		if err := bm.dnsDomains.Delete(&hash); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove DNS filter domain: %v", err)
+		}
+	}
+
+	key := uint32(0)
+	if err := bm.dnsConfig.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write DNS filter settings: %v", err)
+	}
+	return nil
+}
+
+// DNSStats reads the DNS filter counters, summed across CPUs
+func (bm *BPFMapManager) DNSStats() (dnsStats, error) {
+	var stats dnsStats
+	if bm.simulated || bm.dnsStats == nil {
+		return stats, nil
+	}
+	var perCPU []dnsStats
+	key := uint32(0)
+	if err := bm.dnsStats.Lookup(&key, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Queries += value.Queries
+		stats.Allowed += value.Allowed
+		stats.Blocked += value.Blocked
+		stats.Malformed += value.Malformed
+		stats.Lost += value.Lost
+	}
+	return stats, nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Duplicate rule detection: rules matching the same traffic with the same action and priority
+
+package main
+
+import "strings"
+
+// ruleSignature is the canonical form of what a rule matches and does, equal
+// for rules written differently that the data plane enforces the same way
+type ruleSignature struct {
+	action          string
+	log             bool
+	mirrorTo        string
+	src, dst        string // Masked CIDR, empty = any
+	srcAddress      string
+	dstAddress      string
+	srcSet, dstSet  uint32
+	service         string
+	protocol        uint8
+	srcPort, srcEnd int32
+	dstPort, dstEnd int32
+	ingress, egress bool
+	priority        int32
+	enabled         bool
+	connStates      uint8
+	tcpFlags        string
+	srcMAC, dstMAC  string
+	vlan            int32
+	interfaces      string // Sorted and comma separated, empty = any
+	srcCountry      string // Sorted and comma separated, empty = any
+	dstCountry      string
+	srcASN, dstASN  string // Sorted, empty = any
+	namespace, vf   string
+	labels          string // Sorted key=value pairs, comma separated
+	expiresAt       int64  // Unix seconds, 0 = never
+}
+
+func ruleSignatureOf(rule *FirewallRule) ruleSignature {
+	signature := ruleSignature{
+		action:     rule.Action,
+		log:        rule.Log,
+		mirrorTo:   rule.MirrorTo,
+		src:        canonicalAddress(rule.SrcIP),
+		dst:        canonicalAddress(rule.DstIP),
+		srcAddress: rule.SrcAddress,
+		dstAddress: rule.DstAddress,
+		srcSet:     rule.SrcSet,
+		dstSet:     rule.DstSet,
+		service:    rule.Service,
+		protocol:   protocolToUint8(rule.Protocol),
+		priority:   rule.Priority,
+		enabled:    rule.Enabled,
+		connStates: connStateMask(rule.ConnState),
+		tcpFlags:   canonicalTCPFlags(rule.TCPFlags),
+		srcMAC:     canonicalMAC(rule.SrcMAC),
+		dstMAC:     canonicalMAC(rule.DstMAC),
+		vlan:       rule.VLAN,
+		interfaces: strings.Join(canonicalInterfaces(rule.Interfaces), ","),
+		srcCountry: strings.Join(canonicalCountries(rule.SrcCountry), ","),
+		dstCountry: strings.Join(canonicalCountries(rule.DstCountry), ","),
+		srcASN:     formatASNs(canonicalASNs(rule.SrcASN)),
+		dstASN:     formatASNs(canonicalASNs(rule.DstASN)),
+		namespace:  rule.Namespace,
+		vf:         rule.VF,
+		labels:     canonicalLabels(rule.Labels),
+	}
+	if !rule.ExpiresAt.IsZero() {
+		signature.expiresAt = rule.ExpiresAt.Unix()
+	}
+	signature.srcPort, signature.srcEnd = canonicalPorts(rule.SrcPort, rule.SrcPortEnd)
+	signature.dstPort, signature.dstEnd = canonicalPorts(rule.DstPort, rule.DstPortEnd)
+	signature.ingress, signature.egress = ruleHooks(rule)
+	return signature
+}
+
+// canonicalAddress writes a bare address as its host prefix
+func canonicalAddress(addr string) string {
+	if addr == "" {
+		return ""
+	}
+	network, err := parseRuleAddress(addr)
+	if err != nil {
+		return addr
+	}
+	return network.String()
+}
+
+// canonicalPorts writes a single port as a range ending at itself
+func canonicalPorts(start, end int32) (int32, int32) {
+	if start != 0 && end == 0 {
+		end = start
+	}
+	return start, end
+}
+
+// duplicateRule returns the rule, lowest ID first, matching the same
+// traffic as rule with the same action, logging, labels and priority until
+// the same expiry, so a duplicate never goes away before the rule it stands
+// for nor escapes its label selectors. Descriptions and owners are not
+// compared.
+func duplicateRule(rule *FirewallRule, rules map[string]*FirewallRule) *FirewallRule {
+	signature := ruleSignatureOf(rule)
+	var duplicate *FirewallRule
+	for id, other := range rules {
+		if id == rule.ID || (duplicate != nil && id > duplicate.ID) {
+			continue
+		}
+		if ruleSignatureOf(other) == signature {
+			duplicate = other
+		}
+	}
+	return duplicate
+}
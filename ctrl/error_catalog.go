@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// Error catalog: stable codes with remediation hints for operational
+// failures, attached to gRPC errors as error details and to the messages
+// and logs operators read
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	"golang.org/x/sys/unix"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the ErrorInfo domain of catalogued gRPC errors
+const ErrorDomain = "cerberus-v.firewall"
+
+// ErrorCodeHeader carries the catalog code of a failed REST request
+const ErrorCodeHeader = "X-Cerberus-Error"
+
+// Catalog codes. E1xxx are data plane failures, E2xxx rejected requests,
+// E3xxx configuration and databases, E4xxx control plane limits.
+const (
+	ErrCodeDataPlaneNotLoaded  = "E1101"
+	ErrCodeDataPlanePermission = "E1102"
+	ErrCodeDataPlaneFeature    = "E1103"
+	ErrCodeKernelUnsupported   = "E1104"
+	ErrCodeRulesMapFull        = "E1203"
+	ErrCodeNoReplaceSlot       = "E1204"
+	ErrCodeShardFull           = "E1205"
+	ErrCodeInvalidRule         = "E2101"
+	ErrCodeInvalidSelector     = "E2102"
+	ErrCodeInvalidFilter       = "E2103"
+	ErrCodeUnauthenticated     = "E2201"
+	ErrCodeInvalidConfig       = "E3101"
+	ErrCodeGeoIPMissing        = "E3201"
+	ErrCodeASNMissing          = "E3202"
+	ErrCodeRuleLimit           = "E4101"
+	ErrCodeBusy                = "E4102"
+	ErrCodeDeadline            = "E4201"
+	ErrCodeCanceled            = "E4202"
+)
+
+// catalogEntry explains one kind of failure
+type catalogEntry struct {
+	Code        string     `json:"code"`
+	Summary     string     `json:"summary"`
+	Remediation string     `json:"remediation"`
+	grpcCode    codes.Code // Used when the error carries no status of its own
+}
+
+var errorCatalog = map[string]*catalogEntry{}
+
+func init() {
+	for _, entry := range []*catalogEntry{
+		{ErrCodeDataPlaneNotLoaded, "data plane not loaded", "load the XDP program so its maps are pinned under /sys/fs/bpf, then restart cerberus-ctrl", codes.FailedPrecondition},
+		{ErrCodeDataPlanePermission, "no permission to use the data plane maps", "run cerberus-ctrl as root or with CAP_BPF and CAP_NET_ADMIN, and raise its memlock limit", codes.PermissionDenied},
+		{ErrCodeDataPlaneFeature, "data plane lacks a map this rule needs", "reload the XDP program built from this release; the loaded one predates the feature", codes.FailedPrecondition},
+		{ErrCodeKernelUnsupported, "kernel does not support the operation", "upgrade the kernel or disable the feature that needs it", codes.Unimplemented},
+		{ErrCodeRulesMapFull, "data plane map full", "delete unused rules or set entries, raise MAX_RULES in ebpf/xdp_filter.c or enable sharding with CERBERUS_RULE_SHARDS", codes.ResourceExhausted},
+		{ErrCodeNoReplaceSlot, "no free slots for a hit-less replace", "free some rule slots or replace the rules in smaller batches", codes.ResourceExhausted},
+		{ErrCodeShardFull, "rule shard full", "raise CERBERUS_RULE_SHARDS or the hashed prefix length", codes.ResourceExhausted},
+		{ErrCodeInvalidRule, "rule failed validation", "fix the fields named in the error and resend the rule", codes.InvalidArgument},
+		{ErrCodeInvalidSelector, "invalid label selector", "write the selector as key=value, key!=value, key or !key terms joined by commas", codes.InvalidArgument},
+		{ErrCodeInvalidFilter, "invalid filter expression", "compare fields with ==, !=, <, <=, >, >=, in, matches or contains, e.g. severity >= medium && src_ip in 10.0.0.0/8, joining terms with &&, || and !", codes.InvalidArgument},
+		{ErrCodeUnauthenticated, "request not authenticated", "present credentials a provider of the listener accepts: a client certificate, an Authorization: Bearer token, or a permitted Unix user, as set by CERBERUS_AUTH_*", codes.Unauthenticated},
+		{ErrCodeInvalidConfig, "invalid configuration", "fix or unset the CERBERUS_* variable named in the error", codes.InvalidArgument},
+		{ErrCodeGeoIPMissing, "GeoIP database not loaded", "set CERBERUS_GEOIP_DB to a country database and restart cerberus-ctrl", codes.FailedPrecondition},
+		{ErrCodeASNMissing, "ASN database not loaded", "set CERBERUS_ASN_DB, or CERBERUS_ASN_URL to download one, and restart cerberus-ctrl", codes.FailedPrecondition},
+		{ErrCodeRuleLimit, "rule limit reached", "delete unused rules or raise CERBERUS_LIMIT_RULES", codes.ResourceExhausted},
+		{ErrCodeBusy, "control plane busy", "retry later, or raise the CERBERUS_LIMIT_* variable for the exhausted resource", codes.ResourceExhausted},
+		{ErrCodeDeadline, "request deadline exceeded", "retry with a longer client deadline; completed work was rolled back unless the error says otherwise", codes.DeadlineExceeded},
+		{ErrCodeCanceled, "request canceled", "retry the request; completed work was rolled back unless the error says otherwise", codes.Canceled},
+	} {
+		errorCatalog[entry.Code] = entry
+	}
+}
+
+// catalogEntries returns the catalog sorted by code
+func catalogEntries() []*catalogEntry {
+	entries := make([]*catalogEntry, 0, len(errorCatalog))
+	for _, entry := range errorCatalog {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Code < entries[j].Code })
+	return entries
+}
+
+// catalogError is a failure explained by a catalog entry
+type catalogError struct {
+	entry *catalogEntry
+	err   error
+}
+
+// withCode attaches the catalog entry for code to err
+func withCode(code string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &catalogError{entry: errorCatalog[code], err: err}
+}
+
+func (e *catalogError) Error() string {
+	return fmt.Sprintf("%s: %v — %s", e.entry.Code, e.err, e.entry.Remediation)
+}
+
+func (e *catalogError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus makes gRPC handlers returning the error answer with the
+// entry's code and details
+func (e *catalogError) GRPCStatus() *status.Status {
+	return catalogStatus(status.New(e.entry.grpcCode, e.err.Error()), e.entry)
+}
+
+// classifyError finds the catalog entry explaining err, if any
+func classifyError(err error) *catalogEntry {
+	var catalogued *catalogError
+	if errors.As(err, &catalogued) {
+		return catalogued.entry
+	}
+	if interrupted, ok := asInterrupted(err); ok {
+		if errors.Is(interrupted, context.DeadlineExceeded) {
+			return errorCatalog[ErrCodeDeadline]
+		}
+		return errorCatalog[ErrCodeCanceled]
+	}
+	if limited, ok := asResourceLimit(err); ok {
+		if limited.resource == ResourceRules {
+			return errorCatalog[ErrCodeRuleLimit]
+		}
+		return errorCatalog[ErrCodeBusy]
+	}
+	var invalid ruleValidationError
+	if errors.As(err, &invalid) {
+		return errorCatalog[ErrCodeInvalidRule]
+	}
+
+	// A missing key is an answer, not a failure to reach the maps
+	switch {
+	case errors.Is(err, ebpf.ErrKeyNotExist):
+		return nil
+	case errors.Is(err, unix.E2BIG):
+		return errorCatalog[ErrCodeRulesMapFull]
+	case errors.Is(err, unix.EPERM):
+		return errorCatalog[ErrCodeDataPlanePermission]
+	case errors.Is(err, ebpf.ErrNotSupported):
+		return errorCatalog[ErrCodeKernelUnsupported]
+	case errors.Is(err, os.ErrNotExist):
+		return errorCatalog[ErrCodeDataPlaneNotLoaded]
+	}
+	return nil
+}
+
+// withRemediation returns err with its catalog code and remediation when
+// the catalog explains it, else err unchanged
+func withRemediation(err error) error {
+	if err == nil {
+		return nil
+	}
+	var catalogued *catalogError
+	if errors.As(err, &catalogued) {
+		return err
+	}
+	if entry := classifyError(err); entry != nil {
+		return &catalogError{entry: entry, err: err}
+	}
+	return err
+}
+
+// catalogStatus adds the entry's code and remediation to a status, in the
+// message for clients that print it and as ErrorInfo and LocalizedMessage
+// details for clients that branch on it
+func catalogStatus(st *status.Status, entry *catalogEntry) *status.Status {
+	message := st.Message()
+	if !strings.HasPrefix(message, entry.Code+":") {
+		message = fmt.Sprintf("%s: %s — %s", entry.Code, message, entry.Remediation)
+	}
+	detailed, err := status.New(st.Code(), message).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   entry.Code,
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"summary": entry.Summary, "remediation": entry.Remediation},
+		},
+		&errdetails.LocalizedMessage{Locale: "en-US", Message: entry.Summary + ": " + entry.Remediation},
+	)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// catalogGRPCError converts a handler error into a status carrying its
+// catalog entry; unexplained errors pass through
+func catalogGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	entry := classifyError(err)
+	if entry == nil {
+		return err
+	}
+	st := status.Convert(err)
+	if len(st.Details()) > 0 {
+		return err
+	}
+	if st.Code() == codes.Unknown {
+		st = status.New(entry.grpcCode, st.Message())
+	}
+	return catalogStatus(st, entry).Err()
+}
+
+// errorCatalogUnaryInterceptor attaches catalog details to unary errors
+func errorCatalogUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, catalogGRPCError(err)
+}
+
+// errorCatalogStreamInterceptor attaches catalog details to stream errors
+func errorCatalogStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return catalogGRPCError(handler(srv, stream))
+}
+
+// setErrorCode tags a failed REST response with the catalog code of err
+func setErrorCode(w http.ResponseWriter, err error) {
+	if entry := classifyError(err); entry != nil {
+		w.Header().Set(ErrorCodeHeader, entry.Code)
+	}
+}
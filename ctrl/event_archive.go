@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: Apache-2.0
+// Event archive: daily record of the events the detection engines count,
+// replayed to test their tuning against past incidents
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	eventArchiveDirName = "events"
+	eventArchiveFileExt = ".jsonl" // One file per UTC day, named YYYY-MM-DD.jsonl
+
+	// How often buffered events are written out
+	eventArchiveFlushInterval = 10 * time.Second
+
+	eventArchiveRetentionDays = 30
+	eventArchiveDayLimit      = 1048576 // Events kept per day; later events are counted only
+
+	// Longest archived line read back
+	eventArchiveMaxLine = 1 << 20
+)
+
+// eventArchiveTypes are the events archived: those counted by automatic
+// blocking and the blocks it made
+var eventArchiveTypes = []string{EventPacketSample, EventAuthFailed, EventAutoBlocked, EventAutoUnblocked}
+
+// EventArchive appends events to one JSON lines file per day under
+// <state dir>/events, in the order they were published
+type EventArchive struct {
+	mutex   sync.Mutex
+	dir     string
+	day     string // Day of the open file, YYYY-MM-DD
+	file    *os.File
+	writer  *bufio.Writer
+	written int // Events in today's file
+	dropped int // Events over eventArchiveDayLimit today
+	clock   Clock
+}
+
+// NewEventArchive opens the archive under stateDir; today's file is
+// continued on the first event
+func NewEventArchive(stateDir string) (*EventArchive, error) {
+	dir := filepath.Join(stateDir, eventArchiveDirName)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create event archive directory: %v", err)
+	}
+	return &EventArchive{dir: dir, clock: systemClock{}}, nil
+}
+
+// archived reports whether an event is kept: sampled packets only when
+// dropped
+func archived(event *pb.Event) bool {
+	return event.Type != EventPacketSample || event.Metadata["verdict"] == "drop"
+}
+
+// Record appends an event to today's file
+func (ea *EventArchive) Record(event *pb.Event) error {
+	if !archived(event) {
+		return nil
+	}
+	ea.mutex.Lock()
+	defer ea.mutex.Unlock()
+
+	now := ea.clock.Now()
+	if day := now.UTC().Format(time.DateOnly); day != ea.day || ea.file == nil {
+		if err := ea.closeLocked(); err != nil {
+			log.Printf("⚠️  Failed to save event archive for %s: %v", ea.day, err)
+		}
+		if err := ea.openLocked(day); err != nil {
+			return err
+		}
+		ea.prune(now)
+	}
+	if ea.written >= eventArchiveDayLimit {
+		ea.dropped++
+		return nil
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %v", err)
+	}
+	ea.writer.Write(line)
+	ea.writer.WriteByte('\n')
+	ea.written++
+	return nil
+}
+
+// openLocked opens a day's file for appending, counting the events it
+// holds already and ending a line cut short by a crash. Caller must hold
+// ea.mutex.
+func (ea *EventArchive) openLocked(day string) error {
+	file, err := os.OpenFile(ea.dayPath(day), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0640)
+	if err != nil {
+		return fmt.Errorf("failed to open event archive: %v", err)
+	}
+	written, last := 0, byte('\n')
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := file.Read(buf)
+		written += bytes.Count(buf[:n], []byte{'\n'})
+		if n > 0 {
+			last = buf[n-1]
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			file.Close()
+			return fmt.Errorf("failed to read event archive: %v", err)
+		}
+	}
+	writer := bufio.NewWriter(file)
+	if last != '\n' {
+		writer.WriteByte('\n')
+	}
+	ea.day, ea.file, ea.writer, ea.written, ea.dropped = day, file, writer, written, 0
+	return nil
+}
+
+// Flush writes the buffered events to disk
+func (ea *EventArchive) Flush() error {
+	ea.mutex.Lock()
+	defer ea.mutex.Unlock()
+	return ea.flushLocked()
+}
+
+func (ea *EventArchive) flushLocked() error {
+	if ea.writer == nil {
+		return nil
+	}
+	if ea.dropped > 0 {
+		log.Printf("⚠️  Event archive for %s is full, %d events not recorded", ea.day, ea.dropped)
+		ea.dropped = 0
+	}
+	return ea.writer.Flush()
+}
+
+// Close writes the buffered events and closes today's file
+func (ea *EventArchive) Close() error {
+	ea.mutex.Lock()
+	defer ea.mutex.Unlock()
+	return ea.closeLocked()
+}
+
+func (ea *EventArchive) closeLocked() error {
+	if ea.file == nil {
+		return nil
+	}
+	err := ea.flushLocked()
+	if closeErr := ea.file.Close(); err == nil {
+		err = closeErr
+	}
+	ea.file, ea.writer = nil, nil
+	return err
+}
+
+// prune deletes day files older than the retention
+func (ea *EventArchive) prune(now time.Time) {
+	oldest := now.UTC().AddDate(0, 0, -eventArchiveRetentionDays).Format(time.DateOnly)
+	entries, err := os.ReadDir(ea.dir)
+	if err != nil {
+		log.Printf("Failed to list event archive: %v", err)
+		return
+	}
+	for _, entry := range entries {
+		day := strings.TrimSuffix(entry.Name(), eventArchiveFileExt)
+		if _, err := time.Parse(time.DateOnly, day); err != nil || day >= oldest {
+			continue
+		}
+		if err := os.Remove(filepath.Join(ea.dir, entry.Name())); err != nil {
+			log.Printf("Failed to remove expired event archive %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+func (ea *EventArchive) dayPath(day string) string {
+	return filepath.Join(ea.dir, day+eventArchiveFileExt)
+}
+
+// Read calls fn with the archived events published from start to end,
+// in the order they were published
+func (ea *EventArchive) Read(ctx context.Context, start, end time.Time, fn func(*pb.Event) error) error {
+	if err := ea.Flush(); err != nil {
+		return fmt.Errorf("failed to save event archive: %v", err)
+	}
+	return readEventArchive(ctx, ea.dir, start, end, fn)
+}
+
+// readEventArchive calls fn with the events archived under dir with a
+// timestamp from start to end. ctx is checked between days.
+func readEventArchive(ctx context.Context, dir string, start, end time.Time, fn func(*pb.Event) error) error {
+	// Events are filed by the day they were published, which can follow
+	// their timestamp by a little around midnight
+	first := start.UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	last := end.UTC().Truncate(24 * time.Hour)
+	total := int(last.Sub(first)/(24*time.Hour)) + 1
+	for day, n := first, 0; !day.After(last); day, n = day.AddDate(0, 0, 1), n+1 {
+		if err := checkpoint(ctx, "event archive read", n, total, "days read"); err != nil {
+			return err
+		}
+		err := scanEventArchive(filepath.Join(dir, day.Format(time.DateOnly)+eventArchiveFileExt), func(event *pb.Event) error {
+			if event.Timestamp < start.Unix() || event.Timestamp > end.Unix() {
+				return nil
+			}
+			return fn(event)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanEventArchive calls fn with every event of a day file; a missing file
+// holds none. Lines cut short by a crash are skipped.
+func scanEventArchive(path string, fn func(*pb.Event) error) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open event archive: %v", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), eventArchiveMaxLine)
+	skipped := 0
+	for scanner.Scan() {
+		var event pb.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			skipped++
+			continue
+		}
+		if err := fn(&event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read event archive %s: %v", path, err)
+	}
+	if skipped > 0 {
+		log.Printf("⚠️  Skipped %d unreadable lines of event archive %s", skipped, path)
+	}
+	return nil
+}
+
+// Run archives the published events until ctx is done, writing them out
+// every eventArchiveFlushInterval
+func (ea *EventArchive) Run(ctx context.Context, bus *EventBus) {
+	events, cancel := bus.Subscribe(eventArchiveTypes)
+	defer cancel()
+	ticker := time.NewTicker(eventArchiveFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ea.Flush(); err != nil {
+				log.Printf("⚠️  Failed to save event archive: %v", err)
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := ea.Record(event); err != nil {
+				log.Printf("⚠️  Failed to archive event: %v", err)
+			}
+		}
+	}
+}
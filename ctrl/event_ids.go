@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Event IDs: a node prefix and a sequence number persisted across restarts,
+// so downstream systems can deduplicate events and detect gaps
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+const (
+	eventSequenceVersion  = 1
+	eventSequenceFileName = "event_sequence.json"
+
+	// Sequence numbers reserved on disk at a time, so the file is written
+	// once per block rather than per event. A crash skips at most this many.
+	eventSequenceBlock = 4096
+)
+
+// nodeIDPattern is the form of a node ID; it may not contain the
+// underscore separating it from the sequence number
+var nodeIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.-]{0,62}$`)
+
+// eventSequence hands out the sequence numbers of one node's events.
+// Numbers only grow: after a clean shutdown the next run continues where
+// the last one stopped, after a crash it continues past the numbers the
+// last run had reserved.
+type eventSequence struct {
+	node     string // Empty = IDs without a node prefix
+	last     uint64 // Last number handed out
+	reserved uint64 // Highest number reserved, on disk unless the write failed
+	path     string // Empty = kept in memory only
+}
+
+// eventSequenceFile is the persisted form of the sequence
+type eventSequenceFile struct {
+	Version  int    `json:"version"`
+	Node     string `json:"node"`
+	Reserved uint64 `json:"reserved"`
+	// Written at shutdown: Reserved is the last number handed out
+	Clean bool `json:"clean"`
+}
+
+// openEventSequence loads the sequence saved under dir. The node ID is
+// node if set, else the one saved, else a new random one.
+func openEventSequence(dir, node string) (*eventSequence, error) {
+	if node != "" && !nodeIDPattern.MatchString(node) {
+		return nil, fmt.Errorf("invalid node ID %q, expected letters, digits, dots and dashes", node)
+	}
+	seq := &eventSequence{path: filepath.Join(dir, eventSequenceFileName)}
+
+	var file eventSequenceFile
+	data, err := os.ReadFile(seq.path)
+	switch {
+	case os.IsNotExist(err):
+		file.Clean = true
+	case err != nil:
+		return nil, fmt.Errorf("failed to read event sequence: %v", err)
+	default:
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse event sequence %s: %v", seq.path, err)
+		}
+		if file.Version > eventSequenceVersion {
+			return nil, fmt.Errorf("event sequence version %d is newer than supported version %d", file.Version, eventSequenceVersion)
+		}
+	}
+
+	seq.node = node
+	if seq.node == "" {
+		seq.node = file.Node
+	}
+	if seq.node == "" {
+		random := make([]byte, 4)
+		if _, err := rand.Read(random); err != nil {
+			return nil, fmt.Errorf("failed to generate node ID: %v", err)
+		}
+		seq.node = hex.EncodeToString(random)
+	}
+	seq.last = file.Reserved
+	seq.reserved = file.Reserved
+	if !file.Clean {
+		log.Printf("⚠️  Event sequence of node %s resumed at %d after an unclean shutdown, up to %d IDs before it were never used",
+			seq.node, seq.last+1, eventSequenceBlock)
+	}
+
+	if err := seq.reserve(); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// next returns the next sequence number, reserving a block on disk when
+// the last one is used up. A block that cannot be written is used anyway
+// and the write is retried with the block after it.
+func (seq *eventSequence) next() uint64 {
+	seq.last++
+	if seq.path != "" && seq.last > seq.reserved {
+		if err := seq.reserve(); err != nil {
+			// IDs stay unique within this run, but the next run may reuse them
+			seq.reserved = seq.last + eventSequenceBlock
+			log.Printf("⚠️  Failed to reserve event IDs, retrying after %d more events: %v", eventSequenceBlock, err)
+		}
+	}
+	return seq.last
+}
+
+// reserve writes the end of the next block of numbers
+func (seq *eventSequence) reserve() error {
+	reserved := seq.last + eventSequenceBlock
+	if err := seq.save(reserved, false); err != nil {
+		return err
+	}
+	seq.reserved = reserved
+	return nil
+}
+
+// close records the last number handed out so the next run continues
+// without a gap
+func (seq *eventSequence) close() error {
+	if seq.path == "" {
+		return nil
+	}
+	return seq.save(seq.last, true)
+}
+
+func (seq *eventSequence) save(reserved uint64, clean bool) error {
+	data, err := json.MarshalIndent(&eventSequenceFile{
+		Version:  eventSequenceVersion,
+		Node:     seq.node,
+		Reserved: reserved,
+		Clean:    clean,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode event sequence: %v", err)
+	}
+	return writeFileAtomic(seq.path, data)
+}
+
+// id formats the event ID of a sequence number
+func (seq *eventSequence) id(number uint64) string {
+	if seq.node == "" {
+		return "evt_" + strconv.FormatUint(number, 10)
+	}
+	return fmt.Sprintf("evt_%s_%d", seq.node, number)
+}
+
+// PersistSequence continues event IDs from the sequence saved under dir,
+// prefixed with the node ID (see openEventSequence). Call before events
+// are published.
+func (b *EventBus) PersistSequence(dir, node string) error {
+	seq, err := openEventSequence(dir, node)
+	if err != nil {
+		return err
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.ids = seq
+	log.Printf("Event IDs of node %s continue at %d", seq.node, seq.last+1)
+	return nil
+}
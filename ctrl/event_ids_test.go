@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEventSequenceRetriesFailedReservationAfterBlock(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "state")
+	seq := &eventSequence{node: "node-1", path: filepath.Join(dir, eventSequenceFileName)}
+
+	// The state directory is missing, so the first reservation fails
+	if got := seq.next(); got != 1 {
+		t.Fatalf("first number = %d, want 1", got)
+	}
+	if err := os.Mkdir(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < eventSequenceBlock; i++ {
+		seq.next()
+	}
+	if _, err := os.Stat(seq.path); !os.IsNotExist(err) {
+		t.Fatalf("reservation retried within the block after a failure: %v", err)
+	}
+
+	if got, want := seq.next(), uint64(eventSequenceBlock+2); got != want {
+		t.Fatalf("number after the block = %d, want %d", got, want)
+	}
+	if _, err := os.Stat(seq.path); err != nil {
+		t.Fatalf("reservation not retried after the block: %v", err)
+	}
+	if want := uint64(2*eventSequenceBlock + 2); seq.reserved != want {
+		t.Fatalf("reserved = %d, want %d", seq.reserved, want)
+	}
+}
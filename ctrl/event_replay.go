@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Event replay: archived events fed back through automatic blocking with
+// other thresholds, to tune detection against past incidents offline
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Window replayed without a start, and blocks listed without a limit
+	DefaultReplaySeconds = 3600
+	DefaultReplayBlocks  = 1000
+
+	// Most blocks listed
+	MaxReplayBlocks = 100000
+)
+
+// autoBlockReplay runs automatic blocking over archived events on their
+// own timestamps, listing the blocks it would make instead of making them
+type autoBlockReplay struct {
+	config    AutoBlockConfig
+	blocker   *AutoBlocker
+	limit     int
+	lastPrune time.Time
+	active    []time.Time // Ends of the blocks made, for MaxBlocks
+	blocked   map[netip.Addr]bool
+	recorded  map[netip.Addr]bool // Sources of the AUTO_BLOCKED events replayed
+	resp      *pb.ReplayEventsResponse
+}
+
+func newAutoBlockReplay(config AutoBlockConfig, limit int) *autoBlockReplay {
+	return &autoBlockReplay{
+		config:   config,
+		blocker:  NewAutoBlocker(),
+		limit:    limit,
+		blocked:  make(map[netip.Addr]bool),
+		recorded: make(map[netip.Addr]bool),
+		resp:     &pb.ReplayEventsResponse{Config: autoBlockConfigToProto(config)},
+	}
+}
+
+// observe replays one event
+func (r *autoBlockReplay) observe(event *pb.Event) error {
+	r.resp.EventsReplayed++
+	now := time.Unix(event.Timestamp, 0)
+	if event.Type == EventAutoBlocked {
+		r.resp.RecordedBlocks++
+		if addr, err := netip.ParseAddr(event.Source); err == nil {
+			r.recorded[addr.Unmap()] = true
+		}
+		return nil
+	}
+	if now.Sub(r.lastPrune) >= autoBlockPruneInterval {
+		r.blocker.prune(r.config, now)
+		r.lastPrune = now
+	}
+
+	addr, reason, weight, ok := autoBlockCounted(r.config, event)
+	if !ok {
+		return nil
+	}
+	r.resp.EventsCounted++
+	_, offense, exceeded := r.blocker.count(r.config, addr, reason, weight, now)
+	if !exceeded {
+		return nil
+	}
+	active := r.active[:0]
+	for _, until := range r.active {
+		if until.After(now) {
+			active = append(active, until)
+		}
+	}
+	r.active = active
+	if len(r.active) >= int(r.config.MaxBlocks) {
+		return nil
+	}
+
+	until := now.Add(r.config.duration(offense))
+	r.blocker.blocked(addr, offense, until)
+	r.blocker.counted(reason)
+	r.active = append(r.active, until)
+	r.blocked[addr] = true
+	r.resp.BlocksTotal++
+	if len(r.resp.Blocks) < r.limit {
+		r.resp.Blocks = append(r.resp.Blocks, &pb.AutoBlock{
+			Address:   addr.String(),
+			Reason:    reason,
+			BlockedAt: now.Unix(),
+			ExpiresAt: until.Unix(),
+			Offense:   offense,
+		})
+	}
+	return nil
+}
+
+// result completes the response with the sources blocked by only one of
+// the replay and the engine at the time, each ordered by address
+func (r *autoBlockReplay) result() *pb.ReplayEventsResponse {
+	r.resp.ReplayOnly = sortedAddrDifference(r.blocked, r.recorded)
+	r.resp.RecordedOnly = sortedAddrDifference(r.recorded, r.blocked)
+	return r.resp
+}
+
+func sortedAddrDifference(a, b map[netip.Addr]bool) []string {
+	var addrs []netip.Addr
+	for addr := range a {
+		if !b[addr] {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].Less(addrs[j]) })
+	listed := make([]string, len(addrs))
+	for i, addr := range addrs {
+		listed[i] = addr.String()
+	}
+	return listed
+}
+
+// replayWindow returns the window of a replay request, an hour up to now
+// by default
+func replayWindow(startUnix, endUnix int64, now time.Time) (time.Time, time.Time, error) {
+	end := now
+	if endUnix != 0 {
+		end = time.Unix(endUnix, 0)
+	}
+	start := end.Add(-DefaultReplaySeconds * time.Second)
+	if startUnix != 0 {
+		start = time.Unix(startUnix, 0)
+	}
+	var errs ruleValidationError
+	if !start.Before(end) {
+		errs.add("start", "start must be before end")
+	}
+	if end.Sub(start) > eventArchiveRetentionDays*24*time.Hour {
+		errs.add("start", "the window must be at most %d days, the archive's retention", eventArchiveRetentionDays)
+	}
+	if len(errs) > 0 {
+		return start, end, errs
+	}
+	return start, end, nil
+}
+
+// ReplayEvents feeds the archived events of a window through automatic
+// blocking with the given config, blocking nothing, and lists the blocks
+// it would have made next to those made at the time
+func (s *Server) ReplayEvents(ctx context.Context, req *pb.ReplayEventsRequest) (*pb.ReplayEventsResponse, error) {
+	start, end, err := replayWindow(req.Start, req.End, s.clock.Now())
+	if err != nil {
+		return &pb.ReplayEventsResponse{Success: false, Message: fmt.Sprintf("Replay validation failed: %v", err)}, nil
+	}
+	if req.Limit > MaxReplayBlocks {
+		return &pb.ReplayEventsResponse{Success: false, Message: fmt.Sprintf("Replay validation failed: limit must be at most %d", MaxReplayBlocks)}, nil
+	}
+	config := s.autoBlockConfig()
+	if req.Config != nil {
+		if config, err = autoBlockConfigFromProto(req.Config); err != nil {
+			return &pb.ReplayEventsResponse{Success: false, Message: fmt.Sprintf("Replay config validation failed: %v", err)}, nil
+		}
+	}
+	config.Enabled = true
+	if err := validateAutoBlockConfig(&config); err != nil {
+		return &pb.ReplayEventsResponse{Success: false, Message: fmt.Sprintf("Replay config validation failed: %v", err)}, nil
+	}
+	if s.eventArchive == nil {
+		return &pb.ReplayEventsResponse{Success: false, Message: "Event archive is not available"}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultReplayBlocks
+	}
+	replay := newAutoBlockReplay(config, limit)
+	if err := s.eventArchive.Read(ctx, start, end, replay.observe); err != nil {
+		return &pb.ReplayEventsResponse{Success: false, Message: fmt.Sprintf("Failed to replay events: %v", err)}, nil
+	}
+	resp := replay.result()
+	resp.Success = true
+	resp.Message = fmt.Sprintf("Replayed %d events from %s to %s", resp.EventsReplayed,
+		start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	return resp, nil
+}
+
+// runReplay is the cerberusctl replay command: it replays the archive of
+// a state directory without a running control plane, starting from the
+// stored config with the thresholds given as flags
+func runReplay(args []string, stdin io.Reader, stdout io.Writer) error {
+	return replayArchive(args, stdout, systemClock{})
+}
+
+// replayArchive runs the replay command; the window ends at the clock's
+// time unless -to is given
+func replayArchive(args []string, stdout io.Writer, clock Clock) error {
+	flags := flag.NewFlagSet(CtlName+" replay", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	stateDir := flags.String("state-dir", envOrDefault("CERBERUS_STATE_DIR", DefaultStateDir), "state directory holding the event archive")
+	from := flags.String("from", "1h", "start of the window, RFC 3339 or a duration before -to")
+	to := flags.String("to", "", "end of the window, RFC 3339 (default: now)")
+	dropThreshold := flags.Uint("drop-threshold", 0, "estimated dropped packets per window that block a source, 0 = not counted")
+	authThreshold := flags.Uint("auth-failure-threshold", 0, "authentication failures per window that block a source, 0 = not counted")
+	window := flags.Duration("window", 0, "counting window")
+	block := flags.Duration("block", 0, "duration of a first block")
+	backoff := flags.Uint("backoff", 0, "factor each repeated block is longer by")
+	maxBlock := flags.Duration("max-block", 0, "longest block")
+	forget := flags.Duration("forget", 0, "time after a block ends before it is forgotten")
+	exempt := flags.String("exempt", "", "comma-separated prefixes never blocked")
+	limit := flags.Int("limit", DefaultReplayBlocks, "blocks listed at most")
+	asJSON := flags.Bool("json", false, "print the result as JSON")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	end := clock.Now()
+	if *to != "" {
+		parsed, err := time.Parse(time.RFC3339, *to)
+		if err != nil {
+			return fmt.Errorf("invalid -to: %v", err)
+		}
+		end = parsed
+	}
+	start, err := time.Parse(time.RFC3339, *from)
+	if err != nil {
+		ago, durationErr := time.ParseDuration(*from)
+		if durationErr != nil {
+			return fmt.Errorf("invalid -from %q, expected RFC 3339 or a duration", *from)
+		}
+		start = end.Add(-ago)
+	}
+	if _, _, err := replayWindow(start.Unix(), end.Unix(), end); err != nil {
+		return err
+	}
+	if *limit < 0 || *limit > MaxReplayBlocks {
+		return fmt.Errorf("-limit must be between 0 and %d", MaxReplayBlocks)
+	}
+
+	// The stored config, with the flags given replacing its settings
+	var config AutoBlockConfig
+	if data, err := os.ReadFile(filepath.Join(*stateDir, policyFileName)); err == nil {
+		var snapshot PolicySnapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			return fmt.Errorf("failed to parse stored policy: %v", err)
+		}
+		if snapshot.AutoBlock != nil {
+			config = *snapshot.AutoBlock
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read stored policy: %v", err)
+	}
+	proto := autoBlockConfigToProto(config)
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "drop-threshold":
+			proto.DropThreshold = uint32(*dropThreshold)
+		case "auth-failure-threshold":
+			proto.AuthFailureThreshold = uint32(*authThreshold)
+		case "window":
+			proto.WindowSeconds = uint32(*window / time.Second)
+		case "block":
+			proto.BlockSeconds = uint32(*block / time.Second)
+		case "backoff":
+			proto.BackoffFactor = uint32(*backoff)
+		case "max-block":
+			proto.MaxBlockSeconds = uint32(*maxBlock / time.Second)
+		case "forget":
+			proto.ForgetSeconds = uint32(*forget / time.Second)
+		case "exempt":
+			proto.Exempt = nil
+			for _, prefix := range strings.Split(*exempt, ",") {
+				if prefix = strings.TrimSpace(prefix); prefix != "" {
+					proto.Exempt = append(proto.Exempt, prefix)
+				}
+			}
+		}
+	})
+	if config, err = autoBlockConfigFromProto(proto); err != nil {
+		return err
+	}
+	config.Enabled = true
+	if err := validateAutoBlockConfig(&config); err != nil {
+		return err
+	}
+
+	replay := newAutoBlockReplay(config, *limit)
+	if err := readEventArchive(context.Background(), filepath.Join(*stateDir, eventArchiveDirName), start, end, replay.observe); err != nil {
+		return err
+	}
+	resp := replay.result()
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+	printReplay(stdout, resp, start, end)
+	return nil
+}
+
+// printReplay writes a replay result for a terminal
+func printReplay(w io.Writer, resp *pb.ReplayEventsResponse, start, end time.Time) {
+	config := resp.Config
+	fmt.Fprintf(w, "Replayed %d events from %s to %s, %d counted against a source\n",
+		resp.EventsReplayed, start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339), resp.EventsCounted)
+	fmt.Fprintf(w, "Thresholds: %d dropped packets, %d authentication failures in %ds; blocks of %ds x%d up to %ds\n\n",
+		config.DropThreshold, config.AuthFailureThreshold, config.WindowSeconds, config.BlockSeconds, config.BackoffFactor, config.MaxBlockSeconds)
+	fmt.Fprintf(w, "Blocks: %d by the replay, %d at the time\n", resp.BlocksTotal, resp.RecordedBlocks)
+	for _, block := range resp.Blocks {
+		fmt.Fprintf(w, "  %s  %-39s  %-13s  block %d until %s\n", time.Unix(block.BlockedAt, 0).UTC().Format(time.RFC3339),
+			block.Address, block.Reason, block.Offense, time.Unix(block.ExpiresAt, 0).UTC().Format(time.RFC3339))
+	}
+	if omitted := resp.BlocksTotal - uint64(len(resp.Blocks)); omitted > 0 {
+		fmt.Fprintf(w, "  ... %d more\n", omitted)
+	}
+	if len(resp.ReplayOnly) > 0 {
+		fmt.Fprintf(w, "\nBlocked only by the replay: %s\n", strings.Join(resp.ReplayOnly, ", "))
+	}
+	if len(resp.RecordedOnly) > 0 {
+		fmt.Fprintf(w, "\nBlocked only at the time: %s\n", strings.Join(resp.RecordedOnly, ", "))
+	}
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Live event delivery: rule changes, drops and data plane attach/detach
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Event types published on the event bus
+const (
+	EventRuleAdded         = "RULE_ADDED"
+	EventRuleUpdated       = "RULE_UPDATED"
+	EventRuleDeleted       = "RULE_DELETED"
+	EventPacketDrop        = "PACKET_DROP"
+	EventDataPlaneAttached = "DATAPLANE_ATTACHED"
+	EventDataPlaneDetached = "DATAPLANE_DETACHED"
+
+	// Events buffered per subscriber before new ones are discarded, unless
+	// set with CERBERUS_LIMIT_EVENT_QUEUE
+	eventBufferSize = 256
+)
+
+// EventBus fans events out to subscribers. Publishing never blocks: a
+// subscriber that falls behind loses events rather than stalling the caller.
+type EventBus struct {
+	mutex       sync.Mutex
+	subscribers map[*eventSubscription]bool
+	ids         *eventSequence
+	queueSize   int // Events buffered per subscriber
+	closed      bool
+	clock       Clock // Stamps events published without a timestamp
+
+	// Events handed to subscribers and events lost to full buffers
+	delivered uint64
+	discarded uint64
+}
+
+type eventSubscription struct {
+	events chan *pb.Event
+	types  map[string]bool // empty = all types
+	filter *EventFilter    // nil = all events
+}
+
+// NewEventBus creates an event bus without subscribers
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[*eventSubscription]bool), ids: &eventSequence{}, queueSize: eventBufferSize, clock: systemClock{}}
+}
+
+// Subscribe registers for the given event types (all types when empty).
+// The returned channel is closed by the cancel function or by Close.
+func (b *EventBus) Subscribe(types []string) (<-chan *pb.Event, func()) {
+	return b.SubscribeFiltered(types, nil)
+}
+
+// SubscribeFiltered is Subscribe for the events a filter selects, which
+// are the only ones queued
+func (b *EventBus) SubscribeFiltered(types []string, filter *EventFilter) (<-chan *pb.Event, func()) {
+	sub := &eventSubscription{
+		events: make(chan *pb.Event, b.queueSize),
+		types:  make(map[string]bool),
+		filter: filter,
+	}
+	for _, t := range types {
+		sub.types[t] = true
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		close(sub.events)
+		return sub.events, func() {}
+	}
+	b.subscribers[sub] = true
+
+	cancel := func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		if b.subscribers[sub] {
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+	return sub.events, cancel
+}
+
+// Publish stamps an event with an ID, sequence number and timestamp and
+// delivers it to every matching subscriber. Safe to call on a nil bus.
+func (b *EventBus) Publish(event *pb.Event) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	event.Sequence = b.ids.next()
+	event.Node = b.ids.node
+	event.Id = b.ids.id(event.Sequence)
+	if event.Timestamp == 0 {
+		event.Timestamp = b.clock.Now().Unix()
+	}
+
+	for sub := range b.subscribers {
+		if len(sub.types) > 0 && !sub.types[event.Type] || !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+			b.delivered++
+		default:
+			// Subscriber is not keeping up
+			b.discarded++
+		}
+	}
+}
+
+// Deliveries returns the events delivered to subscribers and the events
+// discarded because a subscriber's buffer was full, since the bus started
+func (b *EventBus) Deliveries() (delivered, discarded uint64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.delivered, b.discarded
+}
+
+// SubscriberCount returns the number of active subscriptions
+func (b *EventBus) SubscriberCount() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.subscribers)
+}
+
+// Close ends every subscription so streaming handlers return on shutdown,
+// and records the last event ID for the next run
+func (b *EventBus) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !b.closed {
+		if err := b.ids.close(); err != nil {
+			log.Printf("Failed to save event sequence: %v", err)
+		}
+	}
+	b.closed = true
+	for sub := range b.subscribers {
+		close(sub.events)
+		delete(b.subscribers, sub)
+	}
+}
+
+// SubscribeEvents streams events of the requested types the filter
+// selects until the client disconnects or the server shuts down,
+// anonymized where requested or configured for every export
+func (s *Server) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.FirewallControl_SubscribeEventsServer) error {
+	anonymize := s.anonymizer.applies(req.Anonymize)
+	var anonymizer *Anonymizer
+	if anonymize {
+		anonymizer = s.anonymizer
+	}
+	filter, err := compileEventFilter(req.Filter, anonymizer)
+	if err != nil {
+		return err
+	}
+	release, err := s.limits.acquire(ResourceSubscribers)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	events, cancel := s.events.SubscribeFiltered(req.Types, filter)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if anonymize {
+				event = s.anonymizer.event(event)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StreamEvents streams every event; equivalent to SubscribeEvents without
+// a type filter
+func (s *Server) StreamEvents(req *pb.Empty, stream pb.FirewallControl_StreamEventsServer) error {
+	return s.SubscribeEvents(&pb.SubscribeEventsRequest{}, stream)
+}
+
+// ruleEvent builds a rule lifecycle event
+func ruleEvent(eventType string, rule *FirewallRule) *pb.Event {
+	return &pb.Event{
+		Type:     eventType,
+		Source:   rule.SrcIP,
+		Target:   rule.DstIP,
+		Protocol: rule.Protocol,
+		Port:     rule.DstPort,
+		Message:  fmt.Sprintf("%s rule %s", rule.Action, rule.ID),
+		Severity: "low",
+		RuleId:   rule.ID,
+	}
+}
+
+// watchDrops polls the data plane drop counter and publishes a PACKET_DROP
+// event whenever it grows. Polling is skipped while nobody is subscribed.
+func (s *Server) watchDrops(ctx context.Context, interval time.Duration) {
+	if s.bpfManager == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastDrop uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.events.SubscriberCount() == 0 {
+			lastDrop = 0
+			continue
+		}
+
+		stats, err := s.bpfManager.GetStats()
+		if err != nil {
+			log.Printf("Failed to read drop counter: %v", err)
+			continue
+		}
+		if lastDrop != 0 && stats.Drop > lastDrop {
+			dropped := stats.Drop - lastDrop
+			s.events.Publish(&pb.Event{
+				Type:     EventPacketDrop,
+				Message:  fmt.Sprintf("%d packets dropped in the last %s", dropped, interval),
+				Severity: "medium",
+				Metadata: map[string]string{"count": fmt.Sprintf("%d", dropped)},
+			})
+		}
+		lastDrop = stats.Drop
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule expiry: temporary rules removed from the store and the data plane once their time is up
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	// EventRuleExpired reports a rule the reaper removed at its expiry time
+	EventRuleExpired = "RULE_EXPIRED"
+
+	// MaxRuleTTL is the longest TTL a rule can be added with
+	MaxRuleTTL = 365 * 24 * time.Hour
+)
+
+// ruleTTL converts the TTL of an API rule, where 0 is none, rejecting one
+// out of range before it could overflow the expiry time
+func ruleTTL(seconds int64) (time.Duration, error) {
+	if seconds < 0 || seconds > int64(MaxRuleTTL/time.Second) {
+		var errs ruleValidationError
+		errs.add("ttl_seconds", "TTL %ds out of range: must be 0 for none or 1 to %d", seconds, int64(MaxRuleTTL/time.Second))
+		return 0, errs
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// validateExpiry rejects a rule that would expire before it is enforced
+func validateExpiry(rule *FirewallRule, now time.Time) error {
+	if rule.ExpiresAt.IsZero() || rule.ExpiresAt.After(now) {
+		return nil
+	}
+	var errs ruleValidationError
+	errs.add("expires_at", "expiry %s is not in the future", rule.ExpiresAt.UTC().Format(time.RFC3339))
+	return errs
+}
+
+// reapExpiredRules removes expired rules every interval until ctx is done
+func (s *Server) reapExpiredRules(ctx context.Context, interval time.Duration) {
+	ticker := s.clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			now := s.clock.Now()
+			s.reapExpired(now)
+			s.reapSourceExemptions(now)
+		}
+	}
+}
+
+// reapExpired withdraws every rule expired at now in one policy version
+// and publishes a RULE_EXPIRED event for each. If the data plane rejects
+// the new version the rules stay and the next tick retries.
+func (s *Server) reapExpired(now time.Time) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var expired []*FirewallRule
+	for _, rule := range s.rules {
+		if !rule.ExpiresAt.IsZero() && !rule.ExpiresAt.After(now) {
+			expired = append(expired, rule)
+		}
+	}
+	if len(expired) == 0 {
+		return 0
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i].ID < expired[j].ID })
+
+	for _, rule := range expired {
+		delete(s.rules, rule.ID)
+	}
+	if err := s.applyPolicy(); err != nil {
+		for _, rule := range expired {
+			s.rules[rule.ID] = rule
+		}
+		log.Printf("⚠️  Failed to remove %d expired rules from data plane: %v", len(expired), err)
+		return 0
+	}
+
+	s.persistPolicy()
+	for _, rule := range expired {
+		event := ruleEvent(EventRuleExpired, rule)
+		event.Message = fmt.Sprintf("%s rule %s expired at %s", rule.Action, rule.ID, rule.ExpiresAt.UTC().Format(time.RFC3339))
+		s.events.Publish(event)
+		if _, ok := rule.Labels[AutoBlockLabel]; ok && rule.Owner == AutoBlockOwner {
+			s.events.Publish(autoUnblockEvent(rule, "expired"))
+		}
+		log.Printf("⌛ Expired rule: %s", rule.ID)
+	}
+	return len(expired)
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+func TestRuleExpiresAfterTTL(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s, clock := newTestServer(start)
+	events, cancel := s.events.Subscribe([]string{EventRuleExpired})
+	defer cancel()
+
+	resp, err := s.AddRule(context.Background(), &pb.AddRuleRequest{Rule: &pb.Rule{
+		Action: "drop", SrcIp: "203.0.113.7", Enabled: true, TtlSeconds: 600,
+	}})
+	if err != nil || !resp.Success {
+		t.Fatalf("AddRule = %v, %v", resp, err)
+	}
+	if resp.RuleId != "rule_1" {
+		t.Fatalf("rule ID = %s, want rule_1", resp.RuleId)
+	}
+	if got, want := s.rules["rule_1"].ExpiresAt, start.Add(10*time.Minute); !got.Equal(want) {
+		t.Fatalf("ExpiresAt = %v, want %v", got, want)
+	}
+
+	if reaped := s.reapExpired(clock.Advance(10*time.Minute - time.Second)); reaped != 0 {
+		t.Fatalf("reaped %d rules a second before expiry", reaped)
+	}
+	if reaped := s.reapExpired(clock.Advance(time.Second)); reaped != 1 {
+		t.Fatalf("reaped %d rules at expiry, want 1", reaped)
+	}
+	if _, exists := s.rules["rule_1"]; exists {
+		t.Fatal("expired rule still in the store")
+	}
+	if len(s.compiledPolicy().Sorted) != 0 {
+		t.Fatal("expired rule still in the compiled policy")
+	}
+
+	select {
+	case event := <-events:
+		if event.RuleId != "rule_1" {
+			t.Fatalf("expiry event for %s, want rule_1", event.RuleId)
+		}
+	default:
+		t.Fatal("no RULE_EXPIRED event")
+	}
+}
+
+func TestReaperTicksOnServerClock(t *testing.T) {
+	s, clock := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	events, cancel := s.events.Subscribe([]string{EventRuleExpired})
+	defer cancel()
+	if resp, _ := s.AddRule(context.Background(), &pb.AddRuleRequest{Rule: &pb.Rule{
+		Action: "drop", SrcIp: "203.0.113.7", Enabled: true, TtlSeconds: 60,
+	}}); !resp.Success {
+		t.Fatal(resp.Message)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	go s.reapExpiredRules(ctx, time.Minute)
+
+	// The reaper may not hold its ticker yet, so keep advancing until it fires
+	deadline := time.After(5 * time.Second)
+	for {
+		clock.Advance(time.Minute)
+		select {
+		case event := <-events:
+			if event.RuleId != "rule_1" {
+				t.Fatalf("expiry event for %s, want rule_1", event.RuleId)
+			}
+			return
+		case <-deadline:
+			t.Fatal("reaper never ran on the server clock")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestAddRuleRejectsPastExpiry(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s, _ := newTestServer(start)
+
+	resp, err := s.AddRule(context.Background(), &pb.AddRuleRequest{Rule: &pb.Rule{
+		Action: "drop", SrcIp: "203.0.113.7", Enabled: true, ExpiresAt: start.Unix(),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Success {
+		t.Fatal("rule expiring now was added")
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Field != "expires_at" {
+		t.Fatalf("errors = %v, want one expires_at error", resp.Errors)
+	}
+}
+
+func TestRulesWithoutExpiryAreKept(t *testing.T) {
+	s, clock := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	if resp, _ := s.AddRule(context.Background(), &pb.AddRuleRequest{Rule: &pb.Rule{
+		Action: "drop", SrcIp: "203.0.113.7", Enabled: true,
+	}}); !resp.Success {
+		t.Fatal(resp.Message)
+	}
+
+	if reaped := s.reapExpired(clock.Advance(365 * 24 * time.Hour)); reaped != 0 {
+		t.Fatalf("reaped %d rules without expiry", reaped)
+	}
+}
+
+func TestAddRuleRejectsTTLOutOfRange(t *testing.T) {
+	s, _ := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	for _, ttl := range []int64{-1, int64(MaxRuleTTL/time.Second) + 1, 1 << 62} {
+		resp, err := s.AddRule(context.Background(), &pb.AddRuleRequest{Rule: &pb.Rule{
+			Action: "drop", SrcIp: "203.0.113.7", Enabled: true, TtlSeconds: ttl,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Success {
+			t.Fatalf("rule with TTL %d was added", ttl)
+		}
+		if len(resp.Errors) != 1 || resp.Errors[0].Field != "ttl_seconds" {
+			t.Fatalf("TTL %d: errors = %v, want one ttl_seconds error", ttl, resp.Errors)
+		}
+	}
+}
+
+func TestReplaceAndImportRejectExpiredAndOutOfRangeTTLs(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	invalid := []*pb.Rule{
+		{Action: "drop", SrcIp: "203.0.113.7", Enabled: true, ExpiresAt: start.Add(-time.Hour).Unix()},
+		{Action: "drop", SrcIp: "203.0.113.8", Enabled: true, TtlSeconds: -1},
+		{Action: "drop", SrcIp: "203.0.113.9", Enabled: true, TtlSeconds: int64(MaxRuleTTL/time.Second) + 1},
+	}
+
+	for _, rule := range invalid {
+		s, _ := newTestServer(start)
+		replaced, err := s.ReplaceRules(context.Background(), &pb.ReplaceRulesRequest{Rules: []*pb.Rule{rule}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replaced.Success || len(replaced.Errors) != 1 {
+			t.Fatalf("ReplaceRules with %v = %v, want one error", rule, replaced)
+		}
+
+		imported, err := s.importRules(context.Background(), []*pb.Rule{rule}, nil, nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if imported.Success || len(imported.Errors) != 1 {
+			t.Fatalf("import of %v = %v, want one error", rule, imported)
+		}
+		if len(s.rules) != 0 {
+			t.Fatalf("%d rules stored after rejected batches", len(s.rules))
+		}
+	}
+}
@@ -0,0 +1,381 @@
+// SPDX-License-Identifier: Apache-2.0
+// Verdict explanation: why the data plane dropped a recently sampled packet
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// DefaultRecentDrops is the number of sampled drops kept for ExplainVerdict
+const DefaultRecentDrops = 1024
+
+// sampledDrop is a PACKET_SAMPLE event with a drop verdict and the state
+// of the control plane when it arrived
+type sampledDrop struct {
+	event         *pb.Event
+	policyVersion uint64
+	degradeStage  string
+	degradeFlags  uint32
+}
+
+// dropLog keeps the most recent sampled drops by event ID
+type dropLog struct {
+	mutex sync.Mutex
+	drops map[string]*sampledDrop
+	order []string // Ring of event IDs, oldest at next once full
+	next  int
+}
+
+func newDropLog(capacity int) *dropLog {
+	return &dropLog{drops: make(map[string]*sampledDrop), order: make([]string, 0, capacity)}
+}
+
+// add records a drop, evicting the oldest once the log is full
+func (l *dropLog) add(drop *sampledDrop) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if len(l.order) < cap(l.order) {
+		l.order = append(l.order, drop.event.Id)
+	} else {
+		delete(l.drops, l.order[l.next])
+		l.order[l.next] = drop.event.Id
+		l.next = (l.next + 1) % len(l.order)
+	}
+	l.drops[drop.event.Id] = drop
+}
+
+func (l *dropLog) get(id string) *sampledDrop {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.drops[id]
+}
+
+// recordDrops keeps the sampled drops published on the event bus until ctx
+// is done, stamped with the policy version and degradation stage they were
+// dropped under. The version the data plane stamped on the sample is used
+// when present; the running version when the sample arrived otherwise.
+func (s *Server) recordDrops(ctx context.Context) {
+	events, cancel := s.events.Subscribe([]string{EventPacketSample})
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.Metadata["verdict"] != "drop" {
+				continue
+			}
+			drop := &sampledDrop{event: event, policyVersion: s.compiledPolicy().Version, degradeStage: DegradeStageNormal}
+			if version, ok := eventPolicyVersion(event); ok {
+				drop.policyVersion = version
+			}
+			if s.degradation != nil {
+				status := s.degradation.Status()
+				drop.degradeStage, drop.degradeFlags = status.Stage, status.Flags
+			}
+			s.drops.add(drop)
+		}
+	}
+}
+
+// sampledPacket is the packet of a sample event in data plane terms
+type sampledPacket struct {
+	src, dst         netip.Addr
+	srcPort, dstPort uint16
+	protocol         uint8
+	state            uint8 // connStateBits value
+	tcpFlags         uint8 // Byte 13 of the TCP header
+	vlan             uint16
+	srcMAC, dstMAC   [6]byte
+	iface            string // Interface the packet arrived on, empty = unknown
+	family           int
+	srcSets, dstSets map[uint32]bool // IDs of the IP sets holding the addresses
+	srcCountry       string          // GeoIP country of the source, empty = none
+	dstCountry       string          // GeoIP country of the destination, empty = none
+	srcASN, dstASN   uint32          // Origin ASNs of the addresses, 0 = none
+	defaultAction    string          // Configured inbound default, empty = built-in
+}
+
+// packetFromEvent reconstructs the packet of a PACKET_SAMPLE event
+func packetFromEvent(event *pb.Event) (*sampledPacket, error) {
+	src, err := netip.ParseAddr(event.Source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source address %q: %v", event.Source, err)
+	}
+	dst, err := netip.ParseAddr(event.Target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination address %q: %v", event.Target, err)
+	}
+	srcPort, err := strconv.ParseUint(event.Metadata["src_port"], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source port %q: %v", event.Metadata["src_port"], err)
+	}
+	tcpFlags, err := parseTCPFlagList(event.Metadata["tcp_flags"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid TCP flags %q: %v", event.Metadata["tcp_flags"], err)
+	}
+
+	vlan, err := strconv.ParseUint(event.Metadata["vlan"], 10, 12)
+	if err != nil && event.Metadata["vlan"] != "" {
+		return nil, fmt.Errorf("invalid VLAN %q: %v", event.Metadata["vlan"], err)
+	}
+	srcMAC, err := sampledMAC(event.Metadata["src_mac"])
+	if err != nil {
+		return nil, err
+	}
+	dstMAC, err := sampledMAC(event.Metadata["dst_mac"])
+	if err != nil {
+		return nil, err
+	}
+
+	packet := &sampledPacket{
+		src:      src.Unmap(),
+		dst:      dst.Unmap(),
+		srcPort:  uint16(srcPort),
+		dstPort:  uint16(event.Port),
+		protocol: protocolToUint8(event.Protocol),
+		state:    connStateBits[event.Metadata["conn_state"]],
+		tcpFlags: tcpFlags,
+		vlan:     uint16(vlan),
+		srcMAC:   srcMAC,
+		dstMAC:   dstMAC,
+		iface:    event.Interface,
+		family:   familyIPv4,
+	}
+	if packet.src.Is6() {
+		packet.family = familyIPv6
+		if packet.protocol == 1 {
+			packet.protocol = 58 // ICMPv6
+		}
+	}
+	return packet, nil
+}
+
+// sampledMAC parses a MAC address of a sample, all zero if the sample
+// predates them
+func sampledMAC(value string) ([6]byte, error) {
+	var mac [6]byte
+	if value == "" {
+		return mac, nil
+	}
+	hw, err := net.ParseMAC(value)
+	if err != nil || len(hw) != len(mac) {
+		return mac, fmt.Errorf("invalid MAC address %q", value)
+	}
+	copy(mac[:], hw)
+	return mac, nil
+}
+
+// verdictExplanation is how the data plane decides on a packet
+type verdictExplanation struct {
+	verdict     string
+	stage       string // Pipeline stage deciding the verdict
+	disposition string // Name in dispositionNames
+	rule        *FirewallRule
+}
+
+// explainPacket evaluates a host data plane packet against a compiled
+// policy the way the XDP program does: the enabled entry with the lowest
+// priority number whose prefixes contain the addresses and whose L2 and L4 fields,
+// interfaces and IP sets match, else the configured or built-in defaults
+func explainPacket(policy *CompiledPolicy, packet *sampledPacket, degradeFlags uint32) verdictExplanation {
+	prefixHit := false
+	for _, entry := range policy.Sorted {
+		if entry.Namespace != "" || entry.VF != "" || !tableAccepts(packet.family, false, entry) {
+			continue
+		}
+		if !rulePrefix(entry.SrcIP, packet.family).Contains(packet.src) ||
+			!rulePrefix(entry.DstIP, packet.family).Contains(packet.dst) {
+			continue
+		}
+		prefixHit = true
+		if degradeFlags&DegradeDefaultOnly != 0 {
+			break
+		}
+
+		encoded := encodeRule(entry, packet.family)
+		if encoded.Enabled == 0 {
+			continue
+		}
+		if encoded.Protocol != 0 && encoded.Protocol != packet.protocol {
+			continue
+		}
+		if !portMatches(packet.srcPort, encoded.SrcPort, encoded.SrcPortEnd) ||
+			!portMatches(packet.dstPort, encoded.DstPort, encoded.DstPortEnd) {
+			continue
+		}
+		if encoded.CtState != 0 && encoded.CtState&packet.state == 0 {
+			continue
+		}
+		if !tcpFlagsMatch(packet.protocol, packet.tcpFlags, encoded.Flags, encoded.FlagsMask) {
+			continue
+		}
+		if !l2Match(packet.vlan, packet.srcMAC, packet.dstMAC, encoded) {
+			continue
+		}
+		// Samples that predate interfaces match no interface-scoped rule
+		if len(entry.Interfaces) > 0 && !slices.Contains(entry.Interfaces, packet.iface) {
+			continue
+		}
+		if (encoded.SrcSet != 0 && !packet.srcSets[encoded.SrcSet]) ||
+			(encoded.DstSet != 0 && !packet.dstSets[encoded.DstSet]) {
+			continue
+		}
+		if (len(entry.SrcCountry) > 0 && !slices.Contains(entry.SrcCountry, packet.srcCountry)) ||
+			(len(entry.DstCountry) > 0 && !slices.Contains(entry.DstCountry, packet.dstCountry)) {
+			continue
+		}
+		if (len(entry.SrcASN) > 0 && !slices.Contains(entry.SrcASN, packet.srcASN)) ||
+			(len(entry.DstASN) > 0 && !slices.Contains(entry.DstASN, packet.dstASN)) {
+			continue
+		}
+
+		explanation := verdictExplanation{stage: "rules", disposition: "rule", rule: entry}
+		if encoded.CtState != 0 {
+			explanation.disposition = "conntrack"
+		}
+		switch entry.Action {
+		case "drop", "reject":
+			explanation.verdict = "drop"
+		case "redirect":
+			explanation.verdict = "redirect"
+			if degradeFlags&DegradeNoSlowPath != 0 {
+				explanation.verdict = "pass"
+			}
+		default:
+			explanation.verdict = "pass"
+		}
+		return explanation
+	}
+
+	explanation := verdictExplanation{stage: "actions", disposition: "default"}
+	switch {
+	case degradeFlags&DegradeDefaultOnly != 0:
+		explanation.disposition = "degraded"
+	case prefixHit:
+		explanation.disposition = "ipset"
+	}
+	switch {
+	case packet.defaultAction == DefaultActionDrop:
+		explanation.verdict = "drop"
+	case packet.defaultAction == DefaultActionAllow:
+		explanation.verdict = "pass"
+	case packet.family == familyIPv6:
+		explanation.verdict = "pass"
+	case packet.protocol == 1:
+		explanation.verdict = "drop"
+	case packet.protocol == 6 && degradeFlags&DegradeNoSlowPath == 0:
+		explanation.verdict = "redirect"
+	default:
+		explanation.verdict = "pass"
+	}
+	return explanation
+}
+
+// resolvePacket fills in what the data plane looks up for a packet: the IP
+// sets, countries and ASNs of its addresses and the inbound default of its
+// interface. Caller must hold s.mutex.
+func (s *Server) resolvePacket(packet *sampledPacket) {
+	packet.srcSets, packet.dstSets = s.ipSetsContaining(packet.src), s.ipSetsContaining(packet.dst)
+	if s.geoIP != nil {
+		packet.srcCountry, packet.dstCountry = s.geoIP.country(packet.src), s.geoIP.country(packet.dst)
+	}
+	if s.asnDB != nil {
+		packet.srcASN, packet.dstASN = s.asnDB.origin(packet.src), s.asnDB.origin(packet.dst)
+	}
+	// Samples that predate interfaces get the policy for all
+	packet.defaultAction = s.defaultPolicyFor(packet.iface).Inbound
+}
+
+// portMatches mirrors port_match: a zero start matches any port, a zero end
+// makes the range a single port
+func portMatches(port, start, end uint16) bool {
+	if start == 0 {
+		return true
+	}
+	if end == 0 {
+		end = start
+	}
+	return port >= start && port <= end
+}
+
+// ExplainVerdict reconstructs why a recently sampled packet was dropped:
+// the packet comes from the event, the decision from the running policy
+// evaluated under the degradation stage in force when it was sampled
+func (s *Server) ExplainVerdict(ctx context.Context, req *pb.ExplainVerdictRequest) (*pb.ExplainVerdictResponse, error) {
+	drop := s.drops.get(req.EventId)
+	if drop == nil {
+		return &pb.ExplainVerdictResponse{
+			Success: false,
+			Message: fmt.Sprintf("No recent sampled drop with event ID %q", req.EventId),
+		}, nil
+	}
+	packet, err := packetFromEvent(drop.event)
+	if err != nil {
+		return &pb.ExplainVerdictResponse{Success: false, Message: fmt.Sprintf("Cannot reconstruct packet: %v", err)}, nil
+	}
+
+	s.mutex.RLock()
+	policy := s.compiledPolicy()
+	s.resolvePacket(packet)
+	var generation uint32
+	if s.bpfManager != nil {
+		generation = s.bpfManager.activeGeneration
+	}
+	s.mutex.RUnlock()
+
+	explanation := explainPacket(policy, packet, drop.degradeFlags)
+	resp := &pb.ExplainVerdictResponse{
+		Success:              true,
+		Event:                drop.event,
+		Verdict:              explanation.verdict,
+		Stage:                explanation.stage,
+		Disposition:          explanation.disposition,
+		ConnState:            drop.event.Metadata["conn_state"],
+		PolicyVersion:        policy.Version,
+		SampledPolicyVersion: drop.policyVersion,
+		Generation:           generation,
+		Degradation:          drop.degradeStage,
+		Consistent:           explanation.verdict == "drop",
+	}
+
+	var reasons []string
+	switch {
+	case explanation.rule != nil:
+		resp.RuleId = explanation.rule.ID
+		resp.Rule = toProtoRule(explanation.rule)
+		reasons = append(reasons, fmt.Sprintf("rule %s (%s, priority %d) matched", explanation.rule.ID, explanation.rule.Action, explanation.rule.Priority))
+	case explanation.disposition == "degraded":
+		reasons = append(reasons, fmt.Sprintf("rules were skipped at degradation stage %s, built-in defaults applied", drop.degradeStage))
+	case explanation.disposition == "ipset":
+		reasons = append(reasons, "the addresses hit rule prefixes but no rule matched the rest of the packet, built-in defaults applied")
+	default:
+		reasons = append(reasons, "no rule covers the addresses, built-in defaults applied")
+	}
+	if explanation.rule == nil && explanation.verdict == "drop" {
+		reasons = append(reasons, "IPv4 ICMP is dropped by default")
+	}
+	if resp.PolicyVersion != resp.SampledPolicyVersion {
+		reasons = append(reasons, fmt.Sprintf("policy changed from version %d to %d since the drop", resp.SampledPolicyVersion, resp.PolicyVersion))
+	}
+	if !resp.Consistent {
+		reasons = append(reasons, fmt.Sprintf("the running policy would %s this packet", explanation.verdict))
+	}
+	resp.Message = strings.Join(reasons, "; ")
+	return resp, nil
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule documents: the rule set as versioned JSON or YAML, for keeping policies in Git and moving them between hosts
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule document formats
+const (
+	RuleDocumentJSON = "json"
+	RuleDocumentYAML = "yaml"
+
+	ruleDocumentVersion = 1
+)
+
+// RuleDocument is the exported form of the rule set. It carries the
+// services and address objects its rules reference, so it imports on a
+// host that lacks them.
+type RuleDocument struct {
+	Version        int              `json:"version" yaml:"version"`
+	ExportedAt     time.Time        `json:"exported_at" yaml:"exported_at,omitempty"`
+	PolicyVersion  uint64           `json:"policy_version" yaml:"policy_version,omitempty"`
+	Rules          []*FirewallRule  `json:"rules" yaml:"rules"`
+	Services       []*Service       `json:"services,omitempty" yaml:"services,omitempty"`
+	AddressObjects []*AddressObject `json:"address_objects,omitempty" yaml:"address_objects,omitempty"`
+}
+
+// ruleDocument builds the document of the current rule set: rules in
+// evaluation order, objects by name. Caller must hold s.mutex.
+func (s *Server) ruleDocument() *RuleDocument {
+	rules := make([]*FirewallRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+	doc := &RuleDocument{
+		Version:       ruleDocumentVersion,
+		ExportedAt:    s.clock.Now().UTC(),
+		PolicyVersion: s.compiledPolicy().Version,
+		Rules:         sortByPriority(rules),
+	}
+
+	services := make(map[string]bool)
+	objects := make(map[string]bool)
+	var addObject func(name string)
+	addObject = func(name string) {
+		object, exists := s.addressObjects[name]
+		if !exists || objects[name] {
+			return
+		}
+		objects[name] = true
+		doc.AddressObjects = append(doc.AddressObjects, object)
+		for _, member := range object.Members {
+			addObject(member)
+		}
+	}
+	for _, rule := range doc.Rules {
+		if service, exists := s.services[rule.Service]; exists && !services[rule.Service] {
+			services[rule.Service] = true
+			doc.Services = append(doc.Services, service)
+		}
+		addObject(rule.SrcAddress)
+		addObject(rule.DstAddress)
+	}
+	sort.Slice(doc.Services, func(i, j int) bool { return doc.Services[i].Name < doc.Services[j].Name })
+	sort.Slice(doc.AddressObjects, func(i, j int) bool { return doc.AddressObjects[i].Name < doc.AddressObjects[j].Name })
+	return doc
+}
+
+// marshalRuleDocument encodes a document in a format, JSON by default
+func marshalRuleDocument(doc *RuleDocument, format string) ([]byte, string, error) {
+	switch strings.ToLower(format) {
+	case "", RuleDocumentJSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode rule document: %v", err)
+		}
+		return append(data, '\n'), RuleDocumentJSON, nil
+	case RuleDocumentYAML, "yml":
+		var buffer bytes.Buffer
+		encoder := yaml.NewEncoder(&buffer)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return nil, "", fmt.Errorf("failed to encode rule document: %v", err)
+		}
+		encoder.Close()
+		return buffer.Bytes(), RuleDocumentYAML, nil
+	default:
+		return nil, "", fmt.Errorf("unknown document format %q, expected json, yaml or nft", format)
+	}
+}
+
+// parseRuleDocument decodes a document; an empty format is detected from
+// the content. Unknown fields are rejected so typos do not silently drop
+// settings.
+func parseRuleDocument(data []byte, format string) (*RuleDocument, error) {
+	format = strings.ToLower(format)
+	if format == "" {
+		format = RuleDocumentYAML
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+			format = RuleDocumentJSON
+		}
+	}
+
+	var doc RuleDocument
+	switch format {
+	case RuleDocumentJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("invalid JSON rule document: %v", err)
+		}
+	case RuleDocumentYAML, "yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("invalid YAML rule document: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown document format %q, expected json or yaml", format)
+	}
+
+	if doc.Version == 0 {
+		return nil, fmt.Errorf("rule document has no version")
+	}
+	if doc.Version > ruleDocumentVersion {
+		return nil, fmt.Errorf("rule document version %d is newer than supported version %d", doc.Version, ruleDocumentVersion)
+	}
+	return &doc, nil
+}
+
+// ExportRules returns the rule set as a JSON or YAML document that
+// ImportRules accepts, or as an nftables script of the running policy
+func (s *Server) ExportRules(ctx context.Context, req *pb.ExportRulesRequest) (*pb.ExportRulesResponse, error) {
+	if format := strings.ToLower(req.Format); format == RuleDocumentNFT || format == "nftables" {
+		policy := s.compiledPolicy()
+		script, count := nftablesScript(policy, s.clock.Now())
+		return &pb.ExportRulesResponse{
+			Document: script,
+			Format:   RuleDocumentNFT,
+			Count:    int32(count),
+			Version:  policy.Version,
+		}, nil
+	}
+
+	s.mutex.RLock()
+	doc := s.ruleDocument()
+	s.mutex.RUnlock()
+
+	data, format, err := marshalRuleDocument(doc, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportRulesResponse{
+		Document: data,
+		Format:   format,
+		Count:    int32(len(doc.Rules)),
+		Version:  doc.PolicyVersion,
+	}, nil
+}
@@ -0,0 +1,608 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy federation: regional controllers subscribe to the baseline rules
+// of a global policy service, install them next to their local rules and
+// report back where the two overlap with different actions
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// FederationLabel marks the baseline rules a regional controller
+	// installed; they are changed only by the global policy service
+	FederationLabel         = "federation"
+	FederationBaselineValue = "baseline"
+	// FederatedRulePrefix prefixes the IDs of installed baseline rules
+	FederatedRulePrefix = "fed-"
+
+	// Baseline rules are moved this far ahead of the local rules, or
+	// behind them, by the site's precedence; local rules keep within it
+	FederationPriorityBand = 1 << 30
+
+	// Precedences: which rules are evaluated first where baseline and
+	// local rules overlap
+	PrecedenceGlobal = "global"
+	PrecedenceLocal  = "local"
+
+	// EventBaselineApplied reports a baseline revision installed
+	EventBaselineApplied = "BASELINE_APPLIED"
+
+	// How often a global policy service checks its baseline for changes,
+	// how often a site re-reports while its local rules change, and the
+	// longest wait before resubscribing
+	federationPollInterval   = time.Second
+	federationReportInterval = 30 * time.Second
+	federationMaxBackoff     = time.Minute
+)
+
+// federation is the federation role of a controller: a global policy
+// service exporting a baseline, a regional site subscribed to one, or both
+type federation struct {
+	// Global policy service: rules exported as the baseline, empty = none
+	exportSelector labelSelector
+	exportRaw      string
+
+	// Regional site: global policy service subscribed to, empty = none
+	upstream   string
+	site       string
+	precedence string
+
+	mutex    sync.Mutex
+	sites    map[string]*pb.SiteStatus // Reported by subscribed sites
+	watchers map[string]int            // Open subscriptions by site
+
+	connected bool
+	revision  string // Baseline revision installed
+	appliedAt time.Time
+	lastError string // Why the latest baseline was not installed
+}
+
+// federationFromEnv reads the federation role: CERBERUS_FEDERATION_EXPORT
+// selects the rules exported as the baseline, CERBERUS_FEDERATION_UPSTREAM
+// is the gRPC address of the global policy service to subscribe to. Nil
+// when neither is set.
+func federationFromEnv() (*federation, error) {
+	f := &federation{
+		exportRaw:  strings.TrimSpace(os.Getenv("CERBERUS_FEDERATION_EXPORT")),
+		upstream:   strings.TrimSpace(os.Getenv("CERBERUS_FEDERATION_UPSTREAM")),
+		precedence: PrecedenceGlobal,
+		sites:      make(map[string]*pb.SiteStatus),
+		watchers:   make(map[string]int),
+	}
+	if f.exportRaw == "" && f.upstream == "" {
+		return nil, nil
+	}
+	if f.exportRaw != "" {
+		selector, err := requiredLabelSelector(f.exportRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CERBERUS_FEDERATION_EXPORT: %v", err)
+		}
+		f.exportSelector = selector
+	}
+	if f.upstream == "" {
+		return f, nil
+	}
+
+	if value := os.Getenv("CERBERUS_FEDERATION_PRECEDENCE"); value != "" {
+		if value != PrecedenceGlobal && value != PrecedenceLocal {
+			return nil, fmt.Errorf("invalid CERBERUS_FEDERATION_PRECEDENCE %q, expected %s or %s", value, PrecedenceGlobal, PrecedenceLocal)
+		}
+		f.precedence = value
+	}
+	f.site = os.Getenv("CERBERUS_FEDERATION_SITE")
+	if f.site == "" {
+		f.site = os.Getenv("CERBERUS_NODE_ID")
+	}
+	if f.site == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("CERBERUS_FEDERATION_SITE is required: %v", err)
+		}
+		f.site = hostname
+	}
+	return f, nil
+}
+
+func (f *federation) logSummary() {
+	if f.exportRaw != "" {
+		log.Printf("Federation: exporting rules matching %s as the baseline", f.exportRaw)
+	}
+	if f.upstream != "" {
+		log.Printf("Federation: site %s subscribing to the baseline of %s, %s precedence", f.site, f.upstream, f.precedence)
+	}
+}
+
+// federatedRule reports whether a rule or entry is an installed baseline rule
+func federatedRule(rule *FirewallRule) bool {
+	_, federated := rule.Labels[FederationLabel]
+	return federated
+}
+
+// federatedRules returns the installed baseline rules by ID, which rule
+// set replacements keep. Caller must hold s.mutex.
+func (s *Server) federatedRules() map[string]*FirewallRule {
+	rules := make(map[string]*FirewallRule)
+	for id, rule := range s.rules {
+		if federatedRule(rule) {
+			rules[id] = rule
+		}
+	}
+	return rules
+}
+
+// federatedRuleMessage refuses a local change of an installed baseline rule
+func federatedRuleMessage(rule *FirewallRule) string {
+	return fmt.Sprintf("Rule %s is maintained by the federation baseline; change it on the global policy service", rule.ID)
+}
+
+// validateFederatedPriority keeps the priorities of local rules out of the
+// bands baseline rules are moved into
+func (s *Server) validateFederatedPriority(rule *FirewallRule, errs *ruleValidationError) {
+	if s.federation == nil || s.federation.upstream == "" || federatedRule(rule) {
+		return
+	}
+	if rule.Priority <= -FederationPriorityBand || rule.Priority >= FederationPriorityBand {
+		errs.add("priority", "priority %d is reserved for federated baseline rules, expected %d to %d",
+			rule.Priority, -FederationPriorityBand+1, FederationPriorityBand-1)
+	}
+}
+
+// baseline builds the baseline this controller exports: the selected
+// rules with their service and address references resolved, since those
+// objects are local to each site. Caller must hold s.mutex.
+func (s *Server) baseline() *pb.Baseline {
+	baseline := &pb.Baseline{}
+	for _, rule := range s.selectRules(s.federation.exportSelector) {
+		switch {
+		case federatedRule(rule):
+			baseline.Skipped = append(baseline.Skipped, fmt.Sprintf("rule %s: installed from the baseline of %s", rule.ID, s.federation.upstream))
+			continue
+		case rule.SrcSet != 0 || rule.DstSet != 0:
+			baseline.Skipped = append(baseline.Skipped, fmt.Sprintf("rule %s: IP set IDs are local to each site", rule.ID))
+			continue
+		case rule.Namespace != "" || rule.VF != "":
+			baseline.Skipped = append(baseline.Skipped, fmt.Sprintf("rule %s: namespaces and VFs are local to each site", rule.ID))
+			continue
+		}
+		for _, entry := range resolveRuleObjects(rule, s.services, s.addressObjects) {
+			p := toProtoRule(entry)
+			p.Id = strings.ReplaceAll(entry.ID, "#", "-")
+			p.Service, p.SrcAddress, p.DstAddress = "", "", ""
+			p.CreatedAt, p.UpdatedAt = 0, 0
+			baseline.Rules = append(baseline.Rules, p)
+		}
+	}
+	content, _ := proto.MarshalOptions{Deterministic: true}.Marshal(baseline)
+	digest := sha256.Sum256(content)
+	baseline.Revision = hex.EncodeToString(digest[:8])
+	baseline.PublishedAt = s.clock.Now().Unix()
+	return baseline
+}
+
+// WatchBaseline streams the exported baseline to a regional site: the
+// current revision at once, then every new revision
+func (s *Server) WatchBaseline(req *pb.WatchBaselineRequest, stream pb.FirewallControl_WatchBaselineServer) error {
+	f := s.federation
+	if f == nil || f.exportRaw == "" {
+		return status.Error(codes.FailedPrecondition, "this controller exports no baseline, set CERBERUS_FEDERATION_EXPORT")
+	}
+	if req.Site == "" {
+		return status.Error(codes.InvalidArgument, "site is required")
+	}
+
+	f.mutex.Lock()
+	f.watchers[req.Site]++
+	f.mutex.Unlock()
+	defer func() {
+		f.mutex.Lock()
+		f.watchers[req.Site]--
+		f.mutex.Unlock()
+		log.Printf("Site %s unsubscribed from the baseline", req.Site)
+	}()
+	log.Printf("Site %s subscribed to the baseline", req.Site)
+
+	ticker := time.NewTicker(federationPollInterval)
+	defer ticker.Stop()
+	var sent string
+	var version uint64
+	for first := true; ; first = false {
+		// Every change of rules or objects compiles a new policy version
+		if current := s.compiledPolicy().Version; first || current != version {
+			version = current
+			s.mutex.RLock()
+			baseline := s.baseline()
+			s.mutex.RUnlock()
+			if baseline.Revision != sent {
+				if err := stream.Send(baseline); err != nil {
+					return err
+				}
+				sent = baseline.Revision
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReportSiteStatus records how a regional site installed the baseline
+func (s *Server) ReportSiteStatus(ctx context.Context, req *pb.SiteStatus) (*pb.StatusResponse, error) {
+	f := s.federation
+	if f == nil || f.exportRaw == "" {
+		return &pb.StatusResponse{Success: false, Message: "This controller exports no baseline"}, nil
+	}
+	if req.Site == "" {
+		return &pb.StatusResponse{Success: false, Message: "Site is required"}, nil
+	}
+
+	report := proto.Clone(req).(*pb.SiteStatus)
+	report.ReportedAt = s.clock.Now().Unix()
+	f.mutex.Lock()
+	previous := f.sites[report.Site]
+	f.sites[report.Site] = report
+	f.mutex.Unlock()
+
+	if previous == nil || previous.Error != report.Error || len(previous.Overrides) != len(report.Overrides) {
+		if report.Error != "" {
+			log.Printf("⚠️  Site %s did not install baseline: %s", report.Site, report.Error)
+		} else {
+			log.Printf("Site %s runs baseline %s with %d overrides (%s precedence)",
+				report.Site, report.Revision, len(report.Overrides), report.Precedence)
+		}
+	}
+	return &pb.StatusResponse{Success: true, Message: "Site status recorded"}, nil
+}
+
+// GetFederationStatus returns the exported baseline and the sites reporting
+// on it, and this controller's own site status when it subscribes to one
+func (s *Server) GetFederationStatus(ctx context.Context, req *pb.Empty) (*pb.FederationStatus, error) {
+	f := s.federation
+	if f == nil {
+		return &pb.FederationStatus{}, nil
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.FederationStatus{ExportSelector: f.exportRaw, Upstream: f.upstream}
+	if f.exportRaw != "" {
+		resp.Revision = s.baseline().Revision
+	}
+	if f.upstream != "" {
+		resp.Site = s.siteStatus()
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	resp.Connected = f.connected
+	names := make(map[string]bool)
+	for name := range f.sites {
+		names[name] = true
+	}
+	for name, watchers := range f.watchers {
+		if watchers > 0 {
+			names[name] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		site := &pb.SiteStatus{Site: name}
+		if report := f.sites[name]; report != nil {
+			site = proto.Clone(report).(*pb.SiteStatus)
+		}
+		site.Connected = f.watchers[name] > 0
+		resp.Sites = append(resp.Sites, site)
+	}
+	return resp, nil
+}
+
+// runFederation subscribes to the baseline of the global policy service
+// and installs every revision, resubscribing with backoff until ctx is
+// done
+func (s *Server) runFederation(ctx context.Context) {
+	f := s.federation
+	conn, err := grpc.NewClient(f.upstream,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(DefaultGRPCMaxRecvMsgSize)))
+	if err != nil {
+		log.Printf("⚠️  Federation disabled: %v", err)
+		return
+	}
+	defer conn.Close()
+	client := pb.NewFirewallControlClient(conn)
+
+	backoff := time.Second
+	for {
+		received, err := s.watchUpstream(ctx, client)
+		f.mutex.Lock()
+		f.connected = false
+		f.mutex.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			backoff = time.Second
+		}
+		log.Printf("⚠️  Baseline subscription to %s lost, retrying in %s: %v", f.upstream, backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > federationMaxBackoff {
+			backoff = federationMaxBackoff
+		}
+	}
+}
+
+// watchUpstream runs one subscription: it installs the baselines received
+// and reports the site status after each and whenever the local policy
+// changes. It reports whether any baseline was received.
+func (s *Server) watchUpstream(ctx context.Context, client pb.FirewallControlClient) (bool, error) {
+	f := s.federation
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.WatchBaseline(ctx, &pb.WatchBaselineRequest{Site: f.site})
+	if err != nil {
+		return false, err
+	}
+
+	baselines := make(chan *pb.Baseline)
+	failed := make(chan error, 1)
+	go func() {
+		for {
+			baseline, err := stream.Recv()
+			if err != nil {
+				failed <- err
+				return
+			}
+			select {
+			case baselines <- baseline:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(federationReportInterval)
+	defer ticker.Stop()
+	received := false
+	var reported uint64
+	for {
+		select {
+		case baseline := <-baselines:
+			if !received {
+				log.Printf("Subscribed to the baseline of %s as site %s", f.upstream, f.site)
+				f.mutex.Lock()
+				f.connected = true
+				f.mutex.Unlock()
+			}
+			received = true
+			if err := s.applyBaseline(ctx, baseline); err != nil {
+				log.Printf("⚠️  Baseline %s not installed: %v", baseline.Revision, withRemediation(err))
+			}
+		case <-ticker.C:
+			// Local rule changes move the overrides
+			if !received || s.compiledPolicy().Version == reported {
+				continue
+			}
+		case err := <-failed:
+			return received, err
+		case <-ctx.Done():
+			return received, ctx.Err()
+		}
+
+		s.mutex.RLock()
+		report := s.siteStatus()
+		reported = s.compiledPolicy().Version
+		s.mutex.RUnlock()
+		if resp, err := client.ReportSiteStatus(ctx, report); err != nil {
+			log.Printf("⚠️  Failed to report site status to %s: %v", f.upstream, err)
+		} else if !resp.Success {
+			log.Printf("⚠️  %s refused the site status: %s", f.upstream, resp.Message)
+		}
+	}
+}
+
+// applyBaseline replaces the installed baseline rules with a revision in
+// one hit-less data plane update; local rules stay. A revision with any
+// invalid rule is refused whole and the previous one stays installed.
+func (s *Server) applyBaseline(ctx context.Context, baseline *pb.Baseline) error {
+	f := s.federation
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	f.mutex.Lock()
+	unchanged := baseline.Revision == f.revision && f.lastError == ""
+	f.mutex.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	err := s.installBaseline(ctx, baseline)
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if err != nil {
+		f.lastError = err.Error()
+		return err
+	}
+	f.revision, f.appliedAt, f.lastError = baseline.Revision, s.clock.Now(), ""
+
+	s.persistPolicy()
+	version := s.compiledPolicy().Version
+	message := fmt.Sprintf("Baseline %s of %s installed: %d rules (policy version %d)",
+		baseline.Revision, f.upstream, len(baseline.Rules), version)
+	s.events.Publish(&pb.Event{
+		Type:     EventBaselineApplied,
+		Message:  message,
+		Severity: "medium",
+		Metadata: map[string]string{"revision": baseline.Revision, "upstream": f.upstream},
+	})
+	log.Print(message)
+	for _, skipped := range baseline.Skipped {
+		log.Printf("⚠️  Baseline %s omits %s", baseline.Revision, skipped)
+	}
+	return nil
+}
+
+// installBaseline validates a baseline against the local rules and swaps
+// the data plane to the result. Caller must hold s.mutex.
+func (s *Server) installBaseline(ctx context.Context, baseline *pb.Baseline) error {
+	f := s.federation
+	now := s.clock.Now()
+	rules := make(map[string]*FirewallRule, len(s.rules)+len(baseline.Rules))
+	for id, rule := range s.rules {
+		if !federatedRule(rule) {
+			rules[id] = rule
+		}
+	}
+
+	var added, errs []string
+	for _, p := range baseline.Rules {
+		if p.Priority <= -FederationPriorityBand || p.Priority >= FederationPriorityBand {
+			errs = append(errs, fmt.Sprintf("rule %s: priority %d out of range", p.Id, p.Priority))
+			continue
+		}
+		rule, err := fromProtoRule(p, now)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: %v", p.Id, err))
+			continue
+		}
+		rule.ID = FederatedRulePrefix + p.Id
+		rule.Priority = p.Priority - FederationPriorityBand
+		if f.precedence == PrecedenceLocal {
+			rule.Priority = p.Priority + FederationPriorityBand
+		}
+		if rule.Owner == "" {
+			rule.Owner = "federation " + f.upstream
+		}
+		rule.CreatedAt, rule.UpdatedAt = now, now
+		if existing, exists := s.rules[rule.ID]; exists {
+			rule.CreatedAt = existing.CreatedAt
+		}
+		labels := make(map[string]string, len(rule.Labels)+1)
+		for key, value := range rule.Labels {
+			labels[key] = value
+		}
+		labels[FederationLabel] = FederationBaselineValue
+		rule.Labels = labels
+		if err := s.validateRule(rule); err != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: %v", p.Id, err))
+			continue
+		}
+		if rules[rule.ID] != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: duplicate ID", p.Id))
+			continue
+		}
+		rules[rule.ID] = rule
+		added = append(added, rule.ID)
+	}
+	errs = append(errs, priorityCollisionErrors(rules, added)...)
+	if len(errs) > 0 {
+		return withCode(ErrCodeInvalidRule, fmt.Errorf("%d baseline rules invalid at this site: %s", len(errs), strings.Join(errs, "; ")))
+	}
+	if err := s.limits.checkRules(len(rules)); err != nil {
+		return err
+	}
+
+	previous := s.rules
+	s.rules = rules
+	if err := s.replacePolicy(ctx); err != nil {
+		s.rules = previous
+		return err
+	}
+	return nil
+}
+
+// siteStatus reports this site's installed baseline and where baseline
+// and local rules overlap with different actions. Caller must hold
+// s.mutex, not f.mutex.
+func (s *Server) siteStatus() *pb.SiteStatus {
+	f := s.federation
+	report := &pb.SiteStatus{Site: f.site, Precedence: f.precedence}
+	for _, rule := range s.rules {
+		if federatedRule(rule) {
+			report.BaselineRules++
+		} else {
+			report.LocalRules++
+		}
+	}
+	report.Overrides = federationOverrides(s.compiledPolicy().Sorted)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	report.Revision, report.Error = f.revision, f.lastError
+	if !f.appliedAt.IsZero() {
+		report.AppliedAt = f.appliedAt.Unix()
+	}
+	return report
+}
+
+// federationOverrides pairs the baseline and local entries that overlap
+// with different actions, once per pair of rules. The entry evaluated
+// first wins: it shadows the other when it covers it, else the two
+// conflict where both match.
+func federationOverrides(sorted []*FirewallRule) []*pb.FederationOverride {
+	var overrides []*pb.FederationOverride
+	seen := make(map[[2]string]bool)
+	for j, later := range sorted {
+		for _, earlier := range sorted[:j] {
+			if earlier.Action == later.Action || federatedRule(earlier) == federatedRule(later) || !entriesOverlap(earlier, later) {
+				continue
+			}
+			baseline, local, winner := earlier, later, PrecedenceGlobal
+			if !federatedRule(earlier) {
+				baseline, local, winner = later, earlier, PrecedenceLocal
+			}
+			baselineID := strings.TrimPrefix(entryRuleID(baseline.ID), FederatedRulePrefix)
+			localID := entryRuleID(local.ID)
+			if seen[[2]string{baselineID, localID}] {
+				continue
+			}
+			seen[[2]string{baselineID, localID}] = true
+
+			kind, extent := FindingConflict, "where both match"
+			if entryCovers(earlier, later) {
+				kind, extent = FindingShadowed, "on all its traffic"
+			}
+			message := fmt.Sprintf("baseline rule %s (%s) overrides local rule %s (%s) %s",
+				baselineID, baseline.Action, localID, local.Action, extent)
+			if winner == PrecedenceLocal {
+				message = fmt.Sprintf("local rule %s (%s) overrides baseline rule %s (%s) %s",
+					localID, local.Action, baselineID, baseline.Action, extent)
+			}
+			overrides = append(overrides, &pb.FederationOverride{
+				BaselineRuleId: baselineID,
+				LocalRuleId:    localID,
+				Kind:           kind,
+				Winner:         winner,
+				Message:        message,
+			})
+		}
+	}
+	return overrides
+}
@@ -0,0 +1,582 @@
+// SPDX-License-Identifier: Apache-2.0
+// Threat intelligence feeds: external blocklists downloaded periodically
+// and normalized into IP sets that rules reference
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/netip"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// EventFeedUpdated reports a download that changed a feed's entries
+	EventFeedUpdated = "FEED_UPDATED"
+
+	// Entries per feed set unless CERBERUS_FEED_MAX_ENTRIES says otherwise
+	DefaultFeedMaxEntries = 1 << 18
+
+	// Interval of custom feeds unless CERBERUS_FEED_REFRESH says otherwise
+	DefaultFeedRefresh = time.Hour
+
+	// How often feeds are checked for being due, and how soon a failed
+	// download is retried when that is sooner than the feed's interval
+	feedCheckInterval = time.Minute
+	feedRetryInterval = 5 * time.Minute
+
+	feedDownloadTimeout = 2 * time.Minute
+	feedMaxBytes        = 64 << 20
+)
+
+// Shortest prefixes a feed may list; anything broader is more likely a
+// broken feed than a network worth blocking wholesale
+var minFeedPrefixBits = map[int]int{familyIPv4: 8, familyIPv6: 16}
+
+var feedNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// builtinFeeds are the well-known blocklists CERBERUS_FEEDS names without
+// a URL, with the interval their publishers ask clients to keep to
+var builtinFeeds = map[string]Feed{
+	"spamhaus-drop": {
+		URL:         "https://www.spamhaus.org/drop/drop.txt",
+		Description: "Spamhaus DROP: IPv4 netblocks hijacked or leased by spam and cybercrime operations",
+		Interval:    12 * time.Hour,
+	},
+	"spamhaus-dropv6": {
+		URL:         "https://www.spamhaus.org/drop/dropv6.txt",
+		Description: "Spamhaus DROPv6: IPv6 netblocks hijacked or leased by spam and cybercrime operations",
+		Interval:    12 * time.Hour,
+	},
+	"feodo": {
+		URL:         "https://feodotracker.abuse.ch/downloads/ipblocklist.txt",
+		Description: "abuse.ch Feodo Tracker: botnet command and control servers",
+		Interval:    time.Hour,
+	},
+	"sslbl": {
+		URL:         "https://sslbl.abuse.ch/blacklist/sslipblacklist.txt",
+		Description: "abuse.ch SSL Blacklist: botnet command and control servers",
+		Interval:    time.Hour,
+	},
+	"firehol-level1": {
+		URL:         "https://iplists.firehol.org/files/firehol_level1.netset",
+		Description: "FireHOL level 1: attacks, malware and bogons, including the private ranges",
+		Interval:    time.Hour,
+	},
+}
+
+// Feed is a threat intelligence blocklist downloaded into a pair of LPM IP
+// sets, one per address family. Entries are normalized: addresses become
+// host prefixes, host bits are cleared and prefixes covered by a shorter
+// one are dropped.
+type Feed struct {
+	Name        string
+	URL         string
+	Description string
+	Interval    time.Duration
+	MaxEntries  int // Per set
+
+	sets     map[int]*IPSet  // By family
+	verifier *updateVerifier // nil = downloads are not verified
+
+	attemptedAt time.Time
+	checkedAt   time.Time // Latest successful download
+	updatedAt   time.Time // Latest change of the entries
+	downloads   uint64
+	failures    uint64
+	lastError   string
+	skipped     int
+
+	// Validators of the download the entries came from
+	etag         string
+	lastModified string
+}
+
+// feedDownload is a downloaded and normalized feed
+type feedDownload struct {
+	prefixes     map[netip.Prefix]bool
+	skipped      int
+	etag         string
+	lastModified string
+}
+
+// feedsFromEnv reads the configured feeds: CERBERUS_FEEDS lists built-in
+// feed names and name=URL pairs, separated by commas. Downloads of a feed
+// are checked against CERBERUS_FEED_<NAME>_SHA256_URL and
+// CERBERUS_FEED_<NAME>_SIGNATURE_URL where set, NAME in upper case with
+// underscores for dashes.
+func feedsFromEnv() ([]*Feed, error) {
+	maxEntries := DefaultFeedMaxEntries
+	if value := os.Getenv("CERBERUS_FEED_MAX_ENTRIES"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > MaxIPSetEntries {
+			return nil, fmt.Errorf("invalid CERBERUS_FEED_MAX_ENTRIES %q, expected 1 to %d", value, MaxIPSetEntries)
+		}
+		maxEntries = parsed
+	}
+	var refresh time.Duration
+	if value := os.Getenv("CERBERUS_FEED_REFRESH"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < time.Minute {
+			return nil, fmt.Errorf("invalid CERBERUS_FEED_REFRESH %q, expected a duration of at least 1m", value)
+		}
+		refresh = parsed
+	}
+
+	var feeds []*Feed
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(os.Getenv("CERBERUS_FEEDS"), ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, url, custom := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		feed := &Feed{Name: name, Interval: DefaultFeedRefresh, MaxEntries: maxEntries}
+		if custom {
+			feed.URL = strings.TrimSpace(url)
+			feed.Description = "Custom feed from " + feed.URL
+			if !strings.HasPrefix(feed.URL, "https://") && !strings.HasPrefix(feed.URL, "http://") {
+				return nil, fmt.Errorf("invalid CERBERUS_FEEDS URL of %s %q, expected an http:// or https:// URL", name, feed.URL)
+			}
+		} else {
+			builtin, ok := builtinFeeds[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown feed %q in CERBERUS_FEEDS, expected name=URL or one of %s", name, strings.Join(builtinFeedNames(), ", "))
+			}
+			feed.URL, feed.Description, feed.Interval = builtin.URL, builtin.Description, builtin.Interval
+		}
+		if !feedNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid feed name %q, expected lower-case letters, digits and dashes", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("feed %s is listed twice in CERBERUS_FEEDS", name)
+		}
+		seen[name] = true
+		verifier, err := verifierFromEnv("FEED_"+strings.ToUpper(strings.ReplaceAll(name, "-", "_")), "")
+		if err != nil {
+			return nil, fmt.Errorf("feed %s: %v", name, err)
+		}
+		feed.verifier = verifier
+		if refresh > 0 {
+			feed.Interval = refresh
+		}
+		feeds = append(feeds, feed)
+	}
+	return feeds, nil
+}
+
+func builtinFeedNames() []string {
+	names := make([]string, 0, len(builtinFeeds))
+	for name := range builtinFeeds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// feedSetName names the set holding a feed's addresses of one family
+func feedSetName(feed string, family int) string {
+	return fmt.Sprintf("feed-%s-%s", feed, familyName(family))
+}
+
+// attachFeeds gives every configured feed its pair of sets, reusing the
+// stored ones so rules keep referencing the same set IDs. Sets of feeds no
+// longer configured keep their entries and become ordinary sets. Must run
+// after RestorePolicy.
+func (s *Server) attachFeeds(feeds []*Feed) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.feeds = make(map[string]*Feed)
+	for _, feed := range feeds {
+		feed.sets = make(map[int]*IPSet)
+		for _, family := range []int{familyIPv4, familyIPv6} {
+			set, err := s.feedSet(feed, family)
+			if err != nil {
+				return fmt.Errorf("feed %s: %v", feed.Name, err)
+			}
+			feed.sets[family] = set
+		}
+		s.feeds[feed.Name] = feed
+		log.Printf("Attached feed %s: %s every %s, IP sets %d (IPv4) and %d (IPv6)",
+			feed.Name, feed.URL, feed.Interval, feed.sets[familyIPv4].ID, feed.sets[familyIPv6].ID)
+	}
+	for _, id := range s.sortedIPSetIDs() {
+		set := s.ipSets[id]
+		if set.Feed != "" && s.feeds[set.Feed] == nil {
+			log.Printf("⚠️  Feed %s is no longer configured; IP set %d: %s keeps its %d entries and is now managed through the IP set API",
+				set.Feed, set.ID, set.Name, len(set.Entries))
+			set.Feed = ""
+		}
+	}
+	s.persistPolicy()
+	return nil
+}
+
+// feedSet returns the stored set of a feed and family, resized to the
+// feed's limit, or creates it. Caller must hold s.mutex.
+func (s *Server) feedSet(feed *Feed, family int) (*IPSet, error) {
+	name := feedSetName(feed.Name, family)
+	for _, set := range s.ipSets {
+		if set.Name != name {
+			continue
+		}
+		if set.Feed != feed.Name || set.Type != IPSetTypeLPM || set.family() != family {
+			return nil, fmt.Errorf("IP set %d is already named %s", set.ID, name)
+		}
+		if set.MaxEntries != feed.MaxEntries {
+			if len(set.Entries) > feed.MaxEntries {
+				return nil, fmt.Errorf("IP set %s holds %d entries, more than the limit of %d", name, len(set.Entries), feed.MaxEntries)
+			}
+			set.MaxEntries = feed.MaxEntries
+			if s.bpfManager != nil {
+				if err := s.bpfManager.CreateIPSet(set); err != nil {
+					return nil, err
+				}
+			}
+		}
+		set.Description = feed.Description
+		return set, nil
+	}
+
+	set := &IPSet{
+		Name:        name,
+		Type:        IPSetTypeLPM,
+		Family:      familyName(family),
+		MaxEntries:  feed.MaxEntries,
+		Description: feed.Description,
+		Entries:     make(map[netip.Prefix]bool),
+		Feed:        feed.Name,
+	}
+	if set.ID = s.freeIPSetID(); set.ID == 0 {
+		return nil, fmt.Errorf("IP set limit reached (%d sets)", MaxIPSets-1)
+	}
+	if s.bpfManager != nil {
+		if err := s.bpfManager.CreateIPSet(set); err != nil {
+			return nil, err
+		}
+	}
+	s.ipSets[set.ID] = set
+	return set, nil
+}
+
+// refreshFeeds downloads every feed whose interval has passed since its
+// last attempt, and failed feeds again after feedRetryInterval, until ctx
+// is done
+func (s *Server) refreshFeeds(ctx context.Context) {
+	ticker := time.NewTicker(feedCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, feed := range s.dueFeeds() {
+			if err := s.refreshFeed(ctx, feed); err != nil {
+				log.Printf("⚠️  Feed %s not updated, keeping its entries: %v", feed.Name, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dueFeeds returns the feeds to download now, by name
+func (s *Server) dueFeeds() []*Feed {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	now := s.clock.Now()
+	var due []*Feed
+	for _, feed := range s.feeds {
+		wait := feed.Interval
+		if feed.lastError != "" && feedRetryInterval < wait {
+			wait = feedRetryInterval
+		}
+		if feed.attemptedAt.IsZero() || now.Sub(feed.attemptedAt) >= wait {
+			due = append(due, feed)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].Name < due[j].Name })
+	return due
+}
+
+// refreshFeed downloads a feed and puts its entries in its sets. A failed
+// download or update leaves the previous entries in place.
+func (s *Server) refreshFeed(ctx context.Context, feed *Feed) error {
+	s.mutex.RLock()
+	etag, lastModified := feed.etag, feed.lastModified
+	s.mutex.RUnlock()
+
+	download, err := downloadFeed(ctx, feed.URL, feed.verifier, etag, lastModified)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	feed.attemptedAt = s.clock.Now()
+	if err == nil && download != nil {
+		err = s.applyFeed(feed, download)
+	}
+	if err != nil {
+		feed.failures++
+		feed.lastError = err.Error()
+		return err
+	}
+	feed.downloads++
+	feed.lastError = ""
+	feed.checkedAt = feed.attemptedAt
+	if download != nil {
+		feed.skipped = download.skipped
+		feed.etag, feed.lastModified = download.etag, download.lastModified
+	}
+	return nil
+}
+
+// applyFeed replaces the entries of a feed's sets with a download. Caller
+// must hold s.mutex.
+func (s *Server) applyFeed(feed *Feed, download *feedDownload) error {
+	// An empty download replacing a populated feed is more likely an outage
+	// page or a truncated file than a feed that listed everything as clean
+	if len(download.prefixes) == 0 && len(feed.sets[familyIPv4].Entries)+len(feed.sets[familyIPv6].Entries) > 0 {
+		return fmt.Errorf("download has no usable addresses (%d lines skipped)", download.skipped)
+	}
+	entries := map[int]map[netip.Prefix]bool{familyIPv4: {}, familyIPv6: {}}
+	for prefix := range download.prefixes {
+		entries[prefixFamily(prefix)][prefix] = true
+	}
+	for family, next := range entries {
+		if len(next) > feed.MaxEntries {
+			return fmt.Errorf("download has %d %s entries, more than the limit of %d", len(next), familyName(family), feed.MaxEntries)
+		}
+	}
+
+	added, removed := 0, 0
+	for _, family := range []int{familyIPv4, familyIPv6} {
+		set, next := feed.sets[family], entries[family]
+		var add, remove []netip.Prefix
+		for prefix := range next {
+			if !set.Entries[prefix] {
+				add = append(add, prefix)
+			}
+		}
+		for prefix := range set.Entries {
+			if !next[prefix] {
+				remove = append(remove, prefix)
+			}
+		}
+		if len(add) == 0 && len(remove) == 0 {
+			continue
+		}
+		// Removing first keeps a full set within its map's capacity
+		if s.bpfManager != nil {
+			if err := s.bpfManager.UpdateIPSet(set, nil, remove); err != nil {
+				return fmt.Errorf("IP set %s: %v", set.Name, err)
+			}
+			if err := s.bpfManager.UpdateIPSet(set, add, nil); err != nil {
+				s.bpfManager.UpdateIPSet(set, remove, nil)
+				return fmt.Errorf("IP set %s: %v", set.Name, err)
+			}
+		}
+		set.Entries = next
+		added += len(add)
+		removed += len(remove)
+	}
+	if added == 0 && removed == 0 {
+		return nil
+	}
+
+	feed.updatedAt = s.clock.Now()
+	s.persistPolicy()
+	message := fmt.Sprintf("Feed %s updated: %d IPv4 and %d IPv6 entries (+%d -%d)",
+		feed.Name, len(entries[familyIPv4]), len(entries[familyIPv6]), added, removed)
+	s.events.Publish(&pb.Event{
+		Type:     EventFeedUpdated,
+		Message:  message,
+		Severity: "low",
+		Metadata: map[string]string{
+			"feed":    feed.Name,
+			"added":   strconv.Itoa(added),
+			"removed": strconv.Itoa(removed),
+		},
+	})
+	log.Printf("📥 %s", message)
+	return nil
+}
+
+func prefixFamily(prefix netip.Prefix) int {
+	if prefix.Addr().Is4() {
+		return familyIPv4
+	}
+	return familyIPv6
+}
+
+// downloadFeed fetches a feed unless the validators of the previous
+// download show it unchanged, in which case it returns nil, and verifies
+// it where configured
+func downloadFeed(ctx context.Context, url string, verifier *updateVerifier, etag, lastModified string) (*feedDownload, error) {
+	ctx, cancel := context.WithTimeout(ctx, feedDownloadTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, feedMaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", url, err)
+	}
+	if len(content) > feedMaxBytes {
+		return nil, fmt.Errorf("%s: larger than %d bytes", url, feedMaxBytes)
+	}
+	if err := verifier.verify(ctx, url, content); err != nil {
+		return nil, err
+	}
+	prefixes, skipped, err := parseFeed(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", url, err)
+	}
+	return &feedDownload{
+		prefixes:     prefixes,
+		skipped:      skipped,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// parseFeed reads a blocklist: one address or CIDR prefix per line, first
+// on the line, with '#' and ';' starting comments. It returns the
+// normalized prefixes and how many lines held no usable address.
+func parseFeed(r io.Reader) (map[netip.Prefix]bool, int, error) {
+	prefixes := make(map[netip.Prefix]bool)
+	skipped := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+		if len(fields) == 0 {
+			continue
+		}
+		prefix, ok := parseFeedEntry(fields[0])
+		if !ok {
+			skipped++
+			continue
+		}
+		prefixes[prefix] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Drop prefixes a shorter listed prefix already covers
+	for prefix := range prefixes {
+		for bits := prefix.Bits() - 1; bits >= 0; bits-- {
+			if parent, _ := prefix.Addr().Prefix(bits); prefixes[parent] {
+				delete(prefixes, prefix)
+				break
+			}
+		}
+	}
+	return prefixes, skipped, nil
+}
+
+// parseFeedEntry normalizes an address or prefix of a feed
+func parseFeedEntry(token string) (netip.Prefix, bool) {
+	prefix, err := netip.ParsePrefix(token)
+	if err != nil {
+		addr, err := netip.ParseAddr(token)
+		if err != nil || addr.Zone() != "" {
+			return netip.Prefix{}, false
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	if prefix.Bits() < minFeedPrefixBits[prefixFamily(prefix)] {
+		return netip.Prefix{}, false
+	}
+	return prefix.Masked(), true
+}
+
+// ListFeeds returns the configured feeds by name with their freshness and
+// entry counts
+func (s *Server) ListFeeds(ctx context.Context, req *pb.Empty) (*pb.FeedsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.FeedsResponse{}
+	for _, feed := range s.sortedFeeds() {
+		resp.Feeds = append(resp.Feeds, feedToProto(feed))
+	}
+	return resp, nil
+}
+
+// sortedFeeds returns the feeds by name. Caller must hold s.mutex.
+func (s *Server) sortedFeeds() []*Feed {
+	feeds := make([]*Feed, 0, len(s.feeds))
+	for _, feed := range s.feeds {
+		feeds = append(feeds, feed)
+	}
+	sort.Slice(feeds, func(i, j int) bool { return feeds[i].Name < feeds[j].Name })
+	return feeds
+}
+
+func feedToProto(feed *Feed) *pb.Feed {
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	return &pb.Feed{
+		Name:            feed.Name,
+		Url:             feed.URL,
+		Description:     feed.Description,
+		IntervalSeconds: uint32(feed.Interval.Seconds()),
+		MaxEntries:      uint32(feed.MaxEntries),
+		Ipv4SetId:       feed.sets[familyIPv4].ID,
+		Ipv6SetId:       feed.sets[familyIPv6].ID,
+		Ipv4Entries:     uint32(len(feed.sets[familyIPv4].Entries)),
+		Ipv6Entries:     uint32(len(feed.sets[familyIPv6].Entries)),
+		UpdatedAt:       unix(feed.updatedAt),
+		CheckedAt:       unix(feed.checkedAt),
+		Downloads:       feed.downloads,
+		Failures:        feed.failures,
+		LastError:       feed.lastError,
+		SkippedLines:    uint32(feed.skipped),
+	}
+}
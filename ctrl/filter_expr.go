@@ -0,0 +1,563 @@
+// SPDX-License-Identifier: Apache-2.0
+// Filter expressions: a small CEL-like language selecting events and
+// archived flows server-side, e.g.
+// severity >= WARN && dst_port == 22 && src_ip in 10.0.0.0/8
+
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Longest filter expression and deepest nesting of parentheses and
+	// negations accepted
+	maxFilterLength = 4096
+	maxFilterDepth  = 64
+)
+
+// severityRanks orders event severities; the log level names are aliases
+// of the severity they correspond to
+var severityRanks = map[string]int{
+	"debug":    0,
+	"info":     1,
+	"low":      2,
+	"medium":   3,
+	"warn":     3,
+	"warning":  3,
+	"high":     4,
+	"error":    4,
+	"critical": 5,
+}
+
+// Event fields a filter names; any other name is looked up in the event's
+// metadata, as is metadata.<key>
+var eventFilterFields = map[string]func(*pb.Event) string{
+	"id":        func(e *pb.Event) string { return e.Id },
+	"type":      func(e *pb.Event) string { return e.Type },
+	"severity":  func(e *pb.Event) string { return e.Severity },
+	"src_ip":    func(e *pb.Event) string { return e.Source },
+	"source":    func(e *pb.Event) string { return e.Source },
+	"dst_ip":    func(e *pb.Event) string { return e.Target },
+	"target":    func(e *pb.Event) string { return e.Target },
+	"protocol":  func(e *pb.Event) string { return e.Protocol },
+	"dst_port":  func(e *pb.Event) string { return formatNonZero(int64(e.Port)) },
+	"port":      func(e *pb.Event) string { return formatNonZero(int64(e.Port)) },
+	"bytes":     func(e *pb.Event) string { return formatNonZero(e.Bytes) },
+	"rule_id":   func(e *pb.Event) string { return e.RuleId },
+	"message":   func(e *pb.Event) string { return e.Message },
+	"node":      func(e *pb.Event) string { return e.Node },
+	"sequence":  func(e *pb.Event) string { return formatNonZero(int64(e.Sequence)) },
+	"timestamp": func(e *pb.Event) string { return formatNonZero(e.Timestamp) },
+}
+
+// Flow archive fields a filter names
+var flowFilterFields = map[string]func(*FlowRecord) string{
+	"family":     func(r *FlowRecord) string { return familyName(r.Family) },
+	"protocol":   func(r *FlowRecord) string { return protocolName(r.Protocol) },
+	"src_ip":     func(r *FlowRecord) string { return r.SrcIP },
+	"src_port":   func(r *FlowRecord) string { return strconv.Itoa(int(r.SrcPort)) },
+	"dst_ip":     func(r *FlowRecord) string { return r.DstIP },
+	"dst_port":   func(r *FlowRecord) string { return strconv.Itoa(int(r.DstPort)) },
+	"packets":    func(r *FlowRecord) string { return strconv.FormatUint(r.Packets, 10) },
+	"bytes":      func(r *FlowRecord) string { return strconv.FormatUint(r.Bytes, 10) },
+	"first_seen": func(r *FlowRecord) string { return strconv.FormatInt(r.FirstSeen, 10) },
+	"last_seen":  func(r *FlowRecord) string { return strconv.FormatInt(r.LastSeen, 10) },
+}
+
+// formatNonZero formats a number, empty for zero: an unset field
+func formatNonZero(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// filterLookup returns the value of a field, false if the record has none
+type filterLookup func(field string) (string, bool)
+
+// filterExpr is a node of a parsed filter
+type filterExpr interface {
+	eval(lookup filterLookup) bool
+}
+
+type filterAnd struct{ left, right filterExpr }
+type filterOr struct{ left, right filterExpr }
+type filterNot struct{ expr filterExpr }
+
+// filterPresent is a bare field name: true when the field is set
+type filterPresent struct{ field string }
+
+// filterCompare compares a field with a literal, or a list of them for in
+type filterCompare struct {
+	field  string
+	op     string // "==", "!=", "<", "<=", ">", ">=", "in", "matches", "contains"
+	values []string
+	prefix netip.Prefix   // For in with a prefix
+	regex  *regexp.Regexp // For matches
+}
+
+func (e filterAnd) eval(lookup filterLookup) bool { return e.left.eval(lookup) && e.right.eval(lookup) }
+func (e filterOr) eval(lookup filterLookup) bool  { return e.left.eval(lookup) || e.right.eval(lookup) }
+func (e filterNot) eval(lookup filterLookup) bool { return !e.expr.eval(lookup) }
+
+func (e filterPresent) eval(lookup filterLookup) bool {
+	value, ok := lookup(e.field)
+	return ok && value != ""
+}
+
+// eval compares the field's value. A field the record lacks is only
+// unequal to everything.
+func (e *filterCompare) eval(lookup filterLookup) bool {
+	value, ok := lookup(e.field)
+	if !ok || value == "" {
+		return e.op == "!="
+	}
+	switch e.op {
+	case "in":
+		if e.prefix.IsValid() {
+			addr, ok := parseFilterAddr(value)
+			return ok && e.prefix.Contains(addr)
+		}
+		for _, literal := range e.values {
+			if compareFilterValues(e.field, value, literal) == 0 {
+				return true
+			}
+		}
+		return false
+	case "matches":
+		return e.regex.MatchString(value)
+	case "contains":
+		return strings.Contains(strings.ToLower(value), strings.ToLower(e.values[0]))
+	}
+
+	cmp := compareFilterValues(e.field, value, e.values[0])
+	switch e.op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// compareFilterValues orders a field's value against a literal: severities
+// by rank, numbers numerically, addresses by address, anything else as
+// case-insensitive text
+func compareFilterValues(field, value, literal string) int {
+	if field == "severity" {
+		if rank, ok := severityRanks[strings.ToLower(value)]; ok {
+			return rank - severityRanks[strings.ToLower(literal)]
+		}
+	}
+	if a, err := strconv.ParseFloat(value, 64); err == nil {
+		if b, err := strconv.ParseFloat(literal, 64); err == nil {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	}
+	if a, ok := parseFilterAddr(value); ok {
+		if b, ok := parseFilterAddr(literal); ok {
+			return a.Compare(b)
+		}
+	}
+	return strings.Compare(strings.ToLower(value), strings.ToLower(literal))
+}
+
+// parseFilterAddr parses an address, or the address of an address:port
+func parseFilterAddr(value string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return addr.Unmap(), true
+	}
+	if addrPort, err := netip.ParseAddrPort(value); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	return netip.Addr{}, false
+}
+
+// EventFilter selects events with a filter expression
+type EventFilter struct {
+	expr filterExpr
+	// Addresses and messages are matched as this anonymizer exports them,
+	// so a filter cannot probe for what anonymization hides; nil = as
+	// published
+	anonymizer *Anonymizer
+}
+
+// compileEventFilter parses an event filter; empty text selects every
+// event and returns nil
+func compileEventFilter(text string, anonymizer *Anonymizer) (*EventFilter, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	expr, err := parseFilter(text, nil)
+	if err != nil {
+		return nil, withCode(ErrCodeInvalidFilter, err)
+	}
+	return &EventFilter{expr: expr, anonymizer: anonymizer}, nil
+}
+
+// matches reports whether the filter selects an event; a nil filter
+// selects every event
+func (f *EventFilter) matches(event *pb.Event) bool {
+	if f == nil {
+		return true
+	}
+	return f.expr.eval(func(field string) (string, bool) {
+		value, ok := eventFilterValue(event, field)
+		if ok && f.anonymizer != nil {
+			if f.anonymizer.strip && (field == "message" || strings.HasSuffix(field, "_mac")) {
+				return "", false
+			}
+			value = f.anonymizer.text(value)
+		}
+		return value, ok
+	})
+}
+
+// eventFilterValue returns a named field of an event, or a metadata value
+func eventFilterValue(event *pb.Event, field string) (string, bool) {
+	if get, ok := eventFilterFields[field]; ok {
+		return get(event), true
+	}
+	value, ok := event.Metadata[strings.TrimPrefix(field, "metadata.")]
+	return value, ok
+}
+
+// FlowFilter selects archived flows with a filter expression
+type FlowFilter struct {
+	expr       filterExpr
+	anonymizer *Anonymizer // As for EventFilter
+}
+
+// compileFlowFilter parses a flow filter, which may only name flow
+// fields; empty text selects every flow and returns nil
+func compileFlowFilter(text string, anonymizer *Anonymizer) (*FlowFilter, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	expr, err := parseFilter(text, func(field string) error {
+		if _, ok := flowFilterFields[field]; !ok {
+			return fmt.Errorf("unknown flow field %s", field)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, withCode(ErrCodeInvalidFilter, err)
+	}
+	return &FlowFilter{expr: expr, anonymizer: anonymizer}, nil
+}
+
+// matches reports whether the filter selects a flow; a nil filter selects
+// every flow
+func (f *FlowFilter) matches(record *FlowRecord) bool {
+	if f == nil {
+		return true
+	}
+	return f.expr.eval(func(field string) (string, bool) {
+		value := flowFilterFields[field](record)
+		if f.anonymizer != nil && (field == "src_ip" || field == "dst_ip") {
+			value = f.anonymizer.text(value)
+		}
+		return value, true
+	})
+}
+
+// filterParser is a recursive descent parser over the tokens of a filter:
+//
+//	expr    = and { "||" and }
+//	and     = unary { "&&" unary }
+//	unary   = "!" unary | "(" expr ")" | field [ op value | "in" values ]
+//	op      = "==" | "!=" | "<" | "<=" | ">" | ">=" | "matches" | "contains"
+//	values  = "[" value { "," value } "]" | value
+//
+// Values are quoted strings or bare words such as numbers, addresses and
+// prefixes.
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+	depth  int
+	field  func(string) error // Checks field names, nil = any
+}
+
+type filterToken struct {
+	text   string
+	quoted bool // A string literal, never an operator or field
+	offset int
+}
+
+// parseFilter parses a filter expression, checking field names with
+// field if set
+func parseFilter(text string, field func(string) error) (filterExpr, error) {
+	if len(text) > maxFilterLength {
+		return nil, fmt.Errorf("filter longer than %d characters", maxFilterLength)
+	}
+	tokens, err := tokenizeFilter(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens, field: field}
+	expr, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q at offset %d", token.text, token.offset)
+	}
+	return expr, nil
+}
+
+const (
+	// filterSpaceChars separate tokens; filterOperatorChars also end a
+	// bare word
+	filterSpaceChars    = " \t\n\v\f\r"
+	filterOperatorChars = "()[],!=<>&|\"'"
+)
+
+// tokenizeFilter splits a filter into operators, string literals and bare
+// words
+func tokenizeFilter(text string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case strings.IndexByte(filterSpaceChars, c) >= 0:
+			i++
+		case c == '"' || c == '\'':
+			var literal strings.Builder
+			j := i + 1
+			for ; j < len(text) && text[j] != c; j++ {
+				if text[j] == '\\' && j+1 < len(text) {
+					j++
+				}
+				literal.WriteByte(text[j])
+			}
+			if j == len(text) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, filterToken{text: literal.String(), quoted: true, offset: i})
+			i = j + 1
+		case strings.HasPrefix(text[i:], "&&"), strings.HasPrefix(text[i:], "||"),
+			strings.HasPrefix(text[i:], "=="), strings.HasPrefix(text[i:], "!="),
+			strings.HasPrefix(text[i:], "<="), strings.HasPrefix(text[i:], ">="):
+			tokens = append(tokens, filterToken{text: text[i : i+2], offset: i})
+			i += 2
+		case strings.IndexByte("()[],!<>", c) >= 0:
+			tokens = append(tokens, filterToken{text: text[i : i+1], offset: i})
+			i++
+		case c == '=' || c == '&' || c == '|':
+			return nil, fmt.Errorf("unexpected %q at offset %d, expected ==, && or ||", c, i)
+		default:
+			j := i
+			for j < len(text) && strings.IndexByte(filterSpaceChars+filterOperatorChars, text[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, filterToken{text: text[i:j], offset: i})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+func (p *filterParser) peek() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// accept consumes the next token if it is the operator text
+func (p *filterParser) accept(text string) bool {
+	if token, ok := p.peek(); ok && !token.quoted && token.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// next consumes a token, failing at the end of the filter
+func (p *filterParser) next(expected string) (filterToken, error) {
+	token, ok := p.peek()
+	if !ok {
+		return token, fmt.Errorf("unexpected end of filter, expected %s", expected)
+	}
+	p.pos++
+	return token, nil
+}
+
+func (p *filterParser) or() (filterExpr, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = filterOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) and() (filterExpr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = filterAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) unary() (filterExpr, error) {
+	if p.depth++; p.depth > maxFilterDepth {
+		return nil, fmt.Errorf("filter nested deeper than %d levels", maxFilterDepth)
+	}
+	defer func() { p.depth-- }()
+
+	if p.accept("!") {
+		expr, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{expr}, nil
+	}
+	if p.accept("(") {
+		expr, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, p.expected(")")
+		}
+		return expr, nil
+	}
+	return p.comparison()
+}
+
+// expected is the error of a missing token
+func (p *filterParser) expected(what string) error {
+	if token, ok := p.peek(); ok {
+		return fmt.Errorf("unexpected %q at offset %d, expected %s", token.text, token.offset, what)
+	}
+	return fmt.Errorf("unexpected end of filter, expected %s", what)
+}
+
+// filterFieldPattern is the form of field names
+var filterFieldPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+func (p *filterParser) comparison() (filterExpr, error) {
+	token, err := p.next("a field")
+	if err != nil {
+		return nil, err
+	}
+	if token.quoted || !filterFieldPattern.MatchString(token.text) {
+		return nil, fmt.Errorf("unexpected %q at offset %d, expected a field", token.text, token.offset)
+	}
+	field := strings.ToLower(token.text)
+	if p.field != nil {
+		if err := p.field(field); err != nil {
+			return nil, fmt.Errorf("%v at offset %d", err, token.offset)
+		}
+	}
+
+	op, ok := p.peek()
+	if !ok || op.quoted {
+		return filterPresent{field}, nil
+	}
+	switch op.text {
+	case "==", "!=", "<", "<=", ">", ">=", "matches", "contains":
+		p.pos++
+	case "in":
+		p.pos++
+		return p.membership(field)
+	default:
+		return filterPresent{field}, nil
+	}
+
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	compare := &filterCompare{field: field, op: op.text, values: []string{value.text}}
+	switch {
+	case op.text == "matches":
+		if compare.regex, err = regexp.Compile(value.text); err != nil {
+			return nil, fmt.Errorf("invalid pattern at offset %d: %v", value.offset, err)
+		}
+	case field == "severity" && op.text != "contains":
+		if _, ok := severityRanks[strings.ToLower(value.text)]; !ok {
+			return nil, fmt.Errorf("unknown severity %q at offset %d, expected low, medium, high, critical or a log level", value.text, value.offset)
+		}
+	}
+	return compare, nil
+}
+
+// membership parses the values of in: a list, or a prefix
+func (p *filterParser) membership(field string) (filterExpr, error) {
+	compare := &filterCompare{field: field, op: "in"}
+	if !p.accept("[") {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		prefix, err := netip.ParsePrefix(value.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %q at offset %d, expected a prefix or a [list]", value.text, value.offset)
+		}
+		compare.prefix = prefix.Masked()
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			compare.prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96).Masked()
+		}
+		return compare, nil
+	}
+	for {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		compare.values = append(compare.values, value.text)
+		if p.accept("]") {
+			return compare, nil
+		}
+		if !p.accept(",") {
+			return nil, p.expected(", or ]")
+		}
+	}
+}
+
+// value parses a literal: a string or a bare word that is not an operator
+func (p *filterParser) value() (filterToken, error) {
+	token, err := p.next("a value")
+	if err != nil {
+		return token, err
+	}
+	if !token.quoted && strings.IndexByte(filterOperatorChars, token.text[0]) >= 0 {
+		return token, fmt.Errorf("unexpected %q at offset %d, expected a value", token.text, token.offset)
+	}
+	return token, nil
+}
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Apache-2.0
+// Flow aggregation: group-by, time buckets and top-k over the flow archive,
+// so charts need not download the raw flow records
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	flowAggregateMaxGroups    = 1000 // Groups returned at most
+	flowAggregateMinBucket    = 60   // Shortest time bucket, seconds
+	flowAggregateIPv4Prefix   = 24   // Default bits of the prefix keys
+	flowAggregateIPv6Prefix   = 64
+	flowAggregateDefaultLimit = 10
+)
+
+// flowAggregateKeys are the group_by keys and the value of each for a flow
+var flowAggregateKeys = map[string]func(q *flowAggregation, r *FlowRecord) string{
+	"src_prefix":  func(q *flowAggregation, r *FlowRecord) string { return q.prefix(r.SrcIP) },
+	"dst_prefix":  func(q *flowAggregation, r *FlowRecord) string { return q.prefix(r.DstIP) },
+	"src_ip":      func(q *flowAggregation, r *FlowRecord) string { return q.addr(r.SrcIP) },
+	"dst_ip":      func(q *flowAggregation, r *FlowRecord) string { return q.addr(r.DstIP) },
+	"src_port":    func(q *flowAggregation, r *FlowRecord) string { return strconv.Itoa(int(r.SrcPort)) },
+	"dst_port":    func(q *flowAggregation, r *FlowRecord) string { return strconv.Itoa(int(r.DstPort)) },
+	"protocol":    func(q *flowAggregation, r *FlowRecord) string { return protocolName(r.Protocol) },
+	"family":      func(q *flowAggregation, r *FlowRecord) string { return familyName(r.Family) },
+	"src_country": func(q *flowAggregation, r *FlowRecord) string { return q.country(r.SrcIP) },
+	"dst_country": func(q *flowAggregation, r *FlowRecord) string { return q.country(r.DstIP) },
+}
+
+// flowAggregation is one AggregateFlows query
+type flowAggregation struct {
+	groupBy    []string
+	ipv4Bits   int
+	ipv6Bits   int
+	bucket     int64 // Seconds, 0 = no buckets
+	geoIP      *GeoIPDatabase
+	anonymizer *Anonymizer // Applied to address keys, nil = none
+}
+
+// flowGroup accumulates the flows of one key
+type flowGroup struct {
+	values  []string // In group_by order
+	flows   int64
+	packets uint64
+	bytes   uint64
+	buckets map[int64]*pb.FlowBucket // By start
+}
+
+func (g *flowGroup) add(flows int64, packets, bytes uint64, start int64, bucketed bool) {
+	g.flows += flows
+	g.packets += packets
+	g.bytes += bytes
+	if !bucketed {
+		return
+	}
+	if g.buckets == nil {
+		g.buckets = make(map[int64]*pb.FlowBucket)
+	}
+	bucket := g.buckets[start]
+	if bucket == nil {
+		bucket = &pb.FlowBucket{Start: start}
+		g.buckets[start] = bucket
+	}
+	bucket.Flows += flows
+	bucket.Packets += packets
+	bucket.Bytes += bytes
+}
+
+// merge adds another group's flows to g
+func (g *flowGroup) merge(other *flowGroup, bucketed bool) {
+	g.add(other.flows, other.packets, other.bytes, 0, false)
+	for start, bucket := range other.buckets {
+		g.add(0, 0, 0, start, bucketed)
+		g.buckets[start].Flows += bucket.Flows
+		g.buckets[start].Packets += bucket.Packets
+		g.buckets[start].Bytes += bucket.Bytes
+	}
+}
+
+func (g *flowGroup) toProto(groupBy []string) *pb.FlowGroup {
+	group := &pb.FlowGroup{Flows: g.flows, Packets: g.packets, Bytes: g.bytes}
+	if len(groupBy) > 0 {
+		group.Key = make(map[string]string, len(groupBy))
+		for i, key := range groupBy {
+			group.Key[key] = g.values[i]
+		}
+	}
+	for _, bucket := range g.buckets {
+		group.Buckets = append(group.Buckets, bucket)
+	}
+	sort.Slice(group.Buckets, func(i, j int) bool { return group.Buckets[i].Start < group.Buckets[j].Start })
+	return group
+}
+
+// prefix returns the network of an address at the query's prefix length
+func (q *flowAggregation) prefix(text string) string {
+	addr, err := netip.ParseAddr(text)
+	if err != nil {
+		return text
+	}
+	addr = addr.Unmap()
+	bits := q.ipv6Bits
+	if addr.Is4() {
+		bits = q.ipv4Bits
+	}
+	prefix, _ := addr.Prefix(bits)
+	if q.anonymizer != nil {
+		prefix = q.anonymizer.prefix(prefix)
+	}
+	return prefix.String()
+}
+
+func (q *flowAggregation) addr(text string) string {
+	if q.anonymizer != nil {
+		return q.anonymizer.text(text)
+	}
+	return text
+}
+
+// country looks up the real address: a country reveals no more than the
+// anonymized prefix does
+func (q *flowAggregation) country(text string) string {
+	addr, err := netip.ParseAddr(text)
+	if err != nil {
+		return ""
+	}
+	return q.geoIP.country(addr)
+}
+
+// AggregateFlows sums the archived flows the filter selects by the group_by
+// keys, optionally in time buckets, and returns the largest groups. A flow
+// is counted once per day it was archived, in the bucket of its first_seen.
+func (s *Server) AggregateFlows(ctx context.Context, req *pb.AggregateFlowsRequest) (*pb.AggregateFlowsResponse, error) {
+	if s.flowArchive == nil {
+		return nil, fmt.Errorf("flow archive is not enabled")
+	}
+
+	days := int(req.Days)
+	if days <= 0 {
+		days = 7
+	}
+	if days > flowArchiveRetentionDays {
+		return nil, fmt.Errorf("days must be at most %d", flowArchiveRetentionDays)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = flowAggregateDefaultLimit
+	}
+	if limit > flowAggregateMaxGroups {
+		return nil, fmt.Errorf("limit must be at most %d", flowAggregateMaxGroups)
+	}
+	if req.BucketSeconds < 0 || req.BucketSeconds > 0 && req.BucketSeconds < flowAggregateMinBucket {
+		return nil, fmt.Errorf("bucket_seconds must be 0 or at least %d", flowAggregateMinBucket)
+	}
+	var metric func(g *flowGroup) uint64
+	switch req.OrderBy {
+	case "", "bytes":
+		metric = func(g *flowGroup) uint64 { return g.bytes }
+	case "packets":
+		metric = func(g *flowGroup) uint64 { return g.packets }
+	case "flows":
+		metric = func(g *flowGroup) uint64 { return uint64(g.flows) }
+	default:
+		return nil, fmt.Errorf("invalid order_by %q, expected bytes, packets or flows", req.OrderBy)
+	}
+
+	q := &flowAggregation{
+		ipv4Bits: flowAggregateIPv4Prefix,
+		ipv6Bits: flowAggregateIPv6Prefix,
+		bucket:   req.BucketSeconds,
+	}
+	if req.Ipv4PrefixLen > 32 {
+		return nil, fmt.Errorf("ipv4_prefix_len must be at most 32")
+	} else if req.Ipv4PrefixLen > 0 {
+		q.ipv4Bits = int(req.Ipv4PrefixLen)
+	}
+	if req.Ipv6PrefixLen > 128 {
+		return nil, fmt.Errorf("ipv6_prefix_len must be at most 128")
+	} else if req.Ipv6PrefixLen > 0 {
+		q.ipv6Bits = int(req.Ipv6PrefixLen)
+	}
+	if s.anonymizer.applies(false) {
+		q.anonymizer = s.anonymizer
+	}
+	filter, err := compileFlowFilter(req.Filter, q.anonymizer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.RLock()
+	q.geoIP = s.geoIP
+	s.mutex.RUnlock()
+	var values []func(q *flowAggregation, r *FlowRecord) string
+	seen := make(map[string]bool)
+	for _, key := range req.GroupBy {
+		value, ok := flowAggregateKeys[key]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("invalid group_by key %q, expected one of %s", key, strings.Join(sortedFlowAggregateKeys(), ", "))
+		case seen[key]:
+			return nil, fmt.Errorf("group_by key %s is repeated", key)
+		case strings.HasSuffix(key, "_country") && q.geoIP == nil:
+			return nil, withCode(ErrCodeGeoIPMissing, fmt.Errorf("group_by %s needs a GeoIP database (CERBERUS_GEOIP_DB)", key))
+		}
+		seen[key] = true
+		q.groupBy = append(q.groupBy, key)
+		values = append(values, value)
+	}
+
+	byDay, dayNames, err := s.flowArchive.Days(ctx, s.clock.Now(), days)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*flowGroup)
+	total := &flowGroup{}
+	bucketed := q.bucket > 0
+	keyValues := make([]string, len(values))
+	for i, day := range dayNames {
+		if err := checkpoint(ctx, "flow aggregation", i, len(dayNames), "days aggregated"); err != nil {
+			return nil, err
+		}
+		for _, record := range byDay[day] {
+			if !filter.matches(&record) {
+				continue
+			}
+			for k, value := range values {
+				keyValues[k] = value(q, &record)
+			}
+			id := strings.Join(keyValues, "\x00")
+			group := groups[id]
+			if group == nil {
+				group = &flowGroup{values: append([]string(nil), keyValues...)}
+				groups[id] = group
+			}
+			var start int64
+			if bucketed {
+				start = record.FirstSeen - record.FirstSeen%q.bucket
+			}
+			group.add(1, record.Packets, record.Bytes, start, bucketed)
+			total.add(1, record.Packets, record.Bytes, start, bucketed)
+		}
+	}
+
+	ranked := make([]*flowGroup, 0, len(groups))
+	for _, group := range groups {
+		ranked = append(ranked, group)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if a, b := metric(ranked[i]), metric(ranked[j]); a != b {
+			return a > b
+		}
+		return strings.Join(ranked[i].values, "\x00") < strings.Join(ranked[j].values, "\x00")
+	})
+
+	resp := &pb.AggregateFlowsResponse{
+		Total:      total.toProto(nil),
+		GroupCount: int64(len(ranked)),
+		Days:       dayNames,
+	}
+	other := &flowGroup{}
+	for i, group := range ranked {
+		if i < limit {
+			resp.Groups = append(resp.Groups, group.toProto(q.groupBy))
+		} else {
+			other.merge(group, bucketed)
+		}
+	}
+	if len(ranked) > limit {
+		resp.Other = other.toProto(nil)
+	}
+	return resp, nil
+}
+
+// sortedFlowAggregateKeys lists the group_by keys, for error messages
+func sortedFlowAggregateKeys() []string {
+	keys := make([]string, 0, len(flowAggregateKeys))
+	for key := range flowAggregateKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,420 @@
+// SPDX-License-Identifier: Apache-2.0
+// Flow archive: daily record of tracked flows, used to test rules against past traffic
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	flowArchiveDirName = "flows"
+	flowArchiveFileExt = ".jsonl" // One file per UTC day, named YYYY-MM-DD.jsonl
+
+	// How often the flow table is scanned and today's file rewritten
+	flowArchiveInterval     = time.Minute
+	flowArchiveSaveInterval = 10 * time.Minute
+
+	flowArchiveRetentionDays = 30
+	flowArchiveDayLimit      = 262144 // Flows kept per day; later flows are counted only
+)
+
+// FlowRecord is one flow seen on a day, in the direction of its first packet
+type FlowRecord struct {
+	Family    int    `json:"family"`
+	Protocol  uint8  `json:"protocol"`
+	SrcIP     string `json:"src_ip"`
+	SrcPort   uint16 `json:"src_port"`
+	DstIP     string `json:"dst_ip"`
+	DstPort   uint16 `json:"dst_port"`
+	Packets   uint64 `json:"packets"`
+	Bytes     uint64 `json:"bytes"`
+	FirstSeen int64  `json:"first_seen"` // Unix, first scan that saw the flow
+	LastSeen  int64  `json:"last_seen"`
+}
+
+func (r *FlowRecord) id() string {
+	return fmt.Sprintf("%d/%s/%d/%s/%d", r.Protocol, r.SrcIP, r.SrcPort, r.DstIP, r.DstPort)
+}
+
+// FlowArchive accumulates the flows of the current day in memory and keeps
+// one JSON lines file per day under <state dir>/flows
+type FlowArchive struct {
+	mutex   sync.Mutex
+	dir     string
+	day     string                 // Current day, YYYY-MM-DD
+	flows   map[string]*FlowRecord // Current day's flows by id
+	dropped int                    // Flows over flowArchiveDayLimit today
+	clock   Clock
+}
+
+// NewFlowArchive opens the archive under stateDir, continuing today's file
+func NewFlowArchive(stateDir string) (*FlowArchive, error) {
+	dir := filepath.Join(stateDir, flowArchiveDirName)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create flow archive directory: %v", err)
+	}
+
+	archive := &FlowArchive{dir: dir, flows: make(map[string]*FlowRecord), clock: systemClock{}}
+	archive.day = archive.clock.Now().UTC().Format(time.DateOnly)
+	records, err := archive.readDay(archive.day)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		archive.flows[records[i].id()] = &records[i]
+	}
+	return archive, nil
+}
+
+// Record merges a scan of the flow table taken at now. Counters of a flow
+// recreated after eviction restart, so the larger value is kept.
+func (fa *FlowArchive) Record(now time.Time, connections []Connection) error {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+
+	if day := now.UTC().Format(time.DateOnly); day != fa.day {
+		if err := fa.flushLocked(); err != nil {
+			return err
+		}
+		fa.day, fa.flows, fa.dropped = day, make(map[string]*FlowRecord), 0
+		fa.prune(now)
+	}
+
+	for _, conn := range connections {
+		src, dst := conn.Key.addrs()
+		record := &FlowRecord{
+			Family:   int(conn.Key.Family),
+			Protocol: conn.Key.Protocol,
+			SrcIP:    src.String(),
+			SrcPort:  conn.Key.SrcPort,
+			DstIP:    dst.String(),
+			DstPort:  conn.Key.DstPort,
+		}
+		existing, seen := fa.flows[record.id()]
+		if !seen {
+			if len(fa.flows) >= flowArchiveDayLimit {
+				fa.dropped++
+				continue
+			}
+			record.FirstSeen = now.Unix()
+			fa.flows[record.id()] = record
+			existing = record
+		}
+		existing.LastSeen = now.Unix()
+		existing.Packets = max(existing.Packets, conn.Entry.Packets)
+		existing.Bytes = max(existing.Bytes, conn.Entry.Bytes)
+	}
+	return nil
+}
+
+// Flush writes today's flows to disk
+func (fa *FlowArchive) Flush() error {
+	fa.mutex.Lock()
+	defer fa.mutex.Unlock()
+	return fa.flushLocked()
+}
+
+func (fa *FlowArchive) flushLocked() error {
+	if len(fa.flows) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(fa.flows))
+	for id := range fa.flows {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	for _, id := range ids {
+		if err := encoder.Encode(fa.flows[id]); err != nil {
+			return fmt.Errorf("failed to encode flow %s: %v", id, err)
+		}
+	}
+	if fa.dropped > 0 {
+		log.Printf("⚠️  Flow archive for %s is full, %d flows not recorded", fa.day, fa.dropped)
+	}
+	return writeFileAtomic(fa.dayPath(fa.day), data.Bytes())
+}
+
+// prune deletes day files older than the retention
+func (fa *FlowArchive) prune(now time.Time) {
+	oldest := now.UTC().AddDate(0, 0, -flowArchiveRetentionDays).Format(time.DateOnly)
+	entries, err := os.ReadDir(fa.dir)
+	if err != nil {
+		log.Printf("Failed to list flow archive: %v", err)
+		return
+	}
+	for _, entry := range entries {
+		day := strings.TrimSuffix(entry.Name(), flowArchiveFileExt)
+		if _, err := time.Parse(time.DateOnly, day); err != nil || day >= oldest {
+			continue
+		}
+		if err := os.Remove(filepath.Join(fa.dir, entry.Name())); err != nil {
+			log.Printf("Failed to remove expired flow archive %s: %v", entry.Name(), err)
+		}
+	}
+}
+
+// Days returns the flows of the last n days including today, oldest first;
+// days without an archive are omitted. ctx is checked between days.
+func (fa *FlowArchive) Days(ctx context.Context, now time.Time, n int) (map[string][]FlowRecord, []string, error) {
+	byDay := make(map[string][]FlowRecord)
+	var days []string
+	for offset := n - 1; offset >= 0; offset-- {
+		if err := checkpoint(ctx, "flow archive read", n-1-offset, n, "days read"); err != nil {
+			return nil, nil, err
+		}
+		day := now.UTC().AddDate(0, 0, -offset).Format(time.DateOnly)
+
+		fa.mutex.Lock()
+		current := day == fa.day
+		var records []FlowRecord
+		if current {
+			for _, record := range fa.flows {
+				records = append(records, *record)
+			}
+		}
+		fa.mutex.Unlock()
+
+		if !current {
+			var err error
+			if records, err = fa.readDay(day); err != nil {
+				return nil, nil, err
+			}
+			if records == nil {
+				continue
+			}
+		}
+		byDay[day] = records
+		days = append(days, day)
+	}
+	return byDay, days, nil
+}
+
+// readDay loads a day file, nil when there is none
+func (fa *FlowArchive) readDay(day string) ([]FlowRecord, error) {
+	file, err := os.Open(fa.dayPath(day))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open flow archive: %v", err)
+	}
+	defer file.Close()
+
+	records := []FlowRecord{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record FlowRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to parse flow archive %s: %v", file.Name(), err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read flow archive %s: %v", file.Name(), err)
+	}
+	return records, nil
+}
+
+func (fa *FlowArchive) dayPath(day string) string {
+	return filepath.Join(fa.dir, day+flowArchiveFileExt)
+}
+
+// Run scans the host flow table every flowArchiveInterval and writes
+// today's file every flowArchiveSaveInterval until ctx is done
+func (fa *FlowArchive) Run(ctx context.Context, manager *BPFMapManager) {
+	if manager == nil || manager.conntrack == nil {
+		return
+	}
+
+	scan := time.NewTicker(flowArchiveInterval)
+	defer scan.Stop()
+	save := time.NewTicker(flowArchiveSaveInterval)
+	defer save.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-scan.C:
+			connections, err := manager.Connections(ctx)
+			if err == nil {
+				err = fa.Record(fa.clock.Now(), connections)
+			}
+			if err != nil {
+				log.Printf("Failed to archive flows: %v", err)
+			}
+		case <-save.C:
+			if err := fa.Flush(); err != nil {
+				log.Printf("Failed to save flow archive: %v", err)
+			}
+		}
+	}
+}
+
+// QueryHistoricalMatches reports how many archived flows a rule would have
+// matched, per day, among those the filter selects; without a rule it
+// reports the flows the filter selects. Flows are matched in the direction
+// of their first packet; conn_state and direction are not evaluated.
+func (s *Server) QueryHistoricalMatches(ctx context.Context, req *pb.HistoricalMatchesRequest) (*pb.HistoricalMatchesResponse, error) {
+	if s.flowArchive == nil {
+		return nil, fmt.Errorf("flow archive is not enabled")
+	}
+
+	days := int(req.Days)
+	if days <= 0 {
+		days = 7
+	}
+	if days > flowArchiveRetentionDays {
+		return nil, fmt.Errorf("days must be at most %d", flowArchiveRetentionDays)
+	}
+	limit := int(req.SampleLimit)
+	if limit <= 0 {
+		limit = 10
+	}
+	var anonymizer *Anonymizer
+	if s.anonymizer.applies(false) {
+		anonymizer = s.anonymizer
+	}
+	filter, err := compileFlowFilter(req.Filter, anonymizer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.RLock()
+	var rule *FirewallRule
+	switch {
+	case req.RuleId != "":
+		rule = s.rules[req.RuleId]
+		if rule == nil {
+			s.mutex.RUnlock()
+			return nil, fmt.Errorf("rule not found: %s", req.RuleId)
+		}
+	case req.Rule != nil:
+		var err error
+		rule, err = fromProtoRule(req.Rule, s.clock.Now())
+		if err == nil {
+			if rule.Action == "" {
+				rule.Action = "drop"
+			}
+			err = s.validateRule(rule)
+		}
+		if err != nil {
+			s.mutex.RUnlock()
+			return nil, fmt.Errorf("rule validation failed: %v", err)
+		}
+	case filter != nil:
+	default:
+		s.mutex.RUnlock()
+		return nil, fmt.Errorf("rule_id, rule or filter is required")
+	}
+	var entries []*FirewallRule
+	if rule != nil {
+		entries = resolveRuleObjects(rule, s.services, s.addressObjects)
+	}
+	s.mutex.RUnlock()
+
+	byDay, dayNames, err := s.flowArchive.Days(ctx, s.clock.Now(), days)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.HistoricalMatchesResponse{}
+	var matched []FlowRecord
+	for i, day := range dayNames {
+		if err := checkpoint(ctx, "historical match", i, len(dayNames), "days matched"); err != nil {
+			return nil, err
+		}
+		summary := &pb.DayMatches{Date: day, Flows: int64(len(byDay[day]))}
+		for _, record := range byDay[day] {
+			if !filter.matches(&record) || rule != nil && !flowMatchesEntries(entries, &record) {
+				continue
+			}
+			summary.MatchedFlows++
+			summary.MatchedPackets += record.Packets
+			summary.MatchedBytes += record.Bytes
+			matched = append(matched, record)
+		}
+		resp.Days = append(resp.Days, summary)
+		resp.TotalFlows += summary.Flows
+		resp.MatchedFlows += summary.MatchedFlows
+	}
+
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Bytes > matched[j].Bytes })
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	for _, record := range matched {
+		sample := &pb.Connection{
+			Family:   familyName(record.Family),
+			Protocol: protocolName(record.Protocol),
+			SrcIp:    record.SrcIP,
+			SrcPort:  int32(record.SrcPort),
+			DstIp:    record.DstIP,
+			DstPort:  int32(record.DstPort),
+			Packets:  record.Packets,
+			Bytes:    record.Bytes,
+		}
+		if s.anonymizer.applies(false) {
+			s.anonymizer.connection(sample)
+		}
+		resp.Samples = append(resp.Samples, sample)
+	}
+	return resp, nil
+}
+
+// flowMatchesEntries reports whether any resolved entry of a rule matches a
+// flow the way the data plane matches its first packet
+func flowMatchesEntries(entries []*FirewallRule, record *FlowRecord) bool {
+	src, srcErr := netip.ParseAddr(record.SrcIP)
+	dst, dstErr := netip.ParseAddr(record.DstIP)
+	if srcErr != nil || dstErr != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if family := ruleFamily(entry); family != familyAny && family != record.Family {
+			continue
+		}
+		if protocol := encodeRule(entry, record.Family).Protocol; protocol != 0 && protocol != record.Protocol {
+			continue
+		}
+		if !rulePrefix(entry.SrcIP, record.Family).Contains(src) || !rulePrefix(entry.DstIP, record.Family).Contains(dst) {
+			continue
+		}
+		if !portMatch(record.SrcPort, entry.SrcPort, entry.SrcPortEnd) || !portMatch(record.DstPort, entry.DstPort, entry.DstPortEnd) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// portMatch mirrors port_match in the eBPF program: a zero start matches
+// any port, a zero end makes the range a single port
+func portMatch(port uint16, start, end int32) bool {
+	if start == 0 {
+		return true
+	}
+	if end == 0 {
+		end = start
+	}
+	return int32(port) >= start && int32(port) <= end
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"crypto/tls"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Fuzz targets for the input the control plane takes from outside: rules
+// and imported rulesets from operators, and packets the data plane punts.
+// go test runs each on its seed corpus; make fuzz explores further.
+
+func FuzzValidateRule(f *testing.F) {
+	f.Add("drop", "192.168.1.0/24", "", "tcp", int32(0), int32(22), "", "")
+	f.Add("allow", "2001:db8::/32", "2001:db8::1", "udp", int32(53), int32(0), "", "02:42:ac:11:00:02")
+	f.Add("reject", "10.0.0.1", "10.0.0.0/8", "tcp", int32(1024), int32(443), "syn/syn,ack", "")
+	f.Add("drop", "10.0.0.1/33", "::/0", "icmp", int32(-1), int32(70000), "syn,fin", "zz:zz")
+	f.Add("redirect", "::ffff:10.0.0.1/120", "0.0.0.0/0", "47", int32(0), int32(0), "/", "")
+
+	s, _ := newTestServer(time.Now())
+	f.Fuzz(func(t *testing.T, action, srcIP, dstIP, protocol string, srcPort, dstPort int32, tcpFlags, srcMAC string) {
+		rule := &FirewallRule{
+			Action: action, SrcIP: srcIP, DstIP: dstIP, Protocol: protocol,
+			SrcPort: srcPort, DstPort: dstPort, TCPFlags: tcpFlags, SrcMAC: srcMAC,
+			Direction: "inbound", Priority: 100, Enabled: true,
+		}
+		if err := s.validateRule(rule); err != nil {
+			return
+		}
+		// An accepted rule survives the API round trip
+		again, err := fromProtoRule(toProtoRule(rule), time.Now())
+		if err == nil {
+			err = s.validateRule(again)
+		}
+		if err != nil {
+			t.Fatalf("rule %+v is valid, after a round trip it is not: %v", rule, err)
+		}
+		for _, addr := range []string{srcIP, dstIP} {
+			if _, err := parseRuleAddress(addr); addr != "" && err != nil {
+				t.Fatalf("valid rule address %q does not parse: %v", addr, err)
+			}
+		}
+	})
+}
+
+func FuzzTranslateNFTables(f *testing.F) {
+	f.Add([]byte(`{"nftables": [
+		{"chain": {"family": "inet", "table": "filter", "name": "input", "type": "filter", "hook": "input", "prio": 0, "policy": "drop"}},
+		{"set": {"family": "inet", "table": "filter", "name": "blocked", "elem": ["10.0.0.1", {"prefix": {"addr": "192.168.0.0", "len": 16}}]}},
+		{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 1, "expr": [
+			{"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": "@blocked"}},
+			{"drop": null}]}},
+		{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 2, "expr": [
+			{"match": {"op": "==", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": {"set": [22, {"range": [8000, 8080]}]}}},
+			{"accept": null}]}}
+	]}`))
+	f.Add([]byte(`{"nftables": []}`))
+	f.Add([]byte(`{"nftables": [{"rule": {"expr": [{"match": {"left": null, "right": {"set": [[]]}}}]}}]}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		translation, err := translateNFTables(data, DefaultNFTablesPriority)
+		if err != nil {
+			return
+		}
+		for _, rule := range translation.rules {
+			if rule == nil {
+				t.Fatalf("translation holds a nil rule")
+			}
+		}
+	})
+}
+
+func FuzzTranslateIPTables(f *testing.F) {
+	f.Add([]byte("*filter\n:INPUT DROP [0:0]\n:FORWARD ACCEPT [0:0]\n:OUTPUT ACCEPT [0:0]\n"+
+		"-A INPUT -s 10.0.0.0/8 -p tcp -m tcp --dport 22 -j ACCEPT\n"+
+		"-A INPUT -p udp -m multiport --dports 53,123:124 -j ACCEPT\n"+
+		"-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT\nCOMMIT\n"), false)
+	f.Add([]byte("*filter\n:INPUT ACCEPT [0:0]\n-A INPUT -d 2001:db8::/32 -j REJECT --reject-with tcp-reset\nCOMMIT\n"), true)
+	f.Add([]byte("*nat\n-A PREROUTING -j DNAT\nCOMMIT\n*filter\n-A INPUT ! -s\n-A USER -j\n"), false)
+
+	f.Fuzz(func(t *testing.T, data []byte, ipv6 bool) {
+		translation, err := translateIPTables(data, ipv6, DefaultNFTablesPriority)
+		if err != nil {
+			return
+		}
+		if len(translation.origins) != len(translation.rules) {
+			t.Fatalf("%d rules but %d origins", len(translation.rules), len(translation.origins))
+		}
+	})
+}
+
+func FuzzParseRuleDocument(f *testing.F) {
+	f.Add([]byte("version: 1\nrules:\n  - action: drop\n    src_ip: 10.0.0.0/8\n"), "")
+	f.Add([]byte(`{"version": 1, "rules": [{"action": "allow", "dst_port": 443}]}`), "json")
+	f.Add([]byte("rules: &a [*a]\n"), "yaml")
+	f.Add([]byte("{"), "")
+
+	f.Fuzz(func(t *testing.T, data []byte, format string) {
+		parseRuleDocument(data, format)
+	})
+}
+
+func FuzzFilterExpression(f *testing.F) {
+	f.Add(`type == "RULE_LOG" and severity != "info"`)
+	f.Add(`src_ip in 10.0.0.0/8 or not (dst_port == 443 or protocol == "udp")`)
+	f.Add(`metadata.rule_id ~ "^rule_" and (`)
+	f.Add(`bytes > 1000000 and packets >= 10`)
+	f.Add("type\v==\f\"RULE_LOG\" \x85 \xa0")
+
+	event := &pb.Event{Type: "RULE_LOG", Severity: "warning", Message: "rule matched",
+		Metadata: map[string]string{"src_ip": "10.1.2.3", "rule_id": "rule_1"}}
+	record := &FlowRecord{Family: familyIPv4, Protocol: 6, SrcIP: "10.1.2.3", SrcPort: 40000,
+		DstIP: "192.0.2.1", DstPort: 443, Packets: 12, Bytes: 3400}
+	f.Fuzz(func(t *testing.T, text string) {
+		if filter, err := compileEventFilter(text, nil); err == nil {
+			filter.matches(event)
+		}
+		if filter, err := compileFlowFilter(text, nil); err == nil {
+			filter.matches(record)
+		}
+	})
+}
+
+// ipv4Packet returns an IPv4 header of the given protocol followed by
+// payload
+func ipv4Packet(protocol byte, src, dst string, payload []byte) []byte {
+	packet := make([]byte, 20, 20+len(payload))
+	packet[0], packet[8], packet[9] = 0x45, 64, protocol
+	total := 20 + len(payload)
+	packet[2], packet[3] = byte(total>>8), byte(total)
+	s, d := netip.MustParseAddr(src).As4(), netip.MustParseAddr(dst).As4()
+	copy(packet[12:16], s[:])
+	copy(packet[16:20], d[:])
+	return append(packet, payload...)
+}
+
+// ipv6Packet returns an IPv6 header with the given next header followed by
+// payload
+func ipv6Packet(next byte, src, dst string, payload []byte) []byte {
+	packet := make([]byte, 40, 40+len(payload))
+	packet[0], packet[6], packet[7] = 0x60, next, 1
+	packet[4], packet[5] = byte(len(payload)>>8), byte(len(payload))
+	s, d := netip.MustParseAddr(src).As16(), netip.MustParseAddr(dst).As16()
+	copy(packet[8:24], s[:])
+	copy(packet[24:40], d[:])
+	return append(packet, payload...)
+}
+
+func FuzzParseMembership(f *testing.F) {
+	f.Add(ipv4Packet(2, "10.0.0.2", "224.0.0.22", []byte{0x16, 0, 0, 0, 239, 1, 2, 3}))
+	f.Add(ipv4Packet(2, "10.0.0.2", "224.0.0.22", []byte{0x22, 0, 0, 0, 0, 0, 0, 2,
+		4, 0, 0, 0, 239, 1, 2, 3,
+		3, 1, 0, 1, 239, 1, 2, 4, 10, 0, 0, 1, 0, 0, 0, 0}))
+	group := netip.MustParseAddr("ff0e::1").As16()
+	f.Add(ipv6Packet(0, "fe80::1", "ff02::16", append([]byte{58, 0, 5, 2, 0, 0, 1, 0,
+		143, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0}, group[:]...)))
+	f.Add(ipv6Packet(58, "fe80::1", "ff02::2", append([]byte{132, 0, 0, 0, 0, 0, 0, 0}, group[:]...)))
+	f.Add([]byte{0x4f, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, packet []byte) {
+		reporter, _, _, changes := parseMembership(packet)
+		if !reporter.IsValid() && len(changes) > 0 {
+			t.Fatalf("%d changes without a reporter", len(changes))
+		}
+		for _, change := range changes {
+			if !change.Group.IsMulticast() {
+				t.Fatalf("change of non-multicast group %s", change.Group)
+			}
+		}
+	})
+}
+
+func FuzzRejectReply(f *testing.F) {
+	syn := []byte{0x9c, 0x40, 0, 22, 0, 0, 0, 1, 0, 0, 0, 0, 0x50, 0x02, 0xff, 0xff, 0, 0, 0, 0}
+	f.Add(ipv4Packet(6, "192.0.2.10", "10.0.0.1", syn))
+	f.Add(ipv4Packet(17, "192.0.2.10", "10.0.0.1", []byte{0x9c, 0x40, 0, 53, 0, 12, 0, 0, 1, 2, 3, 4}))
+	f.Add(ipv6Packet(6, "2001:db8::10", "2001:db8::1", syn))
+	f.Add(ipv6Packet(58, "2001:db8::10", "2001:db8::1", []byte{128, 0, 0, 0, 0, 1, 0, 1}))
+	f.Add(ipv4Packet(1, "192.0.2.10", "255.255.255.255", []byte{3, 3, 0, 0}))
+
+	f.Fuzz(func(t *testing.T, packet []byte) {
+		to, reply := rejectReply(packet)
+		if reply == nil {
+			return
+		}
+		if !to.IsValid() {
+			t.Fatalf("reply without a destination")
+		}
+		if len(reply) < 20 || reply[0]>>4 != packet[0]>>4 {
+			t.Fatalf("reply %x is not an IPv%d packet", reply, packet[0]>>4)
+		}
+	})
+}
+
+// captureClientHello returns the first flight of a crypto/tls client
+func captureClientHello(f *testing.F, config *tls.Config) []byte {
+	client, server := net.Pipe()
+	defer server.Close()
+	go func() {
+		tls.Client(client, config).Handshake()
+	}()
+	defer client.Close()
+
+	buf := make([]byte, len(tlsHello{}.Data))
+	server.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := server.Read(buf)
+	if err != nil {
+		f.Fatalf("failed to capture a ClientHello: %v", err)
+	}
+	return buf[:n]
+}
+
+func FuzzParseClientHello(f *testing.F) {
+	hello := captureClientHello(f, &tls.Config{ServerName: "example.com", NextProtos: []string{"h2", "http/1.1"}})
+	f.Add(hello)
+	f.Add(captureClientHello(f, &tls.Config{InsecureSkipVerify: true, MaxVersion: tls.VersionTLS12}))
+	f.Add(hello[:len(hello)/2])
+	f.Add([]byte{22, 3, 1, 0, 4, 1, 0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		hello, err := parseClientHello(data)
+		if err != nil {
+			return
+		}
+		hello.ja3String()
+		if ja3 := hello.ja3(); len(ja3) != 32 {
+			t.Fatalf("JA3 %q is not an MD5 digest", ja3)
+		}
+		if ja4 := hello.ja4(); strings.Count(ja4, "_") != 2 {
+			t.Fatalf("JA4 %q does not have three parts", ja4)
+		}
+	})
+}
+
+func FuzzDNSName(f *testing.F) {
+	f.Add("example.com", []byte("\x03www\x07example\x03com"))
+	f.Add("*.ads.example.net.", []byte("\x02ad\x03ads\x07example\x03net"))
+	f.Add("xn--bcher-kva.example", []byte("\x3fshort"))
+	f.Add("a..b", []byte{0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, domain string, wire []byte) {
+		// Names the data plane reports are bounded by its buffer
+		if len(wire) > dnsNameMax {
+			wire = wire[:dnsNameMax]
+		}
+		dnsNameText(wire)
+
+		normalized, err := normalizeDomain(domain)
+		if err != nil {
+			return
+		}
+		list := &DomainList{Name: "fuzz", Action: DomainListDeny, Domains: []string{normalized}}
+		compiled := compileDomainLists(map[string]*DomainList{list.Name: list})
+		matchDNSName(compiled, wire)
+
+		// A listed domain matches itself and every name below it
+		own := dnsWireName(normalized)
+		if text := dnsNameText(own); text != normalized {
+			t.Fatalf("wire form of %q reads back as %q", normalized, text)
+		}
+		for _, name := range [][]byte{own, append([]byte("\x03sub"), own...)} {
+			if match, ok := matchDNSName(compiled, name); !ok || match.domain != normalized {
+				t.Fatalf("%q does not match listed domain %q", dnsNameText(name), normalized)
+			}
+		}
+	})
+}
@@ -0,0 +1,649 @@
+// SPDX-License-Identifier: Apache-2.0
+// GeoIP country database: the prefixes of each country, for protection
+// profiles and country rules, reloaded when the database file changes
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GeoIP database formats, detected by LoadGeoIPDatabase
+const (
+	GeoIPFormatCSV         = "csv"         // prefix,country lines
+	GeoIPFormatMaxMind     = "mmdb"        // MaxMind GeoIP2/GeoLite2 Country database
+	GeoIPFormatMaxMindCSV  = "maxmind-csv" // Directory of MaxMind GeoIP2/GeoLite2 Country CSV files
+	GeoIPFormatIP2Location = "ip2location" // IP2Location DB1 CSV, IPv4 or IPv6
+
+	// How often the database file is checked for changes, unless set with
+	// CERBERUS_GEOIP_REFRESH
+	DefaultGeoIPRefresh = time.Hour
+)
+
+// GeoIPDatabase holds the prefixes allocated to each country, loaded from
+// the file or directory named by CERBERUS_GEOIP_DB
+type GeoIPDatabase struct {
+	path      string
+	format    string
+	countries map[string][]netip.Prefix // By upper case ISO 3166 code
+	byPrefix  map[netip.Prefix]string   // Country of each prefix, for lookups
+	counts    map[int]int               // Prefixes by family
+	builtAt   time.Time                 // Build time of a MaxMind database, else modification time
+	loadedAt  time.Time
+	stamp     geoIPStamp // Source as loaded, to notice changes
+}
+
+// geoIPStamp identifies one version of a database's files
+type geoIPStamp struct {
+	modTime time.Time // Latest modification
+	size    int64     // Total size
+}
+
+// geoIPSource is the configured database file, where it is downloaded from
+// and how its reloads went
+type geoIPSource struct {
+	path      string
+	update    *dbUpdate // nil = the file is updated by other means
+	refreshes uint64    // Database changes loaded
+	failures  uint64    // Database changes that failed to load
+}
+
+// LoadGeoIPDatabase reads a country database, in the format given by its
+// name or first line:
+//   - a directory: MaxMind Country CSV files, the Blocks-IPv4, Blocks-IPv6
+//     and Locations-en files as downloaded
+//   - a .mmdb file: a MaxMind Country or City binary database
+//   - a CSV file starting with a decimal address range: IP2Location DB1,
+//     e.g. "16777216","16777471","AU","Australia"
+//   - any other CSV file: prefix,country lines such as "192.0.2.0/24,NL".
+//     Further columns are ignored, as are empty lines, lines starting
+//     with # and a header line.
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	stamp, err := geoIPStampOf(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database: %v", err)
+	}
+	db := &GeoIPDatabase{
+		path:      path,
+		countries: make(map[string][]netip.Prefix),
+		byPrefix:  make(map[netip.Prefix]string),
+		counts:    make(map[int]int),
+		builtAt:   stamp.modTime,
+		stamp:     stamp,
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database: %v", err)
+	}
+	switch {
+	case info.IsDir():
+		db.format = GeoIPFormatMaxMindCSV
+		err = db.loadMaxMindCSV(path)
+	case strings.EqualFold(filepath.Ext(path), ".mmdb"):
+		db.format = GeoIPFormatMaxMind
+		err = db.loadMaxMind(path)
+	default:
+		err = db.loadCSV(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(db.countries) == 0 {
+		return nil, fmt.Errorf("%s holds no prefixes", path)
+	}
+	for country, prefixes := range db.countries {
+		db.countries[country] = sortPrefixes(prefixes)
+		for _, prefix := range prefixes {
+			db.byPrefix[prefix] = country
+			if prefix.Addr().Is4() {
+				db.counts[familyIPv4]++
+			} else {
+				db.counts[familyIPv6]++
+			}
+		}
+	}
+	return db, nil
+}
+
+// geoIPStampOf returns the modification time and size of a database file,
+// or the latest time and total size of the files of a database directory
+func geoIPStampOf(path string) (geoIPStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return geoIPStamp{}, err
+	}
+	if !info.IsDir() {
+		return geoIPStamp{modTime: info.ModTime(), size: info.Size()}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return geoIPStamp{}, err
+	}
+	var stamp geoIPStamp
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.IsDir() {
+			continue
+		}
+		if info.ModTime().After(stamp.modTime) {
+			stamp.modTime = info.ModTime()
+		}
+		stamp.size += info.Size()
+	}
+	return stamp, nil
+}
+
+// openGeoIPCSV opens a CSV file of a database for reading record by record
+func openGeoIPCSV(path string) (*os.File, *csv.Reader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open GeoIP database: %v", err)
+	}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+	return file, reader, nil
+}
+
+// loadCSV reads a prefix,country or IP2Location CSV file, told apart by
+// the first field of the first line
+func (db *GeoIPDatabase) loadCSV(path string) error {
+	file, reader, err := openGeoIPCSV(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read GeoIP database: %v", err)
+		}
+		if line == 1 {
+			db.format = GeoIPFormatCSV
+			if _, err := strconv.ParseUint(strings.TrimSpace(record[0]), 10, 64); err == nil {
+				db.format = GeoIPFormatIP2Location
+			}
+		}
+		if db.format == GeoIPFormatIP2Location {
+			err = db.addIP2LocationRange(record)
+		} else {
+			err = db.addPrefixRecord(record, line)
+		}
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+	}
+}
+
+// addPrefixRecord adds a prefix,country line; a first line that is not a
+// prefix is a header
+func (db *GeoIPDatabase) addPrefixRecord(record []string, line int) error {
+	if len(record) < 2 {
+		return fmt.Errorf("expected prefix,country")
+	}
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(record[0]))
+	if err != nil && line == 1 {
+		return nil // Header
+	}
+	if err != nil {
+		return fmt.Errorf("invalid prefix: %v", err)
+	}
+	country := strings.ToUpper(strings.TrimSpace(record[1]))
+	if !validCountryCode(country) {
+		return fmt.Errorf("invalid country code %q", record[1])
+	}
+	db.countries[country] = append(db.countries[country], prefix.Masked())
+	return nil
+}
+
+// addIP2LocationRange adds an ip_from,ip_to,country_code line of an
+// IP2Location DB1 file. Addresses are decimal integers; IPv6 files hold
+// IPv4 ranges as IPv4-mapped addresses. Ranges of no country ("-") are
+// skipped.
+func (db *GeoIPDatabase) addIP2LocationRange(record []string) error {
+	if len(record) < 3 {
+		return fmt.Errorf("expected ip_from,ip_to,country_code")
+	}
+	country := strings.ToUpper(strings.TrimSpace(record[2]))
+	if country == "-" {
+		return nil
+	}
+	if !validCountryCode(country) {
+		return fmt.Errorf("invalid country code %q", record[2])
+	}
+	from, to, err := ip2LocationRange(record[0], record[1])
+	if err != nil {
+		return err
+	}
+	db.countries[country] = append(db.countries[country], rangePrefixes(from, to)...)
+	return nil
+}
+
+// ip2LocationRange parses the decimal range ends of an IP2Location line,
+// IPv4 addresses when the end fits 32 bits or both are IPv4-mapped
+func ip2LocationRange(fromField, toField string) (netip.Addr, netip.Addr, error) {
+	var values [2]*big.Int
+	for i, field := range []string{fromField, toField} {
+		value, ok := new(big.Int).SetString(strings.TrimSpace(field), 10)
+		if !ok || value.Sign() < 0 || value.BitLen() > 128 {
+			return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid address %q", field)
+		}
+		values[i] = value
+	}
+	if values[0].Cmp(values[1]) > 0 {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("invalid range %s-%s", fromField, toField)
+	}
+
+	var addrs [2]netip.Addr
+	for i, value := range values {
+		if values[1].BitLen() <= 32 {
+			var addr [4]byte
+			addrs[i] = netip.AddrFrom4([4]byte(value.FillBytes(addr[:])))
+		} else {
+			var addr [16]byte
+			addrs[i] = netip.AddrFrom16([16]byte(value.FillBytes(addr[:])))
+		}
+	}
+	if addrs[0].Is4In6() && addrs[1].Is4In6() {
+		return addrs[0].Unmap(), addrs[1].Unmap(), nil
+	}
+	return addrs[0], addrs[1], nil
+}
+
+// rangePrefixes returns the fewest prefixes covering exactly the addresses
+// from through to
+func rangePrefixes(from, to netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for from.IsValid() && !to.Less(from) {
+		bits := from.BitLen()
+		for bits > 0 {
+			wider := netip.PrefixFrom(from, bits-1).Masked()
+			if wider.Addr() != from || to.Less(prefixLast(wider)) {
+				break
+			}
+			bits--
+		}
+		prefix := netip.PrefixFrom(from, bits)
+		prefixes = append(prefixes, prefix)
+		from = prefixLast(prefix).Next() // Invalid past the last address
+	}
+	return prefixes
+}
+
+// prefixLast returns the last address of a masked prefix
+func prefixLast(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Addr().As16()
+	offset := 128 - prefix.Addr().BitLen() // IPv4 is in the last 4 bytes
+	for bit := offset + prefix.Bits(); bit < 128; bit++ {
+		addr[bit/8] |= 0x80 >> (bit % 8)
+	}
+	if prefix.Addr().Is4() {
+		return netip.AddrFrom16(addr).Unmap()
+	}
+	return netip.AddrFrom16(addr)
+}
+
+// loadMaxMindCSV reads the Blocks-IPv4, Blocks-IPv6 and Locations-en files
+// of a MaxMind Country CSV download. Networks take the country of their
+// location, else the country they are registered in; networks of neither,
+// such as anonymous proxies, are skipped.
+func (db *GeoIPDatabase) loadMaxMindCSV(dir string) error {
+	locations, err := filepath.Glob(filepath.Join(dir, "*-Locations-en.csv"))
+	if err != nil || len(locations) != 1 {
+		return fmt.Errorf("%s: expected one *-Locations-en.csv file", dir)
+	}
+	codes, err := readMaxMindLocations(locations[0])
+	if err != nil {
+		return err
+	}
+
+	var blocks []string
+	for _, pattern := range []string{"*-Blocks-IPv4.csv", "*-Blocks-IPv6.csv"} {
+		matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+		blocks = append(blocks, matches...)
+	}
+	if len(blocks) == 0 {
+		return fmt.Errorf("%s: no *-Blocks-IPv4.csv or *-Blocks-IPv6.csv file", dir)
+	}
+	for _, path := range blocks {
+		if err := db.readMaxMindBlocks(path, codes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxMindColumns maps the header of a MaxMind CSV file to column indexes,
+// failing unless every required column is present
+func maxMindColumns(path string, header []string, required ...string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%s: no %s column", path, name)
+		}
+	}
+	return columns, nil
+}
+
+// readMaxMindLocations returns the country code of each geoname ID;
+// continent-only locations have none and are left out
+func readMaxMindLocations(path string) (map[string]string, error) {
+	file, reader, err := openGeoIPCSV(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	columns, err := maxMindColumns(path, header, "geoname_id", "country_iso_code")
+	if err != nil {
+		return nil, err
+	}
+	codes := make(map[string]string)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return codes, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", path, err)
+		}
+		if len(record) <= columns["country_iso_code"] || len(record) <= columns["geoname_id"] {
+			continue
+		}
+		if country := strings.ToUpper(record[columns["country_iso_code"]]); validCountryCode(country) {
+			codes[record[columns["geoname_id"]]] = country
+		}
+	}
+}
+
+// readMaxMindBlocks adds the networks of a Blocks file
+func (db *GeoIPDatabase) readMaxMindBlocks(path string, codes map[string]string) error {
+	file, reader, err := openGeoIPCSV(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	columns, err := maxMindColumns(path, header, "network", "geoname_id", "registered_country_geoname_id")
+	if err != nil {
+		return err
+	}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %v", path, err)
+		}
+		if len(record) < len(header) {
+			return fmt.Errorf("%s:%d: expected %d columns", path, line, len(header))
+		}
+		prefix, err := netip.ParsePrefix(record[columns["network"]])
+		if err != nil {
+			return fmt.Errorf("%s:%d: invalid network: %v", path, line, err)
+		}
+		country := codes[record[columns["geoname_id"]]]
+		if country == "" {
+			country = codes[record[columns["registered_country_geoname_id"]]]
+		}
+		if country != "" {
+			db.countries[country] = append(db.countries[country], prefix.Masked())
+		}
+	}
+}
+
+// validCountryCode reports whether code looks like an upper case ISO 3166
+// alpha-2 code
+func validCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// has reports whether the database knows a country
+func (db *GeoIPDatabase) has(country string) bool {
+	return len(db.countries[country]) > 0
+}
+
+// country returns the country of the longest prefix holding an address,
+// empty when no country's prefixes hold it
+func (db *GeoIPDatabase) country(addr netip.Addr) string {
+	addr = addr.Unmap()
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		if country, ok := db.byPrefix[netip.PrefixFrom(addr, bits).Masked()]; ok {
+			return country
+		}
+	}
+	return ""
+}
+
+// prefixes returns the distinct prefixes of one family allocated to any of
+// the countries, in address order
+func (db *GeoIPDatabase) prefixes(countries []string, family int) []netip.Prefix {
+	seen := make(map[netip.Prefix]bool)
+	var prefixes []netip.Prefix
+	for _, country := range countries {
+		for _, prefix := range db.countries[country] {
+			if prefix.Addr().BitLen() == familyBits(family) && !seen[prefix] {
+				seen[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	return sortPrefixes(prefixes)
+}
+
+// sortPrefixes orders prefixes by address, then by length
+func sortPrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+	return prefixes
+}
+
+// geoIPConfigFromEnv returns how often the GeoIP database is downloaded or
+// checked for changes, 0 = never, and the URL of a single-file database
+// it is downloaded from
+func geoIPConfigFromEnv() (time.Duration, string, error) {
+	url := os.Getenv("CERBERUS_GEOIP_URL")
+	if url != "" && !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return 0, "", fmt.Errorf("invalid CERBERUS_GEOIP_URL %q, expected an http:// or https:// URL", url)
+	}
+	if url != "" {
+		path := os.Getenv("CERBERUS_GEOIP_DB")
+		if path == "" {
+			return 0, "", fmt.Errorf("CERBERUS_GEOIP_URL needs CERBERUS_GEOIP_DB, the file it is downloaded to")
+		}
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return 0, "", fmt.Errorf("CERBERUS_GEOIP_URL needs CERBERUS_GEOIP_DB to name a file, %s is a directory", path)
+		}
+	}
+	value := os.Getenv("CERBERUS_GEOIP_REFRESH")
+	if value == "" {
+		return DefaultGeoIPRefresh, url, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		return 0, "", fmt.Errorf("invalid CERBERUS_GEOIP_REFRESH %q, expected a duration such as 24h, or 0 for never", value)
+	}
+	return interval, url, nil
+}
+
+// refreshGeoIP downloads the GeoIP database, if it has a URL, and reloads
+// it whenever its files change, until ctx is done. Downloads go in use
+// once verified and loaded, see dbUpdate.run. A database that fails to
+// load leaves the previous one in use; it is retried once its files
+// change again.
+func (s *Server) refreshGeoIP(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var failed geoIPStamp
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.geoIPSource.update != nil {
+			s.mutex.RLock()
+			var inUse int
+			if s.geoIP != nil {
+				inUse = s.geoIP.prefixCount()
+			}
+			s.mutex.RUnlock()
+			result, err := s.geoIPSource.update.run(ctx, inUse, s.stageGeoIP)
+			if err != nil {
+				s.mutex.Lock()
+				s.geoIPSource.failures++
+				s.mutex.Unlock()
+			}
+			s.publishUpdate(s.geoIPSource.update, result, err)
+		}
+
+		s.mutex.RLock()
+		var loaded geoIPStamp
+		if s.geoIP != nil {
+			loaded = s.geoIP.stamp
+		}
+		s.mutex.RUnlock()
+
+		stamp, err := geoIPStampOf(s.geoIPSource.path)
+		if err != nil {
+			log.Printf("⚠️  Failed to check GeoIP database: %v", err)
+			continue
+		}
+		if stamp == loaded || stamp == failed {
+			continue
+		}
+
+		db, err := LoadGeoIPDatabase(s.geoIPSource.path)
+		if err == nil {
+			err = s.reloadGeoIP(db)
+		}
+		s.mutex.Lock()
+		if err != nil {
+			s.geoIPSource.failures++
+			failed = stamp
+		} else {
+			s.geoIPSource.refreshes++
+		}
+		s.mutex.Unlock()
+		if err != nil {
+			log.Printf("⚠️  GeoIP database not reloaded, keeping the previous one: %v", err)
+			continue
+		}
+		log.Printf("Reloaded GeoIP database %s (%s, %d countries, built %s)",
+			db.path, db.format, len(db.countries), db.builtAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// prefixCount returns the number of prefixes of every country
+func (db *GeoIPDatabase) prefixCount() int {
+	count := 0
+	for _, n := range db.counts {
+		count += n
+	}
+	return count
+}
+
+// stageGeoIP loads a downloaded GeoIP database for dbUpdate, to go in use
+// from the configured path
+func (s *Server) stageGeoIP(path string) (*stagedDatabase, error) {
+	db, err := LoadGeoIPDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	db.path = s.geoIPSource.path
+	return &stagedDatabase{prefixes: db.prefixCount(), install: func() error {
+		if err := s.reloadGeoIP(db); err != nil {
+			return err
+		}
+		s.mutex.Lock()
+		s.geoIPSource.refreshes++
+		s.mutex.Unlock()
+		log.Printf("Reloaded GeoIP database %s (%s, %d countries, built %s)",
+			db.path, db.format, len(db.countries), db.builtAt.UTC().Format(time.RFC3339))
+		return nil
+	}}, nil
+}
+
+// reloadGeoIP puts a new database in use: the prefixes of the countries
+// rules match are rewritten and the country sets of protection profiles
+// rebuilt
+func (s *Server) reloadGeoIP(db *GeoIPDatabase) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous := s.geoIP
+	s.geoIP = db
+	if err := s.addRuleCountries(s.compiledPolicy().Sorted); err != nil {
+		s.geoIP = previous
+		if previous != nil {
+			if err := s.addRuleCountries(s.compiledPolicy().Sorted); err != nil {
+				log.Printf("⚠️  Failed to restore GeoIP prefixes of the previous database: %v", err)
+			}
+		}
+		s.pruneRuleCountries()
+		return fmt.Errorf("failed to push country prefixes: %v", err)
+	}
+	s.pruneRuleCountries()
+	db.loadedAt = s.clock.Now()
+	for _, country := range s.ruleCountries() {
+		if !db.has(country) {
+			log.Printf("⚠️  Country %s of rules has no prefixes in the reloaded GeoIP database, its rules match nothing", country)
+		}
+	}
+
+	for _, profile := range s.protectionProfiles {
+		if len(profile.AllowedCountries) == 0 {
+			continue
+		}
+		stale := &ProtectionProfile{Name: profile.Name, countrySets: profile.countrySets}
+		if err := s.buildCountrySets(profile); err != nil {
+			profile.countrySets = stale.countrySets
+			log.Printf("⚠️  Protection profile %s keeps the countries of the previous GeoIP database: %v", profile.Name, err)
+			continue
+		}
+		for _, dest := range s.destinationsUsingProfile(profile.Name) {
+			if err := s.pushProtection(dest); err != nil {
+				log.Printf("⚠️  Failed to push protection of %s: %v", dest.Destination, err)
+			}
+		}
+		s.dropCountrySets(stale)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: Apache-2.0
+// MaxMind DB reader: the networks of a GeoIP2/GeoLite2 .mmdb file and the
+// country or ASN of each, per the MaxMind DB format 2.0 specification
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/netip"
+	"os"
+	"time"
+)
+
+// mmdbMetadataMarker starts the metadata section at the end of the file
+var mmdbMetadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// Data section field types
+const (
+	mmdbExtended  = 0
+	mmdbPointer   = 1
+	mmdbString    = 2
+	mmdbDouble    = 3
+	mmdbBytes     = 4
+	mmdbUint16    = 5
+	mmdbUint32    = 6
+	mmdbMap       = 7
+	mmdbInt32     = 8
+	mmdbUint64    = 9
+	mmdbUint128   = 10
+	mmdbArray     = 11
+	mmdbContainer = 12
+	mmdbEndMarker = 13
+	mmdbBoolean   = 14
+	mmdbFloat     = 15
+)
+
+// mmdbReader walks the search tree of a database held in memory
+type mmdbReader struct {
+	tree       []byte
+	data       []byte // Data section, which pointers are relative to
+	nodeCount  uint32
+	recordSize uint32 // Bits per record, 24, 28 or 32
+	ipVersion  int
+
+	// extract picks what the caller needs from a network's data record,
+	// nil to skip the network; values caches it by data offset
+	extract func(record map[string]any) any
+	values  map[uint32]any
+
+	// Set by networks: address bits and the node of the IPv4 subtree of
+	// an IPv6 tree, MaxUint32 in an IPv4 one
+	bits      int
+	ipv4Start uint32
+}
+
+// loadMaxMind reads a MaxMind Country or City database. Networks take the
+// country of their location, else the country they are registered in;
+// networks of neither are skipped.
+func (db *GeoIPDatabase) loadMaxMind(path string) error {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read GeoIP database: %v", err)
+	}
+	reader, buildEpoch, err := newMMDBReader(file, mmdbCountry)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if buildEpoch > 0 {
+		db.builtAt = time.Unix(int64(buildEpoch), 0)
+	}
+	err = reader.networks(func(prefix netip.Prefix, country any) {
+		db.countries[country.(string)] = append(db.countries[country.(string)], prefix)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// mmdbCountry returns the ISO code of the country, else the registered
+// country, of a data record
+func mmdbCountry(record map[string]any) any {
+	for _, key := range []string{"country", "registered_country"} {
+		location, _ := record[key].(map[string]any)
+		if code, _ := location["iso_code"].(string); validCountryCode(code) {
+			return code
+		}
+	}
+	return nil
+}
+
+// newMMDBReader parses the metadata of a database, returning a reader
+// extracting values of its data records and the database's build time in
+// Unix seconds
+func newMMDBReader(file []byte, extract func(record map[string]any) any) (*mmdbReader, uint64, error) {
+	start := bytes.LastIndex(file, mmdbMetadataMarker)
+	if start < 0 {
+		return nil, 0, fmt.Errorf("not a MaxMind DB file, no metadata marker")
+	}
+	metadataSection := file[start+len(mmdbMetadataMarker):]
+	value, _, err := mmdbDecode(metadataSection, 0)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid metadata: %v", err)
+	}
+	metadata, ok := value.(map[string]any)
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid metadata: not a map")
+	}
+	number := func(key string) uint64 {
+		n, _ := metadata[key].(uint64)
+		return n
+	}
+
+	reader := &mmdbReader{
+		nodeCount:  uint32(number("node_count")),
+		recordSize: uint32(number("record_size")),
+		ipVersion:  int(number("ip_version")),
+		extract:    extract,
+		values:     make(map[uint32]any),
+	}
+	if version := number("binary_format_major_version"); version != 2 {
+		return nil, 0, fmt.Errorf("unsupported binary format version %d", version)
+	}
+	if reader.recordSize != 24 && reader.recordSize != 28 && reader.recordSize != 32 {
+		return nil, 0, fmt.Errorf("unsupported record size %d", reader.recordSize)
+	}
+	if reader.ipVersion != 4 && reader.ipVersion != 6 {
+		return nil, 0, fmt.Errorf("unsupported IP version %d", reader.ipVersion)
+	}
+	treeSize := uint64(reader.nodeCount) * uint64(reader.recordSize) / 4
+	if treeSize+16 > uint64(start) {
+		return nil, 0, fmt.Errorf("search tree of %d nodes exceeds the file", reader.nodeCount)
+	}
+	reader.tree = file[:treeSize]
+	reader.data = file[treeSize+16 : start] // After 16 zero bytes
+	return reader, number("build_epoch"), nil
+}
+
+// record returns the left (bit 0) or right (bit 1) record of a node
+func (r *mmdbReader) record(node uint32, bit int) uint32 {
+	offset := uint64(node) * uint64(r.recordSize) / 4
+	b := r.tree[offset:]
+	switch r.recordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	case 28:
+		if bit == 0 {
+			return uint32(b[3]&0xf0)<<20 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+		}
+		return uint32(b[3]&0x0f)<<24 | uint32(b[4])<<16 | uint32(b[5])<<8 | uint32(b[6])
+	default:
+		return binary.BigEndian.Uint32(b[bit*4:])
+	}
+}
+
+// networks calls add with every network of the tree that has a value.
+// In an IPv6 tree IPv4 networks are those under ::/96; the IPv4-mapped and
+// 6to4 subtrees alias them and are skipped.
+func (r *mmdbReader) networks(add func(prefix netip.Prefix, value any)) error {
+	r.bits = 32
+	r.ipv4Start = math.MaxUint32
+	if r.ipVersion == 6 {
+		r.bits = 128
+		r.ipv4Start = 0
+		for depth := 0; depth < 96 && r.ipv4Start < r.nodeCount; depth++ {
+			r.ipv4Start = r.record(r.ipv4Start, 0)
+		}
+	}
+	if r.nodeCount == 0 {
+		return nil
+	}
+	var addr [16]byte
+	return r.walk(0, 0, &addr, add)
+}
+
+// walk visits both records of the node at depth, the network addr/depth
+func (r *mmdbReader) walk(node uint32, depth int, addr *[16]byte, add func(netip.Prefix, any)) error {
+	for bit := 0; bit < 2; bit++ {
+		if bit == 1 {
+			addr[depth/8] |= 0x80 >> (depth % 8)
+		}
+		err := r.visit(r.record(node, bit), depth+1, addr, add)
+		if bit == 1 {
+			addr[depth/8] &^= 0x80 >> (depth % 8)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visit follows a record of the network addr/depth: into the node it
+// points to, or to the network's data
+func (r *mmdbReader) visit(record uint32, depth int, addr *[16]byte, add func(netip.Prefix, any)) error {
+	switch {
+	case record < r.nodeCount:
+		if depth >= r.bits {
+			return fmt.Errorf("search tree deeper than %d bits", r.bits)
+		}
+		if record == r.ipv4Start && (depth != 96 || *addr != [16]byte{}) {
+			return nil // Alias of the IPv4 subtree
+		}
+		return r.walk(record, depth, addr, add)
+	case record == r.nodeCount:
+		return nil // No data
+	}
+
+	value, err := r.value(record - r.nodeCount - 16)
+	if err != nil || value == nil {
+		return err
+	}
+	var prefix netip.Prefix
+	switch {
+	case r.bits == 32:
+		prefix = netip.PrefixFrom(netip.AddrFrom4([4]byte(addr[:4])), depth)
+	case depth >= 96 && [12]byte(addr[:12]) == [12]byte{}:
+		prefix = netip.PrefixFrom(netip.AddrFrom4([4]byte(addr[12:])), depth-96)
+	default:
+		prefix = netip.PrefixFrom(netip.AddrFrom16(*addr), depth)
+	}
+	add(prefix, value)
+	return nil
+}
+
+// value returns the extracted value of the data record at offset
+func (r *mmdbReader) value(offset uint32) (any, error) {
+	if value, ok := r.values[offset]; ok {
+		return value, nil
+	}
+	if uint64(offset) >= uint64(len(r.data)) {
+		return nil, fmt.Errorf("data offset %d exceeds the data section", offset)
+	}
+	decoded, _, err := mmdbDecode(r.data, int(offset))
+	if err != nil {
+		return nil, fmt.Errorf("invalid data record at %d: %v", offset, err)
+	}
+
+	var value any
+	if record, ok := decoded.(map[string]any); ok {
+		value = r.extract(record)
+	}
+	r.values[offset] = value
+	return value, nil
+}
+
+// mmdbDecode decodes the field at offset of a data section, returning it
+// and the offset after it. Unsigned integers decode as uint64, int32 as
+// int64, uint128 as its bytes and floats as float64.
+func mmdbDecode(data []byte, offset int) (any, int, error) {
+	if offset >= len(data) {
+		return nil, 0, fmt.Errorf("field at %d exceeds the section", offset)
+	}
+	control := data[offset]
+	offset++
+	kind := int(control >> 5)
+
+	if kind == mmdbPointer {
+		size := int(control>>3) & 0x3
+		payload := []int{1, 2, 3, 4}[size]
+		if offset+payload > len(data) {
+			return nil, 0, fmt.Errorf("pointer at %d exceeds the section", offset)
+		}
+		target := 0
+		if size < 3 {
+			target = int(control & 0x7)
+		}
+		for _, b := range data[offset : offset+payload] {
+			target = target<<8 | int(b)
+		}
+		target += []int{0, 2048, 526336, 0}[size]
+		if target < len(data) && data[target]>>5 == mmdbPointer {
+			return nil, 0, fmt.Errorf("pointer at %d points to a pointer", offset)
+		}
+		value, _, err := mmdbDecode(data, target)
+		return value, offset + payload, err
+	}
+
+	if kind == mmdbExtended {
+		if offset >= len(data) {
+			return nil, 0, fmt.Errorf("extended type at %d exceeds the section", offset)
+		}
+		kind = 7 + int(data[offset])
+		offset++
+	}
+	size := int(control & 0x1f)
+	if size >= 29 {
+		extra := size - 28
+		if offset+extra > len(data) {
+			return nil, 0, fmt.Errorf("size at %d exceeds the section", offset)
+		}
+		n := 0
+		for _, b := range data[offset : offset+extra] {
+			n = n<<8 | int(b)
+		}
+		size = []int{29, 285, 65821}[extra-1] + n
+		offset += extra
+	}
+
+	switch kind {
+	case mmdbMap:
+		value := make(map[string]any, size)
+		for i := 0; i < size; i++ {
+			key, next, err := mmdbDecode(data, offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("map key at %d is not a string", offset)
+			}
+			if value[name], offset, err = mmdbDecode(data, next); err != nil {
+				return nil, 0, err
+			}
+		}
+		return value, offset, nil
+	case mmdbArray:
+		value := make([]any, 0, size)
+		for i := 0; i < size; i++ {
+			element, next, err := mmdbDecode(data, offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			value = append(value, element)
+			offset = next
+		}
+		return value, offset, nil
+	case mmdbBoolean:
+		return size != 0, offset, nil
+	case mmdbContainer, mmdbEndMarker:
+		return nil, offset, nil
+	}
+
+	if offset+size > len(data) {
+		return nil, 0, fmt.Errorf("field at %d exceeds the section", offset)
+	}
+	payload := data[offset : offset+size]
+	offset += size
+	switch kind {
+	case mmdbString:
+		return string(payload), offset, nil
+	case mmdbBytes, mmdbUint128:
+		return payload, offset, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("double of %d bytes", size)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(payload)), offset, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("float of %d bytes", size)
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(payload))), offset, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64:
+		var n uint64
+		for _, b := range payload {
+			n = n<<8 | uint64(b)
+		}
+		return n, offset, nil
+	case mmdbInt32:
+		var n uint32
+		for _, b := range payload {
+			n = n<<8 | uint32(b)
+		}
+		return int64(int32(n)), offset, nil
+	}
+	return nil, 0, fmt.Errorf("unknown field type %d", kind)
+}
@@ -1,3 +1,33 @@
 module github.com/m4rba4s/Cerberus-V/ctrl
 
-go 1.21
+go 1.22
+
+require (
+	github.com/cilium/ebpf v0.16.0
+	github.com/klauspost/compress v1.18.0
+	github.com/m4rba4s/Cerberus-V/proto v0.0.0
+	github.com/mdlayher/netlink v1.7.2
+	github.com/prometheus/client_golang v1.19.1
+	golang.org/x/net v0.25.0
+	golang.org/x/sys v0.20.0
+	golang.org/x/text v0.15.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157
+	google.golang.org/grpc v1.65.0
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/josharian/native v1.1.0 // indirect
+	github.com/mdlayher/socket v0.4.1 // indirect
+	github.com/prometheus/client_model v0.5.0 // indirect
+	github.com/prometheus/common v0.48.0 // indirect
+	github.com/prometheus/procfs v0.12.0 // indirect
+	golang.org/x/exp v0.0.0-20230224173230-c95f2b4c22f2 // indirect
+	golang.org/x/sync v0.7.0 // indirect
+)
+
+replace github.com/m4rba4s/Cerberus-V/proto => ../proto
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// gRPC server tuning: message size limits, stream limits, keepalive and connection age
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Defaults; message limits are raised from the gRPC default of 4 MiB so
+// large rule sets fit in one ImportRules or ReplaceRules message and
+// GetRules can return them
+const (
+	DefaultGRPCMaxRecvMsgSize   = 64 << 20
+	DefaultGRPCMaxSendMsgSize   = 64 << 20
+	DefaultGRPCKeepaliveTime    = 2 * time.Hour
+	DefaultGRPCKeepaliveTimeout = 20 * time.Second
+	DefaultGRPCKeepaliveMinTime = 30 * time.Second
+)
+
+// GRPCConfig tunes the gRPC server. Zero durations and limits leave the
+// gRPC default in place (no limit for streams and connection ages).
+type GRPCConfig struct {
+	MaxRecvMsgSize       int
+	MaxSendMsgSize       int
+	MaxConcurrentStreams uint32
+
+	KeepaliveTime    time.Duration // Ping an idle client after this long
+	KeepaliveTimeout time.Duration // Close the connection when a ping goes unanswered this long
+	// Enforcement: clients pinging more often than MinTime, or without
+	// active RPCs unless PermitWithoutStream, are disconnected
+	KeepaliveMinTime             time.Duration
+	KeepalivePermitWithoutStream bool
+
+	MaxConnectionIdle     time.Duration
+	MaxConnectionAge      time.Duration
+	MaxConnectionAgeGrace time.Duration // Time left to in-flight RPCs once a connection is too old
+}
+
+// grpcConfigFromEnv reads the gRPC server tuning from CERBERUS_GRPC_*:
+// message sizes in bytes, durations as Go durations (30s, 5m)
+func grpcConfigFromEnv() (GRPCConfig, error) {
+	config := GRPCConfig{
+		MaxRecvMsgSize:   DefaultGRPCMaxRecvMsgSize,
+		MaxSendMsgSize:   DefaultGRPCMaxSendMsgSize,
+		KeepaliveTime:    DefaultGRPCKeepaliveTime,
+		KeepaliveTimeout: DefaultGRPCKeepaliveTimeout,
+		KeepaliveMinTime: DefaultGRPCKeepaliveMinTime,
+	}
+
+	for name, value := range map[string]*int{
+		"CERBERUS_GRPC_MAX_RECV_MSG_SIZE": &config.MaxRecvMsgSize,
+		"CERBERUS_GRPC_MAX_SEND_MSG_SIZE": &config.MaxSendMsgSize,
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed <= 0 {
+			return config, fmt.Errorf("invalid %s %q, expected a positive size in bytes", name, raw)
+		}
+		*value = int(parsed)
+	}
+
+	if raw := os.Getenv("CERBERUS_GRPC_MAX_CONCURRENT_STREAMS"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return config, fmt.Errorf("invalid CERBERUS_GRPC_MAX_CONCURRENT_STREAMS %q, expected a stream count, 0 = unlimited", raw)
+		}
+		config.MaxConcurrentStreams = uint32(parsed)
+	}
+
+	for name, value := range map[string]*time.Duration{
+		"CERBERUS_GRPC_KEEPALIVE_TIME":           &config.KeepaliveTime,
+		"CERBERUS_GRPC_KEEPALIVE_TIMEOUT":        &config.KeepaliveTimeout,
+		"CERBERUS_GRPC_KEEPALIVE_MIN_TIME":       &config.KeepaliveMinTime,
+		"CERBERUS_GRPC_MAX_CONNECTION_IDLE":      &config.MaxConnectionIdle,
+		"CERBERUS_GRPC_MAX_CONNECTION_AGE":       &config.MaxConnectionAge,
+		"CERBERUS_GRPC_MAX_CONNECTION_AGE_GRACE": &config.MaxConnectionAgeGrace,
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			return config, fmt.Errorf("invalid %s %q, expected a duration such as 30s", name, raw)
+		}
+		*value = parsed
+	}
+
+	if raw := os.Getenv("CERBERUS_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return config, fmt.Errorf("invalid CERBERUS_GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM %q, expected true or false", raw)
+		}
+		config.KeepalivePermitWithoutStream = parsed
+	}
+	return config, nil
+}
+
+// serverOptions returns the gRPC server options of the configuration
+func (c GRPCConfig) serverOptions() []grpc.ServerOption {
+	options := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(c.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(c.MaxSendMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  c.KeepaliveTime,
+			Timeout:               c.KeepaliveTimeout,
+			MaxConnectionIdle:     c.MaxConnectionIdle,
+			MaxConnectionAge:      c.MaxConnectionAge,
+			MaxConnectionAgeGrace: c.MaxConnectionAgeGrace,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.KeepaliveMinTime,
+			PermitWithoutStream: c.KeepalivePermitWithoutStream,
+		}),
+	}
+	if c.MaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(c.MaxConcurrentStreams))
+	}
+	return options
+}
+
+// logSummary logs the effective limits at startup
+func (c GRPCConfig) logSummary() {
+	streams := "unlimited"
+	if c.MaxConcurrentStreams > 0 {
+		streams = strconv.FormatUint(uint64(c.MaxConcurrentStreams), 10)
+	}
+	log.Printf("gRPC limits: recv %d bytes, send %d bytes, %s concurrent streams, keepalive %v/%v (min client interval %v)",
+		c.MaxRecvMsgSize, c.MaxSendMsgSize, streams, c.KeepaliveTime, c.KeepaliveTimeout, c.KeepaliveMinTime)
+}
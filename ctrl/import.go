@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Bulk rule import: a client-streamed batch validated as a whole and applied in one transaction
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// EventRulesImported reports an ImportRules batch
+const EventRulesImported = "RULES_IMPORTED"
+
+// ImportRules receives a batch of rules in any number of messages and
+// applies it once the client closes the stream: the whole batch is
+// validated first, then added to the rule set, or replaces it, in one
+// hit-less data plane update. Nothing changes unless every rule is valid
+// and the data plane accepts the result. Messages may carry rule
+// documents from ExportRules; their services and address objects are
+// created or replaced along with the rules.
+func (s *Server) ImportRules(stream pb.FirewallControl_ImportRulesServer) error {
+	var rules []*pb.Rule
+	var services []*Service
+	var addressObjects []*AddressObject
+	replace := false
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		rules = append(rules, req.Rules...)
+		replace = replace || req.Replace
+		if len(req.Document) == 0 {
+			continue
+		}
+		doc, err := parseRuleDocument(req.Document, req.Format)
+		if err != nil {
+			return stream.SendAndClose(&pb.ImportRulesResponse{Success: false, Message: err.Error()})
+		}
+		for _, rule := range doc.Rules {
+			rules = append(rules, toProtoRule(rule))
+		}
+		services = append(services, doc.Services...)
+		addressObjects = append(addressObjects, doc.AddressObjects...)
+	}
+	resp, err := s.importRules(stream.Context(), rules, services, addressObjects, replace)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// importDocument imports the rules and objects of a rule document
+func (s *Server) importDocument(ctx context.Context, doc *RuleDocument, replace bool) (*pb.ImportRulesResponse, error) {
+	rules := make([]*pb.Rule, 0, len(doc.Rules))
+	for _, rule := range doc.Rules {
+		rules = append(rules, toProtoRule(rule))
+	}
+	return s.importRules(ctx, rules, doc.Services, doc.AddressObjects, replace)
+}
+
+// importRules applies an import batch as one transaction. Services and
+// address objects are created or replaced, never removed, even when the
+// rules replace the rule set; a replacement keeps installed federation
+// baseline rules. The only error returned is the interruption of the data
+// plane update by ctx.
+func (s *Server) importRules(ctx context.Context, batch []*pb.Rule, services []*Service, addressObjects []*AddressObject, replace bool) (*pb.ImportRulesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previousServices, previousObjects := s.services, s.addressObjects
+	restoreObjects := func() {
+		s.services, s.addressObjects = previousServices, previousObjects
+	}
+	errs := s.mergeObjects(services, addressObjects)
+
+	base := s.rules
+	if replace {
+		base = s.federatedRules()
+	}
+	rules, ruleErrs := s.mergeRules(base, batch)
+	errs = append(errs, ruleErrs...)
+	if len(errs) > 0 {
+		restoreObjects()
+		return &pb.ImportRulesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Validation failed for %d of %d rules and objects", len(errs), len(batch)+len(services)+len(addressObjects)),
+			Errors:  errs,
+		}, nil
+	}
+
+	if err := s.limits.checkRules(len(rules)); err != nil {
+		restoreObjects()
+		return nil, err
+	}
+
+	previous := s.rules
+	s.rules = rules
+	if err := s.replacePolicy(ctx); err != nil {
+		s.rules = previous
+		restoreObjects()
+		if interrupted, ok := asInterrupted(err); ok {
+			return nil, interrupted
+		}
+		return &pb.ImportRulesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to import rules into data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+	version := s.compiledPolicy().Version
+	s.events.Publish(&pb.Event{
+		Type:     EventRulesImported,
+		Message:  fmt.Sprintf("%d rules imported, %d rules in total (policy version %d)", len(batch), len(rules), version),
+		Severity: "medium",
+	})
+	log.Printf("Imported %d rules, %d services and %d address objects: %d rules in total, policy version %d",
+		len(batch), len(services), len(addressObjects), len(rules), version)
+
+	return &pb.ImportRulesResponse{
+		Success: true,
+		Message: "Rules imported successfully",
+		Count:   int32(len(batch)),
+		Total:   int32(len(rules)),
+		Version: version,
+	}, nil
+}
+
+// mergeObjects validates imported services and address objects and puts
+// them in copies of the object maps, returning the validation errors.
+// Caller must hold s.mutex and restore the maps if the import fails.
+func (s *Server) mergeObjects(services []*Service, addressObjects []*AddressObject) []string {
+	if len(services) == 0 && len(addressObjects) == 0 {
+		return nil
+	}
+	var errs []string
+
+	merged := make(map[string]*Service, len(s.services)+len(services))
+	for name, service := range s.services {
+		merged[name] = service
+	}
+	for _, service := range services {
+		if err := validateService(service); err != nil {
+			errs = append(errs, fmt.Sprintf("service %s: %v", service.Name, err))
+			continue
+		}
+		merged[service.Name] = service
+	}
+	s.services = merged
+
+	objects := make(map[string]*AddressObject, len(s.addressObjects)+len(addressObjects))
+	for name, object := range s.addressObjects {
+		objects[name] = object
+	}
+	for _, object := range addressObjects {
+		if err := validateAddressObject(object); err != nil {
+			errs = append(errs, fmt.Sprintf("address object %s: %v", object.Name, err))
+			continue
+		}
+		objects[object.Name] = object
+	}
+	s.addressObjects = objects
+	for _, object := range addressObjects {
+		for _, member := range object.Members {
+			if _, exists := objects[member]; !exists {
+				errs = append(errs, fmt.Sprintf("address object %s: unknown member %s", object.Name, member))
+			}
+		}
+		if s.addressGroupHasCycle(object.Name, map[string]bool{}) {
+			errs = append(errs, fmt.Sprintf("address object %s: group contains itself", object.Name))
+		}
+	}
+	return errs
+}
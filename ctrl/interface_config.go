@@ -0,0 +1,534 @@
+// SPDX-License-Identifier: Apache-2.0
+// Per-interface settings: MSS clamping of the TCP SYNs an interface passes
+// and policing of the packets it receives
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned interface config maps (must match eBPF program)
+	IfaceConfigMapName = "cerberus_iface_config"
+	IfaceStatsMapName  = "cerberus_iface_stats"
+	IfacePoliceMapName = "cerberus_iface_police"
+
+	// Interfaces with a config (must match MAX_IFACE_CONFIGS in eBPF program)
+	MaxInterfaceConfigs = 1024
+
+	// Smallest MSS every IPv4 host accepts (RFC 879) and the MSS of the
+	// IPv6 minimum MTU (RFC 8200); the largest fits an IP packet
+	MinMSS  = 536
+	MinMSS6 = 1220
+	MaxMSS  = 65495
+
+	// Header bytes between the MTU and the MSS
+	mssOverhead4 = 40
+	mssOverhead6 = 60
+
+	// Highest policer ceilings. The byte burst must hold the largest
+	// packet, a GSO super-packet at the TC hook, and by default holds a
+	// second at the bit rate.
+	MaxPolicerPPS        = 1 << 30
+	MaxPolicerBPS        = 1 << 40
+	MinPolicerBurstBytes = 65536
+	MaxPolicerBurstBytes = 1 << 32
+)
+
+// MSS clamping directions, named as rule directions
+const (
+	MSSClampInbound  = "inbound"
+	MSSClampOutbound = "outbound"
+	MSSClampBoth     = "both"
+)
+
+// Data plane bits of the directions (enum mss_clamp_dirs in the eBPF program)
+var mssClampDirs = map[string]uint8{
+	MSSClampInbound:  1,
+	MSSClampOutbound: 2,
+	MSSClampBoth:     3,
+}
+
+// InterfaceConfig holds the settings of one interface. With MSSClamp set,
+// the MSS option of TCP SYNs and SYN-ACKs it passes is lowered to the
+// target, the way peers behind a tunnel need. With Policer set, packets it
+// receives over the ceilings are dropped before rules are evaluated.
+type InterfaceConfig struct {
+	Interface string    `json:"interface"`
+	MSSClamp  *MSSClamp `json:"mss_clamp,omitempty"`
+	Policer   *Policer  `json:"policer,omitempty"`
+
+	// Resolved when the config is applied
+	ifindex uint32
+	mtu     uint32
+	mss4    uint16
+	mss6    uint16
+}
+
+// MSSClamp is the MSS clamping of an interface. A zero MSS follows the
+// interface MTU; a zero MSS6 is MSS less the larger IPv6 header.
+type MSSClamp struct {
+	MSS       uint32 `json:"mss,omitempty"`
+	MSS6      uint32 `json:"mss6,omitempty"`
+	Direction string `json:"direction"`
+}
+
+// Policer is the ingress policer of an interface, a packet and a bit rate
+// ceiling each with a burst allowed above it. Zero rates are off.
+type Policer struct {
+	PacketsPerSecond uint32 `json:"packets_per_second"`
+	PacketBurst      uint32 `json:"packet_burst"` // 0 = PacketsPerSecond
+	BitsPerSecond    uint64 `json:"bits_per_second"`
+	BurstBytes       uint64 `json:"burst_bytes"` // 0 = a second at BitsPerSecond
+}
+
+// ifaceConfig mirrors struct iface_config in the eBPF program
+type ifaceConfig struct {
+	MSS4           uint16
+	MSS6           uint16
+	MSSDirs        uint8
+	Pad            [3]uint8
+	PolicePPS      uint32
+	PolicePPSBurst uint32
+	PoliceRate     uint64 // Bytes per second
+	PoliceBurst    uint64
+}
+
+// ifaceStats mirrors struct iface_stats in the eBPF program
+type ifaceStats struct {
+	MSSSYNs         uint64
+	MSSClamped      uint64
+	MSSMissing      uint64
+	PoliceDrops     uint64
+	PoliceDropBytes uint64
+}
+
+// policerState mirrors struct iface_police in the eBPF program
+type policerState struct {
+	PktTokens  uint64
+	PktRefill  uint64
+	ByteTokens uint64
+	ByteRefill uint64
+}
+
+// SetInterfaceConfig replaces the settings of an interface
+func (s *Server) SetInterfaceConfig(ctx context.Context, req *pb.SetInterfaceConfigRequest) (*pb.InterfaceConfigResponse, error) {
+	if req.GetConfig() == nil {
+		return &pb.InterfaceConfigResponse{Success: false, Message: "Interface config is required"}, nil
+	}
+
+	config := interfaceConfigFromProto(req.Config)
+	if err := validateInterfaceConfig(config); err != nil {
+		return &pb.InterfaceConfigResponse{
+			Success: false,
+			Message: fmt.Sprintf("Interface config validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.interfaceConfigs[config.Interface]; !exists && len(s.interfaceConfigs) >= MaxInterfaceConfigs {
+		return &pb.InterfaceConfigResponse{
+			Success: false,
+			Message: fmt.Sprintf("Interface config limit reached (%d interfaces)", MaxInterfaceConfigs),
+		}, nil
+	}
+	if err := s.pushInterfaceConfig(config); err != nil {
+		return &pb.InterfaceConfigResponse{Success: false, Message: fmt.Sprintf("Failed to push interface config to data plane: %v", withRemediation(err))}, nil
+	}
+	s.interfaceConfigs[config.Interface] = config
+	s.persistPolicy()
+	log.Printf("Set interface config: %s (%s)", config.Interface, config.describe())
+
+	return &pb.InterfaceConfigResponse{
+		Success: true,
+		Message: "Interface config saved successfully",
+		Config:  s.interfaceConfigToProto(config),
+	}, nil
+}
+
+// DeleteInterfaceConfig returns an interface to the default settings
+func (s *Server) DeleteInterfaceConfig(ctx context.Context, req *pb.DeleteInterfaceConfigRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	config, exists := s.interfaceConfigs[req.Interface]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Interface config not found"}, nil
+	}
+	if s.bpfManager != nil {
+		if err := s.bpfManager.DeleteInterfaceConfig(config.ifindex); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove interface config from data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	delete(s.interfaceConfigs, req.Interface)
+	s.persistPolicy()
+	log.Printf("Deleted interface config: %s", req.Interface)
+
+	return &pb.StatusResponse{Success: true, Message: "Interface config deleted successfully"}, nil
+}
+
+// ListInterfaceConfigs returns every interface config with its counters,
+// ordered by interface name
+func (s *Server) ListInterfaceConfigs(ctx context.Context, req *pb.Empty) (*pb.InterfaceConfigsResponse, error) {
+	return &pb.InterfaceConfigsResponse{Interfaces: s.interfaceConfigStatus()}, nil
+}
+
+// interfaceConfigStatus returns the interface configs with their counters,
+// ordered by interface name
+func (s *Server) interfaceConfigStatus() []*pb.InterfaceConfig {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	configs := make([]*pb.InterfaceConfig, 0, len(s.interfaceConfigs))
+	for _, config := range s.sortedInterfaceConfigs() {
+		configs = append(configs, s.interfaceConfigToProto(config))
+	}
+	return configs
+}
+
+// validateInterfaceConfig checks a config against its interface and
+// resolves the interface index and the MSS targets
+func validateInterfaceConfig(config *InterfaceConfig) error {
+	var errs ruleValidationError
+	if config.Interface == "" {
+		errs.add("interface", "interface is required")
+		return errs
+	}
+	iface, err := net.InterfaceByName(config.Interface)
+	if err != nil {
+		errs.add("interface", "unknown interface %s: %v", config.Interface, err)
+		return errs
+	}
+	config.ifindex, config.mtu = uint32(iface.Index), uint32(iface.MTU)
+
+	if policer := config.Policer; policer != nil {
+		validatePolicer(policer, &errs)
+	}
+	clamp := config.MSSClamp
+	if clamp == nil {
+		config.mss4, config.mss6 = 0, 0
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	}
+	if clamp.Direction == "" {
+		clamp.Direction = MSSClampBoth
+	}
+	if _, ok := mssClampDirs[clamp.Direction]; !ok {
+		errs.add("mss_clamp.direction", "invalid direction: %s, expected inbound, outbound or both", clamp.Direction)
+	}
+	if clamp.MSS != 0 && (clamp.MSS < MinMSS || clamp.MSS > MaxMSS) {
+		errs.add("mss_clamp.mss", "mss must be between %d and %d", MinMSS, MaxMSS)
+	}
+	if clamp.MSS6 != 0 && (clamp.MSS6 < MinMSS6 || clamp.MSS6 > MaxMSS) {
+		errs.add("mss_clamp.mss6", "mss6 must be between %d and %d", MinMSS6, MaxMSS)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	mss4, mss6 := clamp.MSS, clamp.MSS6
+	if mss4 == 0 {
+		if config.mtu < MinMSS+mssOverhead4 {
+			errs.add("mss_clamp.mss", "MTU %d of %s is too small to derive an MSS, set mss", config.mtu, config.Interface)
+			return errs
+		}
+		mss4 = config.mtu - mssOverhead4
+	}
+	if mss6 == 0 {
+		if clamp.MSS != 0 {
+			mss6 = clamp.MSS - (mssOverhead6 - mssOverhead4)
+		} else {
+			mss6 = config.mtu - mssOverhead6
+		}
+		if mss6 < MinMSS6 {
+			mss6 = MinMSS6
+		}
+	}
+	config.mss4, config.mss6 = uint16(mss4), uint16(mss6)
+	return nil
+}
+
+// validatePolicer checks a policer and fills in the bursts of the
+// ceilings set
+func validatePolicer(policer *Policer, errs *ruleValidationError) {
+	if policer.PacketsPerSecond == 0 && policer.BitsPerSecond == 0 {
+		errs.add("policer", "policer needs packets_per_second or bits_per_second")
+	}
+	if policer.PacketsPerSecond > MaxPolicerPPS || policer.PacketBurst > MaxPolicerPPS {
+		errs.add("policer.packets_per_second", "packets_per_second and packet_burst must be at most %d", MaxPolicerPPS)
+	}
+	if policer.PacketBurst > 0 && policer.PacketsPerSecond == 0 {
+		errs.add("policer.packet_burst", "packet_burst needs a packets_per_second")
+	}
+	if policer.BitsPerSecond > MaxPolicerBPS {
+		errs.add("policer.bits_per_second", "bits_per_second must be at most %d", uint64(MaxPolicerBPS))
+	}
+	if policer.BurstBytes > 0 && policer.BitsPerSecond == 0 {
+		errs.add("policer.burst_bytes", "burst_bytes needs a bits_per_second")
+	} else if policer.BurstBytes > 0 && (policer.BurstBytes < MinPolicerBurstBytes || policer.BurstBytes > MaxPolicerBurstBytes) {
+		errs.add("policer.burst_bytes", "burst_bytes must be between %d and %d", MinPolicerBurstBytes, uint64(MaxPolicerBurstBytes))
+	}
+	if len(*errs) > 0 {
+		return
+	}
+
+	if policer.PacketsPerSecond > 0 && policer.PacketBurst == 0 {
+		policer.PacketBurst = policer.PacketsPerSecond
+	}
+	if policer.BitsPerSecond > 0 && policer.BurstBytes == 0 {
+		policer.BurstBytes = max(policer.BitsPerSecond/8, MinPolicerBurstBytes)
+	}
+}
+
+// describe summarizes the settings of a config for the log
+func (config *InterfaceConfig) describe() string {
+	var settings []string
+	if clamp := config.MSSClamp; clamp != nil {
+		settings = append(settings, fmt.Sprintf("MSS clamp %s to %d, IPv6 %d", clamp.Direction, config.mss4, config.mss6))
+	}
+	if policer := config.Policer; policer != nil {
+		settings = append(settings, fmt.Sprintf("policer %d pps burst %d, %d bps burst %d bytes",
+			policer.PacketsPerSecond, policer.PacketBurst, policer.BitsPerSecond, policer.BurstBytes))
+	}
+	if len(settings) == 0 {
+		return "no MSS clamp or policer"
+	}
+	return strings.Join(settings, "; ")
+}
+
+// encode converts a config to the data plane's
+func (config *InterfaceConfig) encode() ifaceConfig {
+	value := ifaceConfig{MSS4: config.mss4, MSS6: config.mss6}
+	if config.MSSClamp != nil {
+		value.MSSDirs = mssClampDirs[config.MSSClamp.Direction]
+	}
+	if policer := config.Policer; policer != nil {
+		value.PolicePPS, value.PolicePPSBurst = policer.PacketsPerSecond, policer.PacketBurst
+		value.PoliceRate, value.PoliceBurst = policer.BitsPerSecond/8, policer.BurstBytes
+	}
+	return value
+}
+
+// pushInterfaceConfig writes a config to the host data plane, removing
+// the previous config of the interface if its index changed. Caller must
+// hold s.mutex.
+func (s *Server) pushInterfaceConfig(config *InterfaceConfig) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	if previous := s.interfaceConfigs[config.Interface]; previous != nil && previous.ifindex != config.ifindex {
+		if err := s.bpfManager.DeleteInterfaceConfig(previous.ifindex); err != nil {
+			log.Printf("⚠️  Failed to remove previous config of interface %s: %v", config.Interface, err)
+		}
+	}
+	return s.bpfManager.SetInterfaceConfig(config.ifindex, config.encode())
+}
+
+// sortedInterfaceConfigs returns the interface configs ordered by name.
+// Caller must hold s.mutex.
+func (s *Server) sortedInterfaceConfigs() []*InterfaceConfig {
+	configs := make([]*InterfaceConfig, 0, len(s.interfaceConfigs))
+	for _, config := range s.interfaceConfigs {
+		configs = append(configs, config)
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Interface < configs[j].Interface })
+	return configs
+}
+
+func interfaceConfigFromProto(config *pb.InterfaceConfig) *InterfaceConfig {
+	resp := &InterfaceConfig{Interface: config.Interface}
+	if clamp := config.MssClamp; clamp != nil {
+		resp.MSSClamp = &MSSClamp{MSS: clamp.Mss, MSS6: clamp.Mss6, Direction: clamp.Direction}
+	}
+	if policer := config.Policer; policer != nil {
+		resp.Policer = &Policer{
+			PacketsPerSecond: policer.PacketsPerSecond,
+			PacketBurst:      policer.PacketBurst,
+			BitsPerSecond:    policer.BitsPerSecond,
+			BurstBytes:       policer.BurstBytes,
+		}
+	}
+	return resp
+}
+
+// interfaceConfigToProto converts a config with its counters. Caller must
+// hold s.mutex.
+func (s *Server) interfaceConfigToProto(config *InterfaceConfig) *pb.InterfaceConfig {
+	resp := &pb.InterfaceConfig{
+		Interface:     config.Interface,
+		Mtu:           config.mtu,
+		EffectiveMss:  uint32(config.mss4),
+		EffectiveMss6: uint32(config.mss6),
+	}
+	if clamp := config.MSSClamp; clamp != nil {
+		resp.MssClamp = &pb.MSSClamp{Mss: clamp.MSS, Mss6: clamp.MSS6, Direction: clamp.Direction}
+	}
+	if policer := config.Policer; policer != nil {
+		resp.Policer = &pb.Policer{
+			PacketsPerSecond: policer.PacketsPerSecond,
+			PacketBurst:      policer.PacketBurst,
+			BitsPerSecond:    policer.BitsPerSecond,
+			BurstBytes:       policer.BurstBytes,
+		}
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.InterfaceStats(config.ifindex)
+		if err != nil {
+			log.Printf("⚠️  Failed to read counters of interface %s: %v", config.Interface, err)
+		}
+		resp.MssSyns = stats.MSSSYNs
+		resp.MssClamped = stats.MSSClamped
+		resp.MssMissing = stats.MSSMissing
+		resp.PolicedPackets = stats.PoliceDrops
+		resp.PolicedBytes = stats.PoliceDropBytes
+	}
+	return resp
+}
+
+// openInterfaceConfigs opens the pinned interface config maps and clears
+// configs left by a previous control plane run, with their counters;
+// stored ones are re-pushed on restore
+func (bm *BPFMapManager) openInterfaceConfigs() {
+	path := filepath.Join(bm.pinPath, IfaceConfigMapName)
+	configs, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Interface configs not available at %s: %v", path, err)
+		return
+	}
+	path = filepath.Join(bm.pinPath, IfaceStatsMapName)
+	stats, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Interface configs not available at %s: %v", path, err)
+		configs.Close()
+		return
+	}
+	bm.ifaceConfigs, bm.ifaceStats = configs, stats
+
+	path = filepath.Join(bm.pinPath, IfacePoliceMapName)
+	if police, err := ebpf.LoadPinnedMap(path, nil); err != nil {
+		log.Printf("⚠️  Interface policers not available at %s: %v", path, err)
+	} else {
+		bm.ifacePolice = police
+	}
+
+	var ifindex uint32
+	var config ifaceConfig
+	var stale []uint32
+	entries := configs.Iterate()
+	for entries.Next(&ifindex, &config) {
+		stale = append(stale, ifindex)
+	}
+	for _, key := range stale {
+		if err := bm.DeleteInterfaceConfig(key); err != nil {
+			log.Printf("⚠️  Failed to clear stale interface config: %v", err)
+		}
+	}
+}
+
+// closeInterfaceConfigs closes the interface config maps, if open
+func (bm *BPFMapManager) closeInterfaceConfigs() {
+	if bm.ifaceConfigs == nil {
+		return
+	}
+	bm.ifaceConfigs.Close()
+	bm.ifaceStats.Close()
+	if bm.ifacePolice != nil {
+		bm.ifacePolice.Close()
+	}
+}
+
+// SetInterfaceConfig writes the config of an interface, creating its
+// counters if it has none. A policer starts with full buckets.
+func (bm *BPFMapManager) SetInterfaceConfig(ifindex uint32, config ifaceConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting config of interface %d (MSS %d/%d, directions %d, policer %d pps, %d bytes/s)",
+			ifindex, config.MSS4, config.MSS6, config.MSSDirs, config.PolicePPS, config.PoliceRate)
+		return nil
+	}
+	if bm.ifaceConfigs == nil {
+		return fmt.Errorf("interface config maps not available")
+	}
+	policing := config.PolicePPS != 0 || config.PoliceRate != 0
+	if policing && bm.ifacePolice == nil {
+		return fmt.Errorf("interface policer map not available")
+	}
+	zero := make([]ifaceStats, ebpf.MustPossibleCPU())
+	if err := bm.ifaceStats.Update(&ifindex, zero, ebpf.UpdateNoExist); err != nil && !errors.Is(err, ebpf.ErrKeyExist) {
+		return fmt.Errorf("failed to create counters of interface %d: %v", ifindex, err)
+	}
+	if policing {
+		if err := bm.ifacePolice.Put(&ifindex, &policerState{}); err != nil {
+			return fmt.Errorf("failed to create policer of interface %d: %v", ifindex, err)
+		}
+	} else if err := bm.deletePolicer(ifindex); err != nil {
+		return err
+	}
+	if err := bm.ifaceConfigs.Put(&ifindex, &config); err != nil {
+		return fmt.Errorf("failed to write config of interface %d: %v", ifindex, err)
+	}
+	return nil
+}
+
+// DeleteInterfaceConfig removes the config of an interface with its
+// counters
+func (bm *BPFMapManager) DeleteInterfaceConfig(ifindex uint32) error {
+	if bm.simulated || bm.ifaceConfigs == nil {
+		return nil
+	}
+	if err := bm.ifaceConfigs.Delete(&ifindex); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove config of interface %d: %v", ifindex, err)
+	}
+	if err := bm.ifaceStats.Delete(&ifindex); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove counters of interface %d: %v", ifindex, err)
+	}
+	return bm.deletePolicer(ifindex)
+}
+
+// deletePolicer removes the policer state of an interface, if any
+func (bm *BPFMapManager) deletePolicer(ifindex uint32) error {
+	if bm.ifacePolice == nil {
+		return nil
+	}
+	if err := bm.ifacePolice.Delete(&ifindex); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove policer of interface %d: %v", ifindex, err)
+	}
+	return nil
+}
+
+// InterfaceStats reads the counters of an interface, summed across CPUs
+func (bm *BPFMapManager) InterfaceStats(ifindex uint32) (ifaceStats, error) {
+	var stats ifaceStats
+	if bm.simulated || bm.ifaceStats == nil {
+		return stats, nil
+	}
+	var perCPU []ifaceStats
+	if err := bm.ifaceStats.Lookup(&ifindex, &perCPU); err != nil {
+		if errors.Is(err, ebpf.ErrKeyNotExist) {
+			return stats, nil
+		}
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.MSSSYNs += value.MSSSYNs
+		stats.MSSClamped += value.MSSClamped
+		stats.MSSMissing += value.MSSMissing
+		stats.PoliceDrops += value.PoliceDrops
+		stats.PoliceDropBytes += value.PoliceDropBytes
+	}
+	return stats, nil
+}
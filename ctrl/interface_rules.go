@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Interface-scoped rules: rules matching only packets on some interfaces
+// of their data plane, e.g. a WAN uplink but not the LAN side
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+)
+
+// RuleInterfacesMapName is the pinned interface set map (must match eBPF
+// program)
+const RuleInterfacesMapName = "cerberus_rule_ifaces"
+
+// ruleInterfaceKey mirrors struct iface_set_key in ebpf/xdp_filter.c
+type ruleInterfaceKey struct {
+	Set     uint32
+	Ifindex uint32
+}
+
+// validateInterfaceName checks the form of an interface name; whether the
+// interface exists is only known when the rule is pushed
+func validateInterfaceName(name string) error {
+	if name == "" || len(name) >= 16 || strings.ContainsAny(name, "/: \t\n") {
+		return fmt.Errorf("invalid interface name %q", name)
+	}
+	return nil
+}
+
+// canonicalInterfaces sorts an interface list and removes duplicates
+func canonicalInterfaces(interfaces []string) []string {
+	if len(interfaces) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(interfaces)
+	sort.Strings(sorted)
+	return slices.Compact(sorted)
+}
+
+// ruleInterfaceSetID is the data plane ID of a rule's interface set, the
+// same for every rule with the same interfaces; 0 = any interface
+func ruleInterfaceSetID(interfaces []string) uint32 {
+	if len(interfaces) == 0 {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.Join(canonicalInterfaces(interfaces), "\x00")))
+	if id := hash.Sum32(); id != 0 {
+		return id
+	}
+	return 1
+}
+
+// ruleInterfaceKeys resolves the interface sets of entries to map keys.
+// Interfaces that do not exist are left out, so their rules match nothing
+// on them until the next push after they appear.
+func ruleInterfaceKeys(entries []*FirewallRule) map[ruleInterfaceKey]bool {
+	keys := make(map[ruleInterfaceKey]bool)
+	for _, entry := range entries {
+		set := ruleInterfaceSetID(entry.Interfaces)
+		for _, name := range entry.Interfaces {
+			iface, err := net.InterfaceByName(name)
+			if err != nil {
+				log.Printf("⚠️  Rule %s: interface %s not found, not matched until it exists", entry.ID, name)
+				continue
+			}
+			keys[ruleInterfaceKey{Set: set, Ifindex: uint32(iface.Index)}] = true
+		}
+	}
+	return keys
+}
+
+// addRuleInterfaces writes the interface sets of a version's entries to
+// every data plane before its entries are installed. Caller must hold
+// s.mutex.
+func (s *Server) addRuleInterfaces(entries []*FirewallRule) error {
+	byScope := make(map[string][]*FirewallRule)
+	for _, entry := range entries {
+		byScope[ruleScope(entry)] = append(byScope[ruleScope(entry)], entry)
+	}
+	for scope, scoped := range byScope {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		if err := manager.AddRuleInterfaces(ruleInterfaceKeys(scoped)); err != nil {
+			return fmt.Errorf("data plane %q: %v", scope, err)
+		}
+	}
+	return nil
+}
+
+// pruneRuleInterfaces removes the interface sets no entry of the running
+// version uses any more. Caller must hold s.mutex.
+func (s *Server) pruneRuleInterfaces() {
+	byScope := make(map[string][]*FirewallRule)
+	for _, entry := range s.compiledPolicy().Sorted {
+		byScope[ruleScope(entry)] = append(byScope[ruleScope(entry)], entry)
+	}
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		if err := manager.PruneRuleInterfaces(ruleInterfaceKeys(byScope[scope])); err != nil {
+			log.Printf("⚠️  Failed to prune rule interfaces in %q: %v", scope, err)
+		}
+	}
+}
+
+// openRuleInterfaces opens the pinned interface set map, left nil if the
+// program predates it
+func (bm *BPFMapManager) openRuleInterfaces() {
+	path := filepath.Join(bm.pinPath, RuleInterfacesMapName)
+	ifaces, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Interface-scoped rules not available at %s: %v", path, err)
+		return
+	}
+	bm.ruleIfaces = ifaces
+}
+
+// AddRuleInterfaces writes interface set entries
+func (bm *BPFMapManager) AddRuleInterfaces(keys map[ruleInterfaceKey]bool) error {
+	if bm.simulated {
+		return nil
+	}
+	if bm.ruleIfaces == nil {
+		if len(keys) > 0 {
+			return withCode(ErrCodeDataPlaneFeature, fmt.Errorf("data plane has no %s map, interface-scoped rules unavailable", RuleInterfacesMapName))
+		}
+		return nil
+	}
+	present := uint8(1)
+	for key := range keys {
+		if err := bm.ruleIfaces.Put(&key, &present); err != nil {
+			return fmt.Errorf("failed to add interface %d to set %d: %v", key.Ifindex, key.Set, err)
+		}
+	}
+	return nil
+}
+
+// PruneRuleInterfaces deletes interface set entries not in keep
+func (bm *BPFMapManager) PruneRuleInterfaces(keep map[ruleInterfaceKey]bool) error {
+	if bm.simulated || bm.ruleIfaces == nil {
+		return nil
+	}
+	var key ruleInterfaceKey
+	var present uint8
+	var stale []ruleInterfaceKey
+	entries := bm.ruleIfaces.Iterate()
+	for entries.Next(&key, &present) {
+		if !keep[key] {
+			stale = append(stale, key)
+		}
+	}
+	if err := entries.Err(); err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := bm.ruleIfaces.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove interface %d from set %d: %v", key.Ifindex, key.Set, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,554 @@
+// SPDX-License-Identifier: Apache-2.0
+// IP sets: large dynamic address sets in their own data plane maps,
+// referenced by rules through their set ID
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Pinned outer map names by set type and family (must match eBPF program)
+	IPSetHash4MapName = "cerberus_ipset_hash4"
+	IPSetHash6MapName = "cerberus_ipset_hash6"
+	IPSetLPM4MapName  = "cerberus_ipset_lpm4"
+	IPSetLPM6MapName  = "cerberus_ipset_lpm6"
+
+	// Set IDs per outer map (must match MAX_IPSETS in eBPF program); ID 0
+	// means no set
+	MaxIPSets = 1024
+
+	// Entries of a set created without a limit, and the largest limit
+	DefaultIPSetMaxEntries = 65536
+	MaxIPSetEntries        = 1 << 22
+
+	// Set types: host addresses in a hash map, prefixes in an LPM trie
+	IPSetTypeHash = "hash"
+	IPSetTypeLPM  = "lpm"
+)
+
+// IPSet is a named address set kept in its own data plane map. Rules
+// reference it by ID in SrcSet and DstSet and match only addresses in it;
+// entries change without recompiling the policy.
+type IPSet struct {
+	ID          uint32                `json:"id"`
+	Name        string                `json:"name"`
+	Type        string                `json:"type"`   // hash, lpm
+	Family      string                `json:"family"` // ipv4, ipv6
+	MaxEntries  int                   `json:"max_entries"`
+	Description string                `json:"description"`
+	Entries     map[netip.Prefix]bool `json:"entries"` // Hash sets hold host prefixes
+
+	// Protection profile maintaining the set (see protection.go), empty for
+	// sets managed through the IP set API
+	Profile string `json:"profile,omitempty"`
+
+	// Threat feed maintaining the set (see feeds.go). Unlike profile sets,
+	// feed sets are persisted and referenced by rules.
+	Feed string `json:"feed,omitempty"`
+}
+
+// family returns the set's address family as a familyIPv4/familyIPv6 value
+func (set *IPSet) family() int {
+	if set.Family == "ipv6" {
+		return familyIPv6
+	}
+	return familyIPv4
+}
+
+// contains reports whether an address is in the set, the way the data
+// plane looks it up: exactly in a hash set, longest prefix in an LPM set
+func (set *IPSet) contains(addr netip.Addr) bool {
+	if addr.BitLen() != familyBits(set.family()) {
+		return false
+	}
+	if set.Type == IPSetTypeHash {
+		return set.Entries[netip.PrefixFrom(addr, addr.BitLen())]
+	}
+	for bits := 0; bits <= addr.BitLen(); bits++ {
+		if set.Entries[netip.PrefixFrom(addr, bits).Masked()] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseEntry parses an address to add to or remove from the set: a bare
+// address, or for LPM sets also a CIDR without host bits set
+func (set *IPSet) parseEntry(addr string) (netip.Prefix, error) {
+	if err := validateRuleAddress(addr); err != nil {
+		return netip.Prefix{}, err
+	}
+	prefix, err := parsePrefix(addr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().BitLen() != familyBits(set.family()) {
+		return netip.Prefix{}, fmt.Errorf("not an %s address", set.Family)
+	}
+	if set.Type == IPSetTypeHash && !prefix.IsSingleIP() {
+		return netip.Prefix{}, fmt.Errorf("hash sets hold host addresses only")
+	}
+	return prefix, nil
+}
+
+func familyBits(family int) int {
+	if family == familyIPv6 {
+		return 128
+	}
+	return 32
+}
+
+// CreateIPSet creates an empty IP set and its data plane map, returning the
+// ID rules reference it by
+func (s *Server) CreateIPSet(ctx context.Context, req *pb.CreateIPSetRequest) (*pb.IPSetResponse, error) {
+	if req.GetSet() == nil {
+		return &pb.IPSetResponse{Success: false, Message: "IP set is required"}, nil
+	}
+
+	set := &IPSet{
+		Name:        req.Set.Name,
+		Type:        req.Set.Type,
+		Family:      req.Set.Family,
+		MaxEntries:  int(req.Set.MaxEntries),
+		Description: req.Set.Description,
+		Entries:     make(map[netip.Prefix]bool),
+	}
+	if set.MaxEntries == 0 {
+		set.MaxEntries = DefaultIPSetMaxEntries
+	}
+	if err := validateIPSet(set); err != nil {
+		return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("IP set validation failed: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, existing := range s.ipSets {
+		if existing.Name == set.Name {
+			return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("IP set %s already exists with ID %d", set.Name, existing.ID)}, nil
+		}
+	}
+	if set.ID = s.freeIPSetID(); set.ID == 0 {
+		return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("IP set limit reached (%d sets)", MaxIPSets-1)}, nil
+	}
+
+	if s.bpfManager != nil {
+		if err := s.bpfManager.CreateIPSet(set); err != nil {
+			return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("Failed to create IP set in data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	s.ipSets[set.ID] = set
+	s.persistPolicy()
+	log.Printf("Created IP set %d: %s (%s, %s, up to %d entries)", set.ID, set.Name, set.Type, set.Family, set.MaxEntries)
+
+	return &pb.IPSetResponse{Success: true, Message: "IP set created successfully", Set: ipSetToProto(set)}, nil
+}
+
+// AddToIPSet adds addresses to a set. Either every address is valid and
+// fits and the new ones are added, or the set is left unchanged.
+func (s *Server) AddToIPSet(ctx context.Context, req *pb.IPSetEntriesRequest) (*pb.IPSetResponse, error) {
+	return s.updateIPSet(req, true)
+}
+
+// RemoveFromIPSet removes addresses from a set; addresses not in it are
+// skipped
+func (s *Server) RemoveFromIPSet(ctx context.Context, req *pb.IPSetEntriesRequest) (*pb.IPSetResponse, error) {
+	return s.updateIPSet(req, false)
+}
+
+func (s *Server) updateIPSet(req *pb.IPSetEntriesRequest, add bool) (*pb.IPSetResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set, exists := s.ipSets[req.SetId]
+	if !exists {
+		return &pb.IPSetResponse{Success: false, Message: "IP set not found"}, nil
+	}
+	if set.Profile != "" {
+		return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("IP set is maintained by protection profile %s", set.Profile)}, nil
+	}
+	if set.Feed != "" {
+		return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("IP set is maintained by feed %s", set.Feed)}, nil
+	}
+
+	var changed []netip.Prefix
+	seen := make(map[netip.Prefix]bool)
+	for _, addr := range req.Addresses {
+		prefix, err := set.parseEntry(addr)
+		if err != nil {
+			return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("Invalid address %q: %v", addr, err)}, nil
+		}
+		if !seen[prefix] && set.Entries[prefix] != add {
+			changed = append(changed, prefix)
+		}
+		seen[prefix] = true
+	}
+	if add && len(set.Entries)+len(changed) > set.MaxEntries {
+		return &pb.IPSetResponse{
+			Success: false,
+			Message: fmt.Sprintf("IP set %s would hold %d entries, its limit is %d", set.Name, len(set.Entries)+len(changed), set.MaxEntries),
+		}, nil
+	}
+
+	if s.bpfManager != nil && len(changed) > 0 {
+		var err error
+		if add {
+			err = s.bpfManager.UpdateIPSet(set, changed, nil)
+		} else {
+			err = s.bpfManager.UpdateIPSet(set, nil, changed)
+		}
+		if err != nil {
+			return &pb.IPSetResponse{Success: false, Message: fmt.Sprintf("Failed to update IP set in data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	for _, prefix := range changed {
+		if add {
+			set.Entries[prefix] = true
+		} else {
+			delete(set.Entries, prefix)
+		}
+	}
+	if len(changed) > 0 {
+		s.persistPolicy()
+	}
+
+	verb := "Added"
+	if !add {
+		verb = "Removed"
+	}
+	log.Printf("%s %d entries in IP set %d: %s (%d entries)", verb, len(changed), set.ID, set.Name, len(set.Entries))
+	return &pb.IPSetResponse{
+		Success: true,
+		Message: fmt.Sprintf("%s %d entries", verb, len(changed)),
+		Set:     ipSetToProto(set),
+		Changed: uint32(len(changed)),
+	}, nil
+}
+
+// DeleteIPSet removes a set and its data plane map unless rules still
+// reference it
+func (s *Server) DeleteIPSet(ctx context.Context, req *pb.DeleteIPSetRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	set, exists := s.ipSets[req.SetId]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "IP set not found"}, nil
+	}
+	if set.Profile != "" {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("IP set is maintained by protection profile %s", set.Profile)}, nil
+	}
+	if set.Feed != "" {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("IP set is maintained by feed %s", set.Feed)}, nil
+	}
+	if users := s.rulesUsingIPSet(set.ID); len(users) > 0 {
+		refs := make([]string, len(users))
+		for i, rule := range users {
+			refs[i] = "rule " + rule.ID
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("IP set is referenced by: %s", strings.Join(refs, ", ")),
+		}, nil
+	}
+
+	if s.bpfManager != nil {
+		if err := s.bpfManager.DeleteIPSet(set.ID); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to delete IP set from data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	delete(s.ipSets, set.ID)
+	s.persistPolicy()
+	log.Printf("Deleted IP set %d: %s", set.ID, set.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "IP set deleted successfully"}, nil
+}
+
+// ListIPSets returns all IP sets by ID, without their entries
+func (s *Server) ListIPSets(ctx context.Context, req *pb.Empty) (*pb.IPSetsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.IPSetsResponse{}
+	for _, id := range s.sortedIPSetIDs() {
+		resp.Sets = append(resp.Sets, ipSetToProto(s.ipSets[id]))
+	}
+	return resp, nil
+}
+
+// freeIPSetID returns the lowest unused set ID, 0 when every ID is in use.
+// Caller must hold s.mutex.
+func (s *Server) freeIPSetID() uint32 {
+	for id := uint32(1); id < MaxIPSets; id++ {
+		if _, used := s.ipSets[id]; !used {
+			return id
+		}
+	}
+	return 0
+}
+
+// sortedIPSetIDs returns set IDs in ascending order. Caller must hold
+// s.mutex.
+func (s *Server) sortedIPSetIDs() []uint32 {
+	ids := make([]uint32, 0, len(s.ipSets))
+	for id := range s.ipSets {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// rulesUsingIPSet lists rules referencing a set, ordered by ID. Caller
+// must hold s.mutex.
+func (s *Server) rulesUsingIPSet(id uint32) []*FirewallRule {
+	var users []*FirewallRule
+	for _, rule := range s.rules {
+		if rule.SrcSet == id || rule.DstSet == id {
+			users = append(users, rule)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+// ipSetsContaining returns the IDs of the sets an address is in. Caller
+// must hold s.mutex.
+func (s *Server) ipSetsContaining(addr netip.Addr) map[uint32]bool {
+	containing := make(map[uint32]bool)
+	for id, set := range s.ipSets {
+		if set.contains(addr) {
+			containing[id] = true
+		}
+	}
+	return containing
+}
+
+// validateIPSetReference checks a rule's reference to a set for one side
+// of the rule, adding problems to errs. Caller must hold s.mutex.
+func (s *Server) validateIPSetReference(rule *FirewallRule, field string, id uint32, addr string, errs *ruleValidationError) {
+	if id == 0 {
+		return
+	}
+	set, exists := s.ipSets[id]
+	if !exists {
+		errs.add(field, "unknown IP set: %d", id)
+		return
+	}
+	if set.Profile != "" {
+		errs.add(field, "IP set %s is maintained by protection profile %s", set.Name, set.Profile)
+	}
+	if family := addressFamily(addr); family != familyAny && family != set.family() {
+		errs.add(field, "IP set %s holds %s addresses", set.Name, set.Family)
+	}
+	if rule.Namespace != "" || rule.VF != "" {
+		errs.add(field, "IP sets are only enforced by the host data plane")
+	}
+}
+
+func validateIPSet(set *IPSet) error {
+	if set.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if set.Type != IPSetTypeHash && set.Type != IPSetTypeLPM {
+		return fmt.Errorf("invalid type %q, expected %s or %s", set.Type, IPSetTypeHash, IPSetTypeLPM)
+	}
+	if set.Family != "ipv4" && set.Family != "ipv6" {
+		return fmt.Errorf("invalid family %q, expected ipv4 or ipv6", set.Family)
+	}
+	if set.MaxEntries < 1 || set.MaxEntries > MaxIPSetEntries {
+		return fmt.Errorf("max_entries must be between 1 and %d", MaxIPSetEntries)
+	}
+	return nil
+}
+
+func ipSetToProto(set *IPSet) *pb.IPSet {
+	return &pb.IPSet{
+		Id:          set.ID,
+		Name:        set.Name,
+		Type:        set.Type,
+		Family:      set.Family,
+		MaxEntries:  uint32(set.MaxEntries),
+		Description: set.Description,
+		Entries:     uint32(len(set.Entries)),
+		Profile:     set.Profile,
+		Feed:        set.Feed,
+	}
+}
+
+// ipSetMaps holds the pinned outer maps and the inner map of each set
+type ipSetMaps struct {
+	outer map[string]*ebpf.Map // By ipSetMapName
+	inner map[uint32]*ebpf.Map // By set ID
+}
+
+// ipSetMapName returns the outer map holding sets of a type and family
+func ipSetMapName(set *IPSet) string {
+	switch {
+	case set.Type == IPSetTypeHash && set.family() == familyIPv6:
+		return IPSetHash6MapName
+	case set.Type == IPSetTypeHash:
+		return IPSetHash4MapName
+	case set.family() == familyIPv6:
+		return IPSetLPM6MapName
+	default:
+		return IPSetLPM4MapName
+	}
+}
+
+// ipSetKey encodes an entry as a key of the set's inner map
+func ipSetKey(set *IPSet, prefix netip.Prefix) []byte {
+	if set.Type == IPSetTypeHash {
+		return prefix.Addr().AsSlice()
+	}
+	return lpmKey(prefix)
+}
+
+// openIPSets opens the pinned outer maps and clears sets left by a previous
+// control plane run; stored sets are re-created on restore
+func (bm *BPFMapManager) openIPSets() {
+	sets := &ipSetMaps{outer: make(map[string]*ebpf.Map), inner: make(map[uint32]*ebpf.Map)}
+	for _, name := range []string{IPSetHash4MapName, IPSetHash6MapName, IPSetLPM4MapName, IPSetLPM6MapName} {
+		path := filepath.Join(bm.pinPath, name)
+		outer, err := ebpf.LoadPinnedMap(path, nil)
+		if err == nil && outer.MaxEntries() != MaxIPSets {
+			err = fmt.Errorf("%d set IDs, the control plane expects %d", outer.MaxEntries(), MaxIPSets)
+			outer.Close()
+		}
+		if err != nil {
+			log.Printf("⚠️  IP sets not available at %s: %v", path, err)
+			sets.close()
+			return
+		}
+		sets.outer[name] = outer
+	}
+
+	for name, outer := range sets.outer {
+		for id := uint32(1); id < MaxIPSets; id++ {
+			if err := outer.Delete(&id); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				log.Printf("⚠️  Failed to clear IP set %d of %s: %v", id, name, err)
+			}
+		}
+	}
+	bm.ipSets = sets
+}
+
+// CreateIPSet creates the inner map of a set, fills it with the set's
+// entries and installs it under the set's ID
+func (bm *BPFMapManager) CreateIPSet(set *IPSet) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Creating IP set %d: %s (%d entries)", set.ID, set.Name, len(set.Entries))
+		return nil
+	}
+	if bm.ipSets == nil {
+		return fmt.Errorf("IP set maps not available")
+	}
+
+	spec := &ebpf.MapSpec{
+		Type:       ebpf.Hash,
+		KeySize:    uint32(familyBits(set.family()) / 8),
+		ValueSize:  1,
+		MaxEntries: uint32(set.MaxEntries),
+		Flags:      unix.BPF_F_NO_PREALLOC,
+	}
+	if set.Type == IPSetTypeLPM {
+		spec.Type = ebpf.LPMTrie
+		spec.KeySize += 4
+	}
+	inner, err := ebpf.NewMap(spec)
+	if err != nil {
+		return fmt.Errorf("failed to create map of IP set %s: %v", set.Name, err)
+	}
+	present := uint8(1)
+	for prefix := range set.Entries {
+		if err := inner.Put(ipSetKey(set, prefix), &present); err != nil {
+			inner.Close()
+			return fmt.Errorf("failed to add %s to IP set %s: %v", prefix, set.Name, err)
+		}
+	}
+	if err := bm.ipSets.outer[ipSetMapName(set)].Put(&set.ID, inner); err != nil {
+		inner.Close()
+		return fmt.Errorf("failed to install IP set %s: %v", set.Name, err)
+	}
+	// Recreating an installed set, e.g. to resize it, replaces its map
+	if previous := bm.ipSets.inner[set.ID]; previous != nil {
+		previous.Close()
+	}
+	bm.ipSets.inner[set.ID] = inner
+	return nil
+}
+
+// UpdateIPSet adds and removes entries of an installed set. An entry that
+// cannot be written undoes the ones written before it.
+func (bm *BPFMapManager) UpdateIPSet(set *IPSet, add, remove []netip.Prefix) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Updating IP set %d: +%d -%d entries", set.ID, len(add), len(remove))
+		return nil
+	}
+	if bm.ipSets == nil || bm.ipSets.inner[set.ID] == nil {
+		return fmt.Errorf("IP set %d is not installed", set.ID)
+	}
+
+	inner := bm.ipSets.inner[set.ID]
+	present := uint8(1)
+	for i, prefix := range add {
+		if err := inner.Put(ipSetKey(set, prefix), &present); err != nil {
+			for _, added := range add[:i] {
+				inner.Delete(ipSetKey(set, added))
+			}
+			return fmt.Errorf("failed to add %s: %v", prefix, err)
+		}
+	}
+	for i, prefix := range remove {
+		if err := inner.Delete(ipSetKey(set, prefix)); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			for _, removed := range remove[:i] {
+				inner.Put(ipSetKey(set, removed), &present)
+			}
+			return fmt.Errorf("failed to remove %s: %v", prefix, err)
+		}
+	}
+	return nil
+}
+
+// DeleteIPSet uninstalls a set and releases its map
+func (bm *BPFMapManager) DeleteIPSet(id uint32) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Deleting IP set %d", id)
+		return nil
+	}
+	if bm.ipSets == nil {
+		return fmt.Errorf("IP set maps not available")
+	}
+
+	for name, outer := range bm.ipSets.outer {
+		if err := outer.Delete(&id); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to uninstall IP set %d from %s: %v", id, name, err)
+		}
+	}
+	if inner := bm.ipSets.inner[id]; inner != nil {
+		inner.Close()
+		delete(bm.ipSets.inner, id)
+	}
+	return nil
+}
+
+func (sets *ipSetMaps) close() {
+	for _, m := range sets.outer {
+		m.Close()
+	}
+	for _, m := range sets.inner {
+		m.Close()
+	}
+}
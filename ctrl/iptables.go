@@ -0,0 +1,584 @@
+// SPDX-License-Identifier: Apache-2.0
+// iptables migration: translates iptables-save filter rules and adds them through AddRule, reporting what has no equivalent
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Priority of the first translated rule when the request sets none
+const DefaultIPTablesPriority = 1000
+
+// iptablesChains are the built-in filter chains imported, in the order
+// their rules are laid out, with their direction
+var iptablesChains = []struct {
+	name      string
+	direction string
+}{
+	{"INPUT", "inbound"},
+	{"FORWARD", "inbound"},
+	{"OUTPUT", "outbound"},
+}
+
+// iptablesMatchModules are the -m modules whose options are understood
+var iptablesMatchModules = map[string]bool{
+	"tcp": true, "udp": true, "sctp": true, "icmp": true, "icmp6": true,
+	"multiport": true, "conntrack": true, "state": true, "comment": true,
+	"mac": true, "geoip": true,
+}
+
+// iptablesRule is one -A line of the filter table
+type iptablesRule struct {
+	line  int
+	chain string
+	args  []string
+}
+
+// iptablesTranslation is the outcome of translating a ruleset
+type iptablesTranslation struct {
+	rules   []*FirewallRule
+	origins []string // Chain and line of each rule
+	skipped []string // What could not be translated, and why
+}
+
+// translateIPTables converts the built-in chains of the filter table of an
+// iptables-save or ip6tables-save ruleset into rules. INPUT and FORWARD
+// rules are inbound and OUTPUT rules outbound; each direction is laid out
+// in chain order starting at basePriority, and a DROP policy becomes a
+// final catch-all rule.
+func translateIPTables(data []byte, ipv6 bool, basePriority int32) (*iptablesTranslation, error) {
+	family := familyIPv4
+	if ipv6 {
+		family = familyIPv6
+	}
+
+	table := ""
+	policies := make(map[string]string)
+	rulesByChain := make(map[string][]*iptablesRule)
+	var userChains []string
+	otherTables := make(map[string]int) // Table -> rules not imported
+	var tableOrder []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		// iptables-save -c prefixes rules with their [packets:bytes]
+		if strings.HasPrefix(line, "[") {
+			if end := strings.Index(line, "]"); end > 0 {
+				line = strings.TrimSpace(line[end+1:])
+			}
+		}
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "*"):
+			if table != "" {
+				return nil, fmt.Errorf("line %d: table %s starts before COMMIT of table %s", lineNumber, line[1:], table)
+			}
+			table = line[1:]
+			if table != "filter" {
+				tableOrder = append(tableOrder, table)
+			}
+		case line == "COMMIT":
+			if table == "" {
+				return nil, fmt.Errorf("line %d: COMMIT outside a table", lineNumber)
+			}
+			table = ""
+		case strings.HasPrefix(line, ":"):
+			fields := strings.Fields(line[1:])
+			if table == "" || len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: invalid chain declaration %q", lineNumber, line)
+			}
+			if table != "filter" {
+				continue
+			}
+			if fields[1] == "-" {
+				userChains = append(userChains, fields[0])
+			} else {
+				policies[fields[0]] = fields[1]
+			}
+		case strings.HasPrefix(line, "-A "):
+			if table == "" {
+				return nil, fmt.Errorf("line %d: rule outside a table", lineNumber)
+			}
+			args, err := splitIPTablesLine(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+			}
+			if len(args) < 2 {
+				return nil, fmt.Errorf("line %d: rule without a chain", lineNumber)
+			}
+			if table != "filter" {
+				otherTables[table]++
+				continue
+			}
+			rulesByChain[args[1]] = append(rulesByChain[args[1]], &iptablesRule{line: lineNumber, chain: args[1], args: args[2:]})
+		default:
+			return nil, fmt.Errorf("line %d: unexpected %q, expected the output of iptables-save", lineNumber, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ruleset: %v", err)
+	}
+	if table != "" {
+		return nil, fmt.Errorf("table %s has no COMMIT", table)
+	}
+
+	result := &iptablesTranslation{}
+	for _, name := range tableOrder {
+		if otherTables[name] > 0 {
+			result.skipped = append(result.skipped, fmt.Sprintf("%s table: %d rules not imported, only the filter table is", name, otherTables[name]))
+		}
+	}
+	for _, chain := range userChains {
+		if len(rulesByChain[chain]) > 0 {
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: user-defined chain not imported, rules jumping to it are not translated", chain))
+		}
+	}
+
+	priorities := make(map[string]int32) // Direction -> next priority
+	for _, chain := range iptablesChains {
+		if _, started := priorities[chain.direction]; !started {
+			priorities[chain.direction] = basePriority
+		}
+
+		chainRules := 0
+		for _, rule := range rulesByChain[chain.name] {
+			translated, err := translateIPTablesRule(rule, family, chain.direction, priorities[chain.direction], userChains)
+			if err != nil {
+				result.skipped = append(result.skipped, fmt.Sprintf("%s line %d: %v", rule.chain, rule.line, err))
+				continue
+			}
+			for _, entry := range translated {
+				result.rules = append(result.rules, entry)
+				result.origins = append(result.origins, fmt.Sprintf("%s line %d", rule.chain, rule.line))
+			}
+			chainRules++
+			priorities[chain.direction]++
+		}
+
+		if policies[chain.name] == "DROP" {
+			policy := &FirewallRule{
+				Action:      "drop",
+				Direction:   chain.direction,
+				Priority:    priorities[chain.direction],
+				Enabled:     true,
+				Description: fmt.Sprintf("iptables %s policy DROP", chain.name),
+				SrcIP:       "0.0.0.0/0",
+			}
+			if ipv6 {
+				policy.SrcIP = "::/0"
+			}
+			result.rules = append(result.rules, policy)
+			result.origins = append(result.origins, chain.name+" policy")
+			chainRules++
+			priorities[chain.direction]++
+		}
+		if chain.name == "FORWARD" && chainRules > 0 {
+			result.skipped = append(result.skipped, "FORWARD: imported as inbound, it also matches traffic to this host")
+		}
+	}
+	return result, nil
+}
+
+// translateIPTablesRule translates the options of one rule; multiport
+// lists expand into a rule per port or range
+func translateIPTablesRule(rule *iptablesRule, family int, direction string, priority int32, userChains []string) ([]*FirewallRule, error) {
+	base := FirewallRule{
+		Direction:   direction,
+		Priority:    priority,
+		Enabled:     true,
+		Description: fmt.Sprintf("iptables %s line %d", rule.chain, rule.line),
+	}
+	var sports, dports []nftPortRange
+
+	args := rule.args
+	// next consumes the value of the current option
+	i := 0
+	next := func() (string, error) {
+		if i+1 >= len(args) {
+			return "", fmt.Errorf("option %s needs a value", args[i])
+		}
+		i++
+		return args[i], nil
+	}
+	for ; i < len(args); i++ {
+		option := args[i]
+		if option == "!" {
+			return nil, fmt.Errorf("negated matches are not supported")
+		}
+		if !strings.HasPrefix(option, "-") {
+			return nil, fmt.Errorf("unexpected argument %q", option)
+		}
+		switch option {
+		case "-s", "--source", "-d", "--destination":
+			address, err := next()
+			if err != nil {
+				return nil, err
+			}
+			prefix, err := iptablesAddress(address, family)
+			if err != nil {
+				return nil, err
+			}
+			if option == "-s" || option == "--source" {
+				base.SrcIP = prefix
+			} else {
+				base.DstIP = prefix
+			}
+		case "-p", "--protocol":
+			name, err := next()
+			if err != nil {
+				return nil, err
+			}
+			switch strings.ToLower(name) {
+			case "tcp", "6":
+				base.Protocol = "tcp"
+			case "udp", "17":
+				base.Protocol = "udp"
+			case "icmp", "1", "ipv6-icmp", "icmpv6", "58":
+				base.Protocol = "icmp"
+			case "gre", "47":
+				base.Protocol = "gre"
+			case "ipencap", "ipip", "4":
+				base.Protocol = "ipip"
+			case "ipv6", "41":
+				base.Protocol = "ipv6"
+			case "igmp", "2":
+				base.Protocol = "igmp"
+			case "sctp", "132":
+				base.Protocol = "sctp"
+			case "all", "0":
+				base.Protocol = ""
+			default:
+				if validateProtocol(name) != nil {
+					return nil, fmt.Errorf("unsupported protocol %s", name)
+				}
+				base.Protocol = name
+			}
+		case "-m", "--match":
+			module, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if !iptablesMatchModules[module] {
+				return nil, fmt.Errorf("unsupported match module %s", module)
+			}
+		case "--sport", "--source-port", "--dport", "--destination-port",
+			"--sports", "--source-ports", "--dports", "--destination-ports":
+			list, err := next()
+			if err != nil {
+				return nil, err
+			}
+			ports, err := iptablesPorts(list)
+			if err != nil {
+				return nil, err
+			}
+			if strings.HasPrefix(option, "--s") {
+				sports = ports
+			} else {
+				dports = ports
+			}
+		case "--ctstate", "--state":
+			list, err := next()
+			if err != nil {
+				return nil, err
+			}
+			for _, state := range strings.Split(strings.ToLower(list), ",") {
+				if _, known := connStateBits[state]; !known {
+					return nil, fmt.Errorf("unsupported connection state %s", strings.ToUpper(state))
+				}
+				base.ConnState = append(base.ConnState, state)
+			}
+		case "--syn":
+			base.TCPFlags = "syn/fin,syn,rst,ack"
+		case "--tcp-flags":
+			mask, err := next()
+			if err != nil {
+				return nil, err
+			}
+			set, err := next()
+			if err != nil {
+				return nil, err
+			}
+			match, err := iptablesTCPFlags(mask, set)
+			if err != nil {
+				return nil, err
+			}
+			base.TCPFlags = match
+		case "--mac-source":
+			mac, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := parseMAC(mac); err != nil || mac == "" {
+				return nil, fmt.Errorf("invalid MAC address %s", mac)
+			}
+			base.SrcMAC = canonicalMAC(mac)
+		case "--src-cc", "--source-country", "--dst-cc", "--destination-country":
+			list, err := next()
+			if err != nil {
+				return nil, err
+			}
+			countries := canonicalCountries(strings.Split(list, ","))
+			for _, country := range countries {
+				if !validCountryCode(country) {
+					return nil, fmt.Errorf("invalid country code %s", country)
+				}
+			}
+			if option == "--src-cc" || option == "--source-country" {
+				base.SrcCountry = countries
+			} else {
+				base.DstCountry = countries
+			}
+		case "--comment":
+			comment, err := next()
+			if err != nil {
+				return nil, err
+			}
+			base.Description = comment
+		case "-j", "--jump":
+			target, err := next()
+			if err != nil {
+				return nil, err
+			}
+			switch target {
+			case "ACCEPT":
+				base.Action = "allow"
+			case "DROP":
+				base.Action = "drop"
+			case "REJECT":
+				base.Action = "reject"
+			default:
+				if containsString(userChains, target) {
+					return nil, fmt.Errorf("jump to user-defined chain %s is not translated", target)
+				}
+				return nil, fmt.Errorf("unsupported target %s", target)
+			}
+		case "--reject-with":
+			// The reply is chosen by protocol: a RST for TCP, else ICMP
+			// port unreachable
+			if _, err := next(); err != nil {
+				return nil, err
+			}
+		case "-i", "--in-interface", "-o", "--out-interface":
+			name, err := next()
+			if err != nil {
+				return nil, err
+			}
+			inbound := option == "-i" || option == "--in-interface"
+			if inbound != (direction == "inbound") {
+				return nil, fmt.Errorf("%s is not translated on %s chains", option, direction)
+			}
+			if strings.HasSuffix(name, "+") {
+				return nil, fmt.Errorf("interface wildcard %s is not supported", name)
+			}
+			if err := validateInterfaceName(name); err != nil {
+				return nil, err
+			}
+			base.Interfaces = []string{name}
+		default:
+			return nil, fmt.Errorf("unsupported option %s", option)
+		}
+	}
+
+	if base.Action == "" {
+		return nil, fmt.Errorf("no ACCEPT, DROP or REJECT target")
+	}
+	if (len(sports) > 0 || len(dports) > 0) && !protocolHasPorts(base.Protocol) {
+		return nil, fmt.Errorf("port match without -p tcp, -p udp or -p sctp")
+	}
+	if base.TCPFlags != "" && base.Protocol != "tcp" {
+		return nil, fmt.Errorf("TCP flags match without -p tcp")
+	}
+	if base.SrcIP == "" && base.DstIP == "" {
+		base.SrcIP = "0.0.0.0/0"
+		if family == familyIPv6 {
+			base.SrcIP = "::/0"
+		}
+	}
+
+	if len(sports) == 0 {
+		sports = []nftPortRange{{}}
+	}
+	if len(dports) == 0 {
+		dports = []nftPortRange{{}}
+	}
+	var rules []*FirewallRule
+	for _, sport := range sports {
+		for _, dport := range dports {
+			translated := base
+			translated.SrcPort, translated.SrcPortEnd = sport.start, sport.end
+			translated.DstPort, translated.DstPortEnd = dport.start, dport.end
+			rules = append(rules, &translated)
+		}
+	}
+	return rules, nil
+}
+
+// iptablesTCPFlags converts the mask and set flags of --tcp-flags, e.g.
+// "SYN,ACK SYN", to a TCP flags match
+func iptablesTCPFlags(mask, set string) (string, error) {
+	expand := func(list string) string {
+		switch strings.ToUpper(list) {
+		case "ALL":
+			return strings.Join(tcpFlagNames, ",")
+		case "NONE":
+			return ""
+		}
+		return list
+	}
+	mask, set = expand(mask), expand(set)
+	if mask == "" {
+		return "", nil // Mask NONE matches any flags
+	}
+	match := set + "/" + mask
+	flags, maskBits, err := parseTCPFlags(match)
+	if err != nil {
+		return "", fmt.Errorf("unsupported --tcp-flags %s %s: %v", mask, set, err)
+	}
+	return formatTCPFlags(flags, maskBits), nil
+}
+
+// iptablesAddress checks an address of the ruleset's family and returns
+// it as a prefix
+func iptablesAddress(address string, family int) (string, error) {
+	if strings.Contains(address, ",") {
+		return "", fmt.Errorf("address lists are not supported, iptables-save prints one rule per address")
+	}
+	prefix, err := parsePrefix(address)
+	if err != nil {
+		return "", fmt.Errorf("unsupported address %s", address)
+	}
+	if (family == familyIPv4) != prefix.Addr().Is4() {
+		return "", fmt.Errorf("address %s is not %s", address, familyName(family))
+	}
+	return prefix.String(), nil
+}
+
+// iptablesPorts parses a port, a first:last range or a multiport list of
+// both
+func iptablesPorts(list string) ([]nftPortRange, error) {
+	var ports []nftPortRange
+	for _, item := range strings.Split(list, ",") {
+		first, last, isRange := strings.Cut(item, ":")
+		start, err := strconv.Atoi(first)
+		if err != nil || start < 1 || start > 65535 {
+			return nil, fmt.Errorf("unsupported port %q, service names are not resolved", item)
+		}
+		port := nftPortRange{start: int32(start)}
+		if isRange {
+			end, err := strconv.Atoi(last)
+			if err != nil || end < start || end > 65535 {
+				return nil, fmt.Errorf("invalid port range %q", item)
+			}
+			port.end = int32(end)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// splitIPTablesLine splits a rule line into arguments, honouring the double
+// quotes and backslash escapes iptables-save writes around comments
+func splitIPTablesLine(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg, quoted, escaped := false, false, false
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quoted:
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// ImportIPTables translates an iptables-save ruleset and adds each rule
+// through AddRule, or only returns the translation on a dry run. Rules are
+// added one at a time and kept when a later one fails or the request is
+// interrupted; importing the same ruleset twice adds its rules twice.
+func (s *Server) ImportIPTables(ctx context.Context, req *pb.ImportIPTablesRequest) (*pb.ImportIPTablesResponse, error) {
+	basePriority := req.BasePriority
+	if basePriority == 0 {
+		basePriority = DefaultIPTablesPriority
+	}
+	translation, err := translateIPTables(req.Ruleset, req.Ipv6, basePriority)
+	if err != nil {
+		return &pb.ImportIPTablesResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	resp := &pb.ImportIPTablesResponse{Skipped: translation.skipped}
+	if req.DryRun {
+		for _, rule := range translation.rules {
+			resp.Rules = append(resp.Rules, toProtoRule(rule))
+		}
+		resp.Success = true
+		resp.Message = fmt.Sprintf("Translated %d rules, %d items skipped", len(translation.rules), len(translation.skipped))
+		return resp, nil
+	}
+
+	for i, rule := range translation.rules {
+		if err := checkpoint(ctx, "iptables import", i, len(translation.rules), "rules added and kept"); err != nil {
+			return nil, err
+		}
+		added, err := s.AddRule(ctx, &pb.AddRuleRequest{Rule: toProtoRule(rule)})
+		if err != nil {
+			return nil, err
+		}
+		if !added.Success {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("%s: %s", translation.origins[i], added.Message))
+			continue
+		}
+		if added.Duplicate {
+			resp.Skipped = append(resp.Skipped, fmt.Sprintf("%s: duplicate of rule %s", translation.origins[i], added.RuleId))
+			continue
+		}
+		s.mutex.RLock()
+		if stored, exists := s.rules[added.RuleId]; exists {
+			resp.Rules = append(resp.Rules, toProtoRule(stored))
+		}
+		s.mutex.RUnlock()
+		resp.Count++
+	}
+
+	s.mutex.RLock()
+	resp.Total = int32(len(s.rules))
+	resp.Version = s.compiledPolicy().Version
+	s.mutex.RUnlock()
+
+	resp.Success = len(resp.Errors) == 0
+	resp.Message = fmt.Sprintf("Added %d of %d rules, %d items skipped", resp.Count, len(translation.rules), len(translation.skipped))
+	log.Printf("Imported iptables ruleset: %d of %d rules added, %d rejected, %d items skipped",
+		resp.Count, len(translation.rules), len(resp.Errors), len(translation.skipped))
+	return resp, nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Link layer matches: source and destination MAC addresses and VLAN ID
+
+package main
+
+import (
+	"fmt"
+	"net"
+)
+
+// MaxVLANID is the highest VLAN ID a rule can match; 0 and 4095 are
+// reserved by 802.1Q
+const MaxVLANID = 4094
+
+// parseMAC parses a MAC address match, empty = any. The all-zero address
+// is how the data plane encodes any, so it cannot be matched.
+func parseMAC(match string) ([6]byte, error) {
+	var mac [6]byte
+	if match == "" {
+		return mac, nil
+	}
+	hw, err := net.ParseMAC(match)
+	if err != nil {
+		return mac, err
+	}
+	if len(hw) != len(mac) {
+		return mac, fmt.Errorf("not a 48-bit MAC address")
+	}
+	copy(mac[:], hw)
+	if mac == [6]byte{} {
+		return mac, fmt.Errorf("the all-zero address cannot be matched")
+	}
+	return mac, nil
+}
+
+// canonicalMAC writes a MAC address in lower case colon form, or returns
+// the input if it does not parse
+func canonicalMAC(match string) string {
+	mac, err := parseMAC(match)
+	if err != nil || match == "" {
+		return match
+	}
+	return net.HardwareAddr(mac[:]).String()
+}
+
+// l2Match mirrors the data plane check of a rule's link layer matches
+func l2Match(vlan uint16, srcMAC, dstMAC [6]byte, rule BPFFirewallRule) bool {
+	if rule.VLAN != 0 && rule.VLAN != vlan {
+		return false
+	}
+	return (rule.SrcMAC == [6]byte{} || rule.SrcMAC == srcMAC) &&
+		(rule.DstMAC == [6]byte{} || rule.DstMAC == dstMAC)
+}
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule labels: free-form key/value labels and operations on the rules a label selector picks
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Label keys and values are short identifiers, e.g. "pci", "tenant=acme"
+// or "team=net-ops"; a label with an empty value acts as a tag
+var (
+	labelKeyPattern   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]{0,62}[A-Za-z0-9])?$`)
+	labelValuePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]{0,62}[A-Za-z0-9])?)?$`)
+)
+
+// Label selector operators
+const (
+	labelOpEquals    = "="
+	labelOpNotEquals = "!="
+	labelOpExists    = "exists"
+	labelOpNotExists = "!exists"
+)
+
+// labelRequirement is one comma-separated term of a label selector
+type labelRequirement struct {
+	key   string
+	op    string
+	value string
+}
+
+// labelSelector matches rules whose labels meet every requirement
+type labelSelector []labelRequirement
+
+// parseLabelSelector parses "key=value", "key!=value", "key" and "!key"
+// terms joined by commas. An empty selector matches every rule.
+func parseLabelSelector(selector string) (labelSelector, error) {
+	var parsed labelSelector
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var req labelRequirement
+		switch {
+		case strings.HasPrefix(term, "!"):
+			req = labelRequirement{key: strings.TrimSpace(term[1:]), op: labelOpNotExists}
+		case strings.Contains(term, "!="):
+			key, value, _ := strings.Cut(term, "!=")
+			req = labelRequirement{key: strings.TrimSpace(key), op: labelOpNotEquals, value: strings.TrimSpace(value)}
+		case strings.Contains(term, "="):
+			key, value, _ := strings.Cut(term, "=")
+			req = labelRequirement{key: strings.TrimSpace(key), op: labelOpEquals, value: strings.TrimSpace(value)}
+		default:
+			req = labelRequirement{key: term, op: labelOpExists}
+		}
+		if !labelKeyPattern.MatchString(req.key) {
+			return nil, fmt.Errorf("invalid label key in selector term %q", term)
+		}
+		if !labelValuePattern.MatchString(req.value) {
+			return nil, fmt.Errorf("invalid label value in selector term %q", term)
+		}
+		parsed = append(parsed, req)
+	}
+	return parsed, nil
+}
+
+// matches reports whether labels meet every requirement of the selector
+func (sel labelSelector) matches(labels map[string]string) bool {
+	for _, req := range sel {
+		value, exists := labels[req.key]
+		switch req.op {
+		case labelOpEquals:
+			if !exists || value != req.value {
+				return false
+			}
+		case labelOpNotEquals:
+			if exists && value == req.value {
+				return false
+			}
+		case labelOpExists:
+			if !exists {
+				return false
+			}
+		case labelOpNotExists:
+			if exists {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// canonicalLabels writes labels as sorted key=value pairs, comma separated
+func canonicalLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// validateLabels checks label keys and values
+func validateLabels(labels map[string]string, errs *ruleValidationError) {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !labelKeyPattern.MatchString(key) {
+			errs.add("labels", "invalid label key: %q", key)
+		} else if !labelValuePattern.MatchString(labels[key]) {
+			errs.add("labels", "invalid value of label %s: %q", key, labels[key])
+		}
+	}
+}
+
+// selectRules returns the rules a selector matches in ID order. Caller
+// must hold s.mutex.
+func (s *Server) selectRules(selector labelSelector) []*FirewallRule {
+	var selected []*FirewallRule
+	for _, rule := range s.rules {
+		if selector.matches(rule.Labels) {
+			selected = append(selected, rule)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].ID < selected[j].ID })
+	return selected
+}
+
+// requiredLabelSelector parses the selector of a bulk operation, which must
+// not be empty so that a missing selector never acts on every rule
+func requiredLabelSelector(selector string) (labelSelector, error) {
+	parsed, err := parseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("a label selector is required")
+	}
+	return parsed, nil
+}
+
+// DeleteRulesByLabel deletes every rule the selector matches in one
+// hit-less data plane update
+func (s *Server) DeleteRulesByLabel(ctx context.Context, req *pb.RulesByLabelRequest) (*pb.RulesByLabelResponse, error) {
+	return s.changeRulesByLabel(ctx, req.Selector, "deleted", func(rule *FirewallRule) *FirewallRule {
+		return nil
+	})
+}
+
+// DisableRulesByLabel disables every enabled rule the selector matches in
+// one hit-less data plane update
+func (s *Server) DisableRulesByLabel(ctx context.Context, req *pb.RulesByLabelRequest) (*pb.RulesByLabelResponse, error) {
+	return s.changeRulesByLabel(ctx, req.Selector, "disabled", func(rule *FirewallRule) *FirewallRule {
+		if !rule.Enabled {
+			return rule
+		}
+		updated := *rule
+		updated.Enabled = false
+		return &updated
+	})
+}
+
+// EnableRulesByLabel enables every disabled rule the selector matches in
+// one hit-less data plane update
+func (s *Server) EnableRulesByLabel(ctx context.Context, req *pb.RulesByLabelRequest) (*pb.RulesByLabelResponse, error) {
+	return s.changeRulesByLabel(ctx, req.Selector, "enabled", func(rule *FirewallRule) *FirewallRule {
+		if rule.Enabled {
+			return rule
+		}
+		updated := *rule
+		updated.Enabled = true
+		return &updated
+	})
+}
+
+// changeRulesByLabel replaces every rule the selector matches with the
+// result of change: nil deletes the rule, the rule itself leaves it as is.
+// All changes are enforced as one policy version.
+func (s *Server) changeRulesByLabel(ctx context.Context, selector, verb string, change func(*FirewallRule) *FirewallRule) (*pb.RulesByLabelResponse, error) {
+	parsed, err := requiredLabelSelector(selector)
+	if err != nil {
+		return &pb.RulesByLabelResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.clock.Now()
+	rules := make(map[string]*FirewallRule, len(s.rules))
+	for id, rule := range s.rules {
+		rules[id] = rule
+	}
+	var changed []*FirewallRule
+	var deleted bool
+	for _, rule := range s.selectRules(parsed) {
+		// Baseline rules change only on the global policy service
+		if federatedRule(rule) {
+			continue
+		}
+		updated := change(rule)
+		switch {
+		case updated == nil:
+			delete(rules, rule.ID)
+			changed = append(changed, rule)
+			deleted = true
+		case updated != rule:
+			updated.UpdatedAt = now
+			rules[rule.ID] = updated
+			changed = append(changed, updated)
+		}
+	}
+	if len(changed) == 0 {
+		return &pb.RulesByLabelResponse{
+			Success: true,
+			Message: fmt.Sprintf("No rules matching %s changed", selector),
+			Version: s.compiledPolicy().Version,
+		}, nil
+	}
+
+	previous := s.rules
+	s.rules = rules
+	if err := s.replacePolicy(ctx); err != nil {
+		s.rules = previous
+		if interrupted, ok := asInterrupted(err); ok {
+			return nil, interrupted
+		}
+		return &pb.RulesByLabelResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to update rules in data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+	eventType := EventRuleUpdated
+	if deleted {
+		eventType = EventRuleDeleted
+	}
+	ids := make([]string, 0, len(changed))
+	for _, rule := range changed {
+		s.events.Publish(ruleEvent(eventType, rule))
+		ids = append(ids, rule.ID)
+	}
+	version := s.compiledPolicy().Version
+	log.Printf("Rules %s by label %s: %d, policy version %d", verb, selector, len(changed), version)
+
+	return &pb.RulesByLabelResponse{
+		Success: true,
+		Message: fmt.Sprintf("Rules %s successfully", verb),
+		Count:   int32(len(changed)),
+		RuleIds: ids,
+		Version: version,
+	}, nil
+}
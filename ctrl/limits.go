@@ -0,0 +1,394 @@
+// SPDX-License-Identifier: Apache-2.0
+// Control plane self-limits: memory, goroutines, file descriptors, rules,
+// API requests and event subscribers, refused with a typed error when
+// exhausted rather than letting the host OOM-kill the control plane
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limited resources
+const (
+	ResourceMemory      = "memory"
+	ResourceGoroutines  = "goroutines"
+	ResourceOpenFiles   = "open_files"
+	ResourceRules       = "rules"
+	ResourceRequests    = "requests"
+	ResourceSubscribers = "subscribers"
+)
+
+// Self-limit defaults, overridden with CERBERUS_LIMIT_*. Memory is not
+// limited by default; open files default to 90% of RLIMIT_NOFILE.
+const (
+	DefaultMaxGoroutines  = 10000
+	DefaultMaxRules       = 100000
+	DefaultMaxRequests    = 256
+	DefaultMaxSubscribers = 64
+
+	// How often memory, goroutines and open files are measured; work is
+	// admitted against the last measurement in between
+	resourceSampleInterval = time.Second
+)
+
+// ResourceLimits caps the control plane's own resource use. A zero limit
+// is unlimited, except for the queue sizes.
+type ResourceLimits struct {
+	MaxMemory      uint64 // Bytes held by the Go runtime; the GC works to stay under 90% of it
+	MaxGoroutines  int
+	MaxOpenFiles   int
+	MaxRules       int // Rules in the policy
+	MaxRequests    int // Concurrent unary gRPC and REST requests
+	MaxSubscribers int // Concurrent event streams, each a goroutine and a queue
+
+	EventQueue  int // Events buffered per subscriber before new ones are discarded
+	RecentDrops int // Sampled drops kept for ExplainVerdict
+}
+
+// resourceLimitsFromEnv reads the self-limits from CERBERUS_LIMIT_*
+func resourceLimitsFromEnv() (ResourceLimits, error) {
+	limits := ResourceLimits{
+		MaxGoroutines:  DefaultMaxGoroutines,
+		MaxOpenFiles:   defaultMaxOpenFiles(),
+		MaxRules:       DefaultMaxRules,
+		MaxRequests:    DefaultMaxRequests,
+		MaxSubscribers: DefaultMaxSubscribers,
+		EventQueue:     eventBufferSize,
+		RecentDrops:    DefaultRecentDrops,
+	}
+
+	if raw := os.Getenv("CERBERUS_LIMIT_MEMORY"); raw != "" {
+		parsed, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return limits, fmt.Errorf("invalid CERBERUS_LIMIT_MEMORY %q, expected bytes, 0 = unlimited", raw)
+		}
+		limits.MaxMemory = parsed
+	}
+	for name, value := range map[string]*int{
+		"CERBERUS_LIMIT_GOROUTINES":  &limits.MaxGoroutines,
+		"CERBERUS_LIMIT_OPEN_FILES":  &limits.MaxOpenFiles,
+		"CERBERUS_LIMIT_RULES":       &limits.MaxRules,
+		"CERBERUS_LIMIT_REQUESTS":    &limits.MaxRequests,
+		"CERBERUS_LIMIT_SUBSCRIBERS": &limits.MaxSubscribers,
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed < 0 {
+			return limits, fmt.Errorf("invalid %s %q, expected a count, 0 = unlimited", name, raw)
+		}
+		*value = int(parsed)
+	}
+	for name, value := range map[string]*int{
+		"CERBERUS_LIMIT_EVENT_QUEUE":  &limits.EventQueue,
+		"CERBERUS_LIMIT_RECENT_DROPS": &limits.RecentDrops,
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed <= 0 {
+			return limits, fmt.Errorf("invalid %s %q, expected a positive count", name, raw)
+		}
+		*value = int(parsed)
+	}
+	return limits, nil
+}
+
+// defaultMaxOpenFiles leaves a tenth of RLIMIT_NOFILE for the descriptors
+// the control plane needs to recover, unlimited if it cannot be read
+func defaultMaxOpenFiles() int {
+	var rlimit unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_NOFILE, &rlimit); err != nil || rlimit.Cur == unix.RLIM_INFINITY {
+		return 0
+	}
+	return int(rlimit.Cur / 10 * 9)
+}
+
+// logSummary logs the effective limits at startup
+func (l ResourceLimits) logSummary() {
+	show := func(limit uint64) string {
+		if limit == 0 {
+			return "unlimited"
+		}
+		return strconv.FormatUint(limit, 10)
+	}
+	log.Printf("Self-limits: memory %s bytes, %s goroutines, %s open files, %s rules, %s requests, %s subscribers (queue %d events)",
+		show(l.MaxMemory), show(uint64(l.MaxGoroutines)), show(uint64(l.MaxOpenFiles)), show(uint64(l.MaxRules)),
+		show(uint64(l.MaxRequests)), show(uint64(l.MaxSubscribers)), l.EventQueue)
+}
+
+// resourceLimitError refuses work that would take a resource past its
+// limit
+type resourceLimitError struct {
+	resource string
+	used     uint64
+	limit    uint64
+}
+
+func (e *resourceLimitError) Error() string {
+	if e.resource == ResourceRules {
+		return fmt.Sprintf("rule limit reached: %d rules, limit %d", e.used, e.limit)
+	}
+	return fmt.Sprintf("control plane %s limit reached (%d of %d), try again later", strings.ReplaceAll(e.resource, "_", " "), e.used, e.limit)
+}
+
+// GRPCStatus makes gRPC handlers returning the error answer with
+// ResourceExhausted
+func (e *resourceLimitError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+// asResourceLimit returns the limit an error reports, if any
+func asResourceLimit(err error) (*resourceLimitError, bool) {
+	var limited *resourceLimitError
+	return limited, errors.As(err, &limited)
+}
+
+// writeResourceLimit answers a refused REST request: 507 when the rule
+// set is full, else 503 with a retry hint
+func writeResourceLimit(w http.ResponseWriter, err *resourceLimitError) {
+	code := http.StatusInsufficientStorage
+	if err.resource != ResourceRules {
+		code = http.StatusServiceUnavailable
+		w.Header().Set("Retry-After", "1")
+	}
+	http.Error(w, withRemediation(err).Error(), code)
+}
+
+// writeRefusal answers a REST request whose operation was interrupted or
+// refused, reporting whether it did
+func writeRefusal(w http.ResponseWriter, err error) bool {
+	setErrorCode(w, err)
+	if interrupted, ok := asInterrupted(err); ok {
+		writeInterrupted(w, interrupted)
+		return true
+	}
+	if limited, ok := asResourceLimit(err); ok {
+		writeResourceLimit(w, limited)
+		return true
+	}
+	return false
+}
+
+// ResourceLimiter admits work against the self-limits. Methods are safe
+// to call on a nil limiter, which admits everything.
+type ResourceLimiter struct {
+	limits ResourceLimits
+	clock  Clock
+
+	mutex       sync.Mutex
+	requests    int
+	subscribers int
+	refusals    map[string]uint64
+
+	// Last measurement of the process
+	sampled    time.Time
+	memory     uint64
+	goroutines int
+	openFiles  int
+}
+
+// ResourceUsage is the use of one limited resource
+type ResourceUsage struct {
+	Resource string
+	Used     uint64
+	Limit    uint64 // 0 = unlimited
+	Refused  uint64 // Work refused at the limit since start
+}
+
+// NewResourceLimiter creates a limiter and sets the GC soft limit to 90%
+// of the memory limit, so the collector works harder before work is
+// refused
+func NewResourceLimiter(limits ResourceLimits, clock Clock) *ResourceLimiter {
+	if limits.MaxMemory > 0 {
+		debug.SetMemoryLimit(int64(limits.MaxMemory / 10 * 9))
+	}
+	return &ResourceLimiter{limits: limits, clock: clock, refusals: make(map[string]uint64)}
+}
+
+// measure refreshes the process measurement once it is older than the
+// sample interval. Caller must hold l.mutex.
+func (l *ResourceLimiter) measure() {
+	now := l.clock.Now()
+	if now.Sub(l.sampled) < resourceSampleInterval {
+		return
+	}
+	l.sampled = now
+
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+	if samples[0].Value.Kind() == metrics.KindUint64 && samples[1].Value.Kind() == metrics.KindUint64 {
+		l.memory = samples[0].Value.Uint64() - samples[1].Value.Uint64()
+	}
+	l.goroutines = runtime.NumGoroutine()
+	if entries, err := os.ReadDir("/proc/self/fd"); err == nil {
+		l.openFiles = len(entries)
+	}
+}
+
+// refuse counts and returns a refusal. Caller must hold l.mutex.
+func (l *ResourceLimiter) refuse(resource string, used, limit uint64) error {
+	l.refusals[resource]++
+	return &resourceLimitError{resource: resource, used: used, limit: limit}
+}
+
+// checkPressure refuses new work while memory, goroutines or open files
+// are at their limit
+func (l *ResourceLimiter) checkPressure() error {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.measure()
+	switch {
+	case l.limits.MaxMemory > 0 && l.memory >= l.limits.MaxMemory:
+		return l.refuse(ResourceMemory, l.memory, l.limits.MaxMemory)
+	case l.limits.MaxGoroutines > 0 && l.goroutines >= l.limits.MaxGoroutines:
+		return l.refuse(ResourceGoroutines, uint64(l.goroutines), uint64(l.limits.MaxGoroutines))
+	case l.limits.MaxOpenFiles > 0 && l.openFiles >= l.limits.MaxOpenFiles:
+		return l.refuse(ResourceOpenFiles, uint64(l.openFiles), uint64(l.limits.MaxOpenFiles))
+	}
+	return nil
+}
+
+// acquire takes a request or subscriber slot; the release function
+// returns it
+func (l *ResourceLimiter) acquire(resource string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	counter, limit := &l.requests, l.limits.MaxRequests
+	if resource == ResourceSubscribers {
+		counter, limit = &l.subscribers, l.limits.MaxSubscribers
+	}
+	if limit > 0 && *counter >= limit {
+		return nil, l.refuse(resource, uint64(*counter), uint64(limit))
+	}
+	*counter++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mutex.Lock()
+			*counter--
+			l.mutex.Unlock()
+		})
+	}, nil
+}
+
+// checkRules refuses a policy of more than the rule limit
+func (l *ResourceLimiter) checkRules(total int) error {
+	if l == nil || l.limits.MaxRules == 0 || total <= l.limits.MaxRules {
+		return nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.refuse(ResourceRules, uint64(total), uint64(l.limits.MaxRules))
+}
+
+// Usage returns the use of every limited resource, given the rules in the
+// policy
+func (l *ResourceLimiter) Usage(rules int) []ResourceUsage {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.measure()
+	return []ResourceUsage{
+		{ResourceMemory, l.memory, l.limits.MaxMemory, l.refusals[ResourceMemory]},
+		{ResourceGoroutines, uint64(l.goroutines), uint64(l.limits.MaxGoroutines), l.refusals[ResourceGoroutines]},
+		{ResourceOpenFiles, uint64(l.openFiles), uint64(l.limits.MaxOpenFiles), l.refusals[ResourceOpenFiles]},
+		{ResourceRules, uint64(rules), uint64(l.limits.MaxRules), l.refusals[ResourceRules]},
+		{ResourceRequests, uint64(l.requests), uint64(l.limits.MaxRequests), l.refusals[ResourceRequests]},
+		{ResourceSubscribers, uint64(l.subscribers), uint64(l.limits.MaxSubscribers), l.refusals[ResourceSubscribers]},
+	}
+}
+
+// limitExempt reports whether a gRPC method or REST path frees resources
+// or must answer under load, and so is admitted past the limits
+func limitExempt(name string) bool {
+	method := name[strings.LastIndex(name, "/")+1:]
+	return strings.HasPrefix(method, "Delete") || strings.HasPrefix(method, "Kill") ||
+		strings.HasPrefix(method, "Remove") || name == "/health"
+}
+
+// unaryInterceptor admits unary gRPC calls against the limits
+func (l *ResourceLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if limitExempt(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := l.checkPressure(); err != nil {
+		return nil, err
+	}
+	release, err := l.acquire(ResourceRequests)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// streamInterceptor admits streaming gRPC calls against the process
+// limits; event streams take a subscriber slot in their handler
+func (l *ResourceLimiter) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.checkPressure(); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// limitREST admits REST requests against the limits; the event stream
+// takes a subscriber slot instead of a request slot
+func (l *ResourceLimiter) limitREST(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limitExempt(r.URL.Path) || r.Method == http.MethodDelete {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := l.checkPressure(); err != nil {
+			writeRefusal(w, err)
+			return
+		}
+		if r.URL.Path != "/events" {
+			release, err := l.acquire(ResourceRequests)
+			if err != nil {
+				writeRefusal(w, err)
+				return
+			}
+			defer release()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -6,48 +6,235 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
-	gRPCPort = ":50051"
-	Version  = "1.0.0"
+	gRPCPort    = ":50051"
+	restPort    = ":50052"
+	metricsPort = 8080
+	Version     = "1.0.0"
 )
 
 // FirewallRule represents a firewall rule
 type FirewallRule struct {
-	ID          string    `json:"id"`
-	Action      string    `json:"action"`      // allow, drop, redirect
-	SrcIP       string    `json:"src_ip"`      // CIDR notation
-	DstIP       string    `json:"dst_ip"`      // CIDR notation
-	SrcPort     int32     `json:"src_port"`    // 0 = any
-	DstPort     int32     `json:"dst_port"`    // 0 = any
-	Protocol    string    `json:"protocol"`    // tcp, udp, icmp, any
-	Direction   string    `json:"direction"`   // inbound, outbound, both
-	Priority    int32     `json:"priority"`    // Lower number = higher priority
-	Enabled     bool      `json:"enabled"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string            `json:"id" yaml:"id,omitempty"`
+	Action      string            `json:"action" yaml:"action,omitempty"`             // allow, drop, redirect, reject, mirror
+	SrcIP       string            `json:"src_ip" yaml:"src_ip,omitempty"`             // IPv4 or IPv6 CIDR notation
+	DstIP       string            `json:"dst_ip" yaml:"dst_ip,omitempty"`             // Same family as SrcIP
+	SrcPort     int32             `json:"src_port" yaml:"src_port,omitempty"`         // 0 = any
+	SrcPortEnd  int32             `json:"src_port_end" yaml:"src_port_end,omitempty"` // Inclusive range end, 0 = single port
+	DstPort     int32             `json:"dst_port" yaml:"dst_port,omitempty"`         // 0 = any
+	Protocol    string            `json:"protocol" yaml:"protocol,omitempty"`         // tcp, udp, sctp, icmp, igmp, gre, ipip, ipv6, any or a number
+	Direction   string            `json:"direction" yaml:"direction,omitempty"`       // inbound, outbound, both
+	Priority    int32             `json:"priority" yaml:"priority,omitempty"`         // Lower number = higher priority
+	Enabled     bool              `json:"enabled" yaml:"enabled,omitempty"`
+	Description string            `json:"description" yaml:"description,omitempty"`
+	Owner       string            `json:"owner" yaml:"owner,omitempty"`               // Team or person responsible
+	Service     string            `json:"service" yaml:"service,omitempty"`           // Named service object, replaces Protocol/DstPort
+	DstPortEnd  int32             `json:"dst_port_end" yaml:"dst_port_end,omitempty"` // Inclusive range end, 0 = single port
+	SrcAddress  string            `json:"src_address" yaml:"src_address,omitempty"`   // Named address object, replaces SrcIP
+	DstAddress  string            `json:"dst_address" yaml:"dst_address,omitempty"`   // Named address object, replaces DstIP
+	SrcSet      uint32            `json:"src_set" yaml:"src_set,omitempty"`           // IP set the source must be in, 0 = none
+	DstSet      uint32            `json:"dst_set" yaml:"dst_set,omitempty"`           // IP set the destination must be in, 0 = none
+	Namespace   string            `json:"namespace" yaml:"namespace,omitempty"`       // Network namespace enforcing the rule, empty = host
+	VF          string            `json:"vf" yaml:"vf,omitempty"`                     // VF data plane enforcing the rule, e.g. enp3s0f0/vf3
+	ConnState   []string          `json:"conn_state" yaml:"conn_state,omitempty"`     // new, established, related; empty = any
+	TCPFlags    string            `json:"tcp_flags" yaml:"tcp_flags,omitempty"`       // Flags/mask, e.g. syn/syn,ack for a bare SYN; empty = any
+	SrcMAC      string            `json:"src_mac" yaml:"src_mac,omitempty"`           // Source MAC address, empty = any
+	DstMAC      string            `json:"dst_mac" yaml:"dst_mac,omitempty"`           // Destination MAC address, empty = any
+	VLAN        int32             `json:"vlan" yaml:"vlan,omitempty"`                 // VLAN ID of the outer 802.1Q tag, 0 = any
+	Interfaces  []string          `json:"interfaces" yaml:"interfaces,omitempty"`     // Attached interfaces the rule applies on, empty = all
+	SrcCountry  []string          `json:"src_country" yaml:"src_country,omitempty"`   // ISO 3166 codes of the source per the GeoIP database, empty = any
+	DstCountry  []string          `json:"dst_country" yaml:"dst_country,omitempty"`   // ISO 3166 codes of the destination, empty = any
+	SrcASN      []uint32          `json:"src_asn" yaml:"src_asn,omitempty"`           // Origin ASNs of the source per the ASN database, empty = any
+	DstASN      []uint32          `json:"dst_asn" yaml:"dst_asn,omitempty"`           // Origin ASNs of the destination, empty = any
+	Log         bool              `json:"log" yaml:"log,omitempty"`                   // Matched packets are published as RULE_LOG events
+	MirrorTo    string            `json:"mirror_to" yaml:"mirror_to,omitempty"`       // Interface mirror rules copy packets to
+	ExpiresAt   time.Time         `json:"expires_at" yaml:"expires_at,omitempty"`     // Removed by the reaper at this time, zero = never
+	Labels      map[string]string `json:"labels" yaml:"labels,omitempty"`             // Free-form labels, e.g. tenant=acme; a tag has an empty value
+	CreatedAt   time.Time         `json:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   time.Time         `json:"updated_at" yaml:"updated_at,omitempty"`
 }
 
 // Server implements the gRPC firewall control service
 type Server struct {
-	UnimplementedFirewallControlServer
+	pb.UnimplementedFirewallControlServer
 	rules      map[string]*FirewallRule
 	stats      *FirewallStats
 	mutex      sync.RWMutex
 	vppClient  *VPPClient
 	bpfClient  *BPFClient
 	bpfManager *BPFMapManager
+
+	// Zone-based policy (see zones.go)
+	zones        map[string]*Zone
+	zonePolicies map[string]*ZonePolicy
+
+	// Named service objects (see services.go)
+	services map[string]*Service
+
+	// Named address objects and groups (see addresses.go)
+	addressObjects map[string]*AddressObject
+
+	// IP sets by ID (see ipsets.go)
+	ipSets map[uint32]*IPSet
+
+	// Threat intelligence feeds by name, maintaining IP sets (see feeds.go)
+	feeds map[string]*Feed
+
+	// Policy federation role, nil = none (see federation.go)
+	federation *federation
+
+	// Anonymization of exported events and flows (see anonymize.go)
+	anonymizer *Anonymizer
+
+	// Default policies by interface, "" = all (see default_policy.go)
+	defaultPolicies map[string]*DefaultPolicy
+
+	// Service protection profiles and the destinations they are attached
+	// to (see protection.go); geoIP resolves their countries and those of
+	// country rules (see country_rules.go), nil = none
+	protectionProfiles map[string]*ProtectionProfile
+	protected          map[string]*ProtectedDestination
+	geoIP              *GeoIPDatabase
+	geoIPSource        *geoIPSource // nil = no database configured
+
+	// ASN database resolving the ASNs of ASN rules (see asn_rules.go), nil
+	// = none
+	asnDB     *ASNDatabase
+	asnSource *asnSource // nil = no database configured
+
+	// Terminated tunnels by name (see tunnels.go)
+	tunnels map[string]*Tunnel
+
+	// Multicast group members and counters (see multicast.go), nil = none
+	multicast *MulticastSnooper
+
+	// NAT64 prefixes by prefix (see nat64.go) and the DNS64 proxy
+	// synthesizing addresses in them (see dns64.go), nil = no DNS64
+	nat64Prefixes map[string]*NAT64Prefix
+	dns64         *DNS64Proxy
+
+	// NAT rules by ID (see nat.go)
+	natRules map[uint32]*NatRule
+
+	// DNS filter domain lists by name, their compiled domains, settings
+	// and query reports (see dns_filter.go)
+	domainLists map[string]*DomainList
+	dnsDomains  map[uint64]dnsMatch
+	dnsConfig   DNSFilterConfig
+	dnsFilter   *DNSFilter
+
+	// TLS fingerprint rules by name, their compiled fingerprints, settings
+	// and ClientHello reports (see tls_fingerprint.go)
+	tlsRules        map[string]*TLSFingerprintRule
+	tlsFingerprints map[string]*TLSFingerprintRule
+	tlsConfig       TLSFingerprintConfig
+	tlsInspector    *TLSInspector
+
+	// SYN flood mitigation config and cookie secret (see mitigation.go)
+	mitigation       MitigationConfig
+	mitigationSecret [2]uint64
+
+	// Per-source rate limits and the sources exempt from them (see
+	// source_limits.go)
+	sourceLimits     SourceLimitConfig
+	sourceExemptions map[netip.Addr]*SourceExemption
+
+	// Automatic blocking config and the counts of its engine (see
+	// auto_block.go)
+	autoBlock   AutoBlockConfig
+	autoBlocker *AutoBlocker
+
+	// Port scan detection config and THREAT_DETECTION events raised (see
+	// port_scan.go)
+	portScan           PortScanConfig
+	portScanDetections atomic.Uint64
+
+	// Packet normalization config and PACKET_NORMALIZED events raised (see
+	// normalize.go)
+	normalization       NormalizationConfig
+	normalizationEvents atomic.Uint64
+
+	// Per-interface settings by interface name (see interface_config.go)
+	interfaceConfigs map[string]*InterfaceConfig
+
+	// Candidate policy counted against live samples, nil when none (see
+	// shadow.go)
+	shadow atomic.Pointer[ShadowPolicy]
+
+	// Policy persistence (see persistence.go), nil = in-memory only
+	store PolicyStore
+
+	// Counter archives (see stats_history.go), nil = no history
+	history *StatsHistory
+
+	// Daily flow records (see flow_archive.go), nil = no archive
+	flowArchive *FlowArchive
+
+	// Daily record of detection events (see event_archive.go), nil = no
+	// archive
+	eventArchive *EventArchive
+
+	// Per-tenant usage accounting (see usage.go), nil = not accounted
+	usage *UsageTracker
+
+	// Emailed digests (see reports.go), nil = reporting off
+	reporter *Reporter
+
+	// Degradation under resource pressure (see degradation.go)
+	degradation *DegradationLadder
+
+	// Enforcement path SLIs and burn rate alerts (see slo.go)
+	slo *SLOTracker
+
+	// Control plane self-limits (see limits.go), nil = unlimited
+	limits *ResourceLimiter
+
+	// Data planes in other network namespaces (see namespaces.go)
+	namespaces map[string]*Namespace
+	dataPlanes map[string]*BPFMapManager // By namespace name or VF key
+
+	// SR-IOV VF data planes (see sriov.go)
+	vfPolicies map[string]*VFPolicy
+
+	// Live event delivery (see events.go)
+	events *EventBus
+
+	// Recent sampled drops for ExplainVerdict (see explain.go)
+	drops *dropLog
+
+	// Requested hardware offload and XDP attach modes (see offload.go and
+	// xdp_mode.go)
+	offloadMode string
+	xdpMode     string
+
+	// Data plane version produced by the compile pipeline (see compile.go)
+	compiled atomic.Pointer[CompiledPolicy]
+
+	// Rule search index of the running version (see search.go)
+	searchMutex sync.Mutex
+	searchIndex *ruleIndex
+
+	// Time and ID sources of timestamps, expiry and schedules (see clock.go)
+	clock Clock
+	ids   IDGenerator
 }
 
 // VPPClient manages VPP integration
@@ -62,8 +249,35 @@ type BPFClient struct {
 
 // NewServer creates a new gRPC server instance
 func NewServer(bpfManager *BPFMapManager) *Server {
-	return &Server{
-		rules: make(map[string]*FirewallRule),
+	s := &Server{
+		rules:              make(map[string]*FirewallRule),
+		zones:              make(map[string]*Zone),
+		zonePolicies:       make(map[string]*ZonePolicy),
+		services:           make(map[string]*Service),
+		addressObjects:     make(map[string]*AddressObject),
+		ipSets:             make(map[uint32]*IPSet),
+		defaultPolicies:    make(map[string]*DefaultPolicy),
+		protectionProfiles: make(map[string]*ProtectionProfile),
+		protected:          make(map[string]*ProtectedDestination),
+		tunnels:            make(map[string]*Tunnel),
+		nat64Prefixes:      make(map[string]*NAT64Prefix),
+		natRules:           make(map[uint32]*NatRule),
+		domainLists:        make(map[string]*DomainList),
+		dnsConfig:          defaultDNSFilterConfig(),
+		tlsRules:           make(map[string]*TLSFingerprintRule),
+		tlsConfig:          TLSFingerprintConfig{Report: TLSReportMatched},
+		mitigation:         defaultMitigationConfig(),
+		sourceExemptions:   make(map[netip.Addr]*SourceExemption),
+		autoBlocker:        NewAutoBlocker(),
+		interfaceConfigs:   make(map[string]*InterfaceConfig),
+		namespaces:         make(map[string]*Namespace),
+		dataPlanes:         make(map[string]*BPFMapManager),
+		vfPolicies:         make(map[string]*VFPolicy),
+		events:             NewEventBus(),
+		anonymizer:         defaultAnonymizer(),
+		drops:              newDropLog(DefaultRecentDrops),
+		offloadMode:        OffloadNone,
+		xdpMode:            XDPModeAuto,
 		stats: &FirewallStats{
 			Pass:     0,
 			Drop:     0,
@@ -74,187 +288,632 @@ func NewServer(bpfManager *BPFMapManager) *Server {
 		bpfClient:  &BPFClient{connected: false},
 		bpfManager: bpfManager,
 	}
+	s.clock = systemClock{}
+	s.ids = newClockIDGenerator(s.clock)
+	s.compiled.Store(&CompiledPolicy{})
+	return s
 }
 
 // AddRule adds a new firewall rule
-func (s *Server) AddRule(ctx context.Context, req *AddRuleRequest) (*RuleResponse, error) {
+func (s *Server) AddRule(ctx context.Context, req *pb.AddRuleRequest) (*pb.RuleResponse, error) {
+	if req.GetRule() == nil {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: "Rule is required",
+		}, nil
+	}
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	rule := &FirewallRule{
-		ID:          generateRuleID(),
-		Action:      req.Rule.Action,
-		SrcIP:       req.Rule.SrcIp,
-		DstIP:       req.Rule.DstIp,
-		SrcPort:     req.Rule.SrcPort,
-		DstPort:     req.Rule.DstPort,
-		Protocol:    req.Rule.Protocol,
-		Direction:   req.Rule.Direction,
-		Priority:    req.Rule.Priority,
-		Enabled:     req.Rule.Enabled,
-		Description: req.Rule.Description,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	now := s.clock.Now()
+	rule, err := fromProtoRule(req.Rule, now)
+	if err != nil {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("Rule validation failed: %v", err),
+			Errors:  fieldErrors(err),
+		}, nil
 	}
+	rule.ID = s.ids.NewID("rule")
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
 
 	// Validate rule
-	if err := s.validateRule(rule); err != nil {
-		return &RuleResponse{
+	err = s.validateRule(rule)
+	if err == nil {
+		err = validateExpiry(rule, now)
+	}
+	if err == nil {
+		err = validatePriority(rule, s.rules)
+	}
+	if err != nil {
+		return &pb.RuleResponse{
 			Success: false,
 			Message: fmt.Sprintf("Rule validation failed: %v", err),
+			Errors:  fieldErrors(err),
 		}, nil
 	}
 
-	// Add to local store
-	s.rules[rule.ID] = rule
+	// Adding the same rule twice returns the rule already there
+	if !req.AllowDuplicates {
+		if existing := duplicateRule(rule, s.rules); existing != nil {
+			return &pb.RuleResponse{
+				Success:   true,
+				Message:   fmt.Sprintf("Identical rule %s already exists", existing.ID),
+				RuleId:    existing.ID,
+				Duplicate: true,
+			}, nil
+		}
+	}
 
-	// Push to data plane
-	if err := s.pushRuleToDataPlane(rule); err != nil {
+	if err := s.limits.checkRules(len(s.rules) + 1); err != nil {
+		return nil, err
+	}
+
+	// Add to local store and push the new policy version
+	s.rules[rule.ID] = rule
+	if err := s.applyPolicy(); err != nil {
 		delete(s.rules, rule.ID)
-		return &RuleResponse{
+		return &pb.RuleResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to push rule to data plane: %v", err),
+			Message: fmt.Sprintf("Failed to push rule to data plane: %v", withRemediation(err)),
 		}, nil
 	}
 
+	s.persistPolicy()
+	s.events.Publish(ruleEvent(EventRuleAdded, rule))
+
 	log.Printf("Added rule: %s - %s %s->%s %s", 
 		rule.ID, rule.Action, rule.SrcIP, rule.DstIP, rule.Protocol)
 
-	return &RuleResponse{
+	return &pb.RuleResponse{
 		Success: true,
 		Message: "Rule added successfully",
 		RuleId:  rule.ID,
 	}, nil
 }
 
+// UpdateRule replaces the fields of an existing rule in place. Entries that
+// survive the update are overwritten, never withdrawn, so enforcement
+// never lapses.
+func (s *Server) UpdateRule(ctx context.Context, req *pb.UpdateRuleRequest) (*pb.RuleResponse, error) {
+	if req.GetRule() == nil {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: "Rule is required",
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, exists := s.rules[req.RuleId]
+	if !exists {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: "Rule not found",
+		}, nil
+	}
+	if federatedRule(existing) {
+		return &pb.RuleResponse{Success: false, Message: federatedRuleMessage(existing)}, nil
+	}
+
+	updated, err := fromProtoRule(req.Rule, s.clock.Now())
+	if err != nil {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("Rule validation failed: %v", err),
+			Errors:  fieldErrors(err),
+		}, nil
+	}
+	updated.ID = existing.ID
+	updated.CreatedAt = existing.CreatedAt
+	updated.UpdatedAt = s.clock.Now()
+
+	err = s.validateRule(updated)
+	if err == nil {
+		err = validateExpiry(updated, updated.UpdatedAt)
+	}
+	if err == nil {
+		err = validatePriority(updated, s.rules)
+	}
+	if err != nil {
+		return &pb.RuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("Rule validation failed: %v", err),
+			Errors:  fieldErrors(err),
+		}, nil
+	}
+
+	s.rules[updated.ID] = updated
+	if err := s.applyPolicy(); err != nil {
+		s.rules[existing.ID] = existing
+		return &pb.RuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to push rule to data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+	s.events.Publish(ruleEvent(EventRuleUpdated, updated))
+
+	log.Printf("Updated rule: %s - %s %s->%s %s",
+		updated.ID, updated.Action, updated.SrcIP, updated.DstIP, updated.Protocol)
+
+	return &pb.RuleResponse{
+		Success: true,
+		Message: "Rule updated successfully",
+		RuleId:  updated.ID,
+		Rule:    toProtoRule(updated),
+	}, nil
+}
+
 // DeleteRule removes a firewall rule
-func (s *Server) DeleteRule(ctx context.Context, req *DeleteRuleRequest) (*StatusResponse, error) {
+func (s *Server) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest) (*pb.StatusResponse, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	rule, exists := s.rules[req.RuleId]
 	if !exists {
-		return &StatusResponse{
+		return &pb.StatusResponse{
 			Success: false,
 			Message: "Rule not found",
 		}, nil
 	}
+	if federatedRule(rule) {
+		return &pb.StatusResponse{Success: false, Message: federatedRuleMessage(rule)}, nil
+	}
 
-	// Remove from data plane
-	if err := s.removeRuleFromDataPlane(rule); err != nil {
-		return &StatusResponse{
+	// Remove from local store and withdraw it from the data plane
+	delete(s.rules, req.RuleId)
+	if err := s.applyPolicy(); err != nil {
+		s.rules[rule.ID] = rule
+		return &pb.StatusResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to remove rule from data plane: %v", err),
+			Message: fmt.Sprintf("Failed to remove rule from data plane: %v", withRemediation(err)),
 		}, nil
 	}
 
-	// Remove from local store
-	delete(s.rules, req.RuleId)
+	s.persistPolicy()
+	s.events.Publish(ruleEvent(EventRuleDeleted, rule))
 
 	log.Printf("Deleted rule: %s", req.RuleId)
 
-	return &StatusResponse{
+	return &pb.StatusResponse{
 		Success: true,
 		Message: "Rule deleted successfully",
 	}, nil
 }
 
 // GetStats returns current firewall statistics
-func (s *Server) GetStats(ctx context.Context, req *Empty) (*Statistics, error) {
+func (s *Server) GetStats(ctx context.Context, req *pb.Empty) (*pb.Statistics, error) {
 	s.mutex.RLock()
-	defer s.mutex.RUnlock()
 
 	// Update stats from data plane
 	s.updateStatsFromDataPlane()
+	var countersSince int64
+	var counterResets uint64
+	if s.bpfManager != nil {
+		if since, resets := s.bpfManager.counters.since(); !since.IsZero() {
+			countersSince, counterResets = since.Unix(), resets
+		}
+	}
 
-	return &Statistics{
-		TotalPackets:   s.stats.Pass + s.stats.Drop + s.stats.Redirect,
-		TotalBytes:     (s.stats.Pass + s.stats.Drop + s.stats.Redirect) * 64,
-		DroppedPackets: s.stats.Drop,
-		AllowedPackets: s.stats.Pass + s.stats.Redirect,
-		ActiveRules:    int32(len(s.rules)),
-		Uptime:         int64(time.Since(time.Now()).Seconds()),
-	}, nil
+	stats := &pb.Statistics{
+		TotalPackets:    s.stats.Pass + s.stats.Drop + s.stats.Redirect,
+		TotalBytes:      (s.stats.Pass + s.stats.Drop + s.stats.Redirect) * 64,
+		DroppedPackets:  s.stats.Drop,
+		AllowedPackets:  s.stats.Pass + s.stats.Redirect,
+		ActiveRules:     int32(len(s.rules)),
+		Uptime:          int64(time.Since(time.Now()).Seconds()),
+		DefaultPolicies: s.defaultPolicyProtos(),
+		CountersSince:   countersSince,
+		CounterResets:   counterResets,
+	}
+	if s.bpfManager != nil {
+		if counts, err := s.bpfManager.ProtocolCounts(); err != nil {
+			log.Printf("⚠️  Failed to read protocol counters: %v", err)
+		} else {
+			stats.Protocols = protocolStatsToProto(counts)
+		}
+	}
+	s.mutex.RUnlock()
+
+	// Extensions are collected without the lock, they may take a while
+	stats.Extensions = statsSources.snapshots(ctx, s.clock.Now())
+	return stats, nil
 }
 
-// GetRules returns all firewall rules
-func (s *Server) GetRules(ctx context.Context, req *Empty) (*RulesResponse, error) {
+// GetRules returns the firewall rules, all or those a label selector matches
+func (s *Server) GetRules(ctx context.Context, req *pb.GetRulesRequest) (*pb.RulesResponse, error) {
+	selector, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, withCode(ErrCodeInvalidSelector, err)
+	}
+
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	var rules []*Rule
-	for _, rule := range s.rules {
-		rules = append(rules, &Rule{
-			Id:          rule.ID,
-			Action:      rule.Action,
-			SrcIp:       rule.SrcIP,
-			DstIp:       rule.DstIP,
-			SrcPort:     rule.SrcPort,
-			DstPort:     rule.DstPort,
-			Protocol:    rule.Protocol,
-			Direction:   rule.Direction,
-			Priority:    rule.Priority,
-			Enabled:     rule.Enabled,
-			Description: rule.Description,
-		})
-	}
-
-	return &RulesResponse{
-		Rules: rules,
-		Count: int32(len(rules)),
+	// Rules are listed in evaluation order
+	ordered := s.selectRules(selector)
+
+	hits := s.ruleHits()
+	var rules []*pb.Rule
+	for _, rule := range sortByPriority(ordered) {
+		p := toProtoRule(rule)
+		p.OffloadStatus, p.OffloadReason = s.ruleOffload(rule)
+		if ruleHits, exists := hits[rule.ID]; exists {
+			p.HitPackets, p.HitBytes = ruleHits.Packets, ruleHits.Bytes
+			if !ruleHits.LastHit.IsZero() {
+				p.LastHit = ruleHits.LastHit.Unix()
+			}
+		}
+		rules = append(rules, p)
+	}
+
+	return &pb.RulesResponse{
+		Rules:           rules,
+		Count:           int32(len(rules)),
+		DefaultPolicies: s.defaultPolicyProtos(),
 	}, nil
 }
 
 // Helper functions
 
-func generateRuleID() string {
-	return fmt.Sprintf("rule_%d", time.Now().UnixNano())
+func toProtoRule(rule *FirewallRule) *pb.Rule {
+	p := &pb.Rule{
+		Id:          rule.ID,
+		Action:      rule.Action,
+		SrcIp:       rule.SrcIP,
+		DstIp:       rule.DstIP,
+		SrcPort:     rule.SrcPort,
+		DstPort:     rule.DstPort,
+		Protocol:    rule.Protocol,
+		Direction:   rule.Direction,
+		Priority:    rule.Priority,
+		Enabled:     rule.Enabled,
+		Description: rule.Description,
+		Owner:       rule.Owner,
+		Service:     rule.Service,
+		SrcAddress:  rule.SrcAddress,
+		DstAddress:  rule.DstAddress,
+		SrcSet:      rule.SrcSet,
+		DstSet:      rule.DstSet,
+		Family:      familyName(ruleFamily(rule)),
+		Namespace:   rule.Namespace,
+		Vf:          rule.VF,
+		ConnState:   rule.ConnState,
+		TcpFlags:    rule.TCPFlags,
+		SrcMac:      rule.SrcMAC,
+		DstMac:      rule.DstMAC,
+		Vlan:        rule.VLAN,
+		Interfaces:  rule.Interfaces,
+		SrcCountry:  rule.SrcCountry,
+		DstCountry:  rule.DstCountry,
+		SrcAsn:      rule.SrcASN,
+		DstAsn:      rule.DstASN,
+		Log:         rule.Log,
+		MirrorTo:    rule.MirrorTo,
+		Labels:      rule.Labels,
+		CreatedAt:   rule.CreatedAt.Unix(),
+		UpdatedAt:   rule.UpdatedAt.Unix(),
+	}
+	if rule.SrcPortEnd > 0 {
+		p.SrcPortRange = &pb.PortRange{Start: rule.SrcPort, End: rule.SrcPortEnd}
+	}
+	if rule.DstPortEnd > 0 {
+		p.DstPortRange = &pb.PortRange{Start: rule.DstPort, End: rule.DstPortEnd}
+	}
+	if !rule.ExpiresAt.IsZero() {
+		p.ExpiresAt = rule.ExpiresAt.Unix()
+	}
+	return p
 }
 
-func (s *Server) validateRule(rule *FirewallRule) error {
-	if rule.Action == "" {
-		return fmt.Errorf("action is required")
+// fromProtoRule converts an API rule; timestamps are taken as Unix seconds,
+// port ranges override single ports and a TTL counts from now, overriding
+// the expiry time. Fields of v1 clients are read into the ones replacing
+// them. A TTL out of range, an unknown log level or a rate limit is a
+// ruleValidationError.
+func fromProtoRule(rule *pb.Rule, now time.Time) (*FirewallRule, error) {
+	converted := &FirewallRule{
+		ID:          rule.Id,
+		Action:      rule.Action,
+		SrcIP:       rule.SrcIp,
+		DstIP:       rule.DstIp,
+		SrcPort:     rule.SrcPort,
+		DstPort:     rule.DstPort,
+		Protocol:    rule.Protocol,
+		Direction:   rule.Direction,
+		Priority:    rule.Priority,
+		Enabled:     rule.Enabled,
+		Description: rule.Description,
+		Owner:       rule.Owner,
+		Service:     rule.Service,
+		SrcAddress:  rule.SrcAddress,
+		DstAddress:  rule.DstAddress,
+		SrcSet:      rule.SrcSet,
+		DstSet:      rule.DstSet,
+		Namespace:   rule.Namespace,
+		VF:          rule.Vf,
+		ConnState:   rule.ConnState,
+		TCPFlags:    rule.TcpFlags,
+		SrcMAC:      rule.SrcMac,
+		DstMAC:      rule.DstMac,
+		VLAN:        rule.Vlan,
+		Interfaces:  rule.Interfaces,
+		SrcCountry:  rule.SrcCountry,
+		DstCountry:  rule.DstCountry,
+		SrcASN:      rule.SrcAsn,
+		DstASN:      rule.DstAsn,
+		Log:         rule.Log,
+		MirrorTo:    rule.MirrorTo,
+		Labels:      rule.Labels,
+		CreatedAt:   time.Unix(rule.CreatedAt, 0),
+		UpdatedAt:   time.Unix(rule.UpdatedAt, 0),
 	}
-	if rule.Action != "allow" && rule.Action != "drop" && rule.Action != "redirect" {
-		return fmt.Errorf("invalid action: %s", rule.Action)
+	if rule.RateLimit != 0 {
+		var errs ruleValidationError
+		errs.add("rate_limit", "per-rule rate limits are not supported, limit sources with SetSourceLimitConfig")
+		return nil, errs
 	}
-	if rule.Protocol != "" && rule.Protocol != "tcp" && rule.Protocol != "udp" && 
-	   rule.Protocol != "icmp" && rule.Protocol != "any" {
-		return fmt.Errorf("invalid protocol: %s", rule.Protocol)
+	switch rule.LogLevel {
+	case "", "none":
+	case "info", "debug":
+		// v1 clients turn logging on with a level
+		converted.Log = true
+	default:
+		var errs ruleValidationError
+		errs.add("log_level", "invalid log level %q, expected none, info or debug", rule.LogLevel)
+		return nil, errs
 	}
-	return nil
+	if len(converted.SrcCountry) == 0 && rule.GeoipCountry != "" {
+		// v1 clients list source countries in one field
+		converted.SrcCountry = strings.Split(rule.GeoipCountry, ",")
+	}
+	if r := rule.SrcPortRange; r != nil {
+		converted.SrcPort, converted.SrcPortEnd = r.Start, r.End
+	}
+	if r := rule.DstPortRange; r != nil {
+		converted.DstPort, converted.DstPortEnd = r.Start, r.End
+	}
+	if rule.TtlSeconds != 0 {
+		ttl, err := ruleTTL(rule.TtlSeconds)
+		if err != nil {
+			return nil, err
+		}
+		converted.ExpiresAt = now.Add(ttl)
+	} else if rule.ExpiresAt != 0 {
+		converted.ExpiresAt = time.Unix(rule.ExpiresAt, 0)
+	}
+	return converted, nil
 }
 
-func (s *Server) pushRuleToDataPlane(rule *FirewallRule) error {
-	// Push rule to eBPF via BPF manager
-	if s.bpfManager != nil {
-		if err := s.bpfManager.AddRuleToMap(rule); err != nil {
-			log.Printf("Failed to add rule to eBPF map: %v", err)
+// Address families of rule entries
+const (
+	familyAny  = 0 // No address constraint, installed for both families
+	familyIPv4 = 4
+	familyIPv6 = 6
+)
+
+// parseRuleAddress parses a CIDR; a bare address is treated as a host prefix
+func parseRuleAddress(addr string) (*net.IPNet, error) {
+	if !strings.Contains(addr, "/") {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid address: %s", addr)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
 		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
 	}
+	_, network, err := net.ParseCIDR(addr)
+	return network, err
+}
 
-	// Simulate pushing rule to VPP
-	if s.vppClient.connected {
-		log.Printf("Pushing rule %s to VPP", rule.ID)
-		// vpp.AddRule(rule) - actual VPP API call would go here
+// addressFamily returns the family of an address, familyAny when it is
+// empty or invalid
+func addressFamily(addr string) int {
+	if addr == "" {
+		return familyAny
+	}
+	network, err := parseRuleAddress(addr)
+	if err != nil {
+		return familyAny
 	}
+	if network.IP.To4() != nil {
+		return familyIPv4
+	}
+	return familyIPv6
+}
 
-	return nil
+// ruleFamily returns the family a rule entry applies to
+func ruleFamily(rule *FirewallRule) int {
+	if family := addressFamily(rule.SrcIP); family != familyAny {
+		return family
+	}
+	return addressFamily(rule.DstIP)
 }
 
-func (s *Server) removeRuleFromDataPlane(rule *FirewallRule) error {
-	// Simulate removing rule from VPP
-	if s.vppClient.connected {
-		log.Printf("Removing rule %s from VPP", rule.ID)
-		// vpp.DeleteRule(rule.ID) - actual VPP API call would go here
+// ruleHooks returns the hooks enforcing a rule entry: XDP ingress for
+// inbound rules and rules without a direction, TC egress for outbound
+func ruleHooks(rule *FirewallRule) (ingress, egress bool) {
+	switch rule.Direction {
+	case "outbound":
+		return false, true
+	case "both":
+		return true, true
+	default:
+		return true, false
 	}
+}
+
+// familiesCompatible reports whether a source and destination address can
+// appear in the same entry
+func familiesCompatible(src, dst string) bool {
+	srcFamily, dstFamily := addressFamily(src), addressFamily(dst)
+	return srcFamily == familyAny || dstFamily == familyAny || srcFamily == dstFamily
+}
 
-	// Simulate removing rule from eBPF
-	if s.bpfClient.connected {
-		log.Printf("Removing rule %s from eBPF", rule.ID)
-		// bpf.DeleteMapEntry(rule.ID) - actual eBPF map update would go here
+func familyName(family int) string {
+	switch family {
+	case familyIPv4:
+		return "ipv4"
+	case familyIPv6:
+		return "ipv6"
+	default:
+		return "any"
 	}
+}
 
+// validatePortRange checks a single port (end 0) or an inclusive range
+func validatePortRange(field string, start, end int32) error {
+	if start < 0 || start > 65535 {
+		return fmt.Errorf("invalid %s_port: %d", field, start)
+	}
+	if end == 0 {
+		return nil
+	}
+	if start == 0 {
+		return fmt.Errorf("%s port range end %d without a start port", field, end)
+	}
+	if end < start || end > 65535 {
+		return fmt.Errorf("invalid %s port range: %d-%d", field, start, end)
+	}
+	return nil
+}
+
+// validateRule checks every field of a rule and reports each invalid one
+// in a ruleValidationError
+func (s *Server) validateRule(rule *FirewallRule) error {
+	var errs ruleValidationError
+	if rule.Action == "" {
+		errs.add("action", "action is required")
+	} else if rule.Action != "allow" && rule.Action != "drop" && rule.Action != "redirect" && rule.Action != "reject" && rule.Action != "mirror" {
+		errs.add("action", "invalid action: %s", rule.Action)
+	}
+	if rule.Action == "mirror" && rule.MirrorTo == "" {
+		errs.add("mirror_to", "mirror_to is required for the mirror action")
+	} else if rule.Action != "mirror" && rule.MirrorTo != "" {
+		errs.add("mirror_to", "mirror_to only applies to the mirror action")
+	} else if rule.MirrorTo != "" && ruleScope(rule) == "" {
+		// Interfaces of namespace and VF data planes are not visible from
+		// the host namespace, so only host rules are checked here
+		if _, err := net.InterfaceByName(rule.MirrorTo); err != nil {
+			errs.add("mirror_to", "unknown mirror interface: %s", rule.MirrorTo)
+		}
+	}
+	if rule.Direction != "" && rule.Direction != "inbound" && rule.Direction != "outbound" && rule.Direction != "both" {
+		errs.add("direction", "invalid direction: %s, expected inbound, outbound or both", rule.Direction)
+	}
+	if rule.Direction == "outbound" && rule.Action == "redirect" {
+		errs.add("action", "redirect is only possible on inbound traffic")
+	}
+	if err := validateProtocol(rule.Protocol); err != nil {
+		errs.add("protocol", "%v", err)
+	}
+	if err := validatePortRange("src", rule.SrcPort, rule.SrcPortEnd); err != nil {
+		errs.add("src_port", "%v", err)
+	}
+	if err := validatePortRange("dst", rule.DstPort, rule.DstPortEnd); err != nil {
+		errs.add("dst_port", "%v", err)
+	}
+	if rule.Protocol != "" && rule.Protocol != "any" && !protocolHasPorts(rule.Protocol) && (rule.SrcPort != 0 || rule.DstPort != 0) {
+		errs.add("protocol", "ports only apply to tcp, udp and sctp")
+	}
+	if err := validateRuleAddress(rule.SrcIP); err != nil {
+		errs.add("src_ip", "invalid src_ip %s: %v", rule.SrcIP, err)
+	}
+	if err := validateRuleAddress(rule.DstIP); err != nil {
+		errs.add("dst_ip", "invalid dst_ip %s: %v", rule.DstIP, err)
+	}
+	if !familiesCompatible(rule.SrcIP, rule.DstIP) {
+		errs.add("dst_ip", "src_ip and dst_ip must be the same address family")
+	}
+	if rule.Namespace != "" {
+		if _, exists := s.namespaces[rule.Namespace]; !exists {
+			errs.add("namespace", "unknown namespace: %s", rule.Namespace)
+		}
+	}
+	for _, state := range rule.ConnState {
+		if _, known := connStateBits[state]; !known {
+			errs.add("conn_state", "invalid conn_state: %s", state)
+		}
+	}
+	if flags, mask, err := parseTCPFlags(rule.TCPFlags); err != nil {
+		errs.add("tcp_flags", "invalid tcp_flags %s: %v", rule.TCPFlags, err)
+	} else if mask != 0 && rule.Service == "" && protocolToUint8(rule.Protocol) != 6 {
+		errs.add("tcp_flags", "tcp_flags only apply to tcp")
+	} else {
+		rule.TCPFlags = formatTCPFlags(flags, mask)
+	}
+	if _, err := parseMAC(rule.SrcMAC); err != nil {
+		errs.add("src_mac", "invalid src_mac %s: %v", rule.SrcMAC, err)
+	} else {
+		rule.SrcMAC = canonicalMAC(rule.SrcMAC)
+	}
+	if _, err := parseMAC(rule.DstMAC); err != nil {
+		errs.add("dst_mac", "invalid dst_mac %s: %v", rule.DstMAC, err)
+	} else {
+		rule.DstMAC = canonicalMAC(rule.DstMAC)
+	}
+	if rule.VLAN < 0 || rule.VLAN > MaxVLANID {
+		errs.add("vlan", "invalid vlan %d, expected 1-%d or 0 for any", rule.VLAN, MaxVLANID)
+	}
+	for _, name := range rule.Interfaces {
+		if err := validateInterfaceName(name); err != nil {
+			errs.add("interfaces", "%v", err)
+		}
+	}
+	if len(rule.Interfaces) > 0 && rule.Namespace != "" {
+		errs.add("interfaces", "interfaces are only matched on the host and VF data planes")
+	}
+	rule.Interfaces = canonicalInterfaces(rule.Interfaces)
+	if rule.VF != "" {
+		if rule.Namespace != "" {
+			errs.add("vf", "namespace and vf are mutually exclusive")
+		} else if _, exists := s.vfPolicies[rule.VF]; !exists {
+			errs.add("vf", "no VF policy attached for %s", rule.VF)
+		}
+	}
+	if rule.Service != "" {
+		if _, exists := s.services[rule.Service]; !exists {
+			errs.add("service", "unknown service: %s", rule.Service)
+		}
+		if (rule.Protocol != "" && rule.Protocol != "any") || rule.DstPort != 0 || rule.DstPortEnd != 0 {
+			errs.add("service", "protocol and dst_port must be empty when a service is referenced")
+		}
+	}
+	if rule.SrcAddress != "" {
+		if _, exists := s.addressObjects[rule.SrcAddress]; !exists {
+			errs.add("src_address", "unknown address object: %s", rule.SrcAddress)
+		}
+		if rule.SrcIP != "" {
+			errs.add("src_address", "src_ip must be empty when src_address is referenced")
+		}
+	}
+	if rule.DstAddress != "" {
+		if _, exists := s.addressObjects[rule.DstAddress]; !exists {
+			errs.add("dst_address", "unknown address object: %s", rule.DstAddress)
+		}
+		if rule.DstIP != "" {
+			errs.add("dst_address", "dst_ip must be empty when dst_address is referenced")
+		}
+	}
+	validateMulticastRule(rule, &errs)
+	s.validateIPSetReference(rule, "src_set", rule.SrcSet, rule.SrcIP, &errs)
+	s.validateIPSetReference(rule, "dst_set", rule.DstSet, rule.DstIP, &errs)
+	rule.SrcCountry = s.validateRuleCountries(rule, "src_country", rule.SrcCountry, &errs)
+	rule.DstCountry = s.validateRuleCountries(rule, "dst_country", rule.DstCountry, &errs)
+	rule.SrcASN = s.validateRuleASNs(rule, "src_asn", rule.SrcASN, &errs)
+	rule.DstASN = s.validateRuleASNs(rule, "dst_asn", rule.DstASN, &errs)
+	s.validateFederatedPriority(rule, &errs)
+	validateLabels(rule.Labels, &errs)
+	if len(errs) > 0 {
+		return errs
+	}
 	return nil
 }
 
@@ -277,69 +936,521 @@ func (s *Server) updateStatsFromDataPlane() {
 }
 
 func main() {
+	if isCtl() {
+		os.Exit(runCtl(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+	}
 	log.Printf("Starting Cerberus-V gRPC Control Plane v%s", Version)
 
+	if err := loadConfigFile(os.Getenv("CERBERUS_CONFIG")); err != nil {
+		log.Fatalf("Invalid configuration file: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+
+	offloadMode, err := offloadModeFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid offload configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	xdpMode, err := xdpModeFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid XDP configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	sampleBudget, sampleMaxRate, err := sampleConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid sampling configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	logSampleRate, err := logSampleRateFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid rule log configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	rejectRate, err := rejectRateFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid reject configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	degradeConfig, err := degradationConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid degradation configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	sloConfig, err := sloConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid SLO configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	grpcConfig, err := grpcConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid gRPC configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	authConfig, err := authConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid authentication configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	limits, err := resourceLimitsFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid self-limit configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	compression, err := compressionConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid compression configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	reportConfig, err := reportConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid reporting configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	shardConfig, err := shardConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid sharding configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	dns64Config, err := dns64ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid DNS64 configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	dnsFilterConfig, err := dnsFilterConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid DNS filter configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	tlsFingerprintConfig, err := tlsFingerprintConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid TLS fingerprinting configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	geoIPRefresh, geoIPURL, err := geoIPConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid GeoIP configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	var geoIPUpdate *dbUpdate
+	if geoIPURL != "" {
+		if geoIPUpdate, err = dbUpdateFromEnv(DatabaseGeoIP, "GEOIP", os.Getenv("CERBERUS_GEOIP_DB"), geoIPURL); err != nil {
+			log.Fatalf("Invalid GeoIP configuration: %v", withCode(ErrCodeInvalidConfig, err))
+		}
+	}
+	asnRefresh, asnURL, err := asnConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid ASN database configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	var asnUpdate *dbUpdate
+	if asnURL != "" {
+		if asnUpdate, err = dbUpdateFromEnv(DatabaseASN, "ASN", os.Getenv("CERBERUS_ASN_DB"), asnURL); err != nil {
+			log.Fatalf("Invalid ASN database configuration: %v", withCode(ErrCodeInvalidConfig, err))
+		}
+	}
+	feeds, err := feedsFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid feed configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	federation, err := federationFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid federation configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+	anonymizer, err := anonymizerFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid anonymization configuration: %v", withCode(ErrCodeInvalidConfig, err))
+	}
+
 	// Initialize BPF map manager
 	bpfManager, err := NewBPFMapManager()
 	if err != nil {
-		log.Printf("Warning: Failed to initialize BPF manager: %v", err)
+		log.Printf("Warning: Failed to initialize BPF manager: %v", withRemediation(err))
 		log.Printf("Continuing in simulation mode...")
 		bpfManager = nil
 	}
+
+	// Create server and restore the persisted policy
+	server := NewServer(bpfManager)
+	server.offloadMode = offloadMode
+	server.xdpMode = xdpMode
+	server.degradation = NewDegradationLadder(server, degradeConfig)
+	server.slo = NewSLOTracker(server, sloConfig)
+	server.limits = NewResourceLimiter(limits, server.clock)
+	server.events.queueSize = limits.EventQueue
+
+	// Event IDs continue the persisted sequence before anything is published
+	stateDir := os.Getenv("CERBERUS_STATE_DIR")
+	if stateDir == "" {
+		stateDir = DefaultStateDir
+	}
+	if err := server.events.PersistSequence(stateDir, os.Getenv("CERBERUS_NODE_ID")); err != nil {
+		log.Printf("Warning: Event IDs are not persisted: %v", err)
+	}
+
+	server.drops = newDropLog(limits.RecentDrops)
+	server.multicast = NewMulticastSnooper(bpfManager, server.clock)
+	server.dnsConfig = dnsFilterConfig
+	server.dnsFilter = NewDNSFilter(server, bpfManager)
+	server.tlsConfig = tlsFingerprintConfig
+	server.tlsInspector = NewTLSInspector(server, bpfManager)
+	if tlsFingerprintConfig.Report == TLSReportAll {
+		server.mutex.Lock()
+		if err := server.pushTLSFingerprintRules(server.tlsRules); err != nil {
+			log.Printf("Warning: TLS ClientHellos are not reported: %v", err)
+		}
+		server.mutex.Unlock()
+	}
+	server.federation = federation
+	server.anonymizer = anonymizer
+	if anonymizer.all {
+		log.Printf("Exported events and flows are anonymized (%s)", strings.Join(anonymizer.methods(), ", "))
+	}
+	if dns64Config.Listen != "" {
+		server.dns64 = NewDNS64Proxy(server, dns64Config)
+	}
+
 	if bpfManager != nil {
 		defer bpfManager.Close()
-		// Run end-to-end demo
-		bpfManager.DemoEndToEnd()
+		bpfManager.events = server.events
+		bpfManager.offloadMode = offloadMode
+		bpfManager.xdpMode = xdpMode
+		if err := bpfManager.EnableSharding(shardConfig); err != nil {
+			log.Printf("Warning: Rule sharding disabled: %v", err)
+		}
+		// Run end-to-end demo; it writes a sample rule, so never against live maps
+		if bpfManager.simulated {
+			bpfManager.DemoEndToEnd()
+		}
 	}
 
-	// Create server
-	server := NewServer(bpfManager)
+	if path := os.Getenv("CERBERUS_GEOIP_DB"); path != "" {
+		server.geoIPSource = &geoIPSource{path: path, update: geoIPUpdate}
+		if geoIPUpdate != nil {
+			geoIPUpdate.clock = server.clock
+		}
+		// Rules restored below need the database, so a missing file is
+		// downloaded before starting
+		if _, err := os.Stat(path); os.IsNotExist(err) && geoIPUpdate != nil {
+			result, err := geoIPUpdate.run(context.Background(), 0, validateGeoIPDownload)
+			server.publishUpdate(geoIPUpdate, result, err)
+		}
+		if geoIP, err := LoadGeoIPDatabase(path); err != nil {
+			log.Printf("Warning: GeoIP database disabled: %v", err)
+		} else {
+			geoIP.loadedAt = server.clock.Now()
+			server.geoIP = geoIP
+			log.Printf("Loaded GeoIP database %s (%s, %d countries, built %s)",
+				geoIP.path, geoIP.format, len(geoIP.countries), geoIP.builtAt.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if path := os.Getenv("CERBERUS_ASN_DB"); path != "" {
+		server.asnSource = &asnSource{path: path, update: asnUpdate}
+		if asnUpdate != nil {
+			asnUpdate.clock = server.clock
+		}
+		// Rules restored below need the database, so a missing file is
+		// downloaded before starting
+		if _, err := os.Stat(path); os.IsNotExist(err) && asnUpdate != nil {
+			result, err := asnUpdate.run(context.Background(), 0, validateASNDownload)
+			if result == UpdateResultUpdated {
+				server.asnSource.downloads++
+			}
+			server.publishUpdate(asnUpdate, result, err)
+		}
+		if asnDB, err := LoadASNDatabase(path); err != nil {
+			log.Printf("Warning: ASN database disabled: %v", err)
+		} else {
+			asnDB.loadedAt = server.clock.Now()
+			server.asnDB = asnDB
+			log.Printf("Loaded ASN database %s (%s, %d ASNs, built %s)",
+				asnDB.path, asnDB.format, len(asnDB.asns), asnDB.builtAt.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if store, err := NewJSONFileStore(stateDir); err != nil {
+		log.Printf("Warning: Policy persistence disabled: %v", err)
+	} else if err := server.RestorePolicy(store); err != nil {
+		log.Fatalf("Failed to restore policy from %s: %v", stateDir, err)
+	}
+	if err := server.attachFeeds(feeds); err != nil {
+		log.Fatalf("Failed to attach feeds: %v", withRemediation(err))
+	}
+	if history, err := NewStatsHistory(stateDir); err != nil {
+		log.Printf("Warning: Statistics history disabled: %v", err)
+	} else {
+		server.history = history
+	}
+	if bpfManager != nil && !bpfManager.simulated {
+		if counters, err := NewCounterContinuity(stateDir); err != nil {
+			log.Printf("Warning: Packet counters restart from zero with the data plane: %v", err)
+		} else {
+			bpfManager.attachCounters(counters)
+		}
+	}
+	if flowArchive, err := NewFlowArchive(stateDir); err != nil {
+		log.Printf("Warning: Flow archive disabled: %v", err)
+	} else {
+		server.flowArchive = flowArchive
+	}
+	if eventArchive, err := NewEventArchive(stateDir); err != nil {
+		log.Printf("Warning: Event archive disabled: %v", err)
+	} else {
+		server.eventArchive = eventArchive
+	}
+	if usage, err := NewUsageTracker(stateDir); err != nil {
+		log.Printf("Warning: Usage accounting disabled: %v", err)
+	} else {
+		server.usage = usage
+	}
+	if reportConfig.SMTPAddr != "" {
+		if reporter, err := NewReporter(server, reportConfig, stateDir); err != nil {
+			log.Printf("Warning: Reporting disabled: %v", err)
+		} else {
+			server.reporter = reporter
+		}
+	}
 
 	// Start Prometheus exporter
 	exporter := NewPrometheusExporter(bpfManager, server)
+	exporter.vppTelemetry = NewVPPTelemetryCollector(os.Getenv("CERBERUS_VPP_STATS_SOCKET"))
+	exporter.sampler = NewPacketSampler(bpfManager, server.events, sampleBudget, sampleMaxRate, server.clock)
+	exporter.rejecter = NewRejecter(bpfManager, rejectRate, server.clock)
+	exporter.ruleLogger = NewRuleLogger(server, bpfManager, logSampleRate)
+	exporter.programStats = NewProgramStatsSupervisor(server.clock)
+	defer exporter.vppTelemetry.Close()
 	go func() {
-		if err := exporter.Start(8080); err != nil {
+		if err := exporter.Start(metricsPort); err != nil {
 			log.Printf("Prometheus exporter failed: %v", err)
 		}
 	}()
 
-	// For testing, just run a simple HTTP server instead of gRPC
-	log.Printf("🎯 Test mode: Running simple HTTP server on %s", gRPCPort)
-	
-	// Simple test HTTP endpoints
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(200)
-		w.Write([]byte("OK - Cerberus-V Control Plane"))
-	})
-	
-	http.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
-		stats, _ := server.GetStats(context.Background(), &Empty{})
-		json.NewEncoder(w).Encode(stats)
-	})
-	
-	http.HandleFunc("/rules", func(w http.ResponseWriter, r *http.Request) {
-		rules, _ := server.GetRules(context.Background(), &Empty{})
-		json.NewEncoder(w).Encode(rules)
-	})
+	// Publish drop events to live subscribers
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	go server.watchDrops(watchCtx, 5*time.Second)
+	go exporter.sampler.Run(watchCtx, time.Second)
+	go exporter.rejecter.Run(watchCtx)
+	go exporter.ruleLogger.Run(watchCtx)
+	go server.multicast.Run(watchCtx)
+	go server.dnsFilter.Run(watchCtx)
+	go server.tlsInspector.Run(watchCtx)
+	go server.recordDrops(watchCtx)
+	go server.reapExpiredRules(watchCtx, time.Second)
+	go server.runAutoBlock(watchCtx)
+	go server.enforceProtection(watchCtx, protectionSweepInterval)
+	go server.monitorMitigation(watchCtx, mitigationPollInterval)
+	go server.monitorPortScans(watchCtx, portScanInterval)
+	go server.monitorNormalization(watchCtx, normalizationInterval)
+	go server.runShadowPolicy(watchCtx)
+	go server.reapNAT64Sessions(watchCtx, nat64ReapInterval)
+	go server.reapNatSessions(watchCtx, natReapInterval)
+	if server.geoIPSource != nil && geoIPRefresh > 0 {
+		go server.refreshGeoIP(watchCtx, geoIPRefresh)
+	}
+	if server.asnSource != nil && asnRefresh > 0 {
+		go server.refreshASN(watchCtx, asnRefresh)
+	}
+	if len(server.feeds) > 0 {
+		go server.refreshFeeds(watchCtx)
+	}
+	if federation != nil {
+		federation.logSummary()
+		if federation.upstream != "" {
+			go server.runFederation(watchCtx)
+		}
+	}
+	if server.dns64 != nil {
+		go server.dns64.Run(watchCtx)
+	}
+	go exporter.programStats.Run(watchCtx, 10*time.Second)
+	if server.history != nil {
+		go server.history.Run(watchCtx, bpfManager)
+	}
+	if bpfManager != nil && bpfManager.counters != nil {
+		go bpfManager.counters.Run(watchCtx, bpfManager)
+	}
+	if server.eventArchive != nil {
+		go server.eventArchive.Run(watchCtx, server.events)
+	}
+	if server.flowArchive != nil {
+		go server.flowArchive.Run(watchCtx, bpfManager)
+	}
+	if server.usage != nil {
+		go server.usage.Run(watchCtx, server)
+	}
+	if server.reporter != nil {
+		go server.reporter.Run(watchCtx)
+	}
+	go server.degradation.Run(watchCtx, 5*time.Second)
+	go server.slo.Run(watchCtx, sloProbeInterval)
+
+	// Start gRPC server
+	lis, err := net.Listen("tcp", gRPCPort)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", gRPCPort, err)
+	}
+
+	grpcOptions := grpcConfig.serverOptions()
+	restHandler := server.limits.limitREST(compression.compressREST(newRESTHandler(server)))
+	if server.usage != nil {
+		grpcOptions = append(grpcOptions,
+			grpc.ChainUnaryInterceptor(server.usage.unaryInterceptor),
+			grpc.ChainStreamInterceptor(server.usage.streamInterceptor))
+		restHandler = server.usage.countREST(restHandler)
+	}
+	// Self-limits sit inside usage accounting, so refused requests are counted,
+	// and inside the error catalog, so refusals carry remediation details
+	grpcOptions = append(grpcOptions,
+		grpc.ChainUnaryInterceptor(errorCatalogUnaryInterceptor),
+		grpc.ChainStreamInterceptor(errorCatalogStreamInterceptor),
+		grpc.ChainUnaryInterceptor(server.limits.unaryInterceptor),
+		grpc.ChainStreamInterceptor(server.limits.streamInterceptor),
+		grpc.ChainUnaryInterceptor(compression.unaryInterceptor),
+		grpc.ChainStreamInterceptor(compression.streamInterceptor))
+	// Authentication is outermost: unauthenticated calls are neither
+	// counted nor admitted
+	authConfig.events = server.events
+	grpcServer := grpc.NewServer(append(authConfig.serverOptions(ListenerGRPC), grpcOptions...)...)
+	grpcConfig.logSummary()
+	limits.logSummary()
+	authConfig.logSummary()
+	pb.RegisterFirewallControlServer(grpcServer, server)
+	reflection.Register(grpcServer)
+
+	// The same API on a Unix socket, for local tools authenticated by
+	// their user
+	var unixServer *grpc.Server
+	if authConfig.UnixSocket != "" {
+		unixListener, err := listenUnix(authConfig.UnixSocket)
+		if err != nil {
+			log.Fatalf("Failed to listen on %s: %v", authConfig.UnixSocket, err)
+		}
+		unixServer = grpc.NewServer(append(authConfig.serverOptions(ListenerUnix), grpcOptions...)...)
+		pb.RegisterFirewallControlServer(unixServer, server)
+		reflection.Register(unixServer)
+		go func() {
+			if err := unixServer.Serve(unixListener); err != nil {
+				log.Printf("Unix socket server failed: %v", err)
+			}
+		}()
+	}
+
+	// REST endpoints for tooling that does not speak gRPC
+	restHandler = authConfig.authREST(restHandler)
+	restServer := &http.Server{Addr: restPort, Handler: restHandler, TLSConfig: authConfig.TLS}
+	go func() {
+		var err error
+		if authConfig.TLS != nil {
+			err = restServer.ListenAndServeTLS("", "")
+		} else {
+			err = restServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Printf("REST server failed: %v", err)
+		}
+	}()
 
 	// Handle graceful shutdown
 	go func() {
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 		<-sigChan
-		
+
 		log.Println("Shutting down server...")
-		os.Exit(0)
+		stopWatch()
+		server.events.Close()
+		if server.history != nil {
+			if err := server.history.Save(); err != nil {
+				log.Printf("Failed to save statistics history: %v", err)
+			}
+		}
+		if bpfManager != nil && bpfManager.counters != nil {
+			if _, err := bpfManager.GetStats(); err != nil {
+				log.Printf("Failed to read counters for snapshot: %v", err)
+			}
+			if err := bpfManager.counters.Save(); err != nil {
+				log.Printf("Failed to save counter snapshot: %v", err)
+			}
+		}
+		if server.flowArchive != nil {
+			if err := server.flowArchive.Flush(); err != nil {
+				log.Printf("Failed to save flow archive: %v", err)
+			}
+		}
+		if server.eventArchive != nil {
+			if err := server.eventArchive.Close(); err != nil {
+				log.Printf("Failed to save event archive: %v", err)
+			}
+		}
+		if server.usage != nil {
+			if err := server.usage.Save(); err != nil {
+				log.Printf("Failed to save usage: %v", err)
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		restServer.Shutdown(ctx)
+		if unixServer != nil {
+			unixServer.GracefulStop()
+		}
+		grpcServer.GracefulStop()
 	}()
 
-	log.Printf("Test server listening on %s", gRPCPort)
+	log.Printf("gRPC server listening on %s", gRPCPort)
 	log.Println("Available endpoints:")
-	log.Println("  - http://localhost:50051/health")
-	log.Println("  - http://localhost:50051/stats") 
-	log.Println("  - http://localhost:50051/rules")
+	log.Println("  - grpc://localhost:50051 (cerberus.v1.FirewallControl)")
+	if authConfig.UnixSocket != "" {
+		log.Printf("  - unix://%s (cerberus.v1.FirewallControl)", authConfig.UnixSocket)
+	}
+	log.Println("  - http://localhost:50052/health")
+	log.Println("  - http://localhost:50052/stats?namespace=<name>")
+	log.Println("  - http://localhost:50052/rules?namespace=<name>&label=<selector>")
+	log.Println("  - http://localhost:50052/namespaces")
+	log.Println("  - http://localhost:50052/sriov")
+	log.Println("  - http://localhost:50052/sriov/stats?pf=<pf>&vf=<n>")
+	log.Println("  - http://localhost:50052/offload")
+	log.Println("  - http://localhost:50052/connections?scope=<scope>&limit=<n>")
+	log.Println("  - http://localhost:50052/stats/history?start=<unix>&end=<unix>&resolution=minute|hour|day")
+	log.Println("  - http://localhost:50052/stats/extensions (GET extension metrics, POST a companion binary's snapshot)")
+	log.Println("  - http://localhost:50052/flows/aggregate?group_by=src_prefix,dst_port,src_country&bucket_seconds=<n>&limit=<k>")
+	log.Println("  - PUT http://localhost:50052/rules (replace the rule set)")
+	log.Println("  - PUT http://localhost:50052/rules/{id}")
+	log.Println("  - POST http://localhost:50052/rules/reorder (set priorities in bulk)")
+	log.Println("  - POST http://localhost:50052/rules/by-label/delete|disable|enable?selector=<selector>")
+	log.Println("  - http://localhost:50052/rules/search?q=<text>&limit=<n>")
+	log.Println("  - http://localhost:50052/rules/analyze (POST a policy document to analyze a candidate)")
+	log.Println("  - POST http://localhost:50052/rules/import (bulk import, ?format=json|yaml for rule documents)")
+	log.Println("  - http://localhost:50052/rules/export?format=json|yaml|nft")
+	log.Println("  - POST http://localhost:50052/rules/import/nftables (nft -j list ruleset, ?dry_run=true)")
+	log.Println("  - POST http://localhost:50052/rules/import/iptables (iptables-save | curl --data-binary @-, ?ipv6=true&dry_run=true)")
+	log.Println("  - http://localhost:50052/services[/{name}] (PUT or DELETE a service object)")
+	log.Println("  - http://localhost:50052/addresses[/{name}] (PUT or DELETE an address object)")
+	log.Println("  - http://localhost:50052/temporary-allow (POST to grant an operator host time-boxed access)")
+	log.Println("  - http://localhost:50052/asn-blocks (POST {asn, direction} to drop traffic from or to an ASN's prefixes)")
+	log.Println("  - http://localhost:50052/default-policy (PUT {interface, direction, action} to set it)")
+	log.Println("  - http://localhost:50052/protection[/profiles/{name}] (PUT or DELETE a protection profile)")
+	log.Println("  - http://localhost:50052/protection/destinations/{destination} (PUT {profile} to attach, DELETE to detach)")
+	log.Println("  - http://localhost:50052/tunnels[/{name}] (PUT or DELETE a terminated GRE/IPIP tunnel)")
+	log.Println("  - http://localhost:50052/multicast (joined multicast groups and their counters)")
+	log.Println("  - http://localhost:50052/nat64/prefixes (GET, PUT or DELETE ?prefix= NAT64 prefixes with their counters)")
+	log.Println("  - http://localhost:50052/nat64/sessions (open NAT64 sessions, ?limit=)")
+	log.Println("  - http://localhost:50052/nat/rules (GET, POST or DELETE ?id= DNAT, SNAT and masquerade rules with their counters)")
+	log.Println("  - http://localhost:50052/nat/sessions (open SNAT sessions, ?limit= and ?rule_id=)")
+	log.Println("  - http://localhost:50052/dns/lists (GET, PUT or DELETE ?name= DNS filter domain lists with their counters)")
+	log.Println("  - http://localhost:50052/tls/fingerprints (GET, PUT or DELETE ?name= JA3/JA4 fingerprint rules with their counters)")
+	log.Println("  - http://localhost:50052/mitigation (GET mitigated destinations and counters, PUT the SYN flood mitigation config)")
+	log.Println("  - http://localhost:50052/source-limits (GET counters and exemptions, PUT the per-source rate limits)")
+	log.Println("  - http://localhost:50052/source-limits/offenders (sources with the most packets dropped, ?limit=&seconds=)")
+	log.Println("  - http://localhost:50052/source-limits/exemptions (POST to exempt a source, DELETE ?address= to end it)")
+	log.Println("  - http://localhost:50052/auto-block (GET blocks in effect, PUT the automatic blocking thresholds)")
+	log.Println("  - http://localhost:50052/auto-block/blocks (DELETE ?address= to release a block)")
+	log.Println("  - http://localhost:50052/auto-block/replay (POST to replay archived events with other thresholds)")
+	log.Println("  - http://localhost:50052/port-scan (GET sources scanning, PUT the port scan threshold and quarantine)")
+	log.Println("  - http://localhost:50052/normalization (GET packets dropped by reason, PUT the bogon, checksum and TCP flag checks)")
+	log.Println("  - http://localhost:50052/interfaces[/{name}] (PUT or DELETE an interface's MSS clamping and ingress policer, GET lists them with their counters)")
+	log.Println("  - http://localhost:50052/shadow-policy (GET verdict disagreements, POST a candidate policy, DELETE to stop)")
+	log.Println("  - http://localhost:50052/ipsets[/{id}[/entries]] (POST to create or add, DELETE to remove)")
+	log.Println("  - http://localhost:50052/feeds (threat intelligence feeds, their freshness and entry counts)")
+	log.Println("  - http://localhost:50052/federation (exported baseline, subscribed sites and their overrides)")
+	log.Println("  - http://localhost:50052/databases (GeoIP and ASN database downloads, POST /databases/rollback?name= to revert)")
+	log.Println("  - http://localhost:50052/docs?format=markdown|html")
+	log.Println("  - http://localhost:50052/usage?format=json|csv")
+	log.Println("  - http://localhost:50052/reports/digest?schedule=daily|weekly (POST to email it now)")
+	log.Println("  - http://localhost:50052/degradation")
+	log.Println("  - http://localhost:50052/slo")
+	log.Println("  - http://localhost:50052/bloom?scope=<scope>")
+	log.Println("  - http://localhost:50052/shards (POST to rebalance the rule shards)")
+	log.Println("  - http://localhost:50052/pipeline?scope=<scope> (POST to enable, disable or upgrade a stage)")
+	log.Println("  - http://localhost:50052/compiled?stage=resolved|expanded|sorted|encoded")
+	log.Println("  - http://localhost:50052/explain?event_id=<id> (why a sampled packet was dropped)")
+	log.Println("  - http://localhost:50052/errors[/<code>] (error codes and their remediation)")
+	log.Println("  - http://localhost:50052/events?types=RULE_ADDED,PACKET_DROP (SSE)")
+	log.Println("  - http://localhost:50052/events?filter=severity>=medium%20%26%26%20src_ip%20in%2010.0.0.0/8 (SSE, filtered server-side)")
+	log.Println("  - http://localhost:50052/events?anonymize=true, /connections?anonymize=true (anonymized for sharing)")
 	log.Println("  - http://localhost:8080/metrics (Prometheus)")
-	
-	if err := http.ListenAndServe(gRPCPort, nil); err != nil {
+
+	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
-} 
\ No newline at end of file
+}
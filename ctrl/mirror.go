@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Mirror action: copies of the packets mirror rules pass, sent out of a
+// capture interface. An AF_XDP capture reads them from that interface,
+// e.g. the far end of a veth pair.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+)
+
+// MirrorStatsMapName is the pinned mirror counters map (must match eBPF
+// program)
+const MirrorStatsMapName = "cerberus_mirror_stats"
+
+// Mirror counter indexes (enum mirror_stat in the eBPF program)
+const (
+	mirrorSent   = 0
+	mirrorFailed = 1
+)
+
+// MirrorStats counts the copies of mirror rules
+type MirrorStats struct {
+	Sent   uint64 `json:"sent"`
+	Failed uint64 `json:"failed"` // No metadata room or the interface is gone
+}
+
+// mirrorIfindex resolves the interface a mirror rule copies packets to, 0
+// when it no longer exists: the rule then only passes its packets
+func mirrorIfindex(name string) uint32 {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		log.Printf("⚠️  Mirror interface %s not found, packets are not copied: %v", name, err)
+		return 0
+	}
+	return uint32(iface.Index)
+}
+
+// attachMirror attaches the program sending mirror copies next to XDP on
+// an interface. Without it mirror rules only pass their packets there.
+func (bm *BPFManager) attachMirror(ifindex int, interfaceName string) {
+	if bm.tcMirror == nil {
+		return
+	}
+	filter, err := attachMirrorFilter(ifindex, bm.tcMirror)
+	if err != nil {
+		log.Printf("⚠️  Mirror rules will not copy packets on %s: %v", interfaceName, err)
+		return
+	}
+	bm.tcFilters = append(bm.tcFilters, filter)
+	log.Printf("✅ TC mirror program attached to interface %s", interfaceName)
+}
+
+// MirrorStats reads the mirror counters, summed across CPUs
+func (bm *BPFMapManager) MirrorStats() (MirrorStats, error) {
+	var stats MirrorStats
+	if bm.simulated || bm.mirrors == nil {
+		return stats, nil
+	}
+	for index, counter := range map[uint32]*uint64{mirrorSent: &stats.Sent, mirrorFailed: &stats.Failed} {
+		var perCPU []uint64
+		if err := bm.mirrors.Lookup(&index, &perCPU); err != nil {
+			return stats, fmt.Errorf("failed to read mirror counters: %v", err)
+		}
+		for _, value := range perCPU {
+			*counter += value
+		}
+	}
+	return stats, nil
+}
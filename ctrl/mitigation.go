@@ -0,0 +1,517 @@
+// SPDX-License-Identifier: Apache-2.0
+// SYN flood mitigation: destinations receiving SYNs above a threshold are
+// rate limited or verified with SYN cookies by the XDP program
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned SYN flood maps (must match eBPF program)
+	SYNFloodConfigMapName   = "cerberus_synflood_config"
+	SYNFloodDestsMapName    = "cerberus_synflood_dests"
+	SYNFloodVerifiedMapName = "cerberus_synflood_verified"
+	SYNFloodStatsMapName    = "cerberus_synflood_stats"
+
+	// Defaults of the zero settings of a mitigation config
+	DefaultSYNFloodThreshold       = 1000
+	DefaultSYNFloodHoldSeconds     = 60
+	DefaultSYNFloodRate            = 100
+	DefaultSYNFloodVerifiedSeconds = 300
+
+	// Longest hold and verification
+	MaxSYNFloodSeconds = 24 * 60 * 60
+
+	// How often mitigated destinations are looked for, to publish events
+	mitigationPollInterval = 2 * time.Second
+)
+
+// Mitigation modes
+const (
+	MitigationOff       = "off"
+	MitigationRateLimit = "rate_limit"
+	MitigationSYNCookie = "syn_cookie"
+)
+
+// SYN flood events
+const (
+	EventSYNFloodDetected = "SYN_FLOOD_DETECTED"
+	EventSYNFloodEnded    = "SYN_FLOOD_ENDED"
+)
+
+// Data plane codes of the modes (enum synflood_mode in the eBPF program)
+var mitigationModeCodes = map[string]uint8{
+	MitigationOff:       0,
+	MitigationRateLimit: 1,
+	MitigationSYNCookie: 2,
+}
+
+// MitigationConfig is how SYN floods are detected and mitigated. A
+// destination address receiving more than SYNThreshold new SYNs in a
+// second is mitigated until HoldSeconds after that last happened: its new
+// SYNs are rate limited to SYNRate, or answered with SYN cookies that only
+// a real source returns.
+type MitigationConfig struct {
+	Mode            string `json:"mode"`
+	SYNThreshold    uint32 `json:"syn_threshold"`
+	HoldSeconds     uint32 `json:"hold_seconds"`
+	SYNRate         uint32 `json:"syn_rate"`
+	SYNBurst        uint32 `json:"syn_burst"` // 0 = SYNRate
+	VerifiedSeconds uint32 `json:"verified_seconds"`
+}
+
+// synFloodConfig mirrors struct synflood_config in the eBPF program
+type synFloodConfig struct {
+	Mode       uint8
+	Pad        [3]uint8
+	Threshold  uint32
+	SYNRate    uint32
+	SYNBurst   uint32
+	HoldNs     uint64
+	VerifiedNs uint64
+	Secret     [2]uint64
+}
+
+// synFloodKey mirrors struct synflood_key in the eBPF program
+type synFloodKey struct {
+	Addr   [16]byte // IPv4 uses the first 4 bytes
+	Family uint8
+	Pad    [3]uint8
+}
+
+// synFloodState mirrors struct synflood_state in the eBPF program
+type synFloodState struct {
+	Window    uint64
+	Count     uint64
+	Rate      uint64
+	Since     uint64 // CLOCK_MONOTONIC nanoseconds, 0 = never mitigated
+	Until     uint64
+	SYNTokens uint64
+	SYNRefill uint64
+	SYNs      uint64
+	Dropped   uint64
+	Cookies   uint64
+	Verified  uint64
+}
+
+// synFloodStats mirrors struct synflood_stats in the eBPF program
+type synFloodStats struct {
+	SYNs     uint64
+	Dropped  uint64
+	Cookies  uint64
+	Verified uint64
+	Invalid  uint64
+}
+
+// synFloodDestination is a destination the data plane counts SYNs of
+type synFloodDestination struct {
+	key   synFloodKey
+	state synFloodState
+}
+
+func (key synFloodKey) addr() net.IP {
+	if key.Family == familyIPv4 {
+		return net.IP(key.Addr[:4])
+	}
+	return net.IP(key.Addr[:])
+}
+
+// mitigated reports whether the destination is mitigated at now
+func (d *synFloodDestination) mitigated(now uint64) bool {
+	return d.state.Since != 0 && d.state.Until >= now
+}
+
+// defaultMitigationConfig is mitigation off with the default settings
+func defaultMitigationConfig() MitigationConfig {
+	config := MitigationConfig{Mode: MitigationOff}
+	validateMitigationConfig(&config)
+	return config
+}
+
+// validateMitigationConfig checks a config and fills in the defaults of
+// its zero settings; an empty mode is off
+func validateMitigationConfig(config *MitigationConfig) error {
+	var errs ruleValidationError
+	if config.Mode == "" {
+		config.Mode = MitigationOff
+	}
+	if _, ok := mitigationModeCodes[config.Mode]; !ok {
+		errs.add("mode", "invalid mode: %s, expected %s, %s or %s", config.Mode, MitigationOff, MitigationRateLimit, MitigationSYNCookie)
+	}
+	if config.SYNThreshold > MaxProtectionSYNRate {
+		errs.add("syn_threshold", "syn_threshold must be at most %d", MaxProtectionSYNRate)
+	}
+	if config.SYNRate > MaxProtectionSYNRate {
+		errs.add("syn_rate", "syn_rate must be at most %d", MaxProtectionSYNRate)
+	}
+	if config.SYNBurst > MaxProtectionSYNRate {
+		errs.add("syn_burst", "syn_burst must be at most %d", MaxProtectionSYNRate)
+	}
+	if config.HoldSeconds > MaxSYNFloodSeconds {
+		errs.add("hold_seconds", "hold_seconds must be at most %d", MaxSYNFloodSeconds)
+	}
+	if config.VerifiedSeconds > MaxSYNFloodSeconds {
+		errs.add("verified_seconds", "verified_seconds must be at most %d", MaxSYNFloodSeconds)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if config.SYNThreshold == 0 {
+		config.SYNThreshold = DefaultSYNFloodThreshold
+	}
+	if config.HoldSeconds == 0 {
+		config.HoldSeconds = DefaultSYNFloodHoldSeconds
+	}
+	if config.SYNRate == 0 {
+		config.SYNRate = DefaultSYNFloodRate
+	}
+	if config.SYNBurst == 0 {
+		config.SYNBurst = config.SYNRate
+	}
+	if config.VerifiedSeconds == 0 {
+		config.VerifiedSeconds = DefaultSYNFloodVerifiedSeconds
+	}
+	return nil
+}
+
+// encode converts a config to the data plane's with the cookie secret
+func (config MitigationConfig) encode(secret [2]uint64) synFloodConfig {
+	return synFloodConfig{
+		Mode:       mitigationModeCodes[config.Mode],
+		Threshold:  config.SYNThreshold,
+		SYNRate:    config.SYNRate,
+		SYNBurst:   config.SYNBurst,
+		HoldNs:     uint64(config.HoldSeconds) * uint64(time.Second),
+		VerifiedNs: uint64(config.VerifiedSeconds) * uint64(time.Second),
+		Secret:     secret,
+	}
+}
+
+// SetMitigationConfig replaces the SYN flood mitigation config
+func (s *Server) SetMitigationConfig(ctx context.Context, req *pb.SetMitigationConfigRequest) (*pb.MitigationStatusResponse, error) {
+	if req.GetConfig() == nil {
+		return &pb.MitigationStatusResponse{Success: false, Message: "Mitigation config is required"}, nil
+	}
+
+	config := mitigationConfigFromProto(req.Config)
+	if err := validateMitigationConfig(&config); err != nil {
+		return &pb.MitigationStatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Mitigation config validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	if err := s.pushMitigation(config); err != nil {
+		s.mutex.Unlock()
+		return &pb.MitigationStatusResponse{Success: false, Message: fmt.Sprintf("Failed to push mitigation config to data plane: %v", withRemediation(err))}, nil
+	}
+	s.mitigation = config
+	s.persistPolicy()
+	s.mutex.Unlock()
+	log.Printf("Set SYN flood mitigation: %s (threshold %d SYN/s, hold %ds)", config.Mode, config.SYNThreshold, config.HoldSeconds)
+
+	resp := s.mitigationStatus()
+	resp.Success = true
+	resp.Message = "Mitigation config saved successfully"
+	return resp, nil
+}
+
+// GetMitigationStatus returns the mitigation config, the destinations
+// mitigated now and the counters
+func (s *Server) GetMitigationStatus(ctx context.Context, req *pb.Empty) (*pb.MitigationStatusResponse, error) {
+	resp := s.mitigationStatus()
+	resp.Success = true
+	return resp, nil
+}
+
+// mitigationStatus returns the config, the destinations mitigated now
+// ordered by address, and the data plane's counters
+func (s *Server) mitigationStatus() *pb.MitigationStatusResponse {
+	s.mutex.RLock()
+	manager, config := s.bpfManager, s.mitigation
+	s.mutex.RUnlock()
+
+	resp := &pb.MitigationStatusResponse{Config: mitigationConfigToProto(config)}
+	if manager == nil {
+		return resp
+	}
+	stats, err := manager.SYNFloodStats()
+	if err != nil {
+		log.Printf("⚠️  Failed to read SYN flood counters: %v", err)
+	}
+	resp.Syns = stats.SYNs
+	resp.Dropped = stats.Dropped
+	resp.Cookies = stats.Cookies
+	resp.Verified = stats.Verified
+	resp.InvalidCookies = stats.Invalid
+
+	dests, err := manager.SYNFloodDestinations()
+	if err != nil {
+		log.Printf("⚠️  Failed to read SYN flood destinations: %v", err)
+	}
+	wall, now := s.clock.Now(), monotonicNow()
+	for i := range dests {
+		if dests[i].mitigated(now) {
+			resp.Destinations = append(resp.Destinations, dests[i].toProto(wall, now))
+		}
+	}
+	sort.Slice(resp.Destinations, func(i, j int) bool {
+		return resp.Destinations[i].Address < resp.Destinations[j].Address
+	})
+	return resp
+}
+
+// pushMitigation writes a config to the host data plane, drawing the
+// cookie secret on first use. Caller must hold s.mutex.
+func (s *Server) pushMitigation(config MitigationConfig) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	if s.mitigationSecret == [2]uint64{} {
+		var secret [16]byte
+		if _, err := rand.Read(secret[:]); err != nil {
+			return fmt.Errorf("failed to draw SYN cookie secret: %v", err)
+		}
+		s.mitigationSecret = [2]uint64{binary.LittleEndian.Uint64(secret[:8]), binary.LittleEndian.Uint64(secret[8:])}
+	}
+	return s.bpfManager.SetSYNFlood(config.encode(s.mitigationSecret))
+}
+
+// monitorMitigation publishes SYN_FLOOD_DETECTED when a destination
+// starts being mitigated and SYN_FLOOD_ENDED when it stops, looking every
+// interval until ctx is done
+func (s *Server) monitorMitigation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	mitigated := make(map[synFloodKey]synFloodState) // As last seen mitigated
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.mutex.RLock()
+		manager, mode := s.bpfManager, s.mitigation.Mode
+		s.mutex.RUnlock()
+		if manager == nil || mode == MitigationOff && len(mitigated) == 0 {
+			continue
+		}
+		dests, err := manager.SYNFloodDestinations()
+		if err != nil {
+			log.Printf("⚠️  Failed to read SYN flood destinations: %v", err)
+			continue
+		}
+
+		wall, now := s.clock.Now(), monotonicNow()
+		seen := make(map[synFloodKey]bool)
+		for i := range dests {
+			dest := &dests[i]
+			if !dest.mitigated(now) {
+				continue
+			}
+			seen[dest.key] = true
+			if previous, known := mitigated[dest.key]; !known || previous.Since != dest.state.Since {
+				s.events.Publish(dest.event(EventSYNFloodDetected, mode, wall, now))
+			}
+			mitigated[dest.key] = dest.state
+		}
+		for key, state := range mitigated {
+			if seen[key] {
+				continue
+			}
+			// Evicted destinations end with their last counters
+			dest := &synFloodDestination{key: key, state: state}
+			for i := range dests {
+				if dests[i].key == key {
+					dest = &dests[i]
+				}
+			}
+			s.events.Publish(dest.event(EventSYNFloodEnded, mode, wall, now))
+			delete(mitigated, key)
+		}
+	}
+}
+
+// wallTime converts a data plane timestamp to wall clock time; wall is the
+// clock time at monotonic time now
+func wallTime(wall time.Time, timestamp, now uint64) time.Time {
+	if now > timestamp {
+		return wall.Add(-time.Duration(now - timestamp))
+	}
+	return wall.Add(time.Duration(timestamp - now))
+}
+
+func (d *synFloodDestination) toProto(wall time.Time, now uint64) *pb.MitigatedDestination {
+	return &pb.MitigatedDestination{
+		Address:  d.key.addr().String(),
+		SynRate:  d.state.Rate,
+		Since:    wallTime(wall, d.state.Since, now).Unix(),
+		Until:    wallTime(wall, d.state.Until, now).Unix(),
+		Syns:     d.state.SYNs,
+		Dropped:  d.state.Dropped,
+		Cookies:  d.state.Cookies,
+		Verified: d.state.Verified,
+	}
+}
+
+// event converts a destination starting or ending mitigation to a
+// SYN_FLOOD_DETECTED or SYN_FLOOD_ENDED event
+func (d *synFloodDestination) event(eventType, mode string, wall time.Time, now uint64) *pb.Event {
+	addr := d.key.addr().String()
+	since := wallTime(wall, d.state.Since, now)
+	event := &pb.Event{
+		Type:      eventType,
+		Timestamp: wall.Unix(),
+		Target:    addr,
+		Protocol:  "tcp",
+		Metadata: map[string]string{
+			"mode":     mode,
+			"syn_rate": strconv.FormatUint(d.state.Rate, 10),
+			"since":    since.Format(time.RFC3339),
+			"dropped":  strconv.FormatUint(d.state.Dropped, 10),
+			"cookies":  strconv.FormatUint(d.state.Cookies, 10),
+			"verified": strconv.FormatUint(d.state.Verified, 10),
+		},
+	}
+	if eventType == EventSYNFloodDetected {
+		event.Severity = "high"
+		event.Message = fmt.Sprintf("SYN flood to %s (%d SYN/s), mitigating with %s", addr, d.state.Rate, mode)
+	} else {
+		event.Severity = "medium"
+		event.Message = fmt.Sprintf("SYN flood to %s ended after %s", addr, wall.Sub(since).Round(time.Second))
+	}
+	return event
+}
+
+func mitigationConfigFromProto(config *pb.MitigationConfig) MitigationConfig {
+	return MitigationConfig{
+		Mode:            config.Mode,
+		SYNThreshold:    config.SynThreshold,
+		HoldSeconds:     config.HoldSeconds,
+		SYNRate:         config.SynRate,
+		SYNBurst:        config.SynBurst,
+		VerifiedSeconds: config.VerifiedSeconds,
+	}
+}
+
+func mitigationConfigToProto(config MitigationConfig) *pb.MitigationConfig {
+	return &pb.MitigationConfig{
+		Mode:            config.Mode,
+		SynThreshold:    config.SYNThreshold,
+		HoldSeconds:     config.HoldSeconds,
+		SynRate:         config.SYNRate,
+		SynBurst:        config.SYNBurst,
+		VerifiedSeconds: config.VerifiedSeconds,
+	}
+}
+
+// openSYNFlood opens the pinned SYN flood maps and turns mitigation off
+// until the stored config is re-pushed on restore. Verified sources stay
+// verified.
+func (bm *BPFMapManager) openSYNFlood() {
+	names := []string{SYNFloodConfigMapName, SYNFloodDestsMapName, SYNFloodVerifiedMapName, SYNFloodStatsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  SYN flood mitigation not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.synFloodConfig, bm.synFloodDests, bm.synFloodVerified, bm.synFloodStats = maps[0], maps[1], maps[2], maps[3]
+
+	if err := bm.SetSYNFlood(synFloodConfig{}); err != nil {
+		log.Printf("⚠️  Failed to reset SYN flood mitigation: %v", err)
+	}
+}
+
+// closeSYNFlood closes the SYN flood maps, if open
+func (bm *BPFMapManager) closeSYNFlood() {
+	if bm.synFloodConfig == nil {
+		return
+	}
+	bm.synFloodConfig.Close()
+	bm.synFloodDests.Close()
+	bm.synFloodVerified.Close()
+	bm.synFloodStats.Close()
+}
+
+// SetSYNFlood writes the SYN flood mitigation config
+func (bm *BPFMapManager) SetSYNFlood(config synFloodConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting SYN flood mitigation (mode %d, threshold %d SYN/s)", config.Mode, config.Threshold)
+		return nil
+	}
+	if bm.synFloodConfig == nil {
+		return fmt.Errorf("SYN flood maps not available")
+	}
+	key := uint32(0)
+	if err := bm.synFloodConfig.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write SYN flood mitigation config: %v", err)
+	}
+	return nil
+}
+
+// SYNFloodDestinations reads the destinations the data plane counts SYNs
+// of and has mitigated at some point
+func (bm *BPFMapManager) SYNFloodDestinations() ([]synFloodDestination, error) {
+	if bm.simulated || bm.synFloodDests == nil {
+		return nil, nil
+	}
+	var dests []synFloodDestination
+	var dest synFloodDestination
+	entries := bm.synFloodDests.Iterate()
+	for entries.Next(&dest.key, &dest.state) {
+		if dest.state.Since != 0 {
+			dests = append(dests, dest)
+		}
+	}
+	if err := entries.Err(); err != nil {
+		return dests, err
+	}
+	return dests, nil
+}
+
+// SYNFloodStats reads the SYN flood counters, summed across CPUs
+func (bm *BPFMapManager) SYNFloodStats() (synFloodStats, error) {
+	var stats synFloodStats
+	if bm.simulated || bm.synFloodStats == nil {
+		return stats, nil
+	}
+	var perCPU []synFloodStats
+	key := uint32(0)
+	if err := bm.synFloodStats.Lookup(&key, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.SYNs += value.SYNs
+		stats.Dropped += value.Dropped
+		stats.Cookies += value.Cookies
+		stats.Verified += value.Verified
+		stats.Invalid += value.Invalid
+	}
+	return stats, nil
+}
@@ -0,0 +1,400 @@
+// SPDX-License-Identifier: Apache-2.0
+// Multicast: group address validation for rules, IGMP/MLD membership
+// snooping and per-group counters
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Pinned multicast maps (must match eBPF program)
+const (
+	McastStatsMapName   = "cerberus_mcast_stats"
+	McastReportsMapName = "cerberus_mcast_reports"
+)
+
+// Bytes of each membership message the data plane copies, from its IP
+// header (must match MCAST_QUOTE_MAX in eBPF program)
+const mcastQuoteMax = 256
+
+// membershipTimeout is how long a member stays joined without reporting
+// again: the default Group Membership Interval of IGMPv3 and Multicast
+// Address Listening Interval of MLDv2
+const membershipTimeout = 260 * time.Second
+
+// Multicast address ranges
+var (
+	multicastPrefix4 = netip.MustParsePrefix("224.0.0.0/4")
+	multicastPrefix6 = netip.MustParsePrefix("ff00::/8")
+)
+
+// mcastKey mirrors struct mcast_key in ebpf/xdp_filter.c
+type mcastKey struct {
+	Addr   [16]byte // IPv4 uses the first 4 bytes
+	Family uint8
+	Pad    [3]uint8
+}
+
+// mcastStats mirrors struct mcast_stats in ebpf/xdp_filter.c
+type mcastStats struct {
+	Packets uint64
+	Bytes   uint64
+	Dropped uint64
+}
+
+// mcastReport mirrors struct mcast_report in ebpf/xdp_filter.c
+type mcastReport struct {
+	Ifindex uint32
+	Len     uint16 // Bytes of Packet copied
+	Family  uint8
+	Egress  uint8 // Sent by the host itself
+	Packet  [mcastQuoteMax]byte
+}
+
+// membershipChange is one group joined or left by a membership message
+type membershipChange struct {
+	Group netip.Addr
+	Join  bool
+}
+
+// multicastMember is a host that reported membership of a group
+type multicastMember struct {
+	Ifindex    uint32
+	Protocol   string // "igmp" or "mld"
+	Version    int
+	LastReport time.Time
+	Local      bool
+}
+
+// MulticastSnooper tracks the groups hosts join and leave from the IGMP
+// and MLD messages the data plane passes, the way a snooping switch does.
+// It only observes: forwarding is decided by the rules.
+type MulticastSnooper struct {
+	manager *BPFMapManager
+	clock   Clock // Time reports are recorded at
+
+	mutex  sync.Mutex
+	groups map[netip.Addr]map[netip.Addr]*multicastMember // Members by group and reporter
+}
+
+// NewMulticastSnooper creates a snooper for a data plane
+func NewMulticastSnooper(manager *BPFMapManager, clock Clock) *MulticastSnooper {
+	return &MulticastSnooper{
+		manager: manager,
+		clock:   clock,
+		groups:  make(map[netip.Addr]map[netip.Addr]*multicastMember),
+	}
+}
+
+// ListMulticastGroups returns the groups with members or counted packets,
+// ordered by address
+func (s *Server) ListMulticastGroups(ctx context.Context, req *pb.Empty) (*pb.MulticastGroupsResponse, error) {
+	resp := &pb.MulticastGroupsResponse{}
+	if s.multicast == nil {
+		return resp, nil
+	}
+	resp.Groups = s.multicast.Groups(s.clock.Now())
+	return resp, nil
+}
+
+// Run tracks membership messages until ctx is done. It returns at once
+// when the data plane has no multicast maps.
+func (ms *MulticastSnooper) Run(ctx context.Context) {
+	if ms.manager == nil || ms.manager.mcastReports == nil {
+		return
+	}
+
+	records, cancel, err := ms.manager.rings.Consume(McastReportsMapName, ms.manager.mcastReports, "multicast")
+	if err != nil {
+		log.Printf("Failed to open multicast report ring buffer: %v", err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	for record := range records {
+		var report mcastReport
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &report); err != nil {
+			log.Printf("Malformed multicast report: %v", err)
+			continue
+		}
+		ms.record(&report, ms.clock.Now())
+	}
+}
+
+// record applies one membership message seen at now
+func (ms *MulticastSnooper) record(report *mcastReport, now time.Time) {
+	packet := report.Packet[:min(int(report.Len), mcastQuoteMax)]
+	reporter, protocol, version, changes := parseMembership(packet)
+	if !reporter.IsValid() {
+		return
+	}
+
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	for _, change := range changes {
+		members := ms.groups[change.Group]
+		if !change.Join {
+			delete(members, reporter)
+			if len(members) == 0 {
+				delete(ms.groups, change.Group)
+			}
+			continue
+		}
+		if members == nil {
+			members = make(map[netip.Addr]*multicastMember)
+			ms.groups[change.Group] = members
+		}
+		members[reporter] = &multicastMember{
+			Ifindex:    report.Ifindex,
+			Protocol:   protocol,
+			Version:    version,
+			LastReport: now,
+			Local:      report.Egress != 0,
+		}
+	}
+}
+
+// Groups returns the groups with members or counted packets at now,
+// forgetting members that stopped reporting
+func (ms *MulticastSnooper) Groups(now time.Time) []*pb.MulticastGroup {
+	groups := make(map[netip.Addr]*pb.MulticastGroup)
+	group := func(addr netip.Addr) *pb.MulticastGroup {
+		if groups[addr] == nil {
+			groups[addr] = &pb.MulticastGroup{Group: addr.String()}
+		}
+		return groups[addr]
+	}
+
+	ms.mutex.Lock()
+	for addr, members := range ms.groups {
+		for reporter, member := range members {
+			if now.Sub(member.LastReport) > membershipTimeout {
+				delete(members, reporter)
+				continue
+			}
+			entry := group(addr)
+			entry.Members = append(entry.Members, &pb.MulticastMember{
+				Address:    reporter.String(),
+				Interface:  interfaceName(member.Ifindex),
+				Protocol:   member.Protocol,
+				Version:    int32(member.Version),
+				LastReport: member.LastReport.Unix(),
+				Local:      member.Local,
+			})
+		}
+		if len(members) == 0 {
+			delete(ms.groups, addr)
+		}
+	}
+	ms.mutex.Unlock()
+
+	if ms.manager != nil {
+		stats, err := ms.manager.MulticastStats()
+		if err != nil {
+			log.Printf("⚠️  Failed to read multicast counters: %v", err)
+		}
+		for addr, counters := range stats {
+			entry := group(addr)
+			entry.Packets, entry.Bytes, entry.Dropped = counters.Packets, counters.Bytes, counters.Dropped
+		}
+	}
+
+	addrs := make([]netip.Addr, 0, len(groups))
+	for addr, entry := range groups {
+		addrs = append(addrs, addr)
+		sort.Slice(entry.Members, func(i, j int) bool { return entry.Members[i].Address < entry.Members[j].Address })
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].Less(addrs[j]) })
+	resp := make([]*pb.MulticastGroup, len(addrs))
+	for i, addr := range addrs {
+		resp[i] = groups[addr]
+	}
+	return resp
+}
+
+// parseMembership decodes an IGMP or MLD membership report or leave given
+// from its IP header. The reporter is invalid for other packets; group
+// records past the copied bytes are ignored.
+func parseMembership(packet []byte) (reporter netip.Addr, protocol string, version int, changes []membershipChange) {
+	var message []byte
+	var groupLen int
+	switch {
+	case len(packet) >= 20 && packet[0]>>4 == 4 && packet[9] == 2:
+		header := int(packet[0]&0x0f) * 4
+		if header < 20 || len(packet) < header+8 {
+			return
+		}
+		reporter, protocol, groupLen = netip.AddrFrom4([4]byte(packet[12:16])), "igmp", 4
+		message = packet[header:]
+	case len(packet) >= 40 && packet[0]>>4 == 6:
+		next, offset := packet[6], 40
+		if next == 0 && len(packet) >= offset+2 {
+			// Hop-by-hop options carrying the router alert
+			next, offset = packet[offset], offset+(int(packet[offset+1])+1)*8
+		}
+		if next != 58 || len(packet) < offset+8 {
+			return
+		}
+		reporter, protocol, groupLen = netip.AddrFrom16([16]byte(packet[8:24])), "mld", 16
+		message = packet[offset:]
+	default:
+		return
+	}
+
+	group := func(at int) (netip.Addr, bool) {
+		if len(message) < at+groupLen {
+			return netip.Addr{}, false
+		}
+		addr, _ := netip.AddrFromSlice(message[at : at+groupLen])
+		return addr, addr.IsMulticast()
+	}
+	// IGMPv1/v2 messages carry their group after 4 bytes, MLDv1 ones
+	// after 8
+	single := 4
+	if protocol == "mld" {
+		single = 8
+	}
+	switch message[0] {
+	case 0x12, 0x16, 131: // IGMPv1/v2 and MLDv1 reports
+		version = map[byte]int{0x12: 1, 0x16: 2, 131: 1}[message[0]]
+		if addr, ok := group(single); ok {
+			changes = append(changes, membershipChange{Group: addr, Join: true})
+		}
+	case 0x17, 132: // IGMPv2 leave and MLDv1 done
+		version = map[byte]int{0x17: 2, 132: 1}[message[0]]
+		if addr, ok := group(single); ok {
+			changes = append(changes, membershipChange{Group: addr})
+		}
+	case 0x22, 143: // IGMPv3 and MLDv2 reports
+		version = map[byte]int{0x22: 3, 143: 2}[message[0]]
+		records, at := int(binary.BigEndian.Uint16(message[6:8])), 8
+		for i := 0; i < records && len(message) >= at+4; i++ {
+			recordType, auxLen, sources := message[at], int(message[at+1]), int(binary.BigEndian.Uint16(message[at+2:at+4]))
+			if addr, ok := group(at + 4); ok {
+				// Including no source is leaving; blocking sources
+				// changes no membership
+				switch {
+				case (recordType == 1 || recordType == 3) && sources == 0:
+					changes = append(changes, membershipChange{Group: addr})
+				case recordType != 6:
+					changes = append(changes, membershipChange{Group: addr, Join: true})
+				}
+			}
+			at += 4 + groupLen + sources*groupLen + auxLen*4
+		}
+	default:
+		return netip.Addr{}, "", 0, nil
+	}
+	return reporter, protocol, version, changes
+}
+
+// interfaceName names an interface by index, the index itself when it no
+// longer exists
+func interfaceName(ifindex uint32) string {
+	if iface, err := net.InterfaceByIndex(int(ifindex)); err == nil {
+		return iface.Name
+	}
+	return strconv.Itoa(int(ifindex))
+}
+
+// multicastPrefix returns whether a rule address is a multicast group
+// prefix, as opposed to a unicast one or one spanning both
+func multicastPrefix(addr string) bool {
+	if addr == "" {
+		return false
+	}
+	network, err := parseRuleAddress(addr)
+	if err != nil {
+		return false
+	}
+	bits, _ := network.Mask.Size()
+	first, _ := netip.AddrFromSlice(network.IP)
+	if first.Is4() {
+		return bits >= multicastPrefix4.Bits() && multicastPrefix4.Contains(first)
+	}
+	return bits >= multicastPrefix6.Bits() && multicastPrefix6.Contains(first)
+}
+
+// validateMulticastRule checks the group addresses of a rule: groups are
+// only destinations, of connectionless traffic that is allowed or dropped
+func validateMulticastRule(rule *FirewallRule, errs *ruleValidationError) {
+	if multicastPrefix(rule.SrcIP) {
+		errs.add("src_ip", "src_ip %s is a multicast group, groups are only destinations", rule.SrcIP)
+	}
+	if rule.Protocol == "igmp" && ruleFamily(rule) == familyIPv6 {
+		errs.add("protocol", "igmp is IPv4 only, IPv6 groups are managed with MLD over icmp")
+	}
+	if !multicastPrefix(rule.DstIP) {
+		return
+	}
+	if rule.Protocol == "tcp" {
+		errs.add("protocol", "tcp cannot be sent to multicast group %s", rule.DstIP)
+	}
+	if rule.Action == "redirect" || rule.Action == "reject" {
+		errs.add("action", "multicast group %s can only be allowed or dropped", rule.DstIP)
+	}
+}
+
+// MulticastStats reads the counters of every group, summed across CPUs
+func (bm *BPFMapManager) MulticastStats() (map[netip.Addr]mcastStats, error) {
+	if bm.simulated || bm.mcastStats == nil {
+		return nil, nil
+	}
+	stats := make(map[netip.Addr]mcastStats)
+	var key mcastKey
+	var perCPU []mcastStats
+	entries := bm.mcastStats.Iterate()
+	for entries.Next(&key, &perCPU) {
+		addr := netip.AddrFrom16(key.Addr)
+		if key.Family == familyIPv4 {
+			addr = netip.AddrFrom4([4]byte(key.Addr[:4]))
+		}
+		var sum mcastStats
+		for _, value := range perCPU {
+			sum.Packets += value.Packets
+			sum.Bytes += value.Bytes
+			sum.Dropped += value.Dropped
+		}
+		stats[addr] = sum
+	}
+	if err := entries.Err(); err != nil {
+		return stats, fmt.Errorf("failed to read multicast counters: %v", err)
+	}
+	return stats, nil
+}
+
+// openMulticast opens the pinned multicast maps
+func (bm *BPFMapManager) openMulticast() {
+	path := filepath.Join(bm.pinPath, McastStatsMapName)
+	stats, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Multicast counters not available at %s: %v", path, err)
+		return
+	}
+	path = filepath.Join(bm.pinPath, McastReportsMapName)
+	reports, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Multicast counters not available at %s: %v", path, err)
+		stats.Close()
+		return
+	}
+	bm.mcastStats, bm.mcastReports = stats, reports
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Network namespaces: data plane instances inside containers and VRFs
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Namespace is a network namespace running its own XDP data plane. Its
+// loader pins the instance's maps under PinPath; rules naming the namespace
+// are enforced there instead of on the host.
+type Namespace struct {
+	Name        string   `json:"name"`
+	NetnsPath   string   `json:"netns_path"` // e.g. /var/run/netns/blue
+	PinPath     string   `json:"pin_path"`   // bpffs directory of the instance's maps
+	Interfaces  []string `json:"interfaces"` // Interfaces inside the namespace
+	Description string   `json:"description"`
+}
+
+// AttachNamespace opens the data plane of a namespace, or replaces an
+// attached one, and installs the namespace's rules into it
+func (s *Server) AttachNamespace(ctx context.Context, req *pb.AttachNamespaceRequest) (*pb.StatusResponse, error) {
+	if req.GetNamespace() == nil {
+		return &pb.StatusResponse{Success: false, Message: "Namespace is required"}, nil
+	}
+
+	namespace := namespaceFromProto(req.Namespace)
+	if err := validateNamespace(namespace); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Namespace validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.attachNamespace(namespace); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to attach namespace: %v", err),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Attached namespace: %s (%s, %d interfaces)",
+		namespace.Name, namespace.NetnsPath, len(namespace.Interfaces))
+
+	return &pb.StatusResponse{Success: true, Message: "Namespace attached successfully"}, nil
+}
+
+// DetachNamespace closes the data plane of a namespace no rule refers to
+func (s *Server) DetachNamespace(ctx context.Context, req *pb.DetachNamespaceRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	namespace, exists := s.namespaces[req.Name]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Namespace not found"}, nil
+	}
+
+	if users := s.rulesInScope(req.Name); len(users) > 0 {
+		ids := make([]string, 0, len(users))
+		for _, rule := range users {
+			ids = append(ids, rule.ID)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Namespace is referenced by rules: %s", strings.Join(ids, ", ")),
+		}, nil
+	}
+
+	if manager := s.dataPlanes[namespace.Name]; manager != nil {
+		for _, iface := range namespace.Interfaces {
+			if err := manager.UnloadXDPProgram(iface); err != nil {
+				log.Printf("Failed to detach %s in namespace %s: %v", iface, namespace.Name, err)
+			}
+		}
+		manager.Close()
+	}
+	delete(s.dataPlanes, namespace.Name)
+	delete(s.namespaces, namespace.Name)
+
+	s.persistPolicy()
+	log.Printf("Detached namespace: %s", namespace.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Namespace detached successfully"}, nil
+}
+
+// ListNamespaces returns all attached namespaces
+func (s *Server) ListNamespaces(ctx context.Context, req *pb.Empty) (*pb.NamespacesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.NamespacesResponse{}
+	for _, name := range s.sortedNamespaceNames() {
+		p := namespaceToProto(s.namespaces[name])
+		if manager := s.dataPlanes[name]; manager != nil {
+			p.Simulated = manager.simulated
+		}
+		resp.Namespaces = append(resp.Namespaces, p)
+	}
+
+	return resp, nil
+}
+
+// GetNamespaceStats returns the statistics of one namespace's data plane;
+// an empty name selects the host
+func (s *Server) GetNamespaceStats(ctx context.Context, req *pb.NamespaceStatsRequest) (*pb.Statistics, error) {
+	if req.Name == "" {
+		return s.GetStats(ctx, &pb.Empty{})
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	manager, exists := s.dataPlanes[req.Name]
+	if !exists {
+		return nil, fmt.Errorf("namespace not found: %s", req.Name)
+	}
+
+	stats, err := manager.GetStats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats of namespace %s: %v", req.Name, err)
+	}
+
+	return &pb.Statistics{
+		TotalPackets:   stats.Pass + stats.Drop + stats.Redirect,
+		DroppedPackets: stats.Drop,
+		AllowedPackets: stats.Pass + stats.Redirect,
+		ActiveRules:    int32(len(s.rulesInScope(req.Name))),
+	}, nil
+}
+
+// attachNamespace opens the namespace's data plane, attaches XDP to its
+// interfaces and installs the running version's entries for it.
+// Caller must hold s.mutex.
+func (s *Server) attachNamespace(namespace *Namespace) error {
+	if _, err := os.Stat(namespace.NetnsPath); err != nil {
+		return fmt.Errorf("network namespace %s: %v", namespace.NetnsPath, err)
+	}
+	if namespace.PinPath == "" {
+		namespace.PinPath = filepath.Join(DefaultPinPath, "cerberus", namespace.Name)
+	}
+
+	manager, err := NewBPFMapManagerAt(namespace.PinPath)
+	if err != nil {
+		return err
+	}
+	manager.events = s.events
+	manager.clock = s.clock
+	manager.offloadMode = s.offloadMode
+	manager.xdpMode = s.xdpMode
+
+	for _, iface := range namespace.Interfaces {
+		if err := manager.LoadXDPProgram(iface); err != nil {
+			manager.Close()
+			return fmt.Errorf("failed to attach %s: %v", iface, err)
+		}
+	}
+
+	// A fresh data plane holds none of the entries the running version
+	// expects, so install them all
+	for _, entry := range s.compiledPolicy().Sorted {
+		if ruleScope(entry) != namespace.Name {
+			continue
+		}
+		if err := manager.AddRuleToMap(entry); err != nil {
+			manager.Close()
+			return fmt.Errorf("failed to install entry %s: %v", entry.ID, err)
+		}
+	}
+	if err := manager.SetPolicyVersion(s.compiledPolicy().Version); err != nil {
+		log.Printf("⚠️  Failed to stamp policy version in %s: %v", namespace.Name, err)
+	}
+
+	if previous := s.dataPlanes[namespace.Name]; previous != nil {
+		previous.Close()
+	}
+	s.dataPlanes[namespace.Name] = manager
+	s.namespaces[namespace.Name] = namespace
+	return nil
+}
+
+// ruleScope names the data plane enforcing a rule: a VF key, a namespace
+// name, or empty for the host
+func ruleScope(rule *FirewallRule) string {
+	if rule.VF != "" {
+		return rule.VF
+	}
+	return rule.Namespace
+}
+
+// dataPlaneFor returns the data plane of a scope, nil when it has none.
+// Caller must hold s.mutex.
+func (s *Server) dataPlaneFor(scope string) *BPFMapManager {
+	if scope == "" {
+		return s.bpfManager
+	}
+	return s.dataPlanes[scope]
+}
+
+// rulesInScope lists rules enforced by a scope's data plane, ordered by ID.
+// Caller must hold s.mutex.
+func (s *Server) rulesInScope(scope string) []*FirewallRule {
+	var users []*FirewallRule
+	for _, rule := range s.rules {
+		if ruleScope(rule) == scope {
+			users = append(users, rule)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+// sortedNamespaceNames returns namespace names in a stable order.
+// Caller must hold s.mutex.
+func (s *Server) sortedNamespaceNames() []string {
+	names := make([]string, 0, len(s.namespaces))
+	for name := range s.namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func validateNamespace(namespace *Namespace) error {
+	if namespace.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.ContainsAny(namespace.Name, "/.") {
+		return fmt.Errorf("name must not contain '/' or '.'")
+	}
+	if namespace.NetnsPath == "" {
+		return fmt.Errorf("netns_path is required")
+	}
+	return nil
+}
+
+func namespaceFromProto(p *pb.Namespace) *Namespace {
+	return &Namespace{
+		Name:        p.Name,
+		NetnsPath:   p.NetnsPath,
+		PinPath:     p.PinPath,
+		Interfaces:  p.Interfaces,
+		Description: p.Description,
+	}
+}
+
+func namespaceToProto(namespace *Namespace) *pb.Namespace {
+	return &pb.Namespace{
+		Name:        namespace.Name,
+		NetnsPath:   namespace.NetnsPath,
+		PinPath:     namespace.PinPath,
+		Interfaces:  namespace.Interfaces,
+		Description: namespace.Description,
+	}
+}
@@ -0,0 +1,1014 @@
+// SPDX-License-Identifier: Apache-2.0
+// NAT rules: DNAT port forwarding of a public address and port to an
+// internal one, rewritten by the data plane with the translation kept in
+// the flow table, and source NAT of internal prefixes to a shared public
+// address with per-session ports
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned NAT maps (must match eBPF program)
+	DNATMapName         = "cerberus_dnat"
+	NATStatsMapName     = "cerberus_nat_stats"
+	SNATMapName         = "cerberus_snat"
+	SNATSessionsMapName = "cerberus_snat_sessions"
+	SNATReturnsMapName  = "cerberus_snat_returns"
+
+	// NAT rule IDs index the stats map, 0 = none (must match MAX_NAT_RULES
+	// in eBPF program)
+	MaxNatRules = 1024
+
+	// Source NAT sessions the data plane holds before evicting the least
+	// recently used (must match MAX_SNAT_SESSIONS in eBPF program)
+	MaxSNATSessions = 65536
+
+	// NAT rule types
+	NatTypeDNAT       = "dnat"
+	NatTypeSNAT       = "snat"
+	NatTypeMasquerade = "masquerade"
+
+	// Public ports of a source NAT rule without a range
+	DefaultSNATPortMin = 1024
+	DefaultSNATPortMax = 65535
+
+	// Longest idle timeout of a source NAT session, in seconds
+	MaxSNATTimeout = 7 * 24 * 3600
+
+	// Sessions listed when a request gives no limit
+	DefaultNatSessionLimit = 1000
+
+	natReapInterval = 10 * time.Second
+)
+
+// snatIdleTimeouts is how long a source NAT session may be idle before its
+// port is released when its rule sets no timeout, per protocol. TCP uses
+// the established timeout of RFC 5382, UDP that of RFC 4787.
+var snatIdleTimeouts = map[uint8]time.Duration{
+	6:  2*time.Hour + 4*time.Minute, // TCP
+	17: 5 * time.Minute,             // UDP
+}
+
+// NatRule forwards TCP or UDP packets to PublicIP:PublicPort to
+// InternalIP:InternalPort (DNAT), or translates the source of those from
+// Source leaving by Interface to PublicIP and a port of PortMin-PortMax
+// (SNAT). A masquerade rule is an SNAT rule whose PublicIP is the
+// interface's own address.
+type NatRule struct {
+	ID           uint32 `json:"id"`
+	Type         string `json:"type"`
+	Protocol     string `json:"protocol"`
+	PublicIP     string `json:"public_ip"`
+	PublicPort   uint32 `json:"public_port"`
+	InternalIP   string `json:"internal_ip"`
+	InternalPort uint32 `json:"internal_port"`
+	Description  string `json:"description"`
+	Source       string `json:"source,omitempty"`
+	Interface    string `json:"interface,omitempty"`
+	PortMin      uint32 `json:"port_min,omitempty"`
+	PortMax      uint32 `json:"port_max,omitempty"`
+	TCPTimeout   uint32 `json:"tcp_timeout,omitempty"` // Seconds, 0 = snatIdleTimeouts
+	UDPTimeout   uint32 `json:"udp_timeout,omitempty"`
+
+	protocol uint8 // 0 = TCP and UDP, source NAT only
+	public   netip.Addr
+	internal netip.Addr
+	source   netip.Prefix
+	ifindex  uint32
+}
+
+// dnatKey mirrors struct dnat_key in the eBPF program
+type dnatKey struct {
+	Addr     [16]byte
+	Port     uint16
+	Protocol uint8
+	Family   uint8
+}
+
+// dnatTarget mirrors struct dnat_target in the eBPF program
+type dnatTarget struct {
+	Addr [16]byte
+	Port uint16
+	Pad  [2]uint8
+	ID   uint32
+}
+
+// natStats mirrors struct nat_stats in the eBPF program
+type natStats struct {
+	Translated uint64
+	Replies    uint64
+	Failed     uint64
+	Sessions   uint64
+}
+
+// snatKey mirrors struct snat_key in the eBPF program
+type snatKey struct {
+	Prefixlen uint32 // 64 + the source prefix length
+	Ifindex   uint32
+	Family    uint32
+	Addr      [16]byte
+}
+
+// snatRuleValue mirrors struct snat_rule in the eBPF program
+type snatRuleValue struct {
+	Addr     [16]byte
+	PortMin  uint16
+	PortMax  uint16
+	ID       uint32
+	Protocol uint8
+	Pad      [3]uint8
+}
+
+// snatSession mirrors struct snat_session in the eBPF program
+type snatSession struct {
+	LastSeen uint64 // CLOCK_MONOTONIC nanoseconds
+	Addr     [16]byte
+	Port     uint16
+	Pad      [2]uint8
+	ID       uint32
+}
+
+// snatReturn mirrors struct snat_return in the eBPF program
+type snatReturn struct {
+	Session ConntrackKey
+	ID      uint32
+}
+
+// SNATSessionEntry is one source NAT session read from the data plane,
+// keyed by the internal side of its flow
+type SNATSessionEntry struct {
+	Key     ConntrackKey
+	Session snatSession
+}
+
+// returnKey is the key of the session's replies
+func (entry SNATSessionEntry) returnKey() ConntrackKey {
+	return ConntrackKey{
+		SrcAddr:  entry.Key.DstAddr,
+		DstAddr:  entry.Session.Addr,
+		SrcPort:  entry.Key.DstPort,
+		DstPort:  entry.Session.Port,
+		Protocol: entry.Key.Protocol,
+		Family:   entry.Key.Family,
+	}
+}
+
+// AddNatRule creates a NAT rule, refusing one whose public side another
+// rule already forwards or whose source another rule already translates
+func (s *Server) AddNatRule(ctx context.Context, req *pb.AddNatRuleRequest) (*pb.NatRuleResponse, error) {
+	if req.GetRule() == nil {
+		return &pb.NatRuleResponse{Success: false, Message: "NAT rule is required"}, nil
+	}
+
+	rule := natRuleFromProto(req.Rule)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := validateNatRule(rule); err != nil {
+		return &pb.NatRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("NAT rule validation failed: %v", err),
+		}, nil
+	}
+	if conflict := s.natRuleConflicting(rule); conflict != nil {
+		return &pb.NatRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("%s is already %s by NAT rule %d", rule.matchedSide(), rule.verb(), conflict.ID),
+		}, nil
+	}
+	if rule.ID = s.freeNatRuleID(); rule.ID == 0 {
+		return &pb.NatRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("NAT rule limit reached (%d rules)", MaxNatRules-1),
+		}, nil
+	}
+
+	if err := s.pushNatRule(rule); err != nil {
+		return &pb.NatRuleResponse{Success: false, Message: fmt.Sprintf("Failed to push NAT rule to data plane: %v", withRemediation(err))}, nil
+	}
+	s.natRules[rule.ID] = rule
+	s.persistPolicy()
+	log.Printf("Added NAT rule %d: %s", rule.ID, rule.summary())
+
+	return &pb.NatRuleResponse{
+		Success: true,
+		Message: "NAT rule added successfully",
+		Rule:    s.natRuleToProto(rule, nil),
+	}, nil
+}
+
+// DeleteNatRule stops forwarding a rule's public side, or translating its
+// source, and closes the flows or sessions it translated
+func (s *Server) DeleteNatRule(ctx context.Context, req *pb.DeleteNatRuleRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rule, exists := s.natRules[req.Id]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "NAT rule not found"}, nil
+	}
+	if s.bpfManager != nil {
+		if err := s.removeNatRule(rule); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove NAT rule from data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	delete(s.natRules, rule.ID)
+	s.persistPolicy()
+	log.Printf("Deleted NAT rule %d: %s", rule.ID, rule.summary())
+
+	return &pb.StatusResponse{Success: true, Message: "NAT rule deleted successfully"}, nil
+}
+
+// ListNatRules returns every NAT rule with its counters and open sessions,
+// ordered by ID, and the use of the session table
+func (s *Server) ListNatRules(ctx context.Context, req *pb.Empty) (*pb.NatRulesResponse, error) {
+	return s.natRuleStatus(), nil
+}
+
+// natRuleStatus returns every NAT rule with its counters and open
+// sessions, ordered by ID, and the use of the session table
+func (s *Server) natRuleStatus() *pb.NatRulesResponse {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.NatRulesResponse{SessionCapacity: MaxSNATSessions}
+	var sessions []SNATSessionEntry
+	if s.bpfManager != nil {
+		var err error
+		if sessions, err = s.bpfManager.SNATSessions(); err != nil {
+			log.Printf("⚠️  Failed to read source NAT sessions: %v", err)
+		}
+	}
+	usage := snatUsageOf(sessions)
+	resp.Sessions = uint32(len(sessions))
+	for _, rule := range s.sortedNatRules() {
+		resp.Rules = append(resp.Rules, s.natRuleToProto(rule, usage))
+	}
+	return resp
+}
+
+// snatUsage is the open sessions of a source NAT rule and the public ports
+// they hold
+type snatUsage struct {
+	sessions uint32
+	ports    map[uint16]bool
+}
+
+// snatUsageOf counts the open sessions and ports in use by rule ID
+func snatUsageOf(sessions []SNATSessionEntry) map[uint32]*snatUsage {
+	usage := make(map[uint32]*snatUsage)
+	for _, entry := range sessions {
+		rule := usage[entry.Session.ID]
+		if rule == nil {
+			rule = &snatUsage{ports: make(map[uint16]bool)}
+			usage[entry.Session.ID] = rule
+		}
+		rule.sessions++
+		rule.ports[entry.Session.Port] = true
+	}
+	return usage
+}
+
+// ListNatSessions returns the open source NAT sessions, most recently
+// active first
+func (s *Server) ListNatSessions(ctx context.Context, req *pb.ListNatSessionsRequest) (*pb.NatSessionsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.NatSessionsResponse{}
+	if s.bpfManager == nil {
+		return resp, nil
+	}
+	sessions, err := s.bpfManager.SNATSessions()
+	if err != nil {
+		return nil, err
+	}
+	if req.RuleId != 0 {
+		matching := sessions[:0]
+		for _, entry := range sessions {
+			if entry.Session.ID == req.RuleId {
+				matching = append(matching, entry)
+			}
+		}
+		sessions = matching
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Session.LastSeen > sessions[j].Session.LastSeen
+	})
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultNatSessionLimit
+	}
+	if len(sessions) > limit {
+		sessions, resp.Truncated = sessions[:limit], true
+	}
+
+	now := monotonicNow()
+	for _, entry := range sessions {
+		session := &pb.NatSession{
+			RuleId:       entry.Session.ID,
+			Protocol:     protocolName(entry.Key.Protocol),
+			InternalIp:   entry.Key.natAddr(entry.Key.SrcAddr).String(),
+			InternalPort: uint32(entry.Key.SrcPort),
+			RemoteIp:     entry.Key.natAddr(entry.Key.DstAddr).String(),
+			RemotePort:   uint32(entry.Key.DstPort),
+			PublicIp:     entry.Key.natAddr(entry.Session.Addr).String(),
+			PublicPort:   uint32(entry.Session.Port),
+		}
+		if now > entry.Session.LastSeen {
+			session.IdleSeconds = int64((now - entry.Session.LastSeen) / uint64(time.Second))
+		}
+		resp.Sessions = append(resp.Sessions, session)
+	}
+	return resp, nil
+}
+
+// reapNatSessions closes idle source NAT sessions every interval until ctx
+// is done, releasing their ports, and follows the addresses of
+// masquerading interfaces
+func (s *Server) reapNatSessions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.followMasquerade()
+
+		s.mutex.RLock()
+		manager := s.bpfManager
+		timeouts := make(map[uint32]*NatRule, len(s.natRules))
+		for id, rule := range s.natRules {
+			timeouts[id] = rule
+		}
+		s.mutex.RUnlock()
+		if manager == nil {
+			continue
+		}
+		sessions, err := manager.SNATSessions()
+		if err != nil {
+			log.Printf("⚠️  Failed to read source NAT sessions: %v", err)
+			continue
+		}
+		now := monotonicNow()
+		for _, entry := range sessions {
+			// Sessions of rules that are gone idle out with the defaults
+			timeout := snatIdleTimeouts[entry.Key.Protocol]
+			if rule := timeouts[entry.Session.ID]; rule != nil {
+				timeout = rule.idleTimeout(entry.Key.Protocol)
+			}
+			if now < entry.Session.LastSeen || now-entry.Session.LastSeen < uint64(timeout) {
+				continue
+			}
+			if err := manager.CloseSNATSession(entry); err != nil {
+				log.Printf("⚠️  Failed to close idle source NAT session: %v", err)
+			}
+		}
+	}
+}
+
+// followMasquerade re-reads the address of each masquerading interface,
+// moving the rules whose address changed to the new one and closing their
+// sessions, which no longer get replies
+func (s *Server) followMasquerade() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	changed := false
+	for _, rule := range s.sortedNatRules() {
+		if rule.Type != NatTypeMasquerade {
+			continue
+		}
+		addr, err := masqueradeAddr(rule.Interface, rule.source.Addr().Is4())
+		if err != nil || addr == rule.public {
+			continue
+		}
+		previous := rule.public
+		rule.public, rule.PublicIP = addr, addr.String()
+		if err := s.pushNatRule(rule); err != nil {
+			log.Printf("⚠️  Failed to move NAT rule %d to %s: %v", rule.ID, addr, err)
+			continue
+		}
+		if s.bpfManager != nil {
+			if err := s.bpfManager.CloseSNATSessions(rule.ID); err != nil {
+				log.Printf("⚠️  Failed to close sessions of NAT rule %d: %v", rule.ID, err)
+			}
+		}
+		log.Printf("NAT rule %d: %s moved from %s to %s", rule.ID, rule.Interface, previous, addr)
+		changed = true
+	}
+	if changed {
+		s.persistPolicy()
+	}
+}
+
+// validateNatRule checks a NAT rule and normalizes its type, protocol and
+// addresses. Source NAT rules are resolved against their interface.
+func validateNatRule(rule *NatRule) error {
+	if rule.Type == "" {
+		rule.Type = NatTypeDNAT
+	}
+	switch rule.Type {
+	case NatTypeDNAT:
+		return validateDNATRule(rule)
+	case NatTypeSNAT, NatTypeMasquerade:
+		return validateSNATRule(rule)
+	}
+	var errs ruleValidationError
+	errs.add("type", "invalid type %s: must be dnat, snat or masquerade", rule.Type)
+	return errs
+}
+
+// validateDNATRule checks a DNAT rule
+func validateDNATRule(rule *NatRule) error {
+	var errs ruleValidationError
+	switch rule.Protocol {
+	case "tcp", "udp":
+		rule.protocol = protocolToUint8(rule.Protocol)
+	default:
+		errs.add("protocol", "invalid protocol %s: must be tcp or udp", rule.Protocol)
+	}
+	if rule.Source != "" || rule.Interface != "" || rule.PortMin != 0 || rule.PortMax != 0 || rule.TCPTimeout != 0 || rule.UDPTimeout != 0 {
+		errs.add("source", "source, interface, port range and timeouts are for snat and masquerade rules")
+	}
+
+	public, err := netip.ParseAddr(rule.PublicIP)
+	if err != nil {
+		errs.add("public_ip", "invalid public_ip %s: %v", rule.PublicIP, err)
+	} else if public = public.Unmap(); !natHostAddr(public) {
+		errs.add("public_ip", "invalid public_ip %s: not a host address", rule.PublicIP)
+	}
+	internal, err := netip.ParseAddr(rule.InternalIP)
+	if err != nil {
+		errs.add("internal_ip", "invalid internal_ip %s: %v", rule.InternalIP, err)
+	} else if internal = internal.Unmap(); !natHostAddr(internal) {
+		errs.add("internal_ip", "invalid internal_ip %s: not a host address", rule.InternalIP)
+	} else if public.IsValid() && public.Is4() != internal.Is4() {
+		errs.add("internal_ip", "invalid internal_ip %s: must be the same family as public_ip", rule.InternalIP)
+	}
+
+	if rule.PublicPort == 0 || rule.PublicPort > 65535 {
+		errs.add("public_port", "invalid public_port %d: must be 1-65535", rule.PublicPort)
+	}
+	if rule.InternalPort > 65535 {
+		errs.add("internal_port", "invalid internal_port %d: must be 0-65535", rule.InternalPort)
+	} else if rule.InternalPort == 0 {
+		rule.InternalPort = rule.PublicPort
+	}
+	if len(errs) == 0 && public == internal && rule.PublicPort == rule.InternalPort {
+		errs.add("internal_ip", "invalid internal_ip %s: forwards the public side to itself", rule.InternalIP)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	rule.public, rule.internal = public, internal
+	rule.PublicIP, rule.InternalIP = public.String(), internal.String()
+	return nil
+}
+
+// validateSNATRule checks an SNAT or masquerade rule, resolving its
+// interface and, for masquerade, the interface's address
+func validateSNATRule(rule *NatRule) error {
+	var errs ruleValidationError
+	switch rule.Protocol {
+	case "", "any":
+		rule.Protocol, rule.protocol = "any", 0
+	case "tcp", "udp":
+		rule.protocol = protocolToUint8(rule.Protocol)
+	default:
+		errs.add("protocol", "invalid protocol %s: must be tcp, udp or any", rule.Protocol)
+	}
+
+	source, err := netip.ParsePrefix(rule.Source)
+	if err != nil {
+		errs.add("source", "invalid source %s: %v", rule.Source, err)
+	} else if source.Addr().Is4In6() {
+		if source.Bits() < 96 {
+			errs.add("source", "invalid source %s: IPv4-mapped prefixes must be at least /96", rule.Source)
+		} else {
+			source = netip.PrefixFrom(source.Addr().Unmap(), source.Bits()-96)
+		}
+	}
+	source = source.Masked()
+	if rule.PublicPort != 0 || rule.InternalIP != "" || rule.InternalPort != 0 {
+		errs.add("internal_ip", "public_port, internal_ip and internal_port are for dnat rules")
+	}
+
+	if rule.PortMin == 0 && rule.PortMax == 0 {
+		rule.PortMin, rule.PortMax = DefaultSNATPortMin, DefaultSNATPortMax
+	}
+	if rule.PortMin == 0 || rule.PortMax > 65535 || rule.PortMin > rule.PortMax {
+		errs.add("port_min", "invalid port range %d-%d: must be within 1-65535", rule.PortMin, rule.PortMax)
+	}
+	if rule.TCPTimeout > MaxSNATTimeout || rule.UDPTimeout > MaxSNATTimeout {
+		errs.add("tcp_timeout", "tcp_timeout and udp_timeout must be at most %d seconds", MaxSNATTimeout)
+	}
+
+	var ifindex uint32
+	if rule.Interface == "" {
+		errs.add("interface", "interface is required")
+	} else if iface, err := net.InterfaceByName(rule.Interface); err != nil {
+		errs.add("interface", "unknown interface %s: %v", rule.Interface, err)
+	} else {
+		ifindex = uint32(iface.Index)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	var public netip.Addr
+	if rule.Type == NatTypeMasquerade {
+		if public, err = masqueradeAddr(rule.Interface, source.Addr().Is4()); err != nil {
+			errs.add("interface", "%v", err)
+		}
+	} else if public, err = netip.ParseAddr(rule.PublicIP); err != nil {
+		errs.add("public_ip", "invalid public_ip %s: %v", rule.PublicIP, err)
+	} else if public = public.Unmap(); !natHostAddr(public) {
+		errs.add("public_ip", "invalid public_ip %s: not a host address", rule.PublicIP)
+	} else if public.Is4() != source.Addr().Is4() {
+		errs.add("public_ip", "invalid public_ip %s: must be the same family as source", rule.PublicIP)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	rule.public, rule.source, rule.ifindex = public, source, ifindex
+	rule.PublicIP, rule.Source = public.String(), source.String()
+	return nil
+}
+
+// masqueradeAddr returns the first global unicast address of an interface
+// in the IPv4 or IPv6 family
+func masqueradeAddr(name string, is4 bool) (netip.Addr, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("unknown interface %s: %v", name, err)
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("failed to read addresses of %s: %v", name, err)
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		parsed, ok := netip.AddrFromSlice(ipNet.IP)
+		if ok && parsed.Unmap().Is4() == is4 && parsed.IsGlobalUnicast() {
+			return parsed.Unmap(), nil
+		}
+	}
+	family := "IPv6"
+	if is4 {
+		family = "IPv4"
+	}
+	return netip.Addr{}, fmt.Errorf("interface %s has no global %s address to masquerade as", name, family)
+}
+
+// idleTimeout returns how long a session of a source NAT rule may be idle
+func (rule *NatRule) idleTimeout(protocol uint8) time.Duration {
+	seconds := rule.TCPTimeout
+	if protocol == 17 {
+		seconds = rule.UDPTimeout
+	}
+	if seconds == 0 {
+		return snatIdleTimeouts[protocol]
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// sourceNAT reports whether a rule translates sources
+func (rule *NatRule) sourceNAT() bool {
+	return rule.Type == NatTypeSNAT || rule.Type == NatTypeMasquerade
+}
+
+// summary describes a validated rule for the log
+func (rule *NatRule) summary() string {
+	if rule.sourceNAT() {
+		return fmt.Sprintf("%s %s from %s via %s -> %s ports %d-%d", rule.Type, rule.Protocol, rule.Source, rule.Interface, rule.public, rule.PortMin, rule.PortMax)
+	}
+	return fmt.Sprintf("%s %s -> %s", rule.Protocol, rule.publicSide(), rule.internalSide())
+}
+
+// matchedSide returns what a validated rule translates, for conflicts
+func (rule *NatRule) matchedSide() string {
+	if rule.sourceNAT() {
+		return fmt.Sprintf("%s via %s", rule.Source, rule.Interface)
+	}
+	return rule.publicSide()
+}
+
+// verb returns what a rule does to its matched side, for conflicts
+func (rule *NatRule) verb() string {
+	if rule.sourceNAT() {
+		return "translated"
+	}
+	return "forwarded"
+}
+
+// natHostAddr reports whether addr can be one side of a NAT rule
+func natHostAddr(addr netip.Addr) bool {
+	return !addr.IsUnspecified() && !addr.IsMulticast() &&
+		addr != netip.AddrFrom4([4]byte{255, 255, 255, 255})
+}
+
+// publicSide returns the address and port a validated rule forwards
+func (rule *NatRule) publicSide() string {
+	return netip.AddrPortFrom(rule.public, uint16(rule.PublicPort)).String()
+}
+
+// internalSide returns the address and port a validated rule forwards to
+func (rule *NatRule) internalSide() string {
+	return netip.AddrPortFrom(rule.internal, uint16(rule.InternalPort)).String()
+}
+
+// dnatKey returns the data plane key of a validated rule
+func (rule *NatRule) dnatKey() dnatKey {
+	key := dnatKey{Port: uint16(rule.PublicPort), Protocol: rule.protocol}
+	key.Addr, key.Family = natAddrBytes(rule.public)
+	return key
+}
+
+// snatKey returns the data plane key of a validated source NAT rule
+func (rule *NatRule) snatKey() snatKey {
+	key := snatKey{Prefixlen: 64 + uint32(rule.source.Bits()), Ifindex: rule.ifindex}
+	var family uint8
+	key.Addr, family = natAddrBytes(rule.source.Addr())
+	key.Family = uint32(family)
+	return key
+}
+
+// natAddrBytes encodes an address as the data plane keeps it: an IPv4
+// address in the first 4 bytes
+func natAddrBytes(addr netip.Addr) ([16]byte, uint8) {
+	var encoded [16]byte
+	if addr.Is4() {
+		v4 := addr.As4()
+		copy(encoded[:], v4[:])
+		return encoded, familyIPv4
+	}
+	return addr.As16(), familyIPv6
+}
+
+// natRuleConflicting returns the rule forwarding the public side of a
+// validated DNAT rule, or translating the source of an SNAT rule on the
+// same interface, nil if none. Caller must hold s.mutex.
+func (s *Server) natRuleConflicting(rule *NatRule) *NatRule {
+	for _, other := range s.natRules {
+		if other.sourceNAT() != rule.sourceNAT() {
+			continue
+		}
+		if rule.sourceNAT() && other.snatKey() == rule.snatKey() {
+			return other
+		}
+		if !rule.sourceNAT() && other.dnatKey() == rule.dnatKey() {
+			return other
+		}
+	}
+	return nil
+}
+
+// freeNatRuleID returns the lowest NAT rule ID not in use, 0 when all are.
+// Caller must hold s.mutex.
+func (s *Server) freeNatRuleID() uint32 {
+	for id := uint32(1); id < MaxNatRules; id++ {
+		if s.natRules[id] == nil {
+			return id
+		}
+	}
+	return 0
+}
+
+// pushNatRule writes a rule to the host data plane. Caller must hold
+// s.mutex.
+func (s *Server) pushNatRule(rule *NatRule) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	if rule.sourceNAT() {
+		value := snatRuleValue{PortMin: uint16(rule.PortMin), PortMax: uint16(rule.PortMax), ID: rule.ID, Protocol: rule.protocol}
+		value.Addr, _ = natAddrBytes(rule.public)
+		return s.bpfManager.SetSNATRule(rule.snatKey(), value)
+	}
+	target := dnatTarget{Port: uint16(rule.InternalPort), ID: rule.ID}
+	target.Addr, _ = natAddrBytes(rule.internal)
+	return s.bpfManager.SetNatRule(rule.dnatKey(), target)
+}
+
+// removeNatRule removes a rule from the host data plane and closes the
+// flows or sessions it translated. Caller must hold s.mutex.
+func (s *Server) removeNatRule(rule *NatRule) error {
+	if rule.sourceNAT() {
+		if err := s.bpfManager.DeleteSNATRule(rule.snatKey(), rule.ID); err != nil {
+			return err
+		}
+		if err := s.bpfManager.CloseSNATSessions(rule.ID); err != nil {
+			log.Printf("⚠️  Failed to close sessions of NAT rule %d: %v", rule.ID, err)
+		}
+		return nil
+	}
+	if err := s.bpfManager.DeleteNatRule(rule.dnatKey(), rule.ID); err != nil {
+		return err
+	}
+	if err := s.bpfManager.CloseNatFlows(rule.ID); err != nil {
+		log.Printf("⚠️  Failed to close flows of NAT rule %d: %v", rule.ID, err)
+	}
+	return nil
+}
+
+// sortedNatRules returns the NAT rules ordered by ID. Caller must hold
+// s.mutex.
+func (s *Server) sortedNatRules() []*NatRule {
+	rules := make([]*NatRule, 0, len(s.natRules))
+	for _, rule := range s.natRules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+func natRuleFromProto(rule *pb.NatRule) *NatRule {
+	return &NatRule{
+		Type:         rule.Type,
+		Protocol:     rule.Protocol,
+		PublicIP:     rule.PublicIp,
+		PublicPort:   rule.PublicPort,
+		InternalIP:   rule.InternalIp,
+		InternalPort: rule.InternalPort,
+		Description:  rule.Description,
+		Source:       rule.Source,
+		Interface:    rule.Interface,
+		PortMin:      rule.PortMin,
+		PortMax:      rule.PortMax,
+		TCPTimeout:   rule.TcpTimeout,
+		UDPTimeout:   rule.UdpTimeout,
+	}
+}
+
+// natRuleToProto converts a rule with its counters and, if usage is given,
+// its open sessions. Caller must hold s.mutex.
+func (s *Server) natRuleToProto(rule *NatRule, usage map[uint32]*snatUsage) *pb.NatRule {
+	resp := &pb.NatRule{
+		Id:           rule.ID,
+		Type:         rule.Type,
+		Protocol:     rule.Protocol,
+		PublicIp:     rule.PublicIP,
+		PublicPort:   rule.PublicPort,
+		InternalIp:   rule.InternalIP,
+		InternalPort: rule.InternalPort,
+		Description:  rule.Description,
+		Source:       rule.Source,
+		Interface:    rule.Interface,
+		PortMin:      rule.PortMin,
+		PortMax:      rule.PortMax,
+		TcpTimeout:   rule.TCPTimeout,
+		UdpTimeout:   rule.UDPTimeout,
+	}
+	if used := usage[rule.ID]; used != nil {
+		resp.Sessions, resp.PortsInUse = used.sessions, uint32(len(used.ports))
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.NatStats(rule.ID)
+		if err != nil {
+			log.Printf("⚠️  Failed to read counters of NAT rule %d: %v", rule.ID, err)
+		}
+		resp.Translated = stats.Translated
+		resp.Replies = stats.Replies
+		resp.Failed = stats.Failed
+		resp.SessionsCreated = stats.Sessions
+	}
+	return resp
+}
+
+// openNAT opens the pinned NAT maps and clears rules left by a previous
+// control plane run, with their counters; stored ones are re-pushed on
+// restore. Translated flows and source NAT sessions are kept so forwarded
+// and translated connections survive a restart; sessions of rules that
+// are gone idle out.
+func (bm *BPFMapManager) openNAT() {
+	names := []string{DNATMapName, NATStatsMapName, SNATMapName, SNATSessionsMapName, SNATReturnsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  NAT rules not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.dnat, bm.natStats = maps[0], maps[1]
+	bm.snat, bm.snatSessions, bm.snatReturns = maps[2], maps[3], maps[4]
+
+	var key dnatKey
+	var target dnatTarget
+	stale := make(map[dnatKey]uint32)
+	entries := bm.dnat.Iterate()
+	for entries.Next(&key, &target) {
+		stale[key] = target.ID
+	}
+	for key, id := range stale {
+		if err := bm.DeleteNatRule(key, id); err != nil {
+			log.Printf("⚠️  Failed to clear stale NAT rule: %v", err)
+		}
+	}
+
+	var snat snatKey
+	var rule snatRuleValue
+	staleSNAT := make(map[snatKey]uint32)
+	snatEntries := bm.snat.Iterate()
+	for snatEntries.Next(&snat, &rule) {
+		staleSNAT[snat] = rule.ID
+	}
+	for key, id := range staleSNAT {
+		if err := bm.DeleteSNATRule(key, id); err != nil {
+			log.Printf("⚠️  Failed to clear stale NAT rule: %v", err)
+		}
+	}
+}
+
+// closeNAT closes the NAT maps, if open
+func (bm *BPFMapManager) closeNAT() {
+	if bm.dnat == nil {
+		return
+	}
+	bm.dnat.Close()
+	bm.natStats.Close()
+	bm.snat.Close()
+	bm.snatSessions.Close()
+	bm.snatReturns.Close()
+}
+
+// SetNatRule writes a DNAT rule's public side and its target
+func (bm *BPFMapManager) SetNatRule(key dnatKey, target dnatTarget) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting NAT rule %d", target.ID)
+		return nil
+	}
+	if bm.dnat == nil {
+		return fmt.Errorf("NAT maps not available")
+	}
+	if err := bm.dnat.Put(&key, &target); err != nil {
+		return fmt.Errorf("failed to write NAT rule: %v", err)
+	}
+	return nil
+}
+
+// DeleteNatRule removes a DNAT rule and zeroes the counters of id
+func (bm *BPFMapManager) DeleteNatRule(key dnatKey, id uint32) error {
+	if bm.simulated || bm.dnat == nil {
+		return nil
+	}
+	if err := bm.dnat.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove NAT rule: %v", err)
+	}
+	return bm.resetNatStats(id)
+}
+
+// SetSNATRule writes a source NAT rule's internal prefix and interface
+// and its public side
+func (bm *BPFMapManager) SetSNATRule(key snatKey, value snatRuleValue) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting NAT rule %d (ports %d-%d)", value.ID, value.PortMin, value.PortMax)
+		return nil
+	}
+	if bm.snat == nil {
+		return fmt.Errorf("NAT maps not available")
+	}
+	if err := bm.snat.Put(&key, &value); err != nil {
+		return fmt.Errorf("failed to write NAT rule: %v", err)
+	}
+	return nil
+}
+
+// DeleteSNATRule removes a source NAT rule and zeroes the counters of id
+func (bm *BPFMapManager) DeleteSNATRule(key snatKey, id uint32) error {
+	if bm.simulated || bm.snat == nil {
+		return nil
+	}
+	if err := bm.snat.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove NAT rule: %v", err)
+	}
+	return bm.resetNatStats(id)
+}
+
+// resetNatStats zeroes the counters of a NAT rule ID
+func (bm *BPFMapManager) resetNatStats(id uint32) error {
+	zero := make([]natStats, ebpf.MustPossibleCPU())
+	if err := bm.natStats.Put(&id, zero); err != nil {
+		return fmt.Errorf("failed to reset counters of NAT rule %d: %v", id, err)
+	}
+	return nil
+}
+
+// NatStats reads the counters of a NAT rule, summed across CPUs
+func (bm *BPFMapManager) NatStats(id uint32) (natStats, error) {
+	var stats natStats
+	if bm.simulated || bm.natStats == nil {
+		return stats, nil
+	}
+	var perCPU []natStats
+	if err := bm.natStats.Lookup(&id, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Translated += value.Translated
+		stats.Replies += value.Replies
+		stats.Failed += value.Failed
+		stats.Sessions += value.Sessions
+	}
+	return stats, nil
+}
+
+// CloseNatFlows removes the flows a NAT rule translated, so their replies
+// are not rewritten to a side that is no longer forwarded
+func (bm *BPFMapManager) CloseNatFlows(id uint32) error {
+	if bm.simulated || bm.conntrack == nil {
+		return nil
+	}
+	connections, err := bm.Connections(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, conn := range connections {
+		if conn.Entry.NATID != id {
+			continue
+		}
+		if err := bm.conntrack.Delete(&conn.Key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove translated flow: %v", err)
+		}
+	}
+	return nil
+}
+
+// SNATSessions reads every open source NAT session
+func (bm *BPFMapManager) SNATSessions() ([]SNATSessionEntry, error) {
+	if bm.simulated || bm.snatSessions == nil {
+		return nil, nil
+	}
+	var sessions []SNATSessionEntry
+	var entry SNATSessionEntry
+	iter := bm.snatSessions.Iterate()
+	for iter.Next(&entry.Key, &entry.Session) {
+		sessions = append(sessions, entry)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read source NAT sessions: %v", err)
+	}
+	return sessions, nil
+}
+
+// CloseSNATSession removes a session in both directions, releasing its
+// public port
+func (bm *BPFMapManager) CloseSNATSession(entry SNATSessionEntry) error {
+	if bm.simulated || bm.snatSessions == nil {
+		return nil
+	}
+	returnKey := entry.returnKey()
+	if err := bm.snatReturns.Delete(&returnKey); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove source NAT return mapping: %v", err)
+	}
+	if err := bm.snatSessions.Delete(&entry.Key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove source NAT session: %v", err)
+	}
+	return nil
+}
+
+// CloseSNATSessions removes every session of a source NAT rule
+func (bm *BPFMapManager) CloseSNATSessions(id uint32) error {
+	sessions, err := bm.SNATSessions()
+	if err != nil {
+		return err
+	}
+	for _, entry := range sessions {
+		if entry.Session.ID != id {
+			continue
+		}
+		if err := bm.CloseSNATSession(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,563 @@
+// SPDX-License-Identifier: Apache-2.0
+// NAT64 gateway: IPv6-only clients reach IPv4 servers through /96 NAT64
+// prefixes, translated by the XDP program with per-session pool ports
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned NAT64 maps (must match eBPF program)
+	NAT64PrefixesMapName = "cerberus_nat64_prefixes"
+	NAT64SessionsMapName = "cerberus_nat64_sessions"
+	NAT64ReturnsMapName  = "cerberus_nat64_returns"
+	NAT64StatsMapName    = "cerberus_nat64_stats"
+
+	// NAT64 prefix IDs index the stats map, 0 = none (must match
+	// MAX_NAT64_PREFIXES in eBPF program)
+	MaxNAT64Prefixes = 16
+
+	// Sessions listed when a request gives no limit
+	DefaultNAT64SessionLimit = 1000
+
+	nat64ReapInterval = 10 * time.Second
+)
+
+// nat64IdleTimeouts is how long a session may be idle before its pool
+// port is released, per protocol (RFC 6146 section 4). TCP uses the
+// established timeout: the data plane does not follow TCP state.
+var nat64IdleTimeouts = map[uint8]time.Duration{
+	6:  2*time.Hour + 4*time.Minute, // TCP
+	17: 5 * time.Minute,             // UDP
+	1:  time.Minute,                 // ICMP echo
+}
+
+// NAT64Prefix is a /96 NAT64 prefix (RFC 6052). IPv6 packets to an
+// address in it that rules pass are translated to IPv4, to the address in
+// its last 32 bits, from Pool.
+type NAT64Prefix struct {
+	Prefix      string `json:"prefix"` // e.g. "64:ff9b::/96"
+	Pool        string `json:"pool"`   // IPv4 source of translated packets
+	Description string `json:"description"`
+
+	id     uint32 // Index of the prefix's counters, reallocated on restore
+	prefix netip.Prefix
+	pool   netip.Addr
+}
+
+// nat64PrefixKey mirrors struct nat64_prefix_key in the eBPF program
+type nat64PrefixKey struct {
+	Prefixlen uint32
+	Addr      [16]byte
+}
+
+// nat64PrefixValue mirrors struct nat64_prefix in the eBPF program
+type nat64PrefixValue struct {
+	ID   uint32
+	Pool [4]byte
+}
+
+// nat64Key6 mirrors struct nat64_key6 in the eBPF program
+type nat64Key6 struct {
+	Client     [16]byte
+	Server     [4]byte
+	ClientPort uint16
+	ServerPort uint16
+	Protocol   uint8
+	Pad        [3]uint8
+}
+
+// nat64Session mirrors struct nat64_session in the eBPF program
+type nat64Session struct {
+	LastSeen uint64
+	Pool     [4]byte
+	PrefixID uint32
+	PoolPort uint16
+	Pad      [6]uint8
+}
+
+// nat64Key4 mirrors struct nat64_key4 in the eBPF program
+type nat64Key4 struct {
+	Server     [4]byte
+	Pool       [4]byte
+	ServerPort uint16
+	PoolPort   uint16
+	Protocol   uint8
+	Pad        [3]uint8
+}
+
+// nat64Stats mirrors struct nat64_stats in the eBPF program
+type nat64Stats struct {
+	Out      uint64
+	In       uint64
+	Sessions uint64
+	Failed   uint64
+}
+
+// NAT64SessionEntry is one session read from the data plane
+type NAT64SessionEntry struct {
+	Key     nat64Key6
+	Session nat64Session
+}
+
+// returnKey is the key of the session's replies
+func (entry NAT64SessionEntry) returnKey() nat64Key4 {
+	return nat64Key4{
+		Server:     entry.Key.Server,
+		Pool:       entry.Session.Pool,
+		ServerPort: entry.Key.ServerPort,
+		PoolPort:   entry.Session.PoolPort,
+		Protocol:   entry.Key.Protocol,
+	}
+}
+
+// SetNAT64Prefix creates a NAT64 prefix or replaces the one with the same
+// prefix. A replaced prefix keeps its counters; its sessions are closed if
+// the pool changes.
+func (s *Server) SetNAT64Prefix(ctx context.Context, req *pb.SetNAT64PrefixRequest) (*pb.NAT64PrefixResponse, error) {
+	if req.GetPrefix() == nil {
+		return &pb.NAT64PrefixResponse{Success: false, Message: "NAT64 prefix is required"}, nil
+	}
+
+	prefix := nat64PrefixFromProto(req.Prefix)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := validateNAT64Prefix(prefix); err != nil {
+		return &pb.NAT64PrefixResponse{
+			Success: false,
+			Message: fmt.Sprintf("NAT64 prefix validation failed: %v", err),
+		}, nil
+	}
+	previous := s.nat64Prefixes[prefix.Prefix]
+	if previous != nil {
+		prefix.id = previous.id
+	} else if prefix.id = s.freeNAT64ID(); prefix.id == 0 {
+		return &pb.NAT64PrefixResponse{
+			Success: false,
+			Message: fmt.Sprintf("NAT64 prefix limit reached (%d prefixes)", MaxNAT64Prefixes-1),
+		}, nil
+	}
+
+	if err := s.pushNAT64Prefix(prefix); err != nil {
+		return &pb.NAT64PrefixResponse{Success: false, Message: fmt.Sprintf("Failed to push NAT64 prefix to data plane: %v", withRemediation(err))}, nil
+	}
+	if previous != nil && previous.pool != prefix.pool && s.bpfManager != nil {
+		if err := s.bpfManager.CloseNAT64Sessions(prefix.id); err != nil {
+			log.Printf("⚠️  Failed to close sessions of NAT64 prefix %s: %v", prefix.Prefix, err)
+		}
+	}
+	s.nat64Prefixes[prefix.Prefix] = prefix
+	s.persistPolicy()
+	log.Printf("Set NAT64 prefix: %s (pool %s)", prefix.Prefix, prefix.Pool)
+
+	return &pb.NAT64PrefixResponse{
+		Success: true,
+		Message: "NAT64 prefix saved successfully",
+		Prefix:  s.nat64PrefixToProto(prefix, nil),
+	}, nil
+}
+
+// DeleteNAT64Prefix stops translating a prefix and closes its sessions
+func (s *Server) DeleteNAT64Prefix(ctx context.Context, req *pb.DeleteNAT64PrefixRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	prefix, exists := s.nat64Prefixes[canonicalNAT64Prefix(req.Prefix)]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "NAT64 prefix not found"}, nil
+	}
+	if s.bpfManager != nil {
+		if err := s.bpfManager.DeleteNAT64Prefix(prefix.key(), prefix.id); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove NAT64 prefix from data plane: %v", withRemediation(err))}, nil
+		}
+		if err := s.bpfManager.CloseNAT64Sessions(prefix.id); err != nil {
+			log.Printf("⚠️  Failed to close sessions of NAT64 prefix %s: %v", prefix.Prefix, err)
+		}
+	}
+	delete(s.nat64Prefixes, prefix.Prefix)
+	s.persistPolicy()
+	log.Printf("Deleted NAT64 prefix: %s", prefix.Prefix)
+
+	return &pb.StatusResponse{Success: true, Message: "NAT64 prefix deleted successfully"}, nil
+}
+
+// ListNAT64Prefixes returns every NAT64 prefix with its counters and open
+// sessions, ordered by prefix
+func (s *Server) ListNAT64Prefixes(ctx context.Context, req *pb.Empty) (*pb.NAT64PrefixesResponse, error) {
+	return &pb.NAT64PrefixesResponse{Prefixes: s.nat64PrefixStatus()}, nil
+}
+
+// nat64PrefixStatus returns every NAT64 prefix with its counters and open
+// sessions, ordered by prefix
+func (s *Server) nat64PrefixStatus() []*pb.NAT64Prefix {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	open := make(map[uint32]uint32)
+	if s.bpfManager != nil {
+		sessions, err := s.bpfManager.NAT64Sessions()
+		if err != nil {
+			log.Printf("⚠️  Failed to read NAT64 sessions: %v", err)
+		}
+		for _, entry := range sessions {
+			open[entry.Session.PrefixID]++
+		}
+	}
+
+	var prefixes []*pb.NAT64Prefix
+	for _, prefix := range s.sortedNAT64Prefixes() {
+		prefixes = append(prefixes, s.nat64PrefixToProto(prefix, open))
+	}
+	return prefixes
+}
+
+// ListNAT64Sessions returns the open NAT64 sessions, most recently active
+// first
+func (s *Server) ListNAT64Sessions(ctx context.Context, req *pb.ListNAT64SessionsRequest) (*pb.NAT64SessionsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.NAT64SessionsResponse{}
+	if s.bpfManager == nil {
+		return resp, nil
+	}
+	sessions, err := s.bpfManager.NAT64Sessions()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Session.LastSeen > sessions[j].Session.LastSeen
+	})
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultNAT64SessionLimit
+	}
+	if len(sessions) > limit {
+		sessions, resp.Truncated = sessions[:limit], true
+	}
+
+	prefixes := make(map[uint32]string, len(s.nat64Prefixes))
+	for _, prefix := range s.nat64Prefixes {
+		prefixes[prefix.id] = prefix.Prefix
+	}
+	now := monotonicNow()
+	for _, entry := range sessions {
+		session := &pb.NAT64Session{
+			Protocol:   protocolName(entry.Key.Protocol),
+			Client:     netip.AddrFrom16(entry.Key.Client).String(),
+			ClientPort: uint32(entry.Key.ClientPort),
+			Server:     netip.AddrFrom4(entry.Key.Server).String(),
+			ServerPort: uint32(entry.Key.ServerPort),
+			Pool:       netip.AddrFrom4(entry.Session.Pool).String(),
+			PoolPort:   uint32(entry.Session.PoolPort),
+			Prefix:     prefixes[entry.Session.PrefixID],
+		}
+		if now > entry.Session.LastSeen {
+			session.IdleSeconds = int64((now - entry.Session.LastSeen) / uint64(time.Second))
+		}
+		resp.Sessions = append(resp.Sessions, session)
+	}
+	return resp, nil
+}
+
+// reapNAT64Sessions closes idle NAT64 sessions every interval until ctx is
+// done, releasing their pool ports
+func (s *Server) reapNAT64Sessions(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.mutex.RLock()
+		manager := s.bpfManager
+		s.mutex.RUnlock()
+		if manager == nil {
+			continue
+		}
+		sessions, err := manager.NAT64Sessions()
+		if err != nil {
+			log.Printf("⚠️  Failed to read NAT64 sessions: %v", err)
+			continue
+		}
+		now := monotonicNow()
+		for _, entry := range sessions {
+			timeout := nat64IdleTimeouts[entry.Key.Protocol]
+			if now < entry.Session.LastSeen || now-entry.Session.LastSeen < uint64(timeout) {
+				continue
+			}
+			if err := manager.CloseNAT64Session(entry); err != nil {
+				log.Printf("⚠️  Failed to close idle NAT64 session: %v", err)
+			}
+		}
+	}
+}
+
+// validateNAT64Prefix checks a NAT64 prefix and normalizes its addresses
+func validateNAT64Prefix(prefix *NAT64Prefix) error {
+	var errs ruleValidationError
+	parsed, err := netip.ParsePrefix(prefix.Prefix)
+	if err != nil {
+		errs.add("prefix", "invalid prefix %s: %v", prefix.Prefix, err)
+	} else if !parsed.Addr().Is6() || parsed.Addr().Is4In6() || parsed.Bits() != 96 {
+		errs.add("prefix", "invalid prefix %s: must be an IPv6 /96", prefix.Prefix)
+	} else if parsed.Masked() != parsed {
+		errs.add("prefix", "invalid prefix %s: host bits set", prefix.Prefix)
+	}
+	pool, err := netip.ParseAddr(prefix.Pool)
+	if err != nil || !pool.Unmap().Is4() {
+		errs.add("pool", "invalid pool %s: must be an IPv4 address", prefix.Pool)
+	} else if pool = pool.Unmap(); pool.IsUnspecified() || pool.IsMulticast() || pool == netip.AddrFrom4([4]byte{255, 255, 255, 255}) {
+		errs.add("pool", "invalid pool %s: not a host address", prefix.Pool)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	prefix.prefix, prefix.pool = parsed, pool
+	prefix.Prefix, prefix.Pool = parsed.String(), pool.String()
+	return nil
+}
+
+// canonicalNAT64Prefix returns the form prefixes are stored under, or the
+// input if it does not parse
+func canonicalNAT64Prefix(prefix string) string {
+	parsed, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return prefix
+	}
+	return parsed.Masked().String()
+}
+
+// key returns the data plane key of a validated prefix
+func (prefix *NAT64Prefix) key() nat64PrefixKey {
+	return nat64PrefixKey{Prefixlen: uint32(prefix.prefix.Bits()), Addr: prefix.prefix.Addr().As16()}
+}
+
+// freeNAT64ID returns the lowest NAT64 prefix ID not in use, 0 when all
+// are. Caller must hold s.mutex.
+func (s *Server) freeNAT64ID() uint32 {
+	used := make(map[uint32]bool, len(s.nat64Prefixes))
+	for _, prefix := range s.nat64Prefixes {
+		used[prefix.id] = true
+	}
+	for id := uint32(1); id < MaxNAT64Prefixes; id++ {
+		if !used[id] {
+			return id
+		}
+	}
+	return 0
+}
+
+// pushNAT64Prefix writes a prefix to the host data plane. Caller must hold
+// s.mutex.
+func (s *Server) pushNAT64Prefix(prefix *NAT64Prefix) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	return s.bpfManager.SetNAT64Prefix(prefix.key(), nat64PrefixValue{ID: prefix.id, Pool: prefix.pool.As4()})
+}
+
+// sortedNAT64Prefixes returns the NAT64 prefixes ordered by prefix. Caller
+// must hold s.mutex.
+func (s *Server) sortedNAT64Prefixes() []*NAT64Prefix {
+	prefixes := make([]*NAT64Prefix, 0, len(s.nat64Prefixes))
+	for _, prefix := range s.nat64Prefixes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i].Prefix < prefixes[j].Prefix })
+	return prefixes
+}
+
+func nat64PrefixFromProto(prefix *pb.NAT64Prefix) *NAT64Prefix {
+	return &NAT64Prefix{
+		Prefix:      prefix.Prefix,
+		Pool:        prefix.Pool,
+		Description: prefix.Description,
+	}
+}
+
+// nat64PrefixToProto converts a prefix with its counters and, if open is
+// given, its count of open sessions. Caller must hold s.mutex.
+func (s *Server) nat64PrefixToProto(prefix *NAT64Prefix, open map[uint32]uint32) *pb.NAT64Prefix {
+	resp := &pb.NAT64Prefix{
+		Prefix:      prefix.Prefix,
+		Pool:        prefix.Pool,
+		Description: prefix.Description,
+		Id:          prefix.id,
+		Sessions:    open[prefix.id],
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.NAT64Stats(prefix.id)
+		if err != nil {
+			log.Printf("⚠️  Failed to read counters of NAT64 prefix %s: %v", prefix.Prefix, err)
+		}
+		resp.PacketsOut = stats.Out
+		resp.PacketsIn = stats.In
+		resp.SessionsCreated = stats.Sessions
+		resp.Failed = stats.Failed
+	}
+	return resp
+}
+
+// openNAT64 opens the pinned NAT64 maps and clears prefixes left by a
+// previous control plane run, with their counters; stored ones are
+// re-pushed on restore. Sessions are kept so translated connections
+// survive a restart; those of prefixes that are gone idle out.
+func (bm *BPFMapManager) openNAT64() {
+	names := []string{NAT64PrefixesMapName, NAT64SessionsMapName, NAT64ReturnsMapName, NAT64StatsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  NAT64 not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.nat64Prefixes, bm.nat64Sessions, bm.nat64Returns, bm.nat64Stats = maps[0], maps[1], maps[2], maps[3]
+
+	var key nat64PrefixKey
+	var value nat64PrefixValue
+	stale := make(map[nat64PrefixKey]uint32)
+	entries := bm.nat64Prefixes.Iterate()
+	for entries.Next(&key, &value) {
+		stale[key] = value.ID
+	}
+	for key, id := range stale {
+		if err := bm.DeleteNAT64Prefix(key, id); err != nil {
+			log.Printf("⚠️  Failed to clear stale NAT64 prefix: %v", err)
+		}
+	}
+}
+
+// closeNAT64 closes the NAT64 maps, if open
+func (bm *BPFMapManager) closeNAT64() {
+	if bm.nat64Prefixes == nil {
+		return
+	}
+	bm.nat64Prefixes.Close()
+	bm.nat64Sessions.Close()
+	bm.nat64Returns.Close()
+	bm.nat64Stats.Close()
+}
+
+// SetNAT64Prefix writes a NAT64 prefix and its pool
+func (bm *BPFMapManager) SetNAT64Prefix(key nat64PrefixKey, value nat64PrefixValue) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting NAT64 prefix %d (pool %s)", value.ID, netip.AddrFrom4(value.Pool))
+		return nil
+	}
+	if bm.nat64Prefixes == nil {
+		return fmt.Errorf("NAT64 maps not available")
+	}
+	if err := bm.nat64Prefixes.Put(&key, &value); err != nil {
+		return fmt.Errorf("failed to write NAT64 prefix: %v", err)
+	}
+	return nil
+}
+
+// DeleteNAT64Prefix removes a NAT64 prefix and zeroes the counters of id
+func (bm *BPFMapManager) DeleteNAT64Prefix(key nat64PrefixKey, id uint32) error {
+	if bm.simulated || bm.nat64Prefixes == nil {
+		return nil
+	}
+	if err := bm.nat64Prefixes.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove NAT64 prefix: %v", err)
+	}
+	zero := make([]nat64Stats, ebpf.MustPossibleCPU())
+	if err := bm.nat64Stats.Put(&id, zero); err != nil {
+		return fmt.Errorf("failed to reset counters of NAT64 prefix %d: %v", id, err)
+	}
+	return nil
+}
+
+// NAT64Stats reads the counters of a NAT64 prefix, summed across CPUs
+func (bm *BPFMapManager) NAT64Stats(id uint32) (nat64Stats, error) {
+	var stats nat64Stats
+	if bm.simulated || bm.nat64Stats == nil {
+		return stats, nil
+	}
+	var perCPU []nat64Stats
+	if err := bm.nat64Stats.Lookup(&id, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Out += value.Out
+		stats.In += value.In
+		stats.Sessions += value.Sessions
+		stats.Failed += value.Failed
+	}
+	return stats, nil
+}
+
+// NAT64Sessions reads every open NAT64 session
+func (bm *BPFMapManager) NAT64Sessions() ([]NAT64SessionEntry, error) {
+	if bm.simulated || bm.nat64Sessions == nil {
+		return nil, nil
+	}
+	var sessions []NAT64SessionEntry
+	var entry NAT64SessionEntry
+	iter := bm.nat64Sessions.Iterate()
+	for iter.Next(&entry.Key, &entry.Session) {
+		sessions = append(sessions, entry)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read NAT64 sessions: %v", err)
+	}
+	return sessions, nil
+}
+
+// CloseNAT64Session removes a session in both directions, releasing its
+// pool port
+func (bm *BPFMapManager) CloseNAT64Session(entry NAT64SessionEntry) error {
+	if bm.simulated || bm.nat64Sessions == nil {
+		return nil
+	}
+	returnKey := entry.returnKey()
+	if err := bm.nat64Returns.Delete(&returnKey); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove NAT64 return mapping: %v", err)
+	}
+	if err := bm.nat64Sessions.Delete(&entry.Key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove NAT64 session: %v", err)
+	}
+	return nil
+}
+
+// CloseNAT64Sessions removes every session of a NAT64 prefix
+func (bm *BPFMapManager) CloseNAT64Sessions(id uint32) error {
+	sessions, err := bm.NAT64Sessions()
+	if err != nil {
+		return err
+	}
+	for _, entry := range sessions {
+		if entry.Session.PrefixID != id {
+			continue
+		}
+		if err := bm.CloseNAT64Session(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
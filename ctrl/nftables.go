@@ -0,0 +1,669 @@
+// SPDX-License-Identifier: Apache-2.0
+// nftables migration: translates `nft -j list ruleset` filter chains into rules, reporting what has no equivalent
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Priority of the first translated rule when the request sets none;
+	// later rules and chains follow in evaluation order
+	DefaultNFTablesPriority = 1000
+
+	// Most rules one nftables rule may expand to through sets
+	maxNFTablesExpansion = 256
+)
+
+// nftHookDirections maps the hooks of imported base chains to a direction
+var nftHookDirections = map[string]string{
+	"prerouting":  "inbound",
+	"input":       "inbound",
+	"forward":     "inbound",
+	"output":      "outbound",
+	"postrouting": "outbound",
+}
+
+type nftChain struct {
+	Family string `json:"family"`
+	Table  string `json:"table"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Hook   string `json:"hook"`
+	Prio   int    `json:"prio"`
+	Policy string `json:"policy"`
+}
+
+type nftRule struct {
+	Family  string                       `json:"family"`
+	Table   string                       `json:"table"`
+	Chain   string                       `json:"chain"`
+	Handle  int                          `json:"handle"`
+	Comment string                       `json:"comment"`
+	Expr    []map[string]json.RawMessage `json:"expr"`
+}
+
+type nftSet struct {
+	Family string            `json:"family"`
+	Table  string            `json:"table"`
+	Name   string            `json:"name"`
+	Elem   []json.RawMessage `json:"elem"`
+}
+
+type nftMatch struct {
+	Op    string          `json:"op"`
+	Left  json.RawMessage `json:"left"`
+	Right json.RawMessage `json:"right"`
+}
+
+// nftPortRange is an inclusive port range; a single port has end 0
+type nftPortRange struct {
+	start, end int32
+}
+
+// nftConditions collects the matches of one nftables rule; every list
+// holds alternatives, empty = any
+type nftConditions struct {
+	family   int
+	src, dst []string
+	sport    []nftPortRange
+	dport    []nftPortRange
+	protos   []string
+	states   []string
+	srcMAC   string
+	dstMAC   string
+	vlan     int32
+	ifaces   []string
+}
+
+// nftTranslation is the outcome of translating a ruleset
+type nftTranslation struct {
+	rules   []*FirewallRule
+	skipped []string // What could not be translated, and why
+}
+
+// nftKey identifies a chain or set within the ruleset
+func nftKey(family, table, name string) string {
+	return family + " " + table + " " + name
+}
+
+// translateNFTables converts the filter base chains of an nftables ruleset,
+// in JSON as printed by `nft -j list ruleset`, into rules. Chains of a
+// hook are laid out in nftables priority order and rules in chain order,
+// starting at basePriority; a drop policy becomes a final catch-all rule.
+func translateNFTables(data []byte, basePriority int32) (*nftTranslation, error) {
+	var ruleset struct {
+		NFTables []map[string]json.RawMessage `json:"nftables"`
+	}
+	if err := json.Unmarshal(data, &ruleset); err != nil {
+		return nil, fmt.Errorf("invalid nftables JSON: %v", err)
+	}
+	if ruleset.NFTables == nil {
+		return nil, fmt.Errorf("invalid nftables JSON: no nftables array, expected the output of nft -j list ruleset")
+	}
+
+	chains := make(map[string]*nftChain)
+	sets := make(map[string]*nftSet)
+	rulesByChain := make(map[string][]*nftRule)
+	for _, object := range ruleset.NFTables {
+		var err error
+		switch {
+		case object["chain"] != nil:
+			chain := &nftChain{}
+			if err = json.Unmarshal(object["chain"], chain); err == nil {
+				chains[nftKey(chain.Family, chain.Table, chain.Name)] = chain
+			}
+		case object["set"] != nil:
+			set := &nftSet{}
+			if err = json.Unmarshal(object["set"], set); err == nil {
+				sets[nftKey(set.Family, set.Table, set.Name)] = set
+			}
+		case object["rule"] != nil:
+			rule := &nftRule{}
+			if err = json.Unmarshal(object["rule"], rule); err == nil {
+				key := nftKey(rule.Family, rule.Table, rule.Chain)
+				rulesByChain[key] = append(rulesByChain[key], rule)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid nftables JSON: %v", err)
+		}
+	}
+
+	keys := make([]string, 0, len(chains))
+	for key := range chains {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := &nftTranslation{}
+	var imported []*nftChain
+	for _, key := range keys {
+		chain := chains[key]
+		switch {
+		case chain.Hook == "":
+			if len(rulesByChain[key]) > 0 {
+				result.skipped = append(result.skipped, fmt.Sprintf("%s: regular chain not imported, rules jumping to it are not translated", key))
+			}
+		case chain.Family != "ip" && chain.Family != "ip6" && chain.Family != "inet":
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: %s family chains are not supported", key, chain.Family))
+		case chain.Type != "filter":
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: %s chains are not supported", key, chain.Type))
+		case nftHookDirections[chain.Hook] == "":
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: %s hook is not supported", key, chain.Hook))
+		default:
+			imported = append(imported, chain)
+		}
+	}
+	sort.Slice(imported, func(i, j int) bool {
+		a, b := imported[i], imported[j]
+		if nftHookDirections[a.Hook] != nftHookDirections[b.Hook] {
+			return nftHookDirections[a.Hook] < nftHookDirections[b.Hook]
+		}
+		if a.Prio != b.Prio {
+			return a.Prio < b.Prio
+		}
+		return nftKey(a.Family, a.Table, a.Name) < nftKey(b.Family, b.Table, b.Name)
+	})
+
+	priorities := make(map[string]int32) // Direction -> next priority
+	chainsPerDirection := make(map[string]int)
+	for _, chain := range imported {
+		direction := nftHookDirections[chain.Hook]
+		key := nftKey(chain.Family, chain.Table, chain.Name)
+		if _, started := priorities[direction]; !started {
+			priorities[direction] = basePriority
+		}
+
+		var chainRules []*FirewallRule
+		for _, rule := range rulesByChain[key] {
+			translated, err := translateNFTRule(chain, rule, sets, priorities[direction])
+			if err != nil {
+				result.skipped = append(result.skipped, fmt.Sprintf("%s handle %d: %v", key, rule.Handle, err))
+				continue
+			}
+			chainRules = append(chainRules, translated...)
+			priorities[direction]++
+		}
+
+		if chain.Policy == "drop" {
+			policy := &FirewallRule{
+				ID:          nftRuleID(chain, "policy", 0),
+				Action:      "drop",
+				Direction:   direction,
+				Priority:    priorities[direction],
+				Enabled:     true,
+				Description: fmt.Sprintf("nftables %s policy drop", key),
+			}
+			switch chain.Family {
+			case "ip":
+				policy.SrcIP = "0.0.0.0/0"
+			case "ip6":
+				policy.SrcIP = "::/0"
+			}
+			chainRules = append(chainRules, policy)
+			priorities[direction]++
+		}
+		if len(chainRules) == 0 {
+			continue
+		}
+
+		if chainsPerDirection[direction]++; chainsPerDirection[direction] == 2 {
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: several %s chains are imported one after the other; "+
+				"nftables also evaluates later chains after an accept, the data plane stops at the first match", key, direction))
+		}
+		if chain.Hook == "forward" {
+			result.skipped = append(result.skipped, fmt.Sprintf("%s: forward chain imported as inbound, it also matches traffic to this host", key))
+		}
+		result.rules = append(result.rules, chainRules...)
+	}
+	return result, nil
+}
+
+// nftRuleID names a translated rule after its source, so importing the
+// same ruleset again replaces the rules instead of adding copies
+func nftRuleID(chain *nftChain, handle string, expansion int) string {
+	id := fmt.Sprintf("nft_%s_%s_%s_%s", chain.Family, chain.Table, chain.Name, handle)
+	if expansion > 0 {
+		id += "_" + strconv.Itoa(expansion)
+	}
+	return id
+}
+
+// translateNFTRule translates one rule, expanding sets into a rule per
+// combination of their elements
+func translateNFTRule(chain *nftChain, rule *nftRule, sets map[string]*nftSet, priority int32) ([]*FirewallRule, error) {
+	conditions := &nftConditions{}
+	switch chain.Family {
+	case "ip":
+		conditions.family = familyIPv4
+	case "ip6":
+		conditions.family = familyIPv6
+	}
+
+	action := ""
+	for _, expr := range rule.Expr {
+		for name, value := range expr {
+			switch name {
+			case "match":
+				var match nftMatch
+				if err := json.Unmarshal(value, &match); err != nil {
+					return nil, fmt.Errorf("invalid match: %v", err)
+				}
+				if err := conditions.add(chain, &match, sets); err != nil {
+					return nil, err
+				}
+			case "counter", "log":
+				// No effect on the verdict
+			case "accept":
+				action = "allow"
+			case "drop":
+				action = "drop"
+			case "reject":
+				action = "reject"
+			default:
+				return nil, fmt.Errorf("unsupported statement %s", name)
+			}
+		}
+	}
+	if action == "" {
+		return nil, fmt.Errorf("no accept or drop verdict")
+	}
+	return conditions.expand(chain, rule, action, priority)
+}
+
+// add narrows the conditions by one match
+func (c *nftConditions) add(chain *nftChain, match *nftMatch, sets map[string]*nftSet) error {
+	if match.Op != "==" && match.Op != "in" {
+		return fmt.Errorf("unsupported operator %s", match.Op)
+	}
+	var left struct {
+		Payload *struct {
+			Protocol string `json:"protocol"`
+			Field    string `json:"field"`
+		} `json:"payload"`
+		Meta *struct {
+			Key string `json:"key"`
+		} `json:"meta"`
+		CT *struct {
+			Key string `json:"key"`
+		} `json:"ct"`
+	}
+	if err := json.Unmarshal(match.Left, &left); err != nil {
+		return fmt.Errorf("invalid match: %v", err)
+	}
+	values, err := nftValues(match.Right, chain, sets)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case left.Payload != nil && (left.Payload.Protocol == "ip" || left.Payload.Protocol == "ip6"):
+		family := familyIPv4
+		if left.Payload.Protocol == "ip6" {
+			family = familyIPv6
+		}
+		if err := c.restrictFamily(family); err != nil {
+			return err
+		}
+		switch left.Payload.Field {
+		case "saddr":
+			c.src, err = nftAddresses(values, family)
+			return err
+		case "daddr":
+			c.dst, err = nftAddresses(values, family)
+			return err
+		case "protocol", "nexthdr":
+			return c.restrictProtocols(values)
+		}
+	case left.Payload != nil && (protocolHasPorts(left.Payload.Protocol) || left.Payload.Protocol == "th"):
+		if left.Payload.Protocol != "th" {
+			if err := c.restrictProtocols([]any{left.Payload.Protocol}); err != nil {
+				return err
+			}
+		}
+		switch left.Payload.Field {
+		case "sport":
+			c.sport, err = nftPorts(values)
+			return err
+		case "dport":
+			c.dport, err = nftPorts(values)
+			return err
+		}
+	case left.Payload != nil && left.Payload.Protocol == "ether" && (left.Payload.Field == "saddr" || left.Payload.Field == "daddr"):
+		var mac string
+		if len(values) == 1 {
+			mac, _ = values[0].(string)
+		}
+		if _, err := parseMAC(mac); err != nil || mac == "" {
+			return fmt.Errorf("unsupported ether %s %v", left.Payload.Field, values)
+		}
+		if left.Payload.Field == "saddr" {
+			c.srcMAC = canonicalMAC(mac)
+		} else {
+			c.dstMAC = canonicalMAC(mac)
+		}
+		return nil
+	case left.Payload != nil && left.Payload.Protocol == "vlan" && left.Payload.Field == "id":
+		var id float64
+		if len(values) == 1 {
+			id, _ = values[0].(float64)
+		}
+		if id < 1 || id > MaxVLANID || id != float64(int32(id)) {
+			return fmt.Errorf("unsupported vlan id %v", values)
+		}
+		c.vlan = int32(id)
+		return nil
+	case left.Meta != nil && (left.Meta.Key == "iifname" || left.Meta.Key == "oifname"):
+		if (left.Meta.Key == "iifname") != (nftHookDirections[chain.Hook] == "inbound") {
+			return fmt.Errorf("meta %s in a %s chain is not translated", left.Meta.Key, chain.Hook)
+		}
+		for _, value := range values {
+			name, _ := value.(string)
+			if strings.Contains(name, "*") || validateInterfaceName(name) != nil {
+				return fmt.Errorf("unsupported meta %s %v", left.Meta.Key, value)
+			}
+			c.ifaces = append(c.ifaces, name)
+		}
+		return nil
+	case left.Meta != nil && left.Meta.Key == "l4proto":
+		return c.restrictProtocols(values)
+	case left.Meta != nil && left.Meta.Key == "nfproto":
+		if len(values) != 1 || (values[0] != "ipv4" && values[0] != "ipv6") {
+			return fmt.Errorf("unsupported meta nfproto %v", values)
+		}
+		family := familyIPv4
+		if values[0] == "ipv6" {
+			family = familyIPv6
+		}
+		return c.restrictFamily(family)
+	case left.CT != nil && left.CT.Key == "state":
+		for _, value := range values {
+			state, _ := value.(string)
+			if _, known := connStateBits[state]; !known {
+				return fmt.Errorf("unsupported ct state %v", value)
+			}
+			c.states = append(c.states, state)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported match %s", strings.Join(strings.Fields(string(match.Left)), ""))
+}
+
+// restrictFamily limits the rule to one address family
+func (c *nftConditions) restrictFamily(family int) error {
+	if c.family != familyAny && c.family != family {
+		return fmt.Errorf("matches both IPv4 and IPv6 headers and can never match")
+	}
+	c.family = family
+	return nil
+}
+
+// restrictProtocols intersects the protocols matched with a list
+func (c *nftConditions) restrictProtocols(values []any) error {
+	var protos []string
+	for _, value := range values {
+		var proto string
+		switch v := value.(type) {
+		case string:
+			proto = v
+		case float64:
+			proto = protocolName(uint8(v))
+		}
+		switch proto {
+		case "ipencap":
+			proto = "ipip"
+		case "ipv6-icmp":
+			proto = "icmp"
+		}
+		if v, isNumber := value.(float64); (isNumber && (v < 1 || v > 255)) || validateProtocol(proto) != nil {
+			return fmt.Errorf("unsupported protocol %v", value)
+		}
+		if len(c.protos) == 0 || containsString(c.protos, proto) {
+			protos = append(protos, proto)
+		}
+	}
+	if len(protos) == 0 {
+		return fmt.Errorf("protocol matches exclude each other and can never match")
+	}
+	c.protos = protos
+	return nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// expand builds the rules of every combination of alternatives
+func (c *nftConditions) expand(chain *nftChain, rule *nftRule, action string, priority int32) ([]*FirewallRule, error) {
+	if (len(c.sport) > 0 || len(c.dport) > 0) && len(c.protos) == 0 {
+		return nil, fmt.Errorf("port match without a protocol")
+	}
+	orAny := func(n int) int { return max(n, 1) }
+	combinations := orAny(len(c.src)) * orAny(len(c.dst)) * orAny(len(c.sport)) * orAny(len(c.dport)) * orAny(len(c.protos))
+	if combinations > maxNFTablesExpansion {
+		return nil, fmt.Errorf("sets expand to %d rules, more than %d", combinations, maxNFTablesExpansion)
+	}
+
+	description := rule.Comment
+	if description == "" {
+		description = fmt.Sprintf("nftables %s handle %d", nftKey(chain.Family, chain.Table, chain.Name), rule.Handle)
+	}
+	base := FirewallRule{
+		Action:      action,
+		Direction:   nftHookDirections[chain.Hook],
+		Priority:    priority,
+		Enabled:     true,
+		Description: description,
+		ConnState:   c.states,
+		SrcMAC:      c.srcMAC,
+		DstMAC:      c.dstMAC,
+		VLAN:        c.vlan,
+		Interfaces:  canonicalInterfaces(c.ifaces),
+	}
+	switch {
+	case c.family == familyIPv4 && len(c.src) == 0 && len(c.dst) == 0:
+		base.SrcIP = "0.0.0.0/0"
+	case c.family == familyIPv6 && len(c.src) == 0 && len(c.dst) == 0:
+		base.SrcIP = "::/0"
+	}
+
+	orEmpty := func(list []string) []string {
+		if len(list) == 0 {
+			return []string{""}
+		}
+		return list
+	}
+	orAnyPort := func(list []nftPortRange) []nftPortRange {
+		if len(list) == 0 {
+			return []nftPortRange{{}}
+		}
+		return list
+	}
+
+	var rules []*FirewallRule
+	for _, src := range orEmpty(c.src) {
+		for _, dst := range orEmpty(c.dst) {
+			if !familiesCompatible(src, dst) {
+				continue
+			}
+			for _, proto := range orEmpty(c.protos) {
+				for _, sport := range orAnyPort(c.sport) {
+					for _, dport := range orAnyPort(c.dport) {
+						translated := base
+						translated.ID = nftRuleID(chain, strconv.Itoa(rule.Handle), len(rules))
+						if src != "" || dst != "" {
+							translated.SrcIP, translated.DstIP = src, dst
+						}
+						translated.Protocol = proto
+						translated.SrcPort, translated.SrcPortEnd = sport.start, sport.end
+						translated.DstPort, translated.DstPortEnd = dport.start, dport.end
+						rules = append(rules, &translated)
+					}
+				}
+			}
+		}
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("source and destination families differ and can never match")
+	}
+	return rules, nil
+}
+
+// nftValues decodes the right side of a match into its alternatives:
+// strings, numbers, prefixes as CIDR strings and ranges as [2]float64.
+// Anonymous and named sets are expanded.
+func nftValues(raw json.RawMessage, chain *nftChain, sets map[string]*nftSet) ([]any, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("invalid value: %v", err)
+	}
+	if name, named := value.(string); named && strings.HasPrefix(name, "@") {
+		set := sets[nftKey(chain.Family, chain.Table, strings.TrimPrefix(name, "@"))]
+		if set == nil {
+			return nil, fmt.Errorf("unknown set %s", name)
+		}
+		if len(set.Elem) == 0 {
+			return nil, fmt.Errorf("set %s is empty or dynamic", name)
+		}
+		var values []any
+		for _, elem := range set.Elem {
+			elemValues, err := nftValues(elem, chain, sets)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, elemValues...)
+		}
+		return values, nil
+	}
+	return nftFlatten(value)
+}
+
+// nftFlatten normalizes a decoded value, flattening sets
+func nftFlatten(value any) ([]any, error) {
+	switch v := value.(type) {
+	case string, float64:
+		return []any{v}, nil
+	case []any:
+		var values []any
+		for _, item := range v {
+			flat, err := nftFlatten(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, flat...)
+		}
+		return values, nil
+	case map[string]any:
+		if set, ok := v["set"]; ok {
+			return nftFlatten(set)
+		}
+		if elem, ok := v["elem"].(map[string]any); ok {
+			return nftFlatten(elem["val"])
+		}
+		if prefix, ok := v["prefix"].(map[string]any); ok {
+			addr, _ := prefix["addr"].(string)
+			length, _ := prefix["len"].(float64)
+			return []any{fmt.Sprintf("%s/%d", addr, int(length))}, nil
+		}
+		if bounds, ok := v["range"].([]any); ok && len(bounds) == 2 {
+			start, startNum := bounds[0].(float64)
+			end, endNum := bounds[1].(float64)
+			if !startNum || !endNum {
+				return nil, fmt.Errorf("address ranges are not supported")
+			}
+			return []any{[2]float64{start, end}}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported value %v", value)
+}
+
+// nftAddresses converts values to prefixes of a family
+func nftAddresses(values []any, family int) ([]string, error) {
+	var addresses []string
+	for _, value := range values {
+		address, _ := value.(string)
+		prefix, err := parsePrefix(address)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported address %v", value)
+		}
+		if (family == familyIPv4) != prefix.Addr().Is4() {
+			return nil, fmt.Errorf("address %s does not match the %s header", address, familyName(family))
+		}
+		addresses = append(addresses, prefix.String())
+	}
+	return addresses, nil
+}
+
+// nftPorts converts values to port ranges
+func nftPorts(values []any) ([]nftPortRange, error) {
+	var ports []nftPortRange
+	for _, value := range values {
+		switch v := value.(type) {
+		case float64:
+			ports = append(ports, nftPortRange{start: int32(v)})
+		case [2]float64:
+			ports = append(ports, nftPortRange{start: int32(v[0]), end: int32(v[1])})
+		case string:
+			port, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported port %q, service names are not resolved", v)
+			}
+			ports = append(ports, nftPortRange{start: int32(port)})
+		default:
+			return nil, fmt.Errorf("unsupported port %v", value)
+		}
+	}
+	return ports, nil
+}
+
+// ImportNFTables translates an nftables ruleset and imports the result
+// like ImportRules, or only returns it on a dry run. Everything that could
+// not be translated is listed in skipped.
+func (s *Server) ImportNFTables(ctx context.Context, req *pb.ImportNFTablesRequest) (*pb.ImportNFTablesResponse, error) {
+	basePriority := req.BasePriority
+	if basePriority == 0 {
+		basePriority = DefaultNFTablesPriority
+	}
+	translation, err := translateNFTables(req.Ruleset, basePriority)
+	if err != nil {
+		return &pb.ImportNFTablesResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	resp := &pb.ImportNFTablesResponse{Skipped: translation.skipped}
+	rules := make([]*pb.Rule, 0, len(translation.rules))
+	for _, rule := range translation.rules {
+		resp.Rules = append(resp.Rules, toProtoRule(rule))
+		rules = append(rules, toProtoRule(rule))
+	}
+	if req.DryRun {
+		resp.Success = true
+		resp.Message = fmt.Sprintf("Translated %d rules, %d items skipped", len(rules), len(translation.skipped))
+		return resp, nil
+	}
+
+	imported, err := s.importRules(ctx, rules, nil, nil, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+	resp.Success, resp.Errors = imported.Success, imported.Errors
+	resp.Count, resp.Total, resp.Version = imported.Count, imported.Total, imported.Version
+	resp.Message = imported.Message
+	if imported.Success {
+		resp.Message = fmt.Sprintf("Imported %d rules, %d items skipped", len(rules), len(translation.skipped))
+	}
+	return resp, nil
+}
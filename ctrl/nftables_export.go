@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// nftables export: the running policy as an nft script, for auditing and as a fallback firewall
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RuleDocumentNFT is the export format of the nftables script; scripts are
+// not accepted by ImportRules, ImportNFTables reads `nft -j list ruleset`
+const RuleDocumentNFT = "nft"
+
+// nftCommentMax is the longest comment nftables accepts
+const nftCommentMax = 128
+
+// nftablesScript renders the host entries of a compiled policy as an nft -f
+// script. Inbound entries go to a prerouting chain, which like XDP sees
+// every received packet, and outbound ones to a postrouting chain, in
+// evaluation order; the built-in defaults of the data plane end the
+// inbound chain. Loading the script replaces an earlier export; its header
+// carries the export time now.
+func nftablesScript(policy *CompiledPolicy, now time.Time) ([]byte, int) {
+	var inbound, outbound []string
+	entries, otherScopes := 0, 0
+	for _, entry := range policy.Sorted {
+		if entry.Namespace != "" || entry.VF != "" {
+			otherScopes++
+			continue
+		}
+		statement := nftStatement(entry)
+		hookStatement := func(ifaceKeyword string) string {
+			hooked := nftInterfaceMatch(ifaceKeyword, entry.Interfaces) + statement
+			if !entry.Enabled {
+				return "# disabled: " + hooked
+			}
+			if unexpressed := nftUnexpressed(entry); unexpressed != "" {
+				return fmt.Sprintf("# matches %s, not exported: %s", unexpressed, hooked)
+			}
+			return hooked
+		}
+		ingress, egress := ruleHooks(entry)
+		if ingress {
+			inbound = append(inbound, hookStatement("iifname"))
+		}
+		if egress {
+			outbound = append(outbound, hookStatement("oifname"))
+		}
+		entries++
+	}
+	inbound = append(inbound,
+		"# Data plane defaults when no rule matches: IPv4 ICMP is dropped, IPv4 TCP goes to AF_XDP, the rest passes",
+		`meta nfproto ipv4 meta l4proto icmp drop comment "cerberus default"`)
+
+	var script strings.Builder
+	script.WriteString("#!/usr/sbin/nft -f\n")
+	fmt.Fprintf(&script, "# Cerberus-V policy version %d, exported %s\n", policy.Version, now.UTC().Format(time.RFC3339))
+	if otherScopes > 0 {
+		fmt.Fprintf(&script, "# %d entries of namespace and VF data planes are not included\n", otherScopes)
+	}
+	script.WriteString("\ntable inet cerberus\ndelete table inet cerberus\n\ntable inet cerberus {\n")
+	writeChain := func(name, hook string, statements []string) {
+		fmt.Fprintf(&script, "\tchain %s {\n\t\ttype filter hook %s priority filter; policy accept;\n", name, hook)
+		for _, statement := range statements {
+			fmt.Fprintf(&script, "\t\t%s\n", statement)
+		}
+		script.WriteString("\t}\n")
+	}
+	writeChain("inbound", "prerouting", inbound)
+	script.WriteString("\n")
+	writeChain("outbound", "postrouting", outbound)
+	script.WriteString("}\n")
+	return []byte(script.String()), entries
+}
+
+// nftUnexpressed names the matches of an entry that resolve through
+// control plane state the script does not carry, empty when there are none.
+// Without them the rule would match far more traffic, so it is left out.
+func nftUnexpressed(entry *FirewallRule) string {
+	var unexpressed []string
+	if entry.SrcSet != 0 || entry.DstSet != 0 {
+		unexpressed = append(unexpressed, "IP sets")
+	}
+	if len(entry.SrcCountry) > 0 || len(entry.DstCountry) > 0 {
+		unexpressed = append(unexpressed, "countries")
+	}
+	if len(entry.SrcASN) > 0 || len(entry.DstASN) > 0 {
+		unexpressed = append(unexpressed, "ASNs")
+	}
+	return strings.Join(unexpressed, " and ")
+}
+
+// nftInterfaceMatch renders the interface match of an entry, iifname in
+// the inbound chain and oifname in the outbound one, empty for any
+func nftInterfaceMatch(keyword string, interfaces []string) string {
+	switch len(interfaces) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s %q ", keyword, interfaces[0])
+	}
+	quoted := make([]string, len(interfaces))
+	for i, name := range interfaces {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return fmt.Sprintf("%s { %s } ", keyword, strings.Join(quoted, ", "))
+}
+
+// nftStatement renders one compiled entry as an nftables rule
+func nftStatement(entry *FirewallRule) string {
+	var matches []string
+	if entry.VLAN != 0 {
+		matches = append(matches, fmt.Sprintf("vlan id %d", entry.VLAN))
+	}
+	if entry.SrcMAC != "" {
+		matches = append(matches, "ether saddr "+entry.SrcMAC)
+	}
+	if entry.DstMAC != "" {
+		matches = append(matches, "ether daddr "+entry.DstMAC)
+	}
+	family := ruleFamily(entry)
+	switch family {
+	case familyIPv4:
+		matches = append(matches, nftAddressMatches("ip", entry, family)...)
+	case familyIPv6:
+		matches = append(matches, nftAddressMatches("ip6", entry, family)...)
+	}
+
+	hasPorts := entry.SrcPort != 0 || entry.DstPort != 0
+	switch entry.Protocol {
+	case "tcp", "udp", "sctp":
+		if entry.SrcPort != 0 {
+			matches = append(matches, fmt.Sprintf("%s sport %s", entry.Protocol, nftPortMatch(entry.SrcPort, entry.SrcPortEnd)))
+		}
+		if entry.DstPort != 0 {
+			matches = append(matches, fmt.Sprintf("%s dport %s", entry.Protocol, nftPortMatch(entry.DstPort, entry.DstPortEnd)))
+		}
+		if !hasPorts {
+			matches = append(matches, "meta l4proto "+entry.Protocol)
+		}
+	case "icmp":
+		switch family {
+		case familyIPv4:
+			matches = append(matches, "meta l4proto icmp")
+		case familyIPv6:
+			matches = append(matches, "meta l4proto ipv6-icmp")
+		default:
+			matches = append(matches, "meta l4proto { icmp, ipv6-icmp }")
+		}
+	case "", "any":
+	default:
+		// By number: the names of 4 and 41 differ between protocol databases
+		matches = append(matches, fmt.Sprintf("meta l4proto %d", protocolToUint8(entry.Protocol)))
+	}
+
+	if flags, mask, _ := parseTCPFlags(entry.TCPFlags); mask != 0 {
+		set := "0"
+		if flags != 0 {
+			set = strings.ReplaceAll(formatTCPFlagList(flags), ",", " | ")
+		}
+		matches = append(matches, fmt.Sprintf("tcp flags & (%s) == %s", strings.ReplaceAll(formatTCPFlagList(mask), ",", " | "), set))
+	}
+
+	if len(entry.ConnState) == 1 {
+		matches = append(matches, "ct state "+entry.ConnState[0])
+	} else if len(entry.ConnState) > 1 {
+		matches = append(matches, "ct state { "+strings.Join(entry.ConnState, ", ")+" }")
+	}
+
+	verdict := "accept"
+	switch {
+	case entry.Action == "drop":
+		verdict = "drop"
+	case entry.Action == "reject" && entry.Protocol == "tcp":
+		verdict = "reject with tcp reset"
+	case entry.Action == "reject":
+		verdict = "reject"
+	}
+	matches = append(matches, verdict)
+
+	comment := entry.ID
+	if entry.Action == "redirect" {
+		comment += " (redirect to AF_XDP)"
+	}
+	if entry.Action == "mirror" {
+		comment += " (mirror to " + entry.MirrorTo + ")"
+	}
+	if entry.Description != "" {
+		comment += ": " + entry.Description
+	}
+	comment = strings.NewReplacer(`"`, "'", `\`, "/", "\n", " ", "\r", " ").Replace(comment)
+	if len(comment) > nftCommentMax {
+		comment = strings.ToValidUTF8(comment[:nftCommentMax], "")
+	}
+	matches = append(matches, `comment "`+comment+`"`)
+	return strings.Join(matches, " ")
+}
+
+// nftAddressMatches matches the prefixes of an entry; a /0 prefix only
+// restricts the family
+func nftAddressMatches(protocol string, entry *FirewallRule, family int) []string {
+	var matches []string
+	src, dst := rulePrefix(entry.SrcIP, family), rulePrefix(entry.DstIP, family)
+	if src.Bits() > 0 {
+		matches = append(matches, fmt.Sprintf("%s saddr %s", protocol, src))
+	}
+	if dst.Bits() > 0 {
+		matches = append(matches, fmt.Sprintf("%s daddr %s", protocol, dst))
+	}
+	if len(matches) == 0 {
+		nfproto := "ipv4"
+		if family == familyIPv6 {
+			nfproto = "ipv6"
+		}
+		matches = append(matches, "meta nfproto "+nfproto)
+	}
+	return matches
+}
+
+// nftPortMatch renders a port or an inclusive range
+func nftPortMatch(start, end int32) string {
+	if end != 0 && end != start {
+		return fmt.Sprintf("%d-%d", start, end)
+	}
+	return fmt.Sprintf("%d", start)
+}
@@ -0,0 +1,535 @@
+// SPDX-License-Identifier: Apache-2.0
+// Packet normalization: inbound packets from bogon sources, with checksums
+// that do not verify or with illegal TCP flags, dropped by the data plane
+// before rules and reported as events
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned normalization maps (must match eBPF program)
+	NormConfigMapName  = "cerberus_norm_config"
+	Bogons4MapName     = "cerberus_bogons4"
+	Bogons6MapName     = "cerberus_bogons6"
+	NormStatsMapName   = "cerberus_norm_stats"
+	NormSourcesMapName = "cerberus_norm_sources"
+
+	// EventPacketNormalized reports packets of one source that
+	// normalization dropped for one reason; metadata "reason" names it
+	EventPacketNormalized = "PACKET_NORMALIZED"
+
+	// Most exempt prefixes, leaving room in the bogon tries (MAX_BOGONS
+	// in eBPF program)
+	MaxNormalizationExempt = 256
+
+	// How often dropping sources are reported, and the most reported per
+	// interval; the rest are only logged
+	normalizationInterval  = 5 * time.Second
+	normalizationEventsMax = 100
+)
+
+// Data plane checks (enum norm_checks in the eBPF program)
+const (
+	normCheckBogons    = 1 << 0
+	normCheckChecksums = 1 << 1
+	normCheckTCPFlags  = 1 << 2
+)
+
+// normReasons are the reasons packets are dropped for, by value (must
+// match enum norm_reason, 0 unused)
+var normReasons = []string{"", "bogon", "ip_checksum", "l4_checksum", "tcp_null", "tcp_xmas", "tcp_flags"}
+
+// normReasonMessages describe each reason in events
+var normReasonMessages = map[string]string{
+	"bogon":       "bogon source address",
+	"ip_checksum": "invalid IPv4 header checksum",
+	"l4_checksum": "invalid TCP, UDP or ICMP checksum",
+	"tcp_null":    "TCP NULL scan (no flags)",
+	"tcp_xmas":    "TCP XMAS scan (FIN, PSH and URG)",
+	"tcp_flags":   "illegal TCP flag combination",
+}
+
+// Source prefixes no host on the internet sends from: unspecified,
+// loopback, documentation, benchmarking, reserved and multicast ranges
+// (RFC 6890). 0.0.0.0 itself is the source of DHCP requests and IPv6 ::
+// that of duplicate address detection, neither is listed.
+var martianPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("::ffff:0:0/96"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("3fff::/20"),
+	netip.MustParsePrefix("fec0::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+// Source prefixes of private, shared and link-local space (RFC 1918,
+// RFC 6598, RFC 3927, RFC 4193), bogons on interfaces facing the internet
+// only. IPv6 link-local sources carry neighbor discovery and are never
+// bogons.
+var privatePrefixes = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("fc00::/7"),
+}
+
+// Sources inside martianPrefixes that are not bogons
+var martianExceptions = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/32"),
+}
+
+// NormalizationConfig is which checks inbound packets must pass
+type NormalizationConfig struct {
+	DropBogons           bool           `json:"drop_bogons"`
+	DropPrivate          bool           `json:"drop_private"`
+	DropInvalidChecksums bool           `json:"drop_invalid_checksums"`
+	DropInvalidTCPFlags  bool           `json:"drop_invalid_tcp_flags"`
+	Exempt               []netip.Prefix `json:"exempt,omitempty"` // Sources never dropped as bogons
+}
+
+// normConfig mirrors struct norm_config in the eBPF program
+type normConfig struct {
+	Checks uint32
+}
+
+// normStats mirrors struct norm_stats in the eBPF program
+type normStats struct {
+	Dropped [7]uint64 // By reason, see normReasons
+}
+
+// normSourceKey mirrors struct norm_source_key in the eBPF program
+type normSourceKey struct {
+	Addr   [16]byte
+	Family uint8
+	Reason uint8
+	Pad    [2]uint8
+}
+
+// normSource mirrors struct norm_source in the eBPF program
+type normSource struct {
+	Packets  uint64
+	LastSeen uint64 // CLOCK_MONOTONIC nanoseconds
+}
+
+// normDropper is a source normalization dropped packets of for a reason
+type normDropper struct {
+	key    normSourceKey
+	source normSource
+}
+
+func (key normSourceKey) addr() netip.Addr {
+	if key.Family == familyIPv4 {
+		return netip.AddrFrom4([4]byte(key.Addr[:4]))
+	}
+	return netip.AddrFrom16(key.Addr)
+}
+
+func (key normSourceKey) reason() string {
+	if int(key.Reason) < len(normReasons) && key.Reason != 0 {
+		return normReasons[key.Reason]
+	}
+	return strconv.Itoa(int(key.Reason))
+}
+
+// enabled reports whether any check is on
+func (config NormalizationConfig) enabled() bool {
+	return config.DropBogons || config.DropInvalidChecksums || config.DropInvalidTCPFlags
+}
+
+// validateNormalizationConfig checks a config
+func validateNormalizationConfig(config *NormalizationConfig) error {
+	var errs ruleValidationError
+	if config.DropPrivate && !config.DropBogons {
+		errs.add("drop_private", "drop_private requires drop_bogons")
+	}
+	if len(config.Exempt) > MaxNormalizationExempt {
+		errs.add("exempt", "at most %d prefixes can be exempt", MaxNormalizationExempt)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// encode converts a config to the data plane's
+func (config NormalizationConfig) encode() normConfig {
+	var encoded normConfig
+	if config.DropBogons {
+		encoded.Checks |= normCheckBogons
+	}
+	if config.DropInvalidChecksums {
+		encoded.Checks |= normCheckChecksums
+	}
+	if config.DropInvalidTCPFlags {
+		encoded.Checks |= normCheckTCPFlags
+	}
+	return encoded
+}
+
+// bogons returns the entries of the bogon tries: 1 for bogon prefixes, 0
+// for exempt ones, which win over a bogon prefix of the same length
+func (config NormalizationConfig) bogons() map[netip.Prefix]uint8 {
+	bogons := make(map[netip.Prefix]uint8)
+	if !config.DropBogons {
+		return bogons
+	}
+	for _, prefix := range martianPrefixes {
+		bogons[prefix] = 1
+	}
+	if config.DropPrivate {
+		for _, prefix := range privatePrefixes {
+			bogons[prefix] = 1
+		}
+	}
+	for _, prefix := range martianExceptions {
+		bogons[prefix] = 0
+	}
+	for _, prefix := range config.Exempt {
+		bogons[prefix] = 0
+	}
+	return bogons
+}
+
+// SetNormalizationConfig replaces the packet normalization config
+func (s *Server) SetNormalizationConfig(ctx context.Context, req *pb.SetNormalizationConfigRequest) (*pb.NormalizationStatusResponse, error) {
+	if req.GetConfig() == nil {
+		return &pb.NormalizationStatusResponse{Success: false, Message: "Normalization config is required"}, nil
+	}
+
+	config, err := normalizationConfigFromProto(req.Config)
+	if err == nil {
+		err = validateNormalizationConfig(&config)
+	}
+	if err != nil {
+		return &pb.NormalizationStatusResponse{Success: false, Message: fmt.Sprintf("Normalization config validation failed: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	if err := s.pushNormalization(config); err != nil {
+		s.mutex.Unlock()
+		return &pb.NormalizationStatusResponse{Success: false, Message: fmt.Sprintf("Failed to push normalization config to data plane: %v", withRemediation(err))}, nil
+	}
+	s.normalization = config
+	s.persistPolicy()
+	s.mutex.Unlock()
+	log.Printf("Set packet normalization: bogons=%v (private=%v, %d exempt), checksums=%v, tcp_flags=%v",
+		config.DropBogons, config.DropPrivate, len(config.Exempt), config.DropInvalidChecksums, config.DropInvalidTCPFlags)
+
+	resp := s.normalizationStatus()
+	resp.Success = true
+	resp.Message = "Normalization config saved successfully"
+	return resp, nil
+}
+
+// GetNormalizationStatus returns the packet normalization config and the
+// packets dropped by reason
+func (s *Server) GetNormalizationStatus(ctx context.Context, req *pb.Empty) (*pb.NormalizationStatusResponse, error) {
+	resp := s.normalizationStatus()
+	resp.Success = true
+	return resp, nil
+}
+
+// normalizationStatus returns the config and the data plane's counters
+func (s *Server) normalizationStatus() *pb.NormalizationStatusResponse {
+	s.mutex.RLock()
+	config, manager := s.normalization, s.bpfManager
+	s.mutex.RUnlock()
+
+	resp := &pb.NormalizationStatusResponse{Config: normalizationConfigToProto(config), Events: s.normalizationEvents.Load()}
+	if manager == nil {
+		return resp
+	}
+	stats, err := manager.NormalizationStats()
+	if err != nil {
+		log.Printf("⚠️  Failed to read normalization counters: %v", err)
+	}
+	resp.Bogons = stats.Dropped[1]
+	resp.IpChecksums = stats.Dropped[2]
+	resp.L4Checksums = stats.Dropped[3]
+	resp.TcpNull = stats.Dropped[4]
+	resp.TcpXmas = stats.Dropped[5]
+	resp.TcpFlags = stats.Dropped[6]
+	return resp
+}
+
+// monitorNormalization reports the sources normalization dropped packets
+// of every interval until ctx is done, emptying the data plane's record
+func (s *Server) monitorNormalization(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.mutex.RLock()
+		manager := s.bpfManager
+		s.mutex.RUnlock()
+		if manager == nil {
+			continue
+		}
+		droppers, err := manager.NormalizationDroppers()
+		if err != nil {
+			log.Printf("⚠️  Failed to read normalization sources: %v", err)
+			continue
+		}
+		if len(droppers) == 0 {
+			continue
+		}
+
+		sort.Slice(droppers, func(i, j int) bool {
+			return droppers[i].source.Packets > droppers[j].source.Packets
+		})
+		wall, now := s.clock.Now(), monotonicNow()
+		for i, dropper := range droppers {
+			if i < normalizationEventsMax {
+				s.reportNormalization(dropper, wall, now)
+			}
+			if err := manager.ForgetNormalizationDropper(dropper.key); err != nil {
+				log.Printf("⚠️  Failed to clear normalization source: %v", err)
+			}
+		}
+		if len(droppers) > normalizationEventsMax {
+			log.Printf("⚠️  Normalization dropped packets of %d more sources, not reported", len(droppers)-normalizationEventsMax)
+		}
+	}
+}
+
+// reportNormalization raises a PACKET_NORMALIZED event for a source
+func (s *Server) reportNormalization(dropper normDropper, wall time.Time, now uint64) {
+	addr, reason := dropper.key.addr(), dropper.key.reason()
+	severity := "medium"
+	if reason == "ip_checksum" || reason == "l4_checksum" {
+		severity = "low"
+	}
+	lastSeen := wallTime(wall, dropper.source.LastSeen, now)
+
+	s.normalizationEvents.Add(1)
+	s.events.Publish(&pb.Event{
+		Type:      EventPacketNormalized,
+		Timestamp: lastSeen.Unix(),
+		Source:    addr.String(),
+		Severity:  severity,
+		Message:   fmt.Sprintf("dropped %d packets from %s: %s", dropper.source.Packets, addr, normReasonMessages[reason]),
+		Metadata: map[string]string{
+			"reason":    reason,
+			"packets":   strconv.FormatUint(dropper.source.Packets, 10),
+			"last_seen": lastSeen.Format(time.RFC3339Nano),
+		},
+	})
+}
+
+// pushNormalization writes a config to the host data plane. Caller must
+// hold s.mutex.
+func (s *Server) pushNormalization(config NormalizationConfig) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	return s.bpfManager.SetNormalization(config.encode(), config.bogons())
+}
+
+func normalizationConfigFromProto(config *pb.NormalizationConfig) (NormalizationConfig, error) {
+	converted := NormalizationConfig{
+		DropBogons:           config.DropBogons,
+		DropPrivate:          config.DropPrivate,
+		DropInvalidChecksums: config.DropInvalidChecksums,
+		DropInvalidTCPFlags:  config.DropInvalidTcpFlags,
+	}
+	var errs ruleValidationError
+	converted.Exempt = parseExemptPrefixes(config.Exempt, &errs)
+	if len(errs) > 0 {
+		return converted, errs
+	}
+	return converted, nil
+}
+
+func normalizationConfigToProto(config NormalizationConfig) *pb.NormalizationConfig {
+	converted := &pb.NormalizationConfig{
+		DropBogons:           config.DropBogons,
+		DropPrivate:          config.DropPrivate,
+		DropInvalidChecksums: config.DropInvalidChecksums,
+		DropInvalidTcpFlags:  config.DropInvalidTCPFlags,
+	}
+	for _, prefix := range config.Exempt {
+		converted.Exempt = append(converted.Exempt, prefix.String())
+	}
+	return converted
+}
+
+// openNormalization opens the pinned normalization maps, turning the
+// checks off until the stored config is re-pushed on restore
+func (bm *BPFMapManager) openNormalization() {
+	names := []string{NormConfigMapName, Bogons4MapName, Bogons6MapName, NormStatsMapName, NormSourcesMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  Packet normalization not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.normConfig, bm.bogons4, bm.bogons6, bm.normStats, bm.normSources = maps[0], maps[1], maps[2], maps[3], maps[4]
+
+	if err := bm.SetNormalization(normConfig{}, nil); err != nil {
+		log.Printf("⚠️  Failed to reset packet normalization: %v", err)
+	}
+}
+
+// closeNormalization closes the normalization maps, if open
+func (bm *BPFMapManager) closeNormalization() {
+	if bm.normConfig == nil {
+		return
+	}
+	bm.normConfig.Close()
+	bm.bogons4.Close()
+	bm.bogons6.Close()
+	bm.normStats.Close()
+	bm.normSources.Close()
+}
+
+// SetNormalization writes the bogon tries, then the checks to run
+func (bm *BPFMapManager) SetNormalization(config normConfig, bogons map[netip.Prefix]uint8) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting packet normalization checks %#x (%d bogon entries)", config.Checks, len(bogons))
+		return nil
+	}
+	if bm.normConfig == nil {
+		return fmt.Errorf("normalization maps not available")
+	}
+
+	bogons4 := make(map[netip.Prefix]uint8)
+	bogons6 := make(map[netip.Prefix]uint8)
+	for prefix, bogon := range bogons {
+		if prefix.Addr().Is4() {
+			bogons4[prefix] = bogon
+		} else {
+			bogons6[prefix] = bogon
+		}
+	}
+	if err := syncBogonTrie(bm.bogons4, bogons4); err != nil {
+		return fmt.Errorf("failed to write IPv4 bogons: %v", err)
+	}
+	if err := syncBogonTrie(bm.bogons6, bogons6); err != nil {
+		return fmt.Errorf("failed to write IPv6 bogons: %v", err)
+	}
+
+	key := uint32(0)
+	if err := bm.normConfig.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write normalization config: %v", err)
+	}
+	return nil
+}
+
+// syncBogonTrie makes a bogon trie hold exactly the given entries, like
+// syncPrefixTrie
+func syncBogonTrie(trie *ebpf.Map, entries map[netip.Prefix]uint8) error {
+	for prefix, bogon := range entries {
+		if err := trie.Put(lpmKey(prefix), bogon); err != nil {
+			return fmt.Errorf("failed to write prefix %s: %v", prefix, err)
+		}
+	}
+
+	var stale [][]byte
+	key := make([]byte, trie.KeySize())
+	var bogon uint8
+	iter := trie.Iterate()
+	for iter.Next(&key, &bogon) {
+		if _, exists := entries[lpmKeyPrefix(key)]; !exists {
+			stale = append(stale, append([]byte(nil), key...))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to list prefixes: %v", err)
+	}
+
+	for _, key := range stale {
+		if err := trie.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete prefix %s: %v", lpmKeyPrefix(key), err)
+		}
+	}
+	return nil
+}
+
+// NormalizationStats reads the packets normalization dropped by reason,
+// summed across CPUs
+func (bm *BPFMapManager) NormalizationStats() (normStats, error) {
+	var stats normStats
+	if bm.simulated || bm.normStats == nil {
+		return stats, nil
+	}
+	var perCPU []normStats
+	key := uint32(0)
+	if err := bm.normStats.Lookup(&key, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		for reason, dropped := range value.Dropped {
+			stats.Dropped[reason] += dropped
+		}
+	}
+	return stats, nil
+}
+
+// NormalizationDroppers reads the sources normalization dropped packets of
+// since they were last forgotten
+func (bm *BPFMapManager) NormalizationDroppers() ([]normDropper, error) {
+	if bm.simulated || bm.normSources == nil {
+		return nil, nil
+	}
+	var droppers []normDropper
+	var dropper normDropper
+	entries := bm.normSources.Iterate()
+	for entries.Next(&dropper.key, &dropper.source) {
+		droppers = append(droppers, dropper)
+	}
+	if err := entries.Err(); err != nil {
+		return droppers, err
+	}
+	return droppers, nil
+}
+
+// ForgetNormalizationDropper removes a reported source, so that its next
+// dropped packet is counted afresh
+func (bm *BPFMapManager) ForgetNormalizationDropper(key normSourceKey) error {
+	if bm.simulated || bm.normSources == nil {
+		return nil
+	}
+	if err := bm.normSources.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Object reference resolution shared by service and address objects
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// WhereUsed lists the rules and groups that reference a named object
+func (s *Server) WhereUsed(ctx context.Context, req *pb.WhereUsedRequest) (*pb.WhereUsedResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ruleIDs := make(map[string]bool)
+	resp := &pb.WhereUsedResponse{}
+
+	if req.Kind == "" || req.Kind == "address" {
+		for _, rule := range s.rulesUsingAddress(req.Name) {
+			ruleIDs[rule.ID] = true
+		}
+		resp.Groups = s.groupsContaining(req.Name)
+	}
+	if req.Kind == "" || req.Kind == "service" {
+		for _, rule := range s.rulesUsingService(req.Name) {
+			ruleIDs[rule.ID] = true
+		}
+	}
+
+	for id := range ruleIDs {
+		resp.RuleIds = append(resp.RuleIds, id)
+	}
+	sort.Strings(resp.RuleIds)
+
+	return resp, nil
+}
+
+// resolveRuleObjects resolves object references into the concrete entries
+// pushed to the data plane: one entry per source prefix, destination prefix
+// of the same family and service port. Rules without references resolve to
+// themselves.
+func resolveRuleObjects(rule *FirewallRule, services map[string]*Service, addressObjects map[string]*AddressObject) []*FirewallRule {
+	if rule.Service == "" && rule.SrcAddress == "" && rule.DstAddress == "" {
+		return []*FirewallRule{rule}
+	}
+
+	srcIPs := []string{rule.SrcIP}
+	if rule.SrcAddress != "" {
+		srcIPs = resolveAddress(addressObjects, rule.SrcAddress)
+	}
+	dstIPs := []string{rule.DstIP}
+	if rule.DstAddress != "" {
+		dstIPs = resolveAddress(addressObjects, rule.DstAddress)
+	}
+	ports := []ServicePort{{Protocol: rule.Protocol, PortStart: rule.DstPort, PortEnd: rule.DstPortEnd}}
+	if rule.Service != "" {
+		service, exists := services[rule.Service]
+		if !exists {
+			return nil
+		}
+		ports = service.Ports
+	}
+
+	var entries []*FirewallRule
+	for _, src := range srcIPs {
+		for _, dst := range dstIPs {
+			if !familiesCompatible(src, dst) {
+				continue
+			}
+			for _, port := range ports {
+				entry := *rule
+				entry.ID = fmt.Sprintf("%s#%d", rule.ID, len(entries))
+				entry.SrcIP = src
+				entry.DstIP = dst
+				entry.Protocol = port.Protocol
+				entry.DstPort = port.PortStart
+				entry.DstPortEnd = 0
+				if port.PortEnd > port.PortStart {
+					entry.DstPortEnd = port.PortEnd
+				}
+				entries = append(entries, &entry)
+			}
+		}
+	}
+
+	return entries
+}
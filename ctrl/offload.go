@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Hardware offload: requested vs. active mode per interface and per rule
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Offload modes, requested with CERBERUS_OFFLOAD
+const (
+	OffloadNone = "none" // XDP in the driver or generic path
+	OffloadXDP  = "xdp"  // XDP program runs in the NIC (XDP_FLAGS_HW_MODE)
+	OffloadFlow = "flow" // Rules programmed as NIC flow entries
+)
+
+// Per-rule offload status
+const (
+	OffloadStatusSoftware   = "software"   // Offload not requested
+	OffloadStatusOffloaded  = "offloaded"  // Every interface of the rule's data plane offloads it
+	OffloadStatusFallback   = "fallback"   // Requested but enforced in software
+	OffloadStatusUnverified = "unverified" // Requested, no interface attached by the control plane
+)
+
+// EventOffloadFallback reports an interface or rule falling back to software
+const EventOffloadFallback = "OFFLOAD_FALLBACK"
+
+// Drivers able to program tc flower rules into the NIC
+var flowOffloadDrivers = map[string]bool{
+	"mlx5_core": true,
+	"ice":       true,
+	"nfp":       true,
+	"bnxt_en":   true,
+}
+
+// InterfaceOffload is the verified offload state of one attached interface
+type InterfaceOffload struct {
+	Interface string `json:"interface"`
+	Driver    string `json:"driver"`
+	Requested string `json:"requested"`
+	Active    string `json:"active"`
+	Reason    string `json:"reason,omitempty"` // Why Active differs from Requested
+}
+
+// FellBack reports whether the interface runs in software despite a request
+func (o *InterfaceOffload) FellBack() bool {
+	return o.Requested != OffloadNone && o.Active != o.Requested
+}
+
+// GetOffloadStatus returns the offload state of every attached interface,
+// grouped by data plane
+func (s *Server) GetOffloadStatus(ctx context.Context, req *pb.Empty) (*pb.OffloadStatusResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.OffloadStatusResponse{Requested: s.offloadMode}
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		for _, offload := range manager.interfaceOffloads() {
+			resp.Interfaces = append(resp.Interfaces, &pb.InterfaceOffload{
+				Scope:     scope,
+				Interface: offload.Interface,
+				Driver:    offload.Driver,
+				Requested: offload.Requested,
+				Active:    offload.Active,
+				Reason:    offload.Reason,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// ruleOffload derives a rule's offload status from the interfaces of the
+// data plane enforcing it. Caller must hold s.mutex.
+func (s *Server) ruleOffload(rule *FirewallRule) (status, reason string) {
+	manager := s.dataPlaneFor(ruleScope(rule))
+	if manager == nil || manager.offloadMode == OffloadNone {
+		return OffloadStatusSoftware, ""
+	}
+
+	// AF_XDP sockets live in the host, the NIC cannot deliver to them
+	if rule.Action == "redirect" {
+		return OffloadStatusFallback, "redirect to AF_XDP is only possible in software"
+	}
+	// Replies are sent by the control plane from the ring buffer
+	if rule.Action == "reject" {
+		return OffloadStatusFallback, "reject replies are only possible in software"
+	}
+	// Copies are cloned by the TC hooks
+	if rule.Action == "mirror" {
+		return OffloadStatusFallback, "mirror copies are only possible in software"
+	}
+	// The TC egress program always runs in the kernel
+	if _, egress := ruleHooks(rule); egress && manager.offloadMode == OffloadXDP {
+		return OffloadStatusFallback, "outbound rules run in the TC egress program"
+	}
+
+	offloads := manager.interfaceOffloads()
+	if len(offloads) == 0 {
+		return OffloadStatusUnverified, "no interface attached by the control plane"
+	}
+	for _, offload := range offloads {
+		if offload.FellBack() {
+			return OffloadStatusFallback, fmt.Sprintf("%s: %s", offload.Interface, offload.Reason)
+		}
+	}
+	return OffloadStatusOffloaded, ""
+}
+
+// offloadModeFromEnv reads the requested offload mode, defaulting to none
+func offloadModeFromEnv() (string, error) {
+	mode := strings.ToLower(os.Getenv("CERBERUS_OFFLOAD"))
+	switch mode {
+	case "":
+		return OffloadNone, nil
+	case OffloadNone, OffloadXDP, OffloadFlow:
+		return mode, nil
+	default:
+		return OffloadNone, fmt.Errorf("invalid CERBERUS_OFFLOAD %q, expected none|xdp|flow", mode)
+	}
+}
+
+// verifyOffload records the offload mode an interface actually runs in and
+// warns when it falls back to software
+func (bm *BPFMapManager) verifyOffload(interfaceName string) {
+	offload := &InterfaceOffload{
+		Interface: interfaceName,
+		Driver:    interfaceDriver(interfaceName),
+		Requested: bm.offloadMode,
+		Active:    bm.offloadMode,
+	}
+
+	switch {
+	case offload.Requested == OffloadNone:
+	case bm.simulated:
+		offload.Reason = "data plane runs in simulation mode"
+	case offload.Driver == "":
+		offload.Reason = "no NIC driver behind the interface"
+	case offload.Requested == OffloadXDP && !xdpOffloadDrivers[offload.Driver]:
+		offload.Reason = fmt.Sprintf("driver %s cannot run XDP in hardware", offload.Driver)
+	case offload.Requested == OffloadXDP && bm.attachModes[interfaceName] != XDPModeOffload:
+		offload.Reason = fmt.Sprintf("XDP program attached in %s mode", bm.attachModes[interfaceName])
+	case offload.Requested == OffloadFlow && !flowOffloadDrivers[offload.Driver]:
+		offload.Reason = fmt.Sprintf("driver %s has no flow offload", offload.Driver)
+	}
+	if offload.Reason != "" {
+		offload.Active = OffloadNone
+	}
+
+	if bm.offloads == nil {
+		bm.offloads = make(map[string]*InterfaceOffload)
+	}
+	bm.offloads[interfaceName] = offload
+
+	if offload.FellBack() {
+		log.Printf("⚠️  %s offload requested on %s, falling back to software: %s",
+			offload.Requested, interfaceName, offload.Reason)
+		bm.events.Publish(&pb.Event{
+			Type:      EventOffloadFallback,
+			Interface: interfaceName,
+			Message:   fmt.Sprintf("%s offload unavailable on %s: %s", offload.Requested, interfaceName, offload.Reason),
+			Severity:  "high",
+		})
+	}
+}
+
+// interfaceOffloads returns the verified state of attached interfaces by name
+func (bm *BPFMapManager) interfaceOffloads() []*InterfaceOffload {
+	offloads := make([]*InterfaceOffload, 0, len(bm.offloads))
+	for _, offload := range bm.offloads {
+		offloads = append(offloads, offload)
+	}
+	sort.Slice(offloads, func(i, j int) bool { return offloads[i].Interface < offloads[j].Interface })
+	return offloads
+}
+
+// interfaceDriver returns the kernel driver of an interface, empty for
+// virtual interfaces
+func interfaceDriver(interfaceName string) string {
+	driver, err := os.Readlink(filepath.Join(SysfsNetPath, interfaceName, "device", "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(driver)
+}
@@ -0,0 +1,458 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy persistence: saves rules and objects on mutation, reloads on startup
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// Default directory for persisted control plane state
+	DefaultStateDir = "/var/lib/cerberus"
+
+	policySnapshotVersion = 1
+	policyFileName        = "policy.json"
+)
+
+// PolicySnapshot is the persisted form of the whole policy
+type PolicySnapshot struct {
+	Version               int                     `json:"version"`
+	SavedAt               time.Time               `json:"saved_at"`
+	Rules                 []*FirewallRule         `json:"rules"`
+	Zones                 []*Zone                 `json:"zones"`
+	ZonePolicies          []*ZonePolicy           `json:"zone_policies"`
+	Services              []*Service              `json:"services"`
+	AddressObjects        []*AddressObject        `json:"address_objects"`
+	IPSets                []*IPSet                `json:"ip_sets"`
+	DefaultPolicies       []*DefaultPolicy        `json:"default_policies"`
+	ProtectionProfiles    []*ProtectionProfile    `json:"protection_profiles"`
+	ProtectedDestinations []*ProtectedDestination `json:"protected_destinations"`
+	Tunnels               []*Tunnel               `json:"tunnels"`
+	NAT64Prefixes         []*NAT64Prefix          `json:"nat64_prefixes"`
+	NatRules              []*NatRule              `json:"nat_rules"`
+	DomainLists           []*DomainList           `json:"domain_lists"`
+	TLSFingerprintRules   []*TLSFingerprintRule   `json:"tls_fingerprint_rules"`
+	Mitigation            *MitigationConfig       `json:"mitigation,omitempty"`
+	SourceLimits          *SourceLimitConfig      `json:"source_limits,omitempty"`
+	AutoBlock             *AutoBlockConfig        `json:"auto_block,omitempty"`
+	PortScan              *PortScanConfig         `json:"port_scan,omitempty"`
+	Normalization         *NormalizationConfig    `json:"normalization,omitempty"`
+	SourceExemptions      []*SourceExemption      `json:"source_exemptions"`
+	InterfaceConfigs      []*InterfaceConfig      `json:"interface_configs"`
+	Namespaces            []*Namespace            `json:"namespaces"`
+	VFPolicies            []*VFPolicy             `json:"vf_policies"`
+}
+
+// PolicyStore persists policy snapshots. Implementations must make Save
+// atomic so a crash never leaves a half-written policy behind.
+type PolicyStore interface {
+	Load() (*PolicySnapshot, error)
+	Save(snapshot *PolicySnapshot) error
+}
+
+// JSONFileStore keeps the policy in a single JSON file
+type JSONFileStore struct {
+	path string
+}
+
+// NewJSONFileStore creates a store writing policy.json under dir
+func NewJSONFileStore(dir string) (*JSONFileStore, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %v", err)
+	}
+	return &JSONFileStore{path: filepath.Join(dir, policyFileName)}, nil
+}
+
+// Load reads the stored snapshot; a missing file yields an empty policy
+func (fs *JSONFileStore) Load() (*PolicySnapshot, error) {
+	data, err := os.ReadFile(fs.path)
+	if os.IsNotExist(err) {
+		return &PolicySnapshot{Version: policySnapshotVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %v", err)
+	}
+
+	var snapshot PolicySnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %v", fs.path, err)
+	}
+	if snapshot.Version > policySnapshotVersion {
+		return nil, fmt.Errorf("policy file version %d is newer than supported version %d",
+			snapshot.Version, policySnapshotVersion)
+	}
+
+	return &snapshot, nil
+}
+
+// Save writes the snapshot to a temporary file and renames it into place
+func (fs *JSONFileStore) Save(snapshot *PolicySnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode policy: %v", err)
+	}
+
+	return writeFileAtomic(fs.path, data)
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0640); err != nil {
+		return fmt.Errorf("failed to write %s: %v", filepath.Base(path), err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %v", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// RestorePolicy attaches a store, loads the saved policy and re-pushes it
+// to the data plane. Invalid entries are skipped and logged.
+func (s *Server) RestorePolicy(store PolicyStore) error {
+	snapshot, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.store = store
+
+	for _, service := range snapshot.Services {
+		s.services[service.Name] = service
+	}
+	for _, object := range snapshot.AddressObjects {
+		s.addressObjects[object.Name] = object
+	}
+	for _, set := range snapshot.IPSets {
+		if set.Entries == nil {
+			set.Entries = make(map[netip.Prefix]bool)
+		}
+		if s.bpfManager != nil {
+			if err := s.bpfManager.CreateIPSet(set); err != nil {
+				log.Printf("⚠️  Failed to create stored IP set %s: %v", set.Name, err)
+				continue
+			}
+		}
+		s.ipSets[set.ID] = set
+	}
+	for _, policy := range snapshot.DefaultPolicies {
+		if err := s.pushDefaultPolicy(policy); err != nil {
+			log.Printf("⚠️  Failed to push stored default policy of %q: %v", policy.Interface, err)
+		}
+		s.defaultPolicies[policy.Interface] = policy
+	}
+	for _, profile := range snapshot.ProtectionProfiles {
+		if err := s.buildCountrySets(profile); err != nil {
+			log.Printf("⚠️  Protection profile %s restored without its allowed countries: %v", profile.Name, err)
+		}
+		s.protectionProfiles[profile.Name] = profile
+	}
+	for _, dest := range snapshot.ProtectedDestinations {
+		var err error
+		if dest.Destination, dest.key, err = parseProtectedDestination(dest.Destination); err != nil || s.protectionProfiles[dest.Profile] == nil {
+			log.Printf("⚠️  Skipping stored protected destination %s of profile %s: %v", dest.Destination, dest.Profile, err)
+			continue
+		}
+		if err := s.pushProtection(dest); err != nil {
+			log.Printf("⚠️  Failed to push stored protection of %s: %v", dest.Destination, err)
+		}
+		s.protected[dest.Destination] = dest
+	}
+	for _, tunnel := range snapshot.Tunnels {
+		if err := s.validateTunnel(tunnel); err != nil {
+			log.Printf("⚠️  Skipping stored tunnel %s: %v", tunnel.Name, err)
+			continue
+		}
+		if tunnel.id = s.freeTunnelID(); tunnel.id == 0 {
+			log.Printf("⚠️  Skipping stored tunnel %s: tunnel limit reached", tunnel.Name)
+			continue
+		}
+		if err := s.pushTunnel(tunnel); err != nil {
+			log.Printf("⚠️  Failed to push stored tunnel %s: %v", tunnel.Name, err)
+		}
+		s.tunnels[tunnel.Name] = tunnel
+	}
+	for _, prefix := range snapshot.NAT64Prefixes {
+		if err := validateNAT64Prefix(prefix); err != nil {
+			log.Printf("⚠️  Skipping stored NAT64 prefix %s: %v", prefix.Prefix, err)
+			continue
+		}
+		if prefix.id = s.freeNAT64ID(); prefix.id == 0 {
+			log.Printf("⚠️  Skipping stored NAT64 prefix %s: prefix limit reached", prefix.Prefix)
+			continue
+		}
+		if err := s.pushNAT64Prefix(prefix); err != nil {
+			log.Printf("⚠️  Failed to push stored NAT64 prefix %s: %v", prefix.Prefix, err)
+		}
+		s.nat64Prefixes[prefix.Prefix] = prefix
+	}
+	for _, rule := range snapshot.NatRules {
+		if err := validateNatRule(rule); err != nil {
+			log.Printf("⚠️  Skipping stored NAT rule %d: %v", rule.ID, err)
+			continue
+		}
+		if conflict := s.natRuleConflicting(rule); conflict != nil {
+			log.Printf("⚠️  Skipping stored NAT rule %d: %s is already %s by rule %d", rule.ID, rule.matchedSide(), rule.verb(), conflict.ID)
+			continue
+		}
+		if rule.ID == 0 || rule.ID >= MaxNatRules || s.natRules[rule.ID] != nil {
+			if rule.ID = s.freeNatRuleID(); rule.ID == 0 {
+				log.Printf("⚠️  Skipping stored NAT rule for %s: rule limit reached", rule.matchedSide())
+				continue
+			}
+		}
+		if err := s.pushNatRule(rule); err != nil {
+			log.Printf("⚠️  Failed to push stored NAT rule %d: %v", rule.ID, err)
+		}
+		s.natRules[rule.ID] = rule
+	}
+	for _, list := range snapshot.DomainLists {
+		if err := validateDomainList(list); err != nil {
+			log.Printf("⚠️  Skipping stored domain list %s: %v", list.Name, err)
+			continue
+		}
+		list.id = s.freeDomainListID()
+		s.domainLists[list.Name] = list
+	}
+	if len(s.domainLists) > 0 {
+		if err := s.pushDomainLists(s.domainLists); err != nil {
+			log.Printf("⚠️  Failed to push stored domain lists: %v", err)
+		}
+	}
+	for _, rule := range snapshot.TLSFingerprintRules {
+		if err := validateTLSFingerprintRule(rule); err != nil {
+			log.Printf("⚠️  Skipping stored TLS fingerprint rule %s: %v", rule.Name, err)
+			continue
+		}
+		s.tlsRules[rule.Name] = rule
+	}
+	if len(s.tlsRules) > 0 {
+		if err := s.pushTLSFingerprintRules(s.tlsRules); err != nil {
+			log.Printf("⚠️  Failed to push stored TLS fingerprint rules: %v", err)
+		}
+	}
+	if config := snapshot.Mitigation; config != nil {
+		if err := validateMitigationConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored mitigation config: %v", err)
+		} else if err := s.pushMitigation(*config); err != nil {
+			log.Printf("⚠️  Failed to push stored mitigation config: %v", err)
+		} else {
+			s.mitigation = *config
+		}
+	}
+	if config := snapshot.SourceLimits; config != nil {
+		if err := validateSourceLimitConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored source limits: %v", err)
+		} else if err := s.pushSourceLimits(*config); err != nil {
+			log.Printf("⚠️  Failed to push stored source limits: %v", err)
+		} else {
+			s.sourceLimits = *config
+		}
+	}
+	if config := snapshot.AutoBlock; config != nil {
+		if err := validateAutoBlockConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored auto-block config: %v", err)
+		} else {
+			s.autoBlock = *config
+		}
+	}
+	if config := snapshot.PortScan; config != nil {
+		if err := validatePortScanConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored port scan config: %v", err)
+		} else if err := s.pushPortScan(*config); err != nil {
+			log.Printf("⚠️  Failed to push stored port scan config: %v", err)
+		} else {
+			s.portScan = *config
+		}
+	}
+	if config := snapshot.Normalization; config != nil {
+		if err := validateNormalizationConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored normalization config: %v", err)
+		} else if err := s.pushNormalization(*config); err != nil {
+			log.Printf("⚠️  Failed to push stored normalization config: %v", err)
+		} else {
+			s.normalization = *config
+		}
+	}
+	now := s.clock.Now()
+	for _, exemption := range snapshot.SourceExemptions {
+		if !exemption.Address.IsValid() || !exemption.ExpiresAt.After(now) {
+			continue
+		}
+		if err := s.pushSourceExemption(exemption, now); err != nil {
+			log.Printf("⚠️  Failed to push stored exemption of %s: %v", exemption.Address, err)
+			continue
+		}
+		s.sourceExemptions[exemption.Address] = exemption
+	}
+	for _, config := range snapshot.InterfaceConfigs {
+		if err := validateInterfaceConfig(config); err != nil {
+			log.Printf("⚠️  Skipping stored config of interface %s: %v", config.Interface, err)
+			continue
+		}
+		if err := s.pushInterfaceConfig(config); err != nil {
+			log.Printf("⚠️  Failed to push stored config of interface %s: %v", config.Interface, err)
+		}
+		s.interfaceConfigs[config.Interface] = config
+	}
+	for _, zone := range snapshot.Zones {
+		s.zones[zone.Name] = zone
+	}
+	for _, policy := range snapshot.ZonePolicies {
+		s.zonePolicies[policy.ID] = policy
+	}
+	for _, namespace := range snapshot.Namespaces {
+		if err := s.attachNamespace(namespace); err != nil {
+			log.Printf("⚠️  Failed to attach stored namespace %s: %v", namespace.Name, err)
+		}
+	}
+	for _, policy := range snapshot.VFPolicies {
+		if err := s.attachVFPolicy(policy); err != nil {
+			log.Printf("⚠️  Failed to attach stored VF policy %s: %v", policy.Key(), err)
+		}
+	}
+
+	restored := 0
+	for _, rule := range snapshot.Rules {
+		maskStoredAddresses(rule)
+		if err := s.validateRule(rule); err != nil {
+			log.Printf("⚠️  Skipping stored rule %s: %v", rule.ID, err)
+			continue
+		}
+		s.rules[rule.ID] = rule
+		restored++
+	}
+
+	if err := s.applyPolicy(); err != nil {
+		log.Printf("⚠️  Failed to push stored policy to data plane: %v", withRemediation(err))
+	}
+
+	log.Printf("📂 Restored %d rules, %d zones, %d services, %d address objects, %d IP sets, %d namespaces",
+		restored, len(s.zones), len(s.services), len(s.addressObjects), len(s.ipSets), len(s.namespaces))
+	return nil
+}
+
+// maskStoredAddresses clears the host bits of rule prefixes stored before
+// validation rejected them, e.g. 10.0.0.1/8 becomes 10.0.0.0/8, the prefix
+// the data plane enforced all along
+func maskStoredAddresses(rule *FirewallRule) {
+	for _, addr := range []*string{&rule.SrcIP, &rule.DstIP} {
+		prefix, err := netip.ParsePrefix(*addr)
+		if err != nil {
+			continue
+		}
+		if masked := prefix.Masked(); masked != prefix {
+			log.Printf("Stored rule %s: %s has host bits set, restored as %s", rule.ID, *addr, masked)
+			*addr = masked.String()
+		}
+	}
+}
+
+// persistPolicy saves the current policy if a store is attached.
+// Caller must hold s.mutex.
+func (s *Server) persistPolicy() {
+	if s.store == nil {
+		return
+	}
+	if err := s.store.Save(s.policySnapshot()); err != nil {
+		log.Printf("⚠️  Failed to persist policy: %v", err)
+	}
+}
+
+// policySnapshot captures the current policy in a stable order.
+// Caller must hold s.mutex.
+func (s *Server) policySnapshot() *PolicySnapshot {
+	snapshot := &PolicySnapshot{
+		Version:      policySnapshotVersion,
+		SavedAt:      s.clock.Now().UTC(),
+		ZonePolicies: s.sortedZonePolicies(),
+	}
+
+	for _, rule := range s.rules {
+		snapshot.Rules = append(snapshot.Rules, rule)
+	}
+	sort.Slice(snapshot.Rules, func(i, j int) bool { return snapshot.Rules[i].ID < snapshot.Rules[j].ID })
+
+	for _, name := range s.sortedZoneNames() {
+		snapshot.Zones = append(snapshot.Zones, s.zones[name])
+	}
+	for _, service := range s.services {
+		snapshot.Services = append(snapshot.Services, service)
+	}
+	sort.Slice(snapshot.Services, func(i, j int) bool { return snapshot.Services[i].Name < snapshot.Services[j].Name })
+
+	for _, object := range s.addressObjects {
+		snapshot.AddressObjects = append(snapshot.AddressObjects, object)
+	}
+	sort.Slice(snapshot.AddressObjects, func(i, j int) bool {
+		return snapshot.AddressObjects[i].Name < snapshot.AddressObjects[j].Name
+	})
+
+	for _, id := range s.sortedIPSetIDs() {
+		if s.ipSets[id].Profile == "" {
+			snapshot.IPSets = append(snapshot.IPSets, s.ipSets[id])
+		}
+	}
+	for _, policy := range s.defaultPolicies {
+		snapshot.DefaultPolicies = append(snapshot.DefaultPolicies, policy)
+	}
+	sort.Slice(snapshot.DefaultPolicies, func(i, j int) bool {
+		return snapshot.DefaultPolicies[i].Interface < snapshot.DefaultPolicies[j].Interface
+	})
+
+	for _, profile := range s.protectionProfiles {
+		snapshot.ProtectionProfiles = append(snapshot.ProtectionProfiles, profile)
+	}
+	sort.Slice(snapshot.ProtectionProfiles, func(i, j int) bool {
+		return snapshot.ProtectionProfiles[i].Name < snapshot.ProtectionProfiles[j].Name
+	})
+	snapshot.ProtectedDestinations = s.sortedProtectedDestinations()
+	snapshot.Tunnels = s.sortedTunnels()
+	snapshot.NAT64Prefixes = s.sortedNAT64Prefixes()
+	snapshot.NatRules = s.sortedNatRules()
+	snapshot.DomainLists = s.sortedDomainLists()
+	snapshot.TLSFingerprintRules = s.sortedTLSFingerprintRules()
+	if s.mitigation.Mode != MitigationOff {
+		mitigation := s.mitigation
+		snapshot.Mitigation = &mitigation
+	}
+	if s.sourceLimits.enabled() {
+		sourceLimits := s.sourceLimits
+		snapshot.SourceLimits = &sourceLimits
+	}
+	snapshot.SourceExemptions = s.sortedSourceExemptions()
+	snapshot.InterfaceConfigs = s.sortedInterfaceConfigs()
+	if s.autoBlock.Enabled {
+		autoBlock := s.autoBlock
+		snapshot.AutoBlock = &autoBlock
+	}
+	if s.portScan.Enabled {
+		portScan := s.portScan
+		snapshot.PortScan = &portScan
+	}
+	if s.normalization.enabled() {
+		normalization := s.normalization
+		snapshot.Normalization = &normalization
+	}
+
+	for _, name := range s.sortedNamespaceNames() {
+		snapshot.Namespaces = append(snapshot.Namespaces, s.namespaces[name])
+	}
+	for _, key := range s.sortedVFPolicyKeys() {
+		snapshot.VFPolicies = append(snapshot.VFPolicies, s.vfPolicies[key])
+	}
+
+	return snapshot
+}
@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: Apache-2.0
+// Tail-call pipeline: XDP stages in a pinned program array, enabled, disabled and upgraded at runtime
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// PipelineMapName is the pinned stage program array (must match eBPF program)
+const PipelineMapName = "cerberus_pipeline"
+
+// EventPipelineChanged reports a stage enabled, disabled or upgraded
+const EventPipelineChanged = "PIPELINE_CHANGED"
+
+// UpdatePipeline actions
+const (
+	PipelineEnable  = "enable"
+	PipelineDisable = "disable"
+	PipelineUpgrade = "upgrade"
+)
+
+// pipelineStages are the stages in packet order, their index is their slot
+// (must match enum pipeline_stage)
+var pipelineStages = []string{"parse", "conntrack", "ipset", "rules", "actions"}
+
+// pipelineStage is what the control plane knows of one stage slot
+type pipelineStage struct {
+	program   *ebpf.Program // Installed program, nil when disabled or simulated
+	disabled  bool          // Disabled by an operator, kept across reloads
+	object    string        // Object file the program was loaded from
+	updatedAt time.Time
+}
+
+// pipelineProgramName is the program of a stage in the XDP object
+func pipelineProgramName(stage string) string {
+	return "xdp_stage_" + stage
+}
+
+func pipelineStageIndex(stage string) int {
+	for index, name := range pipelineStages {
+		if name == stage {
+			return index
+		}
+	}
+	return -1
+}
+
+// openPipeline opens the pinned stage program array. Programs predating it
+// filter every packet inline.
+func (bm *BPFMapManager) openPipeline() {
+	path := filepath.Join(bm.pinPath, PipelineMapName)
+	pipeline, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Tail-call pipeline not available at %s: %v", path, err)
+		return
+	}
+	bm.pipeline = pipeline
+}
+
+// stageRecord returns the record of a stage, creating it
+func (bm *BPFMapManager) stageRecord(stage string) *pipelineStage {
+	if bm.stages == nil {
+		bm.stages = make(map[string]*pipelineStage)
+	}
+	record, exists := bm.stages[stage]
+	if !exists {
+		record = &pipelineStage{}
+		bm.stages[stage] = record
+	}
+	return record
+}
+
+// installPipeline fills the stage slots from a freshly loaded XDP object.
+// Stages an operator disabled stay disabled and upgraded ones keep their
+// program. Slots are filled from the last stage back, so packets only
+// enter the pipeline once parse is installed with every stage after it.
+func (bm *BPFMapManager) installPipeline(programs map[string]*ebpf.Program, object string) {
+	bm.pipelineObject = object
+	if len(programs) == 0 {
+		return
+	}
+	if bm.pipeline == nil {
+		bm.openPipeline()
+		if bm.pipeline == nil {
+			return
+		}
+	}
+
+	installed := 0
+	for index := len(pipelineStages) - 1; index >= 0; index-- {
+		stage := pipelineStages[index]
+		record := bm.stageRecord(stage)
+		if record.disabled {
+			continue
+		}
+		if record.program != nil {
+			if err := bm.pipeline.Put(uint32(index), record.program); err != nil {
+				log.Printf("⚠️  Failed to reinstall pipeline stage %s: %v", stage, err)
+			}
+			continue
+		}
+		program, exists := programs[stage]
+		if !exists {
+			continue
+		}
+		clone, err := program.Clone()
+		if err != nil {
+			log.Printf("⚠️  Failed to install pipeline stage %s: %v", stage, err)
+			continue
+		}
+		if err := bm.pipeline.Put(uint32(index), clone); err != nil {
+			log.Printf("⚠️  Failed to install pipeline stage %s: %v", stage, err)
+			clone.Close()
+			continue
+		}
+		record.program, record.object, record.updatedAt = clone, object, bm.clock.Now()
+		installed++
+	}
+	if installed > 0 {
+		log.Printf("✅ Tail-call pipeline: %d stages installed from %s", installed, object)
+	}
+}
+
+// stageEnabled reports whether a stage slot holds a program, and its ID
+func (bm *BPFMapManager) stageEnabled(index int) (bool, uint32, error) {
+	if bm.simulated {
+		record := bm.stages[pipelineStages[index]]
+		return record == nil || !record.disabled, 0, nil
+	}
+	if bm.pipeline == nil {
+		return false, 0, nil
+	}
+	var id uint32
+	err := bm.pipeline.Lookup(uint32(index), &id)
+	if errors.Is(err, ebpf.ErrKeyNotExist) {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to read stage %s: %v", pipelineStages[index], err)
+	}
+	return true, id, nil
+}
+
+// PipelineStages reports the stages of the data plane in packet order
+func (bm *BPFMapManager) PipelineStages() ([]*pb.PipelineStage, error) {
+	stages := make([]*pb.PipelineStage, 0, len(pipelineStages))
+	for index, name := range pipelineStages {
+		enabled, id, err := bm.stageEnabled(index)
+		if err != nil {
+			return nil, err
+		}
+		stage := &pb.PipelineStage{Name: name, Index: int32(index), Enabled: enabled, ProgramId: id}
+		if record, exists := bm.stages[name]; exists && !record.updatedAt.IsZero() {
+			stage.Object = record.object
+			stage.UpdatedAt = record.updatedAt.Unix()
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// UpdatePipelineStage enables, disables or upgrades one stage. Enable and
+// upgrade load the stage's program from object, the loaded XDP object by
+// default; enabling a stage that is already enabled changes nothing.
+func (bm *BPFMapManager) UpdatePipelineStage(stage, action, object string) error {
+	index := pipelineStageIndex(stage)
+	if index < 0 {
+		return fmt.Errorf("unknown stage %q, expected one of %s", stage, strings.Join(pipelineStages, ", "))
+	}
+	if !bm.simulated && bm.pipeline == nil {
+		return fmt.Errorf("the loaded XDP program has no tail-call pipeline")
+	}
+
+	switch action {
+	case PipelineDisable:
+		if !bm.simulated {
+			if err := bm.pipeline.Delete(uint32(index)); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				return fmt.Errorf("failed to clear stage slot: %v", err)
+			}
+		}
+		record := bm.stageRecord(stage)
+		if record.program != nil {
+			record.program.Close()
+		}
+		record.program, record.disabled, record.updatedAt = nil, true, bm.clock.Now()
+		return nil
+
+	case PipelineEnable, PipelineUpgrade:
+		if action == PipelineEnable {
+			enabled, _, err := bm.stageEnabled(index)
+			if err != nil {
+				return err
+			}
+			if enabled {
+				return nil
+			}
+		}
+		if object == "" {
+			object = bm.pipelineObject
+		}
+		record := bm.stageRecord(stage)
+		if bm.simulated {
+			record.disabled, record.object, record.updatedAt = false, object, bm.clock.Now()
+			return nil
+		}
+		if object == "" {
+			return fmt.Errorf("no XDP object loaded to take the stage from")
+		}
+
+		program, err := loadPipelineStage(object, stage, bm.pinPath)
+		if err != nil {
+			return err
+		}
+		if err := bm.pipeline.Put(uint32(index), program); err != nil {
+			program.Close()
+			return fmt.Errorf("failed to install stage: %v", err)
+		}
+		if record.program != nil {
+			record.program.Close()
+		}
+		record.program, record.disabled, record.object, record.updatedAt = program, false, object, bm.clock.Now()
+		return nil
+
+	default:
+		return fmt.Errorf("unknown action %q, expected enable, disable or upgrade", action)
+	}
+}
+
+// loadPipelineStage loads the program of a stage from an object file with
+// only the maps it uses, sharing the pinned maps of the data plane. Objects
+// whose maps do not match the pinned ones are rejected.
+func loadPipelineStage(object, stage, pinPath string) (*ebpf.Program, error) {
+	spec, err := ebpf.LoadCollectionSpec(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %v", object, err)
+	}
+	name := pipelineProgramName(stage)
+	programSpec, exists := spec.Programs[name]
+	if !exists {
+		return nil, fmt.Errorf("program %q not found in %s", name, object)
+	}
+	if programSpec.Type != ebpf.XDP {
+		return nil, fmt.Errorf("program %q in %s is not an XDP program", name, object)
+	}
+
+	used := make(map[string]bool)
+	for _, ins := range programSpec.Instructions {
+		if ins.IsLoadFromMap() {
+			used[ins.Reference()] = true
+		}
+	}
+	for mapName := range spec.Maps {
+		if !used[mapName] {
+			delete(spec.Maps, mapName)
+		}
+	}
+	spec.Programs = map[string]*ebpf.ProgramSpec{name: programSpec}
+
+	coll, err := ebpf.NewCollectionWithOptions(spec, ebpf.CollectionOptions{
+		Maps: ebpf.MapOptions{PinPath: pinPath},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stage %s from %s: %v", stage, object, err)
+	}
+	program := coll.Programs[name]
+	delete(coll.Programs, name)
+	coll.Close()
+	return program, nil
+}
+
+// closePipeline releases the stage programs held by the control plane;
+// installed stages stay in the pinned program array
+func (bm *BPFMapManager) closePipeline() {
+	for _, record := range bm.stages {
+		if record.program != nil {
+			record.program.Close()
+			record.program = nil
+		}
+	}
+	if bm.pipeline != nil {
+		bm.pipeline.Close()
+	}
+}
+
+// pipelineResponse reports the pipeline of a data plane
+func pipelineResponse(manager *BPFMapManager) (*pb.PipelineResponse, error) {
+	stages, err := manager.PipelineStages()
+	if err != nil {
+		return nil, err
+	}
+	counters, err := manager.PipelineCounters()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PipelineResponse{Success: true, Stages: stages}
+	resp.Active = len(stages) > 0 && stages[0].Enabled
+	addPipelineCounters(resp, counters)
+	return resp, nil
+}
+
+// GetPipeline returns the stages of a data plane's pipeline
+func (s *Server) GetPipeline(ctx context.Context, req *pb.GetPipelineRequest) (*pb.PipelineResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	manager := s.dataPlaneFor(req.Scope)
+	if manager == nil {
+		return nil, fmt.Errorf("no data plane for scope %q", req.Scope)
+	}
+	return pipelineResponse(manager)
+}
+
+// UpdatePipeline enables, disables or upgrades one stage of a data
+// plane's pipeline. Packets keep flowing throughout: a stage is swapped in
+// one program array update.
+func (s *Server) UpdatePipeline(ctx context.Context, req *pb.UpdatePipelineRequest) (*pb.PipelineResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	manager := s.dataPlaneFor(req.Scope)
+	if manager == nil {
+		return &pb.PipelineResponse{Success: false, Message: fmt.Sprintf("No data plane for scope %q", req.Scope)}, nil
+	}
+
+	action := strings.ToLower(req.Action)
+	if err := manager.UpdatePipelineStage(req.Stage, action, req.Object); err != nil {
+		resp, statusErr := pipelineResponse(manager)
+		if statusErr != nil {
+			resp = &pb.PipelineResponse{}
+		}
+		resp.Success = false
+		resp.Message = fmt.Sprintf("Failed to %s stage %s: %v", action, req.Stage, err)
+		return resp, nil
+	}
+
+	resp, err := pipelineResponse(manager)
+	if err != nil {
+		return nil, err
+	}
+	scope := req.Scope
+	if scope == "" {
+		scope = "host"
+	}
+	message := fmt.Sprintf("Pipeline stage %s of %s data plane: %s", req.Stage, scope, action)
+	s.events.Publish(&pb.Event{
+		Type:     EventPipelineChanged,
+		Message:  message,
+		Severity: "medium",
+	})
+	log.Printf("%s", message)
+
+	resp.Message = message
+	return resp, nil
+}
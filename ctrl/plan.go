@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Change planning: preview data plane changes of a candidate policy
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// PlanApply computes the data plane adds, removes and modifications that
+// replacing the current policy with the candidate would cause, without
+// applying anything
+func (s *Server) PlanApply(ctx context.Context, req *pb.PlanApplyRequest) (*pb.PlanApplyResponse, error) {
+	if req.GetPolicy() == nil {
+		return &pb.PlanApplyResponse{Valid: false, Errors: []string{"Policy is required"}}, nil
+	}
+
+	candidate, errs := newCandidateServer(req.Policy)
+	if len(errs) > 0 {
+		return &pb.PlanApplyResponse{Valid: false, Errors: errs}, nil
+	}
+
+	current := s.compiledPolicy()
+	planned := compilePolicy(candidate.policySnapshot(), current.Version+1, s.clock.Now())
+	diff := diffEntries(current.Sorted, planned.Sorted)
+
+	resp := &pb.PlanApplyResponse{Valid: true, Unchanged: int32(diff.Unchanged)}
+	for _, entry := range diff.Adds {
+		resp.Adds = append(resp.Adds, toProtoRule(entry))
+	}
+	for _, entry := range diff.Removes {
+		resp.Removes = append(resp.Removes, toProtoRule(entry))
+	}
+	for _, change := range diff.Modifies {
+		resp.Modifies = append(resp.Modifies, &pb.RuleChange{
+			Before: toProtoRule(change.Before),
+			After:  toProtoRule(change.After),
+		})
+	}
+
+	return resp, nil
+}
+
+// newCandidateServer loads a policy document into a detached Server (no
+// data plane) and returns every validation error found
+func newCandidateServer(policy *pb.PolicyDocument) (*Server, []string) {
+	candidate := NewServer(nil)
+	var errs []string
+
+	for _, p := range policy.Services {
+		service := serviceFromProto(p)
+		if err := validateService(service); err != nil {
+			errs = append(errs, fmt.Sprintf("service %s: %v", service.Name, err))
+			continue
+		}
+		candidate.services[service.Name] = service
+	}
+
+	for _, p := range policy.AddressObjects {
+		object := addressObjectFromProto(p)
+		if err := validateAddressObject(object); err != nil {
+			errs = append(errs, fmt.Sprintf("address object %s: %v", object.Name, err))
+			continue
+		}
+		candidate.addressObjects[object.Name] = object
+	}
+	for _, object := range candidate.addressObjects {
+		for _, member := range object.Members {
+			if _, exists := candidate.addressObjects[member]; !exists {
+				errs = append(errs, fmt.Sprintf("address object %s: unknown member %s", object.Name, member))
+			}
+		}
+		if candidate.addressGroupHasCycle(object.Name, map[string]bool{}) {
+			errs = append(errs, fmt.Sprintf("address object %s: group contains itself", object.Name))
+		}
+	}
+
+	for _, p := range policy.Zones {
+		zone := zoneFromProto(p)
+		if err := validateZone(zone); err != nil {
+			errs = append(errs, fmt.Sprintf("zone %s: %v", zone.Name, err))
+			continue
+		}
+		candidate.zones[zone.Name] = zone
+	}
+
+	for i, p := range policy.ZonePolicies {
+		zonePolicy := zonePolicyFromProto(p)
+		if zonePolicy.ID == "" {
+			zonePolicy.ID = fmt.Sprintf("candidate_zpol_%d", i)
+		}
+		if err := candidate.validateZonePolicy(zonePolicy); err != nil {
+			errs = append(errs, fmt.Sprintf("zone policy %s: %v", zonePolicy.ID, err))
+			continue
+		}
+		candidate.zonePolicies[zonePolicy.ID] = zonePolicy
+	}
+
+	// Namespaces are only declared here; the candidate never attaches them
+	for _, p := range policy.Namespaces {
+		namespace := namespaceFromProto(p)
+		if err := validateNamespace(namespace); err != nil {
+			errs = append(errs, fmt.Sprintf("namespace %s: %v", namespace.Name, err))
+			continue
+		}
+		candidate.namespaces[namespace.Name] = namespace
+	}
+	for _, p := range policy.VfPolicies {
+		vfPolicy := vfPolicyFromProto(p)
+		if err := validateVFPolicy(vfPolicy); err != nil {
+			errs = append(errs, fmt.Sprintf("VF policy %s: %v", vfPolicy.Key(), err))
+			continue
+		}
+		candidate.vfPolicies[vfPolicy.Key()] = vfPolicy
+	}
+
+	for i, p := range policy.Rules {
+		id := p.Id
+		if id == "" {
+			id = fmt.Sprintf("candidate_%d", i)
+		}
+		rule, err := fromProtoRule(p, candidate.clock.Now())
+		if err == nil {
+			rule.ID = id
+			err = candidate.validateRule(rule)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: %v", id, err))
+			continue
+		}
+		candidate.rules[rule.ID] = rule
+	}
+	errs = append(errs, priorityCollisionErrors(candidate.rules, sortedEntryIDs(candidate.rules))...)
+
+	return candidate, errs
+}
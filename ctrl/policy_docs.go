@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy documentation generator for Cerberus-V
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+const (
+	// Supported documentation output formats
+	DocFormatMarkdown = "markdown"
+	DocFormatHTML     = "html"
+)
+
+// policyDocGroup is a named set of rules rendered under one heading
+type policyDocGroup struct {
+	Name  string
+	Rules []*FirewallRule
+}
+
+// policyDoc is the data model passed to the documentation templates
+type policyDoc struct {
+	Version     string
+	GeneratedAt string
+	RuleCount   int
+	Groups      []policyDocGroup
+	Hits        map[string]*RuleHits // Data plane hit counters by rule ID
+}
+
+var docFuncs = map[string]interface{}{
+	"srcport": docSrcPort,
+	"dstport": docDstPort,
+	"proto":   docProtocol,
+	"src":     docSource,
+	"dst":     docDestination,
+	"orAny":   docOrAny,
+	"enabled": docEnabled,
+	"mdcell":  docMarkdownCell,
+	"hits":    docHits,
+	"labels":  docLabels,
+}
+
+var markdownDocTemplate = texttemplate.Must(texttemplate.New("markdown").Funcs(docFuncs).Parse(
+	`# Cerberus-V Firewall Policy
+
+Generated {{.GeneratedAt}} by cerberus-ctrl v{{.Version}} — {{.RuleCount}} rule(s).
+{{range .Groups}}
+## {{.Name}}
+
+| Priority | ID | Action | Source | Destination | Protocol | Enabled | Hits | Owner | Labels | Description |
+|---|---|---|---|---|---|---|---|---|---|---|
+{{range .Rules}}| {{.Priority}} | {{.ID}} | {{.Action}} | {{mdcell (src .)}}:{{srcport .}} | {{mdcell (dst .)}}:{{dstport .}} | {{proto .}} | {{enabled .Enabled}} | {{hits $.Hits .ID}} | {{mdcell .Owner}} | {{mdcell (labels .)}} | {{mdcell .Description}} |
+{{end}}{{end}}`))
+
+var htmlDocTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(docFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Cerberus-V Firewall Policy</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+tr.disabled { color: #999; }
+</style>
+</head>
+<body>
+<h1>Cerberus-V Firewall Policy</h1>
+<p>Generated {{.GeneratedAt}} by cerberus-ctrl v{{.Version}} &mdash; {{.RuleCount}} rule(s).</p>
+{{range .Groups}}<h2>{{.Name}}</h2>
+<table>
+<tr><th>Priority</th><th>ID</th><th>Action</th><th>Source</th><th>Destination</th><th>Protocol</th><th>Enabled</th><th>Hits</th><th>Owner</th><th>Labels</th><th>Description</th></tr>
+{{range .Rules}}<tr{{if not .Enabled}} class="disabled"{{end}}><td>{{.Priority}}</td><td>{{.ID}}</td><td>{{.Action}}</td><td>{{src .}}:{{srcport .}}</td><td>{{dst .}}:{{dstport .}}</td><td>{{proto .}}</td><td>{{enabled .Enabled}}</td><td>{{hits $.Hits .ID}}</td><td>{{.Owner}}</td><td>{{labels .}}</td><td>{{.Description}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+// GenerateDocs renders the active policy as human-readable Markdown or HTML
+func (s *Server) GenerateDocs(format string) ([]byte, error) {
+	s.mutex.RLock()
+	doc := s.buildPolicyDoc()
+	s.mutex.RUnlock()
+
+	var buf bytes.Buffer
+	var err error
+	switch strings.ToLower(format) {
+	case "", "md", DocFormatMarkdown:
+		err = markdownDocTemplate.Execute(&buf, doc)
+	case DocFormatHTML:
+		err = htmlDocTemplate.Execute(&buf, doc)
+	default:
+		return nil, fmt.Errorf("unsupported documentation format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render policy documentation: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// buildPolicyDoc groups rules by direction (zone-derived rules by zone pair)
+// and orders them by priority. Caller must hold s.mutex.
+func (s *Server) buildPolicyDoc() *policyDoc {
+	groups := make(map[string][]*FirewallRule)
+	ruleCount := len(s.rules)
+	for _, rule := range s.rules {
+		name := docGroupName(rule)
+		groups[name] = append(groups[name], rule)
+	}
+	for _, policy := range s.sortedZonePolicies() {
+		name := fmt.Sprintf("Zone %s → %s", policy.FromZone, policy.ToZone)
+		compiled := compileZonePolicy(policy, s.zones, s.clock.Now())
+		groups[name] = append(groups[name], compiled...)
+		ruleCount += len(compiled)
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	doc := &policyDoc{
+		Version:     Version,
+		GeneratedAt: s.clock.Now().UTC().Format(time.RFC3339),
+		RuleCount:   ruleCount,
+		Hits:        s.ruleHits(),
+	}
+	for _, name := range names {
+		rules := groups[name]
+		sort.Slice(rules, func(i, j int) bool {
+			if rules[i].Priority != rules[j].Priority {
+				return rules[i].Priority < rules[j].Priority
+			}
+			return rules[i].ID < rules[j].ID
+		})
+		doc.Groups = append(doc.Groups, policyDocGroup{Name: name, Rules: rules})
+	}
+
+	return doc
+}
+
+func docGroupName(rule *FirewallRule) string {
+	switch rule.Direction {
+	case "inbound":
+		return "Inbound"
+	case "outbound":
+		return "Outbound"
+	case "both":
+		return "Both directions"
+	default:
+		return "Unspecified direction"
+	}
+}
+
+func docSrcPort(rule *FirewallRule) string {
+	if rule.SrcPortEnd > rule.SrcPort {
+		return fmt.Sprintf("%d-%d", rule.SrcPort, rule.SrcPortEnd)
+	}
+	return docPort(rule.SrcPort)
+}
+
+func docPort(port int32) string {
+	if port == 0 {
+		return "any"
+	}
+	return fmt.Sprintf("%d", port)
+}
+
+func docSource(rule *FirewallRule) string {
+	described := docASNs(docCountries(docMAC(docAddress(rule.SrcAddress, rule.SrcIP, rule.SrcSet), rule.SrcMAC), rule.SrcCountry), rule.SrcASN)
+	if rule.VLAN != 0 {
+		described += fmt.Sprintf(" on VLAN %d", rule.VLAN)
+	}
+	if len(rule.Interfaces) > 0 {
+		described += " via " + strings.Join(rule.Interfaces, ", ")
+	}
+	return described
+}
+
+func docDestination(rule *FirewallRule) string {
+	return docASNs(docCountries(docMAC(docAddress(rule.DstAddress, rule.DstIP, rule.DstSet), rule.DstMAC), rule.DstCountry), rule.DstASN)
+}
+
+// docCountries adds the countries one side of a rule is narrowed to
+func docCountries(described string, countries []string) string {
+	if len(countries) == 0 {
+		return described
+	}
+	return fmt.Sprintf("%s in %s", described, strings.Join(countries, ", "))
+}
+
+// docASNs adds the origin ASNs one side of a rule is narrowed to
+func docASNs(described string, asns []uint32) string {
+	if len(asns) == 0 {
+		return described
+	}
+	return fmt.Sprintf("%s announced by %s", described, formatASNs(asns))
+}
+
+// docMAC adds the MAC address one side of a rule is narrowed to
+func docMAC(described, mac string) string {
+	if mac == "" {
+		return described
+	}
+	return fmt.Sprintf("%s (MAC %s)", described, mac)
+}
+
+// docAddress names the address object or address of one side of a rule,
+// followed by the IP set it is narrowed to
+func docAddress(object, addr string, set uint32) string {
+	described := docOrAny(addr)
+	if object != "" {
+		described = object
+	}
+	if set != 0 {
+		if described == "any" {
+			return fmt.Sprintf("IP set %d", set)
+		}
+		return fmt.Sprintf("%s in IP set %d", described, set)
+	}
+	return described
+}
+
+func docDstPort(rule *FirewallRule) string {
+	if rule.Service != "" {
+		return rule.Service
+	}
+	if rule.DstPortEnd > rule.DstPort {
+		return fmt.Sprintf("%d-%d", rule.DstPort, rule.DstPortEnd)
+	}
+	return docPort(rule.DstPort)
+}
+
+func docProtocol(rule *FirewallRule) string {
+	if rule.Service != "" {
+		return "service " + rule.Service
+	}
+	return docOrAny(rule.Protocol)
+}
+
+func docOrAny(value string) string {
+	if value == "" {
+		return "any"
+	}
+	return value
+}
+
+// docHits formats the packets a rule matched
+func docHits(hits map[string]*RuleHits, id string) string {
+	if ruleHits, exists := hits[id]; exists {
+		return fmt.Sprintf("%d", ruleHits.Packets)
+	}
+	return "0"
+}
+
+func docEnabled(enabled bool) string {
+	if enabled {
+		return "yes"
+	}
+	return "no"
+}
+
+// docLabels lists the labels of a rule as key=value, tags as the bare key
+func docLabels(rule *FirewallRule) string {
+	labels := make([]string, 0, len(rule.Labels))
+	for key, value := range rule.Labels {
+		if value == "" {
+			labels = append(labels, key)
+		} else {
+			labels = append(labels, key+"="+value)
+		}
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ", ")
+}
+
+// docMarkdownCell keeps free-form text from breaking the Markdown table
+func docMarkdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", " ")
+}
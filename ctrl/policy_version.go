@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Policy version in the data plane: written after each apply and stamped
+// onto packet samples and rule log events
+
+package main
+
+import (
+	"log"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// PolicyVersionMapName is the pinned policy version (must match eBPF program)
+const PolicyVersionMapName = "cerberus_policy_version"
+
+// openPolicyVersion opens the pinned policy version, left nil if the
+// program predates it
+func (bm *BPFMapManager) openPolicyVersion() {
+	path := filepath.Join(bm.pinPath, PolicyVersionMapName)
+	version, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Policy version stamping not available at %s: %v", path, err)
+		return
+	}
+	bm.policyVersion = version
+}
+
+// SetPolicyVersion records the version whose entries the data plane
+// holds. Without the map events carry no version.
+func (bm *BPFMapManager) SetPolicyVersion(version uint64) error {
+	if bm.simulated || bm.policyVersion == nil {
+		return nil
+	}
+	key := uint32(0)
+	return bm.policyVersion.Update(&key, &version, ebpf.UpdateAny)
+}
+
+// pushPolicyVersion writes the running version to every data plane once
+// its entries are installed. Packets evaluated while an apply is in
+// progress carry the previous version. Caller must hold s.mutex.
+func (s *Server) pushPolicyVersion() {
+	version := s.compiledPolicy().Version
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		if err := manager.SetPolicyVersion(version); err != nil {
+			log.Printf("⚠️  Failed to stamp policy version %d in %q: %v", version, scope, err)
+		}
+	}
+}
+
+// eventPolicyVersion returns the policy version a data plane event was
+// stamped with, false for events of a program that predates stamping
+func eventPolicyVersion(event *pb.Event) (uint64, bool) {
+	version, err := strconv.ParseUint(event.Metadata["policy_version"], 10, 64)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+	return version, true
+}
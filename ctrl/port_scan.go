@@ -0,0 +1,443 @@
+// SPDX-License-Identifier: Apache-2.0
+// Port scan detection: sources trying many distinct ports, counted by the
+// data plane, reported as threats and optionally quarantined
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned port scan maps (must match eBPF program)
+	PortScanConfigMapName = "cerberus_portscan_config"
+	PortScanMapName       = "cerberus_portscan"
+	PortScanPortsMapName  = "cerberus_portscan_ports"
+	PortScanStatsMapName  = "cerberus_portscan_stats"
+
+	// EventThreatDetection reports a threat a detector found; metadata
+	// "detector" names it
+	EventThreatDetection = "THREAT_DETECTION"
+
+	// ThreatDetectorPortScan is the detector of port scans
+	ThreatDetectorPortScan = "port_scan"
+
+	// Settings of a config leaving them at 0
+	DefaultPortScanThreshold     = 100
+	DefaultPortScanWindowSeconds = 60
+
+	// Highest threshold, every TCP and UDP port; longest window; most
+	// exempt prefixes
+	MaxPortScanThreshold     = 2 * 65536
+	MaxPortScanWindowSeconds = 3600
+	MaxPortScanExempt        = 1024
+
+	// How often the data plane's counts are evaluated
+	portScanInterval = 5 * time.Second
+)
+
+// PortScanConfig is when a source counts as scanning: trying at least
+// PortThreshold distinct TCP or UDP ports within WindowSeconds
+type PortScanConfig struct {
+	Enabled       bool           `json:"enabled"`
+	PortThreshold uint32         `json:"port_threshold"`
+	WindowSeconds uint32         `json:"window_seconds"`
+	Quarantine    bool           `json:"quarantine"`       // Block scanners like automatic blocking does
+	Exempt        []netip.Prefix `json:"exempt,omitempty"` // Never reported
+}
+
+// portScanConfig mirrors struct portscan_config in the eBPF program
+type portScanConfig struct {
+	Window uint64 // Nanoseconds, 0 = off
+}
+
+// portScanState mirrors struct portscan_state in the eBPF program
+type portScanState struct {
+	Window    uint64
+	Ports     uint64
+	LastPorts uint64
+	Attempts  uint64
+	LastSeen  uint64 // CLOCK_MONOTONIC nanoseconds
+}
+
+// portScanStats mirrors struct portscan_stats in the eBPF program
+type portScanStats struct {
+	Attempts uint64
+	NewPorts uint64
+}
+
+// portScanSource is a source the data plane counts the ports of, keyed
+// like the source rate limits
+type portScanSource struct {
+	key   sourceLimitKey
+	state portScanState
+}
+
+// ports returns the distinct ports of a source in its current window, or
+// in the window that just ended if that saw more
+func (source *portScanSource) ports(now uint64, window uint64) uint64 {
+	var elapsed uint64
+	if now > source.state.Window {
+		elapsed = now - source.state.Window
+	}
+	switch {
+	case elapsed < window:
+		return max(source.state.Ports, source.state.LastPorts)
+	case elapsed < 2*window:
+		return source.state.Ports
+	}
+	return 0
+}
+
+// validatePortScanConfig checks a config and fills in the settings left
+// at 0
+func validatePortScanConfig(config *PortScanConfig) error {
+	var errs ruleValidationError
+	if config.PortThreshold > MaxPortScanThreshold {
+		errs.add("port_threshold", "port_threshold must be at most %d", MaxPortScanThreshold)
+	}
+	if config.WindowSeconds > MaxPortScanWindowSeconds {
+		errs.add("window_seconds", "window_seconds must be at most %d", MaxPortScanWindowSeconds)
+	}
+	if len(config.Exempt) > MaxPortScanExempt {
+		errs.add("exempt", "at most %d prefixes can be exempt", MaxPortScanExempt)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if config.PortThreshold == 0 {
+		config.PortThreshold = DefaultPortScanThreshold
+	}
+	if config.WindowSeconds == 0 {
+		config.WindowSeconds = DefaultPortScanWindowSeconds
+	}
+	return nil
+}
+
+// encode converts a config to the data plane's
+func (config PortScanConfig) encode() portScanConfig {
+	if !config.Enabled {
+		return portScanConfig{}
+	}
+	return portScanConfig{Window: uint64(config.WindowSeconds) * uint64(time.Second)}
+}
+
+// SetPortScanConfig replaces the port scan detection config
+func (s *Server) SetPortScanConfig(ctx context.Context, req *pb.SetPortScanConfigRequest) (*pb.PortScanStatusResponse, error) {
+	if req.GetConfig() == nil {
+		return &pb.PortScanStatusResponse{Success: false, Message: "Port scan config is required"}, nil
+	}
+
+	config, err := portScanConfigFromProto(req.Config)
+	if err == nil {
+		err = validatePortScanConfig(&config)
+	}
+	if err != nil {
+		return &pb.PortScanStatusResponse{Success: false, Message: fmt.Sprintf("Port scan config validation failed: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	if err := s.pushPortScan(config); err != nil {
+		s.mutex.Unlock()
+		return &pb.PortScanStatusResponse{Success: false, Message: fmt.Sprintf("Failed to push port scan config to data plane: %v", withRemediation(err))}, nil
+	}
+	s.portScan = config
+	s.persistPolicy()
+	s.mutex.Unlock()
+	log.Printf("Set port scan detection: enabled=%v, %d ports in %ds, quarantine=%v",
+		config.Enabled, config.PortThreshold, config.WindowSeconds, config.Quarantine)
+
+	resp := s.portScanStatus()
+	resp.Success = true
+	resp.Message = "Port scan config saved successfully"
+	return resp, nil
+}
+
+// GetPortScanStatus returns the port scan detection config, the sources
+// over the threshold and the counters
+func (s *Server) GetPortScanStatus(ctx context.Context, req *pb.Empty) (*pb.PortScanStatusResponse, error) {
+	resp := s.portScanStatus()
+	resp.Success = true
+	return resp, nil
+}
+
+// portScanStatus returns the config, the scanners most ports first and
+// the data plane's counters
+func (s *Server) portScanStatus() *pb.PortScanStatusResponse {
+	s.mutex.RLock()
+	config, manager := s.portScan, s.bpfManager
+	quarantined := make(map[string]bool)
+	for _, rule := range s.autoBlockRules() {
+		quarantined[rule.SrcIP] = true
+	}
+	s.mutex.RUnlock()
+
+	resp := &pb.PortScanStatusResponse{Config: portScanConfigToProto(config), Detections: s.portScanDetections.Load()}
+	if manager == nil {
+		return resp
+	}
+	sources, err := manager.PortScanSources()
+	if err != nil {
+		log.Printf("⚠️  Failed to read port scan sources: %v", err)
+	}
+	stats, err := manager.PortScanStats()
+	if err != nil {
+		log.Printf("⚠️  Failed to read port scan counters: %v", err)
+	}
+	resp.Sources = uint64(len(sources))
+	resp.Attempts = stats.Attempts
+	if !config.Enabled {
+		return resp
+	}
+	wall, now := s.clock.Now(), monotonicNow()
+	for _, source := range portScanners(sources, config, now) {
+		addr := source.key.addr()
+		resp.Scanners = append(resp.Scanners, &pb.PortScanner{
+			Address:     addr.String(),
+			Ports:       source.ports(now, config.encode().Window),
+			Attempts:    source.state.Attempts,
+			LastSeen:    wallTime(wall, source.state.LastSeen, now).Unix(),
+			Quarantined: quarantined[addr.String()],
+		})
+	}
+	return resp
+}
+
+// portScanners returns the sources that reached the threshold and are not
+// exempt, most ports first
+func portScanners(sources []portScanSource, config PortScanConfig, now uint64) []*portScanSource {
+	window := config.encode().Window
+	var scanners []*portScanSource
+	for i := range sources {
+		if sources[i].ports(now, window) >= uint64(config.PortThreshold) && !sourceExempt(config.Exempt, sources[i].key.addr()) {
+			scanners = append(scanners, &sources[i])
+		}
+	}
+	sort.Slice(scanners, func(i, j int) bool {
+		a, b := scanners[i].ports(now, window), scanners[j].ports(now, window)
+		if a != b {
+			return a > b
+		}
+		return scanners[i].key.addr().Less(scanners[j].key.addr())
+	})
+	return scanners
+}
+
+// monitorPortScans evaluates the data plane's port counts every interval
+// until ctx is done, reporting each scanner once per window
+func (s *Server) monitorPortScans(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	reported := make(map[netip.Addr]time.Time)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.mutex.RLock()
+		manager, config := s.bpfManager, s.portScan
+		s.mutex.RUnlock()
+		if manager == nil || !config.Enabled {
+			continue
+		}
+		sources, err := manager.PortScanSources()
+		if err != nil {
+			log.Printf("⚠️  Failed to read port scan sources: %v", err)
+			continue
+		}
+
+		now := s.clock.Now()
+		window := time.Duration(config.WindowSeconds) * time.Second
+		for addr, at := range reported {
+			if now.Sub(at) >= window {
+				delete(reported, addr)
+			}
+		}
+		mono := monotonicNow()
+		for _, source := range portScanners(sources, config, mono) {
+			addr := source.key.addr()
+			if _, done := reported[addr]; done {
+				continue
+			}
+			if s.detectPortScan(config, source, mono, now) {
+				reported[addr] = now
+			}
+		}
+	}
+}
+
+// detectPortScan reports a scanner with a THREAT_DETECTION event,
+// quarantining it first if configured. A scanner quarantined already is
+// not reported again; false is returned for it.
+func (s *Server) detectPortScan(config PortScanConfig, source *portScanSource, mono uint64, now time.Time) bool {
+	addr := source.key.addr()
+	ports := source.ports(mono, config.encode().Window)
+	quarantined := false
+	if config.Quarantine {
+		blockConfig := s.quarantineConfig()
+		offense, ok := s.autoBlocker.offense(blockConfig, addr, now)
+		if !ok {
+			return false
+		}
+		trigger := autoBlockTrigger{addr: addr, reason: AutoBlockReasonPortScan, count: float64(ports), window: config.WindowSeconds, offense: offense}
+		if err := s.blockAutomatically(blockConfig, trigger, now); err != nil {
+			log.Printf("⚠️  Failed to quarantine port scanner %s: %v", addr, err)
+		} else {
+			quarantined = true
+		}
+	}
+
+	s.portScanDetections.Add(1)
+	s.events.Publish(&pb.Event{
+		Type:     EventThreatDetection,
+		Source:   addr.String(),
+		Message:  fmt.Sprintf("port scan from %s: %d distinct ports in %ds", addr, ports, config.WindowSeconds),
+		Severity: "high",
+		Metadata: map[string]string{
+			"detector":       ThreatDetectorPortScan,
+			"ports":          strconv.FormatUint(ports, 10),
+			"attempts":       strconv.FormatUint(source.state.Attempts, 10),
+			"window_seconds": strconv.FormatUint(uint64(config.WindowSeconds), 10),
+			"quarantined":    strconv.FormatBool(quarantined),
+		},
+	})
+	log.Printf("🔎 Port scan from %s: %d distinct ports in %ds (quarantined: %v)", addr, ports, config.WindowSeconds, quarantined)
+	return true
+}
+
+// pushPortScan writes a config to the host data plane. Caller must hold
+// s.mutex.
+func (s *Server) pushPortScan(config PortScanConfig) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	return s.bpfManager.SetPortScan(config.encode())
+}
+
+func portScanConfigFromProto(config *pb.PortScanConfig) (PortScanConfig, error) {
+	converted := PortScanConfig{
+		Enabled:       config.Enabled,
+		PortThreshold: config.PortThreshold,
+		WindowSeconds: config.WindowSeconds,
+		Quarantine:    config.Quarantine,
+	}
+	var errs ruleValidationError
+	converted.Exempt = parseExemptPrefixes(config.Exempt, &errs)
+	if len(errs) > 0 {
+		return converted, errs
+	}
+	return converted, nil
+}
+
+func portScanConfigToProto(config PortScanConfig) *pb.PortScanConfig {
+	converted := &pb.PortScanConfig{
+		Enabled:       config.Enabled,
+		PortThreshold: config.PortThreshold,
+		WindowSeconds: config.WindowSeconds,
+		Quarantine:    config.Quarantine,
+	}
+	for _, prefix := range config.Exempt {
+		converted.Exempt = append(converted.Exempt, prefix.String())
+	}
+	return converted
+}
+
+// openPortScan opens the pinned port scan maps, turning detection off
+// until the stored config is re-pushed on restore
+func (bm *BPFMapManager) openPortScan() {
+	names := []string{PortScanConfigMapName, PortScanMapName, PortScanPortsMapName, PortScanStatsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  Port scan detection not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.portScanConfig, bm.portScans, bm.portScanPorts, bm.portScanStats = maps[0], maps[1], maps[2], maps[3]
+
+	if err := bm.SetPortScan(portScanConfig{}); err != nil {
+		log.Printf("⚠️  Failed to reset port scan detection: %v", err)
+	}
+}
+
+// closePortScan closes the port scan maps, if open
+func (bm *BPFMapManager) closePortScan() {
+	if bm.portScanConfig == nil {
+		return
+	}
+	bm.portScanConfig.Close()
+	bm.portScans.Close()
+	bm.portScanPorts.Close()
+	bm.portScanStats.Close()
+}
+
+// SetPortScan writes the port scan detection config
+func (bm *BPFMapManager) SetPortScan(config portScanConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting port scan window (%s)", time.Duration(config.Window))
+		return nil
+	}
+	if bm.portScanConfig == nil {
+		return fmt.Errorf("port scan maps not available")
+	}
+	key := uint32(0)
+	if err := bm.portScanConfig.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write port scan config: %v", err)
+	}
+	return nil
+}
+
+// PortScanSources reads the sources the data plane counts the ports of
+func (bm *BPFMapManager) PortScanSources() ([]portScanSource, error) {
+	if bm.simulated || bm.portScans == nil {
+		return nil, nil
+	}
+	var sources []portScanSource
+	var source portScanSource
+	entries := bm.portScans.Iterate()
+	for entries.Next(&source.key, &source.state) {
+		sources = append(sources, source)
+	}
+	if err := entries.Err(); err != nil {
+		return sources, err
+	}
+	return sources, nil
+}
+
+// PortScanStats reads the port scan counters, summed across CPUs
+func (bm *BPFMapManager) PortScanStats() (portScanStats, error) {
+	var stats portScanStats
+	if bm.simulated || bm.portScanStats == nil {
+		return stats, nil
+	}
+	var perCPU []portScanStats
+	key := uint32(0)
+	if err := bm.portScanStats.Lookup(&key, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Attempts += value.Attempts
+		stats.NewPorts += value.NewPorts
+	}
+	return stats, nil
+}
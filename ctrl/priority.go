@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Priority order in the data plane: equal-priority entries take slots in ID order, bulk priority changes
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// EventRulesReordered reports a ReorderRules priority change
+const EventRulesReordered = "RULES_REORDERED"
+
+// The XDP program picks the lowest priority number among matching slots and
+// the lowest slot among equal priorities. Keeping entries of equal priority
+// in ID order across slots makes the data plane evaluate them in the order
+// of the compiled policy (see sortByPriority).
+
+// tieBounds returns the slots an entry must sit strictly between to follow
+// the entries of equal priority with lower IDs and precede those with
+// higher IDs
+func (t *ruleSlotTable) tieBounds(rule *FirewallRule) (int, int, error) {
+	after, before := -1, int(t.rulesMap.MaxEntries())
+	for id, slot := range t.slots {
+		if id == rule.ID {
+			continue
+		}
+		var value BPFFirewallRule
+		if err := t.rulesMap.Lookup(&slot, &value); err != nil {
+			return 0, 0, fmt.Errorf("failed to read slot %d of %s: %v", slot, id, err)
+		}
+		if value.Priority != rule.Priority {
+			continue
+		}
+		if id < rule.ID {
+			after = max(after, int(slot))
+		} else {
+			before = min(before, int(slot))
+		}
+	}
+	return after, before, nil
+}
+
+// freeSlot returns the index in t.free of the lowest free slot strictly
+// between after and before
+func (t *ruleSlotTable) freeSlot(after, before int) (int, bool) {
+	found := -1
+	for i, slot := range t.free {
+		if int(slot) > after && int(slot) < before && (found < 0 || slot < t.free[found]) {
+			found = i
+		}
+	}
+	return found, found >= 0
+}
+
+// takeFree removes a slot from the free list
+func (t *ruleSlotTable) takeFree(slot uint32) {
+	for i, free := range t.free {
+		if free == slot {
+			t.free = append(t.free[:i], t.free[i+1:]...)
+			return
+		}
+	}
+}
+
+// orderedPlacement plans the slots of a new entry set given in priority
+// order. Entries in keep may stay in their slot while it is in order;
+// every other entry gets the lowest free slot keeping its priority group
+// ascending. A group that does not fit around its kept entries is placed
+// afresh. It returns the entries to write and their slots.
+func (t *ruleSlotTable) orderedPlacement(sorted []*FirewallRule, keep map[string]bool) (map[string]uint32, error) {
+	free := append([]uint32(nil), t.free...)
+	sort.Slice(free, func(i, j int) bool { return free[i] < free[j] })
+
+	moves := make(map[string]uint32)
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Priority == sorted[start].Priority {
+			end++
+		}
+		group := sorted[start:end]
+		start = end
+
+		groupMoves, remaining, placed := t.placeGroup(group, keep, free)
+		if !placed {
+			groupMoves, remaining, placed = t.placeGroup(group, nil, free)
+		}
+		if !placed {
+			return nil, withCode(ErrCodeNoReplaceSlot, fmt.Errorf("rules map has no free slot for a hit-less replace (%d entries)", t.rulesMap.MaxEntries()))
+		}
+		for id, slot := range groupMoves {
+			moves[id] = slot
+		}
+		free = remaining
+	}
+	return moves, nil
+}
+
+// placeGroup places one priority group in ascending slots, taking slots
+// from the ascending free list. It returns the entries placed in free
+// slots and the slots left free.
+func (t *ruleSlotTable) placeGroup(group []*FirewallRule, keep map[string]bool, free []uint32) (map[string]uint32, []uint32, bool) {
+	free = append([]uint32(nil), free...)
+	moves := make(map[string]uint32)
+	last := -1
+	for i, entry := range group {
+		if slot := t.slots[entry.ID]; keep[entry.ID] && int(slot) > last {
+			last = int(slot)
+			continue
+		}
+
+		// Stay below the next entry that keeps its slot
+		before := int(t.rulesMap.MaxEntries())
+		for _, later := range group[i+1:] {
+			if slot := t.slots[later.ID]; keep[later.ID] && int(slot) > last {
+				before = int(slot)
+				break
+			}
+		}
+		j := sort.Search(len(free), func(k int) bool { return int(free[k]) > last })
+		if j == len(free) || int(free[j]) >= before {
+			return nil, nil, false
+		}
+		moves[entry.ID] = free[j]
+		last = int(free[j])
+		free = append(free[:j], free[j+1:]...)
+	}
+	return moves, free, true
+}
+
+// ReorderRules sets the priority of many rules at once. The new order is
+// enforced in one hit-less data plane update, so no packet sees a mix of
+// old and new priorities.
+func (s *Server) ReorderRules(ctx context.Context, req *pb.ReorderRulesRequest) (*pb.ReorderRulesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs []string
+	for id := range req.Priorities {
+		if _, exists := s.rules[id]; !exists {
+			errs = append(errs, fmt.Sprintf("rule %s: not found", id))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return &pb.ReorderRulesResponse{Success: false, Message: "Unknown rules", Errors: errs}, nil
+	}
+
+	now := s.clock.Now()
+	rules := make(map[string]*FirewallRule, len(s.rules))
+	changed := 0
+	for id, rule := range s.rules {
+		priority, reordered := req.Priorities[id]
+		if !reordered || priority == rule.Priority {
+			rules[id] = rule
+			continue
+		}
+		updated := *rule
+		updated.Priority = priority
+		updated.UpdatedAt = now
+		rules[id] = &updated
+		changed++
+	}
+	if changed == 0 {
+		return &pb.ReorderRulesResponse{
+			Success: true,
+			Message: "Priorities unchanged",
+			Version: s.compiledPolicy().Version,
+		}, nil
+	}
+
+	previous := s.rules
+	s.rules = rules
+	if err := s.replacePolicy(ctx); err != nil {
+		s.rules = previous
+		if interrupted, ok := asInterrupted(err); ok {
+			return nil, interrupted
+		}
+		return &pb.ReorderRulesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to reorder rules in data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+	version := s.compiledPolicy().Version
+	s.events.Publish(&pb.Event{
+		Type:     EventRulesReordered,
+		Message:  fmt.Sprintf("Priorities of %d rules changed (policy version %d)", changed, version),
+		Severity: "medium",
+	})
+	log.Printf("Reordered rules: %d priorities changed, policy version %d", changed, version)
+
+	return &pb.ReorderRulesResponse{
+		Success: true,
+		Message: "Rules reordered successfully",
+		Count:   int32(changed),
+		Version: version,
+	}, nil
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// eBPF program runtime statistics: keeps kernel BPF stats enabled and samples run time and run count per program
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"golang.org/x/sys/unix"
+)
+
+// bpfStatsSysctl enables the same statistics system-wide
+const bpfStatsSysctl = "/proc/sys/kernel/bpf_stats_enabled"
+
+// dataPlanePrograms are the program names of ebpf/xdp_filter.c as the
+// kernel reports them (at most 15 characters)
+var dataPlanePrograms = map[string]bool{
+	"xdp_firewall": true,
+	"tc_ingress":   true,
+	"tc_egress":    true,
+}
+
+// ProgramStats are the runtime counters of one loaded data plane program
+type ProgramStats struct {
+	ID        uint32  `json:"id"`
+	Name      string  `json:"name"`
+	Type      string  `json:"type"`
+	RunCount  uint64  `json:"run_count"`
+	RunTimeNs uint64  `json:"run_time_ns"`
+	NsPerRun  float64 `json:"ns_per_run"` // Average over the last interval
+}
+
+// ProgramStatsStatus is a snapshot of the supervisor
+type ProgramStatsStatus struct {
+	Enabled  bool           `json:"enabled"`
+	CPUUsage float64        `json:"cpu_usage"` // Percent of all CPUs over the last interval
+	Programs []ProgramStats `json:"programs"`
+}
+
+// ProgramStatsSupervisor keeps BPF runtime statistics enabled for as long
+// as it runs and samples every data plane program. The kernel only counts
+// run time and runs while statistics are enabled.
+type ProgramStatsSupervisor struct {
+	mutex    sync.Mutex
+	stats    io.Closer // Holds BPF_STATS_RUN_TIME enabled, nil = off or enabled by sysctl
+	enabled  bool
+	programs map[ebpf.ProgramID]ProgramStats
+	cpuUsage float64
+	lastTime time.Time
+	clock    Clock
+}
+
+// NewProgramStatsSupervisor creates a supervisor; statistics are enabled
+// when it runs
+func NewProgramStatsSupervisor(clock Clock) *ProgramStatsSupervisor {
+	return &ProgramStatsSupervisor{programs: make(map[ebpf.ProgramID]ProgramStats), clock: clock}
+}
+
+// Run enables statistics and samples the programs every interval until
+// ctx is done, then releases the statistics. Enabling is retried on every
+// pass until it succeeds.
+func (ps *ProgramStatsSupervisor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ps.sample()
+	for {
+		select {
+		case <-ctx.Done():
+			ps.mutex.Lock()
+			if ps.stats != nil {
+				ps.stats.Close()
+				ps.stats = nil
+			}
+			ps.enabled = false
+			ps.mutex.Unlock()
+			return
+		case <-ticker.C:
+			ps.sample()
+		}
+	}
+}
+
+// Status returns the last sample, programs ordered by ID
+func (ps *ProgramStatsSupervisor) Status() ProgramStatsStatus {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	status := ProgramStatsStatus{Enabled: ps.enabled, CPUUsage: ps.cpuUsage}
+	for _, stats := range ps.programs {
+		status.Programs = append(status.Programs, stats)
+	}
+	sort.Slice(status.Programs, func(i, j int) bool { return status.Programs[i].ID < status.Programs[j].ID })
+	return status
+}
+
+// enable turns statistics on through BPF_ENABLE_STATS, or finds them on
+// through the sysctl on kernels before 5.8. Caller must hold ps.mutex.
+func (ps *ProgramStatsSupervisor) enable() {
+	if ps.enabled {
+		return
+	}
+	stats, err := ebpf.EnableStats(uint32(unix.BPF_STATS_RUN_TIME))
+	if err == nil {
+		ps.stats, ps.enabled = stats, true
+		log.Printf("📊 eBPF program runtime statistics enabled")
+		return
+	}
+	if value, readErr := os.ReadFile(bpfStatsSysctl); readErr == nil && strings.TrimSpace(string(value)) == "1" {
+		ps.enabled = true
+		log.Printf("📊 eBPF program runtime statistics enabled by %s", bpfStatsSysctl)
+		return
+	}
+	log.Printf("⚠️  eBPF program runtime statistics not available: %v", err)
+}
+
+// sample reads the counters of every loaded data plane program. Averages
+// cover the time since the previous sample; programs that went away are
+// dropped.
+func (ps *ProgramStatsSupervisor) sample() {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	ps.enable()
+	if !ps.enabled {
+		return
+	}
+
+	now := ps.clock.Now()
+	programs := make(map[ebpf.ProgramID]ProgramStats)
+	var runTime uint64
+	for id := ebpf.ProgramID(0); ; {
+		next, err := ebpf.ProgramGetNextID(id)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				log.Printf("Failed to list eBPF programs: %v", err)
+			}
+			break
+		}
+		id = next
+
+		stats, ok := readProgramStats(id)
+		if !ok {
+			continue
+		}
+		previous, seen := ps.programs[id]
+		if seen && stats.RunCount > previous.RunCount {
+			stats.NsPerRun = float64(stats.RunTimeNs-previous.RunTimeNs) / float64(stats.RunCount-previous.RunCount)
+		}
+		if seen {
+			runTime += stats.RunTimeNs - previous.RunTimeNs
+		}
+		programs[id] = stats
+	}
+
+	ps.cpuUsage = 0
+	if !ps.lastTime.IsZero() {
+		elapsed := now.Sub(ps.lastTime)
+		ps.cpuUsage = float64(runTime) / float64(elapsed.Nanoseconds()*int64(runtime.NumCPU())) * 100
+	}
+	ps.programs, ps.lastTime = programs, now
+}
+
+// readProgramStats reads the counters of a program if it belongs to the
+// data plane; programs may be unloaded while they are listed
+func readProgramStats(id ebpf.ProgramID) (ProgramStats, bool) {
+	prog, err := ebpf.NewProgramFromID(id)
+	if err != nil {
+		return ProgramStats{}, false
+	}
+	defer prog.Close()
+
+	info, err := prog.Info()
+	if err != nil || !dataPlanePrograms[info.Name] {
+		return ProgramStats{}, false
+	}
+	stats := ProgramStats{ID: uint32(id), Name: info.Name, Type: info.Type.String()}
+	if runCount, ok := info.RunCount(); ok {
+		stats.RunCount = runCount
+	}
+	if runTime, ok := info.Runtime(); ok {
+		stats.RunTimeNs = uint64(runTime.Nanoseconds())
+	}
+	return stats, true
+}
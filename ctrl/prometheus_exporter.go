@@ -1,316 +1,1065 @@
 // SPDX-License-Identifier: Apache-2.0
-// Prometheus Metrics Exporter for Cerberus-V
+// Prometheus exporter: metrics gathered on scrape from the data plane maps and control plane state
 
 package main
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// PrometheusExporter is a prometheus.Collector reading every metric when it
+// is scraped: counters are the data plane's own cumulative values, never
+// accumulated by the exporter
 type PrometheusExporter struct {
-	server *http.Server
-	
-	// Metrics
-	packetsProcessed *prometheus.CounterVec
-	packetsDropped   *prometheus.CounterVec
-	packetsAllowed   *prometheus.CounterVec
-	bytesProcessed   *prometheus.CounterVec
-	activeConnections prometheus.Gauge
-	ruleCount        prometheus.Gauge
-	systemLoad       prometheus.Gauge
-	memoryUsage      prometheus.Gauge
-	
-	// VPP specific metrics
-	vppPacketsRx     *prometheus.CounterVec
-	vppPacketsTx     *prometheus.CounterVec
-	vppDrops         *prometheus.CounterVec
-	vppPunts         *prometheus.CounterVec
-	
-	// eBPF specific metrics
-	ebpfMapEntries   *prometheus.GaugeVec
-	ebpfPrograms     prometheus.Gauge
-	ebpfCpuUsage     prometheus.Gauge
-}
-
-func NewPrometheusExporter(port int) *PrometheusExporter {
-	pe := &PrometheusExporter{}
-	
-	// Initialize metrics
-	pe.packetsProcessed = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_packets_processed_total",
-			Help: "Total number of packets processed",
-		},
-		[]string{"interface", "direction"},
-	)
-	
-	pe.packetsDropped = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_packets_dropped_total",
-			Help: "Total number of packets dropped",
-		},
-		[]string{"interface", "reason"},
-	)
-	
-	pe.packetsAllowed = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_packets_allowed_total",
-			Help: "Total number of packets allowed",
-		},
-		[]string{"interface", "protocol"},
-	)
-	
-	pe.bytesProcessed = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_bytes_processed_total",
-			Help: "Total bytes processed",
-		},
-		[]string{"interface", "direction"},
-	)
-	
-	pe.activeConnections = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_active_connections",
-			Help: "Number of active connections",
-		},
-	)
-	
-	pe.ruleCount = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_firewall_rules_count",
-			Help: "Number of active firewall rules",
-		},
-	)
-	
-	pe.systemLoad = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_system_load",
-			Help: "System load average",
-		},
-	)
-	
-	pe.memoryUsage = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_memory_usage_bytes",
-			Help: "Memory usage in bytes",
-		},
-	)
-	
-	// VPP metrics
-	pe.vppPacketsRx = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_vpp_packets_rx_total",
-			Help: "VPP packets received",
-		},
-		[]string{"interface", "worker"},
-	)
-	
-	pe.vppPacketsTx = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_vpp_packets_tx_total",
-			Help: "VPP packets transmitted",
-		},
-		[]string{"interface", "worker"},
-	)
-	
-	pe.vppDrops = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_vpp_drops_total",
-			Help: "VPP packet drops",
-		},
-		[]string{"interface", "reason"},
-	)
-	
-	pe.vppPunts = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_vpp_punts_total",
-			Help: "VPP packet punts",
-		},
-		[]string{"interface", "reason"},
-	)
-	
-	// eBPF metrics
-	pe.ebpfMapEntries = prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "cerberus_ebpf_map_entries",
-			Help: "Number of entries in eBPF maps",
-		},
-		[]string{"map_name"},
-	)
-	
-	pe.ebpfPrograms = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_ebpf_programs_loaded",
-			Help: "Number of loaded eBPF programs",
-		},
-	)
-	
-	pe.ebpfCpuUsage = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "cerberus_ebpf_cpu_usage_percent",
-			Help: "eBPF CPU usage percentage",
-		},
-	)
-	
-	// Register metrics
-	prometheus.MustRegister(
-		pe.packetsProcessed,
-		pe.packetsDropped,
-		pe.packetsAllowed,
-		pe.bytesProcessed,
-		pe.activeConnections,
-		pe.ruleCount,
-		pe.systemLoad,
-		pe.memoryUsage,
-		pe.vppPacketsRx,
-		pe.vppPacketsTx,
-		pe.vppDrops,
-		pe.vppPunts,
-		pe.ebpfMapEntries,
-		pe.ebpfPrograms,
-		pe.ebpfCpuUsage,
+	bpfManager *BPFMapManager
+	server     *Server
+	startTime  time.Time
+	registry   *prometheus.Registry
+
+	// VPP stats segment reader, nil = no VPP metrics
+	vppTelemetry *VPPTelemetryCollector
+
+	// Packet sampler of the host data plane, nil = no sampling metrics
+	sampler *PacketSampler
+
+	// Replies to packets of reject rules, nil = no reject metrics
+	rejecter *Rejecter
+
+	// Packets logged by rules with the log flag, nil = no rule log metrics
+	ruleLogger *RuleLogger
+
+	// Runtime statistics of the data plane programs, nil = no program metrics
+	programStats *ProgramStatsSupervisor
+}
+
+var (
+	uptimeDesc = prometheus.NewDesc("cerberus_uptime_seconds",
+		"System uptime in seconds", nil, nil)
+	activeRulesDesc = prometheus.NewDesc("cerberus_active_rules",
+		"Number of active firewall rules", nil, nil)
+	packetsDesc = prometheus.NewDesc("cerberus_packets_total",
+		"Total number of packets processed", []string{"action"}, nil)
+	bytesDesc = prometheus.NewDesc("cerberus_bytes_total",
+		"Total number of bytes processed (estimated)", []string{"action"}, nil)
+	buildInfoDesc = prometheus.NewDesc("cerberus_build_info",
+		"Build information", []string{"version", "mode"}, nil)
+	countersSinceDesc = prometheus.NewDesc("cerberus_counters_since_timestamp_seconds",
+		"Start of the packet totals, which continue across restarts and data plane reloads", nil, nil)
+	counterResetsDesc = prometheus.NewDesc("cerberus_counter_resets_total",
+		"Data plane reloads whose counts were carried into the packet totals", nil, nil)
+
+	conntrackEntriesDesc = prometheus.NewDesc("cerberus_conntrack_entries",
+		"Tracked flows by state", []string{"state"}, nil)
+	conntrackCapacityDesc = prometheus.NewDesc("cerberus_conntrack_capacity",
+		"Flow table size", nil, nil)
+
+	sampleRateDesc = prometheus.NewDesc("cerberus_sample_rate",
+		"Packets per sample (1 in N), 0 = off", nil, nil)
+	sampleBudgetDesc = prometheus.NewDesc("cerberus_sample_budget",
+		"Target samples per second", nil, nil)
+	samplesDesc = prometheus.NewDesc("cerberus_samples_total",
+		"Packet samples read from the data plane", nil, nil)
+
+	rejectRepliesDesc = prometheus.NewDesc("cerberus_reject_replies_total",
+		"Packets dropped by reject rules by reply outcome", []string{"result"}, nil)
+	mirrorPacketsDesc = prometheus.NewDesc("cerberus_mirror_packets_total",
+		"Copies of packets passed by mirror rules by outcome", []string{"result"}, nil)
+	multicastPacketsDesc = prometheus.NewDesc("cerberus_multicast_packets_total",
+		"Packets arriving for each multicast group", []string{"group"}, nil)
+	multicastBytesDesc = prometheus.NewDesc("cerberus_multicast_bytes_total",
+		"Bytes arriving for each multicast group", []string{"group"}, nil)
+	multicastDroppedDesc = prometheus.NewDesc("cerberus_multicast_dropped_total",
+		"Packets for each multicast group that were dropped", []string{"group"}, nil)
+	multicastMembersDesc = prometheus.NewDesc("cerberus_multicast_members",
+		"Hosts that reported membership of each multicast group", []string{"group"}, nil)
+	nat64PacketsDesc = prometheus.NewDesc("cerberus_nat64_packets_total",
+		"Packets translated by each NAT64 prefix by direction", []string{"prefix", "direction"}, nil)
+	nat64SessionsCreatedDesc = prometheus.NewDesc("cerberus_nat64_sessions_created_total",
+		"NAT64 sessions created for each prefix", []string{"prefix"}, nil)
+	nat64FailedDesc = prometheus.NewDesc("cerberus_nat64_failed_total",
+		"Packets each NAT64 prefix left untranslated, e.g. for lack of a free pool port", []string{"prefix"}, nil)
+	nat64SessionsDesc = prometheus.NewDesc("cerberus_nat64_sessions",
+		"Open NAT64 sessions of each prefix", []string{"prefix"}, nil)
+	natPacketsDesc = prometheus.NewDesc("cerberus_nat_packets_total",
+		"Packets rewritten by each NAT rule by direction", []string{"rule", "type", "direction"}, nil)
+	natFailedDesc = prometheus.NewDesc("cerberus_nat_failed_total",
+		"Packets each NAT rule left untranslated, e.g. fragments", []string{"rule", "type"}, nil)
+	natSessionsDesc = prometheus.NewDesc("cerberus_nat_sessions",
+		"Open sessions of each source NAT rule", []string{"rule", "type"}, nil)
+	natSessionsCreatedDesc = prometheus.NewDesc("cerberus_nat_sessions_created_total",
+		"Sessions created by each source NAT rule", []string{"rule", "type"}, nil)
+	natPortsInUseDesc = prometheus.NewDesc("cerberus_nat_ports_in_use",
+		"Public ports held by the open sessions of each source NAT rule", []string{"rule", "type"}, nil)
+	natPortsDesc = prometheus.NewDesc("cerberus_nat_ports",
+		"Public ports in the range of each source NAT rule", []string{"rule", "type"}, nil)
+	natSessionTableDesc = prometheus.NewDesc("cerberus_nat_session_table_entries",
+		"Open source NAT sessions of every rule", nil, nil)
+	natSessionTableCapacityDesc = prometheus.NewDesc("cerberus_nat_session_table_capacity",
+		"Source NAT sessions the data plane holds before evicting the least recently used", nil, nil)
+	dnsQueriesDesc = prometheus.NewDesc("cerberus_dns_queries_total",
+		"DNS queries the data plane checked against the domain lists, by result", []string{"result"}, nil)
+	dnsListMatchesDesc = prometheus.NewDesc("cerberus_dns_list_matches_total",
+		"Reported DNS queries each domain list matched", []string{"list", "action"}, nil)
+	dnsListDomainsDesc = prometheus.NewDesc("cerberus_dns_list_domains",
+		"Domains in each domain list", []string{"list", "action"}, nil)
+	tlsHellosDesc = prometheus.NewDesc("cerberus_tls_hellos_total",
+		"TLS ClientHellos the data plane reported for fingerprinting, by result", []string{"result"}, nil)
+	tlsBlockedFlowsDesc = prometheus.NewDesc("cerberus_tls_blocked_flows_total",
+		"TLS flows blocked by their fingerprint", nil, nil)
+	tlsDroppedPacketsDesc = prometheus.NewDesc("cerberus_tls_dropped_packets_total",
+		"Packets of blocked TLS flows dropped by the data plane", nil, nil)
+	tlsRuleMatchesDesc = prometheus.NewDesc("cerberus_tls_fingerprint_matches_total",
+		"ClientHellos each TLS fingerprint rule matched", []string{"rule", "action"}, nil)
+	synFloodPacketsDesc = prometheus.NewDesc("cerberus_synflood_packets_total",
+		"Packets seen by SYN flood mitigation: new SYNs counted, rate limited, answered with a cookie, and RSTs returning a valid or invalid one", []string{"result"}, nil)
+	synFloodMitigatedDesc = prometheus.NewDesc("cerberus_synflood_mitigated_destinations",
+		"Destinations under SYN flood mitigation", nil, nil)
+	sourceLimitPacketsDesc = prometheus.NewDesc("cerberus_source_limit_packets_total",
+		"Packets seen by the per-source rate limits: counted against a source, dropped over its packet or connection rate, and passed from exempt sources", []string{"result"}, nil)
+	sourceLimitExemptionsDesc = prometheus.NewDesc("cerberus_source_limit_exemptions",
+		"Sources exempt from the per-source rate limits", nil, nil)
+	autoBlocksDesc = prometheus.NewDesc("cerberus_auto_blocks_total",
+		"Sources blocked automatically since start by reason", []string{"reason"}, nil)
+	autoBlocksActiveDesc = prometheus.NewDesc("cerberus_auto_blocks_active",
+		"Automatic blocks in effect", nil, nil)
+	portScanDetectionsDesc = prometheus.NewDesc("cerberus_port_scan_detections_total",
+		"Port scans reported as THREAT_DETECTION events since start", nil, nil)
+	portScanAttemptsDesc = prometheus.NewDesc("cerberus_port_scan_attempts_total",
+		"New connection attempts counted by port scan detection", nil, nil)
+	portScanScannersDesc = prometheus.NewDesc("cerberus_port_scan_scanners",
+		"Sources at or over the port scan threshold", nil, nil)
+	normalizationDropsDesc = prometheus.NewDesc("cerberus_normalization_drops_total",
+		"Packets dropped by packet normalization by reason", []string{"reason"}, nil)
+	normalizationEventsDesc = prometheus.NewDesc("cerberus_normalization_events_total",
+		"PACKET_NORMALIZED events raised since start", nil, nil)
+	mssClampSYNsDesc = prometheus.NewDesc("cerberus_mss_clamp_syns_total",
+		"TCP SYNs inspected by MSS clamping by interface and result: clamped, unchanged or without an MSS option", []string{"interface", "result"}, nil)
+	policerDropsDesc = prometheus.NewDesc("cerberus_policer_dropped_packets_total",
+		"Packets dropped by the ingress policer by interface", []string{"interface"}, nil)
+	policerDropBytesDesc = prometheus.NewDesc("cerberus_policer_dropped_bytes_total",
+		"Bytes dropped by the ingress policer by interface", []string{"interface"}, nil)
+	shadowPacketsDesc = prometheus.NewDesc("cerberus_shadow_packets_total",
+		"Estimated packets the shadow policy was compared on, by whether it agreed with the active policy", []string{"result"}, nil)
+	shadowUncomparedDesc = prometheus.NewDesc("cerberus_shadow_uncompared_samples_total",
+		"Samples the shadow policy was not compared on", nil, nil)
+	dns64QueriesDesc = prometheus.NewDesc("cerberus_dns64_queries_total",
+		"Queries answered by the DNS64 proxy by result", []string{"result"}, nil)
+	ruleLogEventsDesc = prometheus.NewDesc("cerberus_rule_log_events_total",
+		"Packets logged by rules with the log flag, before weighting by the log sample rate", []string{"rule_id", "verdict"}, nil)
+	geoIPAgeDesc = prometheus.NewDesc("cerberus_geoip_database_age_seconds",
+		"Time since the GeoIP database in use was built", nil, nil)
+	geoIPLoadedDesc = prometheus.NewDesc("cerberus_geoip_database_loaded_timestamp_seconds",
+		"Unix time the GeoIP database in use was loaded", nil, nil)
+	geoIPPrefixesDesc = prometheus.NewDesc("cerberus_geoip_database_prefixes",
+		"Prefixes with a country in the GeoIP database in use", []string{"family"}, nil)
+	geoIPRulePrefixesDesc = prometheus.NewDesc("cerberus_geoip_rule_prefixes",
+		"GeoIP prefixes of the countries rules match, written to the host data plane", []string{"family"}, nil)
+	geoIPRefreshesDesc = prometheus.NewDesc("cerberus_geoip_refreshes_total",
+		"Changes of the GeoIP database file by whether they loaded", []string{"result"}, nil)
+	asnAgeDesc = prometheus.NewDesc("cerberus_asn_database_age_seconds",
+		"Time since the ASN database in use was built", nil, nil)
+	asnLoadedDesc = prometheus.NewDesc("cerberus_asn_database_loaded_timestamp_seconds",
+		"Unix time the ASN database in use was loaded", nil, nil)
+	asnPrefixesDesc = prometheus.NewDesc("cerberus_asn_database_prefixes",
+		"Prefixes with an origin ASN in the ASN database in use", []string{"family"}, nil)
+	asnRulePrefixesDesc = prometheus.NewDesc("cerberus_asn_rule_prefixes",
+		"ASN database prefixes of the ASNs rules match, written to the host data plane", []string{"family"}, nil)
+	asnDownloadsDesc = prometheus.NewDesc("cerberus_asn_downloads_total",
+		"Downloads of the ASN database that changed its file", nil, nil)
+	asnRefreshesDesc = prometheus.NewDesc("cerberus_asn_refreshes_total",
+		"Downloads and changes of the ASN database file by whether they succeeded", []string{"result"}, nil)
+
+	// Threat intelligence feeds
+	feedEntriesDesc = prometheus.NewDesc("cerberus_feed_entries",
+		"Prefixes of a threat intelligence feed in its IP set", []string{"feed", "family"}, nil)
+	feedAgeDesc = prometheus.NewDesc("cerberus_feed_age_seconds",
+		"Time since a feed was last downloaded successfully", []string{"feed"}, nil)
+	feedCheckedDesc = prometheus.NewDesc("cerberus_feed_checked_timestamp_seconds",
+		"Unix time a feed was last downloaded successfully, changed or not", []string{"feed"}, nil)
+	feedUpdatedDesc = prometheus.NewDesc("cerberus_feed_updated_timestamp_seconds",
+		"Unix time a download last changed a feed's entries", []string{"feed"}, nil)
+	feedDownloadsDesc = prometheus.NewDesc("cerberus_feed_downloads_total",
+		"Downloads of a feed by whether they succeeded", []string{"feed", "result"}, nil)
+	feedSkippedDesc = prometheus.NewDesc("cerberus_feed_skipped_lines",
+		"Lines of a feed's latest download holding no usable address", []string{"feed"}, nil)
+
+	// Database downloads
+	updateLastSuccessDesc = prometheus.NewDesc("cerberus_update_last_success_timestamp_seconds",
+		"Unix time a database download last ended with a verified file in use", []string{"database"}, nil)
+	updateLastAttemptDesc = prometheus.NewDesc("cerberus_update_last_attempt_timestamp_seconds",
+		"Unix time a database download was last attempted", []string{"database"}, nil)
+	updateStalenessDesc = prometheus.NewDesc("cerberus_update_staleness_seconds",
+		"Time since a database download last succeeded, or before any has, since the file in place changed", []string{"database"}, nil)
+	updateResultsDesc = prometheus.NewDesc("cerberus_update_results_total",
+		"Database download attempts by result", []string{"database", "result"}, nil)
+	resourceUsageDesc = prometheus.NewDesc("cerberus_resource_usage",
+		"Control plane use of each self-limited resource", []string{"resource"}, nil)
+	resourceLimitDesc = prometheus.NewDesc("cerberus_resource_limit",
+		"Self-limit of each limited resource; absent = unlimited", []string{"resource"}, nil)
+	resourceRefusalsDesc = prometheus.NewDesc("cerberus_resource_refusals_total",
+		"Work refused because a resource was at its self-limit", []string{"resource"}, nil)
+	ringRecordsDesc = prometheus.NewDesc("cerberus_ringbuf_records_total",
+		"Records read from each data plane ring buffer", []string{"ring"}, nil)
+	ringConsumerDeliveredDesc = prometheus.NewDesc("cerberus_ringbuf_consumer_delivered_total",
+		"Ring buffer records handed to each consumer", []string{"ring", "consumer"}, nil)
+	ringConsumerDiscardedDesc = prometheus.NewDesc("cerberus_ringbuf_consumer_discarded_total",
+		"Ring buffer records lost because the consumer fell behind", []string{"ring", "consumer"}, nil)
+	ringConsumerLagDesc = prometheus.NewDesc("cerberus_ringbuf_consumer_lag",
+		"Ring buffer records read but not yet taken by each consumer", []string{"ring", "consumer"}, nil)
+
+	ebpfStatsEnabledDesc = prometheus.NewDesc("cerberus_ebpf_stats_enabled",
+		"Whether the kernel counts eBPF program run time", nil, nil)
+	ebpfCPUUsageDesc = prometheus.NewDesc("cerberus_ebpf_cpu_usage_percent",
+		"eBPF CPU usage percentage", nil, nil)
+	programRunTimeDesc = prometheus.NewDesc("cerberus_ebpf_program_run_time_ns_total",
+		"Time spent running each data plane program", []string{"program", "id"}, nil)
+	programRunsDesc = prometheus.NewDesc("cerberus_ebpf_program_runs_total",
+		"Runs of each data plane program, one per packet", []string{"program", "id"}, nil)
+	programNsPerPacketDesc = prometheus.NewDesc("cerberus_ebpf_program_ns_per_packet",
+		"Average run time per packet over the last interval", []string{"program", "id"}, nil)
+
+	degradationLevelDesc = prometheus.NewDesc("cerberus_degradation_level",
+		"Degradation stages entered, 0 = normal", []string{"stage"}, nil)
+	degradationCPUDesc = prometheus.NewDesc("cerberus_degradation_cpu",
+		"Softirq share of CPU time at the last evaluation", nil, nil)
+	degradationMapUsageDesc = prometheus.NewDesc("cerberus_degradation_flow_table_usage",
+		"Fullest flow table at the last evaluation", nil, nil)
+
+	attachModeDesc = prometheus.NewDesc("cerberus_xdp_attach_mode",
+		"Attach mode achieved per interface", []string{"scope", "interface", "mode", "requested"}, nil)
+
+	stagePacketsDesc = prometheus.NewDesc("cerberus_pipeline_stage_packets_total",
+		"Packets entering each pipeline stage", []string{"scope", "stage"}, nil)
+	inlinePacketsDesc = prometheus.NewDesc("cerberus_pipeline_inline_packets_total",
+		"Packets filtered without the pipeline", []string{"scope"}, nil)
+	dispositionsDesc = prometheus.NewDesc("cerberus_packet_dispositions_total",
+		"Ingress packets by where and why they got their verdict", []string{"scope", "disposition", "verdict"}, nil)
+	protocolPacketsDesc = prometheus.NewDesc("cerberus_protocol_packets_total",
+		"Ingress IP packets by L4 protocol", []string{"scope", "protocol"}, nil)
+	protocolBytesDesc = prometheus.NewDesc("cerberus_protocol_bytes_total",
+		"Ingress IP bytes by L4 protocol", []string{"scope", "protocol"}, nil)
+	protocolDroppedDesc = prometheus.NewDesc("cerberus_protocol_dropped_total",
+		"Ingress IP packets dropped by L4 protocol", []string{"scope", "protocol"}, nil)
+	bloomCheckedDesc = prometheus.NewDesc("cerberus_bloom_checked_total",
+		"Ingress packets pre-checked against the bloom filter", []string{"scope"}, nil)
+	bloomNegativeDesc = prometheus.NewDesc("cerberus_bloom_negative_total",
+		"Ingress packets the bloom filter decided matched no rule", []string{"scope"}, nil)
+	bloomElementsDesc = prometheus.NewDesc("cerberus_bloom_elements",
+		"Rule prefixes in the active bloom filter", []string{"scope"}, nil)
+
+	ruleHitsDesc = prometheus.NewDesc("cerberus_rule_hits_total",
+		"Packets matched by each rule", []string{"rule_id"}, nil)
+	ruleHitBytesDesc = prometheus.NewDesc("cerberus_rule_hit_bytes_total",
+		"Bytes matched by each rule", []string{"rule_id"}, nil)
+
+	tenantAPICallsDesc = prometheus.NewDesc("cerberus_tenant_api_calls_total",
+		"API calls made by each tenant", []string{"tenant"}, nil)
+	tenantRulesDesc = prometheus.NewDesc("cerberus_tenant_rules",
+		"Rules enforced for each tenant", []string{"tenant"}, nil)
+	tenantBytesDesc = prometheus.NewDesc("cerberus_tenant_enforced_bytes_total",
+		"Bytes matched by each tenant's rules", []string{"tenant"}, nil)
+	tenantPacketsDesc = prometheus.NewDesc("cerberus_tenant_enforced_packets_total",
+		"Packets matched by each tenant's rules", []string{"tenant"}, nil)
+
+	sloSLIDesc = prometheus.NewDesc("cerberus_slo_sli",
+		"Share of good events of each SLI over each window", []string{"sli", "window"}, nil)
+	sloTargetDesc = prometheus.NewDesc("cerberus_slo_target",
+		"Objective of each SLI", []string{"sli"}, nil)
+	sloBurnRateDesc = prometheus.NewDesc("cerberus_slo_burn_rate",
+		"Error budget spending speed of each SLI over each window, 1 = on budget", []string{"sli", "window"}, nil)
+	sloBudgetDesc = prometheus.NewDesc("cerberus_slo_error_budget_remaining",
+		"Share of the 30 day error budget left, negative once overspent", []string{"sli"}, nil)
+	sloAlertDesc = prometheus.NewDesc("cerberus_slo_alert",
+		"Burn rate alert firing for each SLI", []string{"sli", "alert"}, nil)
+	applyLatencyP99Desc = prometheus.NewDesc("cerberus_apply_latency_p99_seconds",
+		"P99 latency of the latest policy applies", nil, nil)
+	eventsDeliveredDesc = prometheus.NewDesc("cerberus_events_delivered_total",
+		"Events delivered to subscribers", nil, nil)
+	eventsDiscardedDesc = prometheus.NewDesc("cerberus_events_discarded_total",
+		"Events lost because a subscriber's buffer was full", nil, nil)
+
+	vppUpDesc = prometheus.NewDesc("cerberus_vpp_telemetry_up",
+		"Whether the VPP stats segment could be read", nil, nil)
+	vppVectorRateDesc = prometheus.NewDesc("cerberus_vpp_vector_rate",
+		"Average packets per vector across VPP threads", nil, nil)
+	vppWorkerVectorRateDesc = prometheus.NewDesc("cerberus_vpp_worker_vector_rate",
+		"Packets per vector of each VPP thread (max 256)", []string{"thread"}, nil)
+	vppRxNoBufDesc = prometheus.NewDesc("cerberus_vpp_rx_nombuf_total",
+		"Packets dropped because no receive buffers were available (DPDK rx_nombuf)", []string{"interface"}, nil)
+	vppRxMissDesc = prometheus.NewDesc("cerberus_vpp_rx_miss_total",
+		"Packets dropped by the NIC because the receive ring was full (DPDK imissed)", []string{"interface"}, nil)
+)
+
+// exporterDescs lists every metric the exporter may collect
+var exporterDescs = []*prometheus.Desc{
+	uptimeDesc, activeRulesDesc, packetsDesc, bytesDesc, buildInfoDesc, countersSinceDesc, counterResetsDesc,
+	conntrackEntriesDesc, conntrackCapacityDesc,
+	sampleRateDesc, sampleBudgetDesc, samplesDesc,
+	rejectRepliesDesc, ruleLogEventsDesc,
+	geoIPAgeDesc, geoIPLoadedDesc, geoIPPrefixesDesc, geoIPRulePrefixesDesc, geoIPRefreshesDesc,
+	asnAgeDesc, asnLoadedDesc, asnPrefixesDesc, asnRulePrefixesDesc, asnDownloadsDesc, asnRefreshesDesc,
+	feedEntriesDesc, feedAgeDesc, feedCheckedDesc, feedUpdatedDesc, feedDownloadsDesc, feedSkippedDesc,
+	updateLastSuccessDesc, updateLastAttemptDesc, updateStalenessDesc, updateResultsDesc,
+	resourceUsageDesc, resourceLimitDesc, resourceRefusalsDesc,
+	ringRecordsDesc, ringConsumerDeliveredDesc, ringConsumerDiscardedDesc, ringConsumerLagDesc,
+	mirrorPacketsDesc, multicastPacketsDesc, multicastBytesDesc, multicastDroppedDesc, multicastMembersDesc,
+	nat64PacketsDesc, nat64SessionsCreatedDesc, nat64FailedDesc, nat64SessionsDesc, dns64QueriesDesc,
+	natPacketsDesc, natFailedDesc, natSessionsDesc, natSessionsCreatedDesc, natPortsInUseDesc, natPortsDesc,
+	natSessionTableDesc, natSessionTableCapacityDesc,
+	dnsQueriesDesc, dnsListMatchesDesc, dnsListDomainsDesc,
+	tlsHellosDesc, tlsBlockedFlowsDesc, tlsDroppedPacketsDesc, tlsRuleMatchesDesc,
+	synFloodPacketsDesc, synFloodMitigatedDesc,
+	sourceLimitPacketsDesc, sourceLimitExemptionsDesc,
+	autoBlocksDesc, autoBlocksActiveDesc,
+	portScanDetectionsDesc, portScanAttemptsDesc, portScanScannersDesc,
+	normalizationDropsDesc, normalizationEventsDesc,
+	mssClampSYNsDesc, policerDropsDesc, policerDropBytesDesc,
+	shadowPacketsDesc, shadowUncomparedDesc,
+	ebpfStatsEnabledDesc, ebpfCPUUsageDesc, programRunTimeDesc, programRunsDesc, programNsPerPacketDesc,
+	degradationLevelDesc, degradationCPUDesc, degradationMapUsageDesc,
+	attachModeDesc,
+	stagePacketsDesc, inlinePacketsDesc, dispositionsDesc,
+	protocolPacketsDesc, protocolBytesDesc, protocolDroppedDesc,
+	bloomCheckedDesc, bloomNegativeDesc, bloomElementsDesc,
+	ruleHitsDesc, ruleHitBytesDesc,
+	tenantAPICallsDesc, tenantRulesDesc, tenantBytesDesc, tenantPacketsDesc,
+	sloSLIDesc, sloTargetDesc, sloBurnRateDesc, sloBudgetDesc, sloAlertDesc, applyLatencyP99Desc,
+	eventsDeliveredDesc, eventsDiscardedDesc,
+	vppUpDesc, vppVectorRateDesc, vppWorkerVectorRateDesc, vppRxNoBufDesc, vppRxMissDesc,
+}
+
+// NewPrometheusExporter creates a new Prometheus exporter with its own
+// registry, along with the Go runtime and process collectors
+func NewPrometheusExporter(bpfManager *BPFMapManager, server *Server) *PrometheusExporter {
+	pe := &PrometheusExporter{
+		bpfManager: bpfManager,
+		server:     server,
+		startTime:  time.Now(),
+		registry:   prometheus.NewRegistry(),
+	}
+	pe.registry.MustRegister(
+		pe,
+		extensionCollector{clock: server.clock},
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
-	
-	// Setup HTTP server
-	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
-	mux.HandleFunc("/health", pe.healthHandler)
-	
-	pe.server = &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: mux,
-	}
-	
 	return pe
 }
 
-func (pe *PrometheusExporter) Start() error {
-	log.Printf("🚀 Starting Prometheus exporter on port %s", pe.server.Addr)
-	
-	go func() {
-		if err := pe.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Printf("❌ Prometheus exporter error: %v", err)
-		}
-	}()
-	
-	return nil
-}
-
-func (pe *PrometheusExporter) Stop() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	
-	return pe.server.Shutdown(ctx)
-}
-
-func (pe *PrometheusExporter) healthHandler(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
-}
-
-// Update metrics with current statistics
-func (pe *PrometheusExporter) UpdateMetrics(stats *SystemStats) {
-	// Basic packet statistics
-	pe.packetsProcessed.WithLabelValues(stats.Interface, "rx").Add(float64(stats.PacketsRx))
-	pe.packetsProcessed.WithLabelValues(stats.Interface, "tx").Add(float64(stats.PacketsTx))
-	pe.packetsDropped.WithLabelValues(stats.Interface, "firewall").Add(float64(stats.PacketsDropped))
-	pe.packetsAllowed.WithLabelValues(stats.Interface, "tcp").Add(float64(stats.TCPConnections))
-	pe.packetsAllowed.WithLabelValues(stats.Interface, "udp").Add(float64(stats.UDPConnections))
-	
-	// Bytes processed
-	pe.bytesProcessed.WithLabelValues(stats.Interface, "rx").Add(float64(stats.BytesRx))
-	pe.bytesProcessed.WithLabelValues(stats.Interface, "tx").Add(float64(stats.BytesTx))
-	
-	// System metrics
-	pe.activeConnections.Set(float64(stats.ActiveConnections))
-	pe.ruleCount.Set(float64(stats.FirewallRules))
-	pe.systemLoad.Set(stats.SystemLoad)
-	pe.memoryUsage.Set(float64(stats.MemoryUsage))
-	
-	// VPP specific metrics
-	pe.vppPacketsRx.WithLabelValues(stats.Interface, "0").Add(float64(stats.VPP.PacketsRx))
-	pe.vppPacketsTx.WithLabelValues(stats.Interface, "0").Add(float64(stats.VPP.PacketsTx))
-	pe.vppDrops.WithLabelValues(stats.Interface, "acl").Add(float64(stats.VPP.Drops))
-	pe.vppPunts.WithLabelValues(stats.Interface, "unknown").Add(float64(stats.VPP.Punts))
-	
-	// eBPF metrics
-	pe.ebpfMapEntries.WithLabelValues("firewall_rules").Set(float64(stats.eBPF.MapEntries))
-	pe.ebpfPrograms.Set(float64(stats.eBPF.ProgramsLoaded))
-	pe.ebpfCpuUsage.Set(stats.eBPF.CPUUsage)
-}
-
-// Custom metrics for threat detection
-func (pe *PrometheusExporter) RecordThreatDetection(threatType, severity, sourceIP string) {
-	threatCounter := prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cerberus_threats_detected_total",
-			Help: "Total threats detected",
-		},
-		[]string{"type", "severity", "source_ip"},
-	)
-	
-	// Register if not already registered
-	prometheus.MustRegister(threatCounter)
-	threatCounter.WithLabelValues(threatType, severity, sourceIP).Inc()
-}
-
-// Performance metrics
-func (pe *PrometheusExporter) RecordPerformanceMetrics(latency time.Duration, throughput float64) {
-	latencyHist := prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name: "cerberus_processing_latency_seconds",
-			Help: "Processing latency in seconds",
-			Buckets: prometheus.DefBuckets,
-		},
-		[]string{"component"},
-	)
-	
-	throughputGauge := prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "cerberus_throughput_pps",
-			Help: "Throughput in packets per second",
-		},
-		[]string{"component"},
-	)
-	
-	prometheus.MustRegister(latencyHist, throughputGauge)
-	
-	latencyHist.WithLabelValues("firewall").Observe(latency.Seconds())
-	throughputGauge.WithLabelValues("firewall").Set(throughput)
-}
-
-// System statistics structure
-type SystemStats struct {
-	Interface         string
-	PacketsRx         uint64
-	PacketsTx         uint64
-	PacketsDropped    uint64
-	BytesRx           uint64
-	BytesTx           uint64
-	ActiveConnections int
-	TCPConnections    uint64
-	UDPConnections    uint64
-	FirewallRules     int
-	SystemLoad        float64
-	MemoryUsage       uint64
-	
-	VPP struct {
-		PacketsRx uint64
-		PacketsTx uint64
-		Drops     uint64
-		Punts     uint64
-	}
-	
-	eBPF struct {
-		MapEntries      int
-		ProgramsLoaded  int
-		CPUUsage        float64
-	}
-} 
\ No newline at end of file
+// Start starts the Prometheus HTTP server
+func (pe *PrometheusExporter) Start(port int) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(pe.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("OK"))
+	})
+
+	addr := fmt.Sprintf(":%d", port)
+	log.Printf("Prometheus exporter listening on %s", addr)
+
+	return http.ListenAndServe(addr, mux)
+}
+
+// Describe implements prometheus.Collector
+func (pe *PrometheusExporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range exporterDescs {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector. Sources that cannot be read are
+// logged and left out of the scrape rather than failing it.
+func (pe *PrometheusExporter) Collect(ch chan<- prometheus.Metric) {
+	pe.collectFirewallMetrics(ch)
+	if pe.bpfManager != nil {
+		pe.collectConntrackMetrics(ch)
+		pe.collectMirrorMetrics(ch)
+		pe.collectRingBusMetrics(ch)
+	}
+	if pe.sampler != nil {
+		pe.collectSamplingMetrics(ch)
+	}
+	if pe.rejecter != nil {
+		pe.collectRejectMetrics(ch)
+	}
+	if pe.server != nil && pe.server.multicast != nil {
+		pe.collectMulticastMetrics(ch)
+	}
+	if pe.server != nil {
+		pe.collectNAT64Metrics(ch)
+		pe.collectNatMetrics(ch)
+		pe.collectDNSFilterMetrics(ch)
+		pe.collectTLSFingerprintMetrics(ch)
+		pe.collectMitigationMetrics(ch)
+		pe.collectSourceLimitMetrics(ch)
+		pe.collectAutoBlockMetrics(ch)
+		pe.collectPortScanMetrics(ch)
+		pe.collectNormalizationMetrics(ch)
+		pe.collectInterfaceConfigMetrics(ch)
+		pe.collectShadowMetrics(ch)
+	}
+	if pe.ruleLogger != nil {
+		for key, count := range pe.ruleLogger.Counts() {
+			ch <- prometheus.MustNewConstMetric(ruleLogEventsDesc, prometheus.CounterValue, float64(count), key.RuleID, key.Verdict)
+		}
+	}
+	if pe.programStats != nil {
+		pe.collectProgramStatsMetrics(ch)
+	}
+	if pe.server != nil {
+		pe.collectAttachModeMetrics(ch)
+		pe.collectPipelineMetrics(ch)
+		pe.collectRuleHitMetrics(ch)
+		if pe.server.degradation != nil {
+			pe.collectDegradationMetrics(ch)
+		}
+		if pe.server.usage != nil {
+			pe.collectUsageMetrics(ch)
+		}
+		if pe.server.slo != nil {
+			pe.collectSLOMetrics(ch)
+		}
+		if pe.server.limits != nil {
+			pe.collectResourceMetrics(ch)
+		}
+		pe.collectGeoIPMetrics(ch)
+		pe.collectASNMetrics(ch)
+		pe.collectFeedMetrics(ch)
+		pe.collectUpdateMetrics(ch)
+	}
+	if pe.vppTelemetry != nil {
+		pe.collectVPPMetrics(ch)
+	}
+}
+
+// collectFirewallMetrics collects uptime, the rule count and the verdict
+// counters of the host data plane
+func (pe *PrometheusExporter) collectFirewallMetrics(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(uptimeDesc, prometheus.GaugeValue, time.Since(pe.startTime).Seconds())
+
+	mode := "simulated"
+	if pe.bpfManager != nil && !pe.bpfManager.simulated {
+		mode = "ebpf"
+	}
+	ch <- prometheus.MustNewConstMetric(buildInfoDesc, prometheus.GaugeValue, 1, Version, mode)
+
+	if pe.server != nil {
+		pe.server.mutex.RLock()
+		activeRules := len(pe.server.rules)
+		pe.server.mutex.RUnlock()
+		ch <- prometheus.MustNewConstMetric(activeRulesDesc, prometheus.GaugeValue, float64(activeRules))
+	}
+
+	if pe.bpfManager == nil {
+		return
+	}
+	stats, err := pe.bpfManager.GetStats()
+	if err != nil {
+		log.Printf("⚠️  Failed to read firewall stats: %v", err)
+		return
+	}
+	for action, packets := range map[string]uint64{
+		"pass": stats.Pass, "drop": stats.Drop, "redirect": stats.Redirect, "error": stats.Error,
+	} {
+		ch <- prometheus.MustNewConstMetric(packetsDesc, prometheus.CounterValue, float64(packets), action)
+	}
+	ch <- prometheus.MustNewConstMetric(bytesDesc, prometheus.CounterValue, float64(stats.Pass*64), "pass")
+	ch <- prometheus.MustNewConstMetric(bytesDesc, prometheus.CounterValue, float64(stats.Drop*64), "drop")
+	if since, resets := pe.bpfManager.counters.since(); !since.IsZero() {
+		ch <- prometheus.MustNewConstMetric(countersSinceDesc, prometheus.GaugeValue, float64(since.Unix()))
+		ch <- prometheus.MustNewConstMetric(counterResetsDesc, prometheus.CounterValue, float64(resets))
+	}
+}
+
+// collectConntrackMetrics collects flow table occupancy of the host data
+// plane
+func (pe *PrometheusExporter) collectConntrackMetrics(ch chan<- prometheus.Metric) {
+	occupancy, err := pe.bpfManager.ConntrackOccupancy()
+	if err != nil {
+		log.Printf("Failed to read flow table: %v", err)
+		return
+	}
+
+	for _, state := range []string{"new", "established", "closing"} {
+		ch <- prometheus.MustNewConstMetric(conntrackEntriesDesc, prometheus.GaugeValue, float64(occupancy[state]), state)
+	}
+	ch <- prometheus.MustNewConstMetric(conntrackCapacityDesc, prometheus.GaugeValue, float64(pe.bpfManager.ConntrackCapacity()))
+}
+
+// collectSamplingMetrics collects the adaptive sampling state
+func (pe *PrometheusExporter) collectSamplingMetrics(ch chan<- prometheus.Metric) {
+	status := pe.sampler.Status()
+
+	ch <- prometheus.MustNewConstMetric(sampleRateDesc, prometheus.GaugeValue, float64(status.Rate))
+	ch <- prometheus.MustNewConstMetric(sampleBudgetDesc, prometheus.GaugeValue, status.Budget)
+	ch <- prometheus.MustNewConstMetric(samplesDesc, prometheus.CounterValue, float64(status.Samples))
+}
+
+// collectGeoIPMetrics collects the age and size of the GeoIP database and
+// how its reloads went, when one is configured
+func (pe *PrometheusExporter) collectGeoIPMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	defer pe.server.mutex.RUnlock()
+
+	source, db := pe.server.geoIPSource, pe.server.geoIP
+	if source == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(geoIPRefreshesDesc, prometheus.CounterValue, float64(source.refreshes), "success")
+	ch <- prometheus.MustNewConstMetric(geoIPRefreshesDesc, prometheus.CounterValue, float64(source.failures), "failure")
+	if db == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(geoIPAgeDesc, prometheus.GaugeValue, time.Since(db.builtAt).Seconds())
+	ch <- prometheus.MustNewConstMetric(geoIPLoadedDesc, prometheus.GaugeValue, float64(db.loadedAt.Unix()))
+	var installed map[int]int
+	if pe.server.bpfManager != nil {
+		installed = pe.server.bpfManager.GeoIPPrefixes()
+	}
+	for _, family := range []int{familyIPv4, familyIPv6} {
+		ch <- prometheus.MustNewConstMetric(geoIPPrefixesDesc, prometheus.GaugeValue, float64(db.counts[family]), familyName(family))
+		if installed != nil {
+			ch <- prometheus.MustNewConstMetric(geoIPRulePrefixesDesc, prometheus.GaugeValue, float64(installed[family]), familyName(family))
+		}
+	}
+}
+
+// collectASNMetrics collects the age and size of the ASN database and how
+// its downloads and reloads went, when one is configured
+func (pe *PrometheusExporter) collectASNMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	defer pe.server.mutex.RUnlock()
+
+	source, db := pe.server.asnSource, pe.server.asnDB
+	if source == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(asnDownloadsDesc, prometheus.CounterValue, float64(source.downloads))
+	ch <- prometheus.MustNewConstMetric(asnRefreshesDesc, prometheus.CounterValue, float64(source.refreshes), "success")
+	ch <- prometheus.MustNewConstMetric(asnRefreshesDesc, prometheus.CounterValue, float64(source.failures), "failure")
+	if db == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(asnAgeDesc, prometheus.GaugeValue, time.Since(db.builtAt).Seconds())
+	ch <- prometheus.MustNewConstMetric(asnLoadedDesc, prometheus.GaugeValue, float64(db.loadedAt.Unix()))
+	var installed map[int]int
+	if pe.server.bpfManager != nil {
+		installed = pe.server.bpfManager.ASNPrefixes()
+	}
+	for _, family := range []int{familyIPv4, familyIPv6} {
+		ch <- prometheus.MustNewConstMetric(asnPrefixesDesc, prometheus.GaugeValue, float64(db.counts[family]), familyName(family))
+		if installed != nil {
+			ch <- prometheus.MustNewConstMetric(asnRulePrefixesDesc, prometheus.GaugeValue, float64(installed[family]), familyName(family))
+		}
+	}
+}
+
+// collectUpdateMetrics collects when the database downloads last
+// succeeded and how they went
+func (pe *PrometheusExporter) collectUpdateMetrics(ch chan<- prometheus.Metric) {
+	for _, update := range pe.server.databaseUpdates() {
+		status := update.status()
+		for _, result := range updateResults {
+			ch <- prometheus.MustNewConstMetric(updateResultsDesc, prometheus.CounterValue, float64(status.Results[result]), status.Name, result)
+		}
+		var since time.Time
+		if info, err := os.Stat(status.Path); err == nil {
+			since = info.ModTime()
+		}
+		if status.LastAttempt != 0 {
+			ch <- prometheus.MustNewConstMetric(updateLastAttemptDesc, prometheus.GaugeValue, float64(status.LastAttempt), status.Name)
+		}
+		if status.LastSuccess != 0 {
+			ch <- prometheus.MustNewConstMetric(updateLastSuccessDesc, prometheus.GaugeValue, float64(status.LastSuccess), status.Name)
+			since = time.Unix(status.LastSuccess, 0)
+		}
+		if !since.IsZero() {
+			ch <- prometheus.MustNewConstMetric(updateStalenessDesc, prometheus.GaugeValue, time.Since(since).Seconds(), status.Name)
+		}
+	}
+}
+
+// collectFeedMetrics collects the freshness and entry counts of the
+// threat intelligence feeds
+func (pe *PrometheusExporter) collectFeedMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	defer pe.server.mutex.RUnlock()
+
+	for _, feed := range pe.server.sortedFeeds() {
+		for _, family := range []int{familyIPv4, familyIPv6} {
+			ch <- prometheus.MustNewConstMetric(feedEntriesDesc, prometheus.GaugeValue, float64(len(feed.sets[family].Entries)), feed.Name, familyName(family))
+		}
+		ch <- prometheus.MustNewConstMetric(feedDownloadsDesc, prometheus.CounterValue, float64(feed.downloads), feed.Name, "success")
+		ch <- prometheus.MustNewConstMetric(feedDownloadsDesc, prometheus.CounterValue, float64(feed.failures), feed.Name, "failure")
+		ch <- prometheus.MustNewConstMetric(feedSkippedDesc, prometheus.GaugeValue, float64(feed.skipped), feed.Name)
+		if !feed.checkedAt.IsZero() {
+			ch <- prometheus.MustNewConstMetric(feedAgeDesc, prometheus.GaugeValue, pe.server.clock.Now().Sub(feed.checkedAt).Seconds(), feed.Name)
+			ch <- prometheus.MustNewConstMetric(feedCheckedDesc, prometheus.GaugeValue, float64(feed.checkedAt.Unix()), feed.Name)
+		}
+		if !feed.updatedAt.IsZero() {
+			ch <- prometheus.MustNewConstMetric(feedUpdatedDesc, prometheus.GaugeValue, float64(feed.updatedAt.Unix()), feed.Name)
+		}
+	}
+}
+
+// collectResourceMetrics collects the control plane's use of its
+// self-limited resources
+func (pe *PrometheusExporter) collectResourceMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	rules := len(pe.server.rules)
+	pe.server.mutex.RUnlock()
+	for _, usage := range pe.server.limits.Usage(rules) {
+		ch <- prometheus.MustNewConstMetric(resourceUsageDesc, prometheus.GaugeValue, float64(usage.Used), usage.Resource)
+		if usage.Limit > 0 {
+			ch <- prometheus.MustNewConstMetric(resourceLimitDesc, prometheus.GaugeValue, float64(usage.Limit), usage.Resource)
+		}
+		ch <- prometheus.MustNewConstMetric(resourceRefusalsDesc, prometheus.CounterValue, float64(usage.Refused), usage.Resource)
+	}
+}
+
+// collectRingBusMetrics collects the records read from the host data
+// plane's ring buffers and how far each consumer is behind
+func (pe *PrometheusExporter) collectRingBusMetrics(ch chan<- prometheus.Metric) {
+	if pe.bpfManager.rings == nil {
+		return
+	}
+	for ring, read := range pe.bpfManager.rings.RecordsRead() {
+		ch <- prometheus.MustNewConstMetric(ringRecordsDesc, prometheus.CounterValue, float64(read), ring)
+	}
+	for _, lag := range pe.bpfManager.rings.Lag() {
+		ch <- prometheus.MustNewConstMetric(ringConsumerDeliveredDesc, prometheus.CounterValue, float64(lag.Delivered), lag.Ring, lag.Consumer)
+		ch <- prometheus.MustNewConstMetric(ringConsumerDiscardedDesc, prometheus.CounterValue, float64(lag.Discarded), lag.Ring, lag.Consumer)
+		ch <- prometheus.MustNewConstMetric(ringConsumerLagDesc, prometheus.GaugeValue, float64(lag.Queued), lag.Ring, lag.Consumer)
+	}
+}
+
+// collectRejectMetrics collects the outcome of reject replies
+func (pe *PrometheusExporter) collectRejectMetrics(ch chan<- prometheus.Metric) {
+	status := pe.rejecter.Status()
+	for result, count := range map[string]uint64{
+		"sent": status.Sent, "rate_limited": status.RateLimited, "unanswered": status.Unanswered, "failed": status.Failed,
+	} {
+		ch <- prometheus.MustNewConstMetric(rejectRepliesDesc, prometheus.CounterValue, float64(count), result)
+	}
+}
+
+// collectMirrorMetrics collects the copies sent by mirror rules
+func (pe *PrometheusExporter) collectMirrorMetrics(ch chan<- prometheus.Metric) {
+	stats, err := pe.bpfManager.MirrorStats()
+	if err != nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(mirrorPacketsDesc, prometheus.CounterValue, float64(stats.Sent), "sent")
+	ch <- prometheus.MustNewConstMetric(mirrorPacketsDesc, prometheus.CounterValue, float64(stats.Failed), "failed")
+}
+
+// collectMulticastMetrics collects the counters and members of each
+// multicast group
+func (pe *PrometheusExporter) collectMulticastMetrics(ch chan<- prometheus.Metric) {
+	for _, group := range pe.server.multicast.Groups(pe.server.clock.Now()) {
+		ch <- prometheus.MustNewConstMetric(multicastPacketsDesc, prometheus.CounterValue, float64(group.Packets), group.Group)
+		ch <- prometheus.MustNewConstMetric(multicastBytesDesc, prometheus.CounterValue, float64(group.Bytes), group.Group)
+		ch <- prometheus.MustNewConstMetric(multicastDroppedDesc, prometheus.CounterValue, float64(group.Dropped), group.Group)
+		ch <- prometheus.MustNewConstMetric(multicastMembersDesc, prometheus.GaugeValue, float64(len(group.Members)), group.Group)
+	}
+}
+
+// collectNAT64Metrics collects the counters and open sessions of each
+// NAT64 prefix and the DNS64 proxy's answers
+func (pe *PrometheusExporter) collectNAT64Metrics(ch chan<- prometheus.Metric) {
+	if dns64 := pe.server.dns64; dns64 != nil {
+		status := dns64.Status()
+		for result, count := range map[string]uint64{
+			"forwarded": status.Forwarded, "synthesized": status.Synthesized, "failed": status.Failed,
+		} {
+			ch <- prometheus.MustNewConstMetric(dns64QueriesDesc, prometheus.CounterValue, float64(count), result)
+		}
+	}
+
+	for _, prefix := range pe.server.nat64PrefixStatus() {
+		ch <- prometheus.MustNewConstMetric(nat64PacketsDesc, prometheus.CounterValue, float64(prefix.PacketsOut), prefix.Prefix, "out")
+		ch <- prometheus.MustNewConstMetric(nat64PacketsDesc, prometheus.CounterValue, float64(prefix.PacketsIn), prefix.Prefix, "in")
+		ch <- prometheus.MustNewConstMetric(nat64SessionsCreatedDesc, prometheus.CounterValue, float64(prefix.SessionsCreated), prefix.Prefix)
+		ch <- prometheus.MustNewConstMetric(nat64FailedDesc, prometheus.CounterValue, float64(prefix.Failed), prefix.Prefix)
+		ch <- prometheus.MustNewConstMetric(nat64SessionsDesc, prometheus.GaugeValue, float64(prefix.Sessions), prefix.Prefix)
+	}
+}
+
+// collectNatMetrics collects the counters of each NAT rule, the port and
+// session use of source NAT rules and that of the session table
+func (pe *PrometheusExporter) collectNatMetrics(ch chan<- prometheus.Metric) {
+	status := pe.server.natRuleStatus()
+	sourceNAT := false
+	for _, rule := range status.Rules {
+		id := strconv.FormatUint(uint64(rule.Id), 10)
+		direction := "in"
+		if rule.Type != NatTypeDNAT {
+			direction, sourceNAT = "out", true
+		}
+		ch <- prometheus.MustNewConstMetric(natPacketsDesc, prometheus.CounterValue, float64(rule.Translated), id, rule.Type, direction)
+		ch <- prometheus.MustNewConstMetric(natPacketsDesc, prometheus.CounterValue, float64(rule.Replies), id, rule.Type, "reply")
+		ch <- prometheus.MustNewConstMetric(natFailedDesc, prometheus.CounterValue, float64(rule.Failed), id, rule.Type)
+		if rule.Type == NatTypeDNAT {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(natSessionsDesc, prometheus.GaugeValue, float64(rule.Sessions), id, rule.Type)
+		ch <- prometheus.MustNewConstMetric(natSessionsCreatedDesc, prometheus.CounterValue, float64(rule.SessionsCreated), id, rule.Type)
+		ch <- prometheus.MustNewConstMetric(natPortsInUseDesc, prometheus.GaugeValue, float64(rule.PortsInUse), id, rule.Type)
+		ch <- prometheus.MustNewConstMetric(natPortsDesc, prometheus.GaugeValue, float64(rule.PortMax-rule.PortMin+1), id, rule.Type)
+	}
+	if sourceNAT || status.Sessions > 0 {
+		ch <- prometheus.MustNewConstMetric(natSessionTableDesc, prometheus.GaugeValue, float64(status.Sessions))
+		ch <- prometheus.MustNewConstMetric(natSessionTableCapacityDesc, prometheus.GaugeValue, float64(status.SessionCapacity))
+	}
+}
+
+// collectDNSFilterMetrics collects the DNS filter counters and the size
+// and matches of each domain list
+func (pe *PrometheusExporter) collectDNSFilterMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.dnsFilterStatus()
+	if !resp.Enabled {
+		return
+	}
+	for result, count := range map[string]uint64{
+		"checked": resp.Queries, "allowed": resp.Allowed, "blocked": resp.Blocked,
+		"malformed": resp.Malformed, "lost": resp.Lost,
+	} {
+		ch <- prometheus.MustNewConstMetric(dnsQueriesDesc, prometheus.CounterValue, float64(count), result)
+	}
+	for _, list := range resp.Lists {
+		ch <- prometheus.MustNewConstMetric(dnsListMatchesDesc, prometheus.CounterValue, float64(list.Matches), list.Name, list.Action)
+		ch <- prometheus.MustNewConstMetric(dnsListDomainsDesc, prometheus.GaugeValue, float64(len(list.Domains)), list.Name, list.Action)
+	}
+}
+
+// collectTLSFingerprintMetrics collects the TLS fingerprinting counters
+// and the matches of each fingerprint rule
+func (pe *PrometheusExporter) collectTLSFingerprintMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.tlsFingerprintStatus()
+	if !resp.Enabled {
+		return
+	}
+	for result, count := range map[string]uint64{
+		"reported": resp.Hellos, "fingerprinted": resp.Fingerprinted, "truncated": resp.Truncated, "lost": resp.Lost,
+	} {
+		ch <- prometheus.MustNewConstMetric(tlsHellosDesc, prometheus.CounterValue, float64(count), result)
+	}
+	ch <- prometheus.MustNewConstMetric(tlsBlockedFlowsDesc, prometheus.CounterValue, float64(resp.BlockedFlows))
+	ch <- prometheus.MustNewConstMetric(tlsDroppedPacketsDesc, prometheus.CounterValue, float64(resp.DroppedPackets))
+	for _, rule := range resp.Rules {
+		ch <- prometheus.MustNewConstMetric(tlsRuleMatchesDesc, prometheus.CounterValue, float64(rule.Matches), rule.Name, rule.Action)
+	}
+}
+
+// collectMitigationMetrics collects the SYN flood counters and the
+// destinations mitigated now
+func (pe *PrometheusExporter) collectMitigationMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.mitigationStatus()
+	if resp.Config.Mode == MitigationOff && resp.Syns == 0 {
+		return
+	}
+	for result, count := range map[string]uint64{
+		"syn": resp.Syns, "dropped": resp.Dropped, "cookie": resp.Cookies, "verified": resp.Verified, "invalid": resp.InvalidCookies,
+	} {
+		ch <- prometheus.MustNewConstMetric(synFloodPacketsDesc, prometheus.CounterValue, float64(count), result)
+	}
+	ch <- prometheus.MustNewConstMetric(synFloodMitigatedDesc, prometheus.GaugeValue, float64(len(resp.Destinations)))
+}
+
+// collectSourceLimitMetrics collects the source limit counters and the
+// exemptions in effect
+func (pe *PrometheusExporter) collectSourceLimitMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.sourceLimitStatus()
+	if !sourceLimitConfigFromProto(resp.Config).enabled() && resp.Packets == 0 && len(resp.Exemptions) == 0 {
+		return
+	}
+	for result, count := range map[string]uint64{
+		"counted": resp.Packets, "dropped": resp.DroppedPackets, "dropped_connection": resp.DroppedConnections, "exempt": resp.ExemptPackets,
+	} {
+		ch <- prometheus.MustNewConstMetric(sourceLimitPacketsDesc, prometheus.CounterValue, float64(count), result)
+	}
+	ch <- prometheus.MustNewConstMetric(sourceLimitExemptionsDesc, prometheus.GaugeValue, float64(len(resp.Exemptions)))
+}
+
+// collectAutoBlockMetrics collects the automatic blocks by reason and
+// those in effect
+func (pe *PrometheusExporter) collectAutoBlockMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.autoBlockStatus()
+	if !resp.Config.Enabled && resp.BlockedTotal == 0 && len(resp.Blocks) == 0 {
+		return
+	}
+	_, blocks := pe.server.autoBlocker.Stats()
+	for _, reason := range []string{AutoBlockReasonDrops, AutoBlockReasonAuthFailures, AutoBlockReasonPortScan} {
+		ch <- prometheus.MustNewConstMetric(autoBlocksDesc, prometheus.CounterValue, float64(blocks[reason]), reason)
+	}
+	ch <- prometheus.MustNewConstMetric(autoBlocksActiveDesc, prometheus.GaugeValue, float64(len(resp.Blocks)))
+}
+
+// collectPortScanMetrics collects the port scan counters and the sources
+// scanning
+func (pe *PrometheusExporter) collectPortScanMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.portScanStatus()
+	if !resp.Config.Enabled && resp.Detections == 0 && resp.Attempts == 0 {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(portScanDetectionsDesc, prometheus.CounterValue, float64(resp.Detections))
+	ch <- prometheus.MustNewConstMetric(portScanAttemptsDesc, prometheus.CounterValue, float64(resp.Attempts))
+	ch <- prometheus.MustNewConstMetric(portScanScannersDesc, prometheus.GaugeValue, float64(len(resp.Scanners)))
+}
+
+// collectNormalizationMetrics collects the packets dropped by packet
+// normalization by reason
+func (pe *PrometheusExporter) collectNormalizationMetrics(ch chan<- prometheus.Metric) {
+	resp := pe.server.normalizationStatus()
+	drops := map[string]uint64{
+		"bogon":       resp.Bogons,
+		"ip_checksum": resp.IpChecksums,
+		"l4_checksum": resp.L4Checksums,
+		"tcp_null":    resp.TcpNull,
+		"tcp_xmas":    resp.TcpXmas,
+		"tcp_flags":   resp.TcpFlags,
+	}
+	var total uint64
+	for _, dropped := range drops {
+		total += dropped
+	}
+	config := resp.Config
+	if !config.DropBogons && !config.DropInvalidChecksums && !config.DropInvalidTcpFlags && total == 0 {
+		return
+	}
+	for _, reason := range normReasons[1:] {
+		ch <- prometheus.MustNewConstMetric(normalizationDropsDesc, prometheus.CounterValue, float64(drops[reason]), reason)
+	}
+	ch <- prometheus.MustNewConstMetric(normalizationEventsDesc, prometheus.CounterValue, float64(resp.Events))
+}
+
+// collectInterfaceConfigMetrics collects the MSS clamping counters of the
+// interfaces clamping and the policer counters of those policing
+func (pe *PrometheusExporter) collectInterfaceConfigMetrics(ch chan<- prometheus.Metric) {
+	for _, config := range pe.server.interfaceConfigStatus() {
+		if config.Policer != nil {
+			ch <- prometheus.MustNewConstMetric(policerDropsDesc, prometheus.CounterValue, float64(config.PolicedPackets), config.Interface)
+			ch <- prometheus.MustNewConstMetric(policerDropBytesDesc, prometheus.CounterValue, float64(config.PolicedBytes), config.Interface)
+		}
+		if config.MssClamp == nil {
+			continue
+		}
+		var unchanged uint64
+		if config.MssSyns > config.MssClamped+config.MssMissing {
+			unchanged = config.MssSyns - config.MssClamped - config.MssMissing
+		}
+		for result, count := range map[string]uint64{
+			"clamped": config.MssClamped, "unchanged": unchanged, "no_option": config.MssMissing,
+		} {
+			ch <- prometheus.MustNewConstMetric(mssClampSYNsDesc, prometheus.CounterValue, float64(count), config.Interface, result)
+		}
+	}
+}
+
+// collectShadowMetrics collects the verdict comparison of the running
+// shadow policy, if any
+func (pe *PrometheusExporter) collectShadowMetrics(ch chan<- prometheus.Metric) {
+	shadow := pe.server.shadow.Load()
+	if shadow == nil {
+		return
+	}
+	report := shadow.report()
+	ch <- prometheus.MustNewConstMetric(shadowPacketsDesc, prometheus.CounterValue, float64(report.AgreedPackets), "agreed")
+	ch <- prometheus.MustNewConstMetric(shadowPacketsDesc, prometheus.CounterValue, float64(report.DisagreedPackets), "disagreed")
+	ch <- prometheus.MustNewConstMetric(shadowUncomparedDesc, prometheus.CounterValue, float64(report.UncomparedSamples))
+}
+
+// collectProgramStatsMetrics collects the run time and runs of each data
+// plane program and the CPU share they took over the last interval
+func (pe *PrometheusExporter) collectProgramStatsMetrics(ch chan<- prometheus.Metric) {
+	status := pe.programStats.Status()
+	enabled := 0.0
+	if status.Enabled {
+		enabled = 1
+	}
+
+	ch <- prometheus.MustNewConstMetric(ebpfStatsEnabledDesc, prometheus.GaugeValue, enabled)
+	ch <- prometheus.MustNewConstMetric(ebpfCPUUsageDesc, prometheus.GaugeValue, status.CPUUsage)
+	for _, program := range status.Programs {
+		id := strconv.FormatUint(uint64(program.ID), 10)
+		ch <- prometheus.MustNewConstMetric(programRunTimeDesc, prometheus.CounterValue, float64(program.RunTimeNs), program.Name, id)
+		ch <- prometheus.MustNewConstMetric(programRunsDesc, prometheus.CounterValue, float64(program.RunCount), program.Name, id)
+		ch <- prometheus.MustNewConstMetric(programNsPerPacketDesc, prometheus.GaugeValue, program.NsPerRun, program.Name, id)
+	}
+}
+
+// collectDegradationMetrics collects the degradation stage and the pressure
+// readings it was chosen on
+func (pe *PrometheusExporter) collectDegradationMetrics(ch chan<- prometheus.Metric) {
+	status := pe.server.degradation.Status()
+
+	ch <- prometheus.MustNewConstMetric(degradationLevelDesc, prometheus.GaugeValue, float64(status.Level), status.Stage)
+	ch <- prometheus.MustNewConstMetric(degradationCPUDesc, prometheus.GaugeValue, status.CPU)
+	ch <- prometheus.MustNewConstMetric(degradationMapUsageDesc, prometheus.GaugeValue, status.MapUsage)
+}
+
+// exportedScopes returns the host data plane, then every namespace and VF
+// policy, with s.mutex held
+func (pe *PrometheusExporter) exportedScopes() []string {
+	scopes := []string{""}
+	scopes = append(scopes, pe.server.sortedNamespaceNames()...)
+	return append(scopes, pe.server.sortedVFPolicyKeys()...)
+}
+
+// collectAttachModeMetrics collects the mode each interface of each data
+// plane was attached in
+func (pe *PrometheusExporter) collectAttachModeMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	defer pe.server.mutex.RUnlock()
+
+	for _, scope := range pe.exportedScopes() {
+		manager := pe.server.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		for _, iface := range manager.attachedInterfaces() {
+			ch <- prometheus.MustNewConstMetric(attachModeDesc, prometheus.GaugeValue, 1,
+				scope, iface, manager.attachModes[iface], manager.xdpMode)
+		}
+	}
+}
+
+// collectPipelineMetrics collects the packets entering each pipeline stage,
+// where and why each data plane's packets got their verdict, the traffic
+// of each protocol, and how many the bloom filter pre-check decided
+func (pe *PrometheusExporter) collectPipelineMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	defer pe.server.mutex.RUnlock()
+
+	for _, scope := range pe.exportedScopes() {
+		manager := pe.server.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		counters, err := manager.PipelineCounters()
+		if err != nil {
+			log.Printf("⚠️  Failed to read pipeline counters of scope %q: %v", scope, err)
+			continue
+		}
+		for index, stage := range pipelineStages {
+			ch <- prometheus.MustNewConstMetric(stagePacketsDesc, prometheus.CounterValue, float64(counters.Stages[index]), scope, stage)
+		}
+		ch <- prometheus.MustNewConstMetric(inlinePacketsDesc, prometheus.CounterValue, float64(counters.Inline), scope)
+		for _, count := range counters.Dispositions {
+			ch <- prometheus.MustNewConstMetric(dispositionsDesc, prometheus.CounterValue, float64(count.Packets),
+				scope, count.Disposition, count.Verdict)
+		}
+
+		protocols, err := manager.ProtocolCounts()
+		if err != nil {
+			log.Printf("⚠️  Failed to read protocol counters of scope %q: %v", scope, err)
+			continue
+		}
+		for _, count := range protocols {
+			ch <- prometheus.MustNewConstMetric(protocolPacketsDesc, prometheus.CounterValue, float64(count.Packets), scope, count.Protocol)
+			ch <- prometheus.MustNewConstMetric(protocolBytesDesc, prometheus.CounterValue, float64(count.Bytes), scope, count.Protocol)
+			ch <- prometheus.MustNewConstMetric(protocolDroppedDesc, prometheus.CounterValue, float64(count.Dropped), scope, count.Protocol)
+		}
+
+		bloom, err := manager.BloomStatus()
+		if err != nil {
+			log.Printf("⚠️  Failed to read bloom filter counters of scope %q: %v", scope, err)
+			continue
+		}
+		if bloom.Enabled {
+			ch <- prometheus.MustNewConstMetric(bloomCheckedDesc, prometheus.CounterValue, float64(bloom.Checked), scope)
+			ch <- prometheus.MustNewConstMetric(bloomNegativeDesc, prometheus.CounterValue, float64(bloom.Negative), scope)
+			ch <- prometheus.MustNewConstMetric(bloomElementsDesc, prometheus.GaugeValue, float64(bloom.Elements), scope)
+		}
+	}
+}
+
+// collectRuleHitMetrics collects the data plane hit counters of every rule
+func (pe *PrometheusExporter) collectRuleHitMetrics(ch chan<- prometheus.Metric) {
+	pe.server.mutex.RLock()
+	hits := pe.server.ruleHits()
+	ids := make([]string, 0, len(pe.server.rules))
+	for id := range pe.server.rules {
+		ids = append(ids, id)
+	}
+	pe.server.mutex.RUnlock()
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		var packets, bytes uint64
+		if ruleHits, exists := hits[id]; exists {
+			packets, bytes = ruleHits.Packets, ruleHits.Bytes
+		}
+		ch <- prometheus.MustNewConstMetric(ruleHitsDesc, prometheus.CounterValue, float64(packets), id)
+		ch <- prometheus.MustNewConstMetric(ruleHitBytesDesc, prometheus.CounterValue, float64(bytes), id)
+	}
+}
+
+// collectUsageMetrics collects the accounted usage of every tenant
+func (pe *PrometheusExporter) collectUsageMetrics(ch chan<- prometheus.Metric) {
+	for _, record := range pe.server.UsageRecords() {
+		ch <- prometheus.MustNewConstMetric(tenantAPICallsDesc, prometheus.CounterValue, float64(record.APICalls), record.Tenant)
+		ch <- prometheus.MustNewConstMetric(tenantRulesDesc, prometheus.GaugeValue, float64(record.Rules), record.Tenant)
+		ch <- prometheus.MustNewConstMetric(tenantBytesDesc, prometheus.CounterValue, float64(record.Bytes), record.Tenant)
+		ch <- prometheus.MustNewConstMetric(tenantPacketsDesc, prometheus.CounterValue, float64(record.Packets), record.Tenant)
+	}
+}
+
+// collectSLOMetrics collects the enforcement path SLIs and event delivery
+func (pe *PrometheusExporter) collectSLOMetrics(ch chan<- prometheus.Metric) {
+	status := pe.server.slo.Status()
+
+	ch <- prometheus.MustNewConstMetric(applyLatencyP99Desc, prometheus.GaugeValue, status.ApplyLatencyP99.Seconds())
+	for _, sli := range status.SLIs {
+		ch <- prometheus.MustNewConstMetric(sloTargetDesc, prometheus.GaugeValue, sli.Target, sli.Name)
+		ch <- prometheus.MustNewConstMetric(sloBudgetDesc, prometheus.GaugeValue, sli.ErrorBudgetRemaining, sli.Name)
+		for _, window := range sloWindows {
+			ch <- prometheus.MustNewConstMetric(sloSLIDesc, prometheus.GaugeValue, sli.SLI[window.name], sli.Name, window.name)
+			ch <- prometheus.MustNewConstMetric(sloBurnRateDesc, prometheus.GaugeValue, sli.BurnRate[window.name], sli.Name, window.name)
+		}
+		for _, alert := range sloAlerts {
+			firing := 0.0
+			if sli.Alert == alert.name {
+				firing = 1
+			}
+			ch <- prometheus.MustNewConstMetric(sloAlertDesc, prometheus.GaugeValue, firing, sli.Name, alert.name)
+		}
+	}
+
+	delivered, discarded := pe.server.events.Deliveries()
+	ch <- prometheus.MustNewConstMetric(eventsDeliveredDesc, prometheus.CounterValue, float64(delivered))
+	ch <- prometheus.MustNewConstMetric(eventsDiscardedDesc, prometheus.CounterValue, float64(discarded))
+}
+
+// collectVPPMetrics collects VPP saturation metrics;
+// cerberus_vpp_telemetry_up is 0 while the stats segment cannot be read
+func (pe *PrometheusExporter) collectVPPMetrics(ch chan<- prometheus.Metric) {
+	telemetry, err := pe.vppTelemetry.Collect()
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(vppUpDesc, prometheus.GaugeValue, 0)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(vppUpDesc, prometheus.GaugeValue, 1)
+
+	ch <- prometheus.MustNewConstMetric(vppVectorRateDesc, prometheus.GaugeValue, telemetry.VectorRate)
+	for thread, rate := range telemetry.WorkerVectorRate {
+		ch <- prometheus.MustNewConstMetric(vppWorkerVectorRateDesc, prometheus.GaugeValue, float64(rate), strconv.Itoa(thread))
+	}
+	for iface, packets := range telemetry.RxNoBuf {
+		ch <- prometheus.MustNewConstMetric(vppRxNoBufDesc, prometheus.CounterValue, float64(packets), iface)
+	}
+	for iface, packets := range telemetry.RxMiss {
+		ch <- prometheus.MustNewConstMetric(vppRxMissDesc, prometheus.CounterValue, float64(packets), iface)
+	}
+}
@@ -0,0 +1,701 @@
+// SPDX-License-Identifier: Apache-2.0
+// Service protection profiles: connection limits, SYN rates, flow timeouts
+// and source countries bundled into one object attached to destinations
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned protection maps (must match eBPF program)
+	ProtectionMapName      = "cerberus_protect"
+	ProtectionStateMapName = "cerberus_protect_state"
+
+	// Protected destinations (must match MAX_PROTECTED in eBPF program)
+	MaxProtectedDestinations = 4096
+
+	// Largest SYN rate and burst of a profile
+	MaxProtectionSYNRate = 10000000
+
+	// How often the flows of protected destinations are recounted and idle
+	// and slow ones removed
+	protectionSweepInterval = 5 * time.Second
+)
+
+// ProtectionProfile bundles the protections of a service. Inbound packets
+// starting a new flow to a destination the profile is attached to, and
+// that no rule drops, are dropped when the source is outside the allowed
+// countries, when they are SYNs above the rate or when the destination has
+// max connections flows. Flows idle for the idle timeout, or older than the
+// slow timeout and moving fewer bytes per second in both directions than
+// the minimum, are removed; once a profile removes flows, TCP packets of no
+// tracked flow are dropped so that removed connections stay cut. Zero
+// limits and timeouts are off.
+type ProtectionProfile struct {
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	MaxConnections     uint32   `json:"max_connections"`
+	SYNRate            uint32   `json:"syn_rate"`
+	SYNBurst           uint32   `json:"syn_burst"` // 0 = SYNRate
+	IdleTimeoutSeconds uint32   `json:"idle_timeout_seconds"`
+	SlowTimeoutSeconds uint32   `json:"slow_timeout_seconds"`
+	MinBytesPerSecond  uint32   `json:"min_bytes_per_second"`
+	AllowedCountries   []string `json:"allowed_countries"` // Upper case ISO 3166 codes, empty = any
+
+	// IP sets holding the allowed countries' prefixes by family, built from
+	// the GeoIP database; rebuilt on restore rather than stored
+	countrySets map[int]uint32
+}
+
+// removesFlows reports whether the sweep removes flows of the profile's
+// destinations
+func (profile *ProtectionProfile) removesFlows() bool {
+	return profile.IdleTimeoutSeconds > 0 || profile.SlowTimeoutSeconds > 0
+}
+
+// ProtectedDestination is a profile attached to an address and port, or
+// to every port of an address
+type ProtectedDestination struct {
+	Destination string `json:"destination"` // As formatted by parseProtectedDestination
+	Profile     string `json:"profile"`
+
+	key         protectionKey
+	connections uint32 // Tracked flows at the latest sweep
+	removedIdle uint64
+	removedSlow uint64
+}
+
+// protectionKey mirrors struct protect_key in the eBPF program
+type protectionKey struct {
+	Addr   [16]byte // IPv4 uses the first 4 bytes
+	Port   uint16   // 0 = every port
+	Family uint8
+	Pad    uint8
+}
+
+// protectionConfig mirrors struct protect_config in the eBPF program
+type protectionConfig struct {
+	MaxConns   uint32
+	Conns      uint32
+	SYNRate    uint32
+	SYNBurst   uint32
+	CountrySet uint32
+	DropStray  uint8
+	Pad        [3]uint8
+}
+
+// protectionState mirrors struct protect_state in the eBPF program
+type protectionState struct {
+	SYNTokens    uint64
+	SYNRefill    uint64
+	CountryDrops uint64
+	SYNDrops     uint64
+	ConnDrops    uint64
+	StrayDrops   uint64
+}
+
+// SetProtectionProfile creates or replaces a profile and re-pushes every
+// destination it is attached to
+func (s *Server) SetProtectionProfile(ctx context.Context, req *pb.SetProtectionProfileRequest) (*pb.ProtectionProfileResponse, error) {
+	if req.GetProfile() == nil {
+		return &pb.ProtectionProfileResponse{Success: false, Message: "Protection profile is required"}, nil
+	}
+
+	profile := protectionProfileFromProto(req.Profile)
+	if err := s.validateProtectionProfile(profile); err != nil {
+		return &pb.ProtectionProfileResponse{
+			Success: false,
+			Message: fmt.Sprintf("Protection profile validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.buildCountrySets(profile); err != nil {
+		return &pb.ProtectionProfileResponse{Success: false, Message: fmt.Sprintf("Failed to build country sets: %v", err)}, nil
+	}
+	previous := s.protectionProfiles[profile.Name]
+	s.protectionProfiles[profile.Name] = profile
+
+	users := s.destinationsUsingProfile(profile.Name)
+	for _, dest := range users {
+		if err := s.pushProtection(dest); err != nil {
+			if previous != nil {
+				s.protectionProfiles[profile.Name] = previous
+				for _, dest := range users {
+					if err := s.pushProtection(dest); err != nil {
+						log.Printf("⚠️  Failed to restore protection of %s: %v", dest.Destination, err)
+					}
+				}
+			} else {
+				delete(s.protectionProfiles, profile.Name)
+			}
+			s.dropCountrySets(profile)
+			return &pb.ProtectionProfileResponse{
+				Success: false,
+				Message: fmt.Sprintf("Failed to push protection of %s to data plane: %v", dest.Destination, withRemediation(err)),
+			}, nil
+		}
+	}
+	if previous != nil {
+		s.dropCountrySets(previous)
+	}
+
+	s.persistPolicy()
+	log.Printf("Set protection profile: %s (%d destinations updated)", profile.Name, len(users))
+
+	resp := &pb.ProtectionProfileResponse{
+		Success: true,
+		Message: fmt.Sprintf("Protection profile saved successfully, %d destinations updated", len(users)),
+		Profile: protectionProfileToProto(profile),
+	}
+	for _, dest := range users {
+		resp.Destinations = append(resp.Destinations, dest.Destination)
+	}
+	return resp, nil
+}
+
+// DeleteProtectionProfile removes a profile that is not attached anywhere
+func (s *Server) DeleteProtectionProfile(ctx context.Context, req *pb.DeleteProtectionProfileRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	profile, exists := s.protectionProfiles[req.Name]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Protection profile not found"}, nil
+	}
+	if users := s.destinationsUsingProfile(req.Name); len(users) > 0 {
+		destinations := make([]string, len(users))
+		for i, dest := range users {
+			destinations[i] = dest.Destination
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Protection profile is attached to: %s", strings.Join(destinations, ", ")),
+		}, nil
+	}
+
+	s.dropCountrySets(profile)
+	delete(s.protectionProfiles, req.Name)
+	s.persistPolicy()
+	log.Printf("Deleted protection profile: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Protection profile deleted successfully"}, nil
+}
+
+// ListProtectionProfiles returns every profile and every protected
+// destination with its drop counters
+func (s *Server) ListProtectionProfiles(ctx context.Context, req *pb.Empty) (*pb.ProtectionProfilesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	names := make([]string, 0, len(s.protectionProfiles))
+	for name := range s.protectionProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &pb.ProtectionProfilesResponse{}
+	for _, name := range names {
+		resp.Profiles = append(resp.Profiles, protectionProfileToProto(s.protectionProfiles[name]))
+	}
+	for _, dest := range s.sortedProtectedDestinations() {
+		attachment := &pb.ProtectionAttachment{
+			Destination: dest.Destination,
+			Profile:     dest.Profile,
+			Connections: dest.connections,
+			RemovedIdle: dest.removedIdle,
+			RemovedSlow: dest.removedSlow,
+		}
+		if s.bpfManager != nil {
+			state, err := s.bpfManager.ProtectionState(dest.key)
+			if err != nil {
+				log.Printf("⚠️  Failed to read protection counters of %s: %v", dest.Destination, err)
+			}
+			attachment.CountryDrops = state.CountryDrops
+			attachment.SynDrops = state.SYNDrops
+			attachment.ConnectionDrops = state.ConnDrops
+			attachment.StrayDrops = state.StrayDrops
+		}
+		resp.Attachments = append(resp.Attachments, attachment)
+	}
+	return resp, nil
+}
+
+// AttachProtectionProfile protects a destination with a profile, replacing
+// the profile protecting it before
+func (s *Server) AttachProtectionProfile(ctx context.Context, req *pb.AttachProtectionProfileRequest) (*pb.StatusResponse, error) {
+	destination, key, err := parseProtectedDestination(req.Destination)
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Invalid destination: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.protectionProfiles[req.Profile]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Protection profile not found"}, nil
+	}
+	dest := &ProtectedDestination{Destination: destination, Profile: req.Profile, key: key}
+	previous := s.protected[destination]
+	if previous != nil {
+		dest.connections, dest.removedIdle, dest.removedSlow = previous.connections, previous.removedIdle, previous.removedSlow
+	} else if len(s.protected) >= MaxProtectedDestinations {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Protected destination limit reached (%d destinations)", MaxProtectedDestinations),
+		}, nil
+	}
+
+	if err := s.pushProtection(dest); err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to push protection to data plane: %v", withRemediation(err))}, nil
+	}
+	s.protected[destination] = dest
+	s.persistPolicy()
+	log.Printf("Attached protection profile %s to %s", req.Profile, destination)
+
+	return &pb.StatusResponse{Success: true, Message: "Protection profile attached successfully"}, nil
+}
+
+// DetachProtectionProfile removes the protection of a destination and its
+// counters
+func (s *Server) DetachProtectionProfile(ctx context.Context, req *pb.DetachProtectionProfileRequest) (*pb.StatusResponse, error) {
+	destination, key, err := parseProtectedDestination(req.Destination)
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Invalid destination: %v", err)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dest, exists := s.protected[destination]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Protected destination not found"}, nil
+	}
+	if s.bpfManager != nil {
+		if err := s.bpfManager.DeleteProtection(key); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove protection from data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	delete(s.protected, destination)
+	s.persistPolicy()
+	log.Printf("Detached protection profile %s from %s", dest.Profile, destination)
+
+	return &pb.StatusResponse{Success: true, Message: "Protection profile detached successfully"}, nil
+}
+
+// validateProtectionProfile checks a profile and normalizes its country
+// codes
+func (s *Server) validateProtectionProfile(profile *ProtectionProfile) error {
+	var errs ruleValidationError
+	if profile.Name == "" {
+		errs.add("name", "name is required")
+	}
+	if profile.SYNRate > MaxProtectionSYNRate || profile.SYNBurst > MaxProtectionSYNRate {
+		errs.add("syn_rate", "syn_rate and syn_burst must be at most %d", MaxProtectionSYNRate)
+	}
+	if profile.SYNBurst > 0 && profile.SYNRate == 0 {
+		errs.add("syn_burst", "syn_burst needs a syn_rate")
+	}
+	if (profile.SlowTimeoutSeconds > 0) != (profile.MinBytesPerSecond > 0) {
+		errs.add("slow_timeout_seconds", "slow_timeout_seconds and min_bytes_per_second are set together")
+	}
+
+	countries := make([]string, 0, len(profile.AllowedCountries))
+	seen := make(map[string]bool)
+	for _, country := range profile.AllowedCountries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		switch {
+		case !validCountryCode(country):
+			errs.add("allowed_countries", "invalid country code %q", country)
+		case s.geoIP == nil:
+			errs.add("allowed_countries", "allowed_countries needs a GeoIP database (CERBERUS_GEOIP_DB)")
+			return errs
+		case !s.geoIP.has(country):
+			errs.add("allowed_countries", "no prefixes of country %s in %s", country, s.geoIP.path)
+		case !seen[country]:
+			seen[country] = true
+			countries = append(countries, country)
+		}
+	}
+	sort.Strings(countries)
+	profile.AllowedCountries = countries
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// buildCountrySets creates the IP sets of a profile's allowed countries,
+// one per family. Caller must hold s.mutex.
+func (s *Server) buildCountrySets(profile *ProtectionProfile) error {
+	profile.countrySets = make(map[int]uint32)
+	if len(profile.AllowedCountries) == 0 {
+		return nil
+	}
+	if s.geoIP == nil {
+		return fmt.Errorf("no GeoIP database to resolve %s", strings.Join(profile.AllowedCountries, ", "))
+	}
+
+	for _, family := range []int{familyIPv4, familyIPv6} {
+		prefixes := s.geoIP.prefixes(profile.AllowedCountries, family)
+		set := &IPSet{
+			Name:        fmt.Sprintf("protect:%s:%s", profile.Name, familyName(family)),
+			Type:        IPSetTypeLPM,
+			Family:      familyName(family),
+			MaxEntries:  len(prefixes),
+			Description: fmt.Sprintf("Allowed countries of protection profile %s: %s", profile.Name, strings.Join(profile.AllowedCountries, ", ")),
+			Entries:     make(map[netip.Prefix]bool, len(prefixes)),
+			Profile:     profile.Name,
+		}
+		if set.MaxEntries == 0 {
+			set.MaxEntries = 1
+		}
+		for _, prefix := range prefixes {
+			set.Entries[prefix] = true
+		}
+		if set.ID = s.freeIPSetID(); set.ID == 0 {
+			s.dropCountrySets(profile)
+			return fmt.Errorf("IP set limit reached (%d sets)", MaxIPSets-1)
+		}
+		if s.bpfManager != nil {
+			if err := s.bpfManager.CreateIPSet(set); err != nil {
+				s.dropCountrySets(profile)
+				return err
+			}
+		}
+		s.ipSets[set.ID] = set
+		profile.countrySets[family] = set.ID
+	}
+	return nil
+}
+
+// dropCountrySets deletes the IP sets of a profile's allowed countries.
+// Caller must hold s.mutex.
+func (s *Server) dropCountrySets(profile *ProtectionProfile) {
+	for family, id := range profile.countrySets {
+		if s.bpfManager != nil {
+			if err := s.bpfManager.DeleteIPSet(id); err != nil {
+				log.Printf("⚠️  Failed to delete country set %d of protection profile %s: %v", id, profile.Name, err)
+			}
+		}
+		delete(s.ipSets, id)
+		delete(profile.countrySets, family)
+	}
+}
+
+// pushProtection writes a destination's profile to the host data plane.
+// Caller must hold s.mutex.
+func (s *Server) pushProtection(dest *ProtectedDestination) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	profile := s.protectionProfiles[dest.Profile]
+	config := protectionConfig{
+		MaxConns:   profile.MaxConnections,
+		Conns:      dest.connections,
+		SYNRate:    profile.SYNRate,
+		SYNBurst:   profile.SYNBurst,
+		CountrySet: profile.countrySets[int(dest.key.Family)],
+	}
+	if config.SYNBurst == 0 {
+		config.SYNBurst = config.SYNRate
+	}
+	if profile.removesFlows() {
+		config.DropStray = 1
+	}
+	return s.bpfManager.SetProtection(dest.key, config)
+}
+
+// destinationsUsingProfile lists the destinations a profile is attached
+// to, ordered by destination. Caller must hold s.mutex.
+func (s *Server) destinationsUsingProfile(name string) []*ProtectedDestination {
+	var users []*ProtectedDestination
+	for _, dest := range s.sortedProtectedDestinations() {
+		if dest.Profile == name {
+			users = append(users, dest)
+		}
+	}
+	return users
+}
+
+// sortedProtectedDestinations returns the protected destinations ordered
+// by destination. Caller must hold s.mutex.
+func (s *Server) sortedProtectedDestinations() []*ProtectedDestination {
+	dests := make([]*ProtectedDestination, 0, len(s.protected))
+	for _, dest := range s.protected {
+		dests = append(dests, dest)
+	}
+	sort.Slice(dests, func(i, j int) bool { return dests[i].Destination < dests[j].Destination })
+	return dests
+}
+
+// enforceProtection recounts the flows of protected destinations and
+// removes idle and slow ones every interval until ctx is done
+func (s *Server) enforceProtection(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	firstSeen := make(map[ConntrackKey]uint64)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweepProtected(ctx, firstSeen, monotonicNow())
+		}
+	}
+}
+
+// sweepProtected runs one pass over the host flow table at now, in
+// bpf_ktime_get_ns() time. firstSeen holds when each protected flow was
+// first swept, the age the slow timeout is measured against.
+func (s *Server) sweepProtected(ctx context.Context, firstSeen map[ConntrackKey]uint64, now uint64) {
+	s.mutex.RLock()
+	manager, protected := s.bpfManager, len(s.protected)
+	s.mutex.RUnlock()
+	if manager == nil || protected == 0 {
+		for key := range firstSeen {
+			delete(firstSeen, key)
+		}
+		return
+	}
+	connections, err := manager.Connections(ctx)
+	if err != nil {
+		log.Printf("⚠️  Failed to read flow table for protected destinations: %v", err)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	byKey := make(map[protectionKey]*ProtectedDestination, len(s.protected))
+	for _, dest := range s.protected {
+		byKey[dest.key] = dest
+	}
+	counts := make(map[*ProtectedDestination]uint32)
+	seen := make(map[ConntrackKey]bool)
+	removed := 0
+	for _, conn := range connections {
+		dest := protectedDestinationOf(byKey, conn.Key)
+		if dest == nil || conn.Entry.State == ctClosing {
+			continue
+		}
+		profile := s.protectionProfiles[dest.Profile]
+		seen[conn.Key] = true
+		if _, tracked := firstSeen[conn.Key]; !tracked {
+			firstSeen[conn.Key] = now
+		}
+
+		var idle, age time.Duration
+		if now > conn.Entry.LastSeen {
+			idle = time.Duration(now - conn.Entry.LastSeen)
+		}
+		age = time.Duration(now - firstSeen[conn.Key])
+		isIdle := profile.IdleTimeoutSeconds > 0 && idle >= time.Duration(profile.IdleTimeoutSeconds)*time.Second
+		isSlow := profile.SlowTimeoutSeconds > 0 && age >= time.Duration(profile.SlowTimeoutSeconds)*time.Second &&
+			float64(conn.Entry.Bytes) < age.Seconds()*float64(profile.MinBytesPerSecond)
+		if !isIdle && !isSlow {
+			counts[dest]++
+			continue
+		}
+
+		if _, err := manager.KillConnection(conn.Key); err != nil {
+			log.Printf("⚠️  Failed to remove flow to protected %s: %v", dest.Destination, err)
+			counts[dest]++
+			continue
+		}
+		delete(firstSeen, conn.Key)
+		if isIdle {
+			dest.removedIdle++
+		} else {
+			dest.removedSlow++
+		}
+		removed++
+	}
+	for key := range firstSeen {
+		if !seen[key] {
+			delete(firstSeen, key)
+		}
+	}
+
+	for _, dest := range s.protected {
+		dest.connections = counts[dest]
+		if err := s.pushProtection(dest); err != nil {
+			log.Printf("⚠️  Failed to update flow count of protected %s: %v", dest.Destination, err)
+		}
+	}
+	if removed > 0 {
+		log.Printf("🛡️  Removed %d idle or slow flows to protected destinations", removed)
+	}
+}
+
+// protectedDestinationOf returns the destination protecting a flow's first
+// packet: its destination port, else its whole destination address
+func protectedDestinationOf(byKey map[protectionKey]*ProtectedDestination, flow ConntrackKey) *ProtectedDestination {
+	key := protectionKey{Addr: flow.DstAddr, Family: flow.Family}
+	if flow.Protocol == 6 || flow.Protocol == 17 || flow.Protocol == 132 {
+		key.Port = flow.DstPort
+		if dest := byKey[key]; dest != nil {
+			return dest
+		}
+	}
+	key.Port = 0
+	return byKey[key]
+}
+
+// parseProtectedDestination parses "address:port", "[address]:port" or a
+// bare address, returning it formatted and as a data plane key
+func parseProtectedDestination(destination string) (string, protectionKey, error) {
+	var key protectionKey
+	var addr netip.Addr
+	if addrPort, err := netip.ParseAddrPort(destination); err == nil {
+		if addrPort.Port() == 0 {
+			return "", key, fmt.Errorf("port 0, give the bare address to protect every port")
+		}
+		addr, key.Port = addrPort.Addr(), addrPort.Port()
+	} else if addr, err = netip.ParseAddr(destination); err != nil {
+		return "", key, fmt.Errorf("%q is not address:port, [address]:port or an address", destination)
+	}
+	addr = addr.Unmap()
+	if addr.Zone() != "" || addr.IsUnspecified() {
+		return "", key, fmt.Errorf("%s is not a host address", addr)
+	}
+
+	key.Family = familyIPv4
+	if addr.Is6() {
+		key.Family = familyIPv6
+	}
+	copy(key.Addr[:], addr.AsSlice())
+	if key.Port == 0 {
+		return addr.String(), key, nil
+	}
+	return netip.AddrPortFrom(addr, key.Port).String(), key, nil
+}
+
+func protectionProfileFromProto(profile *pb.ProtectionProfile) *ProtectionProfile {
+	return &ProtectionProfile{
+		Name:               profile.Name,
+		Description:        profile.Description,
+		MaxConnections:     profile.MaxConnections,
+		SYNRate:            profile.SynRate,
+		SYNBurst:           profile.SynBurst,
+		IdleTimeoutSeconds: profile.IdleTimeoutSeconds,
+		SlowTimeoutSeconds: profile.SlowTimeoutSeconds,
+		MinBytesPerSecond:  profile.MinBytesPerSecond,
+		AllowedCountries:   profile.AllowedCountries,
+	}
+}
+
+func protectionProfileToProto(profile *ProtectionProfile) *pb.ProtectionProfile {
+	return &pb.ProtectionProfile{
+		Name:               profile.Name,
+		Description:        profile.Description,
+		MaxConnections:     profile.MaxConnections,
+		SynRate:            profile.SYNRate,
+		SynBurst:           profile.SYNBurst,
+		IdleTimeoutSeconds: profile.IdleTimeoutSeconds,
+		SlowTimeoutSeconds: profile.SlowTimeoutSeconds,
+		MinBytesPerSecond:  profile.MinBytesPerSecond,
+		AllowedCountries:   profile.AllowedCountries,
+	}
+}
+
+// openProtection opens the pinned protection maps and clears destinations
+// left by a previous control plane run; stored ones are re-pushed on
+// restore
+func (bm *BPFMapManager) openProtection() {
+	path := filepath.Join(bm.pinPath, ProtectionMapName)
+	configs, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Protection profiles not available at %s: %v", path, err)
+		return
+	}
+	path = filepath.Join(bm.pinPath, ProtectionStateMapName)
+	states, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Protection profiles not available at %s: %v", path, err)
+		configs.Close()
+		return
+	}
+
+	var config protectionConfig
+	var state protectionState
+	for m, value := range map[*ebpf.Map]interface{}{configs: &config, states: &state} {
+		var key protectionKey
+		var stale []protectionKey
+		entries := m.Iterate()
+		for entries.Next(&key, value) {
+			stale = append(stale, key)
+		}
+		for _, key := range stale {
+			if err := m.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				log.Printf("⚠️  Failed to clear stale protected destination: %v", err)
+			}
+		}
+	}
+	bm.protection, bm.protectionState = configs, states
+}
+
+// SetProtection writes the profile of a destination, creating its counters
+// when it was not protected before
+func (bm *BPFMapManager) SetProtection(key protectionKey, config protectionConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting protection of destination port %d: %d/%d flows, %d SYN/s",
+			key.Port, config.Conns, config.MaxConns, config.SYNRate)
+		return nil
+	}
+	if bm.protection == nil {
+		return fmt.Errorf("protection maps not available")
+	}
+
+	var state protectionState
+	if err := bm.protectionState.Update(&key, &state, ebpf.UpdateNoExist); err != nil && !errors.Is(err, ebpf.ErrKeyExist) {
+		return fmt.Errorf("failed to create protection counters: %v", err)
+	}
+	if err := bm.protection.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write protection profile: %v", err)
+	}
+	return nil
+}
+
+// DeleteProtection removes the profile and counters of a destination
+func (bm *BPFMapManager) DeleteProtection(key protectionKey) error {
+	if bm.simulated || bm.protection == nil {
+		return nil
+	}
+	for _, m := range []*ebpf.Map{bm.protection, bm.protectionState} {
+		if err := m.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return fmt.Errorf("failed to remove protected destination: %v", err)
+		}
+	}
+	return nil
+}
+
+// ProtectionState reads the drop counters of a destination, zero when it
+// has none
+func (bm *BPFMapManager) ProtectionState(key protectionKey) (protectionState, error) {
+	var state protectionState
+	if bm.simulated || bm.protectionState == nil {
+		return state, nil
+	}
+	if err := bm.protectionState.Lookup(&key, &state); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return state, err
+	}
+	return state, nil
+}
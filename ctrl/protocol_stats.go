@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Protocol counters: ingress IP packets, bytes and drops by L4 protocol
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// ProtocolStatsMapName is the pinned per-protocol counter map (must match
+// eBPF program)
+const ProtocolStatsMapName = "cerberus_protocol_stats"
+
+// protocolStats mirrors struct proto_stats in the eBPF program
+type protocolStats struct {
+	Packets uint64
+	Bytes   uint64
+	Dropped uint64
+}
+
+// ProtocolCount is the traffic of one protocol, summed across CPUs. ICMP
+// and ICMPv6 are counted together as icmp, as rules match them.
+type ProtocolCount struct {
+	Protocol string
+	Packets  uint64
+	Bytes    uint64
+	Dropped  uint64
+}
+
+// openProtocolStats opens the pinned per-protocol counters, left nil if the
+// program predates them
+func (bm *BPFMapManager) openProtocolStats() {
+	path := filepath.Join(bm.pinPath, ProtocolStatsMapName)
+	protocolStats, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Protocol counters not available at %s: %v", path, err)
+		return
+	}
+	bm.protocolStats = protocolStats
+}
+
+// closeProtocolStats releases the per-protocol counters
+func (bm *BPFMapManager) closeProtocolStats() {
+	if bm.protocolStats != nil {
+		bm.protocolStats.Close()
+	}
+}
+
+// ProtocolCounts reads the counters of the protocols that carried traffic,
+// in protocol number order; none when simulated or when the program
+// predates them
+func (bm *BPFMapManager) ProtocolCounts() ([]ProtocolCount, error) {
+	if bm.simulated || bm.protocolStats == nil {
+		return nil, nil
+	}
+
+	return sumProtocolStats(func(protocol uint32) ([]protocolStats, error) {
+		var perCPU []protocolStats
+		err := bm.protocolStats.Lookup(&protocol, &perCPU)
+		return perCPU, err
+	})
+}
+
+// sumProtocolStats sums the per-CPU counters lookup returns for each
+// protocol number, merging protocols of the same name and leaving out
+// those without packets
+func sumProtocolStats(lookup func(protocol uint32) ([]protocolStats, error)) ([]ProtocolCount, error) {
+	var counts []ProtocolCount
+	index := make(map[string]int)
+	for protocol := 0; protocol < 256; protocol++ {
+		perCPU, err := lookup(uint32(protocol))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read counters of protocol %d: %v", protocol, err)
+		}
+		var sum protocolStats
+		for _, value := range perCPU {
+			sum.Packets += value.Packets
+			sum.Bytes += value.Bytes
+			sum.Dropped += value.Dropped
+		}
+		if sum.Packets == 0 {
+			continue
+		}
+
+		name := protocolName(uint8(protocol))
+		i, exists := index[name]
+		if !exists {
+			i = len(counts)
+			index[name] = i
+			counts = append(counts, ProtocolCount{Protocol: name})
+		}
+		counts[i].Packets += sum.Packets
+		counts[i].Bytes += sum.Bytes
+		counts[i].Dropped += sum.Dropped
+	}
+	return counts, nil
+}
+
+// protocolStatsToProto converts protocol counters for GetStats
+func protocolStatsToProto(counts []ProtocolCount) []*pb.ProtocolStats {
+	var protocols []*pb.ProtocolStats
+	for _, count := range counts {
+		protocols = append(protocols, &pb.ProtocolStats{
+			Protocol:       count.Protocol,
+			Packets:        count.Packets,
+			Bytes:          count.Bytes,
+			DroppedPackets: count.Dropped,
+		})
+	}
+	return protocols
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestProtocolStatsSumCPUsAndMergeICMP(t *testing.T) {
+	stats := map[uint32][]protocolStats{
+		1:  {{Packets: 2, Bytes: 200, Dropped: 1}, {Packets: 3, Bytes: 300}},
+		6:  {{Packets: 10, Bytes: 1000, Dropped: 4}, {Packets: 5, Bytes: 500, Dropped: 1}},
+		17: {{}, {}},
+		58: {{Packets: 1, Bytes: 100, Dropped: 1}},
+	}
+	counts, err := sumProtocolStats(func(protocol uint32) ([]protocolStats, error) {
+		return stats[protocol], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ProtocolCount{
+		{Protocol: "icmp", Packets: 6, Bytes: 600, Dropped: 2},
+		{Protocol: "tcp", Packets: 15, Bytes: 1500, Dropped: 5},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Fatalf("counts = %+v, want %+v", counts, want)
+	}
+
+	protocols := protocolStatsToProto(counts)
+	if len(protocols) != 2 || protocols[1].Protocol != "tcp" || protocols[1].DroppedPackets != 5 {
+		t.Fatalf("GetStats protocols = %v", protocols)
+	}
+}
+
+func TestProtocolStatsLookupFailure(t *testing.T) {
+	_, err := sumProtocolStats(func(protocol uint32) ([]protocolStats, error) {
+		if protocol == 17 {
+			return nil, errors.New("map closed")
+		}
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatal("a failed lookup was not reported")
+	}
+}
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: Apache-2.0
+// Reject action: TCP RST and ICMP unreachable replies to packets the data
+// plane dropped by a reject rule
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Pinned reject ring buffer (must match eBPF program)
+const RejectsMapName = "cerberus_rejects"
+
+// Replies per second, overridden with CERBERUS_REJECT_RATE. Packets above
+// the rate are only dropped, so that spoofed sources cannot turn the host
+// into a reflector.
+const DefaultRejectRate = 100
+
+// Bytes of each rejected packet the data plane copies, from its IP header
+// (must match REJECT_QUOTE_MAX in eBPF program)
+const rejectQuoteMax = 128
+
+// rejectEvent mirrors struct reject_event in ebpf/xdp_filter.c
+type rejectEvent struct {
+	Ifindex uint32
+	Len     uint16 // Bytes of Packet copied
+	Family  uint8
+	Egress  uint8 // Dropped leaving the host
+	Packet  [rejectQuoteMax]byte
+}
+
+// Rejecter answers packets dropped by reject rules: TCP segments with a
+// RST, ICMP echo requests and other protocols with an ICMP port
+// unreachable. Replies are sent through raw sockets, so the kernel routes
+// them, and limited to a rate.
+type Rejecter struct {
+	manager *BPFMapManager
+	rate    float64 // Replies per second, 0 = never reply
+	clock   Clock   // Time the rate is measured by
+
+	mutex       sync.Mutex
+	tokens      float64
+	refilled    time.Time
+	sent        uint64
+	rateLimited uint64 // Not answered because of the rate
+	unanswered  uint64 // Not answered by rule, e.g. ICMP errors and RSTs
+	failed      uint64
+}
+
+// RejectStatus is a snapshot of the rejecter
+type RejectStatus struct {
+	Rate        float64 `json:"rate"`
+	Sent        uint64  `json:"sent"`
+	RateLimited uint64  `json:"rate_limited"`
+	Unanswered  uint64  `json:"unanswered"`
+	Failed      uint64  `json:"failed"`
+}
+
+// NewRejecter creates a rejecter for a data plane sending at most rate
+// replies per second by clock
+func NewRejecter(manager *BPFMapManager, rate float64, clock Clock) *Rejecter {
+	return &Rejecter{manager: manager, rate: rate, clock: clock, tokens: rate}
+}
+
+// rejectRateFromEnv reads the reply rate
+func rejectRateFromEnv() (float64, error) {
+	value := os.Getenv("CERBERUS_REJECT_RATE")
+	if value == "" {
+		return DefaultRejectRate, nil
+	}
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 {
+		return 0, fmt.Errorf("invalid CERBERUS_REJECT_RATE %q, expected replies per second", value)
+	}
+	return rate, nil
+}
+
+// Run answers rejected packets until ctx is done. It returns at once when
+// the data plane has no reject ring buffer.
+func (r *Rejecter) Run(ctx context.Context) {
+	if r.manager == nil || r.manager.rejects == nil {
+		return
+	}
+
+	records, cancel, err := r.manager.rings.Consume(RejectsMapName, r.manager.rejects, "rejecter")
+	if err != nil {
+		log.Printf("Failed to open reject ring buffer: %v", err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	sockets := make(map[int]int) // Raw socket by family
+	defer func() {
+		for _, fd := range sockets {
+			unix.Close(fd)
+		}
+	}()
+	for record := range records {
+		var event rejectEvent
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &event); err != nil {
+			log.Printf("Malformed rejected packet: %v", err)
+			continue
+		}
+		if err := r.answer(&event, sockets); err != nil {
+			r.count(&r.failed)
+			log.Printf("⚠️  Failed to answer rejected packet: %v", err)
+		}
+	}
+}
+
+// answer sends the reply to one rejected packet
+func (r *Rejecter) answer(event *rejectEvent, sockets map[int]int) error {
+	packet := event.Packet[:min(int(event.Len), rejectQuoteMax)]
+	dst, reply := rejectReply(packet)
+	if reply == nil {
+		r.count(&r.unanswered)
+		return nil
+	}
+	if !r.allow(r.clock.Now()) {
+		r.count(&r.rateLimited)
+		return nil
+	}
+
+	family := unix.AF_INET
+	var to unix.Sockaddr = &unix.SockaddrInet4{Addr: dst.As4()}
+	if dst.Is6() {
+		family = unix.AF_INET6
+		to = &unix.SockaddrInet6{Addr: dst.As16()}
+	}
+	fd, open := sockets[family]
+	if !open {
+		var err error
+		// IPPROTO_RAW sockets send packets with their IP header included
+		if fd, err = unix.Socket(family, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.IPPROTO_RAW); err != nil {
+			return fmt.Errorf("failed to open raw socket: %v", err)
+		}
+		sockets[family] = fd
+	}
+	if err := unix.Sendto(fd, reply, 0, to); err != nil {
+		return fmt.Errorf("failed to send reply to %s: %v", dst, err)
+	}
+	r.count(&r.sent)
+	return nil
+}
+
+// allow takes a token of the reply rate at now
+func (r *Rejecter) allow(now time.Time) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.refilled.IsZero() {
+		r.tokens = min(r.rate, r.tokens+now.Sub(r.refilled).Seconds()*r.rate)
+	}
+	r.refilled = now
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
+
+func (r *Rejecter) count(counter *uint64) {
+	r.mutex.Lock()
+	*counter++
+	r.mutex.Unlock()
+}
+
+// Status returns the reply counters
+func (r *Rejecter) Status() RejectStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return RejectStatus{
+		Rate:        r.rate,
+		Sent:        r.sent,
+		RateLimited: r.rateLimited,
+		Unanswered:  r.unanswered,
+		Failed:      r.failed,
+	}
+}
+
+// rejectReply builds the reply to a rejected packet given from its IP
+// header, returning where to send it and the reply with its IP header.
+// The reply is nil for packets that are not answered: truncated ones,
+// non-first fragments, RSTs, ICMP errors and packets from or to multicast
+// and broadcast addresses.
+func rejectReply(packet []byte) (netip.Addr, []byte) {
+	var src, dst netip.Addr
+	var protocol uint8
+	var header, length int // IP header length, datagram length
+	switch {
+	case len(packet) >= 20 && packet[0]>>4 == 4:
+		header, length = int(packet[0]&0x0f)*4, int(binary.BigEndian.Uint16(packet[2:4]))
+		if header < 20 || binary.BigEndian.Uint16(packet[6:8])&0x1fff != 0 {
+			return dst, nil
+		}
+		protocol = packet[9]
+		src, dst = netip.AddrFrom4([4]byte(packet[12:16])), netip.AddrFrom4([4]byte(packet[16:20]))
+	case len(packet) >= 40 && packet[0]>>4 == 6:
+		header, length = 40, 40+int(binary.BigEndian.Uint16(packet[4:6]))
+		protocol = packet[6]
+		src, dst = netip.AddrFrom16([16]byte(packet[8:24])), netip.AddrFrom16([16]byte(packet[24:40]))
+	default:
+		return dst, nil
+	}
+	if len(packet) < header || src.IsMulticast() || src.IsUnspecified() || dst.IsMulticast() ||
+		src == netip.AddrFrom4([4]byte{255, 255, 255, 255}) || dst == netip.AddrFrom4([4]byte{255, 255, 255, 255}) {
+		return dst, nil
+	}
+	l4 := packet[header:]
+
+	switch protocol {
+	case 6:
+		if len(l4) < 20 {
+			return dst, nil
+		}
+		flags := l4[13]
+		if flags&tcpFlagRST != 0 {
+			return dst, nil
+		}
+		rst := make([]byte, 20)
+		copy(rst[0:2], l4[2:4]) // Ports swapped
+		copy(rst[2:4], l4[0:2])
+		rst[12] = 5 << 4
+		if flags&tcpFlagACK != 0 {
+			copy(rst[4:8], l4[8:12]) // Sequence number = acknowledged one
+			rst[13] = tcpFlagRST
+		} else {
+			ack := binary.BigEndian.Uint32(l4[4:8]) + uint32(length-header-int(l4[12]>>4)*4)
+			if flags&tcpFlagSYN != 0 {
+				ack++
+			}
+			if flags&tcpFlagFIN != 0 {
+				ack++
+			}
+			binary.BigEndian.PutUint32(rst[8:12], ack)
+			rst[13] = tcpFlagRST | tcpFlagACK
+		}
+		return src, ipPacket(dst, src, 6, rst)
+	case 1, 58:
+		// Echo requests are answered, errors and other messages are not
+		if len(l4) < 1 || (protocol == 1 && l4[0] != 8) || (protocol == 58 && l4[0] != 128) {
+			return dst, nil
+		}
+	}
+
+	// ICMP port unreachable quoting the packet: its IP header and first 8
+	// bytes for IPv4, as much as was copied for IPv6
+	quote := packet[:min(len(packet), length)]
+	icmp := []byte{3, 3, 0, 0, 0, 0, 0, 0}
+	replyProtocol := uint8(1)
+	if src.Is6() {
+		icmp[0], icmp[1] = 1, 4
+		replyProtocol = 58
+	} else {
+		quote = quote[:min(len(quote), header+8)]
+	}
+	return src, ipPacket(dst, src, replyProtocol, append(icmp, quote...))
+}
+
+// TCP header flags
+const (
+	tcpFlagFIN = 0x01
+	tcpFlagSYN = 0x02
+	tcpFlagRST = 0x04
+	tcpFlagACK = 0x10
+)
+
+// ipPacket wraps an L4 message in an IP header from src to dst and fills
+// in its checksum
+func ipPacket(src, dst netip.Addr, protocol uint8, payload []byte) []byte {
+	checksumAt := map[uint8]int{1: 2, 6: 16, 58: 2}[protocol]
+
+	// ICMPv4 has no pseudo header
+	var pseudo []byte
+	if protocol != 1 {
+		pseudo = append(pseudo, src.AsSlice()...)
+		pseudo = append(pseudo, dst.AsSlice()...)
+		pseudo = binary.BigEndian.AppendUint32(pseudo, uint32(len(payload)))
+		pseudo = append(pseudo, 0, 0, 0, protocol)
+	}
+	binary.BigEndian.PutUint16(payload[checksumAt:], internetChecksum(append(pseudo, payload...)))
+
+	if src.Is6() {
+		header := make([]byte, 40, 40+len(payload))
+		header[0] = 6 << 4
+		binary.BigEndian.PutUint16(header[4:6], uint16(len(payload)))
+		header[6], header[7] = protocol, 64
+		copy(header[8:24], src.AsSlice())
+		copy(header[24:40], dst.AsSlice())
+		return append(header, payload...)
+	}
+	header := make([]byte, 20, 20+len(payload))
+	header[0] = 4<<4 | 5
+	binary.BigEndian.PutUint16(header[2:4], uint16(20+len(payload)))
+	header[8], header[9] = 64, protocol
+	copy(header[12:16], src.AsSlice())
+	copy(header[16:20], dst.AsSlice())
+	binary.BigEndian.PutUint16(header[10:12], internetChecksum(header))
+	return append(header, payload...)
+}
+
+// internetChecksum is the RFC 1071 checksum of data
+func internetChecksum(data []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i:]))
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
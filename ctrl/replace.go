@@ -0,0 +1,438 @@
+// SPDX-License-Identifier: Apache-2.0
+// Hit-less rule set replacement: shadow slots tagged with the next generation, then one generation flip
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// GenerationMapName is the pinned rule set generation (must match eBPF program)
+const GenerationMapName = "cerberus_generation"
+
+// EventRulesReplaced reports a ReplaceRules swap
+const EventRulesReplaced = "RULES_REPLACED"
+
+// openGeneration opens the pinned generation and starts it at 1, so that
+// retiring a slot in the current generation never reads as "no end".
+// Programs predating it cannot replace rule sets hit-lessly.
+func (bm *BPFMapManager) openGeneration() {
+	path := filepath.Join(bm.pinPath, GenerationMapName)
+	generation, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Hit-less rule replacement not available at %s: %v", path, err)
+		return
+	}
+
+	key, active := uint32(0), uint32(0)
+	if err := generation.Lookup(&key, &active); err != nil {
+		log.Printf("⚠️  Hit-less rule replacement disabled: failed to read %s: %v", path, err)
+		generation.Close()
+		return
+	}
+	if active == 0 {
+		active = 1
+		if err := generation.Put(&key, &active); err != nil {
+			log.Printf("⚠️  Hit-less rule replacement disabled: failed to write %s: %v", path, err)
+			generation.Close()
+			return
+		}
+	}
+	bm.generation, bm.activeGeneration = generation, active
+}
+
+// tableAccepts reports whether an entry is enforced by the rule table of a
+// family and hook
+func tableAccepts(family int, egress bool, rule *FirewallRule) bool {
+	ingressHook, egressHook := ruleHooks(rule)
+	if (egress && !egressHook) || (!egress && !ingressHook) {
+		return false
+	}
+	ruleFam := ruleFamily(rule)
+	return ruleFam == familyAny || ruleFam == family
+}
+
+// stagedTable is a rule table prepared for the next generation: changed
+// and new entries sit in shadow slots, entries leaving are marked to end
+// with the current generation
+type stagedTable struct {
+	table   *ruleSlotTable
+	moves   map[string]uint32 // Entry ID -> shadow slot live from the next generation
+	retired map[string]uint32 // Entry ID -> slot live until the current generation
+}
+
+// stage writes the entries of the next generation without changing what
+// the data plane matches now. Entries whose encoding and prefixes are
+// unchanged keep their slot while it stays in priority order.
+func (t *ruleSlotTable) stage(entries []*FirewallRule, active, next uint32) (*stagedTable, error) {
+	staged := &stagedTable{table: t, moves: make(map[string]uint32), retired: make(map[string]uint32)}
+
+	wanted := make(map[string]*FirewallRule, len(entries))
+	for _, entry := range entries {
+		wanted[entry.ID] = entry
+	}
+	sorted := make([]*FirewallRule, 0, len(wanted))
+	for _, entry := range wanted {
+		sorted = append(sorted, entry)
+	}
+	sorted = sortByPriority(sorted)
+
+	keep := make(map[string]bool)
+	for _, entry := range sorted {
+		slot, exists := t.slots[entry.ID]
+		if !exists {
+			continue
+		}
+		var current BPFFirewallRule
+		if err := t.rulesMap.Lookup(&slot, &current); err != nil {
+			return nil, fmt.Errorf("failed to read slot %d of %s: %v", slot, entry.ID, err)
+		}
+		current.GenFrom, current.GenUntil = 0, 0
+		keep[entry.ID] = current == encodeRule(entry, t.family) &&
+			t.srcPrefixes[slot] == rulePrefix(entry.SrcIP, t.family) &&
+			t.dstPrefixes[slot] == rulePrefix(entry.DstIP, t.family)
+	}
+	moves, err := t.orderedPlacement(sorted, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	var slots []uint32
+	var values []BPFFirewallRule
+	for _, entry := range sorted {
+		slot, moved := moves[entry.ID]
+		if !moved {
+			continue
+		}
+		t.takeFree(slot)
+		staged.moves[entry.ID] = slot
+		if err := t.resetHits(slot); err != nil {
+			staged.rollback()
+			return nil, err
+		}
+		value := encodeRule(entry, t.family)
+		value.GenFrom = next
+		slots, values = append(slots, slot), append(values, value)
+		t.srcPrefixes[slot] = rulePrefix(entry.SrcIP, t.family)
+		t.dstPrefixes[slot] = rulePrefix(entry.DstIP, t.family)
+	}
+	if err := t.writeSlots(slots, values); err != nil {
+		staged.rollback()
+		return nil, fmt.Errorf("failed to stage %d entries: %v", len(slots), err)
+	}
+
+	slots, values = slots[:0], values[:0]
+	for id, slot := range t.slots {
+		if _, moved := staged.moves[id]; !moved && wanted[id] != nil {
+			continue
+		}
+		var value BPFFirewallRule
+		if err := t.rulesMap.Lookup(&slot, &value); err != nil {
+			staged.rollback()
+			return nil, fmt.Errorf("failed to read slot %d of %s: %v", slot, id, err)
+		}
+		value.GenUntil = active
+		slots, values = append(slots, slot), append(values, value)
+		staged.retired[id] = slot
+	}
+	if err := t.writeSlots(slots, values); err != nil {
+		staged.rollback()
+		return nil, fmt.Errorf("failed to retire %d entries: %v", len(slots), err)
+	}
+
+	if err := t.syncTries(); err != nil {
+		staged.rollback()
+		return nil, err
+	}
+	return staged, nil
+}
+
+// setGenUntil ends the life of a slot's rule after a generation, 0 = no end
+func (t *ruleSlotTable) setGenUntil(slot, generation uint32) error {
+	var value BPFFirewallRule
+	if err := t.rulesMap.Lookup(&slot, &value); err != nil {
+		return fmt.Errorf("failed to read slot %d: %v", slot, err)
+	}
+	value.GenUntil = generation
+	if err := t.rulesMap.Put(&slot, value); err != nil {
+		return fmt.Errorf("failed to write slot %d: %v", slot, err)
+	}
+	return nil
+}
+
+// rollback undoes a staging that was not committed: shadow slots are
+// cleared and retired entries live on
+func (s *stagedTable) rollback() {
+	t := s.table
+	for id, slot := range s.retired {
+		if err := t.setGenUntil(slot, 0); err != nil {
+			log.Printf("⚠️  Failed to restore %s: %v", id, err)
+		}
+	}
+	empty := make([]byte, t.rulesMap.ValueSize())
+	for id, slot := range s.moves {
+		delete(t.srcPrefixes, slot)
+		delete(t.dstPrefixes, slot)
+		if err := t.rulesMap.Put(&slot, empty); err != nil {
+			log.Printf("⚠️  Failed to clear shadow slot of %s: %v", id, err)
+			continue
+		}
+		t.free = append(t.free, slot)
+	}
+	if err := t.syncTries(); err != nil {
+		log.Printf("⚠️  Failed to restore prefix tries: %v", err)
+	}
+}
+
+// commit frees the retired slots once the next generation is active. Hit
+// counters of moved entries carry over to their new slot.
+func (s *stagedTable) commit() error {
+	t := s.table
+	var slots []uint32
+	for id, slot := range s.retired {
+		newSlot, moved := s.moves[id]
+		if moved {
+			if err := t.carryHits(id, slot); err != nil {
+				log.Printf("⚠️  %v", err)
+			}
+			t.slots[id] = newSlot
+		} else {
+			delete(t.slots, id)
+			delete(t.carried, id)
+		}
+		delete(t.srcPrefixes, slot)
+		delete(t.dstPrefixes, slot)
+		slots = append(slots, slot)
+	}
+	for id, slot := range s.moves {
+		t.slots[id] = slot
+	}
+	if err := t.writeSlots(slots, make([]BPFFirewallRule, len(slots))); err != nil {
+		return fmt.Errorf("failed to clear %d retired slots: %v", len(slots), err)
+	}
+	t.free = append(t.free, slots...)
+	return t.syncTries()
+}
+
+// writeSlots writes rule slots in one batched map update, or one update
+// per slot on kernels without batch operations (before 5.6)
+func (t *ruleSlotTable) writeSlots(slots []uint32, values []BPFFirewallRule) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	_, err := t.rulesMap.BatchUpdate(slots, values, nil)
+	if !errors.Is(err, ebpf.ErrNotSupported) {
+		return err
+	}
+	for i := range slots {
+		if err := t.rulesMap.Put(&slots[i], values[i]); err != nil {
+			return fmt.Errorf("slot %d: %v", slots[i], err)
+		}
+	}
+	return nil
+}
+
+// ReplaceRules swaps the entries of this data plane for a new set in one
+// step. The new set is staged in free slots next to the running one, then
+// the generation flip makes every table switch at once; packets always
+// match either the complete old set or the complete new one. Cancelling
+// ctx before the flip rolls the staging back; after it the replace
+// completes.
+func (bm *BPFMapManager) ReplaceRules(ctx context.Context, entries []*FirewallRule) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Replacing BPF rule set: %d entries", len(entries))
+		return nil
+	}
+	if bm.generation == nil {
+		return withCode(ErrCodeDataPlaneFeature, fmt.Errorf("data plane has no %s map, hit-less replace unavailable", GenerationMapName))
+	}
+
+	type replacedTable struct {
+		table  *ruleSlotTable
+		family int
+		egress bool
+		shard  int // Ingress shard, globalShard for the tables of every packet
+	}
+	tables := []replacedTable{
+		{bm.rules, familyIPv4, false, globalShard},
+		{bm.rules6, familyIPv6, false, globalShard},
+		{bm.egress, familyIPv4, true, globalShard},
+		{bm.egress6, familyIPv6, true, globalShard},
+	}
+	if bm.sharded() {
+		if _, err := bm.RebalanceShards(entries); err != nil {
+			return err
+		}
+		for i, shard := range bm.shards.tables {
+			tables = append(tables,
+				replacedTable{shard[0], familyIPv4, false, i + 1},
+				replacedTable{shard[1], familyIPv6, false, i + 1})
+		}
+	}
+
+	bm.bloomBeforeReplace(entries)
+	active, next := bm.activeGeneration, bm.activeGeneration+1
+	var staged []*stagedTable
+	rollback := func() {
+		for _, s := range staged {
+			s.rollback()
+		}
+	}
+	for i, t := range tables {
+		if err := checkpoint(ctx, "rule set replace", i, len(tables), "tables staged"); err != nil {
+			rollback()
+			return err
+		}
+		var subset []*FirewallRule
+		for _, entry := range entries {
+			if tableAccepts(t.family, t.egress, entry) && (t.egress || bm.entryShard(entry, t.family) == t.shard) {
+				subset = append(subset, entry)
+			}
+		}
+		if t.shard != globalShard && len(subset) == 0 && len(t.table.slots) == 0 {
+			continue
+		}
+		if t.table == nil {
+			for _, entry := range subset {
+				if t.family == familyIPv4 || ruleFamily(entry) == familyIPv6 {
+					rollback()
+					return fmt.Errorf("%s %s rules map not available for entry %s",
+						familyName(t.family), hookNames(!t.egress, t.egress), entry.ID)
+				}
+			}
+			continue
+		}
+		s, err := t.table.stage(subset, active, next)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("%s %s: %v", familyName(t.family), hookNames(!t.egress, t.egress), err)
+		}
+		staged = append(staged, s)
+	}
+
+	if err := checkpoint(ctx, "rule set replace", len(tables), len(tables), "tables staged"); err != nil {
+		rollback()
+		return err
+	}
+	key := uint32(0)
+	if err := bm.generation.Put(&key, &next); err != nil {
+		rollback()
+		return fmt.Errorf("failed to activate generation %d: %v", next, err)
+	}
+	bm.activeGeneration = next
+	bm.placeShards(entries)
+
+	for _, s := range staged {
+		if err := s.commit(); err != nil {
+			return err
+		}
+	}
+	bm.bloomAfterReplace(entries)
+	log.Printf("Replaced BPF rule set: generation %d, %d entries", next, len(entries))
+	return nil
+}
+
+// ReplaceRules replaces the whole rule set with the given rules in one
+// hit-less data plane update. Rules without an ID get a new one; rules
+// keeping an existing ID keep its creation time. Installed federation
+// baseline rules stay.
+func (s *Server) ReplaceRules(ctx context.Context, req *pb.ReplaceRulesRequest) (*pb.ReplaceRulesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rules, errs := s.mergeRules(s.federatedRules(), req.Rules)
+	if len(errs) > 0 {
+		return &pb.ReplaceRulesResponse{Success: false, Message: "Rule validation failed", Errors: errs}, nil
+	}
+	if err := s.limits.checkRules(len(rules)); err != nil {
+		return nil, err
+	}
+
+	previous := s.rules
+	s.rules = rules
+	if err := s.replacePolicy(ctx); err != nil {
+		s.rules = previous
+		if interrupted, ok := asInterrupted(err); ok {
+			return nil, interrupted
+		}
+		return &pb.ReplaceRulesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to replace rules in data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+	version := s.compiledPolicy().Version
+	s.events.Publish(&pb.Event{
+		Type:     EventRulesReplaced,
+		Message:  fmt.Sprintf("Rule set replaced with %d rules (policy version %d)", len(rules), version),
+		Severity: "medium",
+	})
+	log.Printf("Replaced rule set: %d rules, policy version %d", len(rules), version)
+
+	return &pb.ReplaceRulesResponse{
+		Success: true,
+		Message: "Rules replaced successfully",
+		Count:   int32(len(rules)),
+		Version: version,
+	}, nil
+}
+
+// mergeRules validates a batch of rules and returns base with the batch
+// added, or the validation errors. Rules without an ID get a new one;
+// rules keeping an existing ID replace it and keep its creation time.
+// Caller must hold s.mutex.
+func (s *Server) mergeRules(base map[string]*FirewallRule, batch []*pb.Rule) (map[string]*FirewallRule, []string) {
+	now := s.clock.Now()
+	rules := make(map[string]*FirewallRule, len(base)+len(batch))
+	for id, rule := range base {
+		rules[id] = rule
+	}
+	seen := make(map[string]bool, len(batch))
+	var added []string
+	var errs []string
+	for _, p := range batch {
+		id := p.Id
+		if id == "" {
+			id = s.ids.NewID("rule")
+		}
+		if seen[id] {
+			errs = append(errs, fmt.Sprintf("rule %s: duplicate ID", id))
+			continue
+		}
+		seen[id] = true
+		rule, err := fromProtoRule(p, now)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: %v", id, err))
+			continue
+		}
+		rule.ID = id
+		rule.CreatedAt, rule.UpdatedAt = now, now
+		if existing, exists := s.rules[rule.ID]; exists {
+			if federatedRule(existing) {
+				errs = append(errs, fmt.Sprintf("rule %s: maintained by the federation baseline", rule.ID))
+				continue
+			}
+			rule.CreatedAt = existing.CreatedAt
+		}
+		err = s.validateRule(rule)
+		if err == nil {
+			err = validateExpiry(rule, now)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %s: %v", rule.ID, err))
+			continue
+		}
+		rules[rule.ID] = rule
+		added = append(added, rule.ID)
+	}
+	errs = append(errs, priorityCollisionErrors(rules, added)...)
+	return rules, errs
+}
@@ -0,0 +1,575 @@
+// SPDX-License-Identifier: Apache-2.0
+// Scheduled reports: daily and weekly digests emailed over SMTP
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	reportsVersion  = 1
+	reportsFileName = "reports.json"
+
+	// Digest schedules; a daily digest covers the previous UTC day, a
+	// weekly one the previous Monday-to-Monday UTC week
+	ReportDaily  = "daily"
+	ReportWeekly = "weekly"
+
+	// How often due digests are checked and report state is written to disk
+	reportCheckInterval = time.Minute
+	reportSaveInterval  = 10 * time.Minute
+	reportRetryInterval = 15 * time.Minute // After a failed send
+
+	reportTopSources   = 10
+	reportSourceLimit  = 10000 // Sources counted per period; later ones go to OtherSources
+	reportEventLimit   = 500   // Rule changes and auto-blocks listed per period
+	reportSMTPDeadline = time.Minute
+)
+
+// reportRuleChangeEvents are the events listed as rule changes
+var reportRuleChangeEvents = map[string]bool{
+	EventRuleAdded:      true,
+	EventRuleUpdated:    true,
+	EventRuleDeleted:    true,
+	EventRuleExpired:    true,
+	EventRulesImported:  true,
+	EventRulesReordered: true,
+	EventRulesReplaced:  true,
+}
+
+// reportAutoBlockEvents are the events listed as automatic blocks
+var reportAutoBlockEvents = map[string]bool{EventAutoBlocked: true, EventAutoUnblocked: true}
+
+// ReportConfig configures digests, read from CERBERUS_REPORT_* variables.
+// Reporting is off unless an SMTP server is set.
+type ReportConfig struct {
+	SMTPAddr  string // host:port
+	Username  string // PLAIN auth when set
+	Password  string
+	From      string
+	To        []string
+	Schedules []string // ReportDaily and/or ReportWeekly
+	Template  string   // text/template file for the body, empty = built-in
+}
+
+// reportConfigFromEnv reads the reporting configuration
+func reportConfigFromEnv() (ReportConfig, error) {
+	config := ReportConfig{
+		SMTPAddr:  os.Getenv("CERBERUS_REPORT_SMTP"),
+		Username:  os.Getenv("CERBERUS_REPORT_SMTP_USER"),
+		Password:  os.Getenv("CERBERUS_REPORT_SMTP_PASSWORD"),
+		From:      os.Getenv("CERBERUS_REPORT_FROM"),
+		Template:  os.Getenv("CERBERUS_REPORT_TEMPLATE"),
+		Schedules: []string{ReportDaily},
+	}
+	if config.SMTPAddr == "" {
+		return config, nil
+	}
+	if _, _, err := net.SplitHostPort(config.SMTPAddr); err != nil {
+		return config, fmt.Errorf("invalid CERBERUS_REPORT_SMTP %q, expected host:port", config.SMTPAddr)
+	}
+	for _, to := range strings.Split(os.Getenv("CERBERUS_REPORT_TO"), ",") {
+		if to = strings.TrimSpace(to); to != "" {
+			config.To = append(config.To, to)
+		}
+	}
+	if config.From == "" || len(config.To) == 0 {
+		return config, fmt.Errorf("CERBERUS_REPORT_FROM and CERBERUS_REPORT_TO are required with CERBERUS_REPORT_SMTP")
+	}
+	if value := os.Getenv("CERBERUS_REPORT_SCHEDULE"); value != "" {
+		config.Schedules = nil
+		for _, schedule := range strings.Split(value, ",") {
+			schedule = strings.TrimSpace(schedule)
+			if schedule != ReportDaily && schedule != ReportWeekly {
+				return config, fmt.Errorf("invalid CERBERUS_REPORT_SCHEDULE %q, expected daily, weekly or both", value)
+			}
+			config.Schedules = append(config.Schedules, schedule)
+		}
+	}
+	return config, nil
+}
+
+// ReportEvent is an event listed in a digest
+type ReportEvent struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	RuleID  string    `json:"rule_id,omitempty"`
+	Message string    `json:"message"`
+}
+
+// reportPeriod accumulates what a digest needs besides the statistics
+// history and the current state
+type reportPeriod struct {
+	Start          time.Time          `json:"start"`
+	BlockedSources map[string]float64 `json:"blocked_sources"` // Estimated drops by source, from sampled drops
+	OtherSources   float64            `json:"other_sources"`   // Drops of sources over reportSourceLimit
+	RuleChanges    []ReportEvent      `json:"rule_changes"`
+	AutoBlocks     []ReportEvent      `json:"auto_blocks"`
+	Omitted        int                `json:"omitted"`      // Events over reportEventLimit
+	NextAttempt    time.Time          `json:"next_attempt"` // After a failed send
+}
+
+func newReportPeriod(start time.Time) *reportPeriod {
+	return &reportPeriod{Start: start, BlockedSources: make(map[string]float64)}
+}
+
+// reportsFile is the persisted form of the open periods
+type reportsFile struct {
+	Version int                      `json:"version"`
+	Periods map[string]*reportPeriod `json:"periods"` // By schedule
+}
+
+// SourceCount is a blocked source and its estimated dropped packets
+type SourceCount struct {
+	Source  string
+	Packets uint64
+}
+
+// CapacityUsage is the occupancy of one data plane table
+type CapacityUsage struct {
+	Name     string
+	Used     int
+	Capacity int
+}
+
+// Headroom is the free share of the table in percent
+func (c CapacityUsage) Headroom() float64 {
+	if c.Capacity == 0 {
+		return 0
+	}
+	return 100 * float64(c.Capacity-c.Used) / float64(c.Capacity)
+}
+
+// Digest is the data a report template renders
+type Digest struct {
+	Schedule      string
+	Start, End    time.Time
+	Host          string
+	Traffic       StatsPoint // Summed over the period; MaxPPS is the peak
+	TopSources    []SourceCount
+	OtherSources  uint64
+	RuleChanges   []ReportEvent
+	AutoBlocks    []ReportEvent
+	Omitted       int
+	Capacity      []CapacityUsage
+	Rules         int
+	PolicyVersion uint64
+}
+
+// defaultReportTemplate renders a plain text digest
+const defaultReportTemplate = `Cerberus-V {{.Schedule}} digest for {{.Host}}
+{{date .Start}} to {{date .End}} (UTC)
+
+Traffic
+  pass {{.Traffic.Pass}}, drop {{.Traffic.Drop}}, redirect {{.Traffic.Redirect}}, error {{.Traffic.Error}}
+  peak {{printf "%.0f" .Traffic.MaxPPS}} packets/s
+{{with .TopSources}}
+Top blocked sources (estimated from sampled drops)
+{{range .}}  {{printf "%-40s" .Source}} {{.Packets}}
+{{end}}{{if $.OtherSources}}  {{printf "%-40s" "other"}} {{$.OtherSources}}
+{{end}}{{else}}
+No sampled drops.
+{{end}}
+Automatic blocks
+{{range .AutoBlocks}}  {{time .Time}}  {{.Message}}
+{{else}}  None.
+{{end}}
+Rule changes
+{{range .RuleChanges}}  {{time .Time}}  {{.Type}}  {{.Message}}
+{{else}}  None.
+{{end}}{{if .Omitted}}  ... and {{.Omitted}} more events
+{{end}}
+Capacity headroom
+{{range .Capacity}}  {{printf "%-28s" .Name}} {{.Used}}/{{.Capacity}} used, {{printf "%.1f" .Headroom}}% free
+{{else}}  No data plane tables.
+{{end}}
+Policy version {{.PolicyVersion}}, {{.Rules}} rules.
+`
+
+var reportFuncs = template.FuncMap{
+	"date": func(t time.Time) string { return t.UTC().Format(time.DateOnly) },
+	"time": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04") },
+}
+
+// Reporter accumulates digest data from the event bus and emails a digest
+// when a period of each configured schedule ends. Open periods are saved
+// under the state directory so a restart does not lose them.
+type Reporter struct {
+	server   *Server
+	config   ReportConfig
+	template *template.Template
+	send     func(msg []byte) error
+
+	mutex   sync.Mutex
+	periods map[string]*reportPeriod
+	path    string // Empty = kept in memory only
+}
+
+// NewReporter creates a reporter for the configured schedules, loading the
+// periods saved under dir; an empty dir keeps them in memory
+func NewReporter(server *Server, config ReportConfig, dir string) (*Reporter, error) {
+	text := defaultReportTemplate
+	if config.Template != "" {
+		data, err := os.ReadFile(config.Template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read report template: %v", err)
+		}
+		text = string(data)
+	}
+	tmpl, err := template.New("digest").Funcs(reportFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid report template: %v", err)
+	}
+
+	r := &Reporter{server: server, config: config, template: tmpl, periods: make(map[string]*reportPeriod)}
+	r.send = r.sendMail
+	if dir != "" {
+		r.path = filepath.Join(dir, reportsFileName)
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	}
+	now := server.clock.Now().UTC()
+	for _, schedule := range config.Schedules {
+		if r.periods[schedule] == nil {
+			r.periods[schedule] = newReportPeriod(now)
+		}
+	}
+	for schedule := range r.periods {
+		if !r.scheduled(schedule) {
+			delete(r.periods, schedule)
+		}
+	}
+	return r, nil
+}
+
+func (r *Reporter) scheduled(schedule string) bool {
+	for _, configured := range r.config.Schedules {
+		if configured == schedule {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reporter) load() error {
+	data, err := os.ReadFile(r.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read report state: %v", err)
+	}
+	var file reportsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse report state %s: %v", r.path, err)
+	}
+	if file.Version > reportsVersion {
+		return fmt.Errorf("report state version %d is newer than supported version %d", file.Version, reportsVersion)
+	}
+	for schedule, period := range file.Periods {
+		if period.BlockedSources == nil {
+			period.BlockedSources = make(map[string]float64)
+		}
+		r.periods[schedule] = period
+	}
+	return nil
+}
+
+// Save writes the open periods to disk
+func (r *Reporter) Save() error {
+	if r.path == "" {
+		return nil
+	}
+	r.mutex.Lock()
+	data, err := json.Marshal(&reportsFile{Version: reportsVersion, Periods: r.periods})
+	r.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode report state: %v", err)
+	}
+	return writeFileAtomic(r.path, data)
+}
+
+// periodEnd is the end of the last complete period of a schedule started
+// at start, or the zero time while the first is still running
+func periodEnd(schedule string, start, now time.Time) time.Time {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if schedule == ReportWeekly {
+		// Weeks start on Monday
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	if !day.After(start) {
+		return time.Time{}
+	}
+	return day
+}
+
+// observe adds an event to every open period
+func (r *Reporter) observe(event *pb.Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	switch {
+	case event.Type == EventPacketSample:
+		if event.Metadata["verdict"] != "drop" {
+			return
+		}
+		weight := 1.0
+		fmt.Sscan(event.Metadata["sample_rate"], &weight)
+		for _, period := range r.periods {
+			if _, counted := period.BlockedSources[event.Source]; counted || len(period.BlockedSources) < reportSourceLimit {
+				period.BlockedSources[event.Source] += weight
+			} else {
+				period.OtherSources += weight
+			}
+		}
+	case reportRuleChangeEvents[event.Type], reportAutoBlockEvents[event.Type]:
+		listed := ReportEvent{Time: time.Unix(event.Timestamp, 0), Type: event.Type, RuleID: event.RuleId, Message: event.Message}
+		for _, period := range r.periods {
+			if len(period.RuleChanges)+len(period.AutoBlocks) >= reportEventLimit {
+				period.Omitted++
+			} else if reportAutoBlockEvents[event.Type] {
+				period.AutoBlocks = append(period.AutoBlocks, listed)
+			} else {
+				period.RuleChanges = append(period.RuleChanges, listed)
+			}
+		}
+	}
+}
+
+// Digest builds the digest of a schedule's open period up to end
+func (r *Reporter) Digest(schedule string, end time.Time) (*Digest, error) {
+	r.mutex.Lock()
+	period := r.periods[schedule]
+	if period == nil {
+		r.mutex.Unlock()
+		return nil, fmt.Errorf("no %s digest is scheduled", schedule)
+	}
+	digest := &Digest{Schedule: schedule, Start: period.Start, End: end, Omitted: period.Omitted}
+	digest.RuleChanges = append(digest.RuleChanges, period.RuleChanges...)
+	digest.AutoBlocks = append(digest.AutoBlocks, period.AutoBlocks...)
+	digest.OtherSources = uint64(period.OtherSources)
+	for source, packets := range period.BlockedSources {
+		digest.TopSources = append(digest.TopSources, SourceCount{Source: source, Packets: uint64(packets)})
+	}
+	r.mutex.Unlock()
+
+	sort.Slice(digest.TopSources, func(i, j int) bool {
+		if digest.TopSources[i].Packets != digest.TopSources[j].Packets {
+			return digest.TopSources[i].Packets > digest.TopSources[j].Packets
+		}
+		return digest.TopSources[i].Source < digest.TopSources[j].Source
+	})
+	if len(digest.TopSources) > reportTopSources {
+		for _, source := range digest.TopSources[reportTopSources:] {
+			digest.OtherSources += source.Packets
+		}
+		digest.TopSources = digest.TopSources[:reportTopSources]
+	}
+
+	digest.Host, _ = os.Hostname()
+	if history := r.server.history; history != nil {
+		_, points, err := history.Query(digest.Start, end, "hour")
+		if err != nil {
+			return nil, err
+		}
+		for _, point := range points {
+			digest.Traffic.merge(point)
+		}
+	}
+
+	s := r.server
+	s.mutex.RLock()
+	digest.Rules = len(s.rules)
+	digest.PolicyVersion = s.compiledPolicy().Version
+	digest.Capacity = s.bpfManager.capacityUsage()
+	s.mutex.RUnlock()
+	return digest, nil
+}
+
+// capacityUsage reports the occupancy of the rule tables and the flow table
+func (bm *BPFMapManager) capacityUsage() []CapacityUsage {
+	if bm == nil || bm.simulated {
+		return nil
+	}
+	var usage []CapacityUsage
+	tables := []struct {
+		name  string
+		table *ruleSlotTable
+	}{
+		{"ingress IPv4 rule slots", bm.rules},
+		{"ingress IPv6 rule slots", bm.rules6},
+		{"egress IPv4 rule slots", bm.egress},
+		{"egress IPv6 rule slots", bm.egress6},
+	}
+	for _, table := range tables {
+		if table.table != nil {
+			usage = append(usage, CapacityUsage{
+				Name:     table.name,
+				Used:     len(table.table.slots),
+				Capacity: int(table.table.rulesMap.MaxEntries()),
+			})
+		}
+	}
+	if bm.sharded() {
+		for i, family := range shardFamilies {
+			shards := CapacityUsage{Name: fmt.Sprintf("sharded ingress %s rule slots", familyName(family))}
+			for _, tables := range bm.shards.tables {
+				shards.Used += len(tables[i].slots)
+				shards.Capacity += int(tables[i].rulesMap.MaxEntries())
+			}
+			usage = append(usage, shards)
+		}
+	}
+	if capacity := bm.ConntrackCapacity(); capacity > 0 {
+		occupancy, err := bm.ConntrackOccupancy()
+		if err != nil {
+			log.Printf("⚠️  Failed to read flow table occupancy for report: %v", err)
+			return usage
+		}
+		used := 0
+		for _, count := range occupancy {
+			used += count
+		}
+		usage = append(usage, CapacityUsage{Name: "tracked flows", Used: used, Capacity: capacity})
+	}
+	return usage
+}
+
+// Render renders a digest as an email with the configured template
+func (r *Reporter) Render(digest *Digest) ([]byte, error) {
+	var body bytes.Buffer
+	if err := r.template.Execute(&body, digest); err != nil {
+		return nil, fmt.Errorf("failed to render digest: %v", err)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", r.config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(r.config.To, ", "))
+	fmt.Fprintf(&msg, "Subject: Cerberus-V %s digest %s: %d dropped\r\n",
+		digest.Schedule, digest.Start.UTC().Format(time.DateOnly), digest.Traffic.Drop)
+	fmt.Fprintf(&msg, "Date: %s\r\n", r.server.clock.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body.String(), "\n", "\r\n"))
+	return msg.Bytes(), nil
+}
+
+// sendMail delivers a message through the configured SMTP server
+func (r *Reporter) sendMail(msg []byte) error {
+	var auth smtp.Auth
+	if r.config.Username != "" {
+		host, _, _ := net.SplitHostPort(r.config.SMTPAddr)
+		auth = smtp.PlainAuth("", r.config.Username, r.config.Password, host)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(r.config.SMTPAddr, auth, r.config.From, r.config.To, msg)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(reportSMTPDeadline):
+		return fmt.Errorf("no answer from %s within %s", r.config.SMTPAddr, reportSMTPDeadline)
+	}
+}
+
+// SendNow emails the digest of a schedule's open period so far; the period
+// keeps running
+func (r *Reporter) SendNow(schedule string) error {
+	digest, err := r.Digest(schedule, r.server.clock.Now().UTC())
+	if err != nil {
+		return err
+	}
+	msg, err := r.Render(digest)
+	if err != nil {
+		return err
+	}
+	return r.send(msg)
+}
+
+// sendDue emails the digest of every schedule whose period ended and starts
+// the next period. A failed send is retried after reportRetryInterval.
+func (r *Reporter) sendDue(now time.Time) {
+	for _, schedule := range r.config.Schedules {
+		r.mutex.Lock()
+		period := r.periods[schedule]
+		end := periodEnd(schedule, period.Start, now)
+		due := !end.IsZero() && !now.Before(period.NextAttempt)
+		r.mutex.Unlock()
+		if !due {
+			continue
+		}
+
+		digest, err := r.Digest(schedule, end)
+		var msg []byte
+		if err == nil {
+			msg, err = r.Render(digest)
+		}
+		if err == nil {
+			err = r.send(msg)
+		}
+
+		r.mutex.Lock()
+		if err != nil {
+			period.NextAttempt = now.Add(reportRetryInterval)
+			r.mutex.Unlock()
+			log.Printf("⚠️  Failed to send %s digest, retrying in %s: %v", schedule, reportRetryInterval, err)
+			continue
+		}
+		r.periods[schedule] = newReportPeriod(end)
+		r.mutex.Unlock()
+		log.Printf("📧 Sent %s digest for %s to %s", schedule, digest.Start.Format(time.DateOnly), strings.Join(r.config.To, ", "))
+		if err := r.Save(); err != nil {
+			log.Printf("Failed to save report state: %v", err)
+		}
+	}
+}
+
+// Run accumulates events and sends due digests until ctx is done
+func (r *Reporter) Run(ctx context.Context) {
+	events, cancel := r.server.events.Subscribe(nil)
+	defer cancel()
+	check := time.NewTicker(reportCheckInterval)
+	defer check.Stop()
+	save := time.NewTicker(reportSaveInterval)
+	defer save.Stop()
+
+	log.Printf("📧 Reporting %s digests to %s", strings.Join(r.config.Schedules, " and "), strings.Join(r.config.To, ", "))
+	r.sendDue(r.server.clock.Now().UTC())
+	for {
+		select {
+		case <-ctx.Done():
+			if err := r.Save(); err != nil {
+				log.Printf("Failed to save report state: %v", err)
+			}
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			r.observe(event)
+		case <-check.C:
+			r.sendDue(r.server.clock.Now().UTC())
+		case <-save.C:
+			if err := r.Save(); err != nil {
+				log.Printf("Failed to save report state: %v", err)
+			}
+		}
+	}
+}
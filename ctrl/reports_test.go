@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestReporter(t *testing.T, s *Server, schedules ...string) (*Reporter, *[]string) {
+	t.Helper()
+	r, err := NewReporter(s, ReportConfig{
+		SMTPAddr:  "localhost:25",
+		From:      "cerberus@example.com",
+		To:        []string{"ops@example.com"},
+		Schedules: schedules,
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sent []string
+	r.send = func(msg []byte) error {
+		sent = append(sent, string(msg))
+		return nil
+	}
+	return r, &sent
+}
+
+func TestDailyDigestSentAtMidnight(t *testing.T) {
+	s, clock := newTestServer(time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC))
+	r, sent := newTestReporter(t, s, ReportDaily)
+
+	r.sendDue(clock.Advance(time.Hour + 59*time.Minute))
+	if len(*sent) != 0 {
+		t.Fatalf("sent %d digests before midnight", len(*sent))
+	}
+
+	r.sendDue(clock.Advance(time.Minute))
+	if len(*sent) != 1 {
+		t.Fatalf("sent %d digests at midnight, want 1", len(*sent))
+	}
+	if !strings.Contains((*sent)[0], "daily digest 2026-03-01") {
+		t.Fatalf("digest subject does not name 2026-03-01:\n%s", (*sent)[0])
+	}
+
+	r.sendDue(clock.Advance(time.Hour))
+	if len(*sent) != 1 {
+		t.Fatalf("sent %d digests an hour after midnight, want 1", len(*sent))
+	}
+}
+
+func TestWeeklyDigestSentOnMonday(t *testing.T) {
+	// 2026-03-01 is a Sunday
+	s, clock := newTestServer(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	r, sent := newTestReporter(t, s, ReportWeekly)
+
+	r.sendDue(clock.Advance(11 * time.Hour))
+	if len(*sent) != 0 {
+		t.Fatalf("sent %d digests before Monday", len(*sent))
+	}
+	r.sendDue(clock.Advance(time.Hour))
+	if len(*sent) != 1 {
+		t.Fatalf("sent %d digests on Monday, want 1", len(*sent))
+	}
+}
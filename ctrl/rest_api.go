@@ -0,0 +1,1443 @@
+// SPDX-License-Identifier: Apache-2.0
+// REST endpoints for tooling that does not speak gRPC
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"google.golang.org/grpc/status"
+)
+
+// newRESTHandler builds the HTTP mux served on restPort
+func newRESTHandler(server *Server) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte("OK - Cerberus-V Control Plane"))
+	})
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.NamespaceStatsRequest{Name: r.URL.Query().Get("namespace")}
+		stats, err := server.GetNamespaceStats(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(stats)
+	})
+
+	mux.HandleFunc("/stats/history", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.StatsHistoryRequest{Resolution: r.URL.Query().Get("resolution")}
+		for param, value := range map[string]*int64{"start": &req.Start, "end": &req.End} {
+			if raw := r.URL.Query().Get(param); raw != "" {
+				n, err := strconv.ParseInt(raw, 10, 64)
+				if err != nil {
+					http.Error(w, param+" must be a Unix timestamp", http.StatusBadRequest)
+					return
+				}
+				*value = n
+			}
+		}
+		history, err := server.GetStatsHistory(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(history)
+	})
+
+	// Metrics of site-specific extensions: GET lists every source, POST a
+	// PushExtensionStatsRequest publishes a companion binary's snapshot
+	mux.HandleFunc("/stats/extensions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetExtensionStats(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPost:
+			var req pb.PushExtensionStatsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid extension stats: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.PushExtensionStats(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/rules", func(w http.ResponseWriter, r *http.Request) {
+		// PUT replaces the whole rule set hit-lessly
+		if r.Method == http.MethodPut {
+			var req pb.ReplaceRulesRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid rules: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, err := server.ReplaceRules(r.Context(), &req)
+			if writeRefusal(w, err) {
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if !resp.Success {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		rules, err := server.GetRules(r.Context(), &pb.GetRulesRequest{LabelSelector: r.URL.Query().Get("label")})
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+		if namespace, scoped := r.URL.Query()["namespace"]; scoped {
+			filtered := &pb.RulesResponse{}
+			for _, rule := range rules.Rules {
+				if rule.Namespace == namespace[0] {
+					filtered.Rules = append(filtered.Rules, rule)
+				}
+			}
+			filtered.Count = int32(len(filtered.Rules))
+			rules = filtered
+		}
+		json.NewEncoder(w).Encode(rules)
+	})
+
+	mux.HandleFunc("/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		namespaces, _ := server.ListNamespaces(r.Context(), &pb.Empty{})
+		json.NewEncoder(w).Encode(namespaces)
+	})
+
+	mux.HandleFunc("/sriov", func(w http.ResponseWriter, r *http.Request) {
+		devices, err := server.ListSRIOVDevices(r.Context(), &pb.Empty{})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(devices)
+	})
+
+	mux.HandleFunc("/sriov/stats", func(w http.ResponseWriter, r *http.Request) {
+		vf, err := strconv.Atoi(r.URL.Query().Get("vf"))
+		if err != nil {
+			http.Error(w, "vf must be a VF index", http.StatusBadRequest)
+			return
+		}
+		stats, err := server.GetVFStats(r.Context(), &pb.VFStatsRequest{Pf: r.URL.Query().Get("pf"), Vf: int32(vf)})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(stats)
+	})
+
+	mux.HandleFunc("/offload", func(w http.ResponseWriter, r *http.Request) {
+		status, _ := server.GetOffloadStatus(r.Context(), &pb.Empty{})
+		json.NewEncoder(w).Encode(status)
+	})
+
+	mux.HandleFunc("/connections", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.ListConnectionsRequest{
+			Scope:     r.URL.Query().Get("scope"),
+			Anonymize: r.URL.Query().Get("anonymize") == "true",
+		}
+		if limit := r.URL.Query().Get("limit"); limit != "" {
+			n, err := strconv.Atoi(limit)
+			if err != nil {
+				http.Error(w, "limit must be a number", http.StatusBadRequest)
+				return
+			}
+			req.Limit = int32(n)
+		}
+		connections, err := server.ListConnections(r.Context(), req)
+		if interrupted, ok := asInterrupted(err); ok {
+			writeInterrupted(w, interrupted)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(connections)
+	})
+
+	// Flow archive aggregation, the REST form of AggregateFlows:
+	// ?group_by=src_country,dst_port&bucket_seconds=3600&limit=10
+	mux.HandleFunc("/flows/aggregate", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		req := &pb.AggregateFlowsRequest{OrderBy: query.Get("order_by"), Filter: query.Get("filter")}
+		if groupBy := query.Get("group_by"); groupBy != "" {
+			req.GroupBy = strings.Split(groupBy, ",")
+		}
+		numbers := make(map[string]int64)
+		for _, param := range []string{"ipv4_prefix_len", "ipv6_prefix_len", "bucket_seconds", "days", "limit"} {
+			if raw := query.Get(param); raw != "" {
+				n, err := strconv.ParseInt(raw, 10, 32)
+				if err != nil {
+					http.Error(w, param+" must be a number", http.StatusBadRequest)
+					return
+				}
+				numbers[param] = n
+			}
+		}
+		req.Ipv4PrefixLen, req.Ipv6PrefixLen = uint32(max(numbers["ipv4_prefix_len"], 0)), uint32(max(numbers["ipv6_prefix_len"], 0))
+		req.BucketSeconds, req.Days, req.Limit = numbers["bucket_seconds"], int32(numbers["days"]), int32(numbers["limit"])
+		aggregate, err := server.AggregateFlows(r.Context(), req)
+		if interrupted, ok := asInterrupted(err); ok {
+			writeInterrupted(w, interrupted)
+			return
+		}
+		if err != nil {
+			setErrorCode(w, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(aggregate)
+	})
+
+	// Bulk import, the REST form of the ImportRules stream. With
+	// ?format=json|yaml the body is a rule document from /rules/export and
+	// ?replace=true replaces the rule set.
+	mux.HandleFunc("/rules/import", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var resp *pb.ImportRulesResponse
+		var err error
+		if format := r.URL.Query().Get("format"); format != "" {
+			data, readErr := io.ReadAll(r.Body)
+			if readErr != nil {
+				http.Error(w, "failed to read document: "+readErr.Error(), http.StatusBadRequest)
+				return
+			}
+			doc, parseErr := parseRuleDocument(data, format)
+			if parseErr != nil {
+				http.Error(w, parseErr.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, err = server.importDocument(r.Context(), doc, r.URL.Query().Get("replace") == "true")
+		} else {
+			var req pb.ImportRulesRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid rules: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, err = server.importRules(r.Context(), req.Rules, nil, nil, req.Replace)
+		}
+		if writeRefusal(w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// iptables migration, body = iptables-save output, each rule added as
+	// by AddRule; ?ipv6=true for ip6tables-save, ?dry_run=true only
+	// translates
+	mux.HandleFunc("/rules/import/iptables", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ruleset, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read ruleset: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := server.ImportIPTables(r.Context(), &pb.ImportIPTablesRequest{
+			Ruleset: ruleset,
+			DryRun:  r.URL.Query().Get("dry_run") == "true",
+			Ipv6:    r.URL.Query().Get("ipv6") == "true",
+		})
+		if writeRefusal(w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// nftables migration, body = nft -j list ruleset output;
+	// ?dry_run=true only translates, ?replace=true replaces the rule set
+	mux.HandleFunc("/rules/import/nftables", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ruleset, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read ruleset: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := server.ImportNFTables(r.Context(), &pb.ImportNFTablesRequest{
+			Ruleset: ruleset,
+			DryRun:  r.URL.Query().Get("dry_run") == "true",
+			Replace: r.URL.Query().Get("replace") == "true",
+		})
+		if writeRefusal(w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	mux.HandleFunc("/rules/export", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := server.ExportRules(r.Context(), &pb.ExportRulesRequest{Format: r.URL.Query().Get("format")})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch resp.Format {
+		case RuleDocumentYAML:
+			w.Header().Set("Content-Type", "application/yaml")
+		case RuleDocumentNFT:
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		default:
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"cerberus-rules.%s\"", resp.Format))
+		w.Write(resp.Document)
+	})
+
+	mux.HandleFunc("/rules/search", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.SearchRulesRequest{Query: r.URL.Query().Get("q")}
+		if limit := r.URL.Query().Get("limit"); limit != "" {
+			n, err := strconv.Atoi(limit)
+			if err != nil {
+				http.Error(w, "limit must be a number", http.StatusBadRequest)
+				return
+			}
+			req.Limit = int32(n)
+		}
+		resp, _ := server.SearchRules(r.Context(), req)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Bulk priority change, {"priorities": {"<rule id>": <priority>}}
+	mux.HandleFunc("/rules/reorder", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req pb.ReorderRulesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid priorities: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := server.ReorderRules(r.Context(), &req)
+		if interrupted, ok := asInterrupted(err); ok {
+			writeInterrupted(w, interrupted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Label selector operations, e.g. POST /rules/by-label/disable?selector=tenant=acme
+	mux.HandleFunc("/rules/by-label/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		req := &pb.RulesByLabelRequest{Selector: r.URL.Query().Get("selector")}
+		var resp *pb.RulesByLabelResponse
+		var err error
+		switch strings.TrimPrefix(r.URL.Path, "/rules/by-label/") {
+		case "delete":
+			resp, err = server.DeleteRulesByLabel(r.Context(), req)
+		case "disable":
+			resp, err = server.DisableRulesByLabel(r.Context(), req)
+		case "enable":
+			resp, err = server.EnableRulesByLabel(r.Context(), req)
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if interrupted, ok := asInterrupted(err); ok {
+			writeInterrupted(w, interrupted)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	mux.HandleFunc("/rules/", func(w http.ResponseWriter, r *http.Request) {
+		ruleID := strings.TrimPrefix(r.URL.Path, "/rules/")
+		if ruleID == "" || strings.Contains(ruleID, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var rule pb.Rule
+			if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+				http.Error(w, "invalid rule: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.UpdateRule(r.Context(), &pb.UpdateRuleRequest{RuleId: ruleID, Rule: &rule})
+			writeRuleResponse(w, resp)
+		default:
+			w.Header().Set("Allow", http.MethodPut)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Named service and address objects; PUT creates or replaces one, which
+	// re-pushes every rule referencing it
+	mux.HandleFunc("/services", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListServices(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/services/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/services/")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetService(r.Context(), &pb.GetServiceRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodPut:
+			var service pb.Service
+			if err := json.NewDecoder(r.Body).Decode(&service); err != nil {
+				http.Error(w, "invalid service: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			service.Name = name
+			resp, _ := server.SetService(r.Context(), &pb.SetServiceRequest{Service: &service})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteService(r.Context(), &pb.DeleteServiceRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/addresses", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListAddressObjects(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/addresses/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/addresses/")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetAddressObject(r.Context(), &pb.GetAddressObjectRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodPut:
+			var object pb.AddressObject
+			if err := json.NewDecoder(r.Body).Decode(&object); err != nil {
+				http.Error(w, "invalid address object: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			object.Name = name
+			resp, _ := server.SetAddressObject(r.Context(), &pb.SetAddressObjectRequest{Object: &object})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteAddressObject(r.Context(), &pb.DeleteAddressObjectRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Time-boxed access for an operator host, body a TemporaryAllowRequest
+	mux.HandleFunc("/temporary-allow", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req pb.TemporaryAllowRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := server.TemporaryAllow(r.Context(), &req)
+		if writeRefusal(w, err) {
+			return
+		}
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Drop rule for the prefixes of an ASN, body a BlockASNRequest
+	mux.HandleFunc("/asn-blocks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req pb.BlockASNRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := server.BlockASN(r.Context(), &req)
+		if writeRefusal(w, err) {
+			return
+		}
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Default policy: GET lists it, PUT takes a SetDefaultPolicyRequest
+	mux.HandleFunc("/default-policy", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			stats, _ := server.GetStats(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&pb.DefaultPolicyResponse{Success: true, Policies: stats.DefaultPolicies})
+		case http.MethodPut:
+			var req pb.SetDefaultPolicyRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetDefaultPolicy(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Protection profiles: GET lists profiles and protected destinations;
+	// profiles are set with PUT and removed with DELETE on
+	// /protection/profiles/{name}, and attached with PUT {"profile": ...}
+	// and detached with DELETE on /protection/destinations/{destination}
+	mux.HandleFunc("/protection", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListProtectionProfiles(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/protection/profiles/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/protection/profiles/")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var profile pb.ProtectionProfile
+			if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+				http.Error(w, "invalid protection profile: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			profile.Name = name
+			resp, _ := server.SetProtectionProfile(r.Context(), &pb.SetProtectionProfileRequest{Profile: &profile})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteProtectionProfile(r.Context(), &pb.DeleteProtectionProfileRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/protection/destinations/", func(w http.ResponseWriter, r *http.Request) {
+		destination := strings.TrimPrefix(r.URL.Path, "/protection/destinations/")
+		if destination == "" || strings.Contains(destination, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var req pb.AttachProtectionProfileRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.Destination = destination
+			resp, _ := server.AttachProtectionProfile(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DetachProtectionProfile(r.Context(), &pb.DetachProtectionProfileRequest{Destination: destination})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Tunnels: GET lists them with their counters; PUT on /tunnels/{name}
+	// sets one and DELETE removes it
+	mux.HandleFunc("/tunnels", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListTunnels(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/tunnels/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/tunnels/")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var tunnel pb.Tunnel
+			if err := json.NewDecoder(r.Body).Decode(&tunnel); err != nil {
+				http.Error(w, "invalid tunnel: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			tunnel.Name = name
+			resp, _ := server.SetTunnel(r.Context(), &pb.SetTunnelRequest{Tunnel: &tunnel})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteTunnel(r.Context(), &pb.DeleteTunnelRequest{Name: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Multicast groups with their members and counters
+	mux.HandleFunc("/multicast", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListMulticastGroups(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// NAT64 prefixes: GET lists them with their counters; PUT on
+	// /nat64/prefixes sets one and DELETE with ?prefix= removes it.
+	// /nat64/sessions lists open sessions, ?limit= caps them.
+	mux.HandleFunc("/nat64/prefixes", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.ListNAT64Prefixes(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var prefix pb.NAT64Prefix
+			if err := json.NewDecoder(r.Body).Decode(&prefix); err != nil {
+				http.Error(w, "invalid NAT64 prefix: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetNAT64Prefix(r.Context(), &pb.SetNAT64PrefixRequest{Prefix: &prefix})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteNAT64Prefix(r.Context(), &pb.DeleteNAT64PrefixRequest{Prefix: r.URL.Query().Get("prefix")})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/nat64/sessions", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.ListNAT64SessionsRequest{}
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				http.Error(w, "invalid limit: "+value, http.StatusBadRequest)
+				return
+			}
+			req.Limit = uint32(limit)
+		}
+		resp, err := server.ListNAT64Sessions(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// NAT rules: GET lists them with their counters, POST adds one and
+	// DELETE with ?id= removes it. /nat/sessions lists open source NAT
+	// sessions, ?limit= caps them and ?rule_id= keeps those of one rule.
+	mux.HandleFunc("/nat/rules", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.ListNatRules(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPost:
+			var rule pb.NatRule
+			if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+				http.Error(w, "invalid NAT rule: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.AddNatRule(r.Context(), &pb.AddNatRuleRequest{Rule: &rule})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			value := r.URL.Query().Get("id")
+			id, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				http.Error(w, "invalid id: "+value, http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.DeleteNatRule(r.Context(), &pb.DeleteNatRuleRequest{Id: uint32(id)})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/nat/sessions", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.ListNatSessionsRequest{}
+		query := r.URL.Query()
+		if value := query.Get("limit"); value != "" {
+			limit, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				http.Error(w, "invalid limit: "+value, http.StatusBadRequest)
+				return
+			}
+			req.Limit = uint32(limit)
+		}
+		if value := query.Get("rule_id"); value != "" {
+			id, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				http.Error(w, "invalid rule_id: "+value, http.StatusBadRequest)
+				return
+			}
+			req.RuleId = uint32(id)
+		}
+		resp, err := server.ListNatSessions(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// DNS filter domain lists: GET lists them with the filter's settings
+	// and counters, PUT sets one and DELETE with ?name= removes it
+	mux.HandleFunc("/dns/lists", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.ListDomainLists(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var list pb.DomainList
+			if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+				http.Error(w, "invalid domain list: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetDomainList(r.Context(), &pb.SetDomainListRequest{List: &list})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteDomainList(r.Context(), &pb.DeleteDomainListRequest{Name: r.URL.Query().Get("name")})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// TLS fingerprint rules: GET lists them with the counters, PUT sets one
+	// and DELETE with ?name= removes it
+	mux.HandleFunc("/tls/fingerprints", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.ListTLSFingerprintRules(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var rule pb.TLSFingerprintRule
+			if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+				http.Error(w, "invalid fingerprint rule: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetTLSFingerprintRule(r.Context(), &pb.SetTLSFingerprintRuleRequest{Rule: &rule})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteTLSFingerprintRule(r.Context(), &pb.DeleteTLSFingerprintRuleRequest{Name: r.URL.Query().Get("name")})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// SYN flood mitigation: GET returns the config, the mitigated
+	// destinations and the counters, PUT replaces the config
+	mux.HandleFunc("/mitigation", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetMitigationStatus(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var config pb.MitigationConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid mitigation config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetMitigationConfig(r.Context(), &pb.SetMitigationConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Per-source rate limits: GET returns the config, the exemptions and the
+	// counters, PUT replaces the config
+	mux.HandleFunc("/source-limits", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetSourceLimitStatus(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var config pb.SourceLimitConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid source limit config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetSourceLimitConfig(r.Context(), &pb.SetSourceLimitConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Sources with the most packets dropped by the rate limits:
+	// ?limit=10&seconds=60
+	mux.HandleFunc("/source-limits/offenders", func(w http.ResponseWriter, r *http.Request) {
+		numbers := make(map[string]int64)
+		for _, param := range []string{"limit", "seconds"} {
+			if raw := r.URL.Query().Get(param); raw != "" {
+				n, err := strconv.ParseInt(raw, 10, 32)
+				if err != nil {
+					http.Error(w, param+" must be a number", http.StatusBadRequest)
+					return
+				}
+				numbers[param] = n
+			}
+		}
+		resp, _ := server.ListTopOffenders(r.Context(), &pb.ListTopOffendersRequest{Limit: int32(numbers["limit"]), Seconds: numbers["seconds"]})
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Exemptions: POST {"address": ..., "ttl_seconds": ..., "reason": ...}
+	// exempts a source, DELETE ?address= ends its exemption
+	mux.HandleFunc("/source-limits/exemptions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var req pb.ExemptSourceRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid source exemption: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.ExemptSource(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteSourceExemption(r.Context(), &pb.DeleteSourceExemptionRequest{Address: r.URL.Query().Get("address")})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Automatic blocking: GET returns the config and the blocks in effect,
+	// PUT replaces the config
+	mux.HandleFunc("/auto-block", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetAutoBlockStatus(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var config pb.AutoBlockConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid auto-block config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetAutoBlockConfig(r.Context(), &pb.SetAutoBlockConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// DELETE ?address= releases the automatic block of a source
+	mux.HandleFunc("/auto-block/blocks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.Header().Set("Allow", "DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, _ := server.ReleaseAutoBlock(r.Context(), &pb.ReleaseAutoBlockRequest{Address: r.URL.Query().Get("address")})
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Replay: POST {"start": ..., "end": ..., "config": {...}} replays the
+	// archived events of a window through automatic blocking
+	mux.HandleFunc("/auto-block/replay", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req pb.ReplayEventsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid replay request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, _ := server.ReplayEvents(r.Context(), &req)
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Port scan detection: GET returns the config and the sources scanning,
+	// PUT replaces the config
+	mux.HandleFunc("/port-scan", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetPortScanStatus(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var config pb.PortScanConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid port scan config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetPortScanConfig(r.Context(), &pb.SetPortScanConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Packet normalization: GET returns the config and the packets dropped
+	// by reason, PUT replaces the config
+	mux.HandleFunc("/normalization", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.GetNormalizationStatus(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPut:
+			var config pb.NormalizationConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid normalization config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.SetNormalizationConfig(r.Context(), &pb.SetNormalizationConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Interface configs: GET lists them with their counters; PUT on
+	// /interfaces/{name} sets one and DELETE removes it
+	mux.HandleFunc("/interfaces", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ListInterfaceConfigs(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/interfaces/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/interfaces/")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var config pb.InterfaceConfig
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				http.Error(w, "invalid interface config: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			config.Interface = name
+			resp, _ := server.SetInterfaceConfig(r.Context(), &pb.SetInterfaceConfigRequest{Config: &config})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.DeleteInterfaceConfig(r.Context(), &pb.DeleteInterfaceConfigRequest{Interface: name})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// IP sets: POST creates one, entries are added with POST and removed
+	// with DELETE on /ipsets/{id}/entries, body {"addresses": [...]}
+	mux.HandleFunc("/ipsets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ := server.ListIPSets(r.Context(), &pb.Empty{})
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		case http.MethodPost:
+			var set pb.IPSet
+			if err := json.NewDecoder(r.Body).Decode(&set); err != nil {
+				http.Error(w, "invalid IP set: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ := server.CreateIPSet(r.Context(), &pb.CreateIPSetRequest{Set: &set})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/ipsets/", func(w http.ResponseWriter, r *http.Request) {
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/ipsets/"), "/")
+		id, err := strconv.ParseUint(path[0], 10, 32)
+		if err != nil || len(path) > 2 || (len(path) == 2 && path[1] != "entries") {
+			http.NotFound(w, r)
+			return
+		}
+
+		if len(path) == 1 {
+			if r.Method != http.MethodDelete {
+				w.Header().Set("Allow", "DELETE")
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			resp, _ := server.DeleteIPSet(r.Context(), &pb.DeleteIPSetRequest{SetId: uint32(id)})
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+			return
+		}
+
+		var req pb.IPSetEntriesRequest
+		if r.Method == http.MethodPost || r.Method == http.MethodDelete {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid entries: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		req.SetId = uint32(id)
+		switch r.Method {
+		case http.MethodPost:
+			resp, _ := server.AddToIPSet(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		case http.MethodDelete:
+			resp, _ := server.RemoveFromIPSet(r.Context(), &req)
+			writeObjectResponse(w, resp.Success, resp.Message, resp)
+		default:
+			w.Header().Set("Allow", "POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Threat intelligence feeds and the IP sets they maintain
+	mux.HandleFunc("/feeds", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, _ := server.ListFeeds(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Policy federation: the exported baseline and the sites reporting on
+	// it, or this site's installed baseline and overrides
+	mux.HandleFunc("/federation", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, _ := server.GetFederationStatus(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Scheduled GeoIP and ASN database downloads; POST
+	// /databases/rollback?name=geoip|asn puts the previous version back
+	mux.HandleFunc("/databases", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, _ := server.ListDatabaseUpdates(r.Context(), &pb.Empty{})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/databases/rollback", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		resp, err := server.RollbackDatabase(r.Context(), &pb.RollbackDatabaseRequest{Name: r.URL.Query().Get("name")})
+		if writeRefusal(w, err) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	mux.HandleFunc("/docs", func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		doc, err := server.GenerateDocs(format)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if format == DocFormatHTML {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		} else {
+			w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		}
+		w.Write(doc)
+	})
+
+	// Per-tenant usage records for chargeback
+	mux.HandleFunc("/usage", func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		usage, err := server.ExportUsage(format)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if format == UsageFormatCSV {
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.Write(usage)
+	})
+
+	// Shadowed, redundant and conflicting entries of the running policy, or
+	// of a PolicyDocument POSTed as JSON
+	mux.HandleFunc("/rules/analyze", func(w http.ResponseWriter, r *http.Request) {
+		req := &pb.AnalyzeRulesRequest{}
+		if r.Method == http.MethodPost {
+			req.Policy = &pb.PolicyDocument{}
+			if err := json.NewDecoder(r.Body).Decode(req.Policy); err != nil {
+				http.Error(w, "invalid policy document: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		resp, _ := server.AnalyzeRules(r.Context(), req)
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Valid {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Shadow enforcement: GET reports where the candidate disagrees with the
+	// active policy, POST starts a PolicyDocument candidate, DELETE stops it
+	mux.HandleFunc("/shadow-policy", func(w http.ResponseWriter, r *http.Request) {
+		var resp *pb.ShadowPolicyReport
+		switch r.Method {
+		case http.MethodGet:
+			resp, _ = server.GetShadowPolicyReport(r.Context(), &pb.Empty{})
+		case http.MethodPost:
+			var policy pb.PolicyDocument
+			if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+				http.Error(w, "invalid policy document: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ = server.StartShadowPolicy(r.Context(), &pb.StartShadowPolicyRequest{Policy: &policy})
+		case http.MethodDelete:
+			resp, _ = server.StopShadowPolicy(r.Context(), &pb.Empty{})
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeObjectResponse(w, resp.Success, resp.Message, resp)
+	})
+
+	// Digest of the running report period: GET previews it, POST emails it
+	mux.HandleFunc("/reports/digest", func(w http.ResponseWriter, r *http.Request) {
+		if server.reporter == nil {
+			http.Error(w, "reporting is not enabled", http.StatusNotFound)
+			return
+		}
+		schedule := r.URL.Query().Get("schedule")
+		if schedule == "" {
+			schedule = ReportDaily
+		}
+		if r.Method == http.MethodPost {
+			if err := server.reporter.SendNow(schedule); err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		digest, err := server.reporter.Digest(schedule, server.clock.Now().UTC())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		msg, err := server.reporter.Render(digest)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write(msg)
+	})
+
+	// SLIs, error budgets and burn rates of the enforcement path
+	mux.HandleFunc("/slo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(server.slo.Status())
+	})
+
+	mux.HandleFunc("/degradation", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(server.degradation.Status())
+	})
+
+	// Bloom filter pre-check of a data plane
+	mux.HandleFunc("/bloom", func(w http.ResponseWriter, r *http.Request) {
+		status, err := server.BloomStatus(r.URL.Query().Get("scope"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(status)
+	})
+
+	// Ingress rule shards of the host data plane; POST to rebalance them
+	mux.HandleFunc("/shards", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			if _, err := server.RebalanceShards(); err != nil {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(server.ShardStatus())
+	})
+
+	// Tail-call pipeline of a data plane; POST an UpdatePipelineRequest to
+	// enable, disable or upgrade a stage
+	mux.HandleFunc("/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		var resp *pb.PipelineResponse
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			resp, err = server.GetPipeline(r.Context(), &pb.GetPipelineRequest{Scope: r.URL.Query().Get("scope")})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+		case http.MethodPost:
+			var req pb.UpdatePipelineRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid pipeline update: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, err = server.UpdatePipeline(r.Context(), &req)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// Intermediate stages of the running compiled policy, for debugging
+	mux.HandleFunc("/compiled", func(w http.ResponseWriter, r *http.Request) {
+		compiled := server.compiledPolicy()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("stage") {
+		case "":
+			json.NewEncoder(w).Encode(compiled)
+		case "resolved":
+			json.NewEncoder(w).Encode(compiled.Resolved)
+		case "expanded":
+			json.NewEncoder(w).Encode(compiled.Expanded)
+		case "sorted":
+			json.NewEncoder(w).Encode(compiled.Sorted)
+		case "encoded":
+			json.NewEncoder(w).Encode(compiled.Encoded)
+		default:
+			http.Error(w, "unknown stage, expected resolved|expanded|sorted|encoded", http.StatusBadRequest)
+		}
+	})
+
+	// Why a recently sampled packet was dropped, by PACKET_SAMPLE event ID
+	mux.HandleFunc("/explain", func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := server.ExplainVerdict(r.Context(), &pb.ExplainVerdictRequest{EventId: r.URL.Query().Get("event_id")})
+		w.Header().Set("Content-Type", "application/json")
+		if !resp.Success {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	// The error catalog: codes with their remediation, all or one by code
+	mux.HandleFunc("/errors", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(catalogEntries())
+	})
+	mux.HandleFunc("/errors/", func(w http.ResponseWriter, r *http.Request) {
+		entry, ok := errorCatalog[strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/errors/"))]
+		if !ok {
+			http.Error(w, "unknown error code", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(entry)
+	})
+
+	mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		release, err := server.limits.acquire(ResourceSubscribers)
+		if err != nil {
+			writeRefusal(w, err)
+			return
+		}
+		defer release()
+		serveEvents(w, r, server.events, server.anonymizer)
+	})
+
+	return mux
+}
+
+// serveEvents streams events as Server-Sent Events. An optional
+// comma-separated types query parameter filters by event type and filter
+// by a filter expression; anonymize=true anonymizes them.
+func serveEvents(w http.ResponseWriter, r *http.Request, bus *EventBus, anonymizer *Anonymizer) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	var types []string
+	if filter := r.URL.Query().Get("types"); filter != "" {
+		types = strings.Split(filter, ",")
+	}
+	anonymize := anonymizer.applies(r.URL.Query().Get("anonymize") == "true")
+	var filterAnonymizer *Anonymizer
+	if anonymize {
+		filterAnonymizer = anonymizer
+	}
+	filter, err := compileEventFilter(r.URL.Query().Get("filter"), filterAnonymizer)
+	if err != nil {
+		setErrorCode(w, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	events, cancel := bus.SubscribeFiltered(types, filter)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if anonymize {
+				event = anonymizer.event(event)
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
+
+// writeRuleResponse maps a RuleResponse to an HTTP status and JSON body
+func writeRuleResponse(w http.ResponseWriter, resp *pb.RuleResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Success {
+		if resp.Message == "Rule not found" {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// writeObjectResponse writes the response of a service or address object
+// call; a missing object is 404, any other failure 400
+func writeObjectResponse(w http.ResponseWriter, success bool, message string, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if !success {
+		if strings.HasSuffix(message, "not found") {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Ring buffer fan-out: one reader per data plane ring buffer, its records
+// delivered to every in-process consumer of the ring
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/ringbuf"
+)
+
+// ringConsumerBufferSize is the records buffered per consumer before new
+// ones are discarded
+const ringConsumerBufferSize = 1024
+
+// RingBus reads each ring buffer of a data plane with a single reader and
+// fans its records out to consumers. Like the EventBus, delivery never
+// blocks: a consumer that falls behind loses records, counted as
+// discarded, rather than stalling the ring for the others.
+type RingBus struct {
+	mutex  sync.Mutex
+	rings  map[string]*ringFanout
+	closed bool
+}
+
+// ringFanout is the reader of one ring buffer and its consumers
+type ringFanout struct {
+	reader    *ringbuf.Reader
+	consumers map[*ringConsumer]bool
+	read      uint64 // Records read from the ring
+}
+
+type ringConsumer struct {
+	name      string
+	records   chan []byte
+	delivered uint64
+	discarded uint64
+}
+
+// RingConsumerLag is how far one consumer of a ring buffer is behind
+type RingConsumerLag struct {
+	Ring      string
+	Consumer  string
+	Queued    int    // Records read from the ring, not yet taken by the consumer
+	Delivered uint64 // Records handed to the consumer
+	Discarded uint64 // Records lost because the consumer's buffer was full
+}
+
+// NewRingBus creates a ring bus without readers
+func NewRingBus() *RingBus {
+	return &RingBus{rings: make(map[string]*ringFanout)}
+}
+
+// Consume registers a consumer of a ring buffer map, opening the ring's
+// reader for its first consumer. Records are the raw samples of the ring,
+// shared between consumers and so read-only. The returned channel is
+// closed by the cancel function or by Close; the reader closes with its
+// last consumer.
+func (b *RingBus) Consume(ring string, ringMap *ebpf.Map, consumer string) (<-chan []byte, func(), error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return nil, nil, fmt.Errorf("ring bus is closed")
+	}
+	fanout := b.rings[ring]
+	if fanout == nil {
+		reader, err := ringbuf.NewReader(ringMap)
+		if err != nil {
+			return nil, nil, err
+		}
+		fanout = &ringFanout{reader: reader, consumers: make(map[*ringConsumer]bool)}
+		b.rings[ring] = fanout
+		go b.read(ring, fanout)
+	}
+	sub := &ringConsumer{name: consumer, records: make(chan []byte, ringConsumerBufferSize)}
+	fanout.consumers[sub] = true
+
+	cancel := func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		if !fanout.consumers[sub] {
+			return
+		}
+		delete(fanout.consumers, sub)
+		close(sub.records)
+		if len(fanout.consumers) == 0 && b.rings[ring] == fanout {
+			delete(b.rings, ring)
+			fanout.reader.Close()
+		}
+	}
+	return sub.records, cancel, nil
+}
+
+// read delivers the records of one ring until its reader is closed
+func (b *RingBus) read(ring string, fanout *ringFanout) {
+	for {
+		record, err := fanout.reader.Read()
+		if err != nil {
+			if !errors.Is(err, ringbuf.ErrClosed) {
+				log.Printf("Failed to read ring buffer %s: %v", ring, err)
+			}
+			return
+		}
+
+		b.mutex.Lock()
+		fanout.read++
+		for sub := range fanout.consumers {
+			select {
+			case sub.records <- record.RawSample:
+				sub.delivered++
+			default:
+				// Consumer is not keeping up
+				sub.discarded++
+			}
+		}
+		b.mutex.Unlock()
+	}
+}
+
+// Lag returns the state of every consumer, ordered by ring and consumer
+func (b *RingBus) Lag() []RingConsumerLag {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var lags []RingConsumerLag
+	for ring, fanout := range b.rings {
+		for sub := range fanout.consumers {
+			lags = append(lags, RingConsumerLag{
+				Ring:      ring,
+				Consumer:  sub.name,
+				Queued:    len(sub.records),
+				Delivered: sub.delivered,
+				Discarded: sub.discarded,
+			})
+		}
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].Ring != lags[j].Ring {
+			return lags[i].Ring < lags[j].Ring
+		}
+		return lags[i].Consumer < lags[j].Consumer
+	})
+	return lags
+}
+
+// RecordsRead returns the records read from each ring with consumers
+func (b *RingBus) RecordsRead() map[string]uint64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	read := make(map[string]uint64, len(b.rings))
+	for ring, fanout := range b.rings {
+		read[ring] = fanout.read
+	}
+	return read
+}
+
+// Close closes every reader and ends every consumer
+func (b *RingBus) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.closed = true
+	for ring, fanout := range b.rings {
+		fanout.reader.Close()
+		for sub := range fanout.consumers {
+			close(sub.records)
+		}
+		delete(b.rings, ring)
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Per-rule hit counters: per-CPU packets, bytes and last hit of each rule slot
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cilium/ebpf"
+)
+
+// Pinned hit counter maps, one per rule map (must match eBPF program)
+const (
+	HitsMapName        = "cerberus_hits4"
+	Hits6MapName       = "cerberus_hits6"
+	EgressHitsMapName  = "cerberus_ohits4"
+	Egress6HitsMapName = "cerberus_ohits6"
+)
+
+// ruleHitsValue mirrors struct rule_hits in ebpf/xdp_filter.c
+type ruleHitsValue struct {
+	Packets uint64
+	Bytes   uint64
+	LastHit uint64 // CLOCK_MONOTONIC nanoseconds, 0 = never
+}
+
+// RuleHits are the counters of one rule summed over its data plane entries
+type RuleHits struct {
+	Packets uint64    `json:"packets"`
+	Bytes   uint64    `json:"bytes"`
+	LastHit time.Time `json:"last_hit"` // Zero when the rule never matched
+}
+
+func (h *RuleHits) add(value ruleHitsValue, wall time.Time, now uint64) {
+	h.Packets += value.Packets
+	h.Bytes += value.Bytes
+	if value.LastHit == 0 || value.LastHit > now {
+		return
+	}
+	lastHit := wall.Add(-time.Duration(now - value.LastHit))
+	if lastHit.After(h.LastHit) {
+		h.LastHit = lastHit
+	}
+}
+
+func ruleHitsMapName(family int, egress bool) string {
+	switch {
+	case egress && family == familyIPv6:
+		return Egress6HitsMapName
+	case egress:
+		return EgressHitsMapName
+	case family == familyIPv6:
+		return Hits6MapName
+	default:
+		return HitsMapName
+	}
+}
+
+// openHits opens the table's pinned hit counters and zeroes them, as the
+// slots were just cleared. Programs predating the counters have none.
+func (t *ruleSlotTable) openHits(pinPath string, egress bool) {
+	path := filepath.Join(pinPath, ruleHitsMapName(t.family, egress))
+	hits, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Rule hit counters disabled: %s: %v", path, err)
+		return
+	}
+	if hits.Type() != ebpf.PerCPUArray || hits.MaxEntries() != t.rulesMap.MaxEntries() {
+		log.Printf("⚠️  Rule hit counters disabled: %s does not match the control plane layout", path)
+		hits.Close()
+		return
+	}
+	t.hits = hits
+
+	for slot := uint32(0); slot < hits.MaxEntries(); slot++ {
+		if err := t.resetHits(slot); err != nil {
+			log.Printf("⚠️  %v", err)
+			return
+		}
+	}
+}
+
+// resetHits zeroes the counters of a slot before a new entry takes it
+func (t *ruleSlotTable) resetHits(slot uint32) error {
+	if t.hits == nil {
+		return nil
+	}
+	zero := make([]ruleHitsValue, ebpf.MustPossibleCPU())
+	if err := t.hits.Put(&slot, zero); err != nil {
+		return fmt.Errorf("failed to reset hit counters of slot %d: %v", slot, err)
+	}
+	return nil
+}
+
+// addHits adds the counters of every occupied slot to hits, by rule ID;
+// wall is the clock time at monotonic time now
+func (t *ruleSlotTable) addHits(hits map[string]*RuleHits, wall time.Time, now uint64) error {
+	if t.hits == nil {
+		return nil
+	}
+	for id, slot := range t.slots {
+		var values []ruleHitsValue
+		if err := t.hits.Lookup(&slot, &values); err != nil {
+			return fmt.Errorf("failed to read hit counters of %s: %v", id, err)
+		}
+		ruleID := entryRuleID(id)
+		if hits[ruleID] == nil {
+			hits[ruleID] = &RuleHits{}
+		}
+		for _, value := range values {
+			hits[ruleID].add(value, wall, now)
+		}
+		hits[ruleID].add(t.carried[id], wall, now)
+	}
+	return nil
+}
+
+// carryHits keeps the counters an entry collected in a slot it is leaving
+func (t *ruleSlotTable) carryHits(id string, slot uint32) error {
+	if t.hits == nil {
+		return nil
+	}
+	var values []ruleHitsValue
+	if err := t.hits.Lookup(&slot, &values); err != nil {
+		return fmt.Errorf("failed to read hit counters of %s: %v", id, err)
+	}
+	if t.carried == nil {
+		t.carried = make(map[string]ruleHitsValue)
+	}
+	carried := t.carried[id]
+	for _, value := range values {
+		carried.Packets += value.Packets
+		carried.Bytes += value.Bytes
+		if value.LastHit > carried.LastHit {
+			carried.LastHit = value.LastHit
+		}
+	}
+	t.carried[id] = carried
+	return nil
+}
+
+// entryRuleID returns the rule a compiled entry was resolved from
+func entryRuleID(entryID string) string {
+	id, _, _ := strings.Cut(entryID, "#")
+	return id
+}
+
+// RuleHits returns the hit counters of the rules entered in this data plane
+// by rule ID. Rules that did not match yet have zero counters; simulated
+// data planes count nothing.
+func (bm *BPFMapManager) RuleHits() (map[string]*RuleHits, error) {
+	hits := make(map[string]*RuleHits)
+	wall, now := bm.clock.Now(), monotonicNow()
+	tables := append([]*ruleSlotTable{bm.rules, bm.rules6, bm.egress, bm.egress6}, bm.shardTables()...)
+	for _, table := range tables {
+		if table == nil {
+			continue
+		}
+		if err := table.addHits(hits, wall, now); err != nil {
+			return nil, err
+		}
+	}
+	return hits, nil
+}
+
+// ruleHits returns the hit counters of every rule across the data planes
+// enforcing them. Caller must hold s.mutex.
+func (s *Server) ruleHits() map[string]*RuleHits {
+	hits := make(map[string]*RuleHits)
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		scopeHits, err := manager.RuleHits()
+		if err != nil {
+			log.Printf("Failed to read rule hit counters: %v", err)
+			continue
+		}
+		for id, ruleHits := range scopeHits {
+			hits[id] = ruleHits
+		}
+	}
+	return hits
+}
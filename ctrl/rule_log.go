@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule logging: ring buffer consumers turning packets matched by rules
+// with the log flag into events and counters
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Pinned rule log maps (must match eBPF program)
+const (
+	LogRateMapName   = "cerberus_log_rate"
+	LogEventsMapName = "cerberus_log_events"
+)
+
+// EventRuleLog carries one packet matched by a logged rule
+const EventRuleLog = "RULE_LOG"
+
+// Logged packets, 1 in N, overridden with CERBERUS_LOG_SAMPLE_RATE
+const DefaultLogSampleRate = 1
+
+// ruleLogEvent mirrors struct log_event in ebpf/xdp_filter.c
+type ruleLogEvent struct {
+	Timestamp  uint64 // CLOCK_MONOTONIC nanoseconds
+	LogID      uint32
+	SampleRate uint32
+	Bytes      uint32
+	Key        ConntrackKey
+	Verdict    uint8 // XDP action
+	Egress     uint8 // Matched an outbound rule
+	Pad        [2]uint8
+	// Policy version installed when the packet was logged, 0 = unknown
+	PolicyVersion uint64
+}
+
+// ruleLogCount keys the logged packet counters
+type ruleLogCount struct {
+	RuleID  string
+	Verdict string
+}
+
+// RuleLogger reads the packets the data plane logs for rules with the log
+// flag and publishes each as a RULE_LOG event
+type RuleLogger struct {
+	server  *Server
+	manager *BPFMapManager
+	rate    uint32 // 1 in N matched packets is logged
+
+	mutex   sync.Mutex
+	ruleIDs map[uint32]string       // Rule IDs by log ID, rebuilt on a miss
+	counts  map[ruleLogCount]uint64 // Logged packets, not weighted by the rate
+}
+
+// NewRuleLogger creates a logger for a data plane logging one in rate
+// matched packets
+func NewRuleLogger(server *Server, manager *BPFMapManager, rate uint32) *RuleLogger {
+	return &RuleLogger{
+		server:  server,
+		manager: manager,
+		rate:    rate,
+		ruleIDs: make(map[uint32]string),
+		counts:  make(map[ruleLogCount]uint64),
+	}
+}
+
+// logSampleRateFromEnv reads the log sample rate
+func logSampleRateFromEnv() (uint32, error) {
+	value := os.Getenv("CERBERUS_LOG_SAMPLE_RATE")
+	if value == "" {
+		return DefaultLogSampleRate, nil
+	}
+	rate, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || rate == 0 {
+		return 0, fmt.Errorf("invalid CERBERUS_LOG_SAMPLE_RATE %q, expected a positive integer", value)
+	}
+	return uint32(rate), nil
+}
+
+// ruleLogID is the ID a rule's matched packets are logged under
+func ruleLogID(ruleID string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(ruleID))
+	if id := hash.Sum32(); id != 0 {
+		return id
+	}
+	return 1
+}
+
+// Run publishes logged packets until ctx is done. It returns at once when
+// the data plane has no rule log maps.
+func (rl *RuleLogger) Run(ctx context.Context) {
+	if rl.manager == nil || rl.manager.logRate == nil || rl.manager.logEvents == nil {
+		return
+	}
+	key := uint32(0)
+	if err := rl.manager.logRate.Update(&key, &rl.rate, ebpf.UpdateAny); err != nil {
+		log.Printf("Failed to set rule log rate: %v", err)
+	}
+
+	// Counting and publishing are separate consumers of the ring, so a
+	// slow event bus does not cost the counters records
+	counted, cancelCount, err := rl.manager.rings.Consume(LogEventsMapName, rl.manager.logEvents, "rule-log-metrics")
+	if err != nil {
+		log.Printf("Failed to open rule log ring buffer: %v", err)
+		return
+	}
+	published, cancelPublish, err := rl.manager.rings.Consume(LogEventsMapName, rl.manager.logEvents, "rule-log-events")
+	if err != nil {
+		cancelCount()
+		log.Printf("Failed to open rule log ring buffer: %v", err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		cancelCount()
+		cancelPublish()
+	}()
+
+	go rl.count(counted)
+	rl.publish(published)
+}
+
+// count tallies logged packets by rule and verdict until the consumer is
+// cancelled
+func (rl *RuleLogger) count(records <-chan []byte) {
+	for record := range records {
+		var logged ruleLogEvent
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &logged); err != nil {
+			log.Printf("Malformed rule log: %v", err)
+			continue
+		}
+		ruleID := rl.ruleID(logged.LogID)
+		rl.mutex.Lock()
+		rl.counts[ruleLogCount{RuleID: ruleID, Verdict: xdpVerdictName(logged.Verdict)}]++
+		rl.mutex.Unlock()
+	}
+}
+
+// publish turns logged packets into RULE_LOG events until the consumer is
+// cancelled
+func (rl *RuleLogger) publish(records <-chan []byte) {
+	for record := range records {
+		var logged ruleLogEvent
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &logged); err != nil {
+			continue // Reported by count
+		}
+		rl.server.events.Publish(logged.event(rl.ruleID(logged.LogID), rl.server.clock.Now(), monotonicNow()))
+	}
+}
+
+// ruleID resolves a log ID to the ID of the logged rule, empty when no
+// logged rule has it, e.g. after the rule was deleted
+func (rl *RuleLogger) ruleID(logID uint32) string {
+	rl.mutex.Lock()
+	id, known := rl.ruleIDs[logID]
+	rl.mutex.Unlock()
+	if known {
+		return id
+	}
+
+	ruleIDs := make(map[uint32]string)
+	rl.server.mutex.RLock()
+	for id, rule := range rl.server.rules {
+		if rule.Log {
+			ruleIDs[ruleLogID(id)] = id
+		}
+	}
+	rl.server.mutex.RUnlock()
+
+	rl.mutex.Lock()
+	rl.ruleIDs = ruleIDs
+	rl.mutex.Unlock()
+	return ruleIDs[logID]
+}
+
+// Counts returns the logged packets by rule and verdict
+func (rl *RuleLogger) Counts() map[ruleLogCount]uint64 {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	counts := make(map[ruleLogCount]uint64, len(rl.counts))
+	for key, count := range rl.counts {
+		counts[key] = count
+	}
+	return counts
+}
+
+// event converts a logged packet to a RULE_LOG event; metadata carries the
+// rate to weight it by. wall is the clock time at monotonic time now.
+func (e *ruleLogEvent) event(ruleID string, wall time.Time, now uint64) *pb.Event {
+	src, dst := e.Key.addrs()
+	timestamp := wall
+	if now > e.Timestamp {
+		timestamp = timestamp.Add(-time.Duration(now - e.Timestamp))
+	}
+	direction := "inbound"
+	if e.Egress != 0 {
+		direction = "outbound"
+	}
+	verdict := xdpVerdictName(e.Verdict)
+	event := &pb.Event{
+		Type:      EventRuleLog,
+		Timestamp: timestamp.Unix(),
+		Source:    src.String(),
+		Target:    dst.String(),
+		Protocol:  protocolName(e.Key.Protocol),
+		Port:      int32(e.Key.DstPort),
+		Bytes:     int64(e.Bytes),
+		RuleId:    ruleID,
+		Message:   fmt.Sprintf("rule %s: %s %s packet", ruleID, verdict, direction),
+		Severity:  "low",
+		Metadata: map[string]string{
+			"sample_rate": strconv.FormatUint(uint64(e.SampleRate), 10),
+			"src_port":    strconv.Itoa(int(e.Key.SrcPort)),
+			"verdict":     verdict,
+			"direction":   direction,
+			"timestamp":   timestamp.Format(time.RFC3339Nano),
+		},
+	}
+	if e.PolicyVersion != 0 {
+		event.Metadata["policy_version"] = strconv.FormatUint(e.PolicyVersion, 10)
+	}
+	return event
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule validation: field-level errors and conflicting rules of equal priority
+
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// ruleFieldError is the validation error of one rule field
+type ruleFieldError struct {
+	Field   string
+	Message string
+	Code    string // Error catalog code, ErrCodeInvalidRule when empty
+}
+
+// ruleValidationError lists every invalid field of a rule
+type ruleValidationError []ruleFieldError
+
+func (e *ruleValidationError) add(field, format string, args ...interface{}) {
+	*e = append(*e, ruleFieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// addCode records a field error that the catalog entry for code explains
+func (e *ruleValidationError) addCode(code, field, format string, args ...interface{}) {
+	*e = append(*e, ruleFieldError{Field: field, Message: fmt.Sprintf(format, args...), Code: code})
+}
+
+func (e ruleValidationError) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// fieldErrors returns the field errors of a validation error for a
+// RuleResponse, nil for other errors
+func fieldErrors(err error) []*pb.FieldError {
+	validation, ok := err.(ruleValidationError)
+	if !ok {
+		return nil
+	}
+	fieldErrs := make([]*pb.FieldError, len(validation))
+	for i, fieldErr := range validation {
+		code := fieldErr.Code
+		if code == "" {
+			code = ErrCodeInvalidRule
+		}
+		fieldErrs[i] = &pb.FieldError{Field: fieldErr.Field, Message: fieldErr.Message, Code: code, Remediation: errorCatalog[code].Remediation}
+	}
+	return fieldErrs
+}
+
+// validateRuleAddress checks a rule address: empty, a bare address or a
+// CIDR without host bits set
+func validateRuleAddress(addr string) error {
+	if addr == "" {
+		return nil
+	}
+	if _, err := parseRuleAddress(addr); err != nil {
+		return err
+	}
+	if !strings.Contains(addr, "/") {
+		return nil
+	}
+	prefix, err := netip.ParsePrefix(addr)
+	if err != nil {
+		return err
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return fmt.Errorf("host bits set, expected %s", masked)
+	}
+	return nil
+}
+
+// priorityCollision returns the first rule, by ID, with the same priority
+// as rule in the same data plane that matches overlapping traffic with a
+// different action. The data plane would decide between them by rule ID
+// alone. Rules referencing different services or address objects are not
+// compared.
+func priorityCollision(rule *FirewallRule, rules map[string]*FirewallRule) *FirewallRule {
+	var colliding *FirewallRule
+	for id, other := range rules {
+		if id == rule.ID || other.Priority != rule.Priority || other.Action == rule.Action {
+			continue
+		}
+		if (colliding == nil || id < colliding.ID) && rulesOverlap(rule, other) {
+			colliding = other
+		}
+	}
+	return colliding
+}
+
+// rulesOverlap reports whether some packet can match both rules
+func rulesOverlap(a, b *FirewallRule) bool {
+	if a.Service != b.Service || a.SrcAddress != b.SrcAddress || a.DstAddress != b.DstAddress {
+		return false
+	}
+	return entriesOverlap(a, b)
+}
+
+// entriesOverlap reports whether some packet can match both entries,
+// comparing their concrete match fields only
+func entriesOverlap(a, b *FirewallRule) bool {
+	if !a.Enabled || !b.Enabled || a.Namespace != b.Namespace || a.VF != b.VF {
+		return false
+	}
+	aIngress, aEgress := ruleHooks(a)
+	bIngress, bEgress := ruleHooks(b)
+	if !(aIngress && bIngress) && !(aEgress && bEgress) {
+		return false
+	}
+
+	aFamily, bFamily := ruleFamily(a), ruleFamily(b)
+	family := aFamily
+	if family == familyAny {
+		family = bFamily
+	} else if bFamily != familyAny && bFamily != family {
+		return false
+	}
+	if family != familyAny {
+		if !rulePrefix(a.SrcIP, family).Overlaps(rulePrefix(b.SrcIP, family)) ||
+			!rulePrefix(a.DstIP, family).Overlaps(rulePrefix(b.DstIP, family)) {
+			return false
+		}
+	}
+
+	aProtocol, bProtocol := protocolToUint8(a.Protocol), protocolToUint8(b.Protocol)
+	if aProtocol != 0 && bProtocol != 0 && aProtocol != bProtocol {
+		return false
+	}
+	if !portRangesOverlap(a.SrcPort, a.SrcPortEnd, b.SrcPort, b.SrcPortEnd) ||
+		!portRangesOverlap(a.DstPort, a.DstPortEnd, b.DstPort, b.DstPortEnd) {
+		return false
+	}
+	aFlags, aMask, _ := parseTCPFlags(a.TCPFlags)
+	bFlags, bMask, _ := parseTCPFlags(b.TCPFlags)
+	if (aFlags^bFlags)&aMask&bMask != 0 {
+		return false
+	}
+	if (a.VLAN != 0 && b.VLAN != 0 && a.VLAN != b.VLAN) ||
+		(a.SrcMAC != "" && b.SrcMAC != "" && a.SrcMAC != b.SrcMAC) ||
+		(a.DstMAC != "" && b.DstMAC != "" && a.DstMAC != b.DstMAC) {
+		return false
+	}
+	if !namesOverlap(a.Interfaces, b.Interfaces) ||
+		!namesOverlap(a.SrcCountry, b.SrcCountry) || !namesOverlap(a.DstCountry, b.DstCountry) ||
+		!namesOverlap(a.SrcASN, b.SrcASN) || !namesOverlap(a.DstASN, b.DstASN) {
+		return false
+	}
+	aStates, bStates := connStateMask(a.ConnState), connStateMask(b.ConnState)
+	return aStates == 0 || bStates == 0 || aStates&bStates != 0
+}
+
+// namesOverlap reports whether two matches on lists of names share a
+// name; empty is any name
+func namesOverlap[T comparable](a, b []T) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, name := range a {
+		if slices.Contains(b, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// portRangesOverlap compares two port matches; a zero start is any port
+func portRangesOverlap(aStart, aEnd, bStart, bEnd int32) bool {
+	if aStart == 0 || bStart == 0 {
+		return true
+	}
+	if aEnd == 0 {
+		aEnd = aStart
+	}
+	if bEnd == 0 {
+		bEnd = bStart
+	}
+	return aStart <= bEnd && bStart <= aEnd
+}
+
+// validatePriority rejects a rule colliding with another of the rule set it
+// joins
+func validatePriority(rule *FirewallRule, rules map[string]*FirewallRule) error {
+	other := priorityCollision(rule, rules)
+	if other == nil {
+		return nil
+	}
+	var errs ruleValidationError
+	errs.add("priority", "priority %d collides with rule %s (%s) matching overlapping traffic, use distinct priorities",
+		rule.Priority, other.ID, other.Action)
+	return errs
+}
+
+// priorityCollisionErrors checks the given rules of a rule set for
+// collisions, reporting each colliding pair once
+func priorityCollisionErrors(rules map[string]*FirewallRule, ids []string) []string {
+	byPriority := make(map[int32]map[string]*FirewallRule)
+	for id, rule := range rules {
+		if byPriority[rule.Priority] == nil {
+			byPriority[rule.Priority] = make(map[string]*FirewallRule)
+		}
+		byPriority[rule.Priority][id] = rule
+	}
+
+	var errs []string
+	reported := make(map[[2]string]bool)
+	for _, id := range ids {
+		rule := rules[id]
+		group := byPriority[rule.Priority]
+		other := priorityCollision(rule, group)
+		if other == nil || reported[[2]string{other.ID, rule.ID}] {
+			continue
+		}
+		reported[[2]string{rule.ID, other.ID}] = true
+		errs = append(errs, fmt.Sprintf("rule %s: %v", rule.ID, validatePriority(rule, group)))
+	}
+	return errs
+}
@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: Apache-2.0
+// Packet sampling: rate controller and ring buffer reader for the XDP sampler
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Pinned sampling maps (must match eBPF program)
+const (
+	SampleRateMapName = "cerberus_sample_rate"
+	SamplesMapName    = "cerberus_samples"
+)
+
+// EventPacketSample carries one sampled packet
+const EventPacketSample = "PACKET_SAMPLE"
+
+// Sampling defaults, overridden with CERBERUS_SAMPLE_BUDGET and
+// CERBERUS_SAMPLE_MAX_RATE
+const (
+	DefaultSampleBudget  = 100   // Samples per second
+	DefaultSampleMaxRate = 65536 // Coarsest rate, 1 in N packets
+)
+
+// PacketSample mirrors struct pkt_sample in ebpf/xdp_filter.c
+type PacketSample struct {
+	Timestamp  uint64 // CLOCK_MONOTONIC nanoseconds
+	SampleRate uint32 // Rate in effect when the packet was sampled
+	Bytes      uint32
+	Key        ConntrackKey
+	Verdict    uint8 // XDP action
+	CtState    uint8 // connStateBits value of the packet
+	TCPFlags   uint8 // Byte 13 of the TCP header, 0 for other protocols
+	Pad        uint8
+	VLAN       uint16 // VLAN ID of the outer tag, 0 = untagged
+	SrcMAC     [6]byte
+	DstMAC     [6]byte
+	Pad2       [2]uint8
+	Ifindex    uint32 // Interface the packet arrived on
+	// Policy version installed when the packet was sampled, 0 = unknown
+	PolicyVersion uint64
+}
+
+// PacketSampler keeps the sample volume of the host data plane near a
+// budget. Each sample stands for SampleRate packets, so multiplying by the
+// recorded rate gives unbiased packet and byte estimates even while the
+// rate moves.
+type PacketSampler struct {
+	manager *BPFMapManager
+	events  *EventBus
+	clock   Clock
+	budget  float64 // Samples per second, 0 = sampling off
+	maxRate uint32
+
+	mutex     sync.Mutex
+	rate      uint32  // Current 1-in-N rate, 0 = off
+	pps       float64 // Packet rate the current rate was derived from
+	samples   uint64  // Samples read from the ring buffer
+	lastTotal uint64
+	lastTime  time.Time
+}
+
+// SamplingStatus is a snapshot of the sampler
+type SamplingStatus struct {
+	Budget  float64 `json:"budget"`
+	Rate    uint32  `json:"rate"`
+	PPS     float64 `json:"pps"`
+	Samples uint64  `json:"samples"`
+}
+
+// NewPacketSampler creates a sampler for a data plane with the given budget
+// in samples per second
+func NewPacketSampler(manager *BPFMapManager, events *EventBus, budget float64, maxRate uint32, clock Clock) *PacketSampler {
+	if maxRate == 0 {
+		maxRate = DefaultSampleMaxRate
+	}
+	return &PacketSampler{manager: manager, events: events, clock: clock, budget: budget, maxRate: maxRate}
+}
+
+// sampleConfigFromEnv reads the sample budget and the coarsest rate
+func sampleConfigFromEnv() (float64, uint32, error) {
+	budget, maxRate := float64(DefaultSampleBudget), uint32(DefaultSampleMaxRate)
+	if value := os.Getenv("CERBERUS_SAMPLE_BUDGET"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 {
+			return 0, 0, fmt.Errorf("invalid CERBERUS_SAMPLE_BUDGET %q, expected samples per second", value)
+		}
+		budget = parsed
+	}
+	if value := os.Getenv("CERBERUS_SAMPLE_MAX_RATE"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || parsed == 0 {
+			return 0, 0, fmt.Errorf("invalid CERBERUS_SAMPLE_MAX_RATE %q, expected a positive integer", value)
+		}
+		maxRate = uint32(parsed)
+	}
+	return budget, maxRate, nil
+}
+
+// Run adjusts the rate every interval and publishes samples until ctx is
+// done. It returns at once when the data plane has no sampling maps.
+func (ps *PacketSampler) Run(ctx context.Context, interval time.Duration) {
+	if ps.manager == nil || ps.manager.sampleRate == nil || ps.manager.samples == nil {
+		return
+	}
+	if ps.budget == 0 {
+		ps.setRate(0)
+		return
+	}
+
+	records, cancel, err := ps.manager.rings.Consume(SamplesMapName, ps.manager.samples, "sampler")
+	if err != nil {
+		log.Printf("Failed to open sample ring buffer: %v", err)
+		return
+	}
+	defer cancel()
+	go ps.readSamples(records)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	ps.adjust()
+	for {
+		select {
+		case <-ctx.Done():
+			ps.setRate(0)
+			return
+		case <-ticker.C:
+			ps.adjust()
+		}
+	}
+}
+
+// Status returns the current budget, rate and sample count
+func (ps *PacketSampler) Status() SamplingStatus {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	return SamplingStatus{Budget: ps.budget, Rate: ps.rate, PPS: ps.pps, Samples: ps.samples}
+}
+
+// adjust measures the packet rate since the last pass and retunes the
+// sampling rate to it
+func (ps *PacketSampler) adjust() {
+	stats, err := ps.manager.GetStats()
+	if err != nil {
+		log.Printf("Failed to read packet counters for sampling: %v", err)
+		return
+	}
+	total := stats.Pass + stats.Drop + stats.Redirect + stats.Error
+	now := ps.clock.Now()
+
+	ps.mutex.Lock()
+	var pps float64
+	if !ps.lastTime.IsZero() && total >= ps.lastTotal {
+		pps = float64(total-ps.lastTotal) / now.Sub(ps.lastTime).Seconds()
+	}
+	ps.lastTotal, ps.lastTime = total, now
+	ps.pps = pps
+	rate := nextSampleRate(ps.rate, pps, ps.budget, ps.maxRate)
+	ps.mutex.Unlock()
+
+	ps.setRate(rate)
+}
+
+// nextSampleRate picks the 1-in-N rate that keeps pps within budget. The
+// rate coarsens at once when traffic surges, but refines by at most half a
+// step per pass so short lulls in an attack do not flood the event bus.
+func nextSampleRate(current uint32, pps, budget float64, maxRate uint32) uint32 {
+	target := uint32(1)
+	if pps > budget {
+		target = uint32(math.Min(math.Ceil(pps/budget), float64(maxRate)))
+	}
+	if current > target && target < current/2 {
+		return current / 2
+	}
+	return target
+}
+
+// setRate writes the rate to the data plane. It is written on every pass,
+// the pinned map may hold the rate of an earlier control plane.
+func (ps *PacketSampler) setRate(rate uint32) {
+	ps.mutex.Lock()
+	previous := ps.rate
+	ps.rate = rate
+	ps.mutex.Unlock()
+
+	key := uint32(0)
+	if err := ps.manager.sampleRate.Update(&key, &rate, ebpf.UpdateAny); err != nil {
+		log.Printf("Failed to set sampling rate: %v", err)
+		return
+	}
+	if rate != previous {
+		log.Printf("📉 Sampling rate 1 in %d", rate)
+	}
+}
+
+// readSamples publishes ring buffer records until the consumer is
+// cancelled
+func (ps *PacketSampler) readSamples(records <-chan []byte) {
+	for record := range records {
+		var sample PacketSample
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &sample); err != nil {
+			log.Printf("Malformed packet sample: %v", err)
+			continue
+		}
+
+		ps.mutex.Lock()
+		ps.samples++
+		ps.mutex.Unlock()
+		ps.events.Publish(sample.event(ps.clock.Now(), monotonicNow()))
+	}
+}
+
+// event converts a sample to a PACKET_SAMPLE event; metadata carries the
+// rate to weight it by. wall is the clock time at monotonic time now.
+func (s *PacketSample) event(wall time.Time, now uint64) *pb.Event {
+	src, dst := s.Key.addrs()
+	timestamp := wall
+	if now > s.Timestamp {
+		timestamp = timestamp.Add(-time.Duration(now - s.Timestamp))
+	}
+	event := &pb.Event{
+		Type:      EventPacketSample,
+		Timestamp: timestamp.Unix(),
+		Source:    src.String(),
+		Target:    dst.String(),
+		Protocol:  protocolName(s.Key.Protocol),
+		Port:      int32(s.Key.DstPort),
+		Bytes:     int64(s.Bytes),
+		Message:   fmt.Sprintf("sampled packet (1 in %d)", s.SampleRate),
+		Severity:  "low",
+		Metadata: map[string]string{
+			"sample_rate": strconv.FormatUint(uint64(s.SampleRate), 10),
+			"src_port":    strconv.Itoa(int(s.Key.SrcPort)),
+			"verdict":     xdpVerdictName(s.Verdict),
+			"conn_state":  packetStateName(s.CtState),
+			"src_mac":     net.HardwareAddr(s.SrcMAC[:]).String(),
+			"dst_mac":     net.HardwareAddr(s.DstMAC[:]).String(),
+		},
+	}
+	if iface, err := net.InterfaceByIndex(int(s.Ifindex)); err == nil {
+		event.Interface = iface.Name
+	}
+	if s.VLAN != 0 {
+		event.Metadata["vlan"] = strconv.Itoa(int(s.VLAN))
+	}
+	if s.Key.Protocol == 6 {
+		event.Metadata["tcp_flags"] = formatTCPFlagList(s.TCPFlags)
+	}
+	if s.PolicyVersion != 0 {
+		event.Metadata["policy_version"] = strconv.FormatUint(s.PolicyVersion, 10)
+	}
+	return event
+}
+
+func xdpVerdictName(verdict uint8) string {
+	switch verdict {
+	case 0:
+		return "aborted"
+	case 1:
+		return "drop"
+	case 2:
+		return "pass"
+	case 3:
+		return "tx"
+	case 4:
+		return "redirect"
+	default:
+		return strconv.Itoa(int(verdict))
+	}
+}
+
+// packetStateName names a single connStateBits value
+func packetStateName(state uint8) string {
+	for name, bit := range connStateBits {
+		if bit == state {
+			return name
+		}
+	}
+	return "unknown"
+}
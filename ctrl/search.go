@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: Apache-2.0
+// Full-text rule search: in-memory inverted index over descriptions, owners, addresses and object names
+
+package main
+
+import (
+	"context"
+	"net/netip"
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Searchable rule fields; a query term written field:term only matches
+// that field
+const (
+	SearchFieldID          = "id"
+	SearchFieldDescription = "description"
+	SearchFieldOwner       = "owner"
+	SearchFieldAddress     = "address" // Prefixes and address object names
+	SearchFieldService     = "service"
+	SearchFieldNamespace   = "namespace" // Namespace or VF data plane
+	SearchFieldAction      = "action"
+	SearchFieldProtocol    = "protocol"
+	SearchFieldDirection   = "direction"
+	SearchFieldLabel       = "label" // Label keys and values
+
+	// Hits returned when the request sets no limit
+	DefaultSearchLimit = 50
+)
+
+var searchFields = []string{
+	SearchFieldID, SearchFieldDescription, SearchFieldOwner, SearchFieldAddress, SearchFieldService,
+	SearchFieldNamespace, SearchFieldAction, SearchFieldProtocol, SearchFieldDirection, SearchFieldLabel,
+}
+
+// searchFieldBit returns the bit of a field in a posting, 0 if unknown
+func searchFieldBit(field string) uint16 {
+	for i, name := range searchFields {
+		if name == field {
+			return 1 << i
+		}
+	}
+	return 0
+}
+
+// ruleIndex is the inverted index of one policy version: every term maps
+// to the rules containing it and the fields it appears in
+type ruleIndex struct {
+	version  uint64
+	postings map[string]map[string]uint16 // Term -> rule ID -> field bits
+	terms    []string                     // Sorted, for prefix lookups
+	prefixes map[string][]netip.Prefix    // Rule ID -> prefixes it matches
+}
+
+// buildRuleIndex indexes the rules of a policy version. Caller must hold
+// s.mutex.
+func (s *Server) buildRuleIndex(version uint64) *ruleIndex {
+	index := &ruleIndex{
+		version:  version,
+		postings: make(map[string]map[string]uint16),
+		prefixes: make(map[string][]netip.Prefix),
+	}
+	add := func(ruleID, field string, terms []string) {
+		bit := searchFieldBit(field)
+		for _, term := range terms {
+			if index.postings[term] == nil {
+				index.postings[term] = make(map[string]uint16)
+			}
+			index.postings[term][ruleID] |= bit
+		}
+	}
+
+	for id, rule := range s.rules {
+		add(id, SearchFieldID, append(searchTerms(id), strings.ToLower(id)))
+		add(id, SearchFieldDescription, searchTerms(rule.Description))
+		add(id, SearchFieldOwner, searchTerms(rule.Owner))
+		add(id, SearchFieldService, searchTerms(rule.Service))
+		add(id, SearchFieldNamespace, searchTerms(rule.Namespace))
+		add(id, SearchFieldNamespace, searchTerms(rule.VF))
+		add(id, SearchFieldAction, searchTerms(rule.Action))
+		add(id, SearchFieldProtocol, searchTerms(rule.Protocol))
+		add(id, SearchFieldDirection, searchTerms(rule.Direction))
+		for key, value := range rule.Labels {
+			add(id, SearchFieldLabel, searchTerms(key))
+			add(id, SearchFieldLabel, searchTerms(value))
+		}
+
+		// Addresses are indexed whole, as written and as resolved from
+		// address objects
+		addresses := []string{rule.SrcIP, rule.DstIP}
+		for _, name := range []string{rule.SrcAddress, rule.DstAddress} {
+			if name != "" {
+				add(id, SearchFieldAddress, searchTerms(name))
+				addresses = append(addresses, resolveAddress(s.addressObjects, name)...)
+			}
+		}
+		for _, address := range addresses {
+			if address == "" {
+				continue
+			}
+			add(id, SearchFieldAddress, []string{strings.ToLower(address)})
+			if prefix, err := parsePrefix(address); err == nil {
+				index.prefixes[id] = append(index.prefixes[id], prefix)
+			}
+		}
+	}
+
+	index.terms = make([]string, 0, len(index.postings))
+	for term := range index.postings {
+		index.terms = append(index.terms, term)
+	}
+	sort.Strings(index.terms)
+	return index
+}
+
+// parsePrefix parses a CIDR or a single address as its host prefix
+func parsePrefix(address string) (netip.Prefix, error) {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// searchTerms splits text into lower-case terms without accents. Letters
+// and digits form words in any script; scripts written without spaces
+// (Han, kana, Hangul) are split into overlapping character pairs so any
+// word inside a phrase can be found.
+func searchTerms(text string) []string {
+	var terms []string
+	var word, run []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			terms = append(terms, string(word))
+			word = word[:0]
+		}
+	}
+	flushRun := func() {
+		for i := 0; i+1 < len(run); i++ {
+			terms = append(terms, string(run[i:i+2]))
+		}
+		if len(run) > 0 {
+			terms = append(terms, string(run[len(run)-1:]))
+		}
+		run = run[:0]
+	}
+
+	var base rune
+	for _, r := range norm.NFD.String(strings.ToLower(text)) {
+		switch {
+		case unicode.Is(unicode.M, r):
+			// Accents on Latin, Greek and Cyrillic letters are dropped;
+			// marks of other scripts, such as Indic vowel signs, are part
+			// of the word
+			if !unicode.Is(unicode.Mn, r) || !unicode.In(base, unicode.Latin, unicode.Greek, unicode.Cyrillic) {
+				word = append(word, r)
+			}
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			flushWord()
+			run = append(run, r)
+			base = r
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			flushRun()
+			word = append(word, r)
+			base = r
+		default:
+			flushWord()
+			flushRun()
+			base = 0
+		}
+	}
+	flushWord()
+	flushRun()
+
+	for i, term := range terms {
+		terms[i] = norm.NFC.String(term)
+	}
+	return terms
+}
+
+// lookup returns the rules containing a term or a term it prefixes, with
+// the fields it was found in and a score: exact matches count double
+func (index *ruleIndex) lookup(term string, fields uint16) map[string]ruleMatch {
+	matches := make(map[string]ruleMatch)
+	for i := sort.SearchStrings(index.terms, term); i < len(index.terms) && strings.HasPrefix(index.terms[i], term); i++ {
+		score := 1.0
+		if index.terms[i] == term {
+			score = 2
+		}
+		for id, bits := range index.postings[index.terms[i]] {
+			if bits &= fields; bits == 0 {
+				continue
+			}
+			match := matches[id]
+			match.fields |= bits
+			match.score = max(match.score, score)
+			matches[id] = match
+		}
+	}
+	return matches
+}
+
+// covering returns the rules with a prefix overlapping the given one
+func (index *ruleIndex) covering(prefix netip.Prefix) map[string]ruleMatch {
+	matches := make(map[string]ruleMatch)
+	for id, prefixes := range index.prefixes {
+		for _, rulePrefix := range prefixes {
+			if rulePrefix.Overlaps(prefix) {
+				matches[id] = ruleMatch{fields: searchFieldBit(SearchFieldAddress), score: 2}
+				break
+			}
+		}
+	}
+	return matches
+}
+
+type ruleMatch struct {
+	fields uint16
+	score  float64
+}
+
+// search returns the rules matching every term of a query. Whitespace
+// separates terms; an address or prefix matches the rules whose
+// addresses overlap it.
+func (index *ruleIndex) search(query string) map[string]ruleMatch {
+	var result map[string]ruleMatch
+	intersect := func(matches map[string]ruleMatch) {
+		if result == nil {
+			result = matches
+			return
+		}
+		for id, match := range result {
+			other, found := matches[id]
+			if !found {
+				delete(result, id)
+				continue
+			}
+			result[id] = ruleMatch{fields: match.fields | other.fields, score: match.score + other.score}
+		}
+	}
+
+	for _, token := range strings.Fields(query) {
+		fields := ^uint16(0)
+		if field, value, scoped := strings.Cut(token, ":"); scoped && searchFieldBit(strings.ToLower(field)) != 0 {
+			fields, token = searchFieldBit(strings.ToLower(field)), value
+		}
+
+		if prefix, err := parsePrefix(token); err == nil && fields&searchFieldBit(SearchFieldAddress) != 0 {
+			intersect(index.covering(prefix))
+			continue
+		}
+		for _, term := range searchTerms(token) {
+			intersect(index.lookup(term, fields))
+		}
+	}
+	if result == nil {
+		return map[string]ruleMatch{}
+	}
+	return result
+}
+
+// ruleIndex returns the index of the running policy version, rebuilding it
+// after the policy changed. Caller must hold s.mutex.
+func (s *Server) ruleIndex() *ruleIndex {
+	version := s.compiledPolicy().Version
+	s.searchMutex.Lock()
+	defer s.searchMutex.Unlock()
+	if s.searchIndex == nil || s.searchIndex.version != version {
+		s.searchIndex = s.buildRuleIndex(version)
+	}
+	return s.searchIndex
+}
+
+// SearchRules finds rules by free text over their descriptions, owners,
+// addresses and object names. Terms match by prefix regardless of case and
+// accents, and every term must match. Hits are ordered by score, then
+// evaluation order.
+func (s *Server) SearchRules(ctx context.Context, req *pb.SearchRulesRequest) (*pb.SearchRulesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	matches := s.ruleIndex().search(req.Query)
+	found := make([]*FirewallRule, 0, len(matches))
+	for id := range matches {
+		if rule, exists := s.rules[id]; exists {
+			found = append(found, rule)
+		}
+	}
+	found = sortByPriority(found)
+	sort.SliceStable(found, func(i, j int) bool { return matches[found[i].ID].score > matches[found[j].ID].score })
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	resp := &pb.SearchRulesResponse{Count: int32(len(found))}
+	for _, rule := range found {
+		if len(resp.Hits) == limit {
+			break
+		}
+		hit := &pb.RuleSearchHit{Rule: toProtoRule(rule), Score: matches[rule.ID].score}
+		for i, field := range searchFields {
+			if matches[rule.ID].fields&(1<<i) != 0 {
+				hit.Fields = append(hit.Fields, field)
+			}
+		}
+		resp.Hits = append(resp.Hits, hit)
+	}
+	return resp, nil
+}
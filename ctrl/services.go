@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Service objects: named port/protocol bundles referenced by rules
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// ServicePort is a protocol with a single port or an inclusive port range
+type ServicePort struct {
+	Protocol  string `json:"protocol" yaml:"protocol,omitempty"`     // tcp, udp, sctp, icmp
+	PortStart int32  `json:"port_start" yaml:"port_start,omitempty"` // 0 = any
+	PortEnd   int32  `json:"port_end" yaml:"port_end,omitempty"`     // 0 = same as PortStart
+}
+
+// Service is a reusable named bundle such as "web" = tcp/80 + tcp/443
+type Service struct {
+	Name        string        `json:"name" yaml:"name,omitempty"`
+	Ports       []ServicePort `json:"ports" yaml:"ports,omitempty"`
+	Description string        `json:"description" yaml:"description,omitempty"`
+}
+
+// SetService creates or replaces a service and re-pushes every rule using it
+func (s *Server) SetService(ctx context.Context, req *pb.SetServiceRequest) (*pb.StatusResponse, error) {
+	if req.GetService() == nil {
+		return &pb.StatusResponse{Success: false, Message: "Service is required"}, nil
+	}
+
+	service := serviceFromProto(req.Service)
+	if err := validateService(service); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Service validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	users := s.rulesUsingService(service.Name)
+
+	previous := s.services[service.Name]
+	s.services[service.Name] = service
+
+	if err := s.applyPolicy(); err != nil {
+		if previous != nil {
+			s.services[service.Name] = previous
+		} else {
+			delete(s.services, service.Name)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to push rules to data plane: %v", withRemediation(err)),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Set service: %s (%d ports, %d rules updated)",
+		service.Name, len(service.Ports), len(users))
+
+	return &pb.StatusResponse{
+		Success: true,
+		Message: fmt.Sprintf("Service saved successfully, %d rules updated", len(users)),
+	}, nil
+}
+
+// GetService returns a service and the rules using it
+func (s *Server) GetService(ctx context.Context, req *pb.GetServiceRequest) (*pb.ServiceResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	service, exists := s.services[req.Name]
+	if !exists {
+		return &pb.ServiceResponse{Success: false, Message: "Service not found"}, nil
+	}
+	resp := &pb.ServiceResponse{Success: true, Message: "Service found", Service: serviceToProto(service)}
+	for _, rule := range s.rulesUsingService(req.Name) {
+		resp.RuleIds = append(resp.RuleIds, rule.ID)
+	}
+	return resp, nil
+}
+
+// DeleteService removes a service that is not referenced by any rule
+func (s *Server) DeleteService(ctx context.Context, req *pb.DeleteServiceRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.services[req.Name]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Service not found"}, nil
+	}
+
+	if users := s.rulesUsingService(req.Name); len(users) > 0 {
+		ids := make([]string, 0, len(users))
+		for _, rule := range users {
+			ids = append(ids, rule.ID)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Service is referenced by rules: %s", strings.Join(ids, ", ")),
+		}, nil
+	}
+
+	delete(s.services, req.Name)
+	s.persistPolicy()
+	log.Printf("Deleted service: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Service deleted successfully"}, nil
+}
+
+// ListServices returns all service definitions
+func (s *Server) ListServices(ctx context.Context, req *pb.Empty) (*pb.ServicesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	names := make([]string, 0, len(s.services))
+	for name := range s.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &pb.ServicesResponse{}
+	for _, name := range names {
+		resp.Services = append(resp.Services, serviceToProto(s.services[name]))
+	}
+
+	return resp, nil
+}
+
+// rulesUsingService lists rules referencing a service, ordered by ID.
+// Caller must hold s.mutex.
+func (s *Server) rulesUsingService(name string) []*FirewallRule {
+	var users []*FirewallRule
+	for _, rule := range s.rules {
+		if rule.Service == name {
+			users = append(users, rule)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func validateService(service *Service) error {
+	if service.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(service.Ports) == 0 {
+		return fmt.Errorf("service must contain at least one port entry")
+	}
+	for _, port := range service.Ports {
+		if !protocolHasPorts(port.Protocol) && port.Protocol != "icmp" {
+			return fmt.Errorf("invalid protocol: %s", port.Protocol)
+		}
+		if port.PortStart < 0 || port.PortStart > 65535 || port.PortEnd < 0 || port.PortEnd > 65535 {
+			return fmt.Errorf("port out of range: %d-%d", port.PortStart, port.PortEnd)
+		}
+		if port.PortEnd != 0 && port.PortEnd < port.PortStart {
+			return fmt.Errorf("port range end %d is below start %d", port.PortEnd, port.PortStart)
+		}
+	}
+	return nil
+}
+
+func serviceFromProto(p *pb.Service) *Service {
+	service := &Service{Name: p.Name, Description: p.Description}
+	for _, port := range p.Ports {
+		service.Ports = append(service.Ports, ServicePort{
+			Protocol:  port.Protocol,
+			PortStart: port.PortStart,
+			PortEnd:   port.PortEnd,
+		})
+	}
+	return service
+}
+
+func serviceToProto(service *Service) *pb.Service {
+	p := &pb.Service{Name: service.Name, Description: service.Description}
+	for _, port := range service.Ports {
+		p.Ports = append(p.Ports, &pb.ServicePort{
+			Protocol:  port.Protocol,
+			PortStart: port.PortStart,
+			PortEnd:   port.PortEnd,
+		})
+	}
+	return p
+}
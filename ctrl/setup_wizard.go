@@ -0,0 +1,526 @@
+// SPDX-License-Identifier: Apache-2.0
+// First-boot setup: cerberusctl init interrogates the host, asks a few
+// questions and writes a configuration file and a minimal safe starter
+// policy that the control plane restores when it starts
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/features"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// StarterLabel tags the rules of the starter policy
+	StarterLabel = "starter"
+
+	// ManagementAddressObject names the management networks in the
+	// starter policy
+	ManagementAddressObject = "management"
+)
+
+// hostInterface is a network interface as the setup wizard found it
+type hostInterface struct {
+	Name         string
+	Up           bool
+	Driver       string // Empty for virtual interfaces
+	Prefixes     []netip.Prefix
+	DefaultRoute bool
+}
+
+// hostReport is what the setup wizard found on the host
+type hostReport struct {
+	Interfaces []hostInterface
+	Kernel     string
+	BTF        bool
+	BPFFS      bool   // A BPF filesystem is mounted at the pin path
+	XDP        string // "supported", "not supported" or why it is unknown
+	DataPlane  bool   // A loaded program pinned its rules map
+	VPP        string // Path of the vpp binary, empty = not installed
+	VPPStats   bool   // VPP's stats socket exists
+}
+
+// probeHost interrogates the kernel, interfaces and VPP installation
+func probeHost(pinPath string) *hostReport {
+	report := &hostReport{Interfaces: probeInterfaces()}
+
+	var uname unix.Utsname
+	if unix.Uname(&uname) == nil {
+		report.Kernel = unix.ByteSliceToString(uname.Release[:])
+	}
+	_, err := os.Stat("/sys/kernel/btf/vmlinux")
+	report.BTF = err == nil
+	var fs unix.Statfs_t
+	report.BPFFS = unix.Statfs(pinPath, &fs) == nil && fs.Type == unix.BPF_FS_MAGIC
+	switch err := features.HaveProgramType(ebpf.XDP); {
+	case err == nil:
+		report.XDP = "supported"
+	case errors.Is(err, ebpf.ErrNotSupported):
+		report.XDP = "not supported"
+	default:
+		report.XDP = fmt.Sprintf("unknown (%v)", err)
+	}
+	_, err = os.Stat(filepath.Join(pinPath, RulesMapName))
+	report.DataPlane = err == nil
+
+	report.VPP, _ = exec.LookPath("vpp")
+	_, err = os.Stat(DefaultVPPStatsSocket)
+	report.VPPStats = err == nil
+	return report
+}
+
+// probeInterfaces lists the non-loopback interfaces with their driver,
+// addresses and whether they carry a default route
+func probeInterfaces() []hostInterface {
+	links, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	defaults := defaultRouteInterfaces()
+
+	var found []hostInterface
+	for _, link := range links {
+		if link.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		iface := hostInterface{Name: link.Name, Up: link.Flags&net.FlagUp != 0, DefaultRoute: defaults[link.Name]}
+		if driver, err := os.Readlink(filepath.Join("/sys/class/net", link.Name, "device", "driver")); err == nil {
+			iface.Driver = filepath.Base(driver)
+		}
+		addrs, _ := link.Addrs()
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok {
+				if prefix, err := netip.ParsePrefix(ipNet.String()); err == nil {
+					iface.Prefixes = append(iface.Prefixes, prefix)
+				}
+			}
+		}
+		found = append(found, iface)
+	}
+	return found
+}
+
+// defaultRouteInterfaces returns the interfaces of the IPv4 and IPv6
+// default routes
+func defaultRouteInterfaces() map[string]bool {
+	defaults := make(map[string]bool)
+	if data, err := os.ReadFile("/proc/net/route"); err == nil {
+		for _, line := range strings.Split(string(data), "\n")[1:] {
+			// Iface Destination Gateway Flags RefCnt Use Metric Mask ...
+			fields := strings.Fields(line)
+			if len(fields) >= 8 && fields[1] == "00000000" && fields[7] == "00000000" {
+				defaults[fields[0]] = true
+			}
+		}
+	}
+	if data, err := os.ReadFile("/proc/net/ipv6_route"); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			// Destination PrefixLen Source SrcPrefixLen NextHop Metric RefCnt Use Flags Iface
+			fields := strings.Fields(line)
+			if len(fields) == 10 && fields[1] == "00" && strings.Trim(fields[0], "0") == "" && fields[9] != "lo" {
+				defaults[fields[9]] = true
+			}
+		}
+	}
+	return defaults
+}
+
+// defaultInterface suggests the interface to protect: the first one with
+// a default route, else the first one up
+func (r *hostReport) defaultInterface() string {
+	for _, iface := range r.Interfaces {
+		if iface.DefaultRoute {
+			return iface.Name
+		}
+	}
+	for _, iface := range r.Interfaces {
+		if iface.Up {
+			return iface.Name
+		}
+	}
+	return ""
+}
+
+func (r *hostReport) lookup(name string) *hostInterface {
+	for i := range r.Interfaces {
+		if r.Interfaces[i].Name == name {
+			return &r.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+func (r *hostReport) print(w io.Writer) {
+	yesNo := map[bool]string{true: "yes", false: "no"}
+	fmt.Fprintln(w, "Host")
+	fmt.Fprintf(w, "  Kernel        %s (BTF %s, XDP %s)\n", r.Kernel, yesNo[r.BTF], r.XDP)
+	fmt.Fprintf(w, "  BPF fs        %s mounted: %s\n", DefaultPinPath, yesNo[r.BPFFS])
+	fmt.Fprintf(w, "  Data plane    loaded: %s\n", yesNo[r.DataPlane])
+	switch {
+	case r.VPP == "":
+		fmt.Fprintln(w, "  VPP           not installed")
+	default:
+		fmt.Fprintf(w, "  VPP           %s (stats socket: %s)\n", r.VPP, yesNo[r.VPPStats])
+	}
+	fmt.Fprintln(w, "Interfaces")
+	for _, iface := range r.Interfaces {
+		state, driver := "down", iface.Driver
+		if iface.Up {
+			state = "up"
+		}
+		if driver == "" {
+			driver = "virtual"
+		}
+		addresses := make([]string, len(iface.Prefixes))
+		for i, prefix := range iface.Prefixes {
+			addresses[i] = prefix.String()
+		}
+		route := ""
+		if iface.DefaultRoute {
+			route = " (default route)"
+		}
+		fmt.Fprintf(w, "  %-12s %-4s %-12s %s%s\n", iface.Name, state, driver, strings.Join(addresses, " "), route)
+	}
+	fmt.Fprintln(w)
+}
+
+// initPrompter asks the wizard's questions. With assumeYes, or once the
+// input ends, every question takes its default.
+type initPrompter struct {
+	in        *bufio.Reader
+	out       io.Writer
+	assumeYes bool
+}
+
+// ask returns the answer to a question, offering def. A preset answer,
+// given by a flag, is checked without asking.
+func (p *initPrompter) ask(question, preset, def string, check func(string) error) (string, error) {
+	if preset != "" {
+		if err := check(preset); err != nil {
+			return "", fmt.Errorf("%s: %v", strings.ToLower(question), err)
+		}
+		return preset, nil
+	}
+	for {
+		answer := def
+		if !p.assumeYes {
+			fmt.Fprintf(p.out, "%s [%s]: ", question, def)
+			line, err := p.in.ReadString('\n')
+			if err != nil {
+				p.assumeYes = true
+				fmt.Fprintln(p.out)
+			}
+			if line = strings.TrimSpace(line); line != "" {
+				answer = line
+			}
+		}
+		err := check(answer)
+		if err == nil {
+			return answer, nil
+		}
+		if p.assumeYes {
+			return "", fmt.Errorf("%s: %v", strings.ToLower(question), err)
+		}
+		fmt.Fprintf(p.out, "  %v\n", err)
+	}
+}
+
+// confirm asks a yes/no question
+func (p *initPrompter) confirm(question string, def bool) bool {
+	choices := map[bool]string{true: "Y/n", false: "y/N"}
+	if p.assumeYes {
+		return def
+	}
+	for {
+		fmt.Fprintf(p.out, "%s [%s]: ", question, choices[def])
+		line, err := p.in.ReadString('\n')
+		if err != nil {
+			p.assumeYes = true
+			fmt.Fprintln(p.out)
+		}
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "":
+			return def
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+	}
+}
+
+// initAnswers are the choices the starter configuration is built from
+type initAnswers struct {
+	Interface     string
+	XDPMode       string
+	DefaultPolicy string
+	Management    []netip.Prefix
+}
+
+// parseNetworks parses comma-separated CIDR prefixes
+func parseNetworks(raw string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, field := range strings.Split(raw, ",") {
+		if field = strings.TrimSpace(field); field == "" || field == "none" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q, expected CIDR notation", field)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+func formatNetworks(prefixes []netip.Prefix) string {
+	networks := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		networks[i] = prefix.String()
+	}
+	return strings.Join(networks, ",")
+}
+
+// sshClient returns the address of the SSH session running the wizard
+func sshClient() (netip.Addr, bool) {
+	fields := strings.Fields(os.Getenv("SSH_CLIENT"))
+	if len(fields) == 0 {
+		return netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(fields[0])
+	return addr.Unmap(), err == nil
+}
+
+// runInit is cerberusctl init
+func runInit(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet(CtlName+" init", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	configPath := flags.String("config", envOrDefault("CERBERUS_CONFIG", DefaultConfigPath), "configuration file to write")
+	stateDir := flags.String("state-dir", envOrDefault("CERBERUS_STATE_DIR", DefaultStateDir), "state directory the starter policy is written to")
+	ifaceFlag := flags.String("interface", "", "interface to protect (default: the one with the default route)")
+	xdpFlag := flags.String("xdp-mode", "", "XDP attach mode, auto|offload|native|generic|tc (default: auto)")
+	policyFlag := flags.String("default-policy", "", "inbound default policy, drop or allow (default: drop)")
+	managementFlag := flags.String("management", "", "comma-separated management networks (default: the interface's networks)")
+	assumeYes := flags.Bool("yes", false, "take the defaults without asking")
+	force := flags.Bool("force", false, "overwrite an existing configuration and policy")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	policyPath := filepath.Join(*stateDir, policyFileName)
+	if _, err := os.Stat(policyPath); err == nil && !*force {
+		return fmt.Errorf("%s exists, the host is already set up; use -force to replace its policy", policyPath)
+	}
+
+	report := probeHost(DefaultPinPath)
+	report.print(stdout)
+	prompter := &initPrompter{in: bufio.NewReader(stdin), out: stdout, assumeYes: *assumeYes}
+
+	var answers initAnswers
+	var err error
+	answers.Interface, err = prompter.ask("Interface to protect", *ifaceFlag, report.defaultInterface(), func(name string) error {
+		if report.lookup(name) == nil {
+			return fmt.Errorf("no interface %q", name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	answers.XDPMode, err = prompter.ask("XDP attach mode (auto|offload|native|generic|tc)", *xdpFlag, XDPModeAuto, func(mode string) error {
+		_, err := parseXDPMode(mode)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	answers.XDPMode, _ = parseXDPMode(answers.XDPMode)
+	answers.DefaultPolicy, err = prompter.ask("Inbound default policy (drop|allow)", *policyFlag, DefaultActionDrop, func(action string) error {
+		if action != DefaultActionDrop && action != DefaultActionAllow {
+			return fmt.Errorf("expected drop or allow")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Suggest the networks of the protected interface, leaving out
+	// link-local addresses that cannot reach it from elsewhere
+	var suggested []netip.Prefix
+	for _, prefix := range report.lookup(answers.Interface).Prefixes {
+		if !prefix.Addr().IsLinkLocalUnicast() {
+			suggested = append(suggested, prefix.Masked())
+		}
+	}
+	suggestion := formatNetworks(suggested)
+	if suggestion == "" {
+		suggestion = "none"
+	}
+	managementRaw, err := prompter.ask("Management networks allowed to reach SSH and the control plane", *managementFlag, suggestion, func(raw string) error {
+		networks, err := parseNetworks(raw)
+		if err == nil && len(networks) == 0 && answers.DefaultPolicy == DefaultActionDrop {
+			err = fmt.Errorf("a drop default policy needs a management network, or the host is unreachable")
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	answers.Management, _ = parseNetworks(managementRaw)
+
+	// Never cut off the session running the wizard
+	if client, ok := sshClient(); ok && answers.DefaultPolicy == DefaultActionDrop {
+		covered := false
+		for _, prefix := range answers.Management {
+			covered = covered || prefix.Contains(client)
+		}
+		if !covered {
+			fmt.Fprintf(stdout, "Warning: this SSH session comes from %s, outside the management networks\n", client)
+			if prompter.confirm(fmt.Sprintf("Add %s to the management networks?", client), true) {
+				answers.Management = append(answers.Management, netip.PrefixFrom(client, client.BitLen()))
+			}
+		}
+	}
+
+	snapshot, err := starterPolicy(&answers)
+	if err != nil {
+		return fmt.Errorf("starter policy: %v", err)
+	}
+	config := starterConfig(&answers, report, *stateDir)
+
+	fmt.Fprintf(stdout, "\nConfiguration %s:\n%s\n", *configPath, config)
+	fmt.Fprintf(stdout, "Starter policy %s: inbound default %s on %s, %d rules allowing replies and management access from %s\n\n",
+		policyPath, answers.DefaultPolicy, answers.Interface, len(snapshot.Rules), formatNetworks(answers.Management))
+
+	if _, err := os.Stat(*configPath); err == nil && !*force {
+		if !prompter.confirm(fmt.Sprintf("%s exists, replace it?", *configPath), false) {
+			return fmt.Errorf("%s left unchanged, nothing written; use -force to replace it", *configPath)
+		}
+	}
+	if !prompter.confirm("Write these files?", true) {
+		return fmt.Errorf("nothing written")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*configPath), 0755); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(*configPath, []byte(config)); err != nil {
+		return err
+	}
+	store, err := NewJSONFileStore(*stateDir)
+	if err != nil {
+		return err
+	}
+	if err := store.Save(snapshot); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Wrote %s and %s\n\nNext steps:\n", *configPath, policyPath)
+	if !report.DataPlane {
+		fmt.Fprintf(stdout, "  - load the XDP program on %s so its maps are pinned under %s\n", answers.Interface, DefaultPinPath)
+	}
+	if *configPath != DefaultConfigPath {
+		fmt.Fprintf(stdout, "  - set CERBERUS_CONFIG=%s for cerberus-ctrl\n", *configPath)
+	}
+	fmt.Fprintln(stdout, "  - systemctl restart cerberus-ctrl")
+	return nil
+}
+
+// starterConfig renders the configuration file of the answers
+func starterConfig(answers *initAnswers, report *hostReport, stateDir string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Cerberus-V control plane configuration, written by %s init on %s\n", CtlName, time.Now().UTC().Format(time.RFC3339))
+	b.WriteString("# Keys are CERBERUS_* environment variables without the prefix; the\n")
+	b.WriteString("# environment overrides them\n")
+	fmt.Fprintf(&b, "xdp_mode=%s\n", answers.XDPMode)
+	fmt.Fprintf(&b, "state_dir=%s\n", stateDir)
+	if report.VPPStats {
+		fmt.Fprintf(&b, "vpp_stats_socket=%s\n", DefaultVPPStatsSocket)
+	}
+	return b.String()
+}
+
+// starterPolicy builds the minimal safe policy of the answers: replies to
+// the host's own connections and management access are allowed, the rest
+// gets the chosen default on the protected interface. The rules go
+// through the control plane's own validation and compilation.
+func starterPolicy(answers *initAnswers) (*PolicySnapshot, error) {
+	s := NewServer(nil)
+	now := s.clock.Now()
+
+	var rules []*FirewallRule
+	if len(answers.Management) > 0 {
+		management := &AddressObject{
+			Name:        ManagementAddressObject,
+			Prefixes:    strings.Split(formatNetworks(answers.Management), ","),
+			Description: "Networks allowed to reach SSH and the control plane",
+		}
+		if err := validateAddressObject(management); err != nil {
+			return nil, err
+		}
+		s.addressObjects[management.Name] = management
+
+		grpcNum, _ := strconv.Atoi(strings.TrimPrefix(gRPCPort, ":"))
+		restNum, _ := strconv.Atoi(strings.TrimPrefix(restPort, ":"))
+		rules = append(rules,
+			&FirewallRule{ID: "starter-ssh", Protocol: "tcp", DstPort: 22, Description: "SSH from the management networks"},
+			&FirewallRule{ID: "starter-control-plane", Protocol: "tcp", DstPort: int32(min(grpcNum, restNum)), DstPortEnd: int32(max(grpcNum, restNum)),
+				Description: "Control plane gRPC and REST APIs from the management networks"},
+			&FirewallRule{ID: "starter-metrics", Protocol: "tcp", DstPort: metricsPort, Description: "Prometheus metrics from the management networks"},
+			&FirewallRule{ID: "starter-ping", Protocol: "icmp", Description: "Ping from the management networks"},
+		)
+		for _, rule := range rules {
+			rule.SrcAddress = ManagementAddressObject
+			rule.Priority = 20
+		}
+	}
+	rules = append(rules, &FirewallRule{ID: "starter-established", ConnState: []string{"established", "related"}, Priority: 10,
+		Description: "Replies to connections the host opened"})
+
+	for _, rule := range rules {
+		rule.Action = "allow"
+		rule.Direction = "inbound"
+		rule.Enabled = true
+		rule.Owner = CtlName + " init"
+		rule.Labels = map[string]string{StarterLabel: ""}
+		rule.CreatedAt, rule.UpdatedAt = now, now
+		if err := s.validateRule(rule); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", rule.ID, err)
+		}
+		s.rules[rule.ID] = rule
+	}
+	s.defaultPolicies[answers.Interface] = &DefaultPolicy{Interface: answers.Interface, Inbound: answers.DefaultPolicy}
+
+	snapshot := s.policySnapshot()
+	if compiled := compilePolicy(snapshot, 1, now); len(compiled.Sorted) == 0 {
+		return nil, fmt.Errorf("no data plane entries compiled")
+	}
+	return snapshot, nil
+}
+
+// envOrDefault returns an environment variable, or def when it is unset
+func envOrDefault(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+// Shadow enforcement: a candidate policy evaluated alongside the active one
+// on live samples, counting where their verdicts differ without enforcing
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// EventShadowDisagreement reports the first sample of a new
+	// disagreement between the active and the candidate policy
+	EventShadowDisagreement = "SHADOW_DISAGREEMENT"
+
+	// Disagreement groups kept; later groups are counted only
+	MaxShadowDisagreements = 1000
+)
+
+// shadowKey groups disagreements by the verdicts and the deciding entries,
+// an empty entry ID being the defaults
+type shadowKey struct {
+	activeVerdict, shadowVerdict string
+	activeRule, shadowRule       string
+}
+
+// shadowGroup counts the samples of one disagreement
+type shadowGroup struct {
+	samples, packets    uint64
+	firstSeen, lastSeen time.Time
+	example             *pb.Event
+}
+
+// ShadowPolicy is a compiled candidate policy and the verdict comparison
+// counted for it since it started
+type ShadowPolicy struct {
+	mutex     sync.Mutex
+	policy    *CompiledPolicy
+	startedAt time.Time
+
+	samples, packets  uint64
+	agreed, disagreed uint64 // Packets
+	uncompared        uint64 // Samples
+	otherDisagreed    uint64 // Packets of groups over MaxShadowDisagreements
+	disagreements     map[shadowKey]*shadowGroup
+}
+
+// NewShadowPolicy starts counting for a compiled candidate
+func NewShadowPolicy(policy *CompiledPolicy, now time.Time) *ShadowPolicy {
+	return &ShadowPolicy{policy: policy, startedAt: now, disagreements: make(map[shadowKey]*shadowGroup)}
+}
+
+// count records the verdicts of both policies on a sample of weight
+// packets and reports whether it opened a new disagreement group
+func (sp *ShadowPolicy) count(event *pb.Event, active, shadow verdictExplanation, weight uint64, now time.Time) bool {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	sp.samples++
+	sp.packets += weight
+	if active.verdict == shadow.verdict {
+		sp.agreed += weight
+		return false
+	}
+	sp.disagreed += weight
+
+	key := shadowKey{activeVerdict: active.verdict, shadowVerdict: shadow.verdict}
+	if active.rule != nil {
+		key.activeRule = active.rule.ID
+	}
+	if shadow.rule != nil {
+		key.shadowRule = shadow.rule.ID
+	}
+	group, exists := sp.disagreements[key]
+	if !exists {
+		if len(sp.disagreements) >= MaxShadowDisagreements {
+			sp.otherDisagreed += weight
+			return false
+		}
+		group = &shadowGroup{firstSeen: now}
+		sp.disagreements[key] = group
+	}
+	group.samples++
+	group.packets += weight
+	group.lastSeen, group.example = now, event
+	return !exists
+}
+
+// uncomparedSample records a sample the comparison skipped
+func (sp *ShadowPolicy) uncomparedSample() {
+	sp.mutex.Lock()
+	sp.uncompared++
+	sp.mutex.Unlock()
+}
+
+// report returns the counts and the disagreements, most packets first
+func (sp *ShadowPolicy) report() *pb.ShadowPolicyReport {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	resp := &pb.ShadowPolicyReport{
+		Running:               true,
+		StartedAt:             sp.startedAt.Unix(),
+		Entries:               int32(len(sp.policy.Sorted)),
+		Samples:               sp.samples,
+		Packets:               sp.packets,
+		AgreedPackets:         sp.agreed,
+		DisagreedPackets:      sp.disagreed,
+		UncomparedSamples:     sp.uncompared,
+		OtherDisagreedPackets: sp.otherDisagreed,
+	}
+	for key, group := range sp.disagreements {
+		resp.Disagreements = append(resp.Disagreements, &pb.ShadowDisagreement{
+			ActiveVerdict: key.activeVerdict,
+			ShadowVerdict: key.shadowVerdict,
+			ActiveRuleId:  key.activeRule,
+			ShadowRuleId:  key.shadowRule,
+			Samples:       group.samples,
+			Packets:       group.packets,
+			FirstSeen:     group.firstSeen.Unix(),
+			LastSeen:      group.lastSeen.Unix(),
+			Example:       group.example,
+		})
+	}
+	sort.Slice(resp.Disagreements, func(i, j int) bool {
+		a, b := resp.Disagreements[i], resp.Disagreements[j]
+		if a.Packets != b.Packets {
+			return a.Packets > b.Packets
+		}
+		return a.FirstSeen < b.FirstSeen
+	})
+	return resp
+}
+
+// StartShadowPolicy validates a candidate policy and starts counting its
+// verdicts on live samples, replacing the candidate counted so far.
+// Nothing is enforced.
+func (s *Server) StartShadowPolicy(ctx context.Context, req *pb.StartShadowPolicyRequest) (*pb.ShadowPolicyReport, error) {
+	if req.GetPolicy() == nil {
+		return &pb.ShadowPolicyReport{Success: false, Message: "Policy is required"}, nil
+	}
+
+	candidate, errs := newCandidateServer(req.Policy)
+	if len(errs) > 0 {
+		return &pb.ShadowPolicyReport{Success: false, Message: "Candidate policy validation failed", Errors: errs}, nil
+	}
+	shadow := NewShadowPolicy(compilePolicy(candidate.policySnapshot(), 0, s.clock.Now()), s.clock.Now())
+	s.shadow.Store(shadow)
+	log.Printf("Started shadow enforcement of a candidate policy with %d entries", len(shadow.policy.Sorted))
+
+	resp := shadow.report()
+	resp.Success = true
+	resp.Message = "Shadow policy started"
+	return resp, nil
+}
+
+// StopShadowPolicy stops counting and returns the final report
+func (s *Server) StopShadowPolicy(ctx context.Context, req *pb.Empty) (*pb.ShadowPolicyReport, error) {
+	shadow := s.shadow.Swap(nil)
+	if shadow == nil {
+		return &pb.ShadowPolicyReport{Success: false, Message: "No shadow policy is running"}, nil
+	}
+	resp := shadow.report()
+	resp.Running = false
+	log.Printf("Stopped shadow enforcement: %d of %d packets disagreed", resp.DisagreedPackets, resp.Packets)
+
+	resp.Success = true
+	resp.Message = "Shadow policy stopped"
+	return resp, nil
+}
+
+// GetShadowPolicyReport returns the verdict comparison of the running
+// candidate
+func (s *Server) GetShadowPolicyReport(ctx context.Context, req *pb.Empty) (*pb.ShadowPolicyReport, error) {
+	shadow := s.shadow.Load()
+	if shadow == nil {
+		return &pb.ShadowPolicyReport{Success: true, Message: "No shadow policy is running"}, nil
+	}
+	resp := shadow.report()
+	resp.Success = true
+	return resp, nil
+}
+
+// runShadowPolicy evaluates the packet samples published on the event bus
+// against the running candidate, if any, until ctx is done
+func (s *Server) runShadowPolicy(ctx context.Context) {
+	events, cancel := s.events.Subscribe([]string{EventPacketSample})
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if shadow := s.shadow.Load(); shadow != nil {
+				s.compareShadow(shadow, event)
+			}
+		}
+	}
+}
+
+// compareShadow evaluates a sample against the active policy and the
+// candidate. Only samples whose live verdict the active policy explains are
+// compared: the others were decided before the rules, where the candidate
+// would not decide either, or under another policy version.
+func (s *Server) compareShadow(shadow *ShadowPolicy, event *pb.Event) {
+	packet, err := packetFromEvent(event)
+	if err != nil {
+		shadow.uncomparedSample()
+		return
+	}
+	s.mutex.RLock()
+	active := s.compiledPolicy()
+	s.resolvePacket(packet)
+	s.mutex.RUnlock()
+
+	if version, ok := eventPolicyVersion(event); ok && version != active.Version {
+		shadow.uncomparedSample()
+		return
+	}
+	var degradeFlags uint32
+	if s.degradation != nil {
+		degradeFlags = s.degradation.Status().Flags
+	}
+	activeVerdict := explainPacket(active, packet, degradeFlags)
+	if activeVerdict.verdict != event.Metadata["verdict"] {
+		shadow.uncomparedSample()
+		return
+	}
+	shadowVerdict := explainPacket(shadow.policy, packet, degradeFlags)
+
+	weight := uint64(1)
+	fmt.Sscan(event.Metadata["sample_rate"], &weight)
+	if !shadow.count(event, activeVerdict, shadowVerdict, weight, s.clock.Now()) {
+		return
+	}
+	s.events.Publish(shadowDisagreementEvent(event, activeVerdict, shadowVerdict))
+}
+
+// shadowDisagreementEvent reports the first sample of a new disagreement
+func shadowDisagreementEvent(sample *pb.Event, active, shadow verdictExplanation) *pb.Event {
+	activeRule, shadowRule := "default", "default"
+	event := &pb.Event{
+		Type:      EventShadowDisagreement,
+		Source:    sample.Source,
+		Target:    sample.Target,
+		Protocol:  sample.Protocol,
+		Port:      sample.Port,
+		Interface: sample.Interface,
+		Severity:  "medium",
+		Metadata: map[string]string{
+			"active_verdict": active.verdict,
+			"shadow_verdict": shadow.verdict,
+			"sample_rate":    sample.Metadata["sample_rate"],
+		},
+	}
+	if active.rule != nil {
+		activeRule = active.rule.ID
+		event.RuleId = active.rule.ID
+		event.Metadata["active_rule_id"] = active.rule.ID
+	}
+	if shadow.rule != nil {
+		shadowRule = shadow.rule.ID
+		event.Metadata["shadow_rule_id"] = shadow.rule.ID
+	}
+	event.Message = fmt.Sprintf("%s %s -> %s: active policy would %s (%s), shadow policy %s (%s)",
+		sample.Protocol, sample.Source, net.JoinHostPort(sample.Target, strconv.Itoa(int(sample.Port))),
+		active.verdict, activeRule, shadow.verdict, shadowRule)
+	return event
+}
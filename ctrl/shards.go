@@ -0,0 +1,765 @@
+// SPDX-License-Identifier: Apache-2.0
+// Rule-set sharding: ingress rules spread over per-shard rule maps by a hash of their source prefix
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"golang.org/x/sys/unix"
+)
+
+// Pinned maps of the sharded ingress rules (must match eBPF program). Each
+// outer map holds one inner map per shard, created by the control plane.
+const (
+	ShardConfigMapName  = "cerberus_shard_config"
+	ShardBucketsMapName = "cerberus_shard_buckets"
+	ShardRulesMapName   = "cerberus_shard_rules4"
+	ShardHitsMapName    = "cerberus_shard_hits4"
+	ShardSrcMapName     = "cerberus_shard_src4"
+	ShardDstMapName     = "cerberus_shard_dst4"
+	Shard6RulesMapName  = "cerberus_shard_rules6"
+	Shard6HitsMapName   = "cerberus_shard_hits6"
+	Shard6SrcMapName    = "cerberus_shard_src6"
+	Shard6DstMapName    = "cerberus_shard_dst6"
+
+	// Hash buckets, IPv4 in the lower half and IPv6 in the upper (must
+	// match SHARD_BUCKETS)
+	ShardBuckets = 65536
+	// Shard slots of the outer maps; slot 0 stands for the global tables
+	// (must match MAX_SHARDS)
+	MaxRuleShards = 8192
+
+	DefaultShardPrefix    = 16
+	DefaultShard6Prefix   = 48
+	DefaultShardImbalance = 0.25
+
+	// EventShardsRebalanced reports buckets moved between shards
+	EventShardsRebalanced = "SHARDS_REBALANCED"
+)
+
+const (
+	shardBucketsPerFamily = ShardBuckets / 2
+	globalShard           = 0
+
+	// FNV-1a parameters of packet_shard
+	fnvOffset = 2166136261
+	fnvPrime  = 16777619
+)
+
+// ShardConfig spreads ingress rules over shards. A rule whose source
+// prefix is at least as long as the hashed prefix of its family goes to the
+// shard of its bucket; shorter prefixes, including "any", stay in the
+// global tables every packet consults. Each shard holds MaxRules entries
+// per family, so Shards bounds the rule set at about Shards*MaxRules while
+// a packet only ever scans the global tables and one shard.
+type ShardConfig struct {
+	Shards    int     // Hashed shards besides the global tables, 0 = off
+	Prefix    int     // IPv4 source prefix bits hashed, multiple of 8
+	Prefix6   int     // IPv6 source prefix bits hashed, multiple of 8
+	Imbalance float64 // Rebalance when a shard exceeds the mean by this fraction
+}
+
+// shardConfigFromEnv reads CERBERUS_RULE_SHARDS, CERBERUS_SHARD_PREFIX,
+// CERBERUS_SHARD_PREFIX6 and CERBERUS_SHARD_IMBALANCE
+func shardConfigFromEnv() (ShardConfig, error) {
+	config := ShardConfig{Prefix: DefaultShardPrefix, Prefix6: DefaultShard6Prefix, Imbalance: DefaultShardImbalance}
+	if raw := os.Getenv("CERBERUS_RULE_SHARDS"); raw != "" {
+		shards, err := strconv.Atoi(raw)
+		if err != nil || shards < 0 || shards >= MaxRuleShards {
+			return config, fmt.Errorf("invalid CERBERUS_RULE_SHARDS %q, expected 0 to %d", raw, MaxRuleShards-1)
+		}
+		config.Shards = shards
+	}
+	for name, prefix := range map[string]struct {
+		value *int
+		max   int
+	}{
+		"CERBERUS_SHARD_PREFIX":  {&config.Prefix, 32},
+		"CERBERUS_SHARD_PREFIX6": {&config.Prefix6, 128},
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		bits, err := strconv.Atoi(raw)
+		if err != nil || bits <= 0 || bits > prefix.max || bits%8 != 0 {
+			return config, fmt.Errorf("invalid %s %q, expected a multiple of 8 up to %d", name, raw, prefix.max)
+		}
+		*prefix.value = bits
+	}
+	if raw := os.Getenv("CERBERUS_SHARD_IMBALANCE"); raw != "" {
+		imbalance, err := strconv.ParseFloat(raw, 64)
+		if err != nil || imbalance <= 0 {
+			return config, fmt.Errorf("invalid CERBERUS_SHARD_IMBALANCE %q, expected a positive fraction", raw)
+		}
+		config.Imbalance = imbalance
+	}
+	return config, nil
+}
+
+func (c ShardConfig) prefixBits(family int) int {
+	if family == familyIPv6 {
+		return c.Prefix6
+	}
+	return c.Prefix
+}
+
+// shardBucket hashes the leading bits of an address like packet_shard in
+// the data plane: FNV-1a over the bytes, in the half of its family
+func shardBucket(addr netip.Addr, bits int) uint32 {
+	hash := uint32(fnvOffset)
+	for _, b := range addr.AsSlice()[:bits/8] {
+		hash ^= uint32(b)
+		hash *= fnvPrime
+	}
+	bucket := hash % shardBucketsPerFamily
+	if addr.Is6() {
+		bucket += shardBucketsPerFamily
+	}
+	return bucket
+}
+
+// shardLayout assigns the hash buckets to shards
+type shardLayout struct {
+	config  ShardConfig
+	buckets []uint32 // Bucket -> shard, 1..config.Shards
+}
+
+// newShardLayout deals the buckets of each family out to the shards in turn
+func newShardLayout(config ShardConfig) *shardLayout {
+	layout := &shardLayout{config: config, buckets: make([]uint32, ShardBuckets)}
+	for bucket := range layout.buckets {
+		layout.buckets[bucket] = uint32(bucket%shardBucketsPerFamily%config.Shards) + 1
+	}
+	return layout
+}
+
+// entryBucket returns the bucket of an entry in a family's tables, false
+// when its source prefix is too short to hash and it stays global
+func (l *shardLayout) entryBucket(entry *FirewallRule, family int) (uint32, bool) {
+	prefix := rulePrefix(entry.SrcIP, family)
+	bits := l.config.prefixBits(family)
+	if prefix.Bits() < bits {
+		return 0, false
+	}
+	return shardBucket(prefix.Addr(), bits), true
+}
+
+// entryShard returns the shard holding an entry in a family's tables
+func (l *shardLayout) entryShard(entry *FirewallRule, family int) int {
+	if bucket, hashed := l.entryBucket(entry, family); hashed {
+		return int(l.buckets[bucket])
+	}
+	return globalShard
+}
+
+// packetShard returns the shard the data plane consults for a source address
+func (l *shardLayout) packetShard(src netip.Addr) int {
+	return int(l.buckets[shardBucket(src, l.config.prefixBits(addrFamily(src)))])
+}
+
+func addrFamily(addr netip.Addr) int {
+	if addr.Is4() {
+		return familyIPv4
+	}
+	return familyIPv6
+}
+
+// bucketLoads counts the ingress entries of a family in each bucket
+func (l *shardLayout) bucketLoads(entries []*FirewallRule, family int) map[uint32]int {
+	loads := make(map[uint32]int)
+	for _, entry := range entries {
+		if !tableAccepts(family, false, entry) {
+			continue
+		}
+		if bucket, hashed := l.entryBucket(entry, family); hashed {
+			loads[bucket]++
+		}
+	}
+	return loads
+}
+
+// shardLoads sums bucket loads by shard; index 0 is unused
+func (l *shardLayout) shardLoads(loads map[uint32]int) []int {
+	shardLoads := make([]int, l.config.Shards+1)
+	for bucket, load := range loads {
+		shardLoads[l.buckets[bucket]] += load
+	}
+	return shardLoads
+}
+
+// rebalance plans bucket moves for one family: while the fullest shard
+// holds more than capacity or the imbalance tolerance allows, its largest
+// bucket that narrows the gap moves to the emptiest shard. Buckets are the
+// unit of movement, so a single bucket larger than a shard cannot be
+// split; raising the hashed prefix length spreads it. It returns the new
+// shard of every bucket that moves.
+func (l *shardLayout) rebalance(loads map[uint32]int, capacity int) map[uint32]uint32 {
+	shardLoads := l.shardLoads(loads)
+	byShard := make([][]uint32, l.config.Shards+1)
+	total := 0
+	for bucket, load := range loads {
+		shard := l.buckets[bucket]
+		byShard[shard] = append(byShard[shard], bucket)
+		total += load
+	}
+	for _, buckets := range byShard {
+		sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+	}
+
+	mean := float64(total) / float64(l.config.Shards)
+	limit := min(capacity, max(int(math.Ceil(mean)), int(mean*(1+l.config.Imbalance))))
+
+	assigned := make(map[uint32]uint32)
+	for range loads {
+		fullest, emptiest := 1, 1
+		for shard := 2; shard <= l.config.Shards; shard++ {
+			if shardLoads[shard] > shardLoads[fullest] {
+				fullest = shard
+			}
+			if shardLoads[shard] < shardLoads[emptiest] {
+				emptiest = shard
+			}
+		}
+		if shardLoads[fullest] <= limit {
+			break
+		}
+
+		gap := shardLoads[fullest] - shardLoads[emptiest]
+		best := -1
+		for i, bucket := range byShard[fullest] {
+			if load := loads[bucket]; load < gap && (best < 0 || load > loads[byShard[fullest][best]]) {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		bucket := byShard[fullest][best]
+		byShard[fullest] = append(byShard[fullest][:best], byShard[fullest][best+1:]...)
+		byShard[emptiest] = append(byShard[emptiest], bucket)
+		shardLoads[fullest] -= loads[bucket]
+		shardLoads[emptiest] += loads[bucket]
+		assigned[bucket] = uint32(emptiest)
+	}
+
+	moves := make(map[uint32]uint32)
+	for bucket, shard := range assigned {
+		if shard != l.buckets[bucket] {
+			moves[bucket] = shard
+		}
+	}
+	return moves
+}
+
+// overflow reports a shard holding more entries than it has slots
+func (l *shardLayout) overflow(loads map[uint32]int, capacity, family int) error {
+	for shard, load := range l.shardLoads(loads) {
+		if load > capacity {
+			return withCode(ErrCodeShardFull, fmt.Errorf("shard %d needs %d %s slots, more than its %d",
+				shard, load, familyName(family), capacity))
+		}
+	}
+	return nil
+}
+
+// ruleShards are the shard tables of a data plane. Without maps (simulation)
+// only the layout is kept.
+type ruleShards struct {
+	layout  *shardLayout
+	config  *ebpf.Map
+	buckets *ebpf.Map
+	outer   []*ebpf.Map
+	tables  [][2]*ruleSlotTable      // By shard-1: IPv4 and IPv6
+	placed  map[string]*FirewallRule // Ingress entries written, by ID
+}
+
+// shardFamilies are the families of the tables of a shard, in order
+var shardFamilies = [2]int{familyIPv4, familyIPv6}
+
+// shardMapNames returns the outer rule, hits, source and destination trie
+// maps of a family
+func shardMapNames(family int) []string {
+	if family == familyIPv6 {
+		return []string{Shard6RulesMapName, Shard6HitsMapName, Shard6SrcMapName, Shard6DstMapName}
+	}
+	return []string{ShardRulesMapName, ShardHitsMapName, ShardSrcMapName, ShardDstMapName}
+}
+
+// shardInnerSpecs mirror struct shard_rules, shard_hits and shard_trie4/6
+func shardInnerSpecs(family int) []*ebpf.MapSpec {
+	trie := &ebpf.MapSpec{
+		Type:       ebpf.LPMTrie,
+		KeySize:    uint32(len(lpmKey(rulePrefix("", family)))),
+		ValueSize:  uint32(binary.Size(ruleSet{})),
+		MaxEntries: MaxRules + 1,
+		Flags:      unix.BPF_F_NO_PREALLOC,
+	}
+	return []*ebpf.MapSpec{
+		{Type: ebpf.Array, KeySize: 4, ValueSize: uint32(binary.Size(BPFFirewallRule{})), MaxEntries: MaxRules},
+		{Type: ebpf.PerCPUArray, KeySize: 4, ValueSize: uint32(binary.Size(ruleHitsValue{})), MaxEntries: MaxRules},
+		trie,
+		trie.Copy(),
+	}
+}
+
+// EnableSharding spreads ingress rules over config.Shards shards. It must
+// run before rules are installed. The shard maps are created empty and
+// inserted in the pinned outer maps; the data plane starts hashing once the
+// shard configuration is written, last. Each shard holds eight maps open.
+func (bm *BPFMapManager) EnableSharding(config ShardConfig) error {
+	if config.Shards == 0 {
+		return nil
+	}
+	shards := &ruleShards{layout: newShardLayout(config), placed: make(map[string]*FirewallRule)}
+	if bm.simulated {
+		bm.shards = shards
+		log.Printf("✅ [SIMULATED] Rule sharding over %d shards", config.Shards)
+		return nil
+	}
+
+	if err := shards.open(bm.pinPath); err != nil {
+		shards.close()
+		return err
+	}
+	bm.shards = shards
+	log.Printf("✅ Rule sharding over %d shards of %d slots per family, /%d IPv4 and /%d IPv6 source prefixes hashed",
+		config.Shards, MaxRules, config.Prefix, config.Prefix6)
+	return nil
+}
+
+// open creates the shard tables and hands them to the data plane
+func (s *ruleShards) open(pinPath string) error {
+	load := func(name string) (*ebpf.Map, error) {
+		path := filepath.Join(pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			return nil, fmt.Errorf("rule sharding not available at %s: %v", path, err)
+		}
+		s.outer = append(s.outer, m)
+		return m, nil
+	}
+
+	var err error
+	if s.config, err = load(ShardConfigMapName); err != nil {
+		return err
+	}
+	if s.buckets, err = load(ShardBucketsMapName); err != nil {
+		return err
+	}
+	var outer [2][]*ebpf.Map
+	for i, family := range shardFamilies {
+		for _, name := range shardMapNames(family) {
+			m, err := load(name)
+			if err != nil {
+				return err
+			}
+			if m.MaxEntries() <= uint32(s.layout.config.Shards) {
+				return fmt.Errorf("%s has %d shard slots, %d shards configured", name, m.MaxEntries(), s.layout.config.Shards)
+			}
+			outer[i] = append(outer[i], m)
+		}
+	}
+
+	for shard := 1; shard <= s.layout.config.Shards; shard++ {
+		var tables [2]*ruleSlotTable
+		for i, family := range shardFamilies {
+			table, err := newShardTable(family, uint32(shard), outer[i])
+			if err != nil {
+				if tables[0] != nil {
+					tables[0].close()
+				}
+				return fmt.Errorf("shard %d: %v", shard, err)
+			}
+			tables[i] = table
+		}
+		s.tables = append(s.tables, tables)
+	}
+
+	keys := make([]uint32, ShardBuckets)
+	for bucket := range keys {
+		keys[bucket] = uint32(bucket)
+	}
+	if err := writeShardBuckets(s.buckets, keys, s.layout.buckets); err != nil {
+		return err
+	}
+	key := uint32(0)
+	prefixes := [2]uint32{uint32(s.layout.config.Prefix), uint32(s.layout.config.Prefix6)}
+	if err := s.config.Put(&key, prefixes); err != nil {
+		return fmt.Errorf("failed to write shard configuration: %v", err)
+	}
+	return nil
+}
+
+// newShardTable creates the maps of one shard and family, inserts them in
+// the outer maps and returns them as an empty slot table
+func newShardTable(family int, shard uint32, outer []*ebpf.Map) (*ruleSlotTable, error) {
+	var maps []*ebpf.Map
+	for i, spec := range shardInnerSpecs(family) {
+		m, err := ebpf.NewMap(spec)
+		if err == nil {
+			if err = outer[i].Put(&shard, m); err != nil {
+				m.Close()
+			}
+		}
+		if err != nil {
+			for _, created := range maps {
+				created.Close()
+			}
+			return nil, fmt.Errorf("%s map: %v", shardMapNames(family)[i], err)
+		}
+		maps = append(maps, m)
+	}
+
+	table := &ruleSlotTable{
+		family:      family,
+		rulesMap:    maps[0],
+		hits:        maps[1],
+		srcTrie:     maps[2],
+		dstTrie:     maps[3],
+		slots:       make(map[string]uint32),
+		srcPrefixes: make(map[uint32]netip.Prefix),
+		dstPrefixes: make(map[uint32]netip.Prefix),
+	}
+	for slot := uint32(0); slot < MaxRules; slot++ {
+		table.free = append(table.free, slot)
+	}
+	if err := table.syncTries(); err != nil {
+		table.close()
+		return nil, err
+	}
+	return table, nil
+}
+
+// writeShardBuckets assigns buckets to shards in one batched update, or one
+// update per bucket on kernels without batch operations
+func writeShardBuckets(buckets *ebpf.Map, keys, shards []uint32) error {
+	_, err := buckets.BatchUpdate(keys, shards, nil)
+	if !errors.Is(err, ebpf.ErrNotSupported) {
+		return err
+	}
+	for i := range keys {
+		if err := buckets.Put(&keys[i], &shards[i]); err != nil {
+			return fmt.Errorf("failed to assign bucket %d: %v", keys[i], err)
+		}
+	}
+	return nil
+}
+
+func (s *ruleShards) close() {
+	for _, tables := range s.tables {
+		tables[0].close()
+		tables[1].close()
+	}
+	for _, m := range s.outer {
+		m.Close()
+	}
+}
+
+// sharded reports whether ingress entries are written to shard tables
+func (bm *BPFMapManager) sharded() bool {
+	return bm.shards != nil && bm.shards.tables != nil
+}
+
+// entryShard returns the shard holding an entry in a family's ingress
+// tables, the global tables when sharding is off
+func (bm *BPFMapManager) entryShard(entry *FirewallRule, family int) int {
+	if bm.shards == nil {
+		return globalShard
+	}
+	return bm.shards.layout.entryShard(entry, family)
+}
+
+// ingressTable returns the ingress table of a family in a shard
+func (bm *BPFMapManager) ingressTable(shard, family int) *ruleSlotTable {
+	index := 0
+	if family == familyIPv6 {
+		index = 1
+	}
+	if shard != globalShard {
+		return bm.shards.tables[shard-1][index]
+	}
+	return [2]*ruleSlotTable{bm.rules, bm.rules6}[index]
+}
+
+// shardTables returns the tables of every shard
+func (bm *BPFMapManager) shardTables() []*ruleSlotTable {
+	if !bm.sharded() {
+		return nil
+	}
+	tables := make([]*ruleSlotTable, 0, 2*len(bm.shards.tables))
+	for _, shard := range bm.shards.tables {
+		tables = append(tables, shard[:]...)
+	}
+	return tables
+}
+
+// putIngress writes an entry to the ingress tables, or removes it from them
+// when the ingress hook does not enforce it. With sharding an entry moving
+// to another shard is written there before it leaves the tables holding it,
+// so enforcement never lapses; a full shard is rebalanced first.
+func (bm *BPFMapManager) putIngress(rule *FirewallRule, enforced bool) error {
+	if !bm.sharded() {
+		return putRuleFamilies(bm.rules, bm.rules6, rule, enforced)
+	}
+	shards := bm.shards
+	previous := shards.placed[rule.ID]
+
+	if enforced {
+		for _, family := range shardFamilies {
+			table := bm.ingressTable(bm.entryShard(rule, family), family)
+			if _, exists := table.slots[rule.ID]; exists || len(table.free) > 0 || !tableAccepts(family, false, rule) {
+				continue
+			}
+			entries := []*FirewallRule{rule}
+			for id, entry := range shards.placed {
+				if id != rule.ID {
+					entries = append(entries, entry)
+				}
+			}
+			if _, err := bm.rebalanceFamily(family, entries); err != nil {
+				return err
+			}
+		}
+	}
+
+	rules := bm.ingressTable(bm.entryShard(rule, familyIPv4), familyIPv4)
+	rules6 := bm.ingressTable(bm.entryShard(rule, familyIPv6), familyIPv6)
+	if previous != nil {
+		for _, family := range shardFamilies {
+			before := bm.ingressTable(bm.entryShard(previous, family), family)
+			if after := bm.ingressTable(bm.entryShard(rule, family), family); before != after && enforced && tableAccepts(family, false, rule) {
+				moveHits(before, after, rule.ID)
+			}
+		}
+	}
+	if err := putRuleFamilies(rules, rules6, rule, enforced); err != nil {
+		return err
+	}
+	if previous != nil {
+		for _, family := range shardFamilies {
+			if before := bm.ingressTable(bm.entryShard(previous, family), family); before != rules && before != rules6 {
+				if err := before.remove(rule.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if enforced {
+		shards.placed[rule.ID] = rule
+	} else {
+		delete(shards.placed, rule.ID)
+	}
+	return nil
+}
+
+// RebalanceShards moves buckets until every shard fits its slots and the
+// imbalance tolerance, given the ingress entries of this data plane. It
+// returns the number of buckets moved.
+func (bm *BPFMapManager) RebalanceShards(entries []*FirewallRule) (int, error) {
+	if bm.shards == nil {
+		return 0, fmt.Errorf("rule sharding is not enabled")
+	}
+	moved := 0
+	for _, family := range shardFamilies {
+		count, err := bm.rebalanceFamily(family, entries)
+		moved += count
+		if err != nil {
+			return moved, err
+		}
+	}
+	return moved, nil
+}
+
+// rebalanceFamily plans and carries out the bucket moves of one family.
+// Each bucket moves on its own: its entries are written to the new shard,
+// the bucket switches over, and only then are they withdrawn from the old
+// shard. A failure leaves the buckets moved so far in their new shard.
+func (bm *BPFMapManager) rebalanceFamily(family int, entries []*FirewallRule) (int, error) {
+	layout := bm.shards.layout
+	loads := layout.bucketLoads(entries, family)
+	moves := layout.rebalance(loads, MaxRules)
+
+	buckets := make([]uint32, 0, len(moves))
+	for bucket := range moves {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+
+	placed := make(map[uint32][]*FirewallRule)
+	for _, entry := range bm.shards.placed {
+		if bucket, hashed := layout.entryBucket(entry, family); hashed && tableAccepts(family, false, entry) {
+			placed[bucket] = append(placed[bucket], entry)
+		}
+	}
+	for i, bucket := range buckets {
+		if err := bm.moveBucket(family, bucket, moves[bucket], placed[bucket]); err != nil {
+			return i, err
+		}
+	}
+	if len(buckets) > 0 {
+		log.Printf("Rebalanced %s rule shards: %d buckets moved", familyName(family), len(buckets))
+	}
+	return len(buckets), layout.overflow(loads, MaxRules, family)
+}
+
+// moveBucket moves a bucket and the entries written under it to a shard
+func (bm *BPFMapManager) moveBucket(family int, bucket, shard uint32, entries []*FirewallRule) error {
+	layout := bm.shards.layout
+	if !bm.sharded() {
+		layout.buckets[bucket] = shard
+		return nil
+	}
+
+	from, to := bm.ingressTable(int(layout.buckets[bucket]), family), bm.ingressTable(int(shard), family)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	for _, entry := range entries {
+		if err := to.put(entry); err != nil {
+			return fmt.Errorf("bucket %d to shard %d: %v", bucket, shard, err)
+		}
+	}
+	if err := bm.shards.buckets.Put(&bucket, &shard); err != nil {
+		return fmt.Errorf("failed to assign bucket %d to shard %d: %v", bucket, shard, err)
+	}
+	layout.buckets[bucket] = shard
+	for _, entry := range entries {
+		moveHits(from, to, entry.ID)
+		if err := from.remove(entry.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveHits carries the counters an entry collected in one table over to
+// the table it moves to
+func moveHits(from, to *ruleSlotTable, id string) {
+	slot, exists := from.slots[id]
+	if !exists || from.hits == nil {
+		return
+	}
+	if err := from.carryHits(id, slot); err != nil {
+		log.Printf("⚠️  %v", err)
+		return
+	}
+	if to.carried == nil {
+		to.carried = make(map[string]ruleHitsValue)
+	}
+	carried, moving := to.carried[id], from.carried[id]
+	carried.Packets += moving.Packets
+	carried.Bytes += moving.Bytes
+	carried.LastHit = max(carried.LastHit, moving.LastHit)
+	to.carried[id] = carried
+	delete(from.carried, id)
+}
+
+// placeShards records the ingress entries of a replaced rule set
+func (bm *BPFMapManager) placeShards(entries []*FirewallRule) {
+	if !bm.sharded() {
+		return
+	}
+	placed := make(map[string]*FirewallRule)
+	for _, entry := range entries {
+		if ingress, _ := ruleHooks(entry); ingress {
+			placed[entry.ID] = entry
+		}
+	}
+	bm.shards.placed = placed
+}
+
+// ShardUsage is the occupancy of one family's shards
+type ShardUsage struct {
+	Family    string  `json:"family"`
+	Global    int     `json:"global"`    // Entries in the global tables
+	Sharded   int     `json:"sharded"`   // Entries in shards
+	Capacity  int     `json:"capacity"`  // Slots of one shard
+	Max       int     `json:"max"`       // Entries of the fullest shard
+	Mean      float64 `json:"mean"`      // Entries per shard
+	Imbalance float64 `json:"imbalance"` // Max over mean, minus 1
+	Buckets   int     `json:"buckets"`   // Non-empty buckets
+}
+
+// ShardStatus describes the sharding of the host data plane
+type ShardStatus struct {
+	Enabled bool         `json:"enabled"`
+	Shards  int          `json:"shards"`
+	Prefix  int          `json:"prefix"`
+	Prefix6 int          `json:"prefix6"`
+	Usage   []ShardUsage `json:"usage,omitempty"`
+}
+
+// hostIngressEntries lists the compiled entries of the host data plane.
+// Caller must hold s.mutex.
+func (s *Server) hostIngressEntries() []*FirewallRule {
+	var entries []*FirewallRule
+	for _, entry := range s.compiledPolicy().Sorted {
+		if ruleScope(entry) == "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// ShardStatus reports how the running policy spreads over the shards
+func (s *Server) ShardStatus() ShardStatus {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.bpfManager == nil || s.bpfManager.shards == nil {
+		return ShardStatus{}
+	}
+	layout := s.bpfManager.shards.layout
+	status := ShardStatus{Enabled: true, Shards: layout.config.Shards, Prefix: layout.config.Prefix, Prefix6: layout.config.Prefix6}
+	entries := s.hostIngressEntries()
+	for _, family := range shardFamilies {
+		loads := layout.bucketLoads(entries, family)
+		usage := ShardUsage{Family: familyName(family), Capacity: MaxRules, Buckets: len(loads)}
+		for _, entry := range entries {
+			if tableAccepts(family, false, entry) && layout.entryShard(entry, family) == globalShard {
+				usage.Global++
+			}
+		}
+		for _, load := range layout.shardLoads(loads) {
+			usage.Sharded += load
+			usage.Max = max(usage.Max, load)
+		}
+		usage.Mean = float64(usage.Sharded) / float64(layout.config.Shards)
+		if usage.Mean > 0 {
+			usage.Imbalance = float64(usage.Max)/usage.Mean - 1
+		}
+		status.Usage = append(status.Usage, usage)
+	}
+	return status
+}
+
+// RebalanceShards moves buckets between shards for the running policy
+func (s *Server) RebalanceShards() (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.bpfManager == nil {
+		return 0, fmt.Errorf("rule sharding is not enabled")
+	}
+	moved, err := s.bpfManager.RebalanceShards(s.hostIngressEntries())
+	if moved > 0 {
+		s.events.Publish(&pb.Event{
+			Type:     EventShardsRebalanced,
+			Message:  fmt.Sprintf("%d buckets moved between rule shards", moved),
+			Severity: "low",
+		})
+	}
+	return moved, err
+}
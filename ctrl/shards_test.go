@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+// shardedRuleSet is a rule set laid out over shards the way the data plane
+// holds it: the global tables plus one table per shard
+type shardedRuleSet struct {
+	layout *shardLayout
+	global *CompiledPolicy
+	shards []*CompiledPolicy // By shard, index 0 unused
+}
+
+// benchmarkRules returns n drop rules for random /24 source networks
+// behind an SSH allow rule that applies to every source
+func benchmarkRules(n int) []*FirewallRule {
+	rng := rand.New(rand.NewSource(1))
+	rules := []*FirewallRule{{
+		ID: "rule_ssh", Action: "allow", Protocol: "tcp", DstPort: 22,
+		Direction: "inbound", Priority: 10, Enabled: true,
+	}}
+	for i := 0; i < n; i++ {
+		rules = append(rules, &FirewallRule{
+			ID:        fmt.Sprintf("rule_%d", i+1),
+			Action:    "drop",
+			SrcIP:     fmt.Sprintf("%d.%d.%d.0/24", rng.Intn(224), rng.Intn(256), rng.Intn(256)),
+			Direction: "inbound",
+			Priority:  int32(100 + i),
+			Enabled:   true,
+		})
+	}
+	return rules
+}
+
+// benchmarkPackets returns TCP packets from random sources, half of them
+// from networks the rules drop
+func benchmarkPackets(rules []*FirewallRule, count int) []*sampledPacket {
+	rng := rand.New(rand.NewSource(2))
+	packets := make([]*sampledPacket, count)
+	for i := range packets {
+		src := netip.AddrFrom4([4]byte{byte(rng.Intn(224)), byte(rng.Intn(256)), byte(rng.Intn(256)), byte(rng.Intn(256))})
+		if i%2 == 0 {
+			network := rulePrefix(rules[1+rng.Intn(len(rules)-1)].SrcIP, familyIPv4).Addr().As4()
+			network[3] = byte(rng.Intn(256))
+			src = netip.AddrFrom4(network)
+		}
+		packets[i] = &sampledPacket{
+			src: src, dst: netip.MustParseAddr("10.0.0.1"),
+			srcPort: 40000, dstPort: 443, protocol: 6, family: familyIPv4,
+		}
+	}
+	return packets
+}
+
+// newShardedRuleSet spreads rules over enough shards of MaxRules slots,
+// rebalanced as the control plane would
+func newShardedRuleSet(b *testing.B, rules []*FirewallRule) *shardedRuleSet {
+	shards := min((len(rules)+191)/192, MaxRuleShards-1)
+	layout := newShardLayout(ShardConfig{Shards: shards, Prefix: DefaultShardPrefix, Prefix6: DefaultShard6Prefix, Imbalance: DefaultShardImbalance})
+	loads := layout.bucketLoads(rules, familyIPv4)
+	for bucket, shard := range layout.rebalance(loads, MaxRules) {
+		layout.buckets[bucket] = shard
+	}
+	if err := layout.overflow(loads, MaxRules, familyIPv4); err != nil {
+		b.Fatal(err)
+	}
+
+	set := &shardedRuleSet{layout: layout, global: &CompiledPolicy{}, shards: make([]*CompiledPolicy, shards+1)}
+	for shard := range set.shards {
+		set.shards[shard] = &CompiledPolicy{}
+	}
+	for _, rule := range rules {
+		if shard := layout.entryShard(rule, familyIPv4); shard != globalShard {
+			set.shards[shard].Sorted = append(set.shards[shard].Sorted, rule)
+		} else {
+			set.global.Sorted = append(set.global.Sorted, rule)
+		}
+	}
+	return set
+}
+
+// lookup matches a packet like match_ingress: the best of the global
+// tables and the packet's shard, ties to the global tables
+func (set *shardedRuleSet) lookup(packet *sampledPacket) *FirewallRule {
+	rule := explainPacket(set.global, packet, 0).rule
+	shard := set.layout.packetShard(packet.src)
+	if shard == globalShard {
+		return rule
+	}
+	if sharded := explainPacket(set.shards[shard], packet, 0).rule; sharded != nil && (rule == nil || sharded.Priority < rule.Priority) {
+		return sharded
+	}
+	return rule
+}
+
+func BenchmarkShardedLookup(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000, 1_000_000} {
+		rules := benchmarkRules(n)
+		set := newShardedRuleSet(b, rules)
+		packets := benchmarkPackets(rules, 1024)
+		if n <= 10_000 {
+			unsharded := &CompiledPolicy{Sorted: rules}
+			for _, packet := range packets {
+				if want, got := explainPacket(unsharded, packet, 0).rule, set.lookup(packet); want != got {
+					b.Fatalf("%d rules: %s matched %v sharded, %v unsharded", n, packet.src, got, want)
+				}
+			}
+		}
+
+		b.Run(fmt.Sprintf("rules=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				set.lookup(packets[i%len(packets)])
+			}
+		})
+	}
+}
+
+func BenchmarkUnshardedLookup(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		rules := benchmarkRules(n)
+		policy := &CompiledPolicy{Sorted: rules}
+		packets := benchmarkPackets(rules, 1024)
+
+		b.Run(fmt.Sprintf("rules=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				explainPacket(policy, packets[i%len(packets)], 0)
+			}
+		})
+	}
+}
+
+func BenchmarkShardRebalance(b *testing.B) {
+	rules := benchmarkRules(1_000_000)
+	config := ShardConfig{Shards: (len(rules) + 191) / 192, Prefix: DefaultShardPrefix, Imbalance: DefaultShardImbalance}
+	loads := newShardLayout(config).bucketLoads(rules, familyIPv4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		newShardLayout(config).rebalance(loads, MaxRules)
+	}
+}
@@ -0,0 +1,410 @@
+// SPDX-License-Identifier: Apache-2.0
+// SLO tracking: enforcement path SLIs, error budgets and burn rate alerts
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// EventSLOBurnRate reports an error budget burning fast enough to alert,
+// and the alert resolving
+const EventSLOBurnRate = "SLO_BURN_RATE"
+
+// SLIs of the enforcement path
+const (
+	SLIApplyLatency  = "apply_latency"          // Policy applies succeeding within the latency objective
+	SLIEventDelivery = "event_delivery"         // Events delivered rather than lost to full subscriber buffers
+	SLIAvailability  = "dataplane_availability" // Probes finding the host data plane readable
+)
+
+var sliNames = []string{SLIApplyLatency, SLIEventDelivery, SLIAvailability}
+
+// Indexes of the SLIs in sliNames and in bucket counts
+const (
+	sliApplyLatency = iota
+	sliEventDelivery
+	sliAvailability
+)
+
+// SLO defaults, overridden with CERBERUS_SLO_APPLY_LATENCY,
+// CERBERUS_SLO_APPLY, CERBERUS_SLO_EVENT_DELIVERY and
+// CERBERUS_SLO_AVAILABILITY
+const (
+	DefaultSLOApplyLatency  = 500 * time.Millisecond
+	DefaultSLOApply         = 0.99 // Share of applies within the latency, 0.99 makes it a P99
+	DefaultSLOEventDelivery = 0.999
+	DefaultSLOAvailability  = 0.999
+
+	sloBudgetWindow  = 30 * 24 * time.Hour
+	sloProbeInterval = 10 * time.Second
+	sloRecentApplies = 1024 // Apply latencies kept for the P99
+)
+
+// sloWindows are the windows SLIs and burn rates are reported over
+var sloWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"5m", 5 * time.Minute},
+	{"30m", 30 * time.Minute},
+	{"1h", time.Hour},
+	{"6h", 6 * time.Hour},
+	{"30d", sloBudgetWindow},
+}
+
+func sloWindowName(window time.Duration) string {
+	for _, w := range sloWindows {
+		if w.duration == window {
+			return w.name
+		}
+	}
+	return window.String()
+}
+
+// sloAlert fires while the error budget burns faster than rate over both
+// its long and its short window; the short window ends the alert soon
+// after the burn stops
+type sloAlert struct {
+	name     string
+	long     time.Duration
+	short    time.Duration
+	rate     float64
+	severity string
+}
+
+// sloAlerts from most to least severe: 2% of a 30 day budget spent in an
+// hour, and 5% in six hours
+var sloAlerts = []sloAlert{
+	{name: "fast_burn", long: time.Hour, short: 5 * time.Minute, rate: 14.4, severity: "high"},
+	{name: "slow_burn", long: 6 * time.Hour, short: 30 * time.Minute, rate: 6, severity: "medium"},
+}
+
+// SLOConfig is the configured objectives
+type SLOConfig struct {
+	ApplyLatency        time.Duration
+	ApplyTarget         float64
+	EventDeliveryTarget float64
+	AvailabilityTarget  float64
+}
+
+// sloConfigFromEnv reads the SLO targets
+func sloConfigFromEnv() (SLOConfig, error) {
+	config := SLOConfig{
+		ApplyLatency:        DefaultSLOApplyLatency,
+		ApplyTarget:         DefaultSLOApply,
+		EventDeliveryTarget: DefaultSLOEventDelivery,
+		AvailabilityTarget:  DefaultSLOAvailability,
+	}
+	if raw := os.Getenv("CERBERUS_SLO_APPLY_LATENCY"); raw != "" {
+		latency, err := time.ParseDuration(raw)
+		if err != nil || latency <= 0 {
+			return config, fmt.Errorf("invalid CERBERUS_SLO_APPLY_LATENCY %q, expected a positive duration", raw)
+		}
+		config.ApplyLatency = latency
+	}
+	for name, value := range map[string]*float64{
+		"CERBERUS_SLO_APPLY":          &config.ApplyTarget,
+		"CERBERUS_SLO_EVENT_DELIVERY": &config.EventDeliveryTarget,
+		"CERBERUS_SLO_AVAILABILITY":   &config.AvailabilityTarget,
+	} {
+		raw := os.Getenv(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed <= 0 || parsed >= 1 {
+			return config, fmt.Errorf("invalid %s %q, expected a fraction in (0, 1)", name, raw)
+		}
+		*value = parsed
+	}
+	return config, nil
+}
+
+func (c SLOConfig) target(sli string) float64 {
+	switch sli {
+	case SLIApplyLatency:
+		return c.ApplyTarget
+	case SLIEventDelivery:
+		return c.EventDeliveryTarget
+	default:
+		return c.AvailabilityTarget
+	}
+}
+
+// sloBucket counts good and total events of every SLI, by sliNames index
+type sloBucket struct {
+	start time.Time
+	good  [3]uint64
+	total [3]uint64
+}
+
+// sloSeries is a ring of buckets of one step covering a span
+type sloSeries struct {
+	step    time.Duration
+	buckets []sloBucket
+}
+
+func newSLOSeries(step, span time.Duration) *sloSeries {
+	return &sloSeries{step: step, buckets: make([]sloBucket, span/step)}
+}
+
+func (s *sloSeries) add(now time.Time, sli int, good, total uint64) {
+	start := now.Truncate(s.step)
+	bucket := &s.buckets[int(start.UnixNano()/int64(s.step))%len(s.buckets)]
+	if !bucket.start.Equal(start) {
+		*bucket = sloBucket{start: start}
+	}
+	bucket.good[sli] += good
+	bucket.total[sli] += total
+}
+
+// sum adds up the buckets starting within window before now
+func (s *sloSeries) sum(now time.Time, window time.Duration, sli int) (good, total uint64) {
+	from := now.Add(-window)
+	for i := range s.buckets {
+		bucket := &s.buckets[i]
+		if bucket.start.IsZero() || !bucket.start.After(from) || bucket.start.After(now) {
+			continue
+		}
+		good += bucket.good[sli]
+		total += bucket.total[sli]
+	}
+	return good, total
+}
+
+// SLIStatus is an SLI over every reporting window
+type SLIStatus struct {
+	Name                 string             `json:"name"`
+	Target               float64            `json:"target"`
+	SLI                  map[string]float64 `json:"sli"`                    // Good share by window, 1 without events
+	BurnRate             map[string]float64 `json:"burn_rate"`              // Budget spending speed by window, 1 = spent exactly at the window's end
+	ErrorBudgetRemaining float64            `json:"error_budget_remaining"` // Share of the 30 day budget left, negative once overspent
+	Alert                string             `json:"alert,omitempty"`        // Firing alert, if any
+}
+
+// SLOStatus is a snapshot of the tracker
+type SLOStatus struct {
+	ApplyLatencyObjective time.Duration `json:"apply_latency_objective_ns"`
+	ApplyLatencyP99       time.Duration `json:"apply_latency_p99_ns"` // Over the latest applies
+	SLIs                  []SLIStatus   `json:"slis"`
+}
+
+// SLOTracker computes the enforcement path SLIs and publishes burn rate
+// alerts on the event bus. Counts are kept in memory: a restart starts a
+// new budget window.
+type SLOTracker struct {
+	server *Server
+	config SLOConfig
+
+	mutex   sync.Mutex
+	minutes *sloSeries // Burn rate windows
+	hours   *sloSeries // Budget window
+	applies []time.Duration
+	next    int // Oldest apply once the ring is full
+
+	lastDelivered uint64
+	lastDiscarded uint64
+	firing        map[string]string // Alert firing by SLI
+}
+
+// NewSLOTracker creates a tracker of the given objectives
+func NewSLOTracker(server *Server, config SLOConfig) *SLOTracker {
+	t := &SLOTracker{
+		server:  server,
+		config:  config,
+		minutes: newSLOSeries(time.Minute, 6*time.Hour),
+		hours:   newSLOSeries(time.Hour, sloBudgetWindow),
+		applies: make([]time.Duration, 0, sloRecentApplies),
+		firing:  make(map[string]string),
+	}
+	t.lastDelivered, t.lastDiscarded = server.events.Deliveries()
+	return t
+}
+
+// record counts events of an SLI at the current time
+func (t *SLOTracker) record(sli int, good, total uint64) {
+	now := t.server.clock.Now()
+	t.minutes.add(now, sli, good, total)
+	t.hours.add(now, sli, good, total)
+}
+
+// RecordApply counts a policy apply; failed applies are bad whatever
+// their latency
+func (t *SLOTracker) RecordApply(latency time.Duration, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var good uint64
+	if err == nil && latency <= t.config.ApplyLatency {
+		good = 1
+	}
+	t.record(sliApplyLatency, good, 1)
+	if len(t.applies) < cap(t.applies) {
+		t.applies = append(t.applies, latency)
+	} else {
+		t.applies[t.next] = latency
+		t.next = (t.next + 1) % len(t.applies)
+	}
+}
+
+// probe counts the events delivered since the last probe and whether the
+// host data plane answers
+func (t *SLOTracker) probe() {
+	available := uint64(0)
+	if manager := t.server.bpfManager; manager != nil {
+		if _, err := manager.GetStats(); err == nil {
+			available = 1
+		}
+	}
+	delivered, discarded := t.server.events.Deliveries()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.record(sliAvailability, available, 1)
+	newDelivered, newDiscarded := delivered-t.lastDelivered, discarded-t.lastDiscarded
+	t.lastDelivered, t.lastDiscarded = delivered, discarded
+	if newDelivered+newDiscarded > 0 {
+		t.record(sliEventDelivery, newDelivered, newDelivered+newDiscarded)
+	}
+}
+
+// burnRate is the error rate over a window relative to the budget, with
+// t.mutex held
+func (t *SLOTracker) burnRate(now time.Time, window time.Duration, sli int) float64 {
+	series := t.minutes
+	if window > 6*time.Hour {
+		series = t.hours
+	}
+	good, total := series.sum(now, window, sli)
+	if total == 0 {
+		return 0
+	}
+	return float64(total-good) / float64(total) / (1 - t.config.target(sliNames[sli]))
+}
+
+// evaluate publishes an event for every SLI whose alert started, changed
+// or resolved
+func (t *SLOTracker) evaluate() {
+	now := t.server.clock.Now()
+	var events []*pb.Event
+
+	t.mutex.Lock()
+	for sli, name := range sliNames {
+		firing := ""
+		var alert sloAlert
+		var long, short float64
+		for _, candidate := range sloAlerts {
+			long, short = t.burnRate(now, candidate.long, sli), t.burnRate(now, candidate.short, sli)
+			if long > candidate.rate && short > candidate.rate {
+				firing, alert = candidate.name, candidate
+				break
+			}
+		}
+		previous := t.firing[name]
+		if firing == previous {
+			continue
+		}
+		t.firing[name] = firing
+
+		event := &pb.Event{
+			Type: EventSLOBurnRate,
+			Metadata: map[string]string{
+				"sli":    name,
+				"target": strconv.FormatFloat(t.config.target(name), 'g', -1, 64),
+			},
+		}
+		if firing != "" {
+			event.Severity = alert.severity
+			event.Message = fmt.Sprintf("%s error budget burning %.1fx over %s and %.1fx over %s, above %gx",
+				name, long, sloWindowName(alert.long), short, sloWindowName(alert.short), alert.rate)
+			event.Metadata["alert"] = firing
+			event.Metadata["state"] = "firing"
+			event.Metadata["burn_rate_long"] = strconv.FormatFloat(long, 'f', 2, 64)
+			event.Metadata["burn_rate_short"] = strconv.FormatFloat(short, 'f', 2, 64)
+		} else {
+			event.Severity = "low"
+			event.Message = fmt.Sprintf("%s error budget burn back within limits", name)
+			event.Metadata["alert"] = previous
+			event.Metadata["state"] = "resolved"
+		}
+		events = append(events, event)
+	}
+	t.mutex.Unlock()
+
+	for _, event := range events {
+		if event.Metadata["state"] == "firing" {
+			log.Printf("⚠️  SLO %s: %s", event.Metadata["alert"], event.Message)
+		} else {
+			log.Printf("✅ SLO %s resolved: %s", event.Metadata["alert"], event.Message)
+		}
+		t.server.events.Publish(event)
+	}
+}
+
+// Run probes and evaluates every interval until ctx is done
+func (t *SLOTracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.probe()
+			t.evaluate()
+		}
+	}
+}
+
+// Status returns every SLI over every window
+func (t *SLOTracker) Status() SLOStatus {
+	now := t.server.clock.Now()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	status := SLOStatus{ApplyLatencyObjective: t.config.ApplyLatency}
+	if len(t.applies) > 0 {
+		latencies := append([]time.Duration(nil), t.applies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		status.ApplyLatencyP99 = latencies[int(math.Ceil(0.99*float64(len(latencies))))-1]
+	}
+
+	for sli, name := range sliNames {
+		target := t.config.target(name)
+		sliStatus := SLIStatus{
+			Name:                 name,
+			Target:               target,
+			SLI:                  make(map[string]float64),
+			BurnRate:             make(map[string]float64),
+			ErrorBudgetRemaining: 1,
+			Alert:                t.firing[name],
+		}
+		for _, window := range sloWindows {
+			series := t.minutes
+			if window.duration > 6*time.Hour {
+				series = t.hours
+			}
+			good, total := series.sum(now, window.duration, sli)
+			sliStatus.SLI[window.name] = 1
+			if total > 0 {
+				sliStatus.SLI[window.name] = float64(good) / float64(total)
+			}
+			sliStatus.BurnRate[window.name] = t.burnRate(now, window.duration, sli)
+		}
+		sliStatus.ErrorBudgetRemaining = 1 - sliStatus.BurnRate["30d"]
+		status.SLIs = append(status.SLIs, sliStatus)
+	}
+	return status
+}
@@ -0,0 +1,428 @@
+// SPDX-License-Identifier: Apache-2.0
+// SR-IOV: VF discovery and per-VF data planes for VM isolation
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// SysfsNetPath lists the host's network interfaces
+const SysfsNetPath = "/sys/class/net"
+
+// Drivers able to run XDP inside the NIC (XDP_FLAGS_HW_MODE)
+var xdpOffloadDrivers = map[string]bool{
+	"nfp": true,
+}
+
+// Representor port names: "pf0vf3" on most drivers, "vf3" on older ones
+var vfPortName = regexp.MustCompile(`^(?:pf\d+)?vf(\d+)$`)
+
+// PhysicalFunction is an SR-IOV capable NIC port as found in sysfs
+type PhysicalFunction struct {
+	Name       string
+	Driver     string
+	TotalVFs   int
+	NumVFs     int
+	Switchdev  bool
+	XDPOffload bool
+	VFs        []*VirtualFunction
+}
+
+// VirtualFunction is one VF of a PhysicalFunction
+type VirtualFunction struct {
+	Index       int
+	PCIAddress  string
+	Netdev      string
+	Representor string
+}
+
+// VFPolicy attaches a data plane to the representor of one VF. Rules naming
+// the VF are enforced there, isolating the VM the VF is passed through to.
+type VFPolicy struct {
+	PF          string `json:"pf"`
+	VF          int    `json:"vf"`
+	Representor string `json:"representor"`
+	PinPath     string `json:"pin_path"` // bpffs directory of the VF's maps
+	Description string `json:"description"`
+}
+
+// Key names the VF in rules and data plane lookups, e.g. "enp3s0f0/vf3"
+func (p *VFPolicy) Key() string {
+	return vfKey(p.PF, p.VF)
+}
+
+func vfKey(pf string, vf int) string {
+	return fmt.Sprintf("%s/vf%d", pf, vf)
+}
+
+// ListSRIOVDevices enumerates SR-IOV capable PFs with their VFs
+func (s *Server) ListSRIOVDevices(ctx context.Context, req *pb.Empty) (*pb.SRIOVDevicesResponse, error) {
+	pfs, err := discoverPhysicalFunctions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to enumerate SR-IOV devices: %v", err)
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.SRIOVDevicesResponse{}
+	for _, pf := range pfs {
+		p := &pb.PhysicalFunction{
+			Name:       pf.Name,
+			Driver:     pf.Driver,
+			TotalVfs:   int32(pf.TotalVFs),
+			NumVfs:     int32(pf.NumVFs),
+			Switchdev:  pf.Switchdev,
+			XdpOffload: pf.XDPOffload,
+		}
+		for _, vf := range pf.VFs {
+			_, attached := s.vfPolicies[vfKey(pf.Name, vf.Index)]
+			p.Vfs = append(p.Vfs, &pb.VirtualFunction{
+				Index:       int32(vf.Index),
+				PciAddress:  vf.PCIAddress,
+				Netdev:      vf.Netdev,
+				Representor: vf.Representor,
+				Attached:    attached,
+			})
+		}
+		resp.Pfs = append(resp.Pfs, p)
+	}
+	for _, key := range s.sortedVFPolicyKeys() {
+		p := vfPolicyToProto(s.vfPolicies[key])
+		if manager := s.dataPlanes[key]; manager != nil {
+			p.Simulated = manager.simulated
+		}
+		resp.Policies = append(resp.Policies, p)
+	}
+
+	return resp, nil
+}
+
+// AttachVFPolicy opens the data plane of a VF, or replaces an attached one,
+// and installs the VF's rules into it
+func (s *Server) AttachVFPolicy(ctx context.Context, req *pb.AttachVFPolicyRequest) (*pb.StatusResponse, error) {
+	if req.GetPolicy() == nil {
+		return &pb.StatusResponse{Success: false, Message: "VF policy is required"}, nil
+	}
+
+	policy := vfPolicyFromProto(req.Policy)
+	if err := validateVFPolicy(policy); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("VF policy validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.attachVFPolicy(policy); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to attach VF policy: %v", err),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Attached VF policy: %s (representor %s)", policy.Key(), policy.Representor)
+
+	return &pb.StatusResponse{Success: true, Message: "VF policy attached successfully"}, nil
+}
+
+// DetachVFPolicy closes the data plane of a VF no rule refers to
+func (s *Server) DetachVFPolicy(ctx context.Context, req *pb.DetachVFPolicyRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := vfKey(req.Pf, int(req.Vf))
+	policy, exists := s.vfPolicies[key]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "VF policy not found"}, nil
+	}
+
+	if users := s.rulesInScope(key); len(users) > 0 {
+		ids := make([]string, 0, len(users))
+		for _, rule := range users {
+			ids = append(ids, rule.ID)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("VF is referenced by rules: %s", strings.Join(ids, ", ")),
+		}, nil
+	}
+
+	if manager := s.dataPlanes[key]; manager != nil {
+		if err := manager.UnloadXDPProgram(policy.Representor); err != nil {
+			log.Printf("Failed to detach representor %s of %s: %v", policy.Representor, key, err)
+		}
+		manager.Close()
+	}
+	delete(s.dataPlanes, key)
+	delete(s.vfPolicies, key)
+
+	s.persistPolicy()
+	log.Printf("Detached VF policy: %s", key)
+
+	return &pb.StatusResponse{Success: true, Message: "VF policy detached successfully"}, nil
+}
+
+// GetVFStats returns the verdict counters of a VF's data plane together with
+// the traffic counters of its representor
+func (s *Server) GetVFStats(ctx context.Context, req *pb.VFStatsRequest) (*pb.VFStats, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	key := vfKey(req.Pf, int(req.Vf))
+	policy, exists := s.vfPolicies[key]
+	if !exists {
+		return nil, fmt.Errorf("VF policy not found: %s", key)
+	}
+
+	stats, err := s.dataPlanes[key].GetStats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats of %s: %v", key, err)
+	}
+
+	resp := &pb.VFStats{
+		Pf: policy.PF,
+		Vf: int32(policy.VF),
+		Dataplane: &pb.Statistics{
+			TotalPackets:      stats.Pass + stats.Drop + stats.Redirect,
+			DroppedPackets:    stats.Drop,
+			AllowedPackets:    stats.Pass,
+			RedirectedPackets: stats.Redirect,
+			ActiveRules:       int32(len(s.rulesInScope(key))),
+		},
+	}
+
+	// Representor counters are best effort; the link may be gone
+	counters := filepath.Join(SysfsNetPath, policy.Representor, "statistics")
+	resp.RxPackets, _ = readSysfsUint(filepath.Join(counters, "rx_packets"))
+	resp.TxPackets, _ = readSysfsUint(filepath.Join(counters, "tx_packets"))
+	resp.RxBytes, _ = readSysfsUint(filepath.Join(counters, "rx_bytes"))
+	resp.TxBytes, _ = readSysfsUint(filepath.Join(counters, "tx_bytes"))
+	resp.RxDropped, _ = readSysfsUint(filepath.Join(counters, "rx_dropped"))
+
+	return resp, nil
+}
+
+// attachVFPolicy resolves the VF's representor, opens its data plane,
+// attaches XDP and installs the running version's entries for the VF.
+// Caller must hold s.mutex.
+func (s *Server) attachVFPolicy(policy *VFPolicy) error {
+	vf, err := findVirtualFunction(policy.PF, policy.VF)
+	if err != nil {
+		return err
+	}
+
+	// Traffic of a passed-through VF is only visible on its representor;
+	// in legacy mode the VF netdev is used while it stays in the host
+	policy.Representor = vf.Representor
+	if policy.Representor == "" {
+		policy.Representor = vf.Netdev
+	}
+	if policy.Representor == "" {
+		return fmt.Errorf("%s has no representor; switch the eswitch to switchdev mode", policy.Key())
+	}
+	if policy.PinPath == "" {
+		policy.PinPath = filepath.Join(DefaultPinPath, "cerberus", "vf", fmt.Sprintf("%s_vf%d", policy.PF, policy.VF))
+	}
+
+	manager, err := NewBPFMapManagerAt(policy.PinPath)
+	if err != nil {
+		return err
+	}
+	manager.events = s.events
+	manager.clock = s.clock
+	manager.offloadMode = s.offloadMode
+	manager.xdpMode = s.xdpMode
+
+	if err := manager.LoadXDPProgram(policy.Representor); err != nil {
+		manager.Close()
+		return fmt.Errorf("failed to attach %s: %v", policy.Representor, err)
+	}
+
+	key := policy.Key()
+	var scoped []*FirewallRule
+	for _, entry := range s.compiledPolicy().Sorted {
+		if ruleScope(entry) == key {
+			scoped = append(scoped, entry)
+		}
+	}
+	if err := manager.AddRuleInterfaces(ruleInterfaceKeys(scoped)); err != nil {
+		manager.Close()
+		return err
+	}
+	for _, entry := range scoped {
+		if err := manager.AddRuleToMap(entry); err != nil {
+			manager.Close()
+			return fmt.Errorf("failed to install entry %s: %v", entry.ID, err)
+		}
+	}
+	if err := manager.SetPolicyVersion(s.compiledPolicy().Version); err != nil {
+		log.Printf("⚠️  Failed to stamp policy version in %s: %v", key, err)
+	}
+
+	if previous := s.dataPlanes[key]; previous != nil {
+		previous.Close()
+	}
+	s.dataPlanes[key] = manager
+	s.vfPolicies[key] = policy
+	return nil
+}
+
+// sortedVFPolicyKeys returns VF policy keys in a stable order.
+// Caller must hold s.mutex.
+func (s *Server) sortedVFPolicyKeys() []string {
+	keys := make([]string, 0, len(s.vfPolicies))
+	for key := range s.vfPolicies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// discoverPhysicalFunctions lists interfaces exposing sriov_totalvfs
+func discoverPhysicalFunctions() ([]*PhysicalFunction, error) {
+	links, err := os.ReadDir(SysfsNetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var pfs []*PhysicalFunction
+	for _, link := range links {
+		device := filepath.Join(SysfsNetPath, link.Name(), "device")
+		total, err := readSysfsUint(filepath.Join(device, "sriov_totalvfs"))
+		if err != nil || total == 0 {
+			continue
+		}
+		numVFs, _ := readSysfsUint(filepath.Join(device, "sriov_numvfs"))
+
+		pf := &PhysicalFunction{
+			Name:     link.Name(),
+			TotalVFs: int(total),
+			NumVFs:   int(numVFs),
+		}
+		pf.Driver = interfaceDriver(pf.Name)
+		pf.XDPOffload = xdpOffloadDrivers[pf.Driver]
+
+		switchID := readSysfsString(filepath.Join(SysfsNetPath, pf.Name, "phys_switch_id"))
+		pf.Switchdev = switchID != ""
+
+		for i := 0; i < pf.NumVFs; i++ {
+			vf := &VirtualFunction{Index: i}
+			if target, err := os.Readlink(filepath.Join(device, fmt.Sprintf("virtfn%d", i))); err == nil {
+				vf.PCIAddress = filepath.Base(target)
+				if netdevs, err := os.ReadDir(filepath.Join(device, fmt.Sprintf("virtfn%d", i), "net")); err == nil && len(netdevs) > 0 {
+					vf.Netdev = netdevs[0].Name()
+				}
+			}
+			pf.VFs = append(pf.VFs, vf)
+		}
+		if pf.Switchdev {
+			assignRepresentors(pf, switchID, links)
+		}
+
+		pfs = append(pfs, pf)
+	}
+	return pfs, nil
+}
+
+// assignRepresentors matches representor netdevs to the PF's VFs: they share
+// the PF's switch ID and carry the VF index in their port name
+func assignRepresentors(pf *PhysicalFunction, switchID string, links []os.DirEntry) {
+	for _, link := range links {
+		if link.Name() == pf.Name {
+			continue
+		}
+		if readSysfsString(filepath.Join(SysfsNetPath, link.Name(), "phys_switch_id")) != switchID {
+			continue
+		}
+		match := vfPortName.FindStringSubmatch(readSysfsString(filepath.Join(SysfsNetPath, link.Name(), "phys_port_name")))
+		if match == nil {
+			continue
+		}
+		index, _ := strconv.Atoi(match[1])
+		if index < len(pf.VFs) {
+			pf.VFs[index].Representor = link.Name()
+		}
+	}
+}
+
+func findVirtualFunction(pfName string, index int) (*VirtualFunction, error) {
+	pfs, err := discoverPhysicalFunctions()
+	if err != nil {
+		return nil, err
+	}
+	for _, pf := range pfs {
+		if pf.Name != pfName {
+			continue
+		}
+		if index >= len(pf.VFs) {
+			return nil, fmt.Errorf("%s has %d VFs enabled, no vf%d", pfName, pf.NumVFs, index)
+		}
+		return pf.VFs[index], nil
+	}
+	return nil, fmt.Errorf("no SR-IOV physical function named %s", pfName)
+}
+
+// readSysfsString returns a trimmed sysfs attribute, empty when unreadable
+func readSysfsString(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func readSysfsUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+func validateVFPolicy(policy *VFPolicy) error {
+	if policy.PF == "" {
+		return fmt.Errorf("pf is required")
+	}
+	if strings.ContainsAny(policy.PF, "/.") {
+		return fmt.Errorf("pf must not contain '/' or '.'")
+	}
+	if policy.VF < 0 {
+		return fmt.Errorf("invalid vf: %d", policy.VF)
+	}
+	return nil
+}
+
+func vfPolicyFromProto(p *pb.VFPolicy) *VFPolicy {
+	return &VFPolicy{
+		PF:          p.Pf,
+		VF:          int(p.Vf),
+		PinPath:     p.PinPath,
+		Description: p.Description,
+	}
+}
+
+func vfPolicyToProto(policy *VFPolicy) *pb.VFPolicy {
+	return &pb.VFPolicy{
+		Pf:          policy.PF,
+		Vf:          int32(policy.VF),
+		Representor: policy.Representor,
+		PinPath:     policy.PinPath,
+		Description: policy.Description,
+	}
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Statistics history: round-robin archives of packet counters at minute, hour and day resolution
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	statsHistoryVersion  = 1
+	statsHistoryFileName = "stats_history.json"
+
+	// How often counters are sampled and the history is written to disk
+	statsHistoryInterval     = time.Minute
+	statsHistorySaveInterval = 10 * time.Minute
+)
+
+// Archives from finest to coarsest; each consolidates the same samples
+var statsArchiveLayout = []struct {
+	resolution string
+	step       time.Duration
+	retention  time.Duration
+}{
+	{"minute", time.Minute, 24 * time.Hour},
+	{"hour", time.Hour, 30 * 24 * time.Hour},
+	{"day", 24 * time.Hour, 365 * 24 * time.Hour},
+}
+
+// StatsPoint aggregates the packets counted during one archive step
+type StatsPoint struct {
+	Time     int64   `json:"time"` // Unix start of the step
+	Pass     uint64  `json:"pass"`
+	Drop     uint64  `json:"drop"`
+	Redirect uint64  `json:"redirect"`
+	Error    uint64  `json:"error"`
+	MaxPPS   float64 `json:"max_pps"` // Highest sampled rate within the step
+}
+
+func (p *StatsPoint) total() uint64 {
+	return p.Pass + p.Drop + p.Redirect + p.Error
+}
+
+// merge adds a sample to a point of the same step
+func (p *StatsPoint) merge(sample StatsPoint) {
+	p.Pass += sample.Pass
+	p.Drop += sample.Drop
+	p.Redirect += sample.Redirect
+	p.Error += sample.Error
+	if sample.MaxPPS > p.MaxPPS {
+		p.MaxPPS = sample.MaxPPS
+	}
+}
+
+// statsArchive is a fixed-size ring of points; the oldest point is
+// overwritten once it is full
+type statsArchive struct {
+	resolution string
+	step       time.Duration
+	points     []StatsPoint
+	next       int // Slot the next new point goes to
+	count      int
+}
+
+func newStatsArchive(resolution string, step, retention time.Duration) *statsArchive {
+	return &statsArchive{
+		resolution: resolution,
+		step:       step,
+		points:     make([]StatsPoint, int(retention/step)),
+	}
+}
+
+// add consolidates a sample into the point of its step
+func (a *statsArchive) add(at time.Time, sample StatsPoint) {
+	sample.Time = at.Truncate(a.step).Unix()
+	if a.count > 0 {
+		last := &a.points[(a.next+len(a.points)-1)%len(a.points)]
+		if last.Time == sample.Time {
+			last.merge(sample)
+			return
+		}
+		if last.Time > sample.Time {
+			return // Clock stepped back; keep the archive ordered
+		}
+	}
+
+	a.points[a.next] = sample
+	a.next = (a.next + 1) % len(a.points)
+	if a.count < len(a.points) {
+		a.count++
+	}
+}
+
+// ordered returns the stored points, oldest first
+func (a *statsArchive) ordered() []StatsPoint {
+	points := make([]StatsPoint, 0, a.count)
+	for i := 0; i < a.count; i++ {
+		points = append(points, a.points[(a.next-a.count+i+len(a.points))%len(a.points)])
+	}
+	return points
+}
+
+// holds reports whether the archive still has the point covering t: it has
+// not wrapped yet, or its oldest point is not newer than t
+func (a *statsArchive) holds(t time.Time) bool {
+	if a.count < len(a.points) {
+		return true
+	}
+	return a.points[a.next].Time <= t.Unix()
+}
+
+// StatsHistory samples the host data plane counters and keeps them in
+// round-robin archives, so trends survive without external retention
+type StatsHistory struct {
+	mutex    sync.Mutex
+	archives []*statsArchive // Finest first
+	path     string          // Empty = kept in memory only
+
+	last     *FirewallStats // Previous sample, nil before the first
+	lastTime time.Time
+
+	clock Clock
+}
+
+// statsHistoryFile is the persisted form of the archives
+type statsHistoryFile struct {
+	Version  int                     `json:"version"`
+	Archives map[string][]StatsPoint `json:"archives"` // By resolution, oldest first
+}
+
+// NewStatsHistory creates the archives and loads the history saved under
+// dir; an empty dir keeps the history in memory
+func NewStatsHistory(dir string) (*StatsHistory, error) {
+	h := &StatsHistory{clock: systemClock{}}
+	for _, layout := range statsArchiveLayout {
+		h.archives = append(h.archives, newStatsArchive(layout.resolution, layout.step, layout.retention))
+	}
+	if dir == "" {
+		return h, nil
+	}
+
+	h.path = filepath.Join(dir, statsHistoryFileName)
+	data, err := os.ReadFile(h.path)
+	if os.IsNotExist(err) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read statistics history: %v", err)
+	}
+
+	var file statsHistoryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse statistics history %s: %v", h.path, err)
+	}
+	if file.Version > statsHistoryVersion {
+		return nil, fmt.Errorf("statistics history version %d is newer than supported version %d",
+			file.Version, statsHistoryVersion)
+	}
+	for _, archive := range h.archives {
+		for _, point := range file.Archives[archive.resolution] {
+			archive.add(time.Unix(point.Time, 0), point)
+		}
+	}
+	return h, nil
+}
+
+// Record adds the counters read at a given time. The first sample only sets
+// the baseline; counters that went backwards mean the data plane restarted
+// and are taken as counted from zero.
+func (h *StatsHistory) Record(now time.Time, stats *FirewallStats) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	last, lastTime := h.last, h.lastTime
+	h.last, h.lastTime = stats, now
+	if last == nil || !now.After(lastTime) {
+		return
+	}
+
+	sample := StatsPoint{
+		Pass:     counterDelta(last.Pass, stats.Pass),
+		Drop:     counterDelta(last.Drop, stats.Drop),
+		Redirect: counterDelta(last.Redirect, stats.Redirect),
+		Error:    counterDelta(last.Error, stats.Error),
+	}
+	sample.MaxPPS = float64(sample.total()) / now.Sub(lastTime).Seconds()
+	for _, archive := range h.archives {
+		archive.add(lastTime, sample)
+	}
+}
+
+func counterDelta(previous, current uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// Query returns the points of one archive within [start, end]. Without a
+// resolution the finest archive still holding start is used.
+func (h *StatsHistory) Query(start, end time.Time, resolution string) (*statsArchive, []StatsPoint, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var archive *statsArchive
+	for i, candidate := range h.archives {
+		if resolution == candidate.resolution {
+			archive = candidate
+			break
+		}
+		if resolution == "" && (candidate.holds(start) || i == len(h.archives)-1) {
+			archive = candidate
+			break
+		}
+	}
+	if archive == nil {
+		return nil, nil, fmt.Errorf("invalid resolution: %s", resolution)
+	}
+
+	var points []StatsPoint
+	first := start.Truncate(archive.step).Unix()
+	for _, point := range archive.ordered() {
+		if point.Time >= first && point.Time <= end.Unix() {
+			points = append(points, point)
+		}
+	}
+	return archive, points, nil
+}
+
+// Save writes the archives to disk
+func (h *StatsHistory) Save() error {
+	if h.path == "" {
+		return nil
+	}
+
+	h.mutex.Lock()
+	file := statsHistoryFile{Version: statsHistoryVersion, Archives: make(map[string][]StatsPoint)}
+	for _, archive := range h.archives {
+		file.Archives[archive.resolution] = archive.ordered()
+	}
+	h.mutex.Unlock()
+
+	data, err := json.Marshal(&file)
+	if err != nil {
+		return fmt.Errorf("failed to encode statistics history: %v", err)
+	}
+	return writeFileAtomic(h.path, data)
+}
+
+// Run samples the data plane counters every statsHistoryInterval and saves
+// the history every statsHistorySaveInterval until ctx is done
+func (h *StatsHistory) Run(ctx context.Context, manager *BPFMapManager) {
+	if manager == nil {
+		return
+	}
+
+	sample := time.NewTicker(statsHistoryInterval)
+	defer sample.Stop()
+	save := time.NewTicker(statsHistorySaveInterval)
+	defer save.Stop()
+
+	record := func() {
+		stats, err := manager.GetStats()
+		if err != nil {
+			log.Printf("Failed to read counters for statistics history: %v", err)
+			return
+		}
+		h.Record(h.clock.Now(), stats)
+	}
+	record()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sample.C:
+			record()
+		case <-save.C:
+			if err := h.Save(); err != nil {
+				log.Printf("Failed to save statistics history: %v", err)
+			}
+		}
+	}
+}
+
+// GetStatsHistory returns host data plane counters over time, 24 hours at
+// minute resolution, 30 days hourly and 365 days daily
+func (s *Server) GetStatsHistory(ctx context.Context, req *pb.StatsHistoryRequest) (*pb.StatsHistoryResponse, error) {
+	if s.history == nil {
+		return nil, fmt.Errorf("statistics history is not enabled")
+	}
+
+	end := s.clock.Now()
+	if req.End != 0 {
+		end = time.Unix(req.End, 0)
+	}
+	start := end.Add(-24 * time.Hour)
+	if req.Start != 0 {
+		start = time.Unix(req.Start, 0)
+	}
+	if start.After(end) {
+		return nil, fmt.Errorf("start must not be after end")
+	}
+
+	archive, points, err := s.history.Query(start, end, req.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StatsHistoryResponse{Resolution: archive.resolution, Step: int64(archive.step.Seconds())}
+	for _, point := range points {
+		resp.Points = append(resp.Points, &pb.StatsPoint{
+			Timestamp: point.Time,
+			Pass:      point.Pass,
+			Drop:      point.Drop,
+			Redirect:  point.Redirect,
+			Error:     point.Error,
+			AvgPps:    float64(point.total()) / archive.step.Seconds(),
+			MaxPps:    point.MaxPPS,
+		})
+	}
+	return resp, nil
+}
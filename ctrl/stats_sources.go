@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+// Extension stats: metrics of site-specific modules registered in process
+// or pushed by companion binaries, exported with Cerberus's own
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prometheus name prefix of extension metrics, kept apart from the
+	// built-in cerberus_* names
+	statsMetricPrefix = "cerberus_ext_"
+
+	StatsMetricCounter = "counter"
+	StatsMetricGauge   = "gauge"
+
+	MaxStatsSources           = 64
+	MaxStatsSourceMetrics     = 1000
+	DefaultStatsPushTTL       = 5 * time.Minute
+	MaxStatsPushTTL           = 24 * time.Hour
+	statsSourceCollectTimeout = 2 * time.Second
+)
+
+var (
+	statsNamePattern      = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+	statsLabelNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,62}$`)
+)
+
+// StatsMetric is one value an extension publishes
+type StatsMetric struct {
+	Name   string // Exported as cerberus_ext_<source>_<name>
+	Help   string
+	Type   string            // StatsMetricCounter or StatsMetricGauge, empty = gauge
+	Labels map[string]string // Same label names for every metric of a name
+	Value  float64
+}
+
+// StatsSource is a module publishing metrics through the exporter and
+// GetStats. CollectStats is called on every scrape and GetStats call and
+// should return quickly; ctx ends after statsSourceCollectTimeout.
+type StatsSource interface {
+	Name() string
+	CollectStats(ctx context.Context) ([]StatsMetric, error)
+}
+
+// pushedStats is the latest snapshot a companion binary pushed
+type pushedStats struct {
+	metrics   []StatsMetric
+	pushedAt  time.Time
+	expiresAt time.Time
+}
+
+// statsSourceRegistry holds the registered sources and pushed snapshots,
+// which share one namespace of source names
+type statsSourceRegistry struct {
+	mutex   sync.Mutex
+	sources map[string]StatsSource
+	pushed  map[string]*pushedStats
+}
+
+var statsSources = &statsSourceRegistry{
+	sources: make(map[string]StatsSource),
+	pushed:  make(map[string]*pushedStats),
+}
+
+// RegisterStatsSource publishes a module's metrics from now on, usually
+// called from an init function. It panics on an invalid or taken name, like
+// RegisterAuthProvider.
+func RegisterStatsSource(source StatsSource) {
+	name := source.Name()
+	if !statsNamePattern.MatchString(name) {
+		panic(fmt.Sprintf("invalid stats source name %q", name))
+	}
+	statsSources.mutex.Lock()
+	defer statsSources.mutex.Unlock()
+	if _, exists := statsSources.sources[name]; exists {
+		panic(fmt.Sprintf("stats source %q registered twice", name))
+	}
+	delete(statsSources.pushed, name)
+	statsSources.sources[name] = source
+}
+
+// UnregisterStatsSource stops publishing a registered source
+func UnregisterStatsSource(name string) {
+	statsSources.mutex.Lock()
+	delete(statsSources.sources, name)
+	statsSources.mutex.Unlock()
+}
+
+// push replaces the snapshot of a pushed source
+func (r *statsSourceRegistry) push(name string, metrics []StatsMetric, ttl time.Duration, now time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.sources[name]; exists {
+		return fmt.Errorf("stats source %s is registered in process", name)
+	}
+	if _, exists := r.pushed[name]; !exists {
+		if len(r.sources)+len(r.pushed) >= MaxStatsSources {
+			return fmt.Errorf("at most %d stats sources can publish", MaxStatsSources)
+		}
+		log.Printf("Stats source %s pushed its first snapshot (%d metrics)", name, len(metrics))
+	}
+	r.pushed[name] = &pushedStats{metrics: metrics, pushedAt: now, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// snapshots collects every source, registered ones first, each by name.
+// Expired pushed snapshots are dropped.
+func (r *statsSourceRegistry) snapshots(ctx context.Context, now time.Time) []*pb.ExtensionStats {
+	r.mutex.Lock()
+	sources := make([]StatsSource, 0, len(r.sources))
+	for _, source := range r.sources {
+		sources = append(sources, source)
+	}
+	var pushed []*pb.ExtensionStats
+	for name, snapshot := range r.pushed {
+		if !now.Before(snapshot.expiresAt) {
+			log.Printf("Stats source %s expired, last pushed at %s", name, snapshot.pushedAt.Format(time.RFC3339))
+			delete(r.pushed, name)
+			continue
+		}
+		stats := &pb.ExtensionStats{Source: name, CollectedAt: snapshot.pushedAt.Unix(), Pushed: true, ExpiresAt: snapshot.expiresAt.Unix()}
+		for _, metric := range snapshot.metrics {
+			stats.Metrics = append(stats.Metrics, statsMetricToProto(metric))
+		}
+		pushed = append(pushed, stats)
+	}
+	r.mutex.Unlock()
+
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Name() < sources[j].Name() })
+	sort.Slice(pushed, func(i, j int) bool { return pushed[i].Source < pushed[j].Source })
+	var all []*pb.ExtensionStats
+	for _, source := range sources {
+		all = append(all, collectStatsSource(ctx, source, now))
+	}
+	return append(all, pushed...)
+}
+
+// collectStatsSource takes a snapshot of a registered source, leaving out
+// the metrics that fail validation
+func collectStatsSource(ctx context.Context, source StatsSource, now time.Time) *pb.ExtensionStats {
+	stats := &pb.ExtensionStats{Source: source.Name(), CollectedAt: now.Unix()}
+	ctx, cancel := context.WithTimeout(ctx, statsSourceCollectTimeout)
+	defer cancel()
+
+	metrics, err := source.CollectStats(ctx)
+	if err != nil {
+		stats.Error = err.Error()
+		return stats
+	}
+	valid, err := validateStatsMetrics(metrics)
+	if err != nil {
+		stats.Error = err.Error()
+	}
+	for _, metric := range valid {
+		stats.Metrics = append(stats.Metrics, statsMetricToProto(metric))
+	}
+	return stats
+}
+
+// validateStatsMetrics returns the metrics fit for export and an error
+// listing the others: invalid names, types or labels, metrics of a name
+// whose type, help or label names differ from its first, and repeated
+// label sets
+func validateStatsMetrics(metrics []StatsMetric) ([]StatsMetric, error) {
+	var errs ruleValidationError
+	if len(metrics) > MaxStatsSourceMetrics {
+		errs.add("metrics", "at most %d metrics per source, %d left out", MaxStatsSourceMetrics, len(metrics)-MaxStatsSourceMetrics)
+		metrics = metrics[:MaxStatsSourceMetrics]
+	}
+
+	type family struct{ kind, help, labels string }
+	families := make(map[string]family)
+	seen := make(map[string]bool)
+	var valid []StatsMetric
+	for i, metric := range metrics {
+		field := fmt.Sprintf("metrics[%d]", i)
+		if !statsNamePattern.MatchString(metric.Name) {
+			errs.add(field, "invalid metric name %q", metric.Name)
+			continue
+		}
+		if metric.Type == "" {
+			metric.Type = StatsMetricGauge
+		}
+		if metric.Type != StatsMetricCounter && metric.Type != StatsMetricGauge {
+			errs.add(field, "metric %s: type must be %s or %s", metric.Name, StatsMetricCounter, StatsMetricGauge)
+			continue
+		}
+		names := statsLabelNames(metric.Labels)
+		if i := slices.IndexFunc(names, func(name string) bool { return !statsLabelNamePattern.MatchString(name) }); i >= 0 {
+			errs.add(field, "metric %s: invalid label name %q", metric.Name, names[i])
+			continue
+		}
+		current := family{kind: metric.Type, help: metric.Help, labels: strings.Join(names, ",")}
+		if first, exists := families[metric.Name]; exists && first != current {
+			errs.add(field, "metric %s: type, help and label names must match its first metric", metric.Name)
+			continue
+		}
+		families[metric.Name] = current
+
+		series := metric.Name + "{" + statsLabelValues(metric.Labels, names) + "}"
+		if seen[series] {
+			errs.add(field, "metric %s repeats labels %v", metric.Name, metric.Labels)
+			continue
+		}
+		seen[series] = true
+		valid = append(valid, metric)
+	}
+	if len(errs) > 0 {
+		return valid, errs
+	}
+	return valid, nil
+}
+
+// statsLabelNames returns the label names of a metric, sorted
+func statsLabelNames(labels map[string]string) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// statsLabelValues returns the label values of a metric in names order
+func statsLabelValues(labels map[string]string, names []string) string {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = fmt.Sprintf("%s=%q", name, labels[name])
+	}
+	return strings.Join(values, ",")
+}
+
+// PushExtensionStats replaces the metrics a companion binary publishes
+// under its source name, kept until its TTL passes without a new push
+func (s *Server) PushExtensionStats(ctx context.Context, req *pb.PushExtensionStatsRequest) (*pb.StatusResponse, error) {
+	var errs ruleValidationError
+	if !statsNamePattern.MatchString(req.Source) {
+		errs.add("source", "invalid source name %q", req.Source)
+	}
+	ttl := DefaultStatsPushTTL
+	if req.TtlSeconds < 0 || time.Duration(req.TtlSeconds)*time.Second > MaxStatsPushTTL {
+		errs.add("ttl_seconds", "ttl_seconds must be from 0 to %d", int64(MaxStatsPushTTL.Seconds()))
+	} else if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	metrics := make([]StatsMetric, 0, len(req.Metrics))
+	for _, metric := range req.Metrics {
+		metrics = append(metrics, statsMetricFromProto(metric))
+	}
+	metrics, err := validateStatsMetrics(metrics)
+	if err != nil {
+		errs = append(errs, err.(ruleValidationError)...)
+	}
+	if len(errs) > 0 {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Extension stats validation failed: %v", errs)}, nil
+	}
+
+	if err := statsSources.push(req.Source, metrics, ttl, s.clock.Now()); err != nil {
+		return &pb.StatusResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.StatusResponse{Success: true, Message: fmt.Sprintf("%d metrics published for %s", len(metrics), req.Source)}, nil
+}
+
+// GetExtensionStats returns a snapshot of every extension source
+func (s *Server) GetExtensionStats(ctx context.Context, req *pb.Empty) (*pb.ExtensionStatsResponse, error) {
+	return &pb.ExtensionStatsResponse{Sources: statsSources.snapshots(ctx, s.clock.Now())}, nil
+}
+
+func statsMetricFromProto(metric *pb.ExtensionMetric) StatsMetric {
+	return StatsMetric{Name: metric.Name, Help: metric.Help, Type: metric.Type, Labels: metric.Labels, Value: metric.Value}
+}
+
+func statsMetricToProto(metric StatsMetric) *pb.ExtensionMetric {
+	return &pb.ExtensionMetric{Name: metric.Name, Help: metric.Help, Type: metric.Type, Labels: metric.Labels, Value: metric.Value}
+}
+
+// extensionCollector exports the extension metrics. It describes nothing
+// up front, the metrics being known only once collected.
+type extensionCollector struct {
+	clock Clock
+}
+
+// Describe implements prometheus.Collector
+func (c extensionCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector. A name two sources would both
+// export is kept for the first.
+func (c extensionCollector) Collect(ch chan<- prometheus.Metric) {
+	owners := make(map[string]string)
+	for _, stats := range statsSources.snapshots(context.Background(), c.clock.Now()) {
+		for _, metric := range stats.Metrics {
+			name := statsMetricPrefix + stats.Source + "_" + metric.Name
+			if owner, taken := owners[name]; taken && owner != stats.Source {
+				continue
+			}
+			owners[name] = stats.Source
+
+			names := statsLabelNames(metric.Labels)
+			values := make([]string, len(names))
+			for i, label := range names {
+				values[i] = metric.Labels[label]
+			}
+			valueType := prometheus.GaugeValue
+			if metric.Type == StatsMetricCounter {
+				valueType = prometheus.CounterValue
+			}
+			help := metric.Help
+			if help == "" {
+				help = fmt.Sprintf("%s metric of stats source %s", metric.Name, stats.Source)
+			}
+			m, err := prometheus.NewConstMetric(prometheus.NewDesc(name, help, names, nil), valueType, metric.Value, values...)
+			if err != nil {
+				continue
+			}
+			ch <- m
+		}
+	}
+}
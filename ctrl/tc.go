@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// TC attachment: clsact qdisc and direct-action bpf filters over rtnetlink
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/cilium/ebpf"
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// rtnetlink traffic control constants (linux/pkt_sched.h, linux/pkt_cls.h)
+const (
+	tcaKind    = 1 // TCA_KIND
+	tcaOptions = 2 // TCA_OPTIONS
+
+	tcaBPFFD    = 6 // TCA_BPF_FD
+	tcaBPFName  = 7 // TCA_BPF_NAME
+	tcaBPFFlags = 8 // TCA_BPF_FLAGS
+
+	tcaBPFFlagActDirect = 1 // Program return code is the verdict
+
+	tcHandleClsact = 0xFFFF0000 // Handle of the clsact qdisc
+	tcParentClsact = 0xFFFFFFF1 // TC_H_CLSACT
+	tcMinIngress   = 0xFFF2     // TC_H_MIN_INGRESS
+	tcMinEgress    = 0xFFF3     // TC_H_MIN_EGRESS
+
+	// Filter priority and handle of the Cerberus programs; detaching removes
+	// only these filters
+	tcFilterPriority = 0xC0
+	tcFilterHandle   = 1
+)
+
+// tcFilter is an attached ingress or egress filter
+type tcFilter struct {
+	ifindex int
+	parent  uint32
+}
+
+// attachEgressFilter attaches the program to the egress hook
+func attachEgressFilter(ifindex int, program *ebpf.Program) (*tcFilter, error) {
+	return attachTCFilter(ifindex, program, "tc_egress", tcMinEgress)
+}
+
+// attachIngressFilter attaches the program to the ingress hook
+func attachIngressFilter(ifindex int, program *ebpf.Program) (*tcFilter, error) {
+	return attachTCFilter(ifindex, program, "tc_ingress", tcMinIngress)
+}
+
+// attachMirrorFilter attaches the mirror program to the ingress hook of an
+// interface running the XDP program
+func attachMirrorFilter(ifindex int, program *ebpf.Program) (*tcFilter, error) {
+	return attachTCFilter(ifindex, program, "tc_mirror", tcMinIngress)
+}
+
+// attachTCFilter adds a clsact qdisc to an interface, unless it has one,
+// and attaches a direct-action filter running the program at the hook
+func attachTCFilter(ifindex int, program *ebpf.Program, name string, hook uint32) (*tcFilter, error) {
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rtnetlink: %v", err)
+	}
+	defer conn.Close()
+
+	qdisc := netlink.NewAttributeEncoder()
+	qdisc.String(tcaKind, "clsact")
+	err = tcRequest(conn, unix.RTM_NEWQDISC, netlink.Create|netlink.Excl,
+		tcMsg(ifindex, tcHandleClsact, tcParentClsact, 0), qdisc)
+	if err != nil && !errors.Is(err, unix.EEXIST) {
+		return nil, fmt.Errorf("failed to add clsact qdisc: %v", err)
+	}
+
+	parent := tcParentClsact&0xFFFF0000 | hook
+	filter := netlink.NewAttributeEncoder()
+	filter.String(tcaKind, "bpf")
+	filter.Nested(tcaOptions, func(options *netlink.AttributeEncoder) error {
+		options.Uint32(tcaBPFFD, uint32(program.FD()))
+		options.String(tcaBPFName, name)
+		options.Uint32(tcaBPFFlags, tcaBPFFlagActDirect)
+		return nil
+	})
+	err = tcRequest(conn, unix.RTM_NEWTFILTER, netlink.Create|netlink.Replace,
+		tcMsg(ifindex, tcFilterHandle, parent, tcFilterInfo()), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add %s filter: %v", name, err)
+	}
+	return &tcFilter{ifindex: ifindex, parent: parent}, nil
+}
+
+// detach removes the filter; the clsact qdisc stays for other users
+func (f *tcFilter) detach() error {
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, nil)
+	if err != nil {
+		return fmt.Errorf("failed to open rtnetlink: %v", err)
+	}
+	defer conn.Close()
+
+	filter := netlink.NewAttributeEncoder()
+	filter.String(tcaKind, "bpf")
+	err = tcRequest(conn, unix.RTM_DELTFILTER, 0,
+		tcMsg(f.ifindex, tcFilterHandle, f.parent, tcFilterInfo()), filter)
+	if err != nil && !errors.Is(err, unix.ENOENT) {
+		return err
+	}
+	return nil
+}
+
+// tcRequest sends a traffic control request and waits for its ack
+func tcRequest(conn *netlink.Conn, msgType uint16, flags netlink.HeaderFlags, msg []byte, attrs *netlink.AttributeEncoder) error {
+	encoded, err := attrs.Encode()
+	if err != nil {
+		return err
+	}
+	_, err = conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  netlink.HeaderType(msgType),
+			Flags: netlink.Request | netlink.Acknowledge | flags,
+		},
+		Data: append(msg, encoded...),
+	})
+	return err
+}
+
+// tcMsg encodes struct tcmsg
+func tcMsg(ifindex int, handle, parent, info uint32) []byte {
+	msg := make([]byte, 20)
+	msg[0] = unix.AF_UNSPEC
+	binary.NativeEndian.PutUint32(msg[4:], uint32(ifindex))
+	binary.NativeEndian.PutUint32(msg[8:], handle)
+	binary.NativeEndian.PutUint32(msg[12:], parent)
+	binary.NativeEndian.PutUint32(msg[16:], info)
+	return msg
+}
+
+// tcFilterInfo encodes the filter priority and protocol (ETH_P_ALL in
+// network byte order)
+func tcFilterInfo() uint32 {
+	protocol := binary.NativeEndian.Uint16(binary.BigEndian.AppendUint16(nil, unix.ETH_P_ALL))
+	return tcFilterPriority<<16 | uint32(protocol)
+}
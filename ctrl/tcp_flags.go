@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// TCP flags matches: which of a set of flags must be set, e.g. a bare SYN
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tcpFlagNames are the TCP header flags in bit order of byte 13 of the
+// header (must match TCPHDR_* in eBPF program)
+var tcpFlagNames = []string{"fin", "syn", "rst", "psh", "ack", "urg", "ece", "cwr"}
+
+// parseTCPFlags parses a TCP flags match "flags/mask": of the flags in
+// mask, exactly those in flags must be set. "syn/syn,ack" is a bare SYN,
+// "syn,fin" without a mask needs both set whatever the others are, and
+// "/syn,rst,ack" needs all three clear. An empty match is any flags.
+func parseTCPFlags(match string) (flags, mask uint8, err error) {
+	if match == "" {
+		return 0, 0, nil
+	}
+	set, maskList, hasMask := strings.Cut(match, "/")
+	if flags, err = parseTCPFlagList(set); err != nil {
+		return 0, 0, err
+	}
+	mask = flags
+	if hasMask {
+		if mask, err = parseTCPFlagList(maskList); err != nil {
+			return 0, 0, err
+		}
+		if mask == 0 {
+			return 0, 0, fmt.Errorf("mask names no flag")
+		}
+	} else if flags == 0 {
+		return 0, 0, fmt.Errorf("no flag given")
+	}
+	if flags&^mask != 0 {
+		return 0, 0, fmt.Errorf("%s not in mask", formatTCPFlagList(flags&^mask))
+	}
+	return flags, mask, nil
+}
+
+// parseTCPFlagList parses comma separated flag names
+func parseTCPFlagList(list string) (uint8, error) {
+	var bits uint8
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		bit := tcpFlagBit(name)
+		if bit == 0 {
+			return 0, fmt.Errorf("unknown flag %s, expected %s", name, strings.Join(tcpFlagNames, ", "))
+		}
+		bits |= bit
+	}
+	return bits, nil
+}
+
+func tcpFlagBit(name string) uint8 {
+	for i, flag := range tcpFlagNames {
+		if flag == name {
+			return 1 << i
+		}
+	}
+	return 0
+}
+
+// formatTCPFlags writes a match in the canonical form parseTCPFlags reads,
+// the mask left out when it equals the flags
+func formatTCPFlags(flags, mask uint8) string {
+	if mask == 0 {
+		return ""
+	}
+	if flags == mask {
+		return formatTCPFlagList(flags)
+	}
+	return formatTCPFlagList(flags) + "/" + formatTCPFlagList(mask)
+}
+
+// formatTCPFlagList writes flag bits as comma separated names in bit order
+func formatTCPFlagList(bits uint8) string {
+	var names []string
+	for i, name := range tcpFlagNames {
+		if bits&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// canonicalTCPFlags returns the canonical form of a match, or the input if
+// it does not parse
+func canonicalTCPFlags(match string) string {
+	flags, mask, err := parseTCPFlags(match)
+	if err != nil {
+		return match
+	}
+	return formatTCPFlags(flags, mask)
+}
+
+// tcpFlagsMatch mirrors the data plane check of a rule's TCP flags: a zero
+// mask matches any packet, otherwise only TCP packets with the flags
+func tcpFlagsMatch(protocol, packetFlags, flags, mask uint8) bool {
+	return mask == 0 || (protocol == 6 && packetFlags&mask == flags)
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Temporary allows: time-boxed access for an operator host during maintenance, revoked by the expiry reaper
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/netip"
+	"strings"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// EventTemporaryAllowGranted reports a TemporaryAllow grant; its rules
+	// are reported as RULE_EXPIRED when the window ends
+	EventTemporaryAllowGranted = "TEMPORARY_ALLOW_GRANTED"
+
+	// TemporaryAllowLabel marks the rules of a grant, its value is the grant ID
+	TemporaryAllowLabel = "temporary-allow"
+
+	// Window of a grant requested without a duration, and the longest window
+	DefaultTemporaryAllowDuration = 2 * time.Hour
+	MaxTemporaryAllowDuration     = 24 * time.Hour
+)
+
+// TemporaryAllow lets an operator host reach the given ports for a bounded
+// window instead of the firewall being switched off for maintenance. The
+// grant is one inbound allow rule per port range, ahead of every rule
+// matching overlapping traffic, labelled with the grant ID and expiring at
+// the end of the window.
+func (s *Server) TemporaryAllow(ctx context.Context, req *pb.TemporaryAllowRequest) (*pb.TemporaryAllowResponse, error) {
+	var errs ruleValidationError
+	source, err := netip.ParseAddr(req.SourceIp)
+	if err != nil {
+		errs.add("source_ip", "source_ip must be a host address: %v", err)
+	}
+	if len(req.Ports) == 0 {
+		errs.add("ports", "at least one port is required")
+	}
+	for _, ports := range req.Ports {
+		if ports.Start == 0 {
+			errs.add("ports", "ports must be given, 0 would allow every port")
+			break
+		}
+	}
+	protocol := req.Protocol
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		errs.add("protocol", "invalid protocol: %s, expected tcp or udp", protocol)
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if req.DurationSeconds == 0 {
+		duration = DefaultTemporaryAllowDuration
+	}
+	if req.DurationSeconds < 0 || req.DurationSeconds > int64(MaxTemporaryAllowDuration/time.Second) {
+		errs.add("duration_seconds", "duration must be between 1 and %d seconds", int64(MaxTemporaryAllowDuration/time.Second))
+	}
+	if req.Operator == "" {
+		errs.add("operator", "operator is required")
+	}
+	if len(errs) > 0 {
+		return &pb.TemporaryAllowResponse{Success: false, Message: fmt.Sprintf("Temporary allow validation failed: %v", errs), Errors: fieldErrors(errs)}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.clock.Now()
+	grantID := s.ids.NewID("grant")
+	description := fmt.Sprintf("Temporary allow for %s", req.Operator)
+	if req.Reason != "" {
+		description += ": " + req.Reason
+	}
+
+	var grant []*FirewallRule
+	for _, ports := range req.Ports {
+		rule := &FirewallRule{
+			ID:          s.ids.NewID("rule"),
+			Action:      "allow",
+			SrcIP:       source.Unmap().String(),
+			DstPort:     ports.Start,
+			DstPortEnd:  ports.End,
+			Protocol:    protocol,
+			Direction:   "inbound",
+			Enabled:     true,
+			Description: description,
+			Owner:       req.Operator,
+			ExpiresAt:   now.Add(duration),
+			Labels:      map[string]string{TemporaryAllowLabel: grantID},
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := s.validateRule(rule); err != nil {
+			return &pb.TemporaryAllowResponse{Success: false, Message: fmt.Sprintf("Temporary allow validation failed: %v", err), Errors: fieldErrors(err)}, nil
+		}
+		grant = append(grant, rule)
+	}
+
+	// Every rule of the grant takes the same priority, one ahead of the
+	// first entry any of them overlaps
+	priority, ok := s.priorityAhead(grant)
+	if !ok {
+		return &pb.TemporaryAllowResponse{Success: false, Message: "No priority is ahead of the rules matching this traffic"}, nil
+	}
+
+	if err := s.limits.checkRules(len(s.rules) + len(grant)); err != nil {
+		return nil, err
+	}
+
+	ruleIDs := make([]string, len(grant))
+	for i, rule := range grant {
+		rule.Priority = priority
+		s.rules[rule.ID] = rule
+		ruleIDs[i] = rule.ID
+	}
+	if err := s.applyPolicy(); err != nil {
+		for _, rule := range grant {
+			delete(s.rules, rule.ID)
+		}
+		return &pb.TemporaryAllowResponse{Success: false, Message: fmt.Sprintf("Failed to push temporary allow to data plane: %v", withRemediation(err))}, nil
+	}
+
+	s.persistPolicy()
+	expiresAt := now.Add(duration).UTC().Format(time.RFC3339)
+	ports := make([]string, len(grant))
+	for i, rule := range grant {
+		s.events.Publish(ruleEvent(EventRuleAdded, rule))
+		ports[i] = docDstPort(rule)
+	}
+	event := ruleEvent(EventTemporaryAllowGranted, grant[0])
+	event.Message = fmt.Sprintf("%s granted %s access to %s ports %s until %s (%s)",
+		grantID, source, protocol, strings.Join(ports, ","), expiresAt, description)
+	event.Severity = "medium"
+	s.events.Publish(event)
+	log.Printf("🔓 Temporary allow %s for %s: %s to %s ports %s for %s until %s (%s)",
+		grantID, req.Operator, source, protocol, strings.Join(ports, ","), duration, expiresAt, strings.Join(ruleIDs, ", "))
+
+	return &pb.TemporaryAllowResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Access granted until %s", expiresAt),
+		GrantId:   grantID,
+		RuleIds:   ruleIDs,
+		ExpiresAt: now.Add(duration).Unix(),
+		Priority:  priority,
+	}, nil
+}
+
+// priorityAhead returns the priority one ahead of the first entry of the
+// policy any of rules overlaps, 0 if none does, and false if that entry
+// already has the lowest priority. Caller must hold s.mutex.
+func (s *Server) priorityAhead(rules []*FirewallRule) (int32, bool) {
+	priority := int32(math.MaxInt32)
+	for _, entry := range s.compiledPolicy().Sorted {
+		for _, rule := range rules {
+			if entriesOverlap(rule, entry) && entry.Priority < priority {
+				priority = entry.Priority
+			}
+		}
+	}
+	switch priority {
+	case math.MinInt32:
+		return 0, false
+	case math.MaxInt32:
+		return 0, true
+	}
+	return priority - 1, true
+}
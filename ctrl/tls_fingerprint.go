@@ -0,0 +1,860 @@
+// SPDX-License-Identifier: Apache-2.0
+// TLS fingerprinting: JA3 and JA4 fingerprints of the ClientHellos the XDP
+// program reports, and the rules that block or alert on them
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned TLS fingerprinting maps (must match eBPF program)
+	TLSConfigMapName  = "cerberus_tls_config"
+	TLSBlockedMapName = "cerberus_tls_blocked"
+	TLSHellosMapName  = "cerberus_tls_hellos"
+	TLSStatsMapName   = "cerberus_tls_stats"
+
+	// Payload bytes the data plane copies (must match TLS_HELLO_MAX in
+	// eBPF program)
+	tlsHelloMax = 2048
+
+	// Fingerprints of all rules together
+	MaxTLSFingerprints = 64 * 1024
+)
+
+// TLS fingerprinting events
+const (
+	EventTLSFingerprintBlocked = "TLS_FINGERPRINT_BLOCKED"
+	EventTLSFingerprintMatched = "TLS_FINGERPRINT_MATCHED" // Of alert rules
+	EventTLSClientHello        = "TLS_CLIENT_HELLO"        // Unmatched, reported with CERBERUS_TLS_REPORT=all
+)
+
+// Fingerprint rule actions
+const (
+	TLSFingerprintBlock = "block"
+	TLSFingerprintAlert = "alert"
+)
+
+// ClientHellos reported as events, set with CERBERUS_TLS_REPORT
+const (
+	TLSReportMatched = "matched"
+	TLSReportAll     = "all"
+)
+
+// TLS extensions the fingerprints treat specially
+const (
+	tlsExtServerName          = 0x0000
+	tlsExtSupportedGroups     = 0x000a
+	tlsExtPointFormats        = 0x000b
+	tlsExtSignatureAlgorithms = 0x000d
+	tlsExtALPN                = 0x0010
+	tlsExtSupportedVersions   = 0x002b
+)
+
+var (
+	ja3Pattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+	ja4Pattern = regexp.MustCompile(`^[tqd](13|12|11|10|s3|s2|d1|d2|d3|00)[di][0-9]{4}[0-9a-z]{2}_[0-9a-f]{12}_[0-9a-f]{12}$`)
+
+	// JA4 version codes by protocol version
+	ja4Versions = map[uint16]string{
+		0x0304: "13", 0x0303: "12", 0x0302: "11", 0x0301: "10", 0x0300: "s3", 0x0002: "s2",
+		0xfeff: "d1", 0xfefd: "d2", 0xfefc: "d3",
+	}
+)
+
+// TLSFingerprintRule is a named set of JA3 or JA4 fingerprints whose
+// connections are blocked or alerted on
+type TLSFingerprintRule struct {
+	Name         string   `json:"name"`
+	Action       string   `json:"action"`       // "block" or "alert"
+	Fingerprints []string `json:"fingerprints"` // JA3 MD5 hashes or JA4 fingerprints
+	Description  string   `json:"description"`
+}
+
+// TLSFingerprintConfig is which ClientHellos are reported
+type TLSFingerprintConfig struct {
+	Report string // TLSReportMatched or TLSReportAll
+}
+
+// tlsConfig mirrors struct tls_config in the eBPF program
+type tlsConfig struct {
+	Enabled uint8
+	Pad     [3]uint8
+}
+
+// tlsHello mirrors struct tls_hello in the eBPF program
+type tlsHello struct {
+	Timestamp uint64 // CLOCK_MONOTONIC nanoseconds
+	Ifindex   uint32
+	Len       uint16
+	Pad       [2]uint8
+	Key       ConntrackKey
+	Data      [tlsHelloMax]byte
+}
+
+// tlsStats mirrors struct tls_stats in the eBPF program
+type tlsStats struct {
+	Hellos  uint64
+	Dropped uint64
+	Lost    uint64
+}
+
+// tlsFingerprintConfigFromEnv reads CERBERUS_TLS_REPORT: matched reports
+// the ClientHellos a rule matches, all every one, to learn fingerprints
+func tlsFingerprintConfigFromEnv() (TLSFingerprintConfig, error) {
+	config := TLSFingerprintConfig{Report: TLSReportMatched}
+	switch report := os.Getenv("CERBERUS_TLS_REPORT"); report {
+	case "":
+	case TLSReportMatched, TLSReportAll:
+		config.Report = report
+	default:
+		return config, fmt.Errorf("invalid CERBERUS_TLS_REPORT %q, expected %s or %s", report, TLSReportMatched, TLSReportAll)
+	}
+	return config, nil
+}
+
+// clientHello is what the fingerprints of a ClientHello are computed
+// from. GREASE values are left out.
+type clientHello struct {
+	version             uint16 // legacy_version
+	ciphers             []uint16
+	extensions          []uint16 // In the order sent
+	groups              []uint16
+	pointFormats        []uint8
+	signatureAlgorithms []uint16
+	supportedVersions   []uint16
+	alpn                []string
+	serverName          string
+	hasServerName       bool
+}
+
+var (
+	// errTruncatedHello is returned for ClientHellos longer than the
+	// segment they start in
+	errTruncatedHello = errors.New("ClientHello continues beyond the reported segment")
+	errMalformedHello = errors.New("malformed ClientHello")
+)
+
+// tlsGREASE reports whether a value is one of the reserved GREASE values
+// (RFC 8701), which clients send at random and fingerprints ignore
+func tlsGREASE(value uint16) bool {
+	return value&0x0f0f == 0x0a0a && value>>8 == value&0xff
+}
+
+// tlsReader reads big endian fields, remembering the first overrun
+type tlsReader struct {
+	data []byte
+	err  error
+}
+
+func (r *tlsReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > len(r.data) {
+		r.err = errTruncatedHello
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *tlsReader) u8() int {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return int(b[0])
+}
+
+func (r *tlsReader) u16() int {
+	b := r.bytes(2)
+	if b == nil {
+		return 0
+	}
+	return int(binary.BigEndian.Uint16(b))
+}
+
+func (r *tlsReader) u24() int {
+	b := r.bytes(3)
+	if b == nil {
+		return 0
+	}
+	return int(b[0])<<16 | int(b[1])<<8 | int(b[2])
+}
+
+// uint16s reads a list of 16-bit values without the GREASE ones
+func uint16s(data []byte) []uint16 {
+	var values []uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		if value := binary.BigEndian.Uint16(data[i:]); !tlsGREASE(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseClientHello parses the TLS record holding a ClientHello
+func parseClientHello(data []byte) (*clientHello, error) {
+	record := &tlsReader{data: data}
+	if record.u8() != 22 {
+		return nil, fmt.Errorf("not a handshake record")
+	}
+	record.u16() // Record version
+	record.u16() // Record length; the handshake may span records
+	if record.u8() != 1 {
+		return nil, fmt.Errorf("not a ClientHello")
+	}
+	r := &tlsReader{data: record.bytes(record.u24())}
+	if record.err != nil {
+		return nil, record.err
+	}
+
+	hello := &clientHello{version: uint16(r.u16())}
+	r.bytes(32)     // Random
+	r.bytes(r.u8()) // Session ID
+	hello.ciphers = uint16s(r.bytes(r.u16()))
+	r.bytes(r.u8()) // Compression methods
+	if r.err != nil {
+		return nil, errMalformedHello
+	}
+	if len(r.data) == 0 {
+		return hello, nil // No extensions
+	}
+
+	extensions := &tlsReader{data: r.bytes(r.u16())}
+	for r.err == nil && extensions.err == nil && len(extensions.data) > 0 {
+		kind := uint16(extensions.u16())
+		ext := &tlsReader{data: extensions.bytes(extensions.u16())}
+		if extensions.err != nil {
+			break
+		}
+		if tlsGREASE(kind) {
+			continue
+		}
+		hello.extensions = append(hello.extensions, kind)
+		switch kind {
+		case tlsExtServerName:
+			hello.hasServerName = true
+			names := &tlsReader{data: ext.bytes(ext.u16())}
+			for names.err == nil && len(names.data) > 0 {
+				nameType, name := names.u8(), names.bytes(names.u16())
+				if nameType == 0 && names.err == nil {
+					hello.serverName = string(name)
+					break
+				}
+			}
+		case tlsExtSupportedGroups:
+			hello.groups = uint16s(ext.bytes(ext.u16()))
+		case tlsExtPointFormats:
+			hello.pointFormats = ext.bytes(ext.u8())
+		case tlsExtSignatureAlgorithms:
+			hello.signatureAlgorithms = uint16s(ext.bytes(ext.u16()))
+		case tlsExtALPN:
+			protocols := &tlsReader{data: ext.bytes(ext.u16())}
+			for protocols.err == nil && len(protocols.data) > 0 {
+				if protocol := protocols.bytes(protocols.u8()); protocols.err == nil {
+					hello.alpn = append(hello.alpn, string(protocol))
+				}
+			}
+		case tlsExtSupportedVersions:
+			hello.supportedVersions = uint16s(ext.bytes(ext.u8()))
+		}
+	}
+	if r.err != nil || extensions.err != nil {
+		return nil, errMalformedHello
+	}
+	return hello, nil
+}
+
+// joinDecimal joins values as JA3 lists them
+func joinDecimal[T uint8 | uint16](values []T) string {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = strconv.Itoa(int(value))
+	}
+	return strings.Join(texts, "-")
+}
+
+// ja3String returns the JA3 string: version, ciphers, extensions, groups
+// and point formats
+func (h *clientHello) ja3String() string {
+	return strings.Join([]string{
+		strconv.Itoa(int(h.version)),
+		joinDecimal(h.ciphers),
+		joinDecimal(h.extensions),
+		joinDecimal(h.groups),
+		joinDecimal(h.pointFormats),
+	}, ",")
+}
+
+// ja3 returns the JA3 fingerprint, the MD5 hash of the JA3 string
+func (h *clientHello) ja3() string {
+	sum := md5.Sum([]byte(h.ja3String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// ja4Hash returns the first 12 hex digits of the SHA-256 of text, or
+// zeros for an empty list
+func ja4Hash(text string) string {
+	if text == "" {
+		return "000000000000"
+	}
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// joinHex joins values as JA4 lists them, optionally sorted
+func joinHex(values []uint16, sorted bool) string {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = fmt.Sprintf("%04x", value)
+	}
+	if sorted {
+		sort.Strings(texts)
+	}
+	return strings.Join(texts, ",")
+}
+
+// ja4 returns the JA4 fingerprint of a ClientHello sent over TCP: the
+// version, SNI, counts and ALPN part, the hash of the sorted ciphers, and
+// the hash of the sorted extensions with the signature algorithms
+func (h *clientHello) ja4() string {
+	version := h.version
+	if len(h.supportedVersions) > 0 {
+		version = slices.Max(h.supportedVersions)
+	}
+	versionCode, ok := ja4Versions[version]
+	if !ok {
+		versionCode = "00"
+	}
+	sni := "i"
+	if h.hasServerName {
+		sni = "d"
+	}
+	alpn := "00"
+	if len(h.alpn) > 0 && h.alpn[0] != "" {
+		first, last := h.alpn[0][0], h.alpn[0][len(h.alpn[0])-1]
+		if isAlphanumeric(first) && isAlphanumeric(last) {
+			alpn = string([]byte{first, last})
+		} else {
+			digits := hex.EncodeToString([]byte(h.alpn[0]))
+			alpn = string([]byte{digits[0], digits[len(digits)-1]})
+		}
+	}
+	a := fmt.Sprintf("t%s%s%02d%02d%s", versionCode, sni, min(len(h.ciphers), 99), min(len(h.extensions), 99), alpn)
+
+	var extensions []uint16
+	for _, ext := range h.extensions {
+		if ext != tlsExtServerName && ext != tlsExtALPN {
+			extensions = append(extensions, ext)
+		}
+	}
+	c := joinHex(extensions, true)
+	if c != "" && len(h.signatureAlgorithms) > 0 {
+		c += "_" + joinHex(h.signatureAlgorithms, false)
+	}
+	return a + "_" + ja4Hash(joinHex(h.ciphers, true)) + "_" + ja4Hash(c)
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// normalizeTLSFingerprint returns the form a fingerprint is matched in
+func normalizeTLSFingerprint(fingerprint string) (string, error) {
+	fingerprint = strings.ToLower(strings.TrimSpace(fingerprint))
+	if !ja3Pattern.MatchString(fingerprint) && !ja4Pattern.MatchString(fingerprint) {
+		return "", fmt.Errorf("expected a JA3 hash (32 hex digits) or a JA4 fingerprint such as t13d1516h2_8daaf6152771_e5627efa2ab1")
+	}
+	return fingerprint, nil
+}
+
+// validateTLSFingerprintRule checks a rule and normalizes its
+// fingerprints: lowercased, sorted and unique
+func validateTLSFingerprintRule(rule *TLSFingerprintRule) error {
+	var errs ruleValidationError
+	if rule.Name == "" {
+		errs.add("name", "name is required")
+	}
+	if rule.Action != TLSFingerprintBlock && rule.Action != TLSFingerprintAlert {
+		errs.add("action", "invalid action: %s, expected %s or %s", rule.Action, TLSFingerprintBlock, TLSFingerprintAlert)
+	}
+	if len(rule.Fingerprints) == 0 {
+		errs.add("fingerprints", "at least one fingerprint is required")
+	}
+	seen := make(map[string]bool, len(rule.Fingerprints))
+	fingerprints := make([]string, 0, len(rule.Fingerprints))
+	for _, fingerprint := range rule.Fingerprints {
+		normalized, err := normalizeTLSFingerprint(fingerprint)
+		if err != nil {
+			errs.add("fingerprints", "invalid fingerprint %q: %v", fingerprint, err)
+			continue
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			fingerprints = append(fingerprints, normalized)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	sort.Strings(fingerprints)
+	rule.Fingerprints = fingerprints
+	return nil
+}
+
+// compileTLSFingerprintRules maps every listed fingerprint to its rule. A
+// fingerprint both kinds of rule hold is blocked; otherwise the rule first
+// by name keeps it.
+func compileTLSFingerprintRules(rules map[string]*TLSFingerprintRule) map[string]*TLSFingerprintRule {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	compiled := make(map[string]*TLSFingerprintRule)
+	for _, name := range names {
+		rule := rules[name]
+		for _, fingerprint := range rule.Fingerprints {
+			if previous, exists := compiled[fingerprint]; exists &&
+				(previous.Action == TLSFingerprintBlock || rule.Action == TLSFingerprintAlert) {
+				continue
+			}
+			compiled[fingerprint] = rule
+		}
+	}
+	return compiled
+}
+
+// SetTLSFingerprintRule creates a fingerprint rule or replaces the one with
+// the same name
+func (s *Server) SetTLSFingerprintRule(ctx context.Context, req *pb.SetTLSFingerprintRuleRequest) (*pb.TLSFingerprintRuleResponse, error) {
+	if req.GetRule() == nil {
+		return &pb.TLSFingerprintRuleResponse{Success: false, Message: "Fingerprint rule is required"}, nil
+	}
+
+	rule := tlsFingerprintRuleFromProto(req.Rule)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := validateTLSFingerprintRule(rule); err != nil {
+		return &pb.TLSFingerprintRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("Fingerprint rule validation failed: %v", err),
+		}, nil
+	}
+	rules := make(map[string]*TLSFingerprintRule, len(s.tlsRules)+1)
+	for name, existing := range s.tlsRules {
+		rules[name] = existing
+	}
+	rules[rule.Name] = rule
+
+	if err := s.pushTLSFingerprintRules(rules); err != nil {
+		return &pb.TLSFingerprintRuleResponse{Success: false, Message: fmt.Sprintf("Failed to push fingerprint rules to data plane: %v", withRemediation(err))}, nil
+	}
+	s.tlsRules = rules
+	s.persistPolicy()
+	log.Printf("Set TLS fingerprint rule: %s (%s, %d fingerprints)", rule.Name, rule.Action, len(rule.Fingerprints))
+
+	return &pb.TLSFingerprintRuleResponse{
+		Success: true,
+		Message: "Fingerprint rule saved successfully",
+		Rule:    s.tlsFingerprintRuleToProto(rule),
+	}, nil
+}
+
+// DeleteTLSFingerprintRule removes a fingerprint rule; with the last one
+// gone ClientHellos are no longer reported, unless all are
+func (s *Server) DeleteTLSFingerprintRule(ctx context.Context, req *pb.DeleteTLSFingerprintRuleRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.tlsRules[req.Name]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Fingerprint rule not found"}, nil
+	}
+	rules := make(map[string]*TLSFingerprintRule, len(s.tlsRules))
+	for name, rule := range s.tlsRules {
+		if name != req.Name {
+			rules[name] = rule
+		}
+	}
+	if err := s.pushTLSFingerprintRules(rules); err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove fingerprint rule from data plane: %v", withRemediation(err))}, nil
+	}
+	s.tlsRules = rules
+	s.persistPolicy()
+	log.Printf("Deleted TLS fingerprint rule: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Fingerprint rule deleted successfully"}, nil
+}
+
+// ListTLSFingerprintRules returns the fingerprint rules ordered by name,
+// with the reporting setting and the counters
+func (s *Server) ListTLSFingerprintRules(ctx context.Context, req *pb.Empty) (*pb.TLSFingerprintRulesResponse, error) {
+	return s.tlsFingerprintStatus(), nil
+}
+
+// tlsFingerprintStatus returns the fingerprint rules ordered by name, with
+// the reporting setting, the data plane's counters and the inspector's
+func (s *Server) tlsFingerprintStatus() *pb.TLSFingerprintRulesResponse {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.TLSFingerprintRulesResponse{
+		Enabled: s.tlsFingerprintingEnabled(len(s.tlsRules)),
+		Report:  s.tlsConfig.Report,
+	}
+	for _, rule := range s.sortedTLSFingerprintRules() {
+		resp.Rules = append(resp.Rules, s.tlsFingerprintRuleToProto(rule))
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.TLSStats()
+		if err != nil {
+			log.Printf("⚠️  Failed to read TLS fingerprinting counters: %v", err)
+		}
+		resp.Hellos = stats.Hellos
+		resp.DroppedPackets = stats.Dropped
+		resp.Lost = stats.Lost
+	}
+	if s.tlsInspector != nil {
+		resp.Fingerprinted, resp.Truncated, resp.BlockedFlows = s.tlsInspector.Counts()
+	}
+	return resp
+}
+
+// tlsFingerprintingEnabled reports whether the data plane reports
+// ClientHellos: some rule exists or all are reported
+func (s *Server) tlsFingerprintingEnabled(rules int) bool {
+	return rules > 0 || s.tlsConfig.Report == TLSReportAll
+}
+
+// pushTLSFingerprintRules compiles rules and enables the data plane's
+// reports if needed, keeping the rules as those reports are matched
+// against. Caller must hold s.mutex.
+func (s *Server) pushTLSFingerprintRules(rules map[string]*TLSFingerprintRule) error {
+	compiled := compileTLSFingerprintRules(rules)
+	if len(compiled) > MaxTLSFingerprints {
+		return fmt.Errorf("fingerprint limit reached (%d fingerprints in all rules)", MaxTLSFingerprints)
+	}
+	if s.bpfManager != nil {
+		config := tlsConfig{}
+		if s.tlsFingerprintingEnabled(len(rules)) {
+			config.Enabled = 1
+		}
+		if err := s.bpfManager.SetTLSFilter(config); err != nil {
+			return err
+		}
+	}
+	s.tlsFingerprints = compiled
+	return nil
+}
+
+// sortedTLSFingerprintRules returns the fingerprint rules ordered by name.
+// Caller must hold s.mutex.
+func (s *Server) sortedTLSFingerprintRules() []*TLSFingerprintRule {
+	rules := make([]*TLSFingerprintRule, 0, len(s.tlsRules))
+	for _, rule := range s.tlsRules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
+func tlsFingerprintRuleFromProto(rule *pb.TLSFingerprintRule) *TLSFingerprintRule {
+	return &TLSFingerprintRule{
+		Name:         rule.Name,
+		Action:       rule.Action,
+		Fingerprints: rule.Fingerprints,
+		Description:  rule.Description,
+	}
+}
+
+// tlsFingerprintRuleToProto converts a rule with the count of ClientHellos
+// it matched. Caller must hold s.mutex.
+func (s *Server) tlsFingerprintRuleToProto(rule *TLSFingerprintRule) *pb.TLSFingerprintRule {
+	resp := &pb.TLSFingerprintRule{
+		Name:         rule.Name,
+		Action:       rule.Action,
+		Fingerprints: rule.Fingerprints,
+		Description:  rule.Description,
+	}
+	if s.tlsInspector != nil {
+		resp.Matches = s.tlsInspector.Matches(rule.Name)
+	}
+	return resp
+}
+
+// TLSInspector fingerprints the ClientHellos the data plane reports,
+// blocks the flows of blocked fingerprints and publishes events
+type TLSInspector struct {
+	server  *Server
+	manager *BPFMapManager
+
+	mutex         sync.Mutex
+	matches       map[string]uint64 // ClientHellos by rule name
+	fingerprinted uint64
+	truncated     uint64 // Not fingerprinted, longer than their segment
+	blocked       uint64 // Flows blocked
+}
+
+// NewTLSInspector creates a reader of the ClientHellos a data plane reports
+func NewTLSInspector(server *Server, manager *BPFMapManager) *TLSInspector {
+	return &TLSInspector{server: server, manager: manager, matches: make(map[string]uint64)}
+}
+
+// Run fingerprints reported ClientHellos until ctx is done. It returns at
+// once when the data plane has no TLS fingerprinting maps.
+func (t *TLSInspector) Run(ctx context.Context) {
+	if t.manager == nil || t.manager.tlsHellos == nil {
+		return
+	}
+
+	records, cancel, err := t.manager.rings.Consume(TLSHellosMapName, t.manager.tlsHellos, "tls-fingerprint")
+	if err != nil {
+		log.Printf("Failed to open TLS ClientHello ring buffer: %v", err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	for record := range records {
+		var report tlsHello
+		if err := binary.Read(bytes.NewReader(record), binary.NativeEndian, &report); err != nil {
+			log.Printf("Malformed TLS ClientHello report: %v", err)
+			continue
+		}
+		if event := t.inspect(&report); event != nil {
+			t.server.events.Publish(event)
+		}
+	}
+}
+
+// inspect fingerprints one reported ClientHello and applies the rule its
+// fingerprints match, returning the event to publish, if any
+func (t *TLSInspector) inspect(report *tlsHello) *pb.Event {
+	hello, err := parseClientHello(report.Data[:min(int(report.Len), tlsHelloMax)])
+	t.mutex.Lock()
+	if errors.Is(err, errTruncatedHello) {
+		t.truncated++
+	} else if err == nil {
+		t.fingerprinted++
+	}
+	t.mutex.Unlock()
+	if err != nil {
+		return nil
+	}
+
+	ja3, ja4 := hello.ja3(), hello.ja4()
+	t.server.mutex.RLock()
+	rule, fingerprint := t.server.tlsFingerprints[ja4], ja4
+	if rule == nil || rule.Action != TLSFingerprintBlock {
+		if byJA3 := t.server.tlsFingerprints[ja3]; byJA3 != nil && (rule == nil || byJA3.Action == TLSFingerprintBlock) {
+			rule, fingerprint = byJA3, ja3
+		}
+	}
+	reportAll := t.server.tlsConfig.Report == TLSReportAll
+	t.server.mutex.RUnlock()
+
+	if rule == nil {
+		if !reportAll {
+			return nil
+		}
+		return report.event(hello, ja3, ja4, nil, "", t.server.clock.Now(), monotonicNow())
+	}
+	t.mutex.Lock()
+	t.matches[rule.Name]++
+	t.mutex.Unlock()
+	if rule.Action == TLSFingerprintBlock {
+		if err := t.manager.BlockTLSFlow(report.Key); err != nil {
+			log.Printf("⚠️  Failed to block TLS flow: %v", err)
+		} else {
+			t.mutex.Lock()
+			t.blocked++
+			t.mutex.Unlock()
+		}
+	}
+	return report.event(hello, ja3, ja4, rule, fingerprint, t.server.clock.Now(), monotonicNow())
+}
+
+// Matches returns the ClientHellos a rule matched
+func (t *TLSInspector) Matches(rule string) uint64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.matches[rule]
+}
+
+// Counts returns the ClientHellos fingerprinted, those too long to be, and
+// the flows blocked
+func (t *TLSInspector) Counts() (fingerprinted, truncated, blocked uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.fingerprinted, t.truncated, t.blocked
+}
+
+// event converts a fingerprinted ClientHello to a TLS_FINGERPRINT_BLOCKED
+// or TLS_FINGERPRINT_MATCHED event, or a TLS_CLIENT_HELLO one if no rule
+// matched; wall is the clock time at monotonic time now
+func (r *tlsHello) event(hello *clientHello, ja3, ja4 string, rule *TLSFingerprintRule, fingerprint string, wall time.Time, now uint64) *pb.Event {
+	src, dst := r.Key.addrs()
+	timestamp := wall
+	if now > r.Timestamp {
+		timestamp = timestamp.Add(-time.Duration(now - r.Timestamp))
+	}
+	server := hello.serverName
+	if server == "" {
+		server = dst.String()
+	}
+
+	event := &pb.Event{
+		Type:      EventTLSClientHello,
+		Timestamp: timestamp.Unix(),
+		Source:    src.String(),
+		Target:    dst.String(),
+		Protocol:  "tcp",
+		Port:      int32(r.Key.DstPort),
+		Severity:  "low",
+		Message:   fmt.Sprintf("TLS ClientHello for %s, JA4 %s", server, ja4),
+		Metadata: map[string]string{
+			"ja3":        ja3,
+			"ja3_string": hello.ja3String(),
+			"ja4":        ja4,
+			"sni":        hello.serverName,
+			"alpn":       strings.Join(hello.alpn, ","),
+			"src_port":   strconv.Itoa(int(r.Key.SrcPort)),
+			"interface":  interfaceName(r.Ifindex),
+			"timestamp":  timestamp.Format(time.RFC3339Nano),
+		},
+	}
+	if rule == nil {
+		return event
+	}
+	event.Metadata["rule"] = rule.Name
+	event.Metadata["action"] = rule.Action
+	event.Metadata["fingerprint"] = fingerprint
+	if rule.Action == TLSFingerprintBlock {
+		event.Type = EventTLSFingerprintBlocked
+		event.Severity = "high"
+		event.Message = fmt.Sprintf("TLS connection to %s blocked by fingerprint rule %s (%s)", server, rule.Name, fingerprint)
+	} else {
+		event.Type = EventTLSFingerprintMatched
+		event.Severity = "medium"
+		event.Message = fmt.Sprintf("TLS connection to %s matched fingerprint rule %s (%s)", server, rule.Name, fingerprint)
+	}
+	return event
+}
+
+// openTLSFilter opens the pinned TLS fingerprinting maps and turns off
+// the reports of a previous control plane run; stored rules are re-pushed
+// on restore. Flows it blocked stay blocked.
+func (bm *BPFMapManager) openTLSFilter() {
+	names := []string{TLSConfigMapName, TLSBlockedMapName, TLSHellosMapName, TLSStatsMapName}
+	maps := make([]*ebpf.Map, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(bm.pinPath, name)
+		m, err := ebpf.LoadPinnedMap(path, nil)
+		if err != nil {
+			log.Printf("⚠️  TLS fingerprinting not available at %s: %v", path, err)
+			for _, opened := range maps {
+				opened.Close()
+			}
+			return
+		}
+		maps = append(maps, m)
+	}
+	bm.tlsConfig, bm.tlsBlocked, bm.tlsHellos, bm.tlsStats = maps[0], maps[1], maps[2], maps[3]
+
+	if err := bm.SetTLSFilter(tlsConfig{}); err != nil {
+		log.Printf("⚠️  Failed to reset TLS fingerprinting: %v", err)
+	}
+}
+
+// closeTLSFilter closes the TLS fingerprinting maps, if open
+func (bm *BPFMapManager) closeTLSFilter() {
+	if bm.tlsConfig == nil {
+		return
+	}
+	bm.tlsConfig.Close()
+	bm.tlsBlocked.Close()
+	bm.tlsHellos.Close()
+	bm.tlsStats.Close()
+}
+
+// SetTLSFilter sets whether the data plane reports ClientHellos
+func (bm *BPFMapManager) SetTLSFilter(config tlsConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting TLS fingerprinting (enabled: %v)", config.Enabled != 0)
+		return nil
+	}
+	if bm.tlsConfig == nil {
+		return fmt.Errorf("TLS fingerprinting maps not available")
+	}
+	key := uint32(0)
+	if err := bm.tlsConfig.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write TLS fingerprinting settings: %v", err)
+	}
+	return nil
+}
+
+// BlockTLSFlow makes the data plane drop the later packets of a flow, in
+// both directions
+func (bm *BPFMapManager) BlockTLSFlow(key ConntrackKey) error {
+	if bm.simulated {
+		return nil
+	}
+	if bm.tlsBlocked == nil {
+		return fmt.Errorf("TLS fingerprinting maps not available")
+	}
+	blockedAt := monotonicNow()
+	for _, flow := range []ConntrackKey{key, key.reverse()} {
+		if err := bm.tlsBlocked.Put(&flow, &blockedAt); err != nil {
+			return fmt.Errorf("failed to write blocked TLS flow: %v", err)
+		}
+	}
+	return nil
+}
+
+// TLSStats reads the TLS fingerprinting counters, summed across CPUs
+func (bm *BPFMapManager) TLSStats() (tlsStats, error) {
+	var stats tlsStats
+	if bm.simulated || bm.tlsStats == nil {
+		return stats, nil
+	}
+	var perCPU []tlsStats
+	key := uint32(0)
+	if err := bm.tlsStats.Lookup(&key, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Hellos += value.Hellos
+		stats.Dropped += value.Dropped
+		stats.Lost += value.Lost
+	}
+	return stats, nil
+}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: Apache-2.0
+// Tunnel termination: GRE, IPIP and IPv6-in-IP tunnels counted per endpoint
+// pair and, when configured, filtered by their inner headers
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"path/filepath"
+	"sort"
+
+	"github.com/cilium/ebpf"
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Pinned tunnel maps (must match eBPF program)
+	TunnelsMapName     = "cerberus_tunnels"
+	TunnelStatsMapName = "cerberus_tunnel_stats"
+
+	// Tunnel IDs index the stats map, 0 = no tunnel (must match MAX_TUNNELS
+	// in eBPF program)
+	MaxTunnels = 256
+)
+
+// tunnelProtocols are the tunnel types, named as the rule protocol of their
+// outer headers
+var tunnelProtocols = map[string]bool{"gre": true, "ipip": true, "ipv6": true}
+
+// Tunnel is a GRE, IPIP or IPv6-in-IP tunnel terminated on this host.
+// Encapsulated packets between its endpoints are counted against it in
+// both directions; with InspectInner they are classified and filtered by
+// the packet they carry instead of their outer headers.
+type Tunnel struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`   // "gre", "ipip" or "ipv6"
+	Local        string `json:"local"`  // Host address terminating the tunnel
+	Remote       string `json:"remote"` // Peer address, same family as Local
+	InspectInner bool   `json:"inspect_inner"`
+	Description  string `json:"description"`
+
+	id  uint32 // Index of the tunnel's counters, reallocated on restore
+	key tunnelKey
+}
+
+// tunnelKey mirrors struct tunnel_key in the eBPF program
+type tunnelKey struct {
+	Remote   [16]byte // IPv4 uses the first 4 bytes
+	Local    [16]byte
+	Protocol uint8
+	Family   uint8
+	Pad      [2]uint8
+}
+
+// tunnelConfig mirrors struct tunnel_config in the eBPF program
+type tunnelConfig struct {
+	ID      uint32
+	Inspect uint8
+	Pad     [3]uint8
+}
+
+// tunnelStats mirrors struct tunnel_stats in the eBPF program
+type tunnelStats struct {
+	Packets   uint64
+	Bytes     uint64
+	Inspected uint64
+	Malformed uint64
+	Dropped   uint64
+}
+
+// SetTunnel creates a tunnel or replaces the one of the same name. A
+// replaced tunnel keeps its counters.
+func (s *Server) SetTunnel(ctx context.Context, req *pb.SetTunnelRequest) (*pb.TunnelResponse, error) {
+	if req.GetTunnel() == nil {
+		return &pb.TunnelResponse{Success: false, Message: "Tunnel is required"}, nil
+	}
+
+	tunnel := tunnelFromProto(req.Tunnel)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.validateTunnel(tunnel); err != nil {
+		return &pb.TunnelResponse{
+			Success: false,
+			Message: fmt.Sprintf("Tunnel validation failed: %v", err),
+		}, nil
+	}
+	previous := s.tunnels[tunnel.Name]
+	if previous != nil {
+		tunnel.id = previous.id
+	} else if tunnel.id = s.freeTunnelID(); tunnel.id == 0 {
+		return &pb.TunnelResponse{
+			Success: false,
+			Message: fmt.Sprintf("Tunnel limit reached (%d tunnels)", MaxTunnels-1),
+		}, nil
+	}
+
+	if err := s.pushTunnel(tunnel); err != nil {
+		return &pb.TunnelResponse{Success: false, Message: fmt.Sprintf("Failed to push tunnel to data plane: %v", withRemediation(err))}, nil
+	}
+	if previous != nil && previous.key != tunnel.key && s.bpfManager != nil {
+		if err := s.bpfManager.DeleteTunnel(previous.key, 0); err != nil {
+			log.Printf("⚠️  Failed to remove previous endpoints of tunnel %s: %v", tunnel.Name, err)
+		}
+	}
+	s.tunnels[tunnel.Name] = tunnel
+	s.persistPolicy()
+	log.Printf("Set tunnel: %s (%s %s <-> %s, inspect inner: %v)", tunnel.Name, tunnel.Type, tunnel.Local, tunnel.Remote, tunnel.InspectInner)
+
+	return &pb.TunnelResponse{
+		Success: true,
+		Message: "Tunnel saved successfully",
+		Tunnel:  s.tunnelToProto(tunnel),
+	}, nil
+}
+
+// DeleteTunnel stops counting and inspecting a tunnel; its packets are
+// filtered by their outer headers again
+func (s *Server) DeleteTunnel(ctx context.Context, req *pb.DeleteTunnelRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tunnel, exists := s.tunnels[req.Name]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Tunnel not found"}, nil
+	}
+	if s.bpfManager != nil {
+		if err := s.bpfManager.DeleteTunnel(tunnel.key, tunnel.id); err != nil {
+			return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Failed to remove tunnel from data plane: %v", withRemediation(err))}, nil
+		}
+	}
+	delete(s.tunnels, req.Name)
+	s.persistPolicy()
+	log.Printf("Deleted tunnel: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Tunnel deleted successfully"}, nil
+}
+
+// ListTunnels returns every tunnel with its counters, ordered by name
+func (s *Server) ListTunnels(ctx context.Context, req *pb.Empty) (*pb.TunnelsResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.TunnelsResponse{}
+	for _, tunnel := range s.sortedTunnels() {
+		resp.Tunnels = append(resp.Tunnels, s.tunnelToProto(tunnel))
+	}
+	return resp, nil
+}
+
+// validateTunnel checks a tunnel and fills in its data plane key. Caller
+// must hold s.mutex.
+func (s *Server) validateTunnel(tunnel *Tunnel) error {
+	var errs ruleValidationError
+	if tunnel.Name == "" {
+		errs.add("name", "name is required")
+	}
+	if !tunnelProtocols[tunnel.Type] {
+		errs.add("type", "invalid type: %s, expected gre, ipip or ipv6", tunnel.Type)
+	}
+	local, err := parseTunnelEndpoint(tunnel.Local)
+	if err != nil {
+		errs.add("local", "invalid local %s: %v", tunnel.Local, err)
+	}
+	remote, err := parseTunnelEndpoint(tunnel.Remote)
+	if err != nil {
+		errs.add("remote", "invalid remote %s: %v", tunnel.Remote, err)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if local.Is4() != remote.Is4() {
+		errs.add("remote", "local and remote must be the same address family")
+	} else if local == remote {
+		errs.add("remote", "local and remote must differ")
+	}
+	tunnel.Local, tunnel.Remote = local.String(), remote.String()
+	tunnel.key = tunnelKey{Protocol: protocolToUint8(tunnel.Type), Family: familyIPv4}
+	if local.Is6() {
+		tunnel.key.Family = familyIPv6
+	}
+	copy(tunnel.key.Local[:], local.AsSlice())
+	copy(tunnel.key.Remote[:], remote.AsSlice())
+
+	for _, other := range s.tunnels {
+		if other.Name != tunnel.Name && (other.key == tunnel.key || other.key == tunnel.key.reversed()) {
+			errs.add("remote", "tunnel %s has the same endpoints", other.Name)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// parseTunnelEndpoint parses a tunnel endpoint, which must be a host
+// address
+func parseTunnelEndpoint(endpoint string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(endpoint)
+	if err != nil {
+		return addr, fmt.Errorf("not an IP address")
+	}
+	addr = addr.Unmap()
+	if addr.Zone() != "" || addr.IsUnspecified() || addr.IsMulticast() {
+		return addr, fmt.Errorf("not a host address")
+	}
+	return addr, nil
+}
+
+// reversed returns the key of the tunnel with the endpoints swapped, which
+// the data plane matches as well
+func (key tunnelKey) reversed() tunnelKey {
+	key.Local, key.Remote = key.Remote, key.Local
+	return key
+}
+
+// freeTunnelID returns the lowest tunnel ID not in use, 0 when all are.
+// Caller must hold s.mutex.
+func (s *Server) freeTunnelID() uint32 {
+	used := make(map[uint32]bool, len(s.tunnels))
+	for _, tunnel := range s.tunnels {
+		used[tunnel.id] = true
+	}
+	for id := uint32(1); id < MaxTunnels; id++ {
+		if !used[id] {
+			return id
+		}
+	}
+	return 0
+}
+
+// pushTunnel writes a tunnel to the host data plane. Caller must hold
+// s.mutex.
+func (s *Server) pushTunnel(tunnel *Tunnel) error {
+	if s.bpfManager == nil {
+		return nil
+	}
+	config := tunnelConfig{ID: tunnel.id}
+	if tunnel.InspectInner {
+		config.Inspect = 1
+	}
+	return s.bpfManager.SetTunnel(tunnel.key, config)
+}
+
+// sortedTunnels returns the tunnels ordered by name. Caller must hold
+// s.mutex.
+func (s *Server) sortedTunnels() []*Tunnel {
+	tunnels := make([]*Tunnel, 0, len(s.tunnels))
+	for _, tunnel := range s.tunnels {
+		tunnels = append(tunnels, tunnel)
+	}
+	sort.Slice(tunnels, func(i, j int) bool { return tunnels[i].Name < tunnels[j].Name })
+	return tunnels
+}
+
+func tunnelFromProto(tunnel *pb.Tunnel) *Tunnel {
+	return &Tunnel{
+		Name:         tunnel.Name,
+		Type:         tunnel.Type,
+		Local:        tunnel.Local,
+		Remote:       tunnel.Remote,
+		InspectInner: tunnel.InspectInner,
+		Description:  tunnel.Description,
+	}
+}
+
+// tunnelToProto converts a tunnel with its counters. Caller must hold
+// s.mutex.
+func (s *Server) tunnelToProto(tunnel *Tunnel) *pb.Tunnel {
+	resp := &pb.Tunnel{
+		Name:         tunnel.Name,
+		Type:         tunnel.Type,
+		Local:        tunnel.Local,
+		Remote:       tunnel.Remote,
+		InspectInner: tunnel.InspectInner,
+		Description:  tunnel.Description,
+		Id:           tunnel.id,
+	}
+	if s.bpfManager != nil {
+		stats, err := s.bpfManager.TunnelStats(tunnel.id)
+		if err != nil {
+			log.Printf("⚠️  Failed to read counters of tunnel %s: %v", tunnel.Name, err)
+		}
+		resp.Packets = stats.Packets
+		resp.Bytes = stats.Bytes
+		resp.Inspected = stats.Inspected
+		resp.Malformed = stats.Malformed
+		resp.Dropped = stats.Dropped
+	}
+	return resp
+}
+
+// openTunnels opens the pinned tunnel maps and clears tunnels left by a
+// previous control plane run, with their counters; stored ones are
+// re-pushed on restore
+func (bm *BPFMapManager) openTunnels() {
+	path := filepath.Join(bm.pinPath, TunnelsMapName)
+	configs, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Tunnels not available at %s: %v", path, err)
+		return
+	}
+	path = filepath.Join(bm.pinPath, TunnelStatsMapName)
+	stats, err := ebpf.LoadPinnedMap(path, nil)
+	if err != nil {
+		log.Printf("⚠️  Tunnels not available at %s: %v", path, err)
+		configs.Close()
+		return
+	}
+	bm.tunnels, bm.tunnelStats = configs, stats
+
+	var key tunnelKey
+	var config tunnelConfig
+	stale := make(map[tunnelKey]uint32)
+	entries := configs.Iterate()
+	for entries.Next(&key, &config) {
+		stale[key] = config.ID
+	}
+	for key, id := range stale {
+		if err := bm.DeleteTunnel(key, id); err != nil {
+			log.Printf("⚠️  Failed to clear stale tunnel: %v", err)
+		}
+	}
+}
+
+// SetTunnel writes the endpoints and configuration of a tunnel
+func (bm *BPFMapManager) SetTunnel(key tunnelKey, config tunnelConfig) error {
+	if bm.simulated {
+		log.Printf("✅ [SIMULATED] Setting tunnel %d (protocol %d, inspect %d)", config.ID, key.Protocol, config.Inspect)
+		return nil
+	}
+	if bm.tunnels == nil {
+		return fmt.Errorf("tunnel maps not available")
+	}
+	if err := bm.tunnels.Put(&key, &config); err != nil {
+		return fmt.Errorf("failed to write tunnel: %v", err)
+	}
+	return nil
+}
+
+// DeleteTunnel removes the endpoints of a tunnel and zeroes the counters
+// of id, unless it is 0
+func (bm *BPFMapManager) DeleteTunnel(key tunnelKey, id uint32) error {
+	if bm.simulated || bm.tunnels == nil {
+		return nil
+	}
+	if err := bm.tunnels.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to remove tunnel: %v", err)
+	}
+	if id == 0 {
+		return nil
+	}
+	zero := make([]tunnelStats, ebpf.MustPossibleCPU())
+	if err := bm.tunnelStats.Put(&id, zero); err != nil {
+		return fmt.Errorf("failed to reset counters of tunnel %d: %v", id, err)
+	}
+	return nil
+}
+
+// TunnelStats reads the counters of a tunnel, summed across CPUs
+func (bm *BPFMapManager) TunnelStats(id uint32) (tunnelStats, error) {
+	var stats tunnelStats
+	if bm.simulated || bm.tunnelStats == nil {
+		return stats, nil
+	}
+	var perCPU []tunnelStats
+	if err := bm.tunnelStats.Lookup(&id, &perCPU); err != nil {
+		return stats, err
+	}
+	for _, value := range perCPU {
+		stats.Packets += value.Packets
+		stats.Bytes += value.Bytes
+		stats.Inspected += value.Inspected
+		stats.Malformed += value.Malformed
+		stats.Dropped += value.Dropped
+	}
+	return stats, nil
+}
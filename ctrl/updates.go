@@ -0,0 +1,549 @@
+// SPDX-License-Identifier: Apache-2.0
+// Update orchestration: databases and feeds downloaded on a schedule are
+// verified against published checksums and Ed25519 signatures, staged and
+// validated before use, and database files are swapped in with the
+// previous version kept for rollback
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+const (
+	// Events of database updates
+	EventDatabaseUpdated    = "DATABASE_UPDATED"
+	EventDatabaseRolledBack = "DATABASE_ROLLED_BACK"
+	EventUpdateRejected     = "UPDATE_REJECTED"
+
+	// Share of the prefixes of the database in use a new version must
+	// keep, unless set with CERBERUS_UPDATE_MIN_RATIO
+	DefaultUpdateMinRatio = 0.5
+
+	// Suffix of the previous version of a database file, kept for rollback
+	PreviousSuffix = ".previous"
+
+	// Longest a download of a database may take
+	updateDownloadTimeout = 5 * time.Minute
+
+	// Largest checksum and signature files read
+	updateMetaMaxBytes = 64 << 10
+)
+
+// Database names of the update API
+const (
+	DatabaseGeoIP = "geoip"
+	DatabaseASN   = "asn"
+)
+
+// databaseTitles name the databases in messages
+var databaseTitles = map[string]string{DatabaseGeoIP: "GeoIP", DatabaseASN: "ASN"}
+
+// updateVerifier checks a download against a published SHA-256 checksum
+// and a detached Ed25519 signature, where configured
+type updateVerifier struct {
+	checksumURL  string // sha256sum output or a bare digest, empty = none
+	signatureURL string // Raw or base64 signature of the file, empty = none
+	publicKey    ed25519.PublicKey
+}
+
+// verifierFromEnv reads how downloads are verified:
+// CERBERUS_<prefix>_SHA256_URL and CERBERUS_<prefix>_SIGNATURE_URL name the
+// checksum and signature, CERBERUS_UPDATE_PUBLIC_KEY the Ed25519 key
+// signatures are made with. signature is the signature URL used when none
+// is set. Nil when nothing is verified.
+func verifierFromEnv(prefix, signature string) (*updateVerifier, error) {
+	v := &updateVerifier{
+		checksumURL:  os.Getenv("CERBERUS_" + prefix + "_SHA256_URL"),
+		signatureURL: os.Getenv("CERBERUS_" + prefix + "_SIGNATURE_URL"),
+	}
+	if v.signatureURL == "" {
+		v.signatureURL = signature
+	}
+	for name, value := range map[string]string{"SHA256_URL": v.checksumURL, "SIGNATURE_URL": v.signatureURL} {
+		if value != "" && !strings.HasPrefix(value, "https://") && !strings.HasPrefix(value, "http://") {
+			return nil, fmt.Errorf("invalid CERBERUS_%s_%s %q, expected an http:// or https:// URL", prefix, name, value)
+		}
+	}
+	if v.signatureURL != "" {
+		key, err := updatePublicKeyFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		v.publicKey = key
+	}
+	if v.checksumURL == "" && v.signatureURL == "" {
+		return nil, nil
+	}
+	return v, nil
+}
+
+// updatePublicKeyFromEnv reads CERBERUS_UPDATE_PUBLIC_KEY: a base64
+// Ed25519 public key, or a file holding one
+func updatePublicKeyFromEnv() (ed25519.PublicKey, error) {
+	value := strings.TrimSpace(os.Getenv("CERBERUS_UPDATE_PUBLIC_KEY"))
+	if value == "" {
+		return nil, fmt.Errorf("signed updates need CERBERUS_UPDATE_PUBLIC_KEY")
+	}
+	if data, err := os.ReadFile(value); err == nil {
+		value = strings.TrimSpace(string(data))
+	}
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid CERBERUS_UPDATE_PUBLIC_KEY, expected a base64 Ed25519 public key of %d bytes or a file holding one", ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// verify checks the content downloaded from url
+func (v *updateVerifier) verify(ctx context.Context, url string, content []byte) error {
+	if v == nil {
+		return nil
+	}
+	if v.checksumURL != "" {
+		published, err := fetchUpdateMeta(ctx, v.checksumURL)
+		if err != nil {
+			return fmt.Errorf("checksum: %v", err)
+		}
+		expected, err := parseChecksum(published, path.Base(url))
+		if err != nil {
+			return fmt.Errorf("checksum %s: %v", v.checksumURL, err)
+		}
+		if digest := sha256.Sum256(content); !strings.EqualFold(hex.EncodeToString(digest[:]), expected) {
+			return fmt.Errorf("SHA-256 %x does not match the published %s", digest, expected)
+		}
+	}
+	if v.signatureURL != "" {
+		published, err := fetchUpdateMeta(ctx, v.signatureURL)
+		if err != nil {
+			return fmt.Errorf("signature: %v", err)
+		}
+		signature := published
+		if len(signature) != ed25519.SignatureSize {
+			signature, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(published)))
+			if err != nil || len(signature) != ed25519.SignatureSize {
+				return fmt.Errorf("signature %s: expected %d raw or base64 bytes", v.signatureURL, ed25519.SignatureSize)
+			}
+		}
+		if !ed25519.Verify(v.publicKey, content, signature) {
+			return fmt.Errorf("signature %s does not verify with CERBERUS_UPDATE_PUBLIC_KEY", v.signatureURL)
+		}
+	}
+	return nil
+}
+
+// parseChecksum finds the digest of a file in sha256sum output, or takes
+// a file holding only a digest
+func parseChecksum(published []byte, name string) (string, error) {
+	var digests []string
+	for _, line := range strings.Split(string(published), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || len(fields[0]) != 2*sha256.Size {
+			continue
+		}
+		if _, err := hex.DecodeString(fields[0]); err != nil {
+			continue
+		}
+		if len(fields) > 1 && strings.TrimPrefix(fields[1], "*") == name {
+			return fields[0], nil
+		}
+		digests = append(digests, fields[0])
+	}
+	if len(digests) != 1 {
+		return "", fmt.Errorf("no SHA-256 digest of %s", name)
+	}
+	return digests[0], nil
+}
+
+func fetchUpdateMeta(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, updateMetaMaxBytes))
+}
+
+// dbUpdate is the scheduled download of a database file and how its
+// updates went
+type dbUpdate struct {
+	name     string // DatabaseGeoIP, DatabaseASN
+	path     string
+	url      string
+	verifier *updateVerifier
+	minRatio float64
+	clock    Clock // Time attempts and changes are stamped with
+
+	swap        sync.Mutex // Serializes updates and rollbacks of the file
+	mutex       sync.Mutex
+	lastAttempt time.Time
+	lastSuccess time.Time // Latest check that ended with a verified file in use
+	lastChange  time.Time // Latest new version put in use
+	lastError   string
+	results     map[string]uint64 // By update result
+}
+
+// Update results
+const (
+	UpdateResultUpdated    = "updated"
+	UpdateResultUnchanged  = "unchanged"
+	UpdateResultFailed     = "failed"      // Download or verification failed
+	UpdateResultRejected   = "rejected"    // The new version failed validation
+	UpdateResultRolledBack = "rolled_back" // The new version failed to go in use
+	UpdateResultReverted   = "reverted"    // The previous version put back on request
+)
+
+var updateResults = []string{UpdateResultUpdated, UpdateResultUnchanged, UpdateResultFailed, UpdateResultRejected, UpdateResultRolledBack, UpdateResultReverted}
+
+// dbUpdateFromEnv configures the download of a database file from url;
+// prefix names its CERBERUS_<prefix>_* verification settings.
+// CERBERUS_UPDATE_SIGNATURES=required makes the download need a
+// signature, at <url>.sig unless set.
+func dbUpdateFromEnv(name, prefix, path, url string) (*dbUpdate, error) {
+	var signature string
+	switch required := os.Getenv("CERBERUS_UPDATE_SIGNATURES"); required {
+	case "", "optional":
+	case "required":
+		signature = url + ".sig"
+	default:
+		return nil, fmt.Errorf("invalid CERBERUS_UPDATE_SIGNATURES %q, expected optional or required", required)
+	}
+	verifier, err := verifierFromEnv(prefix, signature)
+	if err != nil {
+		return nil, err
+	}
+	minRatio := DefaultUpdateMinRatio
+	if value := os.Getenv("CERBERUS_UPDATE_MIN_RATIO"); value != "" {
+		minRatio, err = strconv.ParseFloat(value, 64)
+		if err != nil || minRatio < 0 || minRatio > 1 {
+			return nil, fmt.Errorf("invalid CERBERUS_UPDATE_MIN_RATIO %q, expected 0 to 1", value)
+		}
+	}
+	return &dbUpdate{
+		name:     name,
+		path:     path,
+		url:      url,
+		verifier: verifier,
+		minRatio: minRatio,
+		clock:    systemClock{},
+		results:  make(map[string]uint64),
+	}, nil
+}
+
+// stagedDatabase is a loaded new version of a database, ready to go in use
+type stagedDatabase struct {
+	prefixes int          // For the shrink check against the version in use
+	install  func() error // Puts the database in use
+}
+
+// run downloads a new version of the file unless the one in place is as
+// new, verifies it and validates it with load before swapping it in.
+// inUse is the prefix count of the version in use, 0 = none. The previous
+// file is kept for rollback and restored when install fails. It returns
+// the update result.
+func (u *dbUpdate) run(ctx context.Context, inUse int, load func(path string) (*stagedDatabase, error)) (string, error) {
+	u.swap.Lock()
+	result, err := u.update(ctx, inUse, load)
+	u.swap.Unlock()
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	now := u.clock.Now()
+	u.lastAttempt = now
+	u.results[result]++
+	switch result {
+	case UpdateResultUpdated:
+		u.lastChange = now
+		fallthrough
+	case UpdateResultUnchanged:
+		u.lastSuccess, u.lastError = now, ""
+	default:
+		u.lastError = err.Error()
+	}
+	return result, err
+}
+
+func (u *dbUpdate) update(ctx context.Context, inUse int, load func(path string) (*stagedDatabase, error)) (string, error) {
+	staged, err := u.stage(ctx)
+	if err != nil {
+		return UpdateResultFailed, err
+	}
+	if staged == "" {
+		return UpdateResultUnchanged, nil
+	}
+	defer os.Remove(staged)
+
+	db, err := load(staged)
+	if err != nil {
+		return UpdateResultRejected, fmt.Errorf("new version failed validation: %v", err)
+	}
+	if inUse > 0 && float64(db.prefixes) < u.minRatio*float64(inUse) {
+		return UpdateResultRejected, fmt.Errorf("new version has %d prefixes, fewer than %.0f%% of the %d in use",
+			db.prefixes, 100*u.minRatio, inUse)
+	}
+
+	previous := u.path + PreviousSuffix
+	hadPrevious := false
+	if _, err := os.Stat(u.path); err == nil {
+		os.Remove(previous)
+		if err := os.Link(u.path, previous); err != nil {
+			return UpdateResultFailed, fmt.Errorf("failed to keep the previous version: %v", err)
+		}
+		hadPrevious = true
+	}
+	if err := os.Rename(staged, u.path); err != nil {
+		return UpdateResultFailed, err
+	}
+	if err := db.install(); err != nil {
+		if !hadPrevious {
+			os.Remove(u.path)
+		} else if restoreErr := os.Rename(previous, u.path); restoreErr != nil {
+			log.Printf("⚠️  Failed to restore the previous %s database file: %v", databaseTitles[u.name], restoreErr)
+		}
+		return UpdateResultRolledBack, fmt.Errorf("new version rolled back: %v", err)
+	}
+	return UpdateResultUpdated, nil
+}
+
+// stage downloads the file next to its destination and verifies it,
+// returning the staged path, or "" when the file in place is as new
+func (u *dbUpdate) stage(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, updateDownloadTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.url, nil)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(u.path); err == nil {
+		req.Header.Set("If-Modified-Since", info.ModTime().UTC().Format(http.TimeFormat))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		return "", nil
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("%s: %s", u.url, resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", u.url, err)
+	}
+	if err := u.verifier.verify(ctx, u.url, content); err != nil {
+		return "", err
+	}
+	// The previous download may be the same file served without
+	// Last-Modified; staging it again would only bump its version
+	if current, err := os.ReadFile(u.path); err == nil && bytes.Equal(current, content) {
+		return "", nil
+	}
+
+	// Staged under the destination's name, which tells the format
+	tmp, err := os.CreateTemp(filepath.Dir(u.path), ".staged-*-"+filepath.Base(u.path))
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		os.Chtimes(tmp.Name(), modified, modified)
+	}
+	return tmp.Name(), nil
+}
+
+// rollback swaps the database file with its previous version and puts it
+// in use with load; the replaced file becomes the previous version, so a
+// second rollback undoes the first
+func (u *dbUpdate) rollback(load func(path string) (*stagedDatabase, error)) error {
+	u.swap.Lock()
+	defer u.swap.Unlock()
+	previous := u.path + PreviousSuffix
+	if _, err := os.Stat(previous); err != nil {
+		return fmt.Errorf("no previous version of the %s database", databaseTitles[u.name])
+	}
+	// The swap goes through a name that keeps the format-telling extension
+	swap := filepath.Join(filepath.Dir(u.path), ".rollback-"+filepath.Base(u.path))
+	if err := os.Rename(previous, swap); err != nil {
+		return err
+	}
+	db, err := load(swap)
+	if err == nil {
+		if err = os.Rename(u.path, previous); err == nil {
+			if err = os.Rename(swap, u.path); err == nil {
+				if err = db.install(); err == nil {
+					u.mutex.Lock()
+					u.lastChange = u.clock.Now()
+					u.results[UpdateResultReverted]++
+					u.mutex.Unlock()
+					return nil
+				}
+				os.Rename(u.path, swap)
+			}
+			os.Rename(previous, u.path)
+		}
+	}
+	os.Rename(swap, previous)
+	return err
+}
+
+// status reports the update state for ListDatabaseUpdates
+func (u *dbUpdate) status() *pb.DatabaseUpdate {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	update := &pb.DatabaseUpdate{
+		Name:        u.name,
+		Path:        u.path,
+		Url:         u.url,
+		LastAttempt: unix(u.lastAttempt),
+		LastSuccess: unix(u.lastSuccess),
+		LastChange:  unix(u.lastChange),
+		LastError:   u.lastError,
+		Results:     make(map[string]uint64, len(u.results)),
+	}
+	if u.verifier != nil {
+		update.ChecksumUrl, update.SignatureUrl = u.verifier.checksumURL, u.verifier.signatureURL
+	}
+	for result, count := range u.results {
+		update.Results[result] = count
+	}
+	if _, err := os.Stat(u.path + PreviousSuffix); err == nil {
+		update.RollbackAvailable = true
+	}
+	return update
+}
+
+// databaseUpdates returns the configured database downloads
+func (s *Server) databaseUpdates() []*dbUpdate {
+	var updates []*dbUpdate
+	if s.geoIPSource != nil && s.geoIPSource.update != nil {
+		updates = append(updates, s.geoIPSource.update)
+	}
+	if s.asnSource != nil && s.asnSource.update != nil {
+		updates = append(updates, s.asnSource.update)
+	}
+	return updates
+}
+
+// ListDatabaseUpdates reports the scheduled downloads of the GeoIP and ASN
+// databases
+func (s *Server) ListDatabaseUpdates(ctx context.Context, req *pb.Empty) (*pb.DatabaseUpdatesResponse, error) {
+	resp := &pb.DatabaseUpdatesResponse{}
+	for _, update := range s.databaseUpdates() {
+		resp.Updates = append(resp.Updates, update.status())
+	}
+	return resp, nil
+}
+
+// RollbackDatabase puts the previous version of a downloaded database back
+// in use
+func (s *Server) RollbackDatabase(ctx context.Context, req *pb.RollbackDatabaseRequest) (*pb.StatusResponse, error) {
+	var err error
+	switch {
+	case req.Name == DatabaseGeoIP && s.geoIPSource != nil && s.geoIPSource.update != nil:
+		err = s.geoIPSource.update.rollback(s.stageGeoIP)
+	case req.Name == DatabaseASN && s.asnSource != nil && s.asnSource.update != nil:
+		err = s.asnSource.update.rollback(s.stageASN)
+	default:
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("No downloaded database %q, expected %s or %s", req.Name, DatabaseGeoIP, DatabaseASN)}, nil
+	}
+	if err != nil {
+		return &pb.StatusResponse{Success: false, Message: fmt.Sprintf("Rollback failed, keeping the database in use: %v", withRemediation(err))}, nil
+	}
+
+	message := fmt.Sprintf("%s database rolled back to its previous version", databaseTitles[req.Name])
+	s.events.Publish(&pb.Event{
+		Type:     EventDatabaseRolledBack,
+		Message:  message,
+		Severity: "medium",
+		Metadata: map[string]string{"database": req.Name},
+	})
+	log.Print(message)
+	return &pb.StatusResponse{Success: true, Message: message}, nil
+}
+
+// publishUpdate reports the outcome of a database update that changed or
+// refused a file
+func (s *Server) publishUpdate(u *dbUpdate, result string, err error) {
+	switch result {
+	case UpdateResultUnchanged:
+	case UpdateResultUpdated:
+		message := fmt.Sprintf("%s database updated from %s", databaseTitles[u.name], u.url)
+		s.events.Publish(&pb.Event{
+			Type:     EventDatabaseUpdated,
+			Message:  message,
+			Severity: "low",
+			Metadata: map[string]string{"database": u.name},
+		})
+		log.Print(message)
+	case UpdateResultRejected, UpdateResultRolledBack:
+		s.events.Publish(&pb.Event{
+			Type:     EventUpdateRejected,
+			Message:  fmt.Sprintf("%s database update refused, keeping the version in use: %v", databaseTitles[u.name], err),
+			Severity: "medium",
+			Metadata: map[string]string{"database": u.name, "result": result},
+		})
+		fallthrough
+	default:
+		log.Printf("⚠️  %s database not updated, keeping the version in use: %v", databaseTitles[u.name], withRemediation(err))
+	}
+}
+
+// validateGeoIPDownload checks a GeoIP database downloaded at startup,
+// which is loaded once in place
+func validateGeoIPDownload(path string) (*stagedDatabase, error) {
+	db, err := LoadGeoIPDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	return &stagedDatabase{prefixes: db.prefixCount(), install: func() error { return nil }}, nil
+}
+
+// validateASNDownload checks an ASN database downloaded at startup, which
+// is loaded once in place
+func validateASNDownload(path string) (*stagedDatabase, error) {
+	db, err := LoadASNDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	return &stagedDatabase{prefixes: len(db.sorted), install: func() error { return nil }}, nil
+}
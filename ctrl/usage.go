@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: Apache-2.0
+// Tenant usage accounting: API calls, rules and enforced traffic per tenant, exported for billing
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	usageVersion  = 1
+	usageFileName = "usage.json"
+
+	// How often traffic is sampled and usage is written to disk
+	usageSampleInterval = time.Minute
+	usageSaveInterval   = 10 * time.Minute
+
+	// A tenant is the data plane scope it runs in; the host data plane
+	// belongs to the default tenant. Callers name their tenant in this
+	// gRPC metadata key or HTTP header.
+	DefaultTenant     = "default"
+	tenantMetadataKey = "x-cerberus-tenant"
+
+	// Supported usage export formats
+	UsageFormatJSON = "json"
+	UsageFormatCSV  = "csv"
+)
+
+// TenantUsage is the accumulated usage of one tenant
+type TenantUsage struct {
+	APICalls map[string]uint64 `json:"api_calls"` // By gRPC method or REST path
+	Packets  uint64            `json:"packets"`   // Matched by the tenant's rules
+	Bytes    uint64            `json:"bytes"`
+
+	// Hit counters of each rule at the last sample, not persisted
+	last map[string]RuleHits
+}
+
+// UsageRecord is one tenant's usage over the accounting period
+type UsageRecord struct {
+	Tenant      string    `json:"tenant"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	APICalls    uint64    `json:"api_calls"`
+	Rules       int       `json:"rules"`
+	Packets     uint64    `json:"packets"`
+	Bytes       uint64    `json:"bytes"`
+}
+
+// UsageTracker accumulates usage per tenant since the accounting period
+// started. Counters are cumulative across restarts so billing systems can
+// take differences between exports.
+type UsageTracker struct {
+	mutex   sync.Mutex
+	since   time.Time
+	tenants map[string]*TenantUsage
+	path    string // Empty = kept in memory only
+	clock   Clock
+}
+
+// usageFile is the persisted form of the tracker
+type usageFile struct {
+	Version int                     `json:"version"`
+	Since   time.Time               `json:"since"`
+	Tenants map[string]*TenantUsage `json:"tenants"`
+}
+
+// NewUsageTracker loads the usage saved under dir; an empty dir keeps
+// usage in memory
+func NewUsageTracker(dir string) (*UsageTracker, error) {
+	u := &UsageTracker{tenants: make(map[string]*TenantUsage), clock: systemClock{}}
+	u.since = u.clock.Now().UTC()
+	if dir == "" {
+		return u, nil
+	}
+
+	u.path = filepath.Join(dir, usageFileName)
+	data, err := os.ReadFile(u.path)
+	if os.IsNotExist(err) {
+		return u, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage: %v", err)
+	}
+
+	var file usageFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse usage %s: %v", u.path, err)
+	}
+	if file.Version > usageVersion {
+		return nil, fmt.Errorf("usage version %d is newer than supported version %d", file.Version, usageVersion)
+	}
+	u.since = file.Since
+	for tenant, usage := range file.Tenants {
+		if usage.APICalls == nil {
+			usage.APICalls = make(map[string]uint64)
+		}
+		u.tenants[tenant] = usage
+	}
+	return u, nil
+}
+
+// tenant returns a tenant's usage, creating it. Caller must hold u.mutex.
+func (u *UsageTracker) tenant(name string) *TenantUsage {
+	usage, exists := u.tenants[name]
+	if !exists {
+		usage = &TenantUsage{APICalls: make(map[string]uint64)}
+		u.tenants[name] = usage
+	}
+	return usage
+}
+
+// CountCall counts one API call of a tenant
+func (u *UsageTracker) CountCall(tenant, method string) {
+	if tenant == "" {
+		tenant = DefaultTenant
+	}
+	u.mutex.Lock()
+	u.tenant(tenant).APICalls[method]++
+	u.mutex.Unlock()
+}
+
+// recordTraffic adds the growth of each rule's hit counters to its
+// tenant. Hit counters start from zero with the control plane and with
+// each new rule, so rules not seen before count in full; counters that
+// went backwards were reset and are taken as counted from zero.
+func (u *UsageTracker) recordTraffic(traffic map[string]map[string]*RuleHits) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	for tenant, rules := range traffic {
+		usage := u.tenant(tenant)
+		last := make(map[string]RuleHits, len(rules))
+		for id, hits := range rules {
+			usage.Packets += counterDelta(usage.last[id].Packets, hits.Packets)
+			usage.Bytes += counterDelta(usage.last[id].Bytes, hits.Bytes)
+			last[id] = *hits
+		}
+		usage.last = last
+	}
+}
+
+// Save writes the accumulated usage to disk
+func (u *UsageTracker) Save() error {
+	if u.path == "" {
+		return nil
+	}
+
+	u.mutex.Lock()
+	data, err := json.Marshal(&usageFile{Version: usageVersion, Since: u.since, Tenants: u.tenants})
+	u.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode usage: %v", err)
+	}
+	return writeFileAtomic(u.path, data)
+}
+
+// Run samples enforced traffic every usageSampleInterval and saves usage
+// every usageSaveInterval until ctx is done
+func (u *UsageTracker) Run(ctx context.Context, s *Server) {
+	sample := time.NewTicker(usageSampleInterval)
+	defer sample.Stop()
+	save := time.NewTicker(usageSaveInterval)
+	defer save.Stop()
+
+	u.recordTraffic(s.tenantTraffic())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sample.C:
+			u.recordTraffic(s.tenantTraffic())
+		case <-save.C:
+			if err := u.Save(); err != nil {
+				log.Printf("Failed to save usage: %v", err)
+			}
+		}
+	}
+}
+
+// unaryInterceptor counts unary gRPC calls by the tenant in their metadata
+func (u *UsageTracker) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	u.CountCall(tenantFromContext(ctx), info.FullMethod)
+	return handler(ctx, req)
+}
+
+// streamInterceptor counts streaming gRPC calls once per stream
+func (u *UsageTracker) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	u.CountCall(tenantFromContext(stream.Context()), info.FullMethod)
+	return handler(srv, stream)
+}
+
+// countREST counts REST calls by the tenant in their header
+func (u *UsageTracker) countREST(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u.CountCall(r.Header.Get(tenantMetadataKey), r.URL.Path)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func tenantFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(tenantMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// scopeTenant returns the tenant owning a data plane scope
+func scopeTenant(scope string) string {
+	if scope == "" {
+		return DefaultTenant
+	}
+	return scope
+}
+
+// tenantTraffic returns the rule hit counters of each tenant's data plane
+func (s *Server) tenantTraffic() map[string]map[string]*RuleHits {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	traffic := make(map[string]map[string]*RuleHits)
+	scopes := []string{""}
+	scopes = append(scopes, s.sortedNamespaceNames()...)
+	scopes = append(scopes, s.sortedVFPolicyKeys()...)
+	for _, scope := range scopes {
+		manager := s.dataPlaneFor(scope)
+		if manager == nil {
+			continue
+		}
+		hits, err := manager.RuleHits()
+		if err != nil {
+			log.Printf("Failed to read rule hit counters for usage: %v", err)
+			continue
+		}
+		traffic[scopeTenant(scope)] = hits
+	}
+	return traffic
+}
+
+// UsageRecords returns the usage of every tenant, sorted by tenant
+func (s *Server) UsageRecords() []UsageRecord {
+	rules := make(map[string]int)
+	s.mutex.RLock()
+	for _, rule := range s.rules {
+		rules[scopeTenant(ruleScope(rule))]++
+	}
+	s.mutex.RUnlock()
+
+	u := s.usage
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	tenants := make(map[string]bool)
+	for tenant := range u.tenants {
+		tenants[tenant] = true
+	}
+	for tenant := range rules {
+		tenants[tenant] = true
+	}
+
+	now := u.clock.Now().UTC()
+	records := make([]UsageRecord, 0, len(tenants))
+	for tenant := range tenants {
+		record := UsageRecord{Tenant: tenant, PeriodStart: u.since, PeriodEnd: now, Rules: rules[tenant]}
+		if usage, exists := u.tenants[tenant]; exists {
+			for _, calls := range usage.APICalls {
+				record.APICalls += calls
+			}
+			record.Packets, record.Bytes = usage.Packets, usage.Bytes
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Tenant < records[j].Tenant })
+	return records
+}
+
+// ExportUsage renders the usage records as JSON or CSV
+func (s *Server) ExportUsage(format string) ([]byte, error) {
+	if s.usage == nil {
+		return nil, fmt.Errorf("usage accounting is not enabled")
+	}
+	records := s.UsageRecords()
+
+	switch strings.ToLower(format) {
+	case "", UsageFormatJSON:
+		data, err := json.Marshal(records)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode usage: %v", err)
+		}
+		return data, nil
+	case UsageFormatCSV:
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		writer.Write([]string{"tenant", "period_start", "period_end", "api_calls", "rules", "packets", "bytes"})
+		for _, record := range records {
+			writer.Write([]string{
+				record.Tenant,
+				record.PeriodStart.Format(time.RFC3339),
+				record.PeriodEnd.Format(time.RFC3339),
+				strconv.FormatUint(record.APICalls, 10),
+				strconv.Itoa(record.Rules),
+				strconv.FormatUint(record.Packets, 10),
+				strconv.FormatUint(record.Bytes, 10),
+			})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, fmt.Errorf("failed to encode usage: %v", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported usage format: %s", format)
+	}
+}
@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: Apache-2.0
+// VPP telemetry: DPDK rx counters and worker vector rates from the VPP stats segment
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// DefaultVPPStatsSocket is where VPP hands out its stats segment
+const DefaultVPPStatsSocket = "/run/vpp/stats.sock"
+
+// Stats segment entries read by the collector
+const (
+	vppStatInterfaceNames = "/if/names"
+	vppStatRxNoBuf        = "/if/rx-no-buf" // DPDK rx_nombuf
+	vppStatRxMiss         = "/if/rx-miss"   // DPDK imissed
+	vppStatVectorRate     = "/sys/vector_rate"
+	vppStatWorkerRate     = "/sys/vector_rate_per_worker"
+)
+
+// Stats segment v2 layout (vpp/stats/stat_segment_shared.h)
+const (
+	statSegmentVersion = 2
+
+	statDirScalarIndex         = 1
+	statDirCounterVectorSimple = 2
+	statDirNameVector          = 4
+
+	statHeaderSize   = 48  // version, base, epoch, in_progress, directory, error vector
+	statDirEntrySize = 144 // type (padded to 8), union, name[128]
+	statDirNameSize  = 128
+
+	// Reads racing a VPP update are retried this often before giving up
+	statReadAttempts = 5
+)
+
+// VPPTelemetry is one consistent read of the counters that show data plane
+// saturation: buffer exhaustion and RX ring overruns per interface, and how
+// full the vectors of each worker are (VPP's maximum is 256)
+type VPPTelemetry struct {
+	VectorRate       float64           `json:"vector_rate"`
+	WorkerVectorRate []uint64          `json:"worker_vector_rate"` // Index 0 = main thread
+	RxNoBuf          map[string]uint64 `json:"rx_nombuf"`          // By interface
+	RxMiss           map[string]uint64 `json:"rx_miss"`            // By interface
+}
+
+// VPPTelemetryCollector maps the VPP stats segment read-only and decodes
+// counters from it. VPP restarts invalidate the mapping; the next Collect
+// reconnects.
+type VPPTelemetryCollector struct {
+	socketPath string
+
+	mutex   sync.Mutex
+	segment []byte // Shared memory, nil while disconnected
+}
+
+// NewVPPTelemetryCollector creates a collector for the given stats socket
+func NewVPPTelemetryCollector(socketPath string) *VPPTelemetryCollector {
+	if socketPath == "" {
+		socketPath = DefaultVPPStatsSocket
+	}
+	return &VPPTelemetryCollector{socketPath: socketPath}
+}
+
+// Collect reads the current telemetry, connecting to VPP if needed
+func (c *VPPTelemetryCollector) Collect() (*VPPTelemetry, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.segment == nil {
+		segment, err := mapStatSegment(c.socketPath)
+		if err != nil {
+			return nil, err
+		}
+		c.segment = segment
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < statReadAttempts; attempt++ {
+		telemetry, err := readVPPTelemetry(statSegment(c.segment))
+		if err == nil {
+			return telemetry, nil
+		}
+		lastErr = err
+		if err != errStatSegmentBusy {
+			break
+		}
+	}
+
+	// The segment may belong to a VPP instance that is gone
+	c.disconnect()
+	return nil, lastErr
+}
+
+// Close unmaps the stats segment
+func (c *VPPTelemetryCollector) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.disconnect()
+}
+
+func (c *VPPTelemetryCollector) disconnect() {
+	if c.segment != nil {
+		syscall.Munmap(c.segment)
+		c.segment = nil
+	}
+}
+
+// mapStatSegment receives the stats segment file descriptor over the stats
+// socket and maps it read-only
+func mapStatSegment(socketPath string) ([]byte, error) {
+	conn, err := net.DialUnix("unixpacket", nil, &net.UnixAddr{Name: socketPath, Net: "unixpacket"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to VPP stats socket: %v", err)
+	}
+	defer conn.Close()
+
+	oob := make([]byte, syscall.CmsgSpace(4))
+	_, oobn, _, _, err := conn.ReadMsgUnix(make([]byte, 1), oob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive stats segment: %v", err)
+	}
+	messages, err := syscall.ParseSocketControlMessage(oob[:oobn])
+	if err != nil || len(messages) == 0 {
+		return nil, fmt.Errorf("stats socket sent no file descriptor")
+	}
+	fds, err := syscall.ParseUnixRights(&messages[0])
+	if err != nil || len(fds) == 0 {
+		return nil, fmt.Errorf("stats socket sent no file descriptor")
+	}
+
+	file := os.NewFile(uintptr(fds[0]), "vpp-stats-segment")
+	defer file.Close()
+	for _, fd := range fds[1:] {
+		syscall.Close(fd)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat stats segment: %v", err)
+	}
+	segment, err := syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map stats segment: %v", err)
+	}
+
+	if version := binary.NativeEndian.Uint64(segment); version != statSegmentVersion {
+		syscall.Munmap(segment)
+		return nil, fmt.Errorf("unsupported stats segment version %d", version)
+	}
+	return segment, nil
+}
+
+var errStatSegmentBusy = fmt.Errorf("stats segment is being updated")
+
+// statSegment decodes the shared memory. VPP stores pointers in its own
+// address space; they are translated to offsets from the segment base.
+type statSegment []byte
+
+func (s statSegment) u64(offset uint64) (uint64, error) {
+	if offset > uint64(len(s)) || uint64(len(s))-offset < 8 {
+		return 0, fmt.Errorf("stats segment offset %d out of range", offset)
+	}
+	return binary.NativeEndian.Uint64(s[offset:]), nil
+}
+
+// pointer translates a VPP pointer stored at offset; 0 means NULL
+func (s statSegment) pointer(offset uint64) (uint64, error) {
+	ptr, err := s.u64(offset)
+	if err != nil || ptr == 0 {
+		return 0, err
+	}
+	base, _ := s.u64(8)
+	if ptr < base || ptr-base >= uint64(len(s)) {
+		return 0, fmt.Errorf("stats segment pointer %#x outside the segment", ptr)
+	}
+	return ptr - base, nil
+}
+
+// vectorLen returns the length of a VPP vector, kept in the 32 bits
+// 8 bytes before its first element
+func (s statSegment) vectorLen(vector uint64) (uint64, error) {
+	if vector < 8 {
+		return 0, fmt.Errorf("stats segment vector %d out of range", vector)
+	}
+	header, err := s.u64(vector - 8)
+	return header & math.MaxUint32, err
+}
+
+type statEntry struct {
+	kind  uint64
+	value uint64 // Scalar bits or vector pointer offset
+}
+
+// directory indexes the entries by name
+func (s statSegment) directory() (map[string]statEntry, error) {
+	dir, err := s.pointer(32)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.vectorLen(dir)
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(len(s))/statDirEntrySize {
+		return nil, fmt.Errorf("stats directory length %d out of range", count)
+	}
+
+	entries := make(map[string]statEntry, count)
+	for i := uint64(0); i < count; i++ {
+		offset := dir + i*statDirEntrySize
+		if offset+statDirEntrySize > uint64(len(s)) {
+			return nil, fmt.Errorf("stats directory entry %d out of range", i)
+		}
+		kind := uint64(binary.NativeEndian.Uint32(s[offset:]))
+		name := s[offset+16 : offset+16+statDirNameSize]
+		if end := bytes.IndexByte(name, 0); end >= 0 {
+			name = name[:end]
+		}
+		entry := statEntry{kind: kind, value: binary.NativeEndian.Uint64(s[offset+8:])}
+		if kind == statDirCounterVectorSimple || kind == statDirNameVector {
+			if entry.value, err = s.pointer(offset + 8); err != nil {
+				return nil, err
+			}
+		}
+		entries[string(name)] = entry
+	}
+	return entries, nil
+}
+
+// simpleCounters sums a per-thread counter vector across threads
+func (s statSegment) simpleCounters(entry statEntry) ([]uint64, error) {
+	if entry.kind != statDirCounterVectorSimple {
+		return nil, fmt.Errorf("not a simple counter vector")
+	}
+	if entry.value == 0 {
+		return nil, nil
+	}
+	threads, err := s.vectorLen(entry.value)
+	if err != nil {
+		return nil, err
+	}
+
+	var sums []uint64
+	for t := uint64(0); t < threads; t++ {
+		counters, err := s.pointer(entry.value + t*8)
+		if err != nil {
+			return nil, err
+		}
+		if counters == 0 {
+			continue
+		}
+		n, err := s.vectorLen(counters)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < n; i++ {
+			value, err := s.u64(counters + i*8)
+			if err != nil {
+				return nil, err
+			}
+			if int(i) >= len(sums) {
+				sums = append(sums, make([]uint64, int(i)+1-len(sums))...)
+			}
+			sums[i] += value
+		}
+	}
+	return sums, nil
+}
+
+// perThread returns element 0 of every thread's counters, as used by
+// per-worker gauges
+func (s statSegment) perThread(entry statEntry) ([]uint64, error) {
+	if entry.kind != statDirCounterVectorSimple || entry.value == 0 {
+		return nil, fmt.Errorf("not a simple counter vector")
+	}
+	threads, err := s.vectorLen(entry.value)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]uint64, threads)
+	for t := uint64(0); t < threads; t++ {
+		counters, err := s.pointer(entry.value + t*8)
+		if err != nil {
+			return nil, err
+		}
+		if counters != 0 {
+			if values[t], err = s.u64(counters); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+func (s statSegment) names(entry statEntry) ([]string, error) {
+	if entry.kind != statDirNameVector {
+		return nil, fmt.Errorf("not a name vector")
+	}
+	n, err := s.vectorLen(entry.value)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, n)
+	for i := uint64(0); i < n; i++ {
+		name, err := s.pointer(entry.value + i*8)
+		if err != nil {
+			return nil, err
+		}
+		if name == 0 {
+			continue // Deleted interface
+		}
+		length, err := s.vectorLen(name)
+		if err != nil || name+length > uint64(len(s)) {
+			return nil, fmt.Errorf("interface name %d out of range", i)
+		}
+		names[i] = strings.TrimRight(string(s[name:name+length]), "\x00")
+	}
+	return names, nil
+}
+
+// readVPPTelemetry decodes one consistent snapshot: VPP bumps the epoch and
+// sets in_progress around directory changes
+func readVPPTelemetry(s statSegment) (*VPPTelemetry, error) {
+	epoch, err := s.u64(16)
+	if err != nil {
+		return nil, err
+	}
+	if busy, _ := s.u64(24); busy != 0 {
+		return nil, errStatSegmentBusy
+	}
+
+	dir, err := s.directory()
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry := &VPPTelemetry{RxNoBuf: make(map[string]uint64), RxMiss: make(map[string]uint64)}
+	if entry, ok := dir[vppStatVectorRate]; ok && entry.kind == statDirScalarIndex {
+		telemetry.VectorRate = math.Float64frombits(entry.value)
+	}
+	if entry, ok := dir[vppStatWorkerRate]; ok {
+		if telemetry.WorkerVectorRate, err = s.perThread(entry); err != nil {
+			return nil, fmt.Errorf("%s: %v", vppStatWorkerRate, err)
+		}
+	}
+
+	var names []string
+	if entry, ok := dir[vppStatInterfaceNames]; ok {
+		if names, err = s.names(entry); err != nil {
+			return nil, fmt.Errorf("%s: %v", vppStatInterfaceNames, err)
+		}
+	}
+	for stat, counters := range map[string]map[string]uint64{vppStatRxNoBuf: telemetry.RxNoBuf, vppStatRxMiss: telemetry.RxMiss} {
+		entry, ok := dir[stat]
+		if !ok {
+			continue
+		}
+		values, err := s.simpleCounters(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", stat, err)
+		}
+		for i, value := range values {
+			if i < len(names) && names[i] != "" {
+				counters[names[i]] = value
+			}
+		}
+	}
+
+	if after, _ := s.u64(16); after != epoch {
+		return nil, errStatSegmentBusy
+	}
+	if busy, _ := s.u64(24); busy != 0 {
+		return nil, errStatSegmentBusy
+	}
+	return telemetry, nil
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// XDP attach mode: requested mode and fallback chain down to TC ingress
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cilium/ebpf/link"
+)
+
+// XDP attach modes, requested with CERBERUS_XDP_MODE
+const (
+	XDPModeAuto    = "auto"    // Best mode the interface supports
+	XDPModeOffload = "offload" // XDP program runs in the NIC
+	XDPModeNative  = "native"  // XDP in the driver
+	XDPModeGeneric = "generic" // XDP in the kernel network stack (skb)
+	XDPModeTC      = "tc"      // No XDP, rules enforced by the TC ingress program
+
+	// Achieved mode of a data plane without a loaded program
+	XDPModeSimulated = "simulated"
+)
+
+// Attach modes from fastest to slowest; an attach starts at the requested
+// mode and falls back along the chain
+var xdpModeChain = []string{XDPModeOffload, XDPModeNative, XDPModeGeneric, XDPModeTC}
+
+// xdpAttachFlags maps attach modes to their XDP flags
+var xdpAttachFlags = map[string]link.XDPAttachFlags{
+	XDPModeOffload: link.XDPOffloadMode,
+	XDPModeNative:  link.XDPDriverMode,
+	XDPModeGeneric: link.XDPGenericMode,
+}
+
+// xdpModeFromEnv reads the requested attach mode, defaulting to auto
+func xdpModeFromEnv() (string, error) {
+	mode, err := parseXDPMode(os.Getenv("CERBERUS_XDP_MODE"))
+	if err != nil {
+		return XDPModeAuto, fmt.Errorf("invalid CERBERUS_XDP_MODE: %v", err)
+	}
+	return mode, nil
+}
+
+// parseXDPMode parses a requested attach mode, empty meaning auto
+func parseXDPMode(raw string) (string, error) {
+	mode := strings.ToLower(raw)
+	switch mode {
+	case "":
+		return XDPModeAuto, nil
+	case "driver":
+		return XDPModeNative, nil
+	case XDPModeAuto, XDPModeOffload, XDPModeNative, XDPModeGeneric, XDPModeTC:
+		return mode, nil
+	default:
+		return XDPModeAuto, fmt.Errorf("%q, expected auto|offload|native|generic|tc", raw)
+	}
+}
+
+// xdpModeFallbacks returns the modes to try, in order, for a requested mode
+func xdpModeFallbacks(requested string) []string {
+	for i, mode := range xdpModeChain {
+		if mode == requested {
+			return xdpModeChain[i:]
+		}
+	}
+	return xdpModeChain
+}
+
+// attachedInterfaces returns the interfaces with a recorded attach mode,
+// sorted by name
+func (bm *BPFMapManager) attachedInterfaces() []string {
+	interfaces := make([]string, 0, len(bm.attachModes))
+	for iface := range bm.attachModes {
+		interfaces = append(interfaces, iface)
+	}
+	sort.Strings(interfaces)
+	return interfaces
+}
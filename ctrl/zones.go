@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: Apache-2.0
+// Zone-based policy model: zones and inter-zone policies compiled to rules
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"time"
+
+	pb "github.com/m4rba4s/Cerberus-V/proto"
+)
+
+// Zone is a named set of interfaces and prefixes (e.g. "lan", "wan")
+type Zone struct {
+	Name        string   `json:"name"`
+	Interfaces  []string `json:"interfaces"`
+	Prefixes    []string `json:"prefixes"` // CIDR notation, empty = any address
+	Description string   `json:"description"`
+}
+
+// ZonePolicy is an inter-zone policy such as "lan -> wan allow"
+type ZonePolicy struct {
+	ID          string `json:"id"`
+	FromZone    string `json:"from_zone"`
+	ToZone      string `json:"to_zone"`
+	Action      string `json:"action"`   // allow, drop, redirect
+	Protocol    string `json:"protocol"` // tcp, udp, icmp, any
+	DstPort     int32  `json:"dst_port"` // 0 = any
+	Priority    int32  `json:"priority"` // Lower number = higher priority
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description"`
+}
+
+// SetZone creates a zone or replaces an existing one and recompiles policies
+func (s *Server) SetZone(ctx context.Context, req *pb.SetZoneRequest) (*pb.StatusResponse, error) {
+	if req.GetZone() == nil {
+		return &pb.StatusResponse{Success: false, Message: "Zone is required"}, nil
+	}
+
+	zone := zoneFromProto(req.Zone)
+	if err := validateZone(zone); err != nil {
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Zone validation failed: %v", err),
+		}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous := s.zones[zone.Name]
+	s.zones[zone.Name] = zone
+
+	if err := s.applyPolicy(); err != nil {
+		if previous != nil {
+			s.zones[zone.Name] = previous
+		} else {
+			delete(s.zones, zone.Name)
+		}
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to apply zone policies: %v", err),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Set zone: %s (%d interfaces, %d prefixes)",
+		zone.Name, len(zone.Interfaces), len(zone.Prefixes))
+
+	return &pb.StatusResponse{Success: true, Message: "Zone saved successfully"}, nil
+}
+
+// DeleteZone removes a zone that is not referenced by any policy
+func (s *Server) DeleteZone(ctx context.Context, req *pb.DeleteZoneRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.zones[req.Name]; !exists {
+		return &pb.StatusResponse{Success: false, Message: "Zone not found"}, nil
+	}
+
+	for _, policy := range s.zonePolicies {
+		if policy.FromZone == req.Name || policy.ToZone == req.Name {
+			return &pb.StatusResponse{
+				Success: false,
+				Message: fmt.Sprintf("Zone is referenced by policy %s", policy.ID),
+			}, nil
+		}
+	}
+
+	delete(s.zones, req.Name)
+	s.persistPolicy()
+	log.Printf("Deleted zone: %s", req.Name)
+
+	return &pb.StatusResponse{Success: true, Message: "Zone deleted successfully"}, nil
+}
+
+// ListZones returns all zones and inter-zone policies
+func (s *Server) ListZones(ctx context.Context, req *pb.Empty) (*pb.ZonesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.ZonesResponse{}
+	for _, name := range s.sortedZoneNames() {
+		resp.Zones = append(resp.Zones, zoneToProto(s.zones[name]))
+	}
+	for _, policy := range s.sortedZonePolicies() {
+		resp.Policies = append(resp.Policies, zonePolicyToProto(policy))
+	}
+
+	return resp, nil
+}
+
+// AddZonePolicy adds an inter-zone policy and pushes its compiled rules
+func (s *Server) AddZonePolicy(ctx context.Context, req *pb.AddZonePolicyRequest) (*pb.ZonePolicyResponse, error) {
+	if req.GetPolicy() == nil {
+		return &pb.ZonePolicyResponse{Success: false, Message: "Policy is required"}, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	policy := zonePolicyFromProto(req.Policy)
+	policy.ID = s.ids.NewID("zpol")
+
+	if err := s.validateZonePolicy(policy); err != nil {
+		return &pb.ZonePolicyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Policy validation failed: %v", err),
+		}, nil
+	}
+
+	s.zonePolicies[policy.ID] = policy
+
+	if err := s.applyPolicy(); err != nil {
+		delete(s.zonePolicies, policy.ID)
+		return &pb.ZonePolicyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to apply zone policies: %v", err),
+		}, nil
+	}
+
+	s.persistPolicy()
+
+	log.Printf("Added zone policy: %s - %s->%s %s",
+		policy.ID, policy.FromZone, policy.ToZone, policy.Action)
+
+	return &pb.ZonePolicyResponse{
+		Success:  true,
+		Message:  "Zone policy added successfully",
+		PolicyId: policy.ID,
+	}, nil
+}
+
+// DeleteZonePolicy removes an inter-zone policy and its compiled rules
+func (s *Server) DeleteZonePolicy(ctx context.Context, req *pb.DeleteZonePolicyRequest) (*pb.StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	policy, exists := s.zonePolicies[req.PolicyId]
+	if !exists {
+		return &pb.StatusResponse{Success: false, Message: "Zone policy not found"}, nil
+	}
+
+	delete(s.zonePolicies, req.PolicyId)
+
+	if err := s.applyPolicy(); err != nil {
+		s.zonePolicies[policy.ID] = policy
+		return &pb.StatusResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to apply zone policies: %v", err),
+		}, nil
+	}
+
+	s.persistPolicy()
+	log.Printf("Deleted zone policy: %s", req.PolicyId)
+
+	return &pb.StatusResponse{Success: true, Message: "Zone policy deleted successfully"}, nil
+}
+
+// ExplainZonePolicy shows the concrete rules each inter-zone policy compiles to
+func (s *Server) ExplainZonePolicy(ctx context.Context, req *pb.ExplainZonePolicyRequest) (*pb.ExplainZonePolicyResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	resp := &pb.ExplainZonePolicyResponse{}
+	for _, policy := range s.sortedZonePolicies() {
+		if req.FromZone != "" && policy.FromZone != req.FromZone {
+			continue
+		}
+		if req.ToZone != "" && policy.ToZone != req.ToZone {
+			continue
+		}
+
+		explanation := &pb.ZonePolicyExplanation{Policy: zonePolicyToProto(policy)}
+		for _, rule := range compileZonePolicy(policy, s.zones, s.clock.Now()) {
+			explanation.CompiledRules = append(explanation.CompiledRules, toProtoRule(rule))
+		}
+		resp.CompiledRuleCount += int32(len(explanation.CompiledRules))
+		resp.Policies = append(resp.Policies, explanation)
+	}
+
+	return resp, nil
+}
+
+// compileZonePolicy expands a policy into one rule per source/destination
+// prefix pair of its zones; pairs mixing IPv4 and IPv6 are skipped. Zones
+// without prefixes match any address; zone interfaces are kept for
+// reference until rules can be interface-scoped. The rules are stamped
+// with now.
+func compileZonePolicy(policy *ZonePolicy, zones map[string]*Zone, now time.Time) []*FirewallRule {
+	from, to := zones[policy.FromZone], zones[policy.ToZone]
+	if from == nil || to == nil {
+		return nil
+	}
+
+	srcPrefixes := zonePrefixesOrAny(from)
+	dstPrefixes := zonePrefixesOrAny(to)
+
+	description := fmt.Sprintf("zone %s->%s", policy.FromZone, policy.ToZone)
+	if policy.Description != "" {
+		description += ": " + policy.Description
+	}
+
+	var rules []*FirewallRule
+	for _, src := range srcPrefixes {
+		for _, dst := range dstPrefixes {
+			if !familiesCompatible(src, dst) {
+				continue
+			}
+			rules = append(rules, &FirewallRule{
+				ID:          fmt.Sprintf("zone_%s_%d", policy.ID, len(rules)),
+				Action:      policy.Action,
+				SrcIP:       src,
+				DstIP:       dst,
+				DstPort:     policy.DstPort,
+				Protocol:    policy.Protocol,
+				Direction:   "both",
+				Priority:    policy.Priority,
+				Enabled:     policy.Enabled,
+				Description: description,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			})
+		}
+	}
+
+	return rules
+}
+
+func zonePrefixesOrAny(zone *Zone) []string {
+	if len(zone.Prefixes) == 0 {
+		return []string{""}
+	}
+	return zone.Prefixes
+}
+
+func validateZone(zone *Zone) error {
+	if zone.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(zone.Interfaces) == 0 && len(zone.Prefixes) == 0 {
+		return fmt.Errorf("zone must contain at least one interface or prefix")
+	}
+	for _, prefix := range zone.Prefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return fmt.Errorf("invalid prefix %s: %v", prefix, err)
+		}
+	}
+	return nil
+}
+
+// validateZonePolicy checks zone references. Caller must hold s.mutex.
+func (s *Server) validateZonePolicy(policy *ZonePolicy) error {
+	if _, exists := s.zones[policy.FromZone]; !exists {
+		return fmt.Errorf("unknown source zone: %s", policy.FromZone)
+	}
+	if _, exists := s.zones[policy.ToZone]; !exists {
+		return fmt.Errorf("unknown destination zone: %s", policy.ToZone)
+	}
+	if policy.DstPort < 0 || policy.DstPort > 65535 {
+		return fmt.Errorf("invalid destination port: %d", policy.DstPort)
+	}
+	// Compiled rules must pass rule validation
+	return s.validateRule(&FirewallRule{Action: policy.Action, Protocol: policy.Protocol})
+}
+
+func (s *Server) sortedZoneNames() []string {
+	names := make([]string, 0, len(s.zones))
+	for name := range s.zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) sortedZonePolicies() []*ZonePolicy {
+	policies := make([]*ZonePolicy, 0, len(s.zonePolicies))
+	for _, policy := range s.zonePolicies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Priority != policies[j].Priority {
+			return policies[i].Priority < policies[j].Priority
+		}
+		return policies[i].ID < policies[j].ID
+	})
+	return policies
+}
+
+func zoneFromProto(z *pb.Zone) *Zone {
+	return &Zone{
+		Name:        z.Name,
+		Interfaces:  z.Interfaces,
+		Prefixes:    z.Prefixes,
+		Description: z.Description,
+	}
+}
+
+func zoneToProto(zone *Zone) *pb.Zone {
+	return &pb.Zone{
+		Name:        zone.Name,
+		Interfaces:  zone.Interfaces,
+		Prefixes:    zone.Prefixes,
+		Description: zone.Description,
+	}
+}
+
+func zonePolicyFromProto(p *pb.ZonePolicy) *ZonePolicy {
+	return &ZonePolicy{
+		ID:          p.Id,
+		FromZone:    p.FromZone,
+		ToZone:      p.ToZone,
+		Action:      p.Action,
+		Protocol:    p.Protocol,
+		DstPort:     p.DstPort,
+		Priority:    p.Priority,
+		Enabled:     p.Enabled,
+		Description: p.Description,
+	}
+}
+
+func zonePolicyToProto(policy *ZonePolicy) *pb.ZonePolicy {
+	return &pb.ZonePolicy{
+		Id:          policy.ID,
+		FromZone:    policy.FromZone,
+		ToZone:      policy.ToZone,
+		Action:      policy.Action,
+		Protocol:    policy.Protocol,
+		DstPort:     policy.DstPort,
+		Priority:    policy.Priority,
+		Enabled:     policy.Enabled,
+		Description: policy.Description,
+	}
+}
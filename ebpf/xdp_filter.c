@@ -1,12 +1,20 @@
 // SPDX-License-Identifier: Apache-2.0
 // Author: vppebpf  Date: 2024-12-19
-// XDP firewall: ICMP drop, TCP redirect to AF_XDP, others pass
+// XDP firewall: IPv4/IPv6 rules (prefix tries + rule slots) first, then ICMP drop, TCP redirect to AF_XDP, others pass;
+// sampled packets go to a ring buffer. A TC egress program applies outbound rules with the same flow table.
+// XDP filtering runs either inline or as a tail-call pipeline of stages the control plane manages.
 
 #include <linux/bpf.h>
 #include <bpf/bpf_helpers.h>
 #include <linux/if_ether.h>
 #include <linux/ip.h>
+#include <linux/ipv6.h>
 #include <linux/in.h>
+#include <linux/tcp.h>
+#include <linux/udp.h>
+#include <linux/icmp.h>
+#include <linux/icmpv6.h>
+#include <linux/pkt_cls.h>
 #include <bpf/bpf_endian.h>
 
 char _license[] SEC("license") = "GPL";
@@ -25,6 +33,7 @@ struct {
     __uint(key_size, sizeof(__u32));
     __uint(value_size, sizeof(__u64));
     __uint(max_entries, 4);  // PASS, DROP, REDIRECT, ERROR
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
 } stats_map SEC(".maps");
 
 enum stats_key {
@@ -34,6 +43,722 @@ enum stats_key {
     STAT_ERROR = 3,
 };
 
+// Why each ingress packet got its verdict, counted per disposition and XDP
+// action (mirrored by dispositionNames in ctrl/dispositions.go)
+enum disposition {
+    DISP_PARSE_ERROR = 0,  // Truncated header
+    DISP_NON_IP = 1,       // Neither IPv4 nor IPv6
+    DISP_RULE = 2,         // A rule matched
+    DISP_CONNTRACK = 3,    // A rule matched on the packet's connection state
+    DISP_IPSET = 4,        // Addresses hit rule prefixes, no rule matched the rest
+    DISP_DEFAULT = 5,      // Addresses hit no rule prefix, built-in defaults
+    DISP_DEGRADED = 6,     // Rules skipped by the degradation ladder
+    DISP_NORMALIZED = 7,   // Dropped by packet normalization
+    DISP_POLICED = 8,      // Dropped by the ingress policer of the interface
+    DISP_MAX = 9,
+};
+
+// XDP actions counted per disposition, XDP_ABORTED to XDP_REDIRECT
+#define DISP_VERDICTS 5
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, DISP_MAX * DISP_VERDICTS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dispositions SEC(".maps");
+
+// Rule slots per family, one bit each in struct rule_set
+#define MAX_RULES 256
+
+// 802.1Q and 802.1ad tags parsed before the IP header; rules match the
+// VLAN ID of the outer one
+#define VLAN_MAX_DEPTH 2
+#define VLAN_VID_MASK  0x0fff
+
+struct vlan_hdr {
+    __be16 tci;          // Priority, drop eligible and VLAN ID
+    __be16 encapsulated_proto;
+};
+
+enum rule_action {
+    ACTION_PASS = 0,
+    ACTION_DROP = 1,
+    ACTION_REDIRECT = 2,
+    ACTION_REJECT = 3,   // Drop and tell the sender (see ctrl/reject.go)
+    ACTION_MIRROR = 4,   // Pass and copy to the rule's mirror interface
+};
+
+// Rule verdict and L4 match, mirrored by BPFFirewallRule in
+// ctrl/bpf_integration.go. Addresses are matched through the prefix tries
+// below; ports are in host byte order.
+struct fw_rule {
+    __u16 src_port;      // 0 = any
+    __u16 src_port_end;  // Inclusive range end, 0 = single port
+    __u16 dst_port;      // 0 = any
+    __u16 dst_port_end;  // Inclusive range end, 0 = single port
+    __u8  protocol;      // IP protocol / next header number, 0 = any
+    __u8  action;        // enum rule_action
+    __u8  enabled;       // Empty slots are zeroed and never match
+    __u8  ct_state;      // CT_STATE_* bits the packet must have, 0 = any
+    __s32 priority;      // Lower number wins
+    __u32 gen_from;      // First rule set generation the rule is live in, 0 = any
+    __u32 gen_until;     // Last generation the rule is live in, 0 = no end
+    __u32 src_set;       // IP set the source address must be in, 0 = none
+    __u32 dst_set;       // IP set the destination address must be in, 0 = none
+    __u32 log_id;        // Matched packets are logged under this ID, 0 = not logged
+    __u32 mirror_ifindex; // Interface mirror rules copy packets to
+    __u8  tcp_flags;     // TCP flags that must be set among those in the mask
+    __u8  tcp_flags_mask; // 0 = any flags; else only TCP packets match
+    __u16 vlan;          // VLAN ID of the packet's outer tag, 0 = any
+    __u8  src_mac[ETH_ALEN]; // All zero = any
+    __u8  dst_mac[ETH_ALEN]; // All zero = any
+    __u32 iface_set;     // Interface set the packet's interface must be in, 0 = any
+    __u32 src_country_set; // Country set the source address must be in, 0 = any
+    __u32 dst_country_set; // Country set the destination address must be in, 0 = any
+    __u32 src_asn_set;   // ASN set the source address must be announced by, 0 = any
+    __u32 dst_asn_set;   // ASN set the destination address must be announced by, 0 = any
+};
+
+// Rule slots whose prefix contains the looked-up one, bit n = slot n
+struct rule_set {
+    __u64 bits[MAX_RULES / 64];
+};
+
+struct lpm_key4 {
+    __u32 prefixlen;
+    __u32 addr;          // Network byte order
+};
+
+struct lpm_key6 {
+    __u32 prefixlen;
+    __u32 addr[4];       // Network byte order
+};
+
+#define RULE_ARRAY(name)                                \
+    struct {                                            \
+        __uint(type, BPF_MAP_TYPE_ARRAY);               \
+        __uint(key_size, sizeof(__u32));                \
+        __uint(value_size, sizeof(struct fw_rule));     \
+        __uint(max_entries, MAX_RULES);                 \
+        __uint(pinning, LIBBPF_PIN_BY_NAME);            \
+    } name SEC(".maps")
+
+// One entry per distinct prefix plus the /0 root
+#define PREFIX_TRIE(name, key)                          \
+    struct {                                            \
+        __uint(type, BPF_MAP_TYPE_LPM_TRIE);            \
+        __uint(key_size, sizeof(struct key));           \
+        __uint(value_size, sizeof(struct rule_set));    \
+        __uint(max_entries, MAX_RULES + 1);             \
+        __uint(map_flags, BPF_F_NO_PREALLOC);           \
+        __uint(pinning, LIBBPF_PIN_BY_NAME);            \
+    } name SEC(".maps")
+
+// Rule set generation, written by the control plane to switch from one
+// complete rule set to the next in a single update
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_generation SEC(".maps");
+
+// Policy version the control plane last installed (see
+// ctrl/policy_version.go), stamped onto samples and rule log events so
+// each can be attributed to the policy that produced its verdict
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_policy_version SEC(".maps");
+
+static __always_inline __u64 policy_version(void) {
+    __u32 zero = 0;
+    __u64 *version = bpf_map_lookup_elem(&cerberus_policy_version, &zero);
+    return version ? *version : 0;
+}
+
+// Hit counters of the rule in the same slot, summed over CPUs by the
+// control plane
+struct rule_hits {
+    __u64 packets;
+    __u64 bytes;
+    __u64 last_hit;      // bpf_ktime_get_ns() of the latest match
+};
+
+#define RULE_HITS(name)                                 \
+    struct {                                            \
+        __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);        \
+        __uint(key_size, sizeof(__u32));                \
+        __uint(value_size, sizeof(struct rule_hits));   \
+        __uint(max_entries, MAX_RULES);                 \
+        __uint(pinning, LIBBPF_PIN_BY_NAME);            \
+    } name SEC(".maps")
+
+// Rules written by the control plane, pinned under /sys/fs/bpf by name.
+// Each family has its own slots, hit counters and source/destination
+// prefix tries.
+RULE_ARRAY(cerberus_rules);
+RULE_HITS(cerberus_hits4);
+PREFIX_TRIE(cerberus_src4, lpm_key4);
+PREFIX_TRIE(cerberus_dst4, lpm_key4);
+
+RULE_ARRAY(cerberus_rules6);
+RULE_HITS(cerberus_hits6);
+PREFIX_TRIE(cerberus_src6, lpm_key6);
+PREFIX_TRIE(cerberus_dst6, lpm_key6);
+
+// Outbound rules, matched by the TC egress program
+RULE_ARRAY(cerberus_out4);
+RULE_HITS(cerberus_ohits4);
+PREFIX_TRIE(cerberus_osrc4, lpm_key4);
+PREFIX_TRIE(cerberus_odst4, lpm_key4);
+
+RULE_ARRAY(cerberus_out6);
+RULE_HITS(cerberus_ohits6);
+PREFIX_TRIE(cerberus_osrc6, lpm_key6);
+PREFIX_TRIE(cerberus_odst6, lpm_key6);
+
+/*
+ * Sharded ingress rules (see ctrl/shards.go). The leading bytes of the
+ * source address hash to a bucket, and the control plane assigns buckets to
+ * shards. Each shard has a rule map, hit counters and prefix tries per
+ * family, laid out like the tables above and created by the control plane.
+ * A packet consults the tables above, which hold rules whose source prefix
+ * is shorter than the hashed bytes, plus the shard of its bucket. IPv4
+ * addresses hash into the lower half of the buckets, IPv6 into the upper.
+ */
+#define SHARD_BUCKETS 65536
+#define MAX_SHARDS 8192   // Shard 0 is the tables above
+
+// Source prefix lengths hashed, in bits; 0 turns sharding off
+struct shard_config {
+    __u32 prefix4;       // Multiple of 8, at most 32
+    __u32 prefix6;       // Multiple of 8, at most 128
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct shard_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_shard_config SEC(".maps");
+
+// Bucket -> shard, 0 = no shard
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, SHARD_BUCKETS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_shard_buckets SEC(".maps");
+
+struct shard_rules {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct fw_rule));
+    __uint(max_entries, MAX_RULES);
+};
+
+struct shard_hits {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct rule_hits));
+    __uint(max_entries, MAX_RULES);
+};
+
+struct shard_trie4 {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key4));
+    __uint(value_size, sizeof(struct rule_set));
+    __uint(max_entries, MAX_RULES + 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+struct shard_trie6 {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key6));
+    __uint(value_size, sizeof(struct rule_set));
+    __uint(max_entries, MAX_RULES + 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+#define SHARD_MAPS(name, inner)                         \
+    struct {                                            \
+        __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);       \
+        __uint(key_size, sizeof(__u32));                \
+        __uint(max_entries, MAX_SHARDS);                \
+        __uint(pinning, LIBBPF_PIN_BY_NAME);            \
+        __array(values, struct inner);                  \
+    } name SEC(".maps")
+
+SHARD_MAPS(cerberus_shard_rules4, shard_rules);
+SHARD_MAPS(cerberus_shard_hits4, shard_hits);
+SHARD_MAPS(cerberus_shard_src4, shard_trie4);
+SHARD_MAPS(cerberus_shard_dst4, shard_trie4);
+
+SHARD_MAPS(cerberus_shard_rules6, shard_rules);
+SHARD_MAPS(cerberus_shard_hits6, shard_hits);
+SHARD_MAPS(cerberus_shard_src6, shard_trie6);
+SHARD_MAPS(cerberus_shard_dst6, shard_trie6);
+
+/*
+ * Bloom filter pre-check (see ctrl/bloom.go). Every enabled ingress rule,
+ * global or sharded, is entered under its source or destination prefix,
+ * whichever is longer, cut to one of the prefix lengths checked for its
+ * family. A packet none of whose checks hits the filter matches no rule,
+ * so the prefix tries and rule maps are skipped. A family with a rule
+ * matching any address on both sides is never pre-checked. Bloom filters
+ * cannot delete elements, so the control plane rebuilds the filter of the
+ * inactive slot on policy changes and then switches slots.
+ */
+#define BLOOM_MAX_CHECKS 8
+#define BLOOM_SLOTS 2
+
+struct bloom_check {
+    __u8 side;           // 0 = source address, 1 = destination address
+    __u8 prefixlen;
+};
+
+struct bloom_family {
+    __u8 complete;       // Every enabled rule of the family is in the filter
+    __u8 checks;         // Entries of check in use
+    struct bloom_check check[BLOOM_MAX_CHECKS];
+};
+
+// Mirrored by bloomConfig in ctrl/bloom.go
+struct bloom_config {
+    struct bloom_family family4;
+    struct bloom_family family6;
+};
+
+// Filter element: a prefix, its address masked to prefixlen bits
+struct bloom_key {
+    __u8 family;         // 4 or 6
+    __u8 side;
+    __u8 prefixlen;
+    __u8 pad;
+    __u8 addr[16];       // Network byte order
+};
+
+// Active slot plus 1, 0 = no pre-check
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_bloom_active SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct bloom_config));
+    __uint(max_entries, BLOOM_SLOTS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_bloom_config SEC(".maps");
+
+// Inner maps are sized to their elements by the control plane; the kernel
+// only compares the size of array inner maps, and bloom filters reject
+// BPF_F_INNER_MAP
+struct bloom_filter {
+    __uint(type, BPF_MAP_TYPE_BLOOM_FILTER);
+    __uint(value_size, sizeof(struct bloom_key));
+    __uint(max_entries, 1);
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);
+    __uint(key_size, sizeof(__u32));
+    __uint(max_entries, BLOOM_SLOTS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+    __array(values, struct bloom_filter);
+} cerberus_bloom SEC(".maps");
+
+// Packets pre-checked and packets the filter decided matched no rule
+#define BLOOM_STAT_CHECKED 0
+#define BLOOM_STAT_NEGATIVE 1
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, 2);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_bloom_stats SEC(".maps");
+
+/*
+ * IP sets (see ctrl/ipsets.go): large address sets managed apart from the
+ * rules, each in its own inner map created by the control plane. Hash sets
+ * hold host addresses, LPM sets hold prefixes. A set ID indexes the outer
+ * map of its type and family; the other outer maps have no map at that
+ * index. Rules reference sets by ID and match only addresses in them.
+ */
+#define MAX_IPSETS 1024   // Set ID 0 is unused
+
+// Inner maps are sized by the control plane
+struct ipset_hash4 {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+struct ipset_hash6 {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u32) * 4);
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+struct ipset_lpm4 {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key4));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+struct ipset_lpm6 {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key6));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, 1);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+};
+
+#define IPSET_MAPS(name, inner)                         \
+    struct {                                            \
+        __uint(type, BPF_MAP_TYPE_ARRAY_OF_MAPS);       \
+        __uint(key_size, sizeof(__u32));                \
+        __uint(max_entries, MAX_IPSETS);                \
+        __uint(pinning, LIBBPF_PIN_BY_NAME);            \
+        __array(values, struct inner);                  \
+    } name SEC(".maps")
+
+IPSET_MAPS(cerberus_ipset_hash4, ipset_hash4);
+IPSET_MAPS(cerberus_ipset_hash6, ipset_hash6);
+IPSET_MAPS(cerberus_ipset_lpm4, ipset_lpm4);
+IPSET_MAPS(cerberus_ipset_lpm6, ipset_lpm6);
+
+// Connection tracking. Flows are keyed in the direction of their first
+// packet; replies are found by looking the reversed key up.
+#define CT_MAX_ENTRIES 65536
+
+enum ct_entry_state {
+    CT_NEW = 1,          // No reply seen yet
+    CT_ESTABLISHED = 2,  // Traffic seen in both directions
+    CT_CLOSING = 3,      // TCP FIN or RST seen
+};
+
+// Packet states a rule can require
+#define CT_STATE_NEW         (1 << 0)
+#define CT_STATE_ESTABLISHED (1 << 1)
+#define CT_STATE_RELATED     (1 << 2)  // ICMP error quoting a tracked flow
+
+// TCP flag bits in byte 13 of the header
+#define TCPHDR_FIN 0x01
+#define TCPHDR_SYN 0x02
+#define TCPHDR_RST 0x04
+#define TCPHDR_ACK 0x10
+
+// Mirrored by ConntrackKey in ctrl/conntrack.go
+struct ct_key {
+    __u32 src_addr[4];   // IPv4 uses the first word, network byte order
+    __u32 dst_addr[4];
+    __u16 src_port;      // Host byte order; ICMP echo identifier for ICMP
+    __u16 dst_port;
+    __u8  protocol;
+    __u8  family;        // 4 or 6
+    __u8  pad[2];
+};
+
+// Mirrored by ConntrackEntry in ctrl/conntrack.go
+struct ct_entry {
+    __u64 last_seen;     // bpf_ktime_get_ns()
+    __u64 packets;
+    __u64 bytes;
+    __u8  state;         // enum ct_entry_state
+    __u8  pad;
+    __u16 nat_port;      // Destination port before a DNAT rule rewrote it
+    __u32 nat_id;        // DNAT rule that rewrote the destination, 0 = none
+    __u32 nat_addr[4];   // Destination address before the rewrite
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct ct_key));
+    __uint(value_size, sizeof(struct ct_entry));
+    __uint(max_entries, CT_MAX_ENTRIES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_conntrack SEC(".maps");
+
+// Packet sampling. The control plane sets the rate, one packet in
+// cerberus_sample_rate[0] is copied to the ring buffer (0 = off).
+#define SAMPLE_RING_SIZE (256 * 1024)
+
+// Mirrored by PacketSample in ctrl/sampling.go
+struct pkt_sample {
+    __u64 timestamp;     // bpf_ktime_get_ns()
+    __u32 sample_rate;   // Rate in effect when the packet was sampled
+    __u32 bytes;
+    struct ct_key key;   // Addresses, ports, protocol and family
+    __u8  verdict;       // XDP action
+    __u8  ct_state;      // CT_STATE_* bit of the packet
+    __u8  tcp_flags;     // Byte 13 of the TCP header, 0 for other protocols
+    __u8  pad;
+    __u16 vlan;          // VLAN ID of the outer tag, 0 = untagged
+    __u8  src_mac[ETH_ALEN];
+    __u8  dst_mac[ETH_ALEN];
+    __u8  pad2[2];
+    __u32 ifindex;       // Interface the packet arrived on
+    __u64 policy_version; // Installed when the packet was sampled, 0 = unknown
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_sample_rate SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, SAMPLE_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_samples SEC(".maps");
+
+// Rule logging. Packets matching a rule with a log ID are copied to the
+// log ring buffer, one in cerberus_log_rate[0] (0 or 1 = every packet);
+// when the ring is full the event is lost.
+#define LOG_RING_SIZE (256 * 1024)
+
+// Mirrored by ruleLogEvent in ctrl/rule_log.go
+struct log_event {
+    __u64 timestamp;     // bpf_ktime_get_ns()
+    __u32 log_id;        // Of the rule the packet matched
+    __u32 sample_rate;   // Rate in effect when the packet was logged
+    __u32 bytes;
+    struct ct_key key;
+    __u8  verdict;       // XDP action
+    __u8  egress;        // Matched an outbound rule
+    __u8  pad[2];
+    __u64 policy_version; // Installed when the packet was logged, 0 = unknown
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_log_rate SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, LOG_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_log_events SEC(".maps");
+
+// Packets dropped by reject rules, copied from their IP header for the
+// control plane to answer with a TCP RST or ICMP unreachable. When the
+// ring is full the packet is only dropped.
+#define REJECT_RING_SIZE (64 * 1024)
+#define REJECT_QUOTE_MAX 128
+
+// Mirrored by rejectEvent in ctrl/reject.go
+struct reject_event {
+    __u32 ifindex;
+    __u16 len;           // Bytes of packet copied
+    __u8  family;        // 4 or 6
+    __u8  egress;        // Dropped leaving the host
+    __u8  packet[REJECT_QUOTE_MAX];
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, REJECT_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_rejects SEC(".maps");
+
+// Multicast visibility (see ctrl/multicast.go). Packets arriving for a
+// multicast group are counted per group; IGMP and MLD membership reports
+// and leaves the host passes are copied from their IP header for the
+// control plane to track joined groups. Whether a group is allowed is up
+// to the rules, matching its address as destination.
+#define MAX_MCAST_GROUPS 1024
+#define MCAST_RING_SIZE (64 * 1024)
+#define MCAST_QUOTE_MAX 256
+
+// IGMP message types (RFC 3376) and MLD ones (RFC 3810)
+#define IGMP_V1_REPORT  0x12
+#define IGMP_V2_REPORT  0x16
+#define IGMP_V2_LEAVE   0x17
+#define IGMP_V3_REPORT  0x22
+#define MLD_V1_REPORT   131
+#define MLD_V1_DONE     132
+#define MLD_V2_REPORT   143
+
+// Mirrored by mcastKey in ctrl/multicast.go
+struct mcast_key {
+    __u32 addr[4];       // Group address, IPv4 uses the first word
+    __u8  family;
+    __u8  pad[3];
+};
+
+// Mirrored by mcastStats in ctrl/multicast.go
+struct mcast_stats {
+    __u64 packets;
+    __u64 bytes;
+    __u64 dropped;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_PERCPU_HASH);
+    __uint(key_size, sizeof(struct mcast_key));
+    __uint(value_size, sizeof(struct mcast_stats));
+    __uint(max_entries, MAX_MCAST_GROUPS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_mcast_stats SEC(".maps");
+
+// Mirrored by mcastReport in ctrl/multicast.go
+struct mcast_report {
+    __u32 ifindex;
+    __u16 len;           // Bytes of packet copied
+    __u8  family;        // 4 or 6
+    __u8  egress;        // Sent by the host itself
+    __u8  packet[MCAST_QUOTE_MAX];
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, MCAST_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_mcast_reports SEC(".maps");
+
+/*
+ * Mirroring. A packet a mirror rule passes is copied out of the rule's
+ * mirror interface, e.g. to an IDS or capture box. XDP cannot clone
+ * packets, so it passes them with the mirror interface in their metadata
+ * and tc_mirror, attached to the ingress hook of the same interface,
+ * sends the copy; the TC hooks clone directly.
+ */
+#define MIRROR_META_MAGIC 0x4d495252   // "MIRR"
+
+struct mirror_meta {
+    __u32 magic;
+    __u32 ifindex;
+};
+
+enum mirror_stat {
+    MIRROR_SENT = 0,
+    MIRROR_FAILED = 1,   // No metadata room or the interface is gone
+    MIRROR_STAT_MAX = 2,
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, MIRROR_STAT_MAX);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_mirror_stats SEC(".maps");
+
+// Degradation under resource pressure. The control plane sets these bits
+// in cerberus_degrade[0] as it steps down its degradation ladder.
+enum degrade_flags {
+    DEGRADE_NO_SAMPLING  = 1 << 0,  // Stop copying packet samples
+    DEGRADE_NO_SLOW_PATH = 1 << 1,  // Pass instead of redirecting to AF_XDP
+    DEGRADE_DEFAULT_ONLY = 1 << 2,  // Skip control plane rules
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_degrade SEC(".maps");
+
+static __always_inline __u32 degrade_flags(void) {
+    __u32 key = 0;
+    __u32 *flags = bpf_map_lookup_elem(&cerberus_degrade, &key);
+    return flags ? *flags : 0;
+}
+
+/*
+ * Default policy for packets no rule matches (see ctrl/default_policy.go),
+ * keyed by interface index; index 0 applies to interfaces without their
+ * own entry. Without an entry the built-in defaults apply.
+ */
+enum default_action {
+    DEFAULT_BUILTIN = 0,
+    DEFAULT_ALLOW = 1,
+    DEFAULT_DROP = 2,
+};
+
+// Mirrored by defaultPolicyValue in ctrl/default_policy.go
+struct default_policy {
+    __u8 ingress;        // enum default_action
+    __u8 egress;
+    __u8 pad[2];
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct default_policy));
+    __uint(max_entries, 256);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_default_policy SEC(".maps");
+
+static __always_inline __u8 default_action(__u32 ifindex, int egress) {
+    struct default_policy *policy = bpf_map_lookup_elem(&cerberus_default_policy, &ifindex);
+    __u8 action = policy ? (egress ? policy->egress : policy->ingress) : DEFAULT_BUILTIN;
+    if (action != DEFAULT_BUILTIN || !ifindex)
+        return action;
+
+    __u32 all = 0;
+    policy = bpf_map_lookup_elem(&cerberus_default_policy, &all);
+    if (!policy)
+        return DEFAULT_BUILTIN;
+    return egress ? policy->egress : policy->ingress;
+}
+
+// Connection tracking context of one packet
+struct ct_ctx {
+    struct ct_key key;       // In the packet's own direction
+    struct ct_entry *entry;  // Flow the packet belongs to, NULL if none
+    __u8 reply;              // Packet travels opposite to the flow's first packet
+    __u8 state;              // CT_STATE_* bit of the packet
+    __u8 tcp_flags;
+    __u8 trackable;          // TCP, UDP, SCTP and ICMP echo create flows
+    __u8 prefix_hit;         // Addresses hit the prefixes of a rule slot
+    __u8 reject;             // Dropped by a reject rule, the sender is told
+    __u8 syn_cookie;         // Dropped SYN to answer with a SYN flood cookie
+    __u8 norm;               // enum norm_reason to drop it for, 0 = none
+    __u16 l3;                // Offset of the IP header the key was taken from
+    __u32 ifindex;           // Interface the packet arrived on or leaves by
+    __u32 tunnel;            // ID of the tunnel that carried it, 0 = none
+    __u32 log_id;            // Log ID of the rule it matched, 0 = not logged
+    __u32 mirror;            // Interface to copy the packet to if passed, 0 = none
+    __u16 vlan;              // VLAN ID of the outer tag, 0 = untagged
+    __u8 src_mac[ETH_ALEN];
+    __u8 dst_mac[ETH_ALEN];
+    __u16 nat_port;          // Destination before a DNAT rule rewrote it
+    __u32 nat_id;            // DNAT rule that rewrote the destination, 0 = none
+    __u32 nat_addr[4];
+};
+
 static __always_inline void update_stats(__u32 key) {
     __u64 *value = bpf_map_lookup_elem(&stats_map, &key);
     if (value) {
@@ -41,49 +766,4169 @@ static __always_inline void update_stats(__u32 key) {
     }
 }
 
+static __always_inline void count_disposition(__u32 disposition, int verdict) {
+    if ((__u32)verdict >= DISP_VERDICTS)
+        return;
+    __u32 key = disposition * DISP_VERDICTS + verdict;
+    __u64 *value = bpf_map_lookup_elem(&cerberus_dispositions, &key);
+    if (value)
+        __sync_fetch_and_add(value, 1);
+}
+
+// Disposition of a packet no rule matched
+static __always_inline __u32 default_disposition(struct ct_ctx *ct, int degraded) {
+    if (degraded)
+        return DISP_DEGRADED;
+    return ct->prefix_hit ? DISP_IPSET : DISP_DEFAULT;
+}
+
+// Interfaces of interface-scoped rules (see ctrl/interface_rules.go): an
+// entry for each interface of each rule interface set. The interface is
+// the one a packet arrived on, or leaves by at TC egress.
+#define MAX_IFACE_SET_ENTRIES 1024
+
+// Mirrored by ruleInterfaceKey in ctrl/interface_rules.go
+struct iface_set_key {
+    __u32 set;           // iface_set of the rules
+    __u32 ifindex;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct iface_set_key));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, MAX_IFACE_SET_ENTRIES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_rule_ifaces SEC(".maps");
+
+static __always_inline int iface_in_set(__u32 set, __u32 ifindex) {
+    struct iface_set_key key = { .set = set, .ifindex = ifindex };
+    return bpf_map_lookup_elem(&cerberus_rule_ifaces, &key) != NULL;
+}
+
+/*
+ * Country matches (see ctrl/country_rules.go): the GeoIP prefixes of the
+ * countries rules reference, mapped to their ISO 3166 code packed into a
+ * __u16 ('N' << 8 | 'L'), and an entry for each country of each rule
+ * country set. An address of no referenced country has code 0.
+ */
+#define MAX_GEO_PREFIXES 524288
+#define MAX_COUNTRY_SET_ENTRIES 4096
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key4));
+    __uint(value_size, sizeof(__u16));
+    __uint(max_entries, MAX_GEO_PREFIXES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_geo4 SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key6));
+    __uint(value_size, sizeof(__u16));
+    __uint(max_entries, MAX_GEO_PREFIXES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_geo6 SEC(".maps");
+
+// Mirrored by ruleCountryKey in ctrl/country_rules.go
+struct country_set_key {
+    __u32 set;           // src_country_set or dst_country_set of the rules
+    __u16 country;
+    __u16 pad;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct country_set_key));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, MAX_COUNTRY_SET_ENTRIES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_rule_countries SEC(".maps");
+
+// Whether an address of the packet's family is in a country of a set
+static __always_inline int country_in_set(__u32 set, __u8 family, const __u32 *addr) {
+    struct country_set_key key = { .set = set };
+    __u16 *country;
+
+    if (family == 6) {
+        struct lpm_key6 geo = { .prefixlen = 128 };
+        __builtin_memcpy(geo.addr, addr, sizeof(geo.addr));
+        country = bpf_map_lookup_elem(&cerberus_geo6, &geo);
+    } else {
+        struct lpm_key4 geo = { .prefixlen = 32, .addr = addr[0] };
+        country = bpf_map_lookup_elem(&cerberus_geo4, &geo);
+    }
+    if (!country)
+        return 0;
+    key.country = *country;
+    return bpf_map_lookup_elem(&cerberus_rule_countries, &key) != NULL;
+}
+
+/*
+ * ASN matches (see ctrl/asn_rules.go): the prefixes announced by the ASNs
+ * rules reference, and the more specific prefixes other ASNs announce
+ * within them, mapped to their origin ASN; and an entry for each ASN of
+ * each rule ASN set. An address of no referenced ASN has no prefix.
+ */
+#define MAX_ASN_PREFIXES 524288
+#define MAX_ASN_SET_ENTRIES 16384
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key4));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, MAX_ASN_PREFIXES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_asn4 SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key6));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, MAX_ASN_PREFIXES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_asn6 SEC(".maps");
+
+// Mirrored by ruleASNKey in ctrl/asn_rules.go
+struct asn_set_key {
+    __u32 set;           // src_asn_set or dst_asn_set of the rules
+    __u32 asn;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct asn_set_key));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, MAX_ASN_SET_ENTRIES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_rule_asns SEC(".maps");
+
+// Whether an address of the packet's family is announced by an ASN of a set
+static __always_inline int asn_in_set(__u32 set, __u8 family, const __u32 *addr) {
+    struct asn_set_key key = { .set = set };
+    __u32 *asn;
+
+    if (family == 6) {
+        struct lpm_key6 origin = { .prefixlen = 128 };
+        __builtin_memcpy(origin.addr, addr, sizeof(origin.addr));
+        asn = bpf_map_lookup_elem(&cerberus_asn6, &origin);
+    } else {
+        struct lpm_key4 origin = { .prefixlen = 32, .addr = addr[0] };
+        asn = bpf_map_lookup_elem(&cerberus_asn4, &origin);
+    }
+    if (!asn)
+        return 0;
+    key.asn = *asn;
+    return bpf_map_lookup_elem(&cerberus_rule_asns, &key) != NULL;
+}
+
+// Whether an address of the packet's family is in an IP set
+static __always_inline int ipset_contains(__u32 id, __u8 family, const __u32 *addr) {
+    void *set;
+
+    if (family == 6) {
+        if ((set = bpf_map_lookup_elem(&cerberus_ipset_hash6, &id)))
+            return bpf_map_lookup_elem(set, addr) != NULL;
+        if ((set = bpf_map_lookup_elem(&cerberus_ipset_lpm6, &id))) {
+            struct lpm_key6 key = { .prefixlen = 128 };
+            __builtin_memcpy(key.addr, addr, sizeof(key.addr));
+            return bpf_map_lookup_elem(set, &key) != NULL;
+        }
+        return 0;
+    }
+    if ((set = bpf_map_lookup_elem(&cerberus_ipset_hash4, &id)))
+        return bpf_map_lookup_elem(set, addr) != NULL;
+    if ((set = bpf_map_lookup_elem(&cerberus_ipset_lpm4, &id))) {
+        struct lpm_key4 key = { .prefixlen = 32, .addr = addr[0] };
+        return bpf_map_lookup_elem(set, &key) != NULL;
+    }
+    return 0;
+}
+
+// A zero start matches any port; a zero end makes the range a single port
+static __always_inline int port_match(__u16 port, __u16 start, __u16 end) {
+    if (!start)
+        return 1;
+    if (!end)
+        end = start;
+    return port >= start && port <= end;
+}
+
+// A rule's MAC address matches when it is all zero or equals the packet's
+static __always_inline int mac_match(const __u8 *rule, const __u8 *mac) {
+    __u8 set = 0, diff = 0;
+
+    for (int i = 0; i < ETH_ALEN; i++) {
+        set |= rule[i];
+        diff |= rule[i] ^ mac[i];
+    }
+    return !set || !diff;
+}
+
+/*
+ * Among the slots set in both the source and destination rule sets, return
+ * the enabled rule live in the active generation with the lowest priority
+ * number matching the L4 fields and IP sets, or NULL, and its slot. Equal
+ * priorities go to the lowest slot; the control plane keeps them in rule ID
+ * order. Rules are only read for candidate slots.
+ */
+static __always_inline struct fw_rule *best_candidate(void *rules, struct rule_set *src,
+                                                      struct rule_set *dst,
+                                                      struct ct_ctx *ct, __u32 *best_slot) {
+    struct fw_rule *best = NULL;
+    __u32 zero = 0;
+    __u32 *generation = bpf_map_lookup_elem(&cerberus_generation, &zero);
+    __u32 active = generation ? *generation : 0;
+
+    if (!src || !dst)
+        return NULL;
+
+    for (__u32 i = 0; i < MAX_RULES; i++) {
+        __u32 word = i / 64;
+        __u64 bit = 1ULL << (i % 64);
+        if (!(src->bits[word] & dst->bits[word] & bit))
+            continue;
+        ct->prefix_hit = 1;
+
+        __u32 key = i;
+        struct fw_rule *rule = bpf_map_lookup_elem(rules, &key);
+        if (!rule || !rule->enabled)
+            continue;
+        if (rule->gen_from > active || (rule->gen_until && rule->gen_until < active))
+            continue;
+        if (rule->protocol && rule->protocol != ct->key.protocol)
+            continue;
+        if (!port_match(ct->key.src_port, rule->src_port, rule->src_port_end))
+            continue;
+        if (!port_match(ct->key.dst_port, rule->dst_port, rule->dst_port_end))
+            continue;
+        if (rule->ct_state && !(rule->ct_state & ct->state))
+            continue;
+        if (rule->tcp_flags_mask && (ct->key.protocol != IPPROTO_TCP ||
+                                     (ct->tcp_flags & rule->tcp_flags_mask) != rule->tcp_flags))
+            continue;
+        if (rule->vlan && rule->vlan != ct->vlan)
+            continue;
+        if (!mac_match(rule->src_mac, ct->src_mac) || !mac_match(rule->dst_mac, ct->dst_mac))
+            continue;
+        if (rule->iface_set && !iface_in_set(rule->iface_set, ct->ifindex))
+            continue;
+        if (rule->src_set && !ipset_contains(rule->src_set, ct->key.family, ct->key.src_addr))
+            continue;
+        if (rule->dst_set && !ipset_contains(rule->dst_set, ct->key.family, ct->key.dst_addr))
+            continue;
+        if (rule->src_country_set &&
+            !country_in_set(rule->src_country_set, ct->key.family, ct->key.src_addr))
+            continue;
+        if (rule->dst_country_set &&
+            !country_in_set(rule->dst_country_set, ct->key.family, ct->key.dst_addr))
+            continue;
+        if (rule->src_asn_set && !asn_in_set(rule->src_asn_set, ct->key.family, ct->key.src_addr))
+            continue;
+        if (rule->dst_asn_set && !asn_in_set(rule->dst_asn_set, ct->key.family, ct->key.dst_addr))
+            continue;
+        if (!best || rule->priority < best->priority) {
+            best = rule;
+            *best_slot = i;
+        }
+    }
+    return best;
+}
+
+// Count a packet in the hit counters of the slot whose rule matched it
+static __always_inline void count_hit(void *hits, __u32 slot, __u64 bytes) {
+    struct rule_hits *counters = bpf_map_lookup_elem(hits, &slot);
+    if (counters) {
+        counters->packets++;
+        counters->bytes += bytes;
+        counters->last_hit = bpf_ktime_get_ns();
+    }
+}
+
+// The best candidate rule, counted in the hit counters of its slot
+static __always_inline struct fw_rule *match_candidates(void *rules, void *hits,
+                                                        struct rule_set *src,
+                                                        struct rule_set *dst,
+                                                        struct ct_ctx *ct,
+                                                        __u64 bytes) {
+    __u32 slot = 0;
+    struct fw_rule *best = best_candidate(rules, src, dst, ct, &slot);
+
+    if (best)
+        count_hit(hits, slot, bytes);
+    return best;
+}
+
+static __always_inline struct fw_rule *match_rules(struct ct_ctx *ct, void *rules, void *hits,
+                                                   void *src_trie, void *dst_trie, __u64 bytes) {
+    struct lpm_key4 src_key = { .prefixlen = 32, .addr = ct->key.src_addr[0] };
+    struct lpm_key4 dst_key = { .prefixlen = 32, .addr = ct->key.dst_addr[0] };
+
+    return match_candidates(rules, hits,
+                            bpf_map_lookup_elem(src_trie, &src_key),
+                            bpf_map_lookup_elem(dst_trie, &dst_key),
+                            ct, bytes);
+}
+
+static __always_inline struct fw_rule *match_rules6(struct ct_ctx *ct, void *rules, void *hits,
+                                                    void *src_trie, void *dst_trie, __u64 bytes) {
+    struct lpm_key6 src_key = { .prefixlen = 128 };
+    struct lpm_key6 dst_key = { .prefixlen = 128 };
+    __builtin_memcpy(src_key.addr, ct->key.src_addr, sizeof(src_key.addr));
+    __builtin_memcpy(dst_key.addr, ct->key.dst_addr, sizeof(dst_key.addr));
+
+    return match_candidates(rules, hits,
+                            bpf_map_lookup_elem(src_trie, &src_key),
+                            bpf_map_lookup_elem(dst_trie, &dst_key),
+                            ct, bytes);
+}
+
+// Shard of the packet's source address: FNV-1a of its leading bytes picks
+// the bucket. Returns 0 when sharding is off.
+static __always_inline __u32 packet_shard(struct ct_key *key) {
+    __u32 zero = 0;
+    struct shard_config *config = bpf_map_lookup_elem(&cerberus_shard_config, &zero);
+    if (!config)
+        return 0;
+
+    __u32 len = (key->family == 6 ? config->prefix6 : config->prefix4) / 8;
+    if (!len)
+        return 0;
+
+    const __u8 *addr = (const __u8 *)key->src_addr;
+    __u32 hash = 2166136261U;
+    for (__u32 i = 0; i < 16; i++) {
+        if (i >= len)
+            break;
+        hash ^= addr[i];
+        hash *= 16777619U;
+    }
+
+    __u32 bucket = hash % (SHARD_BUCKETS / 2);
+    if (key->family == 6)
+        bucket += SHARD_BUCKETS / 2;
+    __u32 *shard = bpf_map_lookup_elem(&cerberus_shard_buckets, &bucket);
+    return shard ? *shard : 0;
+}
+
+// Best rule in the shard of the packet, or NULL; *slot and *hits locate the
+// counters of its slot
+static __always_inline struct fw_rule *match_shard(struct ct_ctx *ct, __u32 *slot, void **hits) {
+    __u32 shard = packet_shard(&ct->key);
+    struct rule_set *src, *dst;
+    void *rules;
+
+    if (!shard)
+        return NULL;
+
+    if (ct->key.family == 6) {
+        struct lpm_key6 src_key = { .prefixlen = 128 };
+        struct lpm_key6 dst_key = { .prefixlen = 128 };
+        __builtin_memcpy(src_key.addr, ct->key.src_addr, sizeof(src_key.addr));
+        __builtin_memcpy(dst_key.addr, ct->key.dst_addr, sizeof(dst_key.addr));
+
+        void *src_trie = bpf_map_lookup_elem(&cerberus_shard_src6, &shard);
+        void *dst_trie = bpf_map_lookup_elem(&cerberus_shard_dst6, &shard);
+        rules = bpf_map_lookup_elem(&cerberus_shard_rules6, &shard);
+        *hits = bpf_map_lookup_elem(&cerberus_shard_hits6, &shard);
+        if (!src_trie || !dst_trie || !rules || !*hits)
+            return NULL;
+        src = bpf_map_lookup_elem(src_trie, &src_key);
+        dst = bpf_map_lookup_elem(dst_trie, &dst_key);
+    } else {
+        struct lpm_key4 src_key = { .prefixlen = 32, .addr = ct->key.src_addr[0] };
+        struct lpm_key4 dst_key = { .prefixlen = 32, .addr = ct->key.dst_addr[0] };
+
+        void *src_trie = bpf_map_lookup_elem(&cerberus_shard_src4, &shard);
+        void *dst_trie = bpf_map_lookup_elem(&cerberus_shard_dst4, &shard);
+        rules = bpf_map_lookup_elem(&cerberus_shard_rules4, &shard);
+        *hits = bpf_map_lookup_elem(&cerberus_shard_hits4, &shard);
+        if (!src_trie || !dst_trie || !rules || !*hits)
+            return NULL;
+        src = bpf_map_lookup_elem(src_trie, &src_key);
+        dst = bpf_map_lookup_elem(dst_trie, &dst_key);
+    }
+    return best_candidate(rules, src, dst, ct, slot);
+}
+
+// Candidate rule slots of a packet, copied out of the prefix tries
+static __always_inline int lookup_candidates(struct ct_key *key, struct rule_set *src,
+                                             struct rule_set *dst) {
+    struct rule_set *src_set, *dst_set;
+
+    if (key->family == 6) {
+        struct lpm_key6 src_key = { .prefixlen = 128 };
+        struct lpm_key6 dst_key = { .prefixlen = 128 };
+        __builtin_memcpy(src_key.addr, key->src_addr, sizeof(src_key.addr));
+        __builtin_memcpy(dst_key.addr, key->dst_addr, sizeof(dst_key.addr));
+        src_set = bpf_map_lookup_elem(&cerberus_src6, &src_key);
+        dst_set = bpf_map_lookup_elem(&cerberus_dst6, &dst_key);
+    } else {
+        struct lpm_key4 src_key = { .prefixlen = 32, .addr = key->src_addr[0] };
+        struct lpm_key4 dst_key = { .prefixlen = 32, .addr = key->dst_addr[0] };
+        src_set = bpf_map_lookup_elem(&cerberus_src4, &src_key);
+        dst_set = bpf_map_lookup_elem(&cerberus_dst4, &dst_key);
+    }
+    if (!src_set || !dst_set)
+        return 0;
+    __builtin_memcpy(src, src_set, sizeof(*src));
+    __builtin_memcpy(dst, dst_set, sizeof(*dst));
+    return 1;
+}
+
+static __always_inline void count_bloom(__u32 stat) {
+    __u64 *count = bpf_map_lookup_elem(&cerberus_bloom_stats, &stat);
+    if (count)
+        (*count)++;
+}
+
+// Whether the bloom filter rules out every ingress rule for a packet
+static __always_inline int bloom_negative(struct ct_key *key) {
+    __u32 zero = 0;
+    __u32 *active = bpf_map_lookup_elem(&cerberus_bloom_active, &zero);
+    if (!active || !*active)
+        return 0;
+
+    __u32 slot = *active - 1;
+    struct bloom_config *config = bpf_map_lookup_elem(&cerberus_bloom_config, &slot);
+    void *filter = bpf_map_lookup_elem(&cerberus_bloom, &slot);
+    if (!config || !filter)
+        return 0;
+    struct bloom_family *family = key->family == 6 ? &config->family6 : &config->family4;
+    if (!family->complete)
+        return 0;
+
+    count_bloom(BLOOM_STAT_CHECKED);
+    for (__u32 i = 0; i < BLOOM_MAX_CHECKS; i++) {
+        if (i >= family->checks)
+            break;
+        struct bloom_key elem = {
+            .family = key->family,
+            .side = family->check[i].side,
+            .prefixlen = family->check[i].prefixlen,
+        };
+        const __u8 *addr = (const __u8 *)(elem.side ? key->dst_addr : key->src_addr);
+        for (__u32 j = 0; j < 16; j++) {
+            __u32 bits = j * 8;
+            if (bits >= elem.prefixlen)
+                break;
+            __u8 byte = addr[j];
+            if (elem.prefixlen - bits < 8)
+                byte &= 0xff << (8 - (elem.prefixlen - bits));
+            elem.addr[j] = byte;
+        }
+        if (bpf_map_peek_elem(filter, &elem) == 0)
+            return 0;
+    }
+    count_bloom(BLOOM_STAT_NEGATIVE);
+    return 1;
+}
+
+/*
+ * Best ingress rule among the candidate slots of the global tables and the
+ * rules of the packet's shard, counted in the hit counters of its slot.
+ * Ties go to the global tables; the control plane rejects overlapping rules
+ * of equal priority with different actions, so a tie never changes the
+ * verdict.
+ */
+static __always_inline struct fw_rule *match_ingress(struct ct_ctx *ct, struct rule_set *src,
+                                                     struct rule_set *dst, __u64 bytes) {
+    void *rules = &cerberus_rules, *hits = &cerberus_hits4;
+    void *shard_hits = NULL;
+    __u32 slot = 0, shard_slot = 0;
+
+    if (ct->key.family == 6) {
+        rules = &cerberus_rules6;
+        hits = &cerberus_hits6;
+    }
+    struct fw_rule *rule = best_candidate(rules, src, dst, ct, &slot);
+    struct fw_rule *sharded = match_shard(ct, &shard_slot, &shard_hits);
+    if (sharded && (!rule || sharded->priority < rule->priority)) {
+        rule = sharded;
+        slot = shard_slot;
+        hits = shard_hits;
+    }
+    if (rule)
+        count_hit(hits, slot, bytes);
+    return rule;
+}
+
+static __always_inline void ct_reverse(const struct ct_key *key, struct ct_key *rev) {
+    __builtin_memcpy(rev->src_addr, key->dst_addr, sizeof(rev->src_addr));
+    __builtin_memcpy(rev->dst_addr, key->src_addr, sizeof(rev->dst_addr));
+    rev->src_port = key->dst_port;
+    rev->dst_port = key->src_port;
+    rev->protocol = key->protocol;
+    rev->family = key->family;
+}
+
+// Find the flow of a packet in either direction and derive its state
+static __always_inline void ct_classify(struct ct_ctx *ct) {
+    struct ct_key rev = {};
+
+    ct->state = CT_STATE_NEW;
+    if (!ct->trackable)
+        return;
+
+    ct->entry = bpf_map_lookup_elem(&cerberus_conntrack, &ct->key);
+    if (ct->entry) {
+        if (ct->entry->state != CT_NEW)
+            ct->state = CT_STATE_ESTABLISHED;
+        return;
+    }
+
+    ct_reverse(&ct->key, &rev);
+    ct->entry = bpf_map_lookup_elem(&cerberus_conntrack, &rev);
+    if (ct->entry) {
+        ct->reply = 1;
+        ct->state = CT_STATE_ESTABLISHED;
+    }
+}
+
+// Mark an ICMP error as related when the packet it quotes is tracked
+static __always_inline void ct_related(struct ct_ctx *ct, struct ct_key *quoted) {
+    struct ct_key rev = {};
+
+    ct_reverse(quoted, &rev);
+    if (bpf_map_lookup_elem(&cerberus_conntrack, quoted) ||
+        bpf_map_lookup_elem(&cerberus_conntrack, &rev))
+        ct->state = CT_STATE_RELATED;
+}
+
+// Record a packet that was not dropped, creating its flow if needed
+static __always_inline void ct_update(struct ct_ctx *ct, __u64 bytes) {
+    if (!ct->trackable)
+        return;
+
+    __u64 now = bpf_ktime_get_ns();
+    struct ct_entry *entry = ct->entry;
+    if (!entry) {
+        struct ct_entry fresh = {
+            .last_seen = now,
+            .packets = 1,
+            .bytes = bytes,
+            .state = CT_NEW,
+            .nat_port = ct->nat_port,
+            .nat_id = ct->nat_id,
+        };
+        __builtin_memcpy(fresh.nat_addr, ct->nat_addr, sizeof(fresh.nat_addr));
+        bpf_map_update_elem(&cerberus_conntrack, &ct->key, &fresh, BPF_NOEXIST);
+        return;
+    }
+
+    entry->last_seen = now;
+    __sync_fetch_and_add(&entry->packets, 1);
+    __sync_fetch_and_add(&entry->bytes, bytes);
+    if (ct->tcp_flags & (TCPHDR_FIN | TCPHDR_RST))
+        entry->state = CT_CLOSING;
+    else if (ct->reply && entry->state == CT_NEW)
+        entry->state = CT_ESTABLISHED;
+}
+
+// Copy one in sample_rate IP packets to the ring buffer; when the ring is
+// full the sample is lost, the controller lowers the rate on its next pass
+static __always_inline void sample_packet(struct ct_ctx *ct, __u64 bytes, int verdict) {
+    __u32 key = 0;
+    __u32 *rate = bpf_map_lookup_elem(&cerberus_sample_rate, &key);
+
+    if (!rate || !*rate || !ct->key.family)
+        return;
+    if (degrade_flags() & DEGRADE_NO_SAMPLING)
+        return;
+    if (*rate > 1 && bpf_get_prandom_u32() % *rate)
+        return;
+
+    struct pkt_sample *sample = bpf_ringbuf_reserve(&cerberus_samples, sizeof(*sample), 0);
+    if (!sample)
+        return;
+    sample->timestamp = bpf_ktime_get_ns();
+    sample->sample_rate = *rate;
+    sample->bytes = bytes;
+    __builtin_memcpy(&sample->key, &ct->key, sizeof(sample->key));
+    sample->verdict = verdict;
+    sample->ct_state = ct->state;
+    sample->tcp_flags = ct->key.protocol == IPPROTO_TCP ? ct->tcp_flags : 0;
+    sample->pad = 0;
+    sample->vlan = ct->vlan;
+    __builtin_memcpy(sample->src_mac, ct->src_mac, ETH_ALEN);
+    __builtin_memcpy(sample->dst_mac, ct->dst_mac, ETH_ALEN);
+    __builtin_memset(sample->pad2, 0, sizeof(sample->pad2));
+    sample->ifindex = ct->ifindex;
+    sample->policy_version = policy_version();
+    bpf_ringbuf_submit(sample, 0);
+}
+
+// Log a packet that matched a rule with a log ID
+static __always_inline void log_packet(struct ct_ctx *ct, __u64 bytes, int verdict, int egress) {
+    if (!ct->log_id)
+        return;
+    __u32 key = 0;
+    __u32 *rate = bpf_map_lookup_elem(&cerberus_log_rate, &key);
+    __u32 sample_rate = rate && *rate > 1 ? *rate : 1;
+    if (sample_rate > 1 && bpf_get_prandom_u32() % sample_rate)
+        return;
+
+    struct log_event *event = bpf_ringbuf_reserve(&cerberus_log_events, sizeof(*event), 0);
+    if (!event)
+        return;
+    event->timestamp = bpf_ktime_get_ns();
+    event->log_id = ct->log_id;
+    event->sample_rate = sample_rate;
+    event->bytes = bytes;
+    __builtin_memcpy(&event->key, &ct->key, sizeof(event->key));
+    event->verdict = verdict;
+    event->egress = egress;
+    __builtin_memset(event->pad, 0, sizeof(event->pad));
+    event->policy_version = policy_version();
+    bpf_ringbuf_submit(event, 0);
+}
+
+// Ingress IP packets per protocol number, counted with their final verdict
+struct proto_stats {
+    __u64 packets;
+    __u64 bytes;
+    __u64 dropped;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct proto_stats));
+    __uint(max_entries, 256);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_protocol_stats SEC(".maps");
+
+// Count an IP packet under its L4 protocol; packets dropped before they
+// were parsed have no family and are not counted
+static __always_inline void protocol_count(struct ct_ctx *ct, __u64 bytes, int verdict) {
+    if (!ct->key.family)
+        return;
+    __u32 key = ct->key.protocol;
+    struct proto_stats *stats = bpf_map_lookup_elem(&cerberus_protocol_stats, &key);
+    if (!stats)
+        return;
+    stats->packets++;
+    stats->bytes += bytes;
+    if (verdict == XDP_DROP || verdict == XDP_ABORTED)
+        stats->dropped++;
+}
+
+// AF_XDP sockets only exist at the XDP hook; the TC ingress fallback and a
+// data plane degraded to no slow path pass redirected traffic to the stack
+// instead
+static __always_inline int redirect_verdict(__u32 queue_id, int xdp) {
+    if (!xdp) {
+        update_stats(STAT_PASS);
+        return XDP_PASS;
+    }
+    update_stats(STAT_REDIRECT);
+    return bpf_redirect_map(&xsk_map, queue_id, 0);
+}
+
+static __always_inline int rule_verdict(__u8 action, __u32 queue_id, int xdp) {
+    switch (action) {
+    case ACTION_DROP:
+    case ACTION_REJECT:
+        update_stats(STAT_DROP);
+        return XDP_DROP;
+    case ACTION_REDIRECT:
+        return redirect_verdict(queue_id, xdp);
+    default:
+        update_stats(STAT_PASS);
+        return XDP_PASS;
+    }
+}
+
+static __always_inline void count_mirror(__u32 stat) {
+    __u64 *value = bpf_map_lookup_elem(&cerberus_mirror_stats, &stat);
+    if (value)
+        *value += 1;
+}
+
+// Tag a packet XDP passes for tc_mirror to copy. Invalidates the packet
+// pointers, so it runs last.
+static __always_inline void mirror_xdp(struct xdp_md *ctx, struct ct_ctx *ct, int verdict) {
+    if (!ct->mirror || verdict != XDP_PASS)
+        return;
+    if (bpf_xdp_adjust_meta(ctx, -(int)sizeof(struct mirror_meta)) < 0) {
+        count_mirror(MIRROR_FAILED);
+        return;
+    }
+    void *data = (void *)(long)ctx->data;
+    struct mirror_meta *meta = (void *)(long)ctx->data_meta;
+    if ((void *)(meta + 1) > data) {
+        count_mirror(MIRROR_FAILED);
+        return;
+    }
+    meta->magic = MIRROR_META_MAGIC;
+    meta->ifindex = ct->mirror;
+}
+
+// Copy a packet out of an interface. Invalidates the packet pointers, so
+// it runs last.
+static __always_inline void mirror_skb(struct __sk_buff *skb, __u32 ifindex) {
+    if (!ifindex)
+        return;
+    count_mirror(bpf_clone_redirect(skb, ifindex, 0) == 0 ? MIRROR_SENT : MIRROR_FAILED);
+}
+
+// Copy up to max bytes of a packet from its IP header, returning how many
+// were copied
+static __always_inline __u16 quote_packet(__u8 *buf, __u32 max, struct ct_ctx *ct,
+                                          void *data, void *data_end) {
+    void *l3 = data + (ct->l3 & 0x1ff);
+    __u16 len = 0;
+    for (__u32 i = 0; i < max; i++) {
+        __u8 *byte = l3 + i;
+        if ((void *)(byte + 1) > data_end)
+            break;
+        buf[i] = *byte;
+        len = i + 1;
+    }
+    return len;
+}
+
+// Hand a packet dropped by a reject rule to the control plane, which
+// answers the sender
+static __always_inline void reject_packet(struct ct_ctx *ct, void *data, void *data_end, int egress) {
+    if (!ct->reject)
+        return;
+    struct reject_event *event = bpf_ringbuf_reserve(&cerberus_rejects, sizeof(*event), 0);
+    if (!event)
+        return;
+    event->ifindex = ct->ifindex;
+    event->family = ct->key.family;
+    event->egress = egress;
+    event->len = quote_packet(event->packet, REJECT_QUOTE_MAX, ct, data, data_end);
+    bpf_ringbuf_submit(event, 0);
+}
+
+static __always_inline int mcast_group(const struct ct_key *key) {
+    const __u8 *dst = (const __u8 *)key->dst_addr;
+    if (key->family == 4)
+        return (dst[0] & 0xf0) == 0xe0;   // 224.0.0.0/4
+    return key->family == 6 && dst[0] == 0xff;   // ff00::/8
+}
+
+// Count a packet arriving for a multicast group
+static __always_inline void mcast_count(struct ct_ctx *ct, __u64 bytes, int verdict) {
+    if (!mcast_group(&ct->key))
+        return;
+    struct mcast_key key = { .family = ct->key.family };
+    __builtin_memcpy(key.addr, ct->key.dst_addr, sizeof(key.addr));
+
+    struct mcast_stats *stats = bpf_map_lookup_elem(&cerberus_mcast_stats, &key);
+    if (!stats) {
+        struct mcast_stats zero = {};
+        bpf_map_update_elem(&cerberus_mcast_stats, &key, &zero, BPF_NOEXIST);
+        stats = bpf_map_lookup_elem(&cerberus_mcast_stats, &key);
+        if (!stats)
+            return;
+    }
+    stats->packets++;
+    stats->bytes += bytes;
+    if (verdict == XDP_DROP || verdict == XDP_ABORTED)
+        stats->dropped++;
+}
+
+// Whether a packet is an IGMP or MLD membership report or leave. MLD
+// messages carry a hop-by-hop router alert option, the one extension
+// header walked here.
+static __always_inline int mcast_membership(struct ct_ctx *ct, void *data, void *data_end) {
+    void *l3 = data + (ct->l3 & 0x1ff);
+    __u8 *type;
+
+    if (ct->key.family == 4) {
+        struct iphdr *ip = l3;
+        if (ct->key.protocol != IPPROTO_IGMP || (void *)(ip + 1) > data_end)
+            return 0;
+        type = (void *)ip + ip->ihl * 4;
+        if ((void *)(type + 1) > data_end)
+            return 0;
+        return *type == IGMP_V1_REPORT || *type == IGMP_V2_REPORT ||
+               *type == IGMP_V2_LEAVE || *type == IGMP_V3_REPORT;
+    }
+
+    struct ipv6hdr *ip6 = l3;
+    if (ct->key.family != 6 || (void *)(ip6 + 1) > data_end)
+        return 0;
+    type = (void *)(ip6 + 1);
+    if (ct->key.protocol == IPPROTO_HOPOPTS) {
+        __u8 *hop = type;
+        if ((void *)(hop + 2) > data_end || hop[0] != IPPROTO_ICMPV6)
+            return 0;
+        type = (void *)hop + (hop[1] + 1) * 8;
+    } else if (ct->key.protocol != IPPROTO_ICMPV6) {
+        return 0;
+    }
+    if ((void *)(type + 1) > data_end)
+        return 0;
+    return *type == MLD_V1_REPORT || *type == MLD_V1_DONE || *type == MLD_V2_REPORT;
+}
+
+// Hand a membership report or leave the host passes to the control plane
+static __always_inline void mcast_report(struct ct_ctx *ct, void *data, void *data_end,
+                                         int verdict, int egress) {
+    if (verdict == XDP_DROP || verdict == XDP_ABORTED || !mcast_membership(ct, data, data_end))
+        return;
+    struct mcast_report *report = bpf_ringbuf_reserve(&cerberus_mcast_reports, sizeof(*report), 0);
+    if (!report)
+        return;
+    report->ifindex = ct->ifindex;
+    report->family = ct->key.family;
+    report->egress = egress;
+    report->len = quote_packet(report->packet, MCAST_QUOTE_MAX, ct, data, data_end);
+    bpf_ringbuf_submit(report, 0);
+}
+
+/*
+ * Fill the L4 part of the conntrack key: ports and TCP flags, or the echo
+ * identifier of ICMP echo messages. ICMP errors quote the IP header and
+ * first 8 bytes of the offending packet; *quoted is set to that header.
+ * Returns -1 on a truncated header.
+ */
+static __always_inline int parse_l4(struct ct_ctx *ct, void *l4, void *data_end,
+                                    __u8 echo_request, __u8 echo_reply,
+                                    void **quoted) {
+    if (ct->key.protocol == IPPROTO_TCP) {
+        struct tcphdr *tcp = l4;
+        if ((void *)(tcp + 1) > data_end)
+            return -1;
+        ct->key.src_port = bpf_ntohs(tcp->source);
+        ct->key.dst_port = bpf_ntohs(tcp->dest);
+        ct->tcp_flags = ((__u8 *)tcp)[13];
+        ct->trackable = 1;
+    } else if (ct->key.protocol == IPPROTO_UDP || ct->key.protocol == IPPROTO_SCTP) {
+        struct udphdr *udp = l4;  // The SCTP common header starts with the ports too
+        if ((void *)(udp + 1) > data_end)
+            return -1;
+        ct->key.src_port = bpf_ntohs(udp->source);
+        ct->key.dst_port = bpf_ntohs(udp->dest);
+        ct->trackable = 1;
+    } else if (ct->key.protocol == IPPROTO_ICMP || ct->key.protocol == IPPROTO_ICMPV6) {
+        struct icmphdr *icmp = l4;  // Same leading layout as struct icmp6hdr
+        if ((void *)(icmp + 1) > data_end)
+            return -1;
+        if (icmp->type == echo_request || icmp->type == echo_reply) {
+            ct->key.src_port = bpf_ntohs(icmp->un.echo.id);
+            ct->key.dst_port = ct->key.src_port;
+            ct->trackable = 1;
+        } else {
+            *quoted = icmp + 1;
+        }
+    }
+    return 0;
+}
+
+// Quoted L4 ports of an ICMP error; protocols without ports match as 0
+static __always_inline int parse_quoted_ports(struct ct_key *quoted, void *l4, void *data_end) {
+    if (quoted->protocol != IPPROTO_TCP && quoted->protocol != IPPROTO_UDP &&
+        quoted->protocol != IPPROTO_SCTP)
+        return 0;
+    struct udphdr *ports = l4;  // TCP, UDP and SCTP all start with the ports
+    if ((void *)(ports + 1) > data_end)
+        return -1;
+    quoted->src_port = bpf_ntohs(ports->source);
+    quoted->dst_port = bpf_ntohs(ports->dest);
+    return 0;
+}
+
+/*
+ * Tunnel termination (see ctrl/tunnels.go). GRE, IPIP and IPv6-in-IP
+ * packets between a configured pair of endpoints are counted per pair and,
+ * when the tunnel inspects inner headers, classified and filtered by the
+ * packet they carry instead of the outer headers. Encapsulated packets of
+ * other endpoints are filtered by their outer headers like any packet.
+ */
+#define MAX_TUNNELS 256
+
+// GRE header flags (RFC 2784, RFC 2890)
+#define GRE_CSUM    0x8000
+#define GRE_ROUTING 0x4000
+#define GRE_KEY     0x2000
+#define GRE_SEQ     0x1000
+#define GRE_VERSION 0x0007
+
+struct gre_base {
+    __be16 flags;
+    __be16 protocol;     // EtherType of the payload
+};
+
+// Mirrored by tunnelKey in ctrl/tunnels.go
+struct tunnel_key {
+    __u32 remote[4];     // IPv4 uses the first word, network byte order
+    __u32 local[4];
+    __u8  protocol;      // IPPROTO_GRE, IPPROTO_IPIP or IPPROTO_IPV6
+    __u8  family;        // Of the outer header, 4 or 6
+    __u8  pad[2];
+};
+
+// Mirrored by tunnelConfig in ctrl/tunnels.go
+struct tunnel_config {
+    __u32 id;            // Index in cerberus_tunnel_stats, from 1
+    __u8  inspect;       // Filter by the inner headers
+    __u8  pad[3];
+};
+
+// Mirrored by tunnelStats in ctrl/tunnels.go
+struct tunnel_stats {
+    __u64 packets;       // Both directions
+    __u64 bytes;
+    __u64 inspected;     // Classified by their inner headers
+    __u64 malformed;     // Inner packet truncated or not IP, filtered by the outer headers
+    __u64 dropped;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct tunnel_key));
+    __uint(value_size, sizeof(struct tunnel_config));
+    __uint(max_entries, MAX_TUNNELS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tunnels SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct tunnel_stats));
+    __uint(max_entries, MAX_TUNNELS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tunnel_stats SEC(".maps");
+
+// Tunnel of an encapsulated packet, arriving from the remote endpoint or
+// leaving towards it
+static __always_inline struct tunnel_config *tunnel_lookup(const struct ct_key *outer) {
+    struct tunnel_key key = { .protocol = outer->protocol, .family = outer->family };
+
+    __builtin_memcpy(key.remote, outer->src_addr, sizeof(key.remote));
+    __builtin_memcpy(key.local, outer->dst_addr, sizeof(key.local));
+    struct tunnel_config *config = bpf_map_lookup_elem(&cerberus_tunnels, &key);
+    if (config)
+        return config;
+
+    __builtin_memcpy(key.remote, outer->dst_addr, sizeof(key.remote));
+    __builtin_memcpy(key.local, outer->src_addr, sizeof(key.local));
+    return bpf_map_lookup_elem(&cerberus_tunnels, &key);
+}
+
+// Find the packet an encapsulation header carries. Returns its EtherType,
+// or 0 when it is not an IP packet, e.g. GRE carrying Ethernet frames.
+static __always_inline __u16 tunnel_payload(__u8 protocol, void *l4, void *data_end, void **inner) {
+    if (protocol == IPPROTO_IPIP) {
+        *inner = l4;
+        return ETH_P_IP;
+    }
+    if (protocol == IPPROTO_IPV6) {
+        *inner = l4;
+        return ETH_P_IPV6;
+    }
+
+    struct gre_base *gre = l4;
+    if ((void *)(gre + 1) > data_end)
+        return 0;
+    __u16 flags = bpf_ntohs(gre->flags);
+    if (flags & (GRE_ROUTING | GRE_VERSION))
+        return 0;
+    void *next = gre + 1;
+    if (flags & GRE_CSUM)
+        next += 4;       // Checksum and reserved
+    if (flags & GRE_KEY)
+        next += 4;
+    if (flags & GRE_SEQ)
+        next += 4;
+    *inner = next;
+
+    __u16 payload = bpf_ntohs(gre->protocol);
+    if (payload != ETH_P_IP && payload != ETH_P_IPV6)
+        return 0;
+    return payload;
+}
+
+// Replace the key of an encapsulated packet by the key of the packet it
+// carries. Returns 0, leaving *ct unchanged, when the inner packet cannot
+// be parsed; ICMP errors inside a tunnel are not matched to their flow.
+static __always_inline int parse_inner(struct ct_ctx *ct, void *l4, void *data, void *data_end) {
+    struct ct_ctx in = {};
+    void *inner = NULL, *quoted = NULL;
+
+    __u16 payload = tunnel_payload(ct->key.protocol, l4, data_end, &inner);
+    if (payload == ETH_P_IPV6) {
+        struct ipv6hdr *ip6 = inner;
+        if ((void *)(ip6 + 1) > data_end)
+            return 0;
+        __builtin_memcpy(in.key.src_addr, &ip6->saddr, sizeof(in.key.src_addr));
+        __builtin_memcpy(in.key.dst_addr, &ip6->daddr, sizeof(in.key.dst_addr));
+        in.key.protocol = ip6->nexthdr;
+        in.key.family = 6;
+        if (parse_l4(&in, ip6 + 1, data_end, ICMPV6_ECHO_REQUEST, ICMPV6_ECHO_REPLY, &quoted) < 0)
+            return 0;
+    } else if (payload == ETH_P_IP) {
+        struct iphdr *ip = inner;
+        if ((void *)(ip + 1) > data_end)
+            return 0;
+        in.key.src_addr[0] = ip->saddr;
+        in.key.dst_addr[0] = ip->daddr;
+        in.key.protocol = ip->protocol;
+        in.key.family = 4;
+        if (parse_l4(&in, (void *)ip + ip->ihl * 4, data_end, ICMP_ECHO, ICMP_ECHOREPLY, &quoted) < 0)
+            return 0;
+    } else {
+        return 0;
+    }
+
+    ct->key = in.key;
+    ct->tcp_flags = in.tcp_flags;
+    ct->trackable = in.trackable;
+    ct->l3 = inner - data;
+    return 1;
+}
+
+// Count an encapsulated packet against its tunnel and, if the tunnel
+// inspects inner headers, classify it by the packet it carries
+static __always_inline void parse_tunnel(struct ct_ctx *ct, void *l4, void *data, void *data_end) {
+    if (ct->key.protocol != IPPROTO_GRE && ct->key.protocol != IPPROTO_IPIP &&
+        ct->key.protocol != IPPROTO_IPV6)
+        return;
+    struct tunnel_config *config = tunnel_lookup(&ct->key);
+    if (!config)
+        return;
+    struct tunnel_stats *stats = bpf_map_lookup_elem(&cerberus_tunnel_stats, &config->id);
+    if (!stats)
+        return;
+
+    ct->tunnel = config->id;
+    stats->packets++;
+    stats->bytes += data_end - data;
+    if (!config->inspect)
+        return;
+    if (parse_inner(ct, l4, data, data_end))
+        stats->inspected++;
+    else
+        stats->malformed++;
+}
+
+// Count a dropped packet against the tunnel that carried it
+static __always_inline void tunnel_drop(struct ct_ctx *ct) {
+    if (!ct->tunnel)
+        return;
+    struct tunnel_stats *stats = bpf_map_lookup_elem(&cerberus_tunnel_stats, &ct->tunnel);
+    if (stats)
+        stats->dropped++;
+}
+
+/*
+ * Parse the Ethernet, IP and L4 headers of a packet into *ct. Up to
+ * VLAN_MAX_DEPTH 802.1Q or 802.1ad tags are stepped over; the outer tag's
+ * VLAN ID is kept, ct->vlan stays as set by the caller on untagged
+ * packets. The packet an ICMP error quotes goes to *quoted, whose family
+ * stays 0 otherwise.
+ * Returns 1 for IP packets, 0 for other ethertypes and -1 on a truncated
+ * header. IPv6 extension headers are not walked, so rules see the first
+ * next header value. Packets of tunnels inspecting inner headers get the
+ * key of the packet they carry.
+ */
+static __always_inline int parse_headers(struct ct_ctx *ct, struct ct_key *quoted_key,
+                                         void *data, void *data_end) {
+    struct ethhdr *eth = data;
+    void *quoted = NULL;
+
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+    __builtin_memcpy(ct->src_mac, eth->h_source, ETH_ALEN);
+    __builtin_memcpy(ct->dst_mac, eth->h_dest, ETH_ALEN);
+
+    __be16 proto = eth->h_proto;
+    void *l3 = eth + 1;
+    for (int i = 0; i < VLAN_MAX_DEPTH; i++) {
+        if (proto != bpf_htons(ETH_P_8021Q) && proto != bpf_htons(ETH_P_8021AD))
+            break;
+        struct vlan_hdr *tag = l3;
+        if ((void *)(tag + 1) > data_end)
+            return -1;
+        if (i == 0)
+            ct->vlan = bpf_ntohs(tag->tci) & VLAN_VID_MASK;
+        proto = tag->encapsulated_proto;
+        l3 = tag + 1;
+    }
+
+    if (proto == bpf_htons(ETH_P_IPV6)) {
+        struct ipv6hdr *ip6 = l3;
+        if ((void *)(ip6 + 1) > data_end)
+            return -1;
+
+        __builtin_memcpy(ct->key.src_addr, &ip6->saddr, sizeof(ct->key.src_addr));
+        __builtin_memcpy(ct->key.dst_addr, &ip6->daddr, sizeof(ct->key.dst_addr));
+        ct->key.protocol = ip6->nexthdr;
+        ct->key.family = 6;
+        ct->l3 = l3 - data;
+        if (parse_l4(ct, ip6 + 1, data_end, ICMPV6_ECHO_REQUEST, ICMPV6_ECHO_REPLY, &quoted) < 0)
+            return -1;
+        parse_tunnel(ct, ip6 + 1, data, data_end);
+
+        struct ipv6hdr *inner = quoted;
+        if (inner && (void *)(inner + 1) <= data_end) {
+            struct ct_key key = { .protocol = inner->nexthdr, .family = 6 };
+            __builtin_memcpy(key.src_addr, &inner->saddr, sizeof(key.src_addr));
+            __builtin_memcpy(key.dst_addr, &inner->daddr, sizeof(key.dst_addr));
+            if (parse_quoted_ports(&key, inner + 1, data_end) == 0)
+                __builtin_memcpy(quoted_key, &key, sizeof(key));
+        }
+        return 1;
+    }
+
+    if (proto != bpf_htons(ETH_P_IP))
+        return 0;
+
+    struct iphdr *ip = l3;
+    if ((void *)(ip + 1) > data_end)
+        return -1;
+
+    ct->key.src_addr[0] = ip->saddr;
+    ct->key.dst_addr[0] = ip->daddr;
+    ct->key.protocol = ip->protocol;
+    ct->key.family = 4;
+    ct->l3 = l3 - data;
+    if (parse_l4(ct, (void *)ip + ip->ihl * 4, data_end, ICMP_ECHO, ICMP_ECHOREPLY, &quoted) < 0)
+        return -1;
+    parse_tunnel(ct, (void *)ip + ip->ihl * 4, data, data_end);
+
+    struct iphdr *inner = quoted;
+    if (inner && (void *)(inner + 1) <= data_end) {
+        struct ct_key key = {
+            .src_addr = { inner->saddr },
+            .dst_addr = { inner->daddr },
+            .protocol = inner->protocol,
+            .family = 4,
+        };
+        if (parse_quoted_ports(&key, (void *)inner + inner->ihl * 4, data_end) == 0)
+            __builtin_memcpy(quoted_key, &key, sizeof(key));
+    }
+    return 1;
+}
+
+// VLAN ID of a tag the driver stripped into the skb, 0 = none; tags still
+// in the packet are read by parse_headers
+static __always_inline __u16 skb_vlan(struct __sk_buff *skb) {
+    return skb->vlan_present ? skb->vlan_tci & VLAN_VID_MASK : 0;
+}
+
+// Parse a packet and classify it against the flow table; returns as
+// parse_headers
+static __always_inline int parse_packet(struct ct_ctx *ct, void *data, void *data_end) {
+    struct ct_key quoted = {};
+
+    int parsed = parse_headers(ct, &quoted, data, data_end);
+    if (parsed > 0) {
+        ct_classify(ct);
+        if (quoted.family)
+            ct_related(ct, &quoted);
+    }
+    return parsed;
+}
+
+/*
+ * NAT64 (RFC 6146, see ctrl/nat64.go). IPv6-only clients reach IPv4
+ * servers through addresses of a /96 NAT64 prefix that embed the server's
+ * address in their last word, usually handed out by the DNS64 proxy. A
+ * packet to such an address that the rules pass is translated to IPv4 from
+ * the prefix's pool address and a port allocated for its session; replies
+ * to the pool are translated back before anything else sees them, so rules
+ * and conntrack only deal with the IPv6 side. TCP, UDP and ICMP echo are
+ * translated; fragments, IPv6 extension headers, IPv4 options and ICMP
+ * errors are not, nor are packets on VLANs, in tunnels or on the TC
+ * fallback.
+ */
+#define MAX_NAT64_PREFIXES 16
+#define MAX_NAT64_SESSIONS 65536
+#define NAT64_PORT_MIN   1024
+#define NAT64_PORT_RANGE (65536 - NAT64_PORT_MIN)
+#define NAT64_PORT_TRIES 4
+#define NAT64_HDR_DELTA  ((int)(sizeof(struct ipv6hdr) - sizeof(struct iphdr)))
+
+#define IPV4_DF        0x4000
+#define IPV4_MF_OFFSET 0x3fff   // More fragments flag and fragment offset
+
+// Mirrored by nat64PrefixKey in ctrl/nat64.go
+struct nat64_prefix_key {
+    __u32 prefixlen;
+    __u32 addr[4];
+};
+
+// Mirrored by nat64Prefix in ctrl/nat64.go
+struct nat64_prefix {
+    __u32 id;            // Index in cerberus_nat64_stats, from 1
+    __u32 pool;          // IPv4 source of translated packets, network byte order
+};
+
+// IPv6 side of a session, mirrored by nat64Key6 in ctrl/nat64.go
+struct nat64_key6 {
+    __u32 client[4];
+    __u32 server;        // IPv4 address embedded in the destination
+    __u16 client_port;   // Host byte order; ICMP echo identifier for ICMP
+    __u16 server_port;   // 0 for ICMP
+    __u8  protocol;      // IPPROTO_TCP, IPPROTO_UDP or IPPROTO_ICMP
+    __u8  pad[3];
+};
+
+// Mirrored by nat64Session in ctrl/nat64.go
+struct nat64_session {
+    __u64 last_seen;     // bpf_ktime_get_ns()
+    __u32 pool;
+    __u32 prefix_id;
+    __u16 pool_port;     // Host byte order; ICMP echo identifier for ICMP
+    __u8  pad[6];
+};
+
+// IPv4 side of a session, mirrored by nat64Key4 in ctrl/nat64.go
+struct nat64_key4 {
+    __u32 server;
+    __u32 pool;
+    __u16 server_port;
+    __u16 pool_port;
+    __u8  protocol;
+    __u8  pad[3];
+};
+
+// Mirrored by nat64Return in ctrl/nat64.go
+struct nat64_return {
+    struct nat64_key6 session;
+    __u32 prefix[3];     // Prefix the client used, to rebuild the source
+    __u32 prefix_id;
+};
+
+// Mirrored by nat64Stats in ctrl/nat64.go
+struct nat64_stats {
+    __u64 out;           // IPv6 packets translated to IPv4
+    __u64 in;            // IPv4 replies translated to IPv6
+    __u64 sessions;      // Sessions created
+    __u64 failed;        // Left untranslated: no free port, no headroom, no UDP checksum
+};
+
+enum nat64_stat {
+    NAT64_OUT = 0,
+    NAT64_IN = 1,
+    NAT64_SESSION = 2,
+    NAT64_FAILED = 3,
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct nat64_prefix_key));
+    __uint(value_size, sizeof(struct nat64_prefix));
+    __uint(max_entries, MAX_NAT64_PREFIXES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_nat64_prefixes SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct nat64_key6));
+    __uint(value_size, sizeof(struct nat64_session));
+    __uint(max_entries, MAX_NAT64_SESSIONS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_nat64_sessions SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct nat64_key4));
+    __uint(value_size, sizeof(struct nat64_return));
+    __uint(max_entries, MAX_NAT64_SESSIONS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_nat64_returns SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct nat64_stats));
+    __uint(max_entries, MAX_NAT64_PREFIXES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_nat64_stats SEC(".maps");
+
+static __always_inline void nat64_count(__u32 id, __u32 stat) {
+    struct nat64_stats *stats = bpf_map_lookup_elem(&cerberus_nat64_stats, &id);
+    if (!stats)
+        return;
+    if (stat == NAT64_OUT)
+        stats->out += 1;
+    else if (stat == NAT64_IN)
+        stats->in += 1;
+    else if (stat == NAT64_SESSION)
+        stats->sessions += 1;
+    else
+        stats->failed += 1;
+}
+
+static __always_inline __u16 csum_fold(__s64 sum) {
+    __u64 folded = (__u64)sum & 0xffffffff;
+    folded = (folded & 0xffff) + (folded >> 16);
+    folded = (folded & 0xffff) + (folded >> 16);
+    return ~folded;
+}
+
+// Checksum after the words in 'from' were replaced by those in 'to'; a
+// 16-bit field is passed as the low half of a word
+static __always_inline __u16 csum_replace(__u16 check, __be32 *from, __u32 from_size,
+                                          __be32 *to, __u32 to_size) {
+    return csum_fold(bpf_csum_diff(from, from_size, to, to_size, ~check & 0xffff));
+}
+
+// ICMP type and code as they sit in the header, for csum_replace
+static __always_inline __be32 icmp_type_word(__u8 type, __u8 code) {
+    return bpf_htons(type << 8 | code);
+}
+
+// Session of an outbound packet, created with a free pool port if new
+static __always_inline int nat64_session(struct nat64_key6 *key6, const __u32 *daddr,
+                                         __u32 id, __u32 pool, struct nat64_session *out) {
+    __u64 now = bpf_ktime_get_ns();
+    struct nat64_session *session = bpf_map_lookup_elem(&cerberus_nat64_sessions, key6);
+    if (session) {
+        session->last_seen = now;
+        *out = *session;
+        return 0;
+    }
+
+    struct nat64_return ret = { .session = *key6, .prefix_id = id };
+    __builtin_memcpy(ret.prefix, daddr, sizeof(ret.prefix));
+    struct nat64_key4 key4 = {
+        .server = key6->server,
+        .pool = pool,
+        .server_port = key6->server_port,
+        .protocol = key6->protocol,
+    };
+    __u32 seed = bpf_get_prandom_u32();
+    for (__u32 i = 0; i < NAT64_PORT_TRIES; i++) {
+        key4.pool_port = NAT64_PORT_MIN + (seed + i * 7919) % NAT64_PORT_RANGE;
+        if (bpf_map_update_elem(&cerberus_nat64_returns, &key4, &ret, BPF_NOEXIST))
+            continue;
+        struct nat64_session fresh = {
+            .last_seen = now,
+            .pool = pool,
+            .prefix_id = id,
+            .pool_port = key4.pool_port,
+        };
+        if (bpf_map_update_elem(&cerberus_nat64_sessions, key6, &fresh, BPF_ANY)) {
+            bpf_map_delete_elem(&cerberus_nat64_returns, &key4);
+            return -1;
+        }
+        nat64_count(id, NAT64_SESSION);
+        *out = fresh;
+        return 0;
+    }
+    return -1;
+}
+
+// Translate an IPv6 packet the rules passed to an address of a NAT64
+// prefix to IPv4. Invalidates the packet pointers, so it runs after
+// everything that reads the packet.
+static __always_inline void nat64_out(struct xdp_md *ctx, struct ct_ctx *ct, int verdict) {
+    if (verdict != XDP_PASS || ct->key.family != 6 || ct->tunnel || ct->l3 != sizeof(struct ethhdr))
+        return;
+    if (ct->key.protocol != IPPROTO_TCP && ct->key.protocol != IPPROTO_UDP &&
+        ct->key.protocol != IPPROTO_ICMPV6)
+        return;
+
+    struct nat64_prefix_key prefix_key = { .prefixlen = 128 };
+    __builtin_memcpy(prefix_key.addr, ct->key.dst_addr, sizeof(prefix_key.addr));
+    struct nat64_prefix *prefix = bpf_map_lookup_elem(&cerberus_nat64_prefixes, &prefix_key);
+    if (!prefix)
+        return;
+    __u32 id = prefix->id;
+    __u32 pool = prefix->pool;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    struct ipv6hdr *ip6 = (void *)(eth + 1);
+    if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != ct->key.protocol)
+        return;   // Extension headers
+    void *l4 = ip6 + 1;
+
+    struct nat64_key6 key6 = { .server = ct->key.dst_addr[3] };
+    __builtin_memcpy(key6.client, ct->key.src_addr, sizeof(key6.client));
+    __u32 port_off, check_off;
+    __u16 check;
+    __u8 code = 0;
+    if (ct->key.protocol == IPPROTO_ICMPV6) {
+        struct icmp6hdr *icmp6 = l4;
+        if ((void *)(icmp6 + 1) > data_end || icmp6->icmp6_type != ICMPV6_ECHO_REQUEST)
+            return;
+        key6.protocol = IPPROTO_ICMP;
+        key6.client_port = bpf_ntohs(icmp6->icmp6_identifier);
+        code = icmp6->icmp6_code;
+        check = icmp6->icmp6_cksum;
+        port_off = __builtin_offsetof(struct icmp6hdr, icmp6_identifier);
+        check_off = __builtin_offsetof(struct icmp6hdr, icmp6_cksum);
+    } else if (ct->key.protocol == IPPROTO_TCP) {
+        struct tcphdr *tcp = l4;
+        if ((void *)(tcp + 1) > data_end)
+            return;
+        key6.protocol = IPPROTO_TCP;
+        key6.client_port = bpf_ntohs(tcp->source);
+        key6.server_port = bpf_ntohs(tcp->dest);
+        check = tcp->check;
+        port_off = __builtin_offsetof(struct tcphdr, source);
+        check_off = __builtin_offsetof(struct tcphdr, check);
+    } else {
+        struct udphdr *udp = l4;
+        if ((void *)(udp + 1) > data_end)
+            return;
+        key6.protocol = IPPROTO_UDP;
+        key6.client_port = bpf_ntohs(udp->source);
+        key6.server_port = bpf_ntohs(udp->dest);
+        check = udp->check;
+        port_off = __builtin_offsetof(struct udphdr, source);
+        check_off = __builtin_offsetof(struct udphdr, check);
+    }
+
+    struct nat64_session session;
+    if (nat64_session(&key6, ct->key.dst_addr, id, pool, &session) < 0) {
+        nat64_count(id, NAT64_FAILED);
+        return;
+    }
+
+    // ICMPv6 covers a pseudo header and ICMP does not; TCP and UDP only
+    // swap addresses, the length and protocol sum the same in both
+    __be32 old_port = bpf_htons(key6.client_port);
+    __be32 new_port = bpf_htons(session.pool_port);
+    __u16 payload = bpf_ntohs(ip6->payload_len);
+    __be32 from[12] = {};
+    __be32 to[4] = {};
+    if (key6.protocol == IPPROTO_ICMP) {
+        __builtin_memcpy(from, ct->key.src_addr, 16);
+        __builtin_memcpy(from + 4, ct->key.dst_addr, 16);
+        from[8] = bpf_htonl(payload);
+        from[9] = bpf_htonl(IPPROTO_ICMPV6);
+        from[10] = icmp_type_word(ICMPV6_ECHO_REQUEST, code);
+        from[11] = old_port;
+        to[0] = icmp_type_word(ICMP_ECHO, code);
+        to[1] = new_port;
+        check = csum_replace(check, from, sizeof(from), to, 2 * sizeof(__be32));
+    } else {
+        __builtin_memcpy(from, ct->key.src_addr, 16);
+        __builtin_memcpy(from + 4, ct->key.dst_addr, 16);
+        from[8] = old_port;
+        to[0] = pool;
+        to[1] = key6.server;
+        to[2] = new_port;
+        check = csum_replace(check, from, 9 * sizeof(__be32), to, 3 * sizeof(__be32));
+        if (key6.protocol == IPPROTO_UDP && !check)
+            check = 0xffff;   // 0 means no checksum over IPv4
+    }
+
+    struct ethhdr mac;
+    __builtin_memcpy(&mac, eth, sizeof(mac));
+    mac.h_proto = bpf_htons(ETH_P_IP);
+    __u8 tos = ip6->priority << 4 | ip6->flow_lbl[0] >> 4;
+    __u8 ttl = ip6->hop_limit;
+
+    if (bpf_xdp_adjust_head(ctx, NAT64_HDR_DELTA)) {
+        nat64_count(id, NAT64_FAILED);
+        return;
+    }
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    struct iphdr *iph = (void *)(eth + 1);
+    if ((void *)(iph + 1) > data_end)
+        return;
+    __builtin_memcpy(eth, &mac, sizeof(mac));
+    iph->version = 4;
+    iph->ihl = 5;
+    iph->tos = tos;
+    iph->tot_len = bpf_htons(payload + sizeof(struct iphdr));
+    iph->id = 0;
+    iph->frag_off = bpf_htons(IPV4_DF);
+    iph->ttl = ttl;
+    iph->protocol = key6.protocol;
+    iph->check = 0;
+    iph->saddr = pool;
+    iph->daddr = key6.server;
+    iph->check = csum_fold(bpf_csum_diff(NULL, 0, (__be32 *)iph, sizeof(*iph), 0));
+
+    l4 = iph + 1;
+    __be16 *port_field = l4 + (port_off & 0x1f);
+    __u16 *check_field = l4 + (check_off & 0x1f);
+    if ((void *)(port_field + 1) > data_end || (void *)(check_field + 1) > data_end)
+        return;
+    *port_field = new_port;
+    *check_field = check;
+    if (key6.protocol == IPPROTO_ICMP)
+        ((struct icmphdr *)l4)->type = ICMP_ECHO;
+    nat64_count(id, NAT64_OUT);
+}
+
+// Translate a reply to a NAT64 session back to IPv6 before the packet is
+// filtered. Invalidates the packet pointers, so it runs first.
+static __always_inline void nat64_in(struct xdp_md *ctx) {
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    struct iphdr *iph = (void *)(eth + 1);
+    if ((void *)(iph + 1) > data_end || eth->h_proto != bpf_htons(ETH_P_IP))
+        return;
+    if (iph->ihl != 5 || (iph->frag_off & bpf_htons(IPV4_MF_OFFSET)))
+        return;
+    void *l4 = iph + 1;
+
+    struct nat64_key4 key4 = { .server = iph->saddr, .pool = iph->daddr, .protocol = iph->protocol };
+    __u32 port_off, check_off;
+    __u16 check;
+    __u8 code = 0;
+    if (iph->protocol == IPPROTO_ICMP) {
+        struct icmphdr *icmp = l4;
+        if ((void *)(icmp + 1) > data_end || icmp->type != ICMP_ECHOREPLY)
+            return;
+        key4.pool_port = bpf_ntohs(icmp->un.echo.id);
+        code = icmp->code;
+        check = icmp->checksum;
+        port_off = __builtin_offsetof(struct icmphdr, un.echo.id);
+        check_off = __builtin_offsetof(struct icmphdr, checksum);
+    } else if (iph->protocol == IPPROTO_TCP) {
+        struct tcphdr *tcp = l4;
+        if ((void *)(tcp + 1) > data_end)
+            return;
+        key4.server_port = bpf_ntohs(tcp->source);
+        key4.pool_port = bpf_ntohs(tcp->dest);
+        check = tcp->check;
+        port_off = __builtin_offsetof(struct tcphdr, dest);
+        check_off = __builtin_offsetof(struct tcphdr, check);
+    } else if (iph->protocol == IPPROTO_UDP) {
+        struct udphdr *udp = l4;
+        if ((void *)(udp + 1) > data_end)
+            return;
+        key4.server_port = bpf_ntohs(udp->source);
+        key4.pool_port = bpf_ntohs(udp->dest);
+        check = udp->check;
+        port_off = __builtin_offsetof(struct udphdr, dest);
+        check_off = __builtin_offsetof(struct udphdr, check);
+    } else {
+        return;
+    }
+
+    struct nat64_return *ret = bpf_map_lookup_elem(&cerberus_nat64_returns, &key4);
+    if (!ret)
+        return;
+    struct nat64_key6 key6 = ret->session;
+    __u32 id = ret->prefix_id;
+    __u32 src[4] = { ret->prefix[0], ret->prefix[1], ret->prefix[2], key4.server };
+    struct nat64_session *session = bpf_map_lookup_elem(&cerberus_nat64_sessions, &key6);
+    if (!session || (key4.protocol == IPPROTO_UDP && !check)) {
+        nat64_count(id, NAT64_FAILED);   // Evicted, or a UDP checksum IPv6 cannot do without
+        return;
+    }
+    session->last_seen = bpf_ktime_get_ns();
+
+    __u16 tot_len = bpf_ntohs(iph->tot_len);
+    if (tot_len <= sizeof(struct iphdr))
+        return;
+    __u16 payload = tot_len - sizeof(struct iphdr);
+    __be32 old_port = bpf_htons(key4.pool_port);
+    __be32 new_port = bpf_htons(key6.client_port);
+    __be32 from[3] = {};
+    __be32 to[12] = {};
+    __builtin_memcpy(to, src, 16);
+    __builtin_memcpy(to + 4, key6.client, 16);
+    if (key4.protocol == IPPROTO_ICMP) {
+        from[0] = icmp_type_word(ICMP_ECHOREPLY, code);
+        from[1] = old_port;
+        to[8] = bpf_htonl(payload);
+        to[9] = bpf_htonl(IPPROTO_ICMPV6);
+        to[10] = icmp_type_word(ICMPV6_ECHO_REPLY, code);
+        to[11] = new_port;
+        check = csum_replace(check, from, 2 * sizeof(__be32), to, sizeof(to));
+    } else {
+        from[0] = key4.server;
+        from[1] = key4.pool;
+        from[2] = old_port;
+        to[8] = new_port;
+        check = csum_replace(check, from, sizeof(from), to, 9 * sizeof(__be32));
+        if (key4.protocol == IPPROTO_UDP && !check)
+            check = 0xffff;
+    }
+
+    struct ethhdr mac;
+    __builtin_memcpy(&mac, eth, sizeof(mac));
+    mac.h_proto = bpf_htons(ETH_P_IPV6);
+    __u8 tos = iph->tos;
+    __u8 ttl = iph->ttl;
+
+    if (bpf_xdp_adjust_head(ctx, -NAT64_HDR_DELTA)) {
+        nat64_count(id, NAT64_FAILED);
+        return;
+    }
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    struct ipv6hdr *ip6 = (void *)(eth + 1);
+    if ((void *)(ip6 + 1) > data_end)
+        return;
+    __builtin_memcpy(eth, &mac, sizeof(mac));
+    ip6->version = 6;
+    ip6->priority = tos >> 4;
+    ip6->flow_lbl[0] = (tos & 0xf) << 4;
+    ip6->flow_lbl[1] = 0;
+    ip6->flow_lbl[2] = 0;
+    ip6->payload_len = bpf_htons(payload);
+    ip6->nexthdr = key4.protocol == IPPROTO_ICMP ? IPPROTO_ICMPV6 : key4.protocol;
+    ip6->hop_limit = ttl;
+    __builtin_memcpy(&ip6->saddr, src, sizeof(src));
+    __builtin_memcpy(&ip6->daddr, key6.client, sizeof(key6.client));
+
+    l4 = ip6 + 1;
+    __be16 *port_field = l4 + (port_off & 0x1f);
+    __u16 *check_field = l4 + (check_off & 0x1f);
+    if ((void *)(port_field + 1) > data_end || (void *)(check_field + 1) > data_end)
+        return;
+    *port_field = new_port;
+    *check_field = check;
+    if (key4.protocol == IPPROTO_ICMP)
+        ((struct icmp6hdr *)l4)->icmp6_type = ICMPV6_ECHO_REPLY;
+    nat64_count(id, NAT64_IN);
+}
+
+/*
+ * Packet normalization (see ctrl/normalize.go). Inbound IP packets are
+ * checked right after parsing, before DNAT and rules, by each check the
+ * control plane turned on: sources in the bogon tries, IPv4 header and
+ * TCP, UDP or ICMP checksums, and TCP flag combinations no stack sends.
+ * Dropped packets are counted by reason and their sources recorded in
+ * cerberus_norm_sources, which the control plane reports and empties.
+ * L4 checksums of fragments, of packets behind IPv6 extension headers and
+ * of segments over NORM_CSUM_MAX bytes are not verified.
+ */
+#define MAX_BOGONS         512
+#define MAX_NORM_SOURCES   4096
+#define NORM_CSUM_MAX      1536  // Bytes of L4 segment verified, in 64-byte chunks
+#define NORM_CSUM_CHUNK    64
+
+#define TCPHDR_PSH 0x08
+#define TCPHDR_URG 0x20
+
+enum norm_checks {
+    NORM_CHECK_BOGONS = 1 << 0,
+    NORM_CHECK_CHECKSUMS = 1 << 1,
+    NORM_CHECK_TCP_FLAGS = 1 << 2,
+};
+
+// Why a packet was dropped, 0 = it was not
+enum norm_reason {
+    NORM_BOGON = 1,
+    NORM_IP_CHECKSUM = 2,
+    NORM_L4_CHECKSUM = 3,
+    NORM_TCP_NULL = 4,
+    NORM_TCP_XMAS = 5,
+    NORM_TCP_FLAGS = 6,   // Other illegal combinations
+    NORM_REASONS = 7,
+};
+
+// Mirrored by normConfig in ctrl/normalize.go
+struct norm_config {
+    __u32 checks;        // enum norm_checks, 0 = off
+};
+
+// Mirrored by normStats in ctrl/normalize.go
+struct norm_stats {
+    __u64 dropped[NORM_REASONS];  // By enum norm_reason, 0 unused
+};
+
+// Mirrored by normSourceKey in ctrl/normalize.go
+struct norm_source_key {
+    __u32 addr[4];       // IPv4 uses the first word
+    __u8  family;
+    __u8  reason;        // enum norm_reason
+    __u8  pad[2];
+};
+
+// Mirrored by normSource in ctrl/normalize.go
+struct norm_source {
+    __u64 packets;
+    __u64 last_seen;     // bpf_ktime_get_ns()
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct norm_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_norm_config SEC(".maps");
+
+// Value 1 = bogon, 0 = exempt, the longest prefix decides
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key4));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, MAX_BOGONS);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_bogons4 SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct lpm_key6));
+    __uint(value_size, sizeof(__u8));
+    __uint(max_entries, MAX_BOGONS);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_bogons6 SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct norm_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_norm_stats SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct norm_source_key));
+    __uint(value_size, sizeof(struct norm_source));
+    __uint(max_entries, MAX_NORM_SOURCES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_norm_sources SEC(".maps");
+
+// Fold a sum of 16-bit words and 32-bit partial sums to its checksum
+static __always_inline __u16 csum_fold64(__u64 sum) {
+    sum = (sum & 0xffffffff) + (sum >> 32);
+    sum = (sum & 0xffffffff) + (sum >> 32);
+    return csum_fold(sum);
+}
+
+static __always_inline int norm_bogon(struct ct_ctx *ct) {
+    __u8 *bogon;
+    if (ct->key.family == 4) {
+        struct lpm_key4 key = { .prefixlen = 32, .addr = ct->key.src_addr[0] };
+        bogon = bpf_map_lookup_elem(&cerberus_bogons4, &key);
+    } else {
+        struct lpm_key6 key = { .prefixlen = 128 };
+        __builtin_memcpy(key.addr, ct->key.src_addr, sizeof(key.addr));
+        bogon = bpf_map_lookup_elem(&cerberus_bogons6, &key);
+    }
+    return bogon && *bogon;
+}
+
+static __always_inline __u8 norm_tcp_flags(__u8 flags) {
+    flags &= TCPHDR_FIN | TCPHDR_SYN | TCPHDR_RST | TCPHDR_PSH | TCPHDR_ACK | TCPHDR_URG;
+    if (!flags)
+        return NORM_TCP_NULL;
+    if ((flags & (TCPHDR_FIN | TCPHDR_PSH | TCPHDR_URG)) == (TCPHDR_FIN | TCPHDR_PSH | TCPHDR_URG))
+        return NORM_TCP_XMAS;
+    if ((flags & TCPHDR_SYN) && (flags & (TCPHDR_FIN | TCPHDR_RST)))
+        return NORM_TCP_FLAGS;
+    if (!(flags & TCPHDR_ACK) && (flags & (TCPHDR_FIN | TCPHDR_PSH | TCPHDR_URG)))
+        return NORM_TCP_FLAGS;
+    return 0;
+}
+
+// Sum of the bytes from p to end as 16-bit words, an odd last byte
+// padded with zero
+static __always_inline __u64 csum_bytes(void *p, void *end, void *data_end) {
+    __u64 sum = 0;
+    for (int i = 0; i < NORM_CSUM_MAX / NORM_CSUM_CHUNK; i++) {
+        if (p + NORM_CSUM_CHUNK > end || p + NORM_CSUM_CHUNK > data_end)
+            break;
+        sum += (__u32)bpf_csum_diff(NULL, 0, p, NORM_CSUM_CHUNK, 0);
+        p += NORM_CSUM_CHUNK;
+    }
+    for (int i = 0; i < NORM_CSUM_CHUNK / 2; i++) {
+        if (p + 2 > end || p + 2 > data_end)
+            break;
+        sum += *(__u16 *)p;
+        p += 2;
+    }
+    if (p + 1 <= end && p + 1 <= data_end) {
+        __u16 last = 0;
+        *(__u8 *)&last = *(__u8 *)p;
+        sum += last;
+    }
+    return sum;
+}
+
+// Verify the IPv4 header checksum and the TCP, UDP or ICMP checksum of an
+// unfragmented packet
+static __always_inline __u8 norm_checksums(struct ct_ctx *ct, void *data, void *data_end) {
+    void *ip = data + (ct->l3 & 0x1ff);
+    __u8 protocol = ct->key.protocol;
+    __be32 pseudo[10] = {};
+    __u32 pseudo_size;
+    void *l4;
+    __u32 len;
+
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end)
+            return 0;
+        __u32 hlen = (ip4->ihl * 4) & 0x3c;
+        if (hlen < sizeof(*ip4) || ip + hlen > data_end)
+            return 0;
+        if (csum_fold64(csum_bytes(ip, ip + hlen, data_end)))
+            return NORM_IP_CHECKSUM;
+        __u16 tot_len = bpf_ntohs(ip4->tot_len);
+        if ((ip4->frag_off & bpf_htons(IPV4_MF_OFFSET)) || tot_len < hlen)
+            return 0;
+        l4 = ip + hlen;
+        len = tot_len - hlen;
+        pseudo[0] = ip4->saddr;
+        pseudo[1] = ip4->daddr;
+        pseudo[2] = bpf_htonl(len);
+        pseudo[3] = bpf_htonl(protocol);
+        pseudo_size = protocol == IPPROTO_ICMP ? 0 : 4 * sizeof(__be32);
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != protocol)
+            return 0;
+        l4 = ip6 + 1;
+        len = bpf_ntohs(ip6->payload_len);
+        __builtin_memcpy(pseudo, &ip6->saddr, 16);
+        __builtin_memcpy(pseudo + 4, &ip6->daddr, 16);
+        pseudo[8] = bpf_htonl(len);
+        pseudo[9] = bpf_htonl(protocol);
+        pseudo_size = sizeof(pseudo);
+    }
+
+    if (protocol != IPPROTO_TCP && protocol != IPPROTO_UDP &&
+        protocol != IPPROTO_ICMP && protocol != IPPROTO_ICMPV6)
+        return 0;
+    if (len > NORM_CSUM_MAX || l4 + len > data_end)
+        return 0;   // Not verified, or not all in the linear data
+    if (protocol == IPPROTO_UDP) {
+        struct udphdr *udp = l4;
+        if ((void *)(udp + 1) > data_end)
+            return 0;
+        if (!udp->check)
+            return ct->key.family == 6 ? NORM_L4_CHECKSUM : 0;   // Optional over IPv4 only
+    }
+
+    __u64 sum = csum_bytes(l4, l4 + len, data_end);
+    if (pseudo_size == sizeof(pseudo))
+        sum += (__u32)bpf_csum_diff(NULL, 0, pseudo, sizeof(pseudo), 0);
+    else if (pseudo_size)
+        sum += (__u32)bpf_csum_diff(NULL, 0, pseudo, 4 * sizeof(__be32), 0);
+    return csum_fold64(sum) ? NORM_L4_CHECKSUM : 0;
+}
+
+static __always_inline void norm_report(struct ct_ctx *ct, __u8 reason) {
+    __u32 key = 0;
+    struct norm_stats *stats = bpf_map_lookup_elem(&cerberus_norm_stats, &key);
+    if (stats && reason < NORM_REASONS)
+        stats->dropped[reason] += 1;
+
+    struct norm_source_key source_key = { .family = ct->key.family, .reason = reason };
+    __builtin_memcpy(source_key.addr, ct->key.src_addr, sizeof(source_key.addr));
+    __u64 now = bpf_ktime_get_ns();
+    struct norm_source *source = bpf_map_lookup_elem(&cerberus_norm_sources, &source_key);
+    if (source) {
+        __sync_fetch_and_add(&source->packets, 1);
+        source->last_seen = now;
+        return;
+    }
+    struct norm_source fresh = { .packets = 1, .last_seen = now };
+    bpf_map_update_elem(&cerberus_norm_sources, &source_key, &fresh, BPF_NOEXIST);
+}
+
+// Check a parsed inbound packet; returns the enum norm_reason to drop it
+// for, 0 to keep it
+static __always_inline __u8 normalize(struct ct_ctx *ct, void *data, void *data_end) {
+    __u32 key = 0;
+    struct norm_config *config = bpf_map_lookup_elem(&cerberus_norm_config, &key);
+    if (!config || !config->checks)
+        return 0;
+    __u32 checks = config->checks;
+
+    __u8 reason = 0;
+    if ((checks & NORM_CHECK_BOGONS) && norm_bogon(ct))
+        reason = NORM_BOGON;
+    if (!reason && (checks & NORM_CHECK_CHECKSUMS))
+        reason = norm_checksums(ct, data, data_end);
+    if (!reason && (checks & NORM_CHECK_TCP_FLAGS) && ct->key.protocol == IPPROTO_TCP) {
+        // Fragments after the first carry no TCP header to read flags from
+        struct iphdr *ip4 = data + (ct->l3 & 0x1ff);
+        if (ct->key.family == 6 || ((void *)(ip4 + 1) <= data_end &&
+                                    !(ip4->frag_off & bpf_htons(IPV4_MF_OFFSET))))
+            reason = norm_tcp_flags(ct->tcp_flags);
+    }
+    if (reason)
+        norm_report(ct, reason);
+    return reason;
+}
+
+/*
+ * NAT rules (see ctrl/nat.go). A DNAT rule forwards TCP or UDP packets to
+ * a public address and port to an internal address and port. Inbound
+ * packets are rewritten right after parsing, so rules, conntrack and
+ * everything after match the internal side; the flow created for them
+ * keeps the public side, and replies the rules pass have their source
+ * rewritten back to it. Addresses, ports and checksums are rewritten in
+ * place. Fragments, IPv6 extension headers, tunneled packets and ICMP
+ * errors quoting a translated flow are left alone.
+ */
+#define MAX_NAT_RULES 1024
+
+// Mirrored by dnatKey in ctrl/nat.go
+struct dnat_key {
+    __u32 addr[4];       // Public address, IPv4 uses the first word
+    __u16 port;          // Host byte order
+    __u8  protocol;      // IPPROTO_TCP or IPPROTO_UDP
+    __u8  family;        // 4 or 6
+};
+
+// Mirrored by dnatTarget in ctrl/nat.go
+struct dnat_target {
+    __u32 addr[4];       // Internal address
+    __u16 port;          // Host byte order
+    __u8  pad[2];
+    __u32 id;            // Index in cerberus_nat_stats, from 1
+};
+
+// Mirrored by natStats in ctrl/nat.go
+struct nat_stats {
+    __u64 translated;    // Packets rewritten to the internal side
+    __u64 replies;       // Replies rewritten to the public side
+    __u64 failed;        // Left untranslated: fragment, extension headers, no free port
+    __u64 sessions;      // SNAT sessions created
+};
+
+enum nat_stat {
+    NAT_TRANSLATED = 0,
+    NAT_REPLY = 1,
+    NAT_FAILED = 2,
+    NAT_SESSION = 3,
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct dnat_key));
+    __uint(value_size, sizeof(struct dnat_target));
+    __uint(max_entries, MAX_NAT_RULES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dnat SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct nat_stats));
+    __uint(max_entries, MAX_NAT_RULES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_nat_stats SEC(".maps");
+
+static __always_inline void nat_count(__u32 id, __u32 stat) {
+    struct nat_stats *stats = bpf_map_lookup_elem(&cerberus_nat_stats, &id);
+    if (!stats)
+        return;
+    if (stat == NAT_TRANSLATED)
+        stats->translated += 1;
+    else if (stat == NAT_REPLY)
+        stats->replies += 1;
+    else if (stat == NAT_SESSION)
+        stats->sessions += 1;
+    else
+        stats->failed += 1;
+}
+
+// Rewrite the source or destination address and port of a parsed TCP or
+// UDP packet, adjusting the IPv4 header and L4 checksums
+static __always_inline int nat_rewrite(struct ct_ctx *ct, void *data, void *data_end,
+                                       int source, const __u32 *addr, __u16 port) {
+    void *ip = data + (ct->l3 & 0x1ff);
+    void *l4;
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end || ip4->frag_off & bpf_htons(IPV4_MF_OFFSET))
+            return -1;
+        l4 = ip + ((ip4->ihl * 4) & 0x3c);
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != ct->key.protocol)
+            return -1;
+        l4 = ip6 + 1;
+    }
+
+    __be16 *ports;
+    __u16 *check;
+    if (ct->key.protocol == IPPROTO_TCP) {
+        struct tcphdr *tcp = l4;
+        if ((void *)(tcp + 1) > data_end)
+            return -1;
+        ports = &tcp->source;
+        check = &tcp->check;
+    } else {
+        struct udphdr *udp = l4;
+        if ((void *)(udp + 1) > data_end)
+            return -1;
+        ports = &udp->source;
+        check = &udp->check;
+    }
+    __be16 *port_field = source ? ports : ports + 1;
+
+    // The address is part of the pseudo header; the unused words of an
+    // IPv4 address are zero on both sides
+    __be32 from[5] = {};
+    __be32 to[5] = {};
+    __builtin_memcpy(from, source ? ct->key.src_addr : ct->key.dst_addr, 16);
+    __builtin_memcpy(to, addr, 16);
+    from[4] = *port_field;
+    to[4] = bpf_htons(port);
+    if (ct->key.family == 6 || ct->key.protocol == IPPROTO_TCP || *check) {
+        __u16 sum = csum_replace(*check, from, sizeof(from), to, sizeof(to));
+        if (ct->key.protocol == IPPROTO_UDP && !sum)
+            sum = 0xffff;   // 0 means no checksum
+        *check = sum;
+    }
+    *port_field = to[4];
+
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        ip4->check = csum_replace(ip4->check, from, sizeof(__be32), to, sizeof(__be32));
+        if (source)
+            ip4->saddr = to[0];
+        else
+            ip4->daddr = to[0];
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        if (source)
+            __builtin_memcpy(&ip6->saddr, to, 16);
+        else
+            __builtin_memcpy(&ip6->daddr, to, 16);
+    }
+    return 0;
+}
+
+static __always_inline struct dnat_target *dnat_lookup(struct ct_key *key, int source) {
+    struct dnat_key dkey = {
+        .port = source ? key->src_port : key->dst_port,
+        .protocol = key->protocol,
+        .family = key->family,
+    };
+    if (key->protocol != IPPROTO_TCP && key->protocol != IPPROTO_UDP)
+        return NULL;
+    __builtin_memcpy(dkey.addr, source ? key->src_addr : key->dst_addr, sizeof(dkey.addr));
+    return bpf_map_lookup_elem(&cerberus_dnat, &dkey);
+}
+
+// Rewrite a packet to the public side of a DNAT rule to its internal side,
+// keeping the public side in *ct for the flow it creates
+static __always_inline void dnat_in(struct ct_ctx *ct, void *data, void *data_end) {
+    if (ct->tunnel)
+        return;
+    struct dnat_target *target = dnat_lookup(&ct->key, 0);
+    if (!target)
+        return;
+    __u32 id = target->id;
+    __u32 addr[4];
+    __builtin_memcpy(addr, target->addr, sizeof(addr));
+    __u16 port = target->port;
+
+    if (nat_rewrite(ct, data, data_end, 0, addr, port) < 0) {
+        nat_count(id, NAT_FAILED);
+        return;
+    }
+    ct->nat_id = id;
+    ct->nat_port = ct->key.dst_port;
+    __builtin_memcpy(ct->nat_addr, ct->key.dst_addr, sizeof(ct->nat_addr));
+    __builtin_memcpy(ct->key.dst_addr, addr, sizeof(ct->key.dst_addr));
+    ct->key.dst_port = port;
+    nat_count(id, NAT_TRANSLATED);
+}
+
+// Rewrite the source of a reply on a translated flow back to the public
+// side. Runs once the packet is passed, after everything that reads it.
+static __always_inline void dnat_reply(struct ct_ctx *ct, void *data, void *data_end) {
+    struct ct_entry *entry = ct->entry;
+    if (!entry || !ct->reply || !entry->nat_id)
+        return;
+    __u32 id = entry->nat_id;
+    __u32 addr[4];
+    __builtin_memcpy(addr, entry->nat_addr, sizeof(addr));
+    __u16 port = entry->nat_port;
+
+    if (nat_rewrite(ct, data, data_end, 1, addr, port) < 0) {
+        nat_count(id, NAT_FAILED);
+        return;
+    }
+    nat_count(id, NAT_REPLY);
+}
+
+/*
+ * Source NAT (see ctrl/nat.go). An SNAT or masquerade rule translates the
+ * source of TCP and UDP packets from an internal prefix leaving by an
+ * interface to a public address and a port allocated from the rule's
+ * range, so that the hosts behind it share the address. A session is
+ * created in tc_egress for the first packet of a flow the rules passed,
+ * after the flow was recorded with its internal side; replies to the
+ * allocated port are rewritten back to the internal side right after
+ * parsing, like DNAT, so rules and conntrack see the flow as it left. The
+ * control plane closes idle sessions and follows the address of
+ * masquerading interfaces. Packets XDP redirects never reach tc_egress
+ * and are not translated, nor are DNAT flows, fragments and ICMP.
+ */
+#define MAX_SNAT_SESSIONS 65536
+#define SNAT_PORT_TRIES   8
+
+// Mirrored by snatKey in ctrl/nat.go. prefixlen counts the ifindex and
+// family words, 64 bits, ahead of the source prefix.
+struct snat_key {
+    __u32 prefixlen;
+    __u32 ifindex;       // Interface the packets leave by
+    __u32 family;        // 4 or 6
+    __u32 addr[4];       // Internal prefix, IPv4 uses the first word
+};
+
+// Mirrored by snatRuleValue in ctrl/nat.go
+struct snat_rule {
+    __u32 addr[4];       // Public address
+    __u16 port_min;      // Public port range, host byte order
+    __u16 port_max;
+    __u32 id;            // Index in cerberus_nat_stats, from 1
+    __u8  protocol;      // IPPROTO_TCP or IPPROTO_UDP, 0 = both
+    __u8  pad[3];
+};
+
+// Mirrored by snatSession in ctrl/nat.go, keyed by the internal side of
+// the flow as it leaves
+struct snat_session {
+    __u64 last_seen;     // bpf_ktime_get_ns()
+    __u32 addr[4];       // Public address
+    __u16 port;          // Allocated public port, host byte order
+    __u8  pad[2];
+    __u32 id;
+};
+
+// Mirrored by snatReturn in ctrl/nat.go, keyed by the replies as they
+// arrive: from the remote side to the public address and port
+struct snat_return {
+    struct ct_key session;
+    __u32 id;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __uint(key_size, sizeof(struct snat_key));
+    __uint(value_size, sizeof(struct snat_rule));
+    __uint(max_entries, MAX_NAT_RULES);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_snat SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct ct_key));
+    __uint(value_size, sizeof(struct snat_session));
+    __uint(max_entries, MAX_SNAT_SESSIONS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_snat_sessions SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct ct_key));
+    __uint(value_size, sizeof(struct snat_return));
+    __uint(max_entries, MAX_SNAT_SESSIONS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_snat_returns SEC(".maps");
+
+// Create the session of a packet leaving by an interface with an SNAT
+// rule covering its source, with a free public port; the source port is
+// kept when the range allows it
+static __always_inline int snat_session(struct ct_ctx *ct, __u64 now, struct snat_session *out) {
+    struct snat_key rule_key = {
+        .prefixlen = 64 + (ct->key.family == 4 ? 32 : 128),
+        .ifindex = ct->ifindex,
+        .family = ct->key.family,
+    };
+    __builtin_memcpy(rule_key.addr, ct->key.src_addr, sizeof(rule_key.addr));
+    struct snat_rule *rule = bpf_map_lookup_elem(&cerberus_snat, &rule_key);
+    if (!rule || (rule->protocol && rule->protocol != ct->key.protocol))
+        return -1;
+    // The public address itself sends as it is
+    if (rule->addr[0] == ct->key.src_addr[0] && rule->addr[1] == ct->key.src_addr[1] &&
+        rule->addr[2] == ct->key.src_addr[2] && rule->addr[3] == ct->key.src_addr[3])
+        return -1;
+
+    struct snat_return ret = { .session = ct->key, .id = rule->id };
+    struct ct_key return_key = {
+        .src_port = ct->key.dst_port,
+        .protocol = ct->key.protocol,
+        .family = ct->key.family,
+    };
+    __builtin_memcpy(return_key.src_addr, ct->key.dst_addr, sizeof(return_key.src_addr));
+    __builtin_memcpy(return_key.dst_addr, rule->addr, sizeof(return_key.dst_addr));
+    __u32 range = (__u32)rule->port_max - rule->port_min + 1;
+    __u32 seed = bpf_get_prandom_u32();
+    for (__u32 i = 0; i < SNAT_PORT_TRIES; i++) {
+        if (i == 0 && ct->key.src_port >= rule->port_min && ct->key.src_port <= rule->port_max)
+            return_key.dst_port = ct->key.src_port;
+        else
+            return_key.dst_port = rule->port_min + (seed + i * 7919) % range;
+        if (bpf_map_update_elem(&cerberus_snat_returns, &return_key, &ret, BPF_NOEXIST))
+            continue;
+        struct snat_session fresh = {
+            .last_seen = now,
+            .port = return_key.dst_port,
+            .id = rule->id,
+        };
+        __builtin_memcpy(fresh.addr, rule->addr, sizeof(fresh.addr));
+        if (bpf_map_update_elem(&cerberus_snat_sessions, &ct->key, &fresh, BPF_ANY)) {
+            bpf_map_delete_elem(&cerberus_snat_returns, &return_key);
+            break;
+        }
+        nat_count(rule->id, NAT_SESSION);
+        *out = fresh;
+        return 0;
+    }
+    nat_count(rule->id, NAT_FAILED);
+    return -1;
+}
+
+// Rewrite the source of a packet tc_egress passed to the public side of
+// its SNAT session, creating one for the first packet of a flow. Runs
+// after everything that reads the packet.
+static __always_inline void snat_out(struct ct_ctx *ct, void *data, void *data_end) {
+    if (ct->tunnel || (ct->key.protocol != IPPROTO_TCP && ct->key.protocol != IPPROTO_UDP))
+        return;
+    // Replies of DNAT flows already carry the public side
+    if (ct->entry && ct->entry->nat_id)
+        return;
+
+    __u64 now = bpf_ktime_get_ns();
+    struct snat_session session = {};
+    struct snat_session *found = bpf_map_lookup_elem(&cerberus_snat_sessions, &ct->key);
+    if (found) {
+        found->last_seen = now;
+        session = *found;
+    } else if (ct->reply || snat_session(ct, now, &session) < 0) {
+        return;
+    }
+
+    if (nat_rewrite(ct, data, data_end, 1, session.addr, session.port) < 0) {
+        nat_count(session.id, NAT_FAILED);
+        return;
+    }
+    nat_count(session.id, NAT_TRANSLATED);
+}
+
+// Rewrite a reply to a port an SNAT session allocated back to the internal
+// side, keeping *ct in step; returns 1 if the packet belongs to a session
+static __always_inline int snat_in(struct ct_ctx *ct, void *data, void *data_end) {
+    if (ct->tunnel || (ct->key.protocol != IPPROTO_TCP && ct->key.protocol != IPPROTO_UDP))
+        return 0;
+    struct snat_return *ret = bpf_map_lookup_elem(&cerberus_snat_returns, &ct->key);
+    if (!ret)
+        return 0;
+    struct ct_key internal = ret->session;
+    __u32 id = ret->id;
+    struct snat_session *session = bpf_map_lookup_elem(&cerberus_snat_sessions, &internal);
+    if (!session) {
+        // Evicted on the way out, the port is free again
+        bpf_map_delete_elem(&cerberus_snat_returns, &ct->key);
+        return 0;
+    }
+    session->last_seen = bpf_ktime_get_ns();
+
+    if (nat_rewrite(ct, data, data_end, 0, internal.src_addr, internal.src_port) < 0) {
+        nat_count(id, NAT_FAILED);
+        return 1;
+    }
+    __builtin_memcpy(ct->key.dst_addr, internal.src_addr, sizeof(ct->key.dst_addr));
+    ct->key.dst_port = internal.src_port;
+    nat_count(id, NAT_REPLY);
+    return 1;
+}
+
+// Parse an inbound packet, normalize it, translate it if it replies to an
+// SNAT session or a DNAT rule forwards its destination and classify it
+// against the flow table; returns as parse_headers. A packet normalization
+// drops is left in ct->norm, untranslated and unclassified.
+static __always_inline int parse_ingress(struct ct_ctx *ct, void *data, void *data_end) {
+    struct ct_key quoted = {};
+
+    int parsed = parse_headers(ct, &quoted, data, data_end);
+    if (parsed > 0)
+        ct->norm = normalize(ct, data, data_end);
+    if (parsed > 0 && !ct->norm) {
+        if (!snat_in(ct, data, data_end))
+            dnat_in(ct, data, data_end);
+        ct_classify(ct);
+        if (quoted.family)
+            ct_related(ct, &quoted);
+    }
+    return parsed;
+}
+
+/*
+ * Service protection profiles (see ctrl/protection.go), keyed by the
+ * destination they protect; port 0 protects every port of the address.
+ * Inbound packets starting a new flow that no rule drops are checked
+ * against the profile of their destination. The control plane recounts
+ * the tracked flows of each destination and removes idle and slow ones.
+ */
+#define MAX_PROTECTED 4096
+#define NSEC_PER_SEC 1000000000ULL
+
+// Mirrored by protectionKey in ctrl/protection.go
+struct protect_key {
+    __u32 addr[4];       // IPv4 uses the first word, network byte order
+    __u16 port;          // Host byte order, 0 = every port
+    __u8  family;        // 4 or 6
+    __u8  pad;
+};
+
+// Mirrored by protectionConfig in ctrl/protection.go. Zero limits are off.
+struct protect_config {
+    __u32 max_conns;     // Tracked flows to the destination
+    __u32 conns;         // Recounted by the control plane, counted up here in between
+    __u32 syn_rate;      // TCP SYNs per second
+    __u32 syn_burst;     // Bucket size in SYNs, at least 1 when syn_rate is set
+    __u32 country_set;   // IP set of the allowed sources, 0 = any
+    __u8  drop_stray;    // Drop TCP packets of no tracked flow
+    __u8  pad[3];
+};
+
+// Mirrored by protectionState in ctrl/protection.go. Updated without
+// locking: concurrent SYNs on other CPUs may overdraw the bucket slightly.
+struct protect_state {
+    __u64 syn_tokens;    // One SYN costs NSEC_PER_SEC
+    __u64 syn_refill;    // bpf_ktime_get_ns() of the last refill
+    __u64 country_drops;
+    __u64 syn_drops;
+    __u64 conn_drops;
+    __u64 stray_drops;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct protect_key));
+    __uint(value_size, sizeof(struct protect_config));
+    __uint(max_entries, MAX_PROTECTED);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_protect SEC(".maps");
+
+// Created and removed with the cerberus_protect entry of the same key
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct protect_key));
+    __uint(value_size, sizeof(struct protect_state));
+    __uint(max_entries, MAX_PROTECTED);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_protect_state SEC(".maps");
+
+// Profile protecting a packet's destination port, else its whole address;
+// *key is left set to the entry found
+static __always_inline struct protect_config *protect_lookup(struct ct_ctx *ct,
+                                                             struct protect_key *key) {
+    __builtin_memcpy(key->addr, ct->key.dst_addr, sizeof(key->addr));
+    key->family = ct->key.family;
+    if (ct->key.protocol == IPPROTO_TCP || ct->key.protocol == IPPROTO_UDP ||
+        ct->key.protocol == IPPROTO_SCTP) {
+        key->port = ct->key.dst_port;
+        struct protect_config *config = bpf_map_lookup_elem(&cerberus_protect, key);
+        if (config)
+            return config;
+    }
+    key->port = 0;
+    return bpf_map_lookup_elem(&cerberus_protect, key);
+}
+
+// Refill a token bucket of rate tokens per second holding at most burst.
+// A token is NSEC_PER_SEC in *tokens.
+static __always_inline void bucket_refill(__u64 rate, __u64 burst, __u64 *tokens, __u64 *refill) {
+    __u64 now = bpf_ktime_get_ns();
+    __u64 cap = burst * NSEC_PER_SEC;
+    __u64 elapsed = now - *refill;
+
+    if (elapsed >= cap / rate)
+        *tokens = cap;
+    else if (*tokens + elapsed * rate > cap)
+        *tokens = cap;
+    else
+        *tokens += elapsed * rate;
+    *refill = now;
+}
+
+// Refill a token bucket of rate tokens per second holding at most burst,
+// and take one token from it
+static __always_inline int bucket_take(__u32 rate, __u32 burst, __u64 *tokens, __u64 *refill) {
+    bucket_refill(rate, burst, tokens, refill);
+    if (*tokens < NSEC_PER_SEC)
+        return 0;
+    *tokens -= NSEC_PER_SEC;
+    return 1;
+}
+
+// Refill the SYN bucket of a destination and take one SYN from it
+static __always_inline int syn_allowed(struct protect_config *config, struct protect_state *state) {
+    return bucket_take(config->syn_rate, config->syn_burst, &state->syn_tokens, &state->syn_refill);
+}
+
+// Whether the protection profile of a packet's destination drops it.
+// Packets of tracked flows and related ICMP errors are not checked.
+static __always_inline int protect_drop(struct ct_ctx *ct) {
+    if (ct->entry || ct->state != CT_STATE_NEW)
+        return 0;
+
+    struct protect_key key = {};
+    struct protect_config *config = protect_lookup(ct, &key);
+    if (!config)
+        return 0;
+    struct protect_state *state = bpf_map_lookup_elem(&cerberus_protect_state, &key);
+    if (!state)
+        return 0;
+
+    if (config->country_set &&
+        !ipset_contains(config->country_set, ct->key.family, ct->key.src_addr)) {
+        __sync_fetch_and_add(&state->country_drops, 1);
+        return 1;
+    }
+    // Without conntrack in the pipeline no flow is tracked, and only the
+    // source check above applies
+    if (!ct->trackable)
+        return 0;
+    if (ct->key.protocol == IPPROTO_TCP) {
+        int syn = (ct->tcp_flags & (TCPHDR_SYN | TCPHDR_ACK)) == TCPHDR_SYN;
+        if (!syn && config->drop_stray) {
+            __sync_fetch_and_add(&state->stray_drops, 1);
+            return 1;
+        }
+        if (syn && config->syn_rate && !syn_allowed(config, state)) {
+            __sync_fetch_and_add(&state->syn_drops, 1);
+            return 1;
+        }
+    }
+    if (config->max_conns) {
+        if (config->conns >= config->max_conns) {
+            __sync_fetch_and_add(&state->conn_drops, 1);
+            return 1;
+        }
+        __sync_fetch_and_add(&config->conns, 1);
+    }
+    return 0;
+}
+
+/*
+ * SYN flood mitigation (see ctrl/mitigation.go). New TCP SYNs the rules
+ * pass are counted against their destination address in one-second
+ * windows; a destination receiving more than the threshold in one window
+ * is mitigated until hold_ns after that last happened. New SYNs to a
+ * mitigated destination are rate limited, or answered with a SYN-ACK
+ * acknowledging a cookie of the flow instead of the SYN. No client
+ * expects that acknowledgment, so a real one answers with an RST carrying
+ * the cookie (RFC 793, SYN-SENT state) and sends its SYN again about a
+ * second later: the RST proves the source address, whose SYNs then pass.
+ * Spoofed sources never see the cookie. Cookies are only answered on the
+ * XDP path; the TC fallback, degraded mode and tunnels rate limit instead.
+ */
+#define MAX_SYNFLOOD_DESTS    65536
+#define MAX_SYNFLOOD_VERIFIED (256 * 1024)
+#define SYNFLOOD_COOKIE_SLOT  6  // Cookies change every 2^6 s, the previous one stays valid
+
+enum synflood_mode {
+    SYNFLOOD_OFF = 0,
+    SYNFLOOD_RATE_LIMIT = 1,
+    SYNFLOOD_COOKIE = 2,
+};
+
+enum synflood_verdict {
+    SYNFLOOD_PASS = 0,
+    SYNFLOOD_DROP = 1,
+    SYNFLOOD_ANSWER = 2, // Drop and answer with a cookie
+};
+
+// Mirrored by synFloodConfig in ctrl/mitigation.go
+struct synflood_config {
+    __u8  mode;          // enum synflood_mode
+    __u8  pad[3];
+    __u32 threshold;     // SYNs per second to a destination that start mitigating it
+    __u32 syn_rate;      // SYNs per second passed to a mitigated destination when rate limiting
+    __u32 syn_burst;     // At least 1
+    __u64 hold_ns;       // Mitigation lasts this long after the threshold was last exceeded
+    __u64 verified_ns;   // Verified sources pass this long
+    __u64 secret[2];     // Cookie key
+};
+
+// Mirrored by synFloodKey in ctrl/mitigation.go
+struct synflood_key {
+    __u32 addr[4];       // IPv4 uses the first word, network byte order
+    __u8  family;        // 4 or 6
+    __u8  pad[3];
+};
+
+// Mirrored by synFloodState in ctrl/mitigation.go. Updated without
+// locking, like protect_state.
+struct synflood_state {
+    __u64 window;        // bpf_ktime_get_ns() the current one-second window started
+    __u64 count;         // SYNs in the current window
+    __u64 rate;          // SYNs in the previous window, 0 if it saw none
+    __u64 since;         // Mitigated since, 0 = never
+    __u64 until;         // Mitigated until
+    __u64 syn_tokens;    // Rate limiting bucket, see bucket_take
+    __u64 syn_refill;
+    __u64 syns;
+    __u64 dropped;       // Rate limited
+    __u64 cookies;       // SYNs answered with a cookie
+    __u64 verified;      // Sources that returned one
+};
+
+// Mirrored by synFloodStats in ctrl/mitigation.go
+struct synflood_stats {
+    __u64 syns;          // New SYNs counted
+    __u64 dropped;       // Rate limited
+    __u64 cookies;       // Answered with a cookie
+    __u64 verified;      // RSTs that returned a valid cookie
+    __u64 invalid;       // RSTs to mitigated destinations with a wrong one
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct synflood_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_synflood_config SEC(".maps");
+
+// SYN rates and mitigation by destination address
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct synflood_key));
+    __uint(value_size, sizeof(struct synflood_state));
+    __uint(max_entries, MAX_SYNFLOOD_DESTS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_synflood_dests SEC(".maps");
+
+// Sources that returned a cookie, the value is when they stop passing
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct synflood_key));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, MAX_SYNFLOOD_VERIFIED);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_synflood_verified SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct synflood_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_synflood_stats SEC(".maps");
+
+// Finalizer of MurmurHash3
+static __always_inline __u64 synflood_mix(__u64 x) {
+    x ^= x >> 33;
+    x *= 0xff51afd7ed558ccdULL;
+    x ^= x >> 33;
+    x *= 0xc4ceb9fe1a85ec53ULL;
+    x ^= x >> 33;
+    return x;
+}
+
+// Cookie of a flow in a time slot, keyed by the control plane's secret.
+// The client's RST carries the same key as its SYN.
+static __always_inline __u32 synflood_cookie(struct synflood_config *config, struct ct_key *key,
+                                             __u64 slot) {
+    __u64 hash = config->secret[0] ^ slot;
+    for (int i = 0; i < 4; i++)
+        hash = synflood_mix(hash ^ ((__u64)key->src_addr[i] << 32 | key->dst_addr[i]));
+    hash = synflood_mix(hash ^ config->secret[1] ^ ((__u64)key->src_port << 16 | key->dst_port));
+    return hash ^ hash >> 32;
+}
+
+static __always_inline __u64 synflood_slot(void) {
+    return bpf_ktime_get_ns() / NSEC_PER_SEC >> SYNFLOOD_COOKIE_SLOT;
+}
+
+static __always_inline void synflood_addr_key(struct synflood_key *key, __u32 *addr, __u8 family) {
+    __builtin_memcpy(key->addr, addr, sizeof(key->addr));
+    key->family = family;
+}
+
+// Count a new SYN against its destination, returning the destination's
+// state if it is mitigated
+static __always_inline struct synflood_state *synflood_count(struct synflood_config *config,
+                                                             struct ct_ctx *ct,
+                                                             struct synflood_stats *stats) {
+    struct synflood_key key = {};
+    synflood_addr_key(&key, ct->key.dst_addr, ct->key.family);
+    struct synflood_state *state = bpf_map_lookup_elem(&cerberus_synflood_dests, &key);
+    if (!state) {
+        struct synflood_state fresh = {};
+        bpf_map_update_elem(&cerberus_synflood_dests, &key, &fresh, BPF_NOEXIST);
+        state = bpf_map_lookup_elem(&cerberus_synflood_dests, &key);
+        if (!state)
+            return NULL;
+    }
+
+    __u64 now = bpf_ktime_get_ns();
+    __u64 elapsed = now - state->window;
+    if (elapsed >= NSEC_PER_SEC) {
+        state->rate = elapsed < 2 * NSEC_PER_SEC ? state->count : 0;
+        state->window = now;
+        state->count = 0;
+    }
+    state->count += 1;
+    state->syns += 1;
+    stats->syns += 1;
+    if (state->count > config->threshold) {
+        if (!state->since || now > state->until)
+            state->since = now;
+        state->until = now + config->hold_ns;
+    }
+    if (!state->since || now > state->until)
+        return NULL;
+    return state;
+}
+
+// SYN flood verdict of a packet the rules pass: new SYNs to a mitigated
+// destination are dropped above the rate, or answered with a cookie
+// unless their source returned one before. xdp is 0 when the packet
+// cannot be answered.
+static __always_inline int synflood_check(struct ct_ctx *ct, int xdp) {
+    if (ct->entry || ct->state != CT_STATE_NEW || ct->key.protocol != IPPROTO_TCP ||
+        (ct->tcp_flags & (TCPHDR_SYN | TCPHDR_ACK)) != TCPHDR_SYN)
+        return SYNFLOOD_PASS;
+    __u32 zero = 0;
+    struct synflood_config *config = bpf_map_lookup_elem(&cerberus_synflood_config, &zero);
+    if (!config || config->mode == SYNFLOOD_OFF)
+        return SYNFLOOD_PASS;
+    struct synflood_stats *stats = bpf_map_lookup_elem(&cerberus_synflood_stats, &zero);
+    if (!stats)
+        return SYNFLOOD_PASS;
+    struct synflood_state *state = synflood_count(config, ct, stats);
+    if (!state)
+        return SYNFLOOD_PASS;
+
+    if (config->mode == SYNFLOOD_COOKIE && xdp && !ct->tunnel) {
+        struct synflood_key source = {};
+        synflood_addr_key(&source, ct->key.src_addr, ct->key.family);
+        __u64 *until = bpf_map_lookup_elem(&cerberus_synflood_verified, &source);
+        if (until && *until >= bpf_ktime_get_ns())
+            return SYNFLOOD_PASS;
+        return SYNFLOOD_ANSWER;
+    }
+    if (bucket_take(config->syn_rate, config->syn_burst, &state->syn_tokens, &state->syn_refill))
+        return SYNFLOOD_PASS;
+    state->dropped += 1;
+    stats->dropped += 1;
+    return SYNFLOOD_DROP;
+}
+
+// Answer a SYN synflood_check dropped with a cookie, and verify the
+// sources whose RSTs return one, returning the verdict to apply instead
+static __always_inline int synflood_filter(struct xdp_md *ctx, struct ct_ctx *ct, int verdict) {
+    if (ct->tunnel || ct->key.protocol != IPPROTO_TCP)
+        return verdict;
+    if (!ct->syn_cookie && (ct->entry || !(ct->tcp_flags & TCPHDR_RST)))
+        return verdict;
+    __u32 zero = 0;
+    struct synflood_config *config = bpf_map_lookup_elem(&cerberus_synflood_config, &zero);
+    struct synflood_stats *stats = bpf_map_lookup_elem(&cerberus_synflood_stats, &zero);
+    if (!config || !stats || config->mode != SYNFLOOD_COOKIE)
+        return verdict;
+    struct synflood_key dest = {};
+    synflood_addr_key(&dest, ct->key.dst_addr, ct->key.family);
+    struct synflood_state *state = bpf_map_lookup_elem(&cerberus_synflood_dests, &dest);
+    if (!state)
+        return verdict;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return verdict;
+    void *ip = data + (ct->l3 & 0x1ff);
+    struct tcphdr *tcp;
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end)
+            return verdict;
+        tcp = ip + ((ip4->ihl * 4) & 0x3c);
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != IPPROTO_TCP)
+            return verdict;
+        tcp = (void *)(ip6 + 1);
+    }
+    if ((void *)(tcp + 1) > data_end)
+        return verdict;
+
+    __u64 slot = synflood_slot();
+    if (!ct->syn_cookie) {
+        __u64 now = bpf_ktime_get_ns();
+        if (!state->since || now > state->until)
+            return verdict;
+        __u32 seq = bpf_ntohl(tcp->seq);
+        if (seq != synflood_cookie(config, &ct->key, slot) &&
+            seq != synflood_cookie(config, &ct->key, slot - 1)) {
+            stats->invalid += 1;
+            return verdict;
+        }
+        struct synflood_key source = {};
+        synflood_addr_key(&source, ct->key.src_addr, ct->key.family);
+        __u64 until = now + config->verified_ns;
+        bpf_map_update_elem(&cerberus_synflood_verified, &source, &until, BPF_ANY);
+        state->verified += 1;
+        stats->verified += 1;
+        return XDP_DROP;
+    }
+
+    // Turn the SYN into the SYN-ACK in place: swapping addresses and ports
+    // leaves the checksums as they are, the SYN's options are echoed
+    __u8 mac[ETH_ALEN];
+    __builtin_memcpy(mac, eth->h_source, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, eth->h_dest, ETH_ALEN);
+    __builtin_memcpy(eth->h_dest, mac, ETH_ALEN);
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        __be32 addr = ip4->saddr;
+        ip4->saddr = ip4->daddr;
+        ip4->daddr = addr;
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        struct in6_addr addr = ip6->saddr;
+        ip6->saddr = ip6->daddr;
+        ip6->daddr = addr;
+    }
+    __be16 port = tcp->source;
+    tcp->source = tcp->dest;
+    tcp->dest = port;
+
+    // Sequence and acknowledgment numbers, offset and flags
+    __be32 from[3], to[3];
+    __builtin_memcpy(from, &tcp->seq, sizeof(from));
+    __be32 cookie = bpf_htonl(synflood_cookie(config, &ct->key, slot));
+    tcp->seq = cookie;
+    tcp->ack_seq = cookie;
+    ((__u8 *)tcp)[13] = TCPHDR_SYN | TCPHDR_ACK;
+    __builtin_memcpy(to, &tcp->seq, sizeof(to));
+    tcp->check = csum_replace(tcp->check, from, sizeof(from), to, sizeof(to));
+
+    state->cookies += 1;
+    stats->cookies += 1;
+    return XDP_TX;
+}
+
+/*
+ * Per-source rate limiting (see ctrl/source_limits.go). Every packet the
+ * rules pass takes a token from its source address's packet bucket, and
+ * every new connection one from its connection bucket; packets finding a
+ * bucket empty are dropped. Sources live in an LRU map, so under pressure
+ * the least recently seen are forgotten and start over with full buckets.
+ * Exempt sources are not limited until their exemption expires.
+ */
+#define MAX_SRCLIMIT_SOURCES (256 * 1024)
+#define MAX_SRCLIMIT_EXEMPT  4096
+
+// Mirrored by sourceLimitConfig in ctrl/source_limits.go. Zero rates are off.
+struct srclimit_config {
+    __u32 pps;           // Packets per second
+    __u32 pps_burst;     // At least 1 when pps is set
+    __u32 conn_rate;     // New connections per second
+    __u32 conn_burst;    // At least 1 when conn_rate is set
+};
+
+// Mirrored by sourceLimitKey in ctrl/source_limits.go
+struct srclimit_key {
+    __u32 addr[4];       // IPv4 uses the first word, network byte order
+    __u8  family;        // 4 or 6
+    __u8  pad[3];
+};
+
+// Mirrored by sourceLimitState in ctrl/source_limits.go. Updated without
+// locking, like protect_state.
+struct srclimit_state {
+    __u64 window;        // bpf_ktime_get_ns() the current one-second window started
+    __u64 count;         // Packets in the current window
+    __u64 rate;          // Packets in the previous window, 0 if it saw none
+    __u64 pkt_tokens;    // Buckets, see bucket_take
+    __u64 pkt_refill;
+    __u64 conn_tokens;
+    __u64 conn_refill;
+    __u64 packets;
+    __u64 conns;
+    __u64 dropped_packets; // Over the packet rate
+    __u64 dropped_conns;   // Over the connection rate
+    __u64 last_drop;     // bpf_ktime_get_ns(), 0 = never
+};
+
+// Mirrored by sourceLimitStats in ctrl/source_limits.go
+struct srclimit_stats {
+    __u64 packets;       // Counted against a source
+    __u64 conns;
+    __u64 dropped_packets;
+    __u64 dropped_conns;
+    __u64 exempt;        // Passed unlimited from exempt sources
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct srclimit_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_srclimit_config SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct srclimit_key));
+    __uint(value_size, sizeof(struct srclimit_state));
+    __uint(max_entries, MAX_SRCLIMIT_SOURCES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_srclimit SEC(".maps");
+
+// Exempt sources, the value is when the exemption expires
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(struct srclimit_key));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, MAX_SRCLIMIT_EXEMPT);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_srclimit_exempt SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct srclimit_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_srclimit_stats SEC(".maps");
+
+// Whether the source rate limits drop a packet the rules pass
+static __always_inline int srclimit_drop(struct ct_ctx *ct) {
+    __u32 zero = 0;
+    struct srclimit_config *config = bpf_map_lookup_elem(&cerberus_srclimit_config, &zero);
+    if (!config || (!config->pps && !config->conn_rate))
+        return 0;
+    struct srclimit_stats *stats = bpf_map_lookup_elem(&cerberus_srclimit_stats, &zero);
+    if (!stats)
+        return 0;
+
+    struct srclimit_key key = {};
+    __builtin_memcpy(key.addr, ct->key.src_addr, sizeof(key.addr));
+    key.family = ct->key.family;
+    __u64 now = bpf_ktime_get_ns();
+    __u64 *until = bpf_map_lookup_elem(&cerberus_srclimit_exempt, &key);
+    if (until && *until >= now) {
+        stats->exempt += 1;
+        return 0;
+    }
+
+    struct srclimit_state *state = bpf_map_lookup_elem(&cerberus_srclimit, &key);
+    if (!state) {
+        struct srclimit_state fresh = {};
+        bpf_map_update_elem(&cerberus_srclimit, &key, &fresh, BPF_NOEXIST);
+        state = bpf_map_lookup_elem(&cerberus_srclimit, &key);
+        if (!state)
+            return 0;
+    }
+
+    __u64 elapsed = now - state->window;
+    if (elapsed >= NSEC_PER_SEC) {
+        state->rate = elapsed < 2 * NSEC_PER_SEC ? state->count : 0;
+        state->window = now;
+        state->count = 0;
+    }
+    state->count += 1;
+    state->packets += 1;
+    stats->packets += 1;
+    if (config->pps &&
+        !bucket_take(config->pps, config->pps_burst, &state->pkt_tokens, &state->pkt_refill)) {
+        state->dropped_packets += 1;
+        state->last_drop = now;
+        stats->dropped_packets += 1;
+        return 1;
+    }
+
+    if (ct->entry || ct->state != CT_STATE_NEW)
+        return 0;
+    state->conns += 1;
+    stats->conns += 1;
+    if (config->conn_rate &&
+        !bucket_take(config->conn_rate, config->conn_burst, &state->conn_tokens, &state->conn_refill)) {
+        state->dropped_conns += 1;
+        state->last_drop = now;
+        stats->dropped_conns += 1;
+        return 1;
+    }
+    return 0;
+}
+
+/*
+ * Port scan detection (see ctrl/port_scan.go). Every new TCP or UDP
+ * connection attempt is counted against its source whatever the rules
+ * decide: the first attempt to a port within the source's current window
+ * adds to its count of distinct ports. The control plane reads the counts
+ * on an interval and reports the sources over its threshold. Sources and
+ * the ports they tried live in LRU maps, so under pressure the least
+ * recently seen are forgotten.
+ */
+#define MAX_PORTSCAN_SOURCES (64 * 1024)
+#define MAX_PORTSCAN_PORTS   (256 * 1024)
+
+// Mirrored by portScanConfig in ctrl/port_scan.go
+struct portscan_config {
+    __u64 window;        // Nanoseconds, 0 = detection off
+};
+
+// Only the data plane reads the ports tried
+struct portscan_port_key {
+    __u32 addr[4];       // Source, IPv4 uses the first word, network byte order
+    __u8  family;        // 4 or 6
+    __u8  protocol;
+    __u16 port;          // Destination port, host byte order
+};
+
+// Mirrored by portScanState in ctrl/port_scan.go, keyed by struct
+// srclimit_key. Updated without locking, like protect_state.
+struct portscan_state {
+    __u64 window;        // bpf_ktime_get_ns() the current window started
+    __u64 ports;         // Distinct ports tried in the current window
+    __u64 last_ports;    // In the previous window, 0 if it did not end this one
+    __u64 attempts;      // Connection attempts in the current window
+    __u64 last_seen;     // bpf_ktime_get_ns() of the last attempt
+};
+
+// Mirrored by portScanStats in ctrl/port_scan.go
+struct portscan_stats {
+    __u64 attempts;      // Connection attempts counted
+    __u64 new_ports;     // First attempts to a port within a window
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct portscan_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_portscan_config SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct srclimit_key));
+    __uint(value_size, sizeof(struct portscan_state));
+    __uint(max_entries, MAX_PORTSCAN_SOURCES);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_portscan SEC(".maps");
+
+// Ports tried, the value is the window of the source they were last tried in
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct portscan_port_key));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, MAX_PORTSCAN_PORTS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_portscan_ports SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct portscan_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_portscan_stats SEC(".maps");
+
+// Count a new connection attempt against its source's distinct ports
+static __always_inline void portscan_count(struct ct_ctx *ct) {
+    if (ct->entry || ct->state != CT_STATE_NEW)
+        return;
+    if (ct->key.protocol != IPPROTO_TCP && ct->key.protocol != IPPROTO_UDP)
+        return;
+    __u32 zero = 0;
+    struct portscan_config *config = bpf_map_lookup_elem(&cerberus_portscan_config, &zero);
+    if (!config || !config->window)
+        return;
+    struct portscan_stats *stats = bpf_map_lookup_elem(&cerberus_portscan_stats, &zero);
+    if (!stats)
+        return;
+
+    struct srclimit_key key = {};
+    __builtin_memcpy(key.addr, ct->key.src_addr, sizeof(key.addr));
+    key.family = ct->key.family;
+    struct portscan_state *state = bpf_map_lookup_elem(&cerberus_portscan, &key);
+    if (!state) {
+        struct portscan_state fresh = {};
+        bpf_map_update_elem(&cerberus_portscan, &key, &fresh, BPF_NOEXIST);
+        state = bpf_map_lookup_elem(&cerberus_portscan, &key);
+        if (!state)
+            return;
+    }
+
+    __u64 now = bpf_ktime_get_ns();
+    __u64 elapsed = now - state->window;
+    if (elapsed >= config->window) {
+        state->last_ports = elapsed < 2 * config->window ? state->ports : 0;
+        state->window = now;
+        state->ports = 0;
+        state->attempts = 0;
+    }
+    state->attempts += 1;
+    state->last_seen = now;
+    stats->attempts += 1;
+
+    struct portscan_port_key port = {};
+    __builtin_memcpy(port.addr, ct->key.src_addr, sizeof(port.addr));
+    port.family = ct->key.family;
+    port.protocol = ct->key.protocol;
+    port.port = ct->key.dst_port;
+    __u64 *tried = bpf_map_lookup_elem(&cerberus_portscan_ports, &port);
+    if (tried && *tried == state->window)
+        return;
+    __u64 window = state->window;
+    bpf_map_update_elem(&cerberus_portscan_ports, &port, &window, BPF_ANY);
+    state->ports += 1;
+    stats->new_ports += 1;
+}
+
+/*
+ * DNS filtering (see ctrl/dns_filter.go). The question of plain UDP
+ * queries to port 53 that the rules pass is matched against the domains
+ * of the control plane's lists: every suffix of the name starting at a
+ * label is hashed, and the longest one listed decides. Blocked queries are
+ * dropped or answered with NXDOMAIN from the interface they came in on.
+ * Queries are copied to a ring buffer for the control plane to report,
+ * the blocked ones or all of them. Only inbound XDP sees queries; those
+ * in tunnels and on the TC fallback are not checked.
+ */
+#define MAX_DNS_DOMAINS (256 * 1024)
+#define DNS_RING_SIZE   (256 * 1024)
+#define DNS_PORT        53
+#define DNS_NAME_MAX    256       // Wire name with its terminating zero
+#define DNS_LABEL_MAX   63
+
+// FNV-1a, 64-bit, mirrored by dnsSuffixHash in ctrl/dns_filter.go
+#define DNS_HASH_OFFSET 0xcbf29ce484222325ULL
+#define DNS_HASH_PRIME  0x100000001b3ULL
+
+// Header flags (RFC 1035)
+#define DNS_FLAG_QR     0x8000
+#define DNS_OPCODE_MASK 0x7800
+#define DNS_FLAG_RD     0x0100
+#define DNS_FLAG_RA     0x0080
+#define DNS_RCODE_NXDOMAIN 3
+
+enum dns_action {
+    DNS_ALLOW = 1,
+    DNS_DENY = 2,
+};
+
+enum dns_block {
+    DNS_BLOCK_DROP = 0,
+    DNS_BLOCK_NXDOMAIN = 1,
+};
+
+enum dns_punt {
+    DNS_PUNT_NONE = 0,
+    DNS_PUNT_BLOCKED = 1,
+    DNS_PUNT_ALL = 2,
+};
+
+// What became of a query, in dns_query.verdict
+enum dns_verdict {
+    DNS_VERDICT_PASS = 0,
+    DNS_VERDICT_DROP = 1,
+    DNS_VERDICT_NXDOMAIN = 2,
+};
+
+// Mirrored by dnsConfig in ctrl/dns_filter.go
+struct dns_config {
+    __u8 enabled;
+    __u8 block;          // enum dns_block
+    __u8 punt;           // enum dns_punt
+    __u8 default_deny;   // Block queries no list matches
+};
+
+// Mirrored by dnsDomain in ctrl/dns_filter.go
+struct dns_domain {
+    __u32 list_id;
+    __u8  action;        // enum dns_action
+    __u8  pad[3];
+};
+
+// Mirrored by dnsQuery in ctrl/dns_filter.go
+struct dns_query {
+    __u64 timestamp;     // bpf_ktime_get_ns()
+    __u32 ifindex;
+    __u32 list_id;       // List that matched, 0 = none
+    struct ct_key key;
+    __u16 qtype;
+    __u8  action;        // enum dns_action of the match, 0 = none
+    __u8  verdict;       // enum dns_verdict
+    __u16 name_len;      // Wire name bytes without the terminating zero
+    __u8  pad[2];
+    __u8  name[DNS_NAME_MAX];   // Lowercased
+};
+
+// Mirrored by dnsStats in ctrl/dns_filter.go
+struct dns_stats {
+    __u64 queries;       // Inspected
+    __u64 allowed;       // Matched an allow list
+    __u64 blocked;
+    __u64 malformed;     // Passed unchecked
+    __u64 lost;          // Not copied, the ring was full
+};
+
+struct dns_hdr {
+    __be16 id;
+    __be16 flags;
+    __be16 qdcount;
+    __be16 ancount;
+    __be16 nscount;
+    __be16 arcount;
+};
+
+// Per-CPU copy of the name being matched, too large for the stack
+struct dns_scratch {
+    __u8 name[DNS_NAME_MAX];
+    __u8 starts[DNS_NAME_MAX];   // 1 where a label starts
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct dns_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dns_config SEC(".maps");
+
+// Keyed by the hash of a domain's wire name
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u64));
+    __uint(value_size, sizeof(struct dns_domain));
+    __uint(max_entries, MAX_DNS_DOMAINS);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dns_domains SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, DNS_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dns_queries SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct dns_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_dns_stats SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct dns_scratch));
+    __uint(max_entries, 1);
+} cerberus_dns_scratch SEC(".maps");
+
+// Copy the question name at 'qname' to the scratch, lowercased, returning
+// its length without the terminating zero or -1 if it is not a plain
+// uncompressed name
+static __always_inline int dns_copy_name(struct dns_scratch *s, __u8 *qname, void *data_end) {
+    __u32 next = 0;
+    for (__u32 i = 0; i < DNS_NAME_MAX; i++) {
+        __u8 *byte = qname + i;
+        if ((void *)(byte + 1) > data_end)
+            return -1;
+        __u8 c = *byte;
+        s->starts[i] = 0;
+        if (i == next) {
+            if (c == 0)
+                return i;
+            if (c > DNS_LABEL_MAX)
+                return -1;
+            s->starts[i] = 1;
+            next = i + c + 1;
+        } else if (c >= 'A' && c <= 'Z') {
+            c += 'a' - 'A';
+        }
+        s->name[i] = c;
+    }
+    return -1;
+}
+
+// Longest listed suffix of the name in the scratch, hashed from its end so
+// each label start completes the hash of one more suffix
+static __always_inline struct dns_domain *dns_match(struct dns_scratch *s, __u32 len) {
+    struct dns_domain *match = NULL;
+    __u64 hash = DNS_HASH_OFFSET;
+    for (__u32 k = 0; k < DNS_NAME_MAX; k++) {
+        if (k >= len)
+            break;
+        __u32 i = (len - 1 - k) & (DNS_NAME_MAX - 1);
+        hash ^= s->name[i];
+        hash *= DNS_HASH_PRIME;
+        if (!s->starts[i])
+            continue;
+        struct dns_domain *domain = bpf_map_lookup_elem(&cerberus_dns_domains, &hash);
+        if (domain)
+            match = domain;
+    }
+    return match;
+}
+
+// Turn a query into its NXDOMAIN answer in place: addresses and ports are
+// swapped, which leaves the checksums as they are, and only the flags
+// change
+static __always_inline int dns_nxdomain(void *data, void *data_end, struct ethhdr *eth,
+                                        void *ip, struct udphdr *udp, struct dns_hdr *dns,
+                                        __u8 family) {
+    __u8 mac[ETH_ALEN];
+    __builtin_memcpy(mac, eth->h_source, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, eth->h_dest, ETH_ALEN);
+    __builtin_memcpy(eth->h_dest, mac, ETH_ALEN);
+
+    if (family == 4) {
+        struct iphdr *ip4 = ip;
+        __be32 addr = ip4->saddr;
+        ip4->saddr = ip4->daddr;
+        ip4->daddr = addr;
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        struct in6_addr addr = ip6->saddr;
+        ip6->saddr = ip6->daddr;
+        ip6->daddr = addr;
+    }
+    __be16 port = udp->source;
+    udp->source = udp->dest;
+    udp->dest = port;
+
+    __be32 from = dns->flags;
+    __u16 flags = DNS_FLAG_QR | (bpf_ntohs(dns->flags) & DNS_FLAG_RD) | DNS_FLAG_RA |
+                  DNS_RCODE_NXDOMAIN;
+    dns->flags = bpf_htons(flags);
+    __be32 to = dns->flags;
+    // A zero IPv4 UDP checksum is none
+    if (udp->check) {
+        __u16 check = csum_replace(udp->check, &from, sizeof(from), &to, sizeof(to));
+        udp->check = check ? check : 0xffff;
+    }
+    return XDP_TX;
+}
+
+static __always_inline void dns_punt(struct ct_ctx *ct, struct dns_scratch *s, __u32 len,
+                                     __u16 qtype, struct dns_domain *domain, __u8 verdict,
+                                     struct dns_stats *stats) {
+    struct dns_query *query = bpf_ringbuf_reserve(&cerberus_dns_queries, sizeof(*query), 0);
+    if (!query) {
+        stats->lost += 1;
+        return;
+    }
+    query->timestamp = bpf_ktime_get_ns();
+    query->ifindex = ct->ifindex;
+    query->list_id = domain ? domain->list_id : 0;
+    query->action = domain ? domain->action : 0;
+    query->key = ct->key;
+    query->qtype = qtype;
+    query->verdict = verdict;
+    query->name_len = len;
+    __builtin_memcpy(query->name, s->name, DNS_NAME_MAX);
+    bpf_ringbuf_submit(query, 0);
+}
+
+// Check a DNS query the rules pass against the domain lists, returning
+// the verdict to apply instead
+static __always_inline int dns_filter(struct xdp_md *ctx, struct ct_ctx *ct, int verdict) {
+    if (verdict != XDP_PASS || ct->tunnel || ct->key.protocol != IPPROTO_UDP ||
+        ct->key.dst_port != DNS_PORT)
+        return verdict;
+    __u32 zero = 0;
+    struct dns_config *config = bpf_map_lookup_elem(&cerberus_dns_config, &zero);
+    if (!config || !config->enabled)
+        return verdict;
+    struct dns_stats *stats = bpf_map_lookup_elem(&cerberus_dns_stats, &zero);
+    struct dns_scratch *s = bpf_map_lookup_elem(&cerberus_dns_scratch, &zero);
+    if (!stats || !s)
+        return verdict;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return verdict;
+    void *ip = data + (ct->l3 & 0x1ff);
+    struct udphdr *udp;
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end || ip4->frag_off & bpf_htons(IPV4_MF_OFFSET))
+            return verdict;
+        udp = ip + ((ip4->ihl * 4) & 0x3c);
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        // Queries behind extension headers are left alone
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != IPPROTO_UDP)
+            return verdict;
+        udp = (void *)(ip6 + 1);
+    }
+    struct dns_hdr *dns = (void *)(udp + 1);
+    if ((void *)(dns + 1) > data_end)
+        return verdict;
+    stats->queries += 1;
+    if (dns->flags & bpf_htons(DNS_FLAG_QR | DNS_OPCODE_MASK) || dns->qdcount != bpf_htons(1) ||
+        dns->ancount || dns->nscount) {
+        stats->malformed += 1;
+        return verdict;
+    }
+
+    __u8 *qname = (void *)(dns + 1);
+    int len = dns_copy_name(s, qname, data_end);
+    if (len < 0) {
+        stats->malformed += 1;
+        return verdict;
+    }
+    __be16 *qtype = (void *)(qname + (len & (DNS_NAME_MAX - 1)) + 1);
+    if ((void *)(qtype + 1) > data_end) {
+        stats->malformed += 1;
+        return verdict;
+    }
+    // Clear what is left of the previous name, which would go to the ring
+    for (__u32 i = 0; i < DNS_NAME_MAX; i++)
+        if (i >= len)
+            s->name[i] = 0;
+
+    struct dns_domain *domain = dns_match(s, len);
+    int blocked = domain ? domain->action == DNS_DENY : config->default_deny;
+    __u8 result = DNS_VERDICT_PASS;
+    if (blocked) {
+        stats->blocked += 1;
+        result = config->block == DNS_BLOCK_NXDOMAIN ? DNS_VERDICT_NXDOMAIN : DNS_VERDICT_DROP;
+    } else if (domain) {
+        stats->allowed += 1;
+    }
+    if (config->punt == DNS_PUNT_ALL || (blocked && config->punt == DNS_PUNT_BLOCKED))
+        dns_punt(ct, s, len, bpf_ntohs(*qtype), domain, result, stats);
+
+    if (result == DNS_VERDICT_DROP)
+        return XDP_DROP;
+    if (result == DNS_VERDICT_NXDOMAIN)
+        return dns_nxdomain(data, data_end, eth, ip, udp, dns, ct->key.family);
+    return verdict;
+}
+
 /*
- * This is the main XDP program. It is attached to the XDP hook and
- * will be executed for each incoming packet.
+ * TLS fingerprinting (see ctrl/tls_fingerprint.go). TCP segments the rules
+ * pass whose payload starts a handshake record holding a ClientHello are
+ * copied to a ring buffer, where the control plane computes their JA3 and
+ * JA4 fingerprints and matches them against its fingerprint rules. Flows
+ * of blocked fingerprints are listed in cerberus_tls_blocked, in both
+ * directions, and their later packets dropped: the ClientHello itself
+ * passes but the handshake never completes. A ClientHello is fingerprinted
+ * from the segment it starts in. Like DNS filtering, only inbound XDP sees
+ * them.
  */
-SEC("xdp")
-int xdp_firewall(struct xdp_md *ctx) {
-    void *data_end = (void *)(long)ctx->data_end;
+#define TLS_RING_SIZE   (1024 * 1024)
+#define TLS_HELLO_MAX   2048      // Payload bytes copied, more than a segment
+#define MAX_TLS_BLOCKED 65536
+#define TLS_RECORD_HANDSHAKE       22
+#define TLS_HANDSHAKE_CLIENT_HELLO 1
+
+// Mirrored by tlsConfig in ctrl/tls_fingerprint.go
+struct tls_config {
+    __u8 enabled;
+    __u8 pad[3];
+};
+
+// Mirrored by tlsHello in ctrl/tls_fingerprint.go
+struct tls_hello {
+    __u64 timestamp;     // bpf_ktime_get_ns()
+    __u32 ifindex;
+    __u16 len;           // Payload bytes copied
+    __u8  pad[2];
+    struct ct_key key;
+    __u8  data[TLS_HELLO_MAX];   // From the record header on
+};
+
+// Mirrored by tlsStats in ctrl/tls_fingerprint.go
+struct tls_stats {
+    __u64 hellos;        // ClientHellos reported
+    __u64 dropped;       // Packets of blocked flows
+    __u64 lost;          // Not reported, the ring was full
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct tls_config));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tls_config SEC(".maps");
+
+// Flows of blocked ClientHellos, the value is when they were blocked
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __uint(key_size, sizeof(struct ct_key));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, MAX_TLS_BLOCKED);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tls_blocked SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, TLS_RING_SIZE);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tls_hellos SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct tls_stats));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_tls_stats SEC(".maps");
+
+// Drop the packets of blocked TLS flows and report the ClientHellos of
+// the others, returning the verdict to apply instead
+static __always_inline int tls_filter(struct xdp_md *ctx, struct ct_ctx *ct, int verdict) {
+    if (verdict != XDP_PASS || ct->tunnel || ct->key.protocol != IPPROTO_TCP)
+        return verdict;
+    __u32 zero = 0;
+    struct tls_config *config = bpf_map_lookup_elem(&cerberus_tls_config, &zero);
+    if (!config || !config->enabled)
+        return verdict;
+    struct tls_stats *stats = bpf_map_lookup_elem(&cerberus_tls_stats, &zero);
+    if (!stats)
+        return verdict;
+    if (bpf_map_lookup_elem(&cerberus_tls_blocked, &ct->key)) {
+        stats->dropped += 1;
+        return XDP_DROP;
+    }
+
     void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    void *ip = data + (ct->l3 & 0x1ff);
+    struct tcphdr *tcp;
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end || ip4->frag_off & bpf_htons(IPV4_MF_OFFSET))
+            return verdict;
+        tcp = ip + ((ip4->ihl * 4) & 0x3c);
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        // Segments behind extension headers are left alone
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != IPPROTO_TCP)
+            return verdict;
+        tcp = (void *)(ip6 + 1);
+    }
+    if ((void *)(tcp + 1) > data_end)
+        return verdict;
+    __u8 *payload = (void *)tcp + ((tcp->doff * 4) & 0x3c);
+    // Record type, major version 3, record length, handshake type
+    if ((void *)(payload + 6) > data_end || payload[0] != TLS_RECORD_HANDSHAKE ||
+        payload[1] != 3 || payload[5] != TLS_HANDSHAKE_CLIENT_HELLO)
+        return verdict;
+
+    __u32 offset = (void *)payload - data;
+    __u32 len = data_end - (void *)payload;
+    if (len > TLS_HELLO_MAX)
+        len = TLS_HELLO_MAX;
+    struct tls_hello *hello = bpf_ringbuf_reserve(&cerberus_tls_hellos, sizeof(*hello), 0);
+    if (!hello) {
+        stats->lost += 1;
+        return verdict;
+    }
+    if (len < 6 || bpf_xdp_load_bytes(ctx, offset, hello->data, len)) {
+        bpf_ringbuf_discard(hello, 0);
+        return verdict;
+    }
+    hello->timestamp = bpf_ktime_get_ns();
+    hello->ifindex = ct->ifindex;
+    hello->len = len;
+    hello->pad[0] = hello->pad[1] = 0;
+    hello->key = ct->key;
+    bpf_ringbuf_submit(hello, 0);
+    stats->hellos += 1;
+    return verdict;
+}
+
+/*
+ * Per-interface settings (see ctrl/interface_config.go). MSS clamping
+ * lowers the MSS option of TCP SYNs and SYN-ACKs arriving on or leaving
+ * by an interface to its target, so that peers behind a tunnel or a link
+ * with a smaller MTU never send segments that would need fragmenting.
+ * The option is rewritten in place with the TCP checksum adjusted; SYNs
+ * without one are counted and passed as they are. Tunneled packets and
+ * IPv6 segments behind extension headers are left alone.
+ *
+ * An ingress policer drops the packets an interface receives over a packet
+ * or bit rate ceiling before they are parsed, so that a volumetric flood
+ * costs the rules, conntrack and everything after them nothing. Both
+ * ceilings are token buckets shared by every CPU.
+ */
+#define MAX_IFACE_CONFIGS 1024
+#define TCPOPT_EOL       0
+#define TCPOPT_NOP       1
+#define TCPOPT_MSS       2
+#define TCPOLEN_MSS      4
+#define TCP_OPTIONS_MAX  40
+
+enum mss_clamp_dirs {
+    MSS_CLAMP_INGRESS = 1 << 0,
+    MSS_CLAMP_EGRESS = 1 << 1,
+};
+
+// Mirrored by ifaceConfig in ctrl/interface_config.go
+struct iface_config {
+    __u16 mss4;          // Target MSS of IPv4 SYNs
+    __u16 mss6;          // Target MSS of IPv6 SYNs
+    __u8  mss_dirs;      // enum mss_clamp_dirs, 0 = no clamping
+    __u8  pad[3];
+    __u32 police_pps;    // Packets per second received, 0 = no ceiling
+    __u32 police_pps_burst; // At least 1 when police_pps is set
+    __u64 police_rate;   // Bytes per second received, 0 = no ceiling
+    __u64 police_burst;  // Bytes, at least the largest packet when police_rate is set
+};
+
+// Mirrored by ifaceStats in ctrl/interface_config.go
+struct iface_stats {
+    __u64 mss_syns;      // SYNs inspected for clamping
+    __u64 mss_clamped;   // SYNs whose MSS was lowered
+    __u64 mss_missing;   // SYNs without an MSS option
+    __u64 police_drops;  // Packets over the policer's ceilings
+    __u64 police_drop_bytes;
+};
+
+// Mirrored by policerState in ctrl/interface_config.go. Updated without
+// locking, like protect_state: CPUs receiving at once may overdraw the
+// buckets slightly.
+struct iface_police {
+    __u64 pkt_tokens;    // Buckets, see bucket_refill
+    __u64 pkt_refill;
+    __u64 byte_tokens;
+    __u64 byte_refill;
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u32));  // ifindex
+    __uint(value_size, sizeof(struct iface_config));
+    __uint(max_entries, MAX_IFACE_CONFIGS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_iface_config SEC(".maps");
+
+// Created by the control plane with the interface's config
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_HASH);
+    __uint(key_size, sizeof(__u32));  // ifindex
+    __uint(value_size, sizeof(struct iface_stats));
+    __uint(max_entries, MAX_IFACE_CONFIGS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_iface_stats SEC(".maps");
+
+// Created by the control plane with the config of an interface policing,
+// starting with full buckets
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(key_size, sizeof(__u32));  // ifindex
+    __uint(value_size, sizeof(struct iface_police));
+    __uint(max_entries, MAX_IFACE_CONFIGS);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_iface_police SEC(".maps");
+
+// Whether the ingress policer of an interface drops a packet of the given
+// length. Packets passed take their tokens from both buckets; dropped ones
+// take none.
+static __always_inline int police_drop(__u32 ifindex, __u64 bytes) {
+    struct iface_config *config = bpf_map_lookup_elem(&cerberus_iface_config, &ifindex);
+    if (!config || (!config->police_pps && !config->police_rate))
+        return 0;
+    struct iface_police *state = bpf_map_lookup_elem(&cerberus_iface_police, &ifindex);
+    if (!state)
+        return 0;
+
+    int over = 0;
+    if (config->police_pps) {
+        bucket_refill(config->police_pps, config->police_pps_burst, &state->pkt_tokens, &state->pkt_refill);
+        over |= state->pkt_tokens < NSEC_PER_SEC;
+    }
+    if (config->police_rate) {
+        bucket_refill(config->police_rate, config->police_burst, &state->byte_tokens, &state->byte_refill);
+        over |= state->byte_tokens < bytes * NSEC_PER_SEC;
+    }
+    if (over) {
+        struct iface_stats *stats = bpf_map_lookup_elem(&cerberus_iface_stats, &ifindex);
+        if (stats) {
+            stats->police_drops += 1;
+            stats->police_drop_bytes += bytes;
+        }
+        return 1;
+    }
+    if (config->police_pps)
+        state->pkt_tokens -= NSEC_PER_SEC;
+    if (config->police_rate)
+        state->byte_tokens -= bytes * NSEC_PER_SEC;
+    return 0;
+}
+
+// Clamp the MSS option of a TCP SYN passed on ct->ifindex in one direction
+static __always_inline void mss_clamp(struct ct_ctx *ct, void *data, void *data_end, int egress) {
+    if (ct->tunnel || ct->key.protocol != IPPROTO_TCP || !(ct->tcp_flags & TCPHDR_SYN))
+        return;
+    struct iface_config *config = bpf_map_lookup_elem(&cerberus_iface_config, &ct->ifindex);
+    if (!config || !(config->mss_dirs & (egress ? MSS_CLAMP_EGRESS : MSS_CLAMP_INGRESS)))
+        return;
+    struct iface_stats *stats = bpf_map_lookup_elem(&cerberus_iface_stats, &ct->ifindex);
+    if (!stats)
+        return;
+
+    void *ip = data + (ct->l3 & 0x1ff);
+    struct tcphdr *tcp;
+    __u16 target;
+    if (ct->key.family == 4) {
+        struct iphdr *ip4 = ip;
+        if ((void *)(ip4 + 1) > data_end || ip4->frag_off & bpf_htons(IPV4_MF_OFFSET))
+            return;
+        tcp = ip + ((ip4->ihl * 4) & 0x3c);
+        target = config->mss4;
+    } else {
+        struct ipv6hdr *ip6 = ip;
+        if ((void *)(ip6 + 1) > data_end || ip6->nexthdr != IPPROTO_TCP)
+            return;
+        tcp = (void *)(ip6 + 1);
+        target = config->mss6;
+    }
+    if ((void *)(tcp + 1) > data_end)
+        return;
+    stats->mss_syns += 1;
+
+    __u8 *opt = (void *)(tcp + 1);
+    __u8 *end = (void *)tcp + ((tcp->doff * 4) & 0x3c);
+    for (int i = 0; i < TCP_OPTIONS_MAX; i++) {
+        if ((void *)(opt + 1) > data_end || opt >= end || opt[0] == TCPOPT_EOL)
+            break;
+        if (opt[0] == TCPOPT_NOP) {
+            opt += 1;
+            continue;
+        }
+        if ((void *)(opt + 2) > data_end || opt[1] < 2)
+            break;
+        if (opt[0] != TCPOPT_MSS) {
+            opt += opt[1];
+            continue;
+        }
+        if (opt[1] != TCPOLEN_MSS || (void *)(opt + TCPOLEN_MSS) > data_end)
+            break;
+        __be16 *mss = (void *)(opt + 2);
+        if (bpf_ntohs(*mss) <= target)
+            return;
+        // The field is summed as a 16-bit word of the header; at an odd
+        // offset its bytes straddle two words
+        __be32 from = *mss, to = bpf_htons(target);
+        if (((void *)mss - (void *)tcp) & 1) {
+            from <<= 8;
+            to <<= 8;
+        }
+        *mss = bpf_htons(target);
+        tcp->check = csum_replace(tcp->check, &from, sizeof(from), &to, sizeof(to));
+        stats->mss_clamped += 1;
+        return;
+    }
+    stats->mss_missing += 1;
+}
+
+// Verdict of a parsed IP packet given the action of the rule it matched,
+// if any. Packets that are not dropped are recorded in the flow table.
+static __always_inline int packet_verdict(struct ct_ctx *ct, int matched, __u8 action,
+                                          __u64 bytes, int xdp) {
     __u32 queue_id = 0;  // Default queue
 
-    // Parse Ethernet header
-    struct ethhdr *eth = data;
-    if ((void *)(eth + 1) > data_end) {
-        update_stats(STAT_ERROR);
-        return XDP_ABORTED;
+    // Port scans are counted whatever the rules decide
+    portscan_count(ct);
+
+    // Source rate limits, SYN flood mitigation and protection profiles
+    // apply to what the rules let through
+    if (!(matched && (action == ACTION_DROP || action == ACTION_REJECT))) {
+        if (srclimit_drop(ct)) {
+            update_stats(STAT_DROP);
+            return XDP_DROP;
+        }
+        int syn = synflood_check(ct, xdp);
+        if (syn != SYNFLOOD_PASS || protect_drop(ct)) {
+            ct->syn_cookie = syn == SYNFLOOD_ANSWER;
+            update_stats(STAT_DROP);
+            return XDP_DROP;
+        }
     }
 
-    // Only process IPv4 packets
-    if (eth->h_proto != bpf_htons(ETH_P_IP)) {
+    if (matched) {
+        if (action != ACTION_DROP && action != ACTION_REJECT)
+            ct_update(ct, bytes);
+        ct->reject = action == ACTION_REJECT;
+        return rule_verdict(action, queue_id, xdp);
+    }
+
+    // A configured default policy replaces the built-in defaults below
+    __u8 policy = default_action(ct->ifindex, 0);
+    if (policy == DEFAULT_DROP) {
+        update_stats(STAT_DROP);
+        return XDP_DROP;
+    }
+    if (policy == DEFAULT_ALLOW) {
+        ct_update(ct, bytes);
         update_stats(STAT_PASS);
         return XDP_PASS;
     }
 
-    // Parse IP header
-    struct iphdr *ip = (void *)(eth + 1);
-    if ((void *)(ip + 1) > data_end) {
-        update_stats(STAT_ERROR);
-        return XDP_ABORTED;
+    // Only IPv4 gets the built-in defaults below
+    if (ct->key.family == 6) {
+        ct_update(ct, bytes);
+        update_stats(STAT_PASS);
+        return XDP_PASS;
     }
 
     // Drop ICMP packets (DDoS protection)
-    if (ip->protocol == IPPROTO_ICMP) {
+    if (ct->key.protocol == IPPROTO_ICMP) {
         update_stats(STAT_DROP);
         return XDP_DROP;
     }
 
+    ct_update(ct, bytes);
+
     // Redirect TCP packets to userspace via AF_XDP
-    if (ip->protocol == IPPROTO_TCP) {
-        update_stats(STAT_REDIRECT);
-        return bpf_redirect_map(&xsk_map, queue_id, 0);
-    }
+    if (ct->key.protocol == IPPROTO_TCP)
+        return redirect_verdict(queue_id, xdp);
 
     // Pass all other traffic (UDP, etc.)
     update_stats(STAT_PASS);
     return XDP_PASS;
-} 
\ No newline at end of file
+}
+
+// Filter one packet, leaving its parsed headers in *ct. Returns an XDP
+// action; xdp is 0 when called from the TC ingress fallback.
+static __always_inline int filter_packet(void *data, void *data_end, __u64 bytes,
+                                         struct ct_ctx *ct, int xdp) {
+    __u32 degrade = degrade_flags();
+
+    if (degrade & DEGRADE_NO_SLOW_PATH)
+        xdp = 0;
+
+    if (police_drop(ct->ifindex, bytes)) {
+        update_stats(STAT_DROP);
+        count_disposition(DISP_POLICED, XDP_DROP);
+        return XDP_DROP;
+    }
+
+    int parsed = parse_ingress(ct, data, data_end);
+    if (parsed < 0) {
+        update_stats(STAT_ERROR);
+        count_disposition(DISP_PARSE_ERROR, XDP_ABORTED);
+        return XDP_ABORTED;
+    }
+    if (!parsed) {
+        update_stats(STAT_PASS);
+        count_disposition(DISP_NON_IP, XDP_PASS);
+        return XDP_PASS;
+    }
+    if (ct->norm) {
+        update_stats(STAT_DROP);
+        count_disposition(DISP_NORMALIZED, XDP_DROP);
+        return XDP_DROP;
+    }
+
+    // Control plane rules take precedence over the built-in defaults
+    struct fw_rule *rule = NULL;
+    if (!(degrade & DEGRADE_DEFAULT_ONLY)) {
+        struct rule_set src, dst;
+        if (!bloom_negative(&ct->key) && lookup_candidates(&ct->key, &src, &dst))
+            rule = match_ingress(ct, &src, &dst, bytes);
+    }
+    int verdict;
+    if (rule) {
+        ct->log_id = rule->log_id;
+        ct->mirror = rule->action == ACTION_MIRROR ? rule->mirror_ifindex : 0;
+        verdict = packet_verdict(ct, 1, rule->action, bytes, xdp);
+        count_disposition(rule->ct_state ? DISP_CONNTRACK : DISP_RULE, verdict);
+        return verdict;
+    }
+    verdict = packet_verdict(ct, 0, 0, bytes, xdp);
+    count_disposition(default_disposition(ct, degrade & DEGRADE_DEFAULT_ONLY), verdict);
+    return verdict;
+}
+
+/*
+ * Tail-call pipeline. The control plane fills cerberus_pipeline with one
+ * XDP program per stage; xdp_firewall enters it at the parse stage and each
+ * stage tail-calls the next one present. Without a parse stage packets are
+ * filtered inline as before. An empty slot skips its stage: no flow
+ * tracking without conntrack, built-in defaults only without ipset or
+ * rules. Without an actions stage the last stage applies the verdict
+ * itself. The TC ingress fallback always filters inline.
+ */
+enum pipeline_stage {
+    STAGE_PARSE = 0,
+    STAGE_CONNTRACK = 1,
+    STAGE_IPSET = 2,     // Candidate rule slots from the prefix tries
+    STAGE_RULES = 3,
+    STAGE_ACTIONS = 4,
+    STAGE_MAX = 5,
+};
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PROG_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+    __uint(max_entries, STAGE_MAX);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_pipeline SEC(".maps");
+
+// Packet state handed from stage to stage. A packet runs all its stages on
+// one CPU, so one slot per CPU is enough. ct.entry is never stored: the
+// flow is looked up again where it is needed.
+struct pipeline_ctx {
+    struct ct_ctx ct;
+    struct ct_key quoted;    // Packet quoted by an ICMP error, family 0 = none
+    struct rule_set src;     // Candidate slots of the source address
+    struct rule_set dst;     // Candidate slots of the destination address
+    __u64 bytes;
+    __u8  tracked;           // Conntrack stage ran
+    __u8  candidates;        // src and dst were found
+    __u8  matched;           // A rule matched, action is its action
+    __u8  action;
+    __u8  disposition;       // enum disposition of a matched rule
+    __u8  pad[3];
+};
+
+// Pinned so that stages loaded from another object share it
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(struct pipeline_ctx));
+    __uint(max_entries, 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_pipeline_ctx SEC(".maps");
+
+// Packets entering each stage, plus STAGE_INLINE for packets filtered
+// without the pipeline
+#define STAGE_INLINE STAGE_MAX
+
+struct {
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u64));
+    __uint(max_entries, STAGE_MAX + 1);
+    __uint(pinning, LIBBPF_PIN_BY_NAME);
+} cerberus_stage_stats SEC(".maps");
+
+static __always_inline void count_stage(__u32 stage) {
+    __u64 *value = bpf_map_lookup_elem(&cerberus_stage_stats, &stage);
+    if (value)
+        __sync_fetch_and_add(value, 1);
+}
+
+static __always_inline struct pipeline_ctx *pipeline_state(void) {
+    __u32 key = 0;
+    return bpf_map_lookup_elem(&cerberus_pipeline_ctx, &key);
+}
+
+static __always_inline int pipeline_error(void) {
+    update_stats(STAT_ERROR);
+    return XDP_ABORTED;
+}
+
+// Find the flow of a classified packet again, in the direction
+// ct_classify found it
+static __always_inline void ct_refresh(struct ct_ctx *ct) {
+    struct ct_key rev = {};
+
+    ct->entry = NULL;
+    if (!ct->trackable)
+        return;
+    if (!ct->reply) {
+        ct->entry = bpf_map_lookup_elem(&cerberus_conntrack, &ct->key);
+        return;
+    }
+    ct_reverse(&ct->key, &rev);
+    ct->entry = bpf_map_lookup_elem(&cerberus_conntrack, &rev);
+}
+
+// The actions stage: apply the verdict, update the flow and sample
+static __always_inline int pipeline_actions(struct xdp_md *ctx, struct pipeline_ctx *p) {
+    struct ct_ctx ct = p->ct;
+    __u32 degrade = degrade_flags();
+    int xdp = !(degrade & DEGRADE_NO_SLOW_PATH);
+
+    if (p->tracked)
+        ct_refresh(&ct);
+    else
+        ct.trackable = 0;
+
+    int verdict = packet_verdict(&ct, p->matched, p->action, p->bytes, xdp);
+    if (p->matched)
+        count_disposition(p->disposition, verdict);
+    else
+        count_disposition(default_disposition(&ct, degrade & DEGRADE_DEFAULT_ONLY), verdict);
+    verdict = dns_filter(ctx, &ct, verdict);
+    verdict = tls_filter(ctx, &ct, verdict);
+    verdict = synflood_filter(ctx, &ct, verdict);
+    if (verdict == XDP_PASS || verdict == XDP_REDIRECT) {
+        mss_clamp(&ct, (void *)(long)ctx->data, (void *)(long)ctx->data_end, 0);
+        dnat_reply(&ct, (void *)(long)ctx->data, (void *)(long)ctx->data_end);
+    }
+    sample_packet(&ct, p->bytes, verdict);
+    log_packet(&ct, p->bytes, verdict, 0);
+    mcast_count(&ct, p->bytes, verdict);
+    protocol_count(&ct, p->bytes, verdict);
+    mcast_report(&ct, (void *)(long)ctx->data, (void *)(long)ctx->data_end, verdict, 0);
+    if (verdict == XDP_DROP) {
+        tunnel_drop(&ct);
+        reject_packet(&ct, (void *)(long)ctx->data, (void *)(long)ctx->data_end, 0);
+    }
+    nat64_out(ctx, &ct, verdict);
+    mirror_xdp(ctx, &ct, verdict);
+    return verdict;
+}
+
+// Continue with the first stage after 'from' that is present, applying the
+// verdict here when there is none
+static __always_inline int pipeline_next(struct xdp_md *ctx, struct pipeline_ctx *p, __u32 from) {
+    for (__u32 stage = from + 1; stage < STAGE_MAX; stage++)
+        bpf_tail_call(ctx, &cerberus_pipeline, stage);
+    return pipeline_actions(ctx, p);
+}
+
+/*
+ * This is the main XDP program. It is attached to the XDP hook and
+ * will be executed for each incoming packet.
+ */
+SEC("xdp")
+int xdp_firewall(struct xdp_md *ctx) {
+    struct ct_ctx ct = {};
+    nat64_in(ctx);
+    void *data_end = (void *)(long)ctx->data_end;
+    void *data = (void *)(long)ctx->data;
+    __u64 bytes = data_end - data;
+
+    // Hand the packet to the pipeline when the control plane installed it
+    bpf_tail_call(ctx, &cerberus_pipeline, STAGE_PARSE);
+    count_stage(STAGE_INLINE);
+
+    ct.ifindex = ctx->ingress_ifindex;
+    int verdict = filter_packet(data, data_end, bytes, &ct, 1);
+    verdict = dns_filter(ctx, &ct, verdict);
+    verdict = tls_filter(ctx, &ct, verdict);
+    verdict = synflood_filter(ctx, &ct, verdict);
+    if (verdict == XDP_PASS || verdict == XDP_REDIRECT) {
+        mss_clamp(&ct, data, data_end, 0);
+        dnat_reply(&ct, data, data_end);
+    }
+    sample_packet(&ct, bytes, verdict);
+    log_packet(&ct, bytes, verdict, 0);
+    mcast_count(&ct, bytes, verdict);
+    protocol_count(&ct, bytes, verdict);
+    mcast_report(&ct, data, data_end, verdict, 0);
+    if (verdict == XDP_DROP) {
+        tunnel_drop(&ct);
+        reject_packet(&ct, data, data_end, 0);
+    }
+    nat64_out(ctx, &ct, verdict);
+    mirror_xdp(ctx, &ct, verdict);
+    return verdict;
+}
+
+/*
+ * Ingress fallback for interfaces that cannot run XDP at all, attached as a
+ * direct-action clsact filter. It enforces the same rules and defaults;
+ * packets XDP would redirect to AF_XDP go to the stack.
+ */
+SEC("tc")
+int tc_ingress(struct __sk_buff *skb) {
+    void *data_end = (void *)(long)skb->data_end;
+    void *data = (void *)(long)skb->data;
+    struct ct_ctx ct = { .ifindex = skb->ifindex, .vlan = skb_vlan(skb) };
+
+    count_stage(STAGE_INLINE);
+    int verdict = filter_packet(data, data_end, skb->len, &ct, 0);
+    sample_packet(&ct, skb->len, verdict);
+    log_packet(&ct, skb->len, verdict, 0);
+    mcast_count(&ct, skb->len, verdict);
+    protocol_count(&ct, skb->len, verdict);
+    mcast_report(&ct, data, data_end, verdict, 0);
+    if (verdict == XDP_DROP || verdict == XDP_ABORTED) {
+        tunnel_drop(&ct);
+        reject_packet(&ct, data, data_end, 0);
+        return TC_ACT_SHOT;
+    }
+    if (verdict == XDP_PASS) {
+        mss_clamp(&ct, data, data_end, 0);
+        dnat_reply(&ct, data, data_end);
+        mirror_skb(skb, ct.mirror);
+    }
+    return TC_ACT_OK;
+}
+
+/*
+ * Egress filter, attached as a direct-action clsact filter. Outbound rules
+ * only drop, reject, pass or mirror; redirect is an ingress action. Packets
+ * it passes are recorded in the flow table, so replies arrive at XDP as
+ * established.
+ * Packets no rule matches get the egress default policy, pass if none is
+ * configured. Verdicts are not counted in stats_map, which reports ingress.
+ * Replies of DNAT flows to this host are rewritten to the public side here,
+ * and the sources of SNAT rules to the rule's public address and port.
+ */
+SEC("tc")
+int tc_egress(struct __sk_buff *skb) {
+    void *data_end = (void *)(long)skb->data_end;
+    void *data = (void *)(long)skb->data;
+    struct ct_ctx ct = { .ifindex = skb->ifindex, .vlan = skb_vlan(skb) };
+
+    if (parse_packet(&ct, data, data_end) <= 0)
+        return TC_ACT_OK;
+
+    struct fw_rule *rule = NULL;
+    if (!(degrade_flags() & DEGRADE_DEFAULT_ONLY)) {
+        if (ct.key.family == 6)
+            rule = match_rules6(&ct, &cerberus_out6, &cerberus_ohits6, &cerberus_osrc6, &cerberus_odst6, skb->len);
+        else
+            rule = match_rules(&ct, &cerberus_out4, &cerberus_ohits4, &cerberus_osrc4, &cerberus_odst4, skb->len);
+    }
+    if (rule) {
+        ct.log_id = rule->log_id;
+        ct.mirror = rule->action == ACTION_MIRROR ? rule->mirror_ifindex : 0;
+    }
+    if ((rule && (rule->action == ACTION_DROP || rule->action == ACTION_REJECT)) ||
+        (!rule && default_action(ct.ifindex, 1) == DEFAULT_DROP)) {
+        log_packet(&ct, skb->len, XDP_DROP, 1);
+        tunnel_drop(&ct);
+        ct.reject = rule && rule->action == ACTION_REJECT;
+        reject_packet(&ct, data, data_end, 1);
+        return TC_ACT_SHOT;
+    }
+
+    log_packet(&ct, skb->len, XDP_PASS, 1);
+    mcast_report(&ct, data, data_end, XDP_PASS, 1);
+    mss_clamp(&ct, data, data_end, 1);
+    // Replies translated on the way in already carry the public side,
+    // which is no flow of its own
+    if (!dnat_lookup(&ct.key, 1))
+        ct_update(&ct, skb->len);
+    dnat_reply(&ct, data, data_end);
+    snat_out(&ct, data, data_end);
+    mirror_skb(skb, ct.mirror);
+    return TC_ACT_OK;
+}
+
+/*
+ * Mirror copies of packets XDP passed, attached as a direct-action clsact
+ * ingress filter next to the XDP program. Every packet continues to the
+ * stack.
+ */
+SEC("tc")
+int tc_mirror(struct __sk_buff *skb) {
+    void *data = (void *)(long)skb->data;
+    struct mirror_meta *meta = (void *)(long)skb->data_meta;
+
+    if ((void *)(meta + 1) > data || meta->magic != MIRROR_META_MAGIC)
+        return TC_ACT_OK;
+    mirror_skb(skb, meta->ifindex);
+    return TC_ACT_OK;
+}
+
+/*
+ * Pipeline stages, installed in cerberus_pipeline by the control plane
+ * (see ctrl/pipeline.go). Each can be replaced at runtime by the program of
+ * the same name in another object built against these maps.
+ */
+SEC("xdp")
+int xdp_stage_parse(struct xdp_md *ctx) {
+    void *data_end = (void *)(long)ctx->data_end;
+    void *data = (void *)(long)ctx->data;
+    struct pipeline_ctx *p = pipeline_state();
+
+    count_stage(STAGE_PARSE);
+    if (!p)
+        return pipeline_error();
+    __builtin_memset(p, 0, sizeof(*p));
+    p->bytes = data_end - data;
+    p->ct.ifindex = ctx->ingress_ifindex;
+    if (police_drop(p->ct.ifindex, p->bytes)) {
+        update_stats(STAT_DROP);
+        count_disposition(DISP_POLICED, XDP_DROP);
+        return XDP_DROP;
+    }
+
+    int parsed = parse_headers(&p->ct, &p->quoted, data, data_end);
+    if (parsed < 0) {
+        count_disposition(DISP_PARSE_ERROR, XDP_ABORTED);
+        return pipeline_error();
+    }
+    if (!parsed) {
+        update_stats(STAT_PASS);
+        count_disposition(DISP_NON_IP, XDP_PASS);
+        return XDP_PASS;
+    }
+    p->ct.norm = normalize(&p->ct, data, data_end);
+    if (p->ct.norm) {
+        update_stats(STAT_DROP);
+        count_disposition(DISP_NORMALIZED, XDP_DROP);
+        protocol_count(&p->ct, p->bytes, XDP_DROP);
+        return XDP_DROP;
+    }
+    if (!snat_in(&p->ct, data, data_end))
+        dnat_in(&p->ct, data, data_end);
+    p->ct.state = CT_STATE_NEW;
+    return pipeline_next(ctx, p, STAGE_PARSE);
+}
+
+SEC("xdp")
+int xdp_stage_conntrack(struct xdp_md *ctx) {
+    struct pipeline_ctx *p = pipeline_state();
+
+    count_stage(STAGE_CONNTRACK);
+    if (!p)
+        return pipeline_error();
+
+    struct ct_ctx ct = p->ct;
+    ct_classify(&ct);
+    if (p->quoted.family)
+        ct_related(&ct, &p->quoted);
+    p->ct.reply = ct.reply;
+    p->ct.state = ct.state;
+    p->tracked = 1;
+    return pipeline_next(ctx, p, STAGE_CONNTRACK);
+}
+
+SEC("xdp")
+int xdp_stage_ipset(struct xdp_md *ctx) {
+    struct pipeline_ctx *p = pipeline_state();
+
+    count_stage(STAGE_IPSET);
+    if (!p)
+        return pipeline_error();
+    if (!(degrade_flags() & DEGRADE_DEFAULT_ONLY))
+        p->candidates = !bloom_negative(&p->ct.key) &&
+                        lookup_candidates(&p->ct.key, &p->src, &p->dst);
+    return pipeline_next(ctx, p, STAGE_IPSET);
+}
+
+SEC("xdp")
+int xdp_stage_rules(struct xdp_md *ctx) {
+    struct pipeline_ctx *p = pipeline_state();
+
+    count_stage(STAGE_RULES);
+    if (!p)
+        return pipeline_error();
+    if (p->candidates && !(degrade_flags() & DEGRADE_DEFAULT_ONLY)) {
+        struct fw_rule *rule = match_ingress(&p->ct, &p->src, &p->dst, p->bytes);
+        if (rule) {
+            p->matched = 1;
+            p->action = rule->action;
+            p->ct.log_id = rule->log_id;
+            p->ct.mirror = rule->action == ACTION_MIRROR ? rule->mirror_ifindex : 0;
+            p->disposition = rule->ct_state ? DISP_CONNTRACK : DISP_RULE;
+        }
+    }
+    return pipeline_next(ctx, p, STAGE_RULES);
+}
+
+SEC("xdp")
+int xdp_stage_actions(struct xdp_md *ctx) {
+    struct pipeline_ctx *p = pipeline_state();
+
+    count_stage(STAGE_ACTIONS);
+    if (!p)
+        return pipeline_error();
+    return pipeline_actions(ctx, p);
+}
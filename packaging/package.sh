@@ -237,6 +237,7 @@ build_tar_package() {
     
     # Copy binaries
     [[ -f "ctrl/cerberus-ctrl" ]] && cp "ctrl/cerberus-ctrl" "$package_dir/bin/"
+    [[ -f "ctrl/cerberus-ctrl" ]] && ln -sf cerberus-ctrl "$package_dir/bin/cerberusctl"
     [[ -f "userspace/af_xdp_loader" ]] && cp "userspace/af_xdp_loader" "$package_dir/bin/"
     
     # Copy libraries
@@ -15,7 +15,7 @@ License:        Apache-2.0
 URL:            https://github.com/m4rba4s/Cerberus-V
 Source0:        %{name}-%{version}.tar.gz
 
-BuildRequires:  golang >= 1.21
+BuildRequires:  golang >= 1.22
 BuildRequires:  gcc
 BuildRequires:  make
 BuildRequires:  clang >= 14
@@ -123,6 +123,7 @@ install -d %{buildroot}%{_datadir}/cerberus-v/gui
 
 # Install binaries
 install -m 755 ctrl/cerberus-ctrl %{buildroot}%{_sbindir}/
+ln -s cerberus-ctrl %{buildroot}%{_sbindir}/cerberusctl
 install -m 755 userspace/af_xdp_loader %{buildroot}%{_bindir}/cerberus-loader
 install -m 755 scripts/setup.sh %{buildroot}%{_bindir}/cerberus-setup
 
@@ -288,6 +289,7 @@ fi
 
 # Binaries
 %{_sbindir}/cerberus-ctrl
+%{_sbindir}/cerberusctl
 %{_bindir}/cerberus-loader
 %{_bindir}/cerberus-setup
 
@@ -0,0 +1,21942 @@
+// SPDX-License-Identifier: Apache-2.0
+// Author: funcybot@gmail.com  Date: 2025-06-26
+// Cerberus-V gRPC API Schema
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.29.3
+// source: firewall.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Common types
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{0}
+}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                   // "allow", "drop", "redirect", "reject" (drop answered with TCP RST or ICMP unreachable), "mirror" (allow and copy to mirror_to)
+	SrcIp       string `protobuf:"bytes,3,opt,name=src_ip,json=srcIp,proto3" json:"src_ip,omitempty"`        // IPv4 or IPv6 CIDR, e.g., "192.168.1.0/24", "2001:db8::/32"
+	DstIp       string `protobuf:"bytes,4,opt,name=dst_ip,json=dstIp,proto3" json:"dst_ip,omitempty"`        // IPv4 or IPv6 CIDR, same family as src_ip
+	SrcPort     int32  `protobuf:"varint,5,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"` // 0 = any port
+	DstPort     int32  `protobuf:"varint,6,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"` // 0 = any port
+	Protocol    string `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"`               // "tcp", "udp", "sctp", "icmp", "gre", "ipip", "ipv6", "any", or the number of an unnamed protocol
+	Direction   string `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`             // "inbound", "outbound", "both"
+	Priority    int32  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`              // Lower number = higher priority
+	Enabled     bool   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt   int64  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	// Advanced fields
+	//
+	// Deprecated: Marked as deprecated in firewall.proto.
+	GeoipCountry string `protobuf:"bytes,14,opt,name=geoip_country,json=geoipCountry,proto3" json:"geoip_country,omitempty"` // v1 form of src_country, comma separated, e.g. "US,CN,RU"; read when src_country is empty
+	// Deprecated: Marked as deprecated in firewall.proto.
+	RateLimit int32 `protobuf:"varint,15,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"` // v1 per-rule limit, never enforced; rejected unless 0, see SetSourceLimitConfig
+	// Deprecated: Marked as deprecated in firewall.proto.
+	LogLevel      string            `protobuf:"bytes,16,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`                                                                     // v1 form of log: "info" and "debug" turn it on, "none" leaves it
+	Stateful      bool              `protobuf:"varint,17,opt,name=stateful,proto3" json:"stateful,omitempty"`                                                                                    // Enable connection tracking
+	Owner         string            `protobuf:"bytes,18,opt,name=owner,proto3" json:"owner,omitempty"`                                                                                           // Team or person responsible for the rule
+	Service       string            `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`                                                                                       // Named service object; replaces protocol/dst_port
+	SrcAddress    string            `protobuf:"bytes,20,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`                                                               // Named address object; replaces src_ip
+	DstAddress    string            `protobuf:"bytes,21,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`                                                               // Named address object; replaces dst_ip
+	Family        string            `protobuf:"bytes,22,opt,name=family,proto3" json:"family,omitempty"`                                                                                         // Output only: "ipv4", "ipv6" or "any"
+	Namespace     string            `protobuf:"bytes,23,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                                   // Network namespace enforcing the rule, empty = host
+	SrcPortRange  *PortRange        `protobuf:"bytes,24,opt,name=src_port_range,json=srcPortRange,proto3" json:"src_port_range,omitempty"`                                                       // Overrides src_port when set
+	DstPortRange  *PortRange        `protobuf:"bytes,25,opt,name=dst_port_range,json=dstPortRange,proto3" json:"dst_port_range,omitempty"`                                                       // Overrides dst_port when set
+	Vf            string            `protobuf:"bytes,26,opt,name=vf,proto3" json:"vf,omitempty"`                                                                                                 // VF data plane enforcing the rule, e.g. "enp3s0f0/vf3"
+	OffloadStatus string            `protobuf:"bytes,27,opt,name=offload_status,json=offloadStatus,proto3" json:"offload_status,omitempty"`                                                      // Output only: "software", "offloaded", "fallback", "unverified"
+	OffloadReason string            `protobuf:"bytes,28,opt,name=offload_reason,json=offloadReason,proto3" json:"offload_reason,omitempty"`                                                      // Output only: why the rule is not offloaded
+	ConnState     []string          `protobuf:"bytes,29,rep,name=conn_state,json=connState,proto3" json:"conn_state,omitempty"`                                                                  // "new", "established", "related"; empty = any
+	HitPackets    uint64            `protobuf:"varint,30,opt,name=hit_packets,json=hitPackets,proto3" json:"hit_packets,omitempty"`                                                              // Output only: packets matched by the data plane
+	HitBytes      uint64            `protobuf:"varint,31,opt,name=hit_bytes,json=hitBytes,proto3" json:"hit_bytes,omitempty"`                                                                    // Output only: bytes matched by the data plane
+	LastHit       int64             `protobuf:"varint,32,opt,name=last_hit,json=lastHit,proto3" json:"last_hit,omitempty"`                                                                       // Output only: Unix timestamp of the latest match, 0 = never
+	ExpiresAt     int64             `protobuf:"varint,33,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                                 // Unix timestamp the rule is removed at, 0 = never
+	TtlSeconds    int64             `protobuf:"varint,34,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`                                                              // Input only: expire this long after the request, overrides expires_at; at most a year
+	Labels        map[string]string `protobuf:"bytes,35,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Free-form labels, e.g. "tenant": "acme"; a tag has an empty value
+	SrcSet        uint32            `protobuf:"varint,36,opt,name=src_set,json=srcSet,proto3" json:"src_set,omitempty"`                                                                          // IP set ID the source address must be in, 0 = none
+	DstSet        uint32            `protobuf:"varint,37,opt,name=dst_set,json=dstSet,proto3" json:"dst_set,omitempty"`                                                                          // IP set ID the destination address must be in, 0 = none
+	Log           bool              `protobuf:"varint,38,opt,name=log,proto3" json:"log,omitempty"`                                                                                              // Matched packets are published as RULE_LOG events (5-tuple, verdict, rule ID)
+	MirrorTo      string            `protobuf:"bytes,39,opt,name=mirror_to,json=mirrorTo,proto3" json:"mirror_to,omitempty"`                                                                     // Interface mirror rules copy packets to, e.g. an IDS or capture port
+	TcpFlags      string            `protobuf:"bytes,40,opt,name=tcp_flags,json=tcpFlags,proto3" json:"tcp_flags,omitempty"`                                                                     // TCP only, "flags/mask": "syn/syn,ack" is a bare SYN, "syn,fin" needs both set; empty = any
+	SrcMac        string            `protobuf:"bytes,41,opt,name=src_mac,json=srcMac,proto3" json:"src_mac,omitempty"`                                                                           // Source MAC address, e.g. "02:42:ac:11:00:02"; empty = any
+	DstMac        string            `protobuf:"bytes,42,opt,name=dst_mac,json=dstMac,proto3" json:"dst_mac,omitempty"`                                                                           // Destination MAC address; empty = any
+	Vlan          int32             `protobuf:"varint,43,opt,name=vlan,proto3" json:"vlan,omitempty"`                                                                                            // VLAN ID of the packet's outer 802.1Q/802.1ad tag, 1-4094; 0 = any
+	Interfaces    []string          `protobuf:"bytes,44,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                                                 // Attached interfaces the rule applies on, e.g. "eth0" for a WAN uplink; empty = all
+	SrcCountry    []string          `protobuf:"bytes,45,rep,name=src_country,json=srcCountry,proto3" json:"src_country,omitempty"`                                                               // ISO 3166 codes of the source address per the GeoIP database, e.g. "NL"; empty = any
+	DstCountry    []string          `protobuf:"bytes,46,rep,name=dst_country,json=dstCountry,proto3" json:"dst_country,omitempty"`                                                               // ISO 3166 codes of the destination address; empty = any
+	SrcAsn        []uint32          `protobuf:"varint,47,rep,packed,name=src_asn,json=srcAsn,proto3" json:"src_asn,omitempty"`                                                                   // Origin ASNs of the source address per the ASN database; empty = any
+	DstAsn        []uint32          `protobuf:"varint,48,rep,packed,name=dst_asn,json=dstAsn,proto3" json:"dst_asn,omitempty"`                                                                   // Origin ASNs of the destination address; empty = any
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcIp() string {
+	if x != nil {
+		return x.SrcIp
+	}
+	return ""
+}
+
+func (x *Rule) GetDstIp() string {
+	if x != nil {
+		return x.DstIp
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcPort() int32 {
+	if x != nil {
+		return x.SrcPort
+	}
+	return 0
+}
+
+func (x *Rule) GetDstPort() int32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *Rule) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Rule) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Rule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Rule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Rule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Rule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Rule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in firewall.proto.
+func (x *Rule) GetGeoipCountry() string {
+	if x != nil {
+		return x.GeoipCountry
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in firewall.proto.
+func (x *Rule) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in firewall.proto.
+func (x *Rule) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *Rule) GetStateful() bool {
+	if x != nil {
+		return x.Stateful
+	}
+	return false
+}
+
+func (x *Rule) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Rule) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *Rule) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *Rule) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *Rule) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcPortRange() *PortRange {
+	if x != nil {
+		return x.SrcPortRange
+	}
+	return nil
+}
+
+func (x *Rule) GetDstPortRange() *PortRange {
+	if x != nil {
+		return x.DstPortRange
+	}
+	return nil
+}
+
+func (x *Rule) GetVf() string {
+	if x != nil {
+		return x.Vf
+	}
+	return ""
+}
+
+func (x *Rule) GetOffloadStatus() string {
+	if x != nil {
+		return x.OffloadStatus
+	}
+	return ""
+}
+
+func (x *Rule) GetOffloadReason() string {
+	if x != nil {
+		return x.OffloadReason
+	}
+	return ""
+}
+
+func (x *Rule) GetConnState() []string {
+	if x != nil {
+		return x.ConnState
+	}
+	return nil
+}
+
+func (x *Rule) GetHitPackets() uint64 {
+	if x != nil {
+		return x.HitPackets
+	}
+	return 0
+}
+
+func (x *Rule) GetHitBytes() uint64 {
+	if x != nil {
+		return x.HitBytes
+	}
+	return 0
+}
+
+func (x *Rule) GetLastHit() int64 {
+	if x != nil {
+		return x.LastHit
+	}
+	return 0
+}
+
+func (x *Rule) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Rule) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *Rule) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Rule) GetSrcSet() uint32 {
+	if x != nil {
+		return x.SrcSet
+	}
+	return 0
+}
+
+func (x *Rule) GetDstSet() uint32 {
+	if x != nil {
+		return x.DstSet
+	}
+	return 0
+}
+
+func (x *Rule) GetLog() bool {
+	if x != nil {
+		return x.Log
+	}
+	return false
+}
+
+func (x *Rule) GetMirrorTo() string {
+	if x != nil {
+		return x.MirrorTo
+	}
+	return ""
+}
+
+func (x *Rule) GetTcpFlags() string {
+	if x != nil {
+		return x.TcpFlags
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcMac() string {
+	if x != nil {
+		return x.SrcMac
+	}
+	return ""
+}
+
+func (x *Rule) GetDstMac() string {
+	if x != nil {
+		return x.DstMac
+	}
+	return ""
+}
+
+func (x *Rule) GetVlan() int32 {
+	if x != nil {
+		return x.Vlan
+	}
+	return 0
+}
+
+func (x *Rule) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Rule) GetSrcCountry() []string {
+	if x != nil {
+		return x.SrcCountry
+	}
+	return nil
+}
+
+func (x *Rule) GetDstCountry() []string {
+	if x != nil {
+		return x.DstCountry
+	}
+	return nil
+}
+
+func (x *Rule) GetSrcAsn() []uint32 {
+	if x != nil {
+		return x.SrcAsn
+	}
+	return nil
+}
+
+func (x *Rule) GetDstAsn() []uint32 {
+	if x != nil {
+		return x.DstAsn
+	}
+	return nil
+}
+
+// Inclusive port range, e.g. 1024-65535
+type PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortRange) ProtoMessage() {}
+
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PortRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                    // "RULE_MATCH", "PACKET_DROP", "CONNECTION_NEW", etc.
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`         // Unix timestamp
+	Source    string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                // Source IP
+	Target    string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`                // Destination IP
+	Protocol  string `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`            // Protocol
+	Port      int32  `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`                   // Port number
+	Message   string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`              // Human-readable message
+	Severity  string `protobuf:"bytes,9,opt,name=severity,proto3" json:"severity,omitempty"`            // "low", "medium", "high", "critical"
+	RuleId    string `protobuf:"bytes,10,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Matching rule ID (if applicable)
+	Bytes     int64  `protobuf:"varint,11,opt,name=bytes,proto3" json:"bytes,omitempty"`                // Packet/flow bytes
+	Interface string `protobuf:"bytes,12,opt,name=interface,proto3" json:"interface,omitempty"`         // Network interface
+	// Additional context
+	Metadata map[string]string `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Publishing node and its event sequence number; id is built from both.
+	// Sequence numbers of a node only grow, also across restarts, so a gap
+	// means lost events, except for a jump after an unclean shutdown.
+	Node     string `protobuf:"bytes,14,opt,name=node,proto3" json:"node,omitempty"`
+	Sequence uint64 `protobuf:"varint,15,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Event) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Event) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Event) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Event) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Event) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *Event) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Event) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Event) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Event) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types     []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`          // Event types to deliver, empty = all
+	Anonymize bool     `protobuf:"varint,2,opt,name=anonymize,proto3" json:"anonymize,omitempty"` // Anonymize addresses as configured with CERBERUS_ANONYMIZE
+	// Filter expression selecting events, e.g.
+	// severity >= medium && dst_port == 22 && src_ip in 10.0.0.0/8. Fields
+	// are type, severity, src_ip, dst_ip, protocol, dst_port, bytes, rule_id,
+	// message and the metadata keys; empty = all.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetAnonymize() bool {
+	if x != nil {
+		return x.Anonymize
+	}
+	return false
+}
+
+func (x *SubscribeEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type Statistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalPackets      uint64 `protobuf:"varint,1,opt,name=total_packets,json=totalPackets,proto3" json:"total_packets,omitempty"`
+	TotalBytes        uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	DroppedPackets    uint64 `protobuf:"varint,3,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`
+	AllowedPackets    uint64 `protobuf:"varint,4,opt,name=allowed_packets,json=allowedPackets,proto3" json:"allowed_packets,omitempty"`
+	RedirectedPackets uint64 `protobuf:"varint,5,opt,name=redirected_packets,json=redirectedPackets,proto3" json:"redirected_packets,omitempty"`
+	ActiveRules       int32  `protobuf:"varint,6,opt,name=active_rules,json=activeRules,proto3" json:"active_rules,omitempty"`
+	ActiveConnections int32  `protobuf:"varint,7,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	Uptime            int64  `protobuf:"varint,8,opt,name=uptime,proto3" json:"uptime,omitempty"` // Seconds since start
+	// Performance metrics
+	CpuUsage       float64 `protobuf:"fixed64,9,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`                    // Percentage
+	MemoryUsage    uint64  `protobuf:"varint,10,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`           // Bytes
+	ThroughputMbps float64 `protobuf:"fixed64,11,opt,name=throughput_mbps,json=throughputMbps,proto3" json:"throughput_mbps,omitempty"` // Megabits per second
+	LatencyUs      float64 `protobuf:"fixed64,12,opt,name=latency_us,json=latencyUs,proto3" json:"latency_us,omitempty"`                // Microseconds average
+	// Per-interface breakdown
+	Interfaces      []*InterfaceStats `protobuf:"bytes,13,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	DefaultPolicies []*DefaultPolicy  `protobuf:"bytes,14,rep,name=default_policies,json=defaultPolicies,proto3" json:"default_policies,omitempty"` // Verdicts of packets no rule matches
+	// Ingress traffic of the host data plane by L4 protocol, only protocols
+	// that carried traffic
+	Protocols []*ProtocolStats `protobuf:"bytes,15,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	// Packet counts are totals since counters_since, continued across
+	// control plane restarts and data plane reloads; 0 = since the data
+	// plane was loaded
+	CountersSince int64  `protobuf:"varint,16,opt,name=counters_since,json=countersSince,proto3" json:"counters_since,omitempty"`
+	CounterResets uint64 `protobuf:"varint,17,opt,name=counter_resets,json=counterResets,proto3" json:"counter_resets,omitempty"` // Data plane reloads within the totals
+	// Metrics of site-specific extensions, by source name
+	Extensions []*ExtensionStats `protobuf:"bytes,18,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *Statistics) Reset() {
+	*x = Statistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Statistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statistics) ProtoMessage() {}
+
+func (x *Statistics) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statistics.ProtoReflect.Descriptor instead.
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Statistics) GetTotalPackets() uint64 {
+	if x != nil {
+		return x.TotalPackets
+	}
+	return 0
+}
+
+func (x *Statistics) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *Statistics) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *Statistics) GetAllowedPackets() uint64 {
+	if x != nil {
+		return x.AllowedPackets
+	}
+	return 0
+}
+
+func (x *Statistics) GetRedirectedPackets() uint64 {
+	if x != nil {
+		return x.RedirectedPackets
+	}
+	return 0
+}
+
+func (x *Statistics) GetActiveRules() int32 {
+	if x != nil {
+		return x.ActiveRules
+	}
+	return 0
+}
+
+func (x *Statistics) GetActiveConnections() int32 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
+}
+
+func (x *Statistics) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *Statistics) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *Statistics) GetMemoryUsage() uint64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *Statistics) GetThroughputMbps() float64 {
+	if x != nil {
+		return x.ThroughputMbps
+	}
+	return 0
+}
+
+func (x *Statistics) GetLatencyUs() float64 {
+	if x != nil {
+		return x.LatencyUs
+	}
+	return 0
+}
+
+func (x *Statistics) GetInterfaces() []*InterfaceStats {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Statistics) GetDefaultPolicies() []*DefaultPolicy {
+	if x != nil {
+		return x.DefaultPolicies
+	}
+	return nil
+}
+
+func (x *Statistics) GetProtocols() []*ProtocolStats {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *Statistics) GetCountersSince() int64 {
+	if x != nil {
+		return x.CountersSince
+	}
+	return 0
+}
+
+func (x *Statistics) GetCounterResets() uint64 {
+	if x != nil {
+		return x.CounterResets
+	}
+	return 0
+}
+
+func (x *Statistics) GetExtensions() []*ExtensionStats {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+type ProtocolStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol       string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // "tcp", "udp", "sctp", "icmp" (both families), ... or the number
+	Packets        uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes          uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	DroppedPackets uint64 `protobuf:"varint,4,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`
+}
+
+func (x *ProtocolStats) Reset() {
+	*x = ProtocolStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolStats) ProtoMessage() {}
+
+func (x *ProtocolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolStats.ProtoReflect.Descriptor instead.
+func (*ProtocolStats) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProtocolStats) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ProtocolStats) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ProtocolStats) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *ProtocolStats) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+type ExtensionMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "queue_depth"; letters, digits and underscores
+	Help   string            `protobuf:"bytes,2,opt,name=help,proto3" json:"help,omitempty"`
+	Type   string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                                                             // "counter" or "gauge", empty = gauge
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Same label names for every metric of a name
+	Value  float64           `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ExtensionMetric) Reset() {
+	*x = ExtensionMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionMetric) ProtoMessage() {}
+
+func (x *ExtensionMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionMetric.ProtoReflect.Descriptor instead.
+func (*ExtensionMetric) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExtensionMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtensionMetric) GetHelp() string {
+	if x != nil {
+		return x.Help
+	}
+	return ""
+}
+
+func (x *ExtensionMetric) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExtensionMetric) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ExtensionMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type ExtensionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source      string             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // e.g. "ids_bridge"
+	Metrics     []*ExtensionMetric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	CollectedAt int64              `protobuf:"varint,3,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // Unix timestamp of the snapshot
+	Error       string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                 // Why the snapshot failed or dropped metrics, empty = none
+	Pushed      bool               `protobuf:"varint,5,opt,name=pushed,proto3" json:"pushed,omitempty"`                              // Pushed by a companion binary, not registered in process
+	ExpiresAt   int64              `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`       // Unix timestamp a pushed snapshot is dropped at
+}
+
+func (x *ExtensionStats) Reset() {
+	*x = ExtensionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionStats) ProtoMessage() {}
+
+func (x *ExtensionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionStats.ProtoReflect.Descriptor instead.
+func (*ExtensionStats) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExtensionStats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExtensionStats) GetMetrics() []*ExtensionMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *ExtensionStats) GetCollectedAt() int64 {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return 0
+}
+
+func (x *ExtensionStats) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExtensionStats) GetPushed() bool {
+	if x != nil {
+		return x.Pushed
+	}
+	return false
+}
+
+func (x *ExtensionStats) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type PushExtensionStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source     string             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Metrics    []*ExtensionMetric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`                          // Replace the source's previous snapshot
+	TtlSeconds int64              `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Kept this long without a new push, 0 = 300
+}
+
+func (x *PushExtensionStatsRequest) Reset() {
+	*x = PushExtensionStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushExtensionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushExtensionStatsRequest) ProtoMessage() {}
+
+func (x *PushExtensionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushExtensionStatsRequest.ProtoReflect.Descriptor instead.
+func (*PushExtensionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PushExtensionStatsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PushExtensionStatsRequest) GetMetrics() []*ExtensionMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *PushExtensionStatsRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ExtensionStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources []*ExtensionStats `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *ExtensionStatsResponse) Reset() {
+	*x = ExtensionStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionStatsResponse) ProtoMessage() {}
+
+func (x *ExtensionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionStatsResponse.ProtoReflect.Descriptor instead.
+func (*ExtensionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExtensionStatsResponse) GetSources() []*ExtensionStats {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type InterfaceStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Interface name, e.g., "eth0"
+	Type        string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "ethernet", "wireless", "virtual"
+	Enabled     bool    `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RxPackets   uint64  `protobuf:"varint,4,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxPackets   uint64  `protobuf:"varint,5,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	RxBytes     uint64  `protobuf:"varint,6,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes     uint64  `protobuf:"varint,7,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxDropped   uint64  `protobuf:"varint,8,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxDropped   uint64  `protobuf:"varint,9,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+	RxErrors    uint64  `protobuf:"varint,10,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	TxErrors    uint64  `protobuf:"varint,11,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	Utilization float64 `protobuf:"fixed64,12,opt,name=utilization,proto3" json:"utilization,omitempty"` // Percentage
+	Status      string  `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`             // "up", "down", "unknown"
+}
+
+func (x *InterfaceStats) Reset() {
+	*x = InterfaceStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceStats) ProtoMessage() {}
+
+func (x *InterfaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceStats.ProtoReflect.Descriptor instead.
+func (*InterfaceStats) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InterfaceStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InterfaceStats) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InterfaceStats) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InterfaceStats) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxDropped() uint64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxDropped() uint64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SystemInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version       string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                  // Cerberus-V version
+	VppVersion    string   `protobuf:"bytes,2,opt,name=vpp_version,json=vppVersion,proto3" json:"vpp_version,omitempty"`          // VPP version
+	KernelVersion string   `protobuf:"bytes,3,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"` // Linux kernel version
+	Platform      string   `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`                                // Hardware platform
+	CpuCores      int32    `protobuf:"varint,5,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	TotalMemory   uint64   `protobuf:"varint,6,opt,name=total_memory,json=totalMemory,proto3" json:"total_memory,omitempty"` // Total system memory in bytes
+	Hugepages     uint64   `protobuf:"varint,7,opt,name=hugepages,proto3" json:"hugepages,omitempty"`                        // Available hugepages
+	Features      []string `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`                           // Enabled features
+	StartedAt     int64    `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`       // Unix timestamp when started
+	ConfigFile    string   `protobuf:"bytes,10,opt,name=config_file,json=configFile,proto3" json:"config_file,omitempty"`    // Path to configuration file
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SystemInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetVppVersion() string {
+	if x != nil {
+		return x.VppVersion
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetTotalMemory() uint64 {
+	if x != nil {
+		return x.TotalMemory
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetHugepages() uint64 {
+	if x != nil {
+		return x.Hugepages
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetConfigFile() string {
+	if x != nil {
+		return x.ConfigFile
+	}
+	return ""
+}
+
+type AddRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule            *Rule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	AllowDuplicates bool  `protobuf:"varint,2,opt,name=allow_duplicates,json=allowDuplicates,proto3" json:"allow_duplicates,omitempty"` // Add the rule even if an identical rule exists
+}
+
+func (x *AddRuleRequest) Reset() {
+	*x = AddRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRuleRequest) ProtoMessage() {}
+
+func (x *AddRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *AddRuleRequest) GetAllowDuplicates() bool {
+	if x != nil {
+		return x.AllowDuplicates
+	}
+	return false
+}
+
+type UpdateRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Rule   *Rule  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *UpdateRuleRequest) Reset() {
+	*x = UpdateRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *UpdateRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type GetRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *GetRuleRequest) Reset() {
+	*x = GetRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleRequest) ProtoMessage() {}
+
+func (x *GetRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// Replaces the whole rule set; rules without an id get a new one
+type ReplaceRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ReplaceRulesRequest) Reset() {
+	*x = ReplaceRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceRulesRequest) ProtoMessage() {}
+
+func (x *ReplaceRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReplaceRulesRequest) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ReplaceRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`    // Validation errors, one per offending rule
+	Count   int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`     // Rules in the new set
+	Version uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *ReplaceRulesResponse) Reset() {
+	*x = ReplaceRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceRulesResponse) ProtoMessage() {}
+
+func (x *ReplaceRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplaceRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReplaceRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplaceRulesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ReplaceRulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReplaceRulesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Sets the priority of many rules at once; rules not listed keep theirs
+type ReorderRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priorities map[string]int32 `protobuf:"bytes,1,rep,name=priorities,proto3" json:"priorities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Rule id -> new priority, lower wins
+}
+
+func (x *ReorderRulesRequest) Reset() {
+	*x = ReorderRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesRequest) ProtoMessage() {}
+
+func (x *ReorderRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReorderRulesRequest) GetPriorities() map[string]int32 {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+type ReorderRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`    // Unknown rule ids
+	Count   int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`     // Rules whose priority changed
+	Version uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *ReorderRulesResponse) Reset() {
+	*x = ReorderRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesResponse) ProtoMessage() {}
+
+func (x *ReorderRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReorderRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReorderRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReorderRulesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ReorderRulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReorderRulesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// One message of a rule import; all messages of the stream form one batch
+// applied when the client closes it
+type ImportRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules    []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`       // Rules without an id get a new one; existing ids are replaced
+	Replace  bool    `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`  // The batch replaces the whole rule set instead of adding to it
+	Document []byte  `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"` // A rule document as returned by ExportRules; its rules join the batch
+	Format   string  `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`     // Document format: json or yaml, empty = detect
+}
+
+func (x *ImportRulesRequest) Reset() {
+	*x = ImportRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRulesRequest) ProtoMessage() {}
+
+func (x *ImportRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRulesRequest.ProtoReflect.Descriptor instead.
+func (*ImportRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportRulesRequest) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ImportRulesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *ImportRulesRequest) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ImportRulesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`    // Validation errors, one per offending rule
+	Count   int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`     // Rules imported
+	Total   int32    `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`     // Rules in the resulting set
+	Version uint64   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *ImportRulesResponse) Reset() {
+	*x = ImportRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRulesResponse) ProtoMessage() {}
+
+func (x *ImportRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRulesResponse.ProtoReflect.Descriptor instead.
+func (*ImportRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportRulesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportRulesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportRulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ImportRulesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportRulesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Rule export: the rule set as a versioned JSON or YAML document, with the
+// services and address objects its rules reference, in evaluation order
+type ExportRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // json (default), yaml or nft (nftables script of the running policy)
+}
+
+func (x *ExportRulesRequest) Reset() {
+	*x = ExportRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRulesRequest) ProtoMessage() {}
+
+func (x *ExportRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRulesRequest.ProtoReflect.Descriptor instead.
+func (*ExportRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportRulesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document []byte `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Format   string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Count    int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`     // Rules exported, entries for nft
+	Version  uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // Policy version exported
+}
+
+func (x *ExportRulesResponse) Reset() {
+	*x = ExportRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRulesResponse) ProtoMessage() {}
+
+func (x *ExportRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRulesResponse.ProtoReflect.Descriptor instead.
+func (*ExportRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportRulesResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ExportRulesResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportRulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExportRulesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// nftables migration. Filter base chains of the ip, ip6 and inet families
+// are translated in evaluation order; matches on addresses, ports,
+// protocols and ct state, anonymous and named sets, accept, drop and
+// reject are supported. Rule IDs derive from family, table, chain and
+// handle, so importing a ruleset again replaces its rules.
+type ImportNFTablesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ruleset      []byte `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`                                // Output of nft -j list ruleset
+	DryRun       bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                   // Only translate, import nothing
+	Replace      bool   `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`                               // The translated rules replace the whole rule set
+	BasePriority int32  `protobuf:"varint,4,opt,name=base_priority,json=basePriority,proto3" json:"base_priority,omitempty"` // Priority of the first rule, 0 = 1000
+}
+
+func (x *ImportNFTablesRequest) Reset() {
+	*x = ImportNFTablesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportNFTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNFTablesRequest) ProtoMessage() {}
+
+func (x *ImportNFTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNFTablesRequest.ProtoReflect.Descriptor instead.
+func (*ImportNFTablesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportNFTablesRequest) GetRuleset() []byte {
+	if x != nil {
+		return x.Ruleset
+	}
+	return nil
+}
+
+func (x *ImportNFTablesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportNFTablesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *ImportNFTablesRequest) GetBasePriority() int32 {
+	if x != nil {
+		return x.BasePriority
+	}
+	return 0
+}
+
+type ImportNFTablesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules   []*Rule  `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`      // Translated rules
+	Skipped []string `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`  // Chains, rules and semantics that could not be translated
+	Errors  []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`    // Validation errors of translated rules
+	Count   int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`     // Rules imported
+	Total   int32    `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`     // Rules in the resulting set
+	Version uint64   `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *ImportNFTablesResponse) Reset() {
+	*x = ImportNFTablesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportNFTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNFTablesResponse) ProtoMessage() {}
+
+func (x *ImportNFTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNFTablesResponse.ProtoReflect.Descriptor instead.
+func (*ImportNFTablesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportNFTablesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportNFTablesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportNFTablesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ImportNFTablesResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportNFTablesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportNFTablesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ImportNFTablesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportNFTablesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ImportIPTablesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ruleset      []byte `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`                                // Output of iptables-save or ip6tables-save
+	DryRun       bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                   // Only translate, add nothing
+	BasePriority int32  `protobuf:"varint,3,opt,name=base_priority,json=basePriority,proto3" json:"base_priority,omitempty"` // Priority of the first rule, 0 = 1000
+	Ipv6         bool   `protobuf:"varint,4,opt,name=ipv6,proto3" json:"ipv6,omitempty"`                                     // The ruleset comes from ip6tables-save
+}
+
+func (x *ImportIPTablesRequest) Reset() {
+	*x = ImportIPTablesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportIPTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportIPTablesRequest) ProtoMessage() {}
+
+func (x *ImportIPTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportIPTablesRequest.ProtoReflect.Descriptor instead.
+func (*ImportIPTablesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportIPTablesRequest) GetRuleset() []byte {
+	if x != nil {
+		return x.Ruleset
+	}
+	return nil
+}
+
+func (x *ImportIPTablesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportIPTablesRequest) GetBasePriority() int32 {
+	if x != nil {
+		return x.BasePriority
+	}
+	return 0
+}
+
+func (x *ImportIPTablesRequest) GetIpv6() bool {
+	if x != nil {
+		return x.Ipv6
+	}
+	return false
+}
+
+type ImportIPTablesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Every translated rule was added
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules   []*Rule  `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`      // Rules added, or translated on a dry run
+	Skipped []string `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`  // Chains, rules and options that could not be translated, rules already present
+	Errors  []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`    // Translated rules AddRule rejected
+	Count   int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`     // Rules added
+	Total   int32    `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`     // Rules in the resulting set
+	Version uint64   `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *ImportIPTablesResponse) Reset() {
+	*x = ImportIPTablesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportIPTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportIPTablesResponse) ProtoMessage() {}
+
+func (x *ImportIPTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportIPTablesResponse.ProtoReflect.Descriptor instead.
+func (*ImportIPTablesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportIPTablesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportIPTablesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportIPTablesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ImportIPTablesResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportIPTablesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportIPTablesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ImportIPTablesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportIPTablesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Free-text rule search. Terms are separated by whitespace and all must
+// match; they match words by prefix, ignoring case and accents. A term
+// written field:term only matches that field (id, description, owner,
+// address, service, namespace, action, protocol, direction); an address
+// or prefix matches the rules whose addresses overlap it.
+// Label selectors are comma-separated requirements that must all hold:
+// "key=value", "key!=value", "key" (label set) and "!key" (label not set)
+type GetRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabelSelector string `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"` // Empty = all rules
+}
+
+func (x *GetRulesRequest) Reset() {
+	*x = GetRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRulesRequest) ProtoMessage() {}
+
+func (x *GetRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRulesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type RulesByLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"` // Required; see GetRulesRequest
+}
+
+func (x *RulesByLabelRequest) Reset() {
+	*x = RulesByLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesByLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesByLabelRequest) ProtoMessage() {}
+
+func (x *RulesByLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesByLabelRequest.ProtoReflect.Descriptor instead.
+func (*RulesByLabelRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RulesByLabelRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type RulesByLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count   int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // Rules changed
+	RuleIds []string `protobuf:"bytes,4,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	Version uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // Policy version now enforced
+}
+
+func (x *RulesByLabelResponse) Reset() {
+	*x = RulesByLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesByLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesByLabelResponse) ProtoMessage() {}
+
+func (x *RulesByLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesByLabelResponse.ProtoReflect.Descriptor instead.
+func (*RulesByLabelResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RulesByLabelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RulesByLabelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RulesByLabelResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RulesByLabelResponse) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+func (x *RulesByLabelResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TemporaryAllowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceIp        string       `protobuf:"bytes,1,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`                       // Operator host address
+	Ports           []*PortRange `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`                                             // Destination ports; end 0 = single port
+	Protocol        string       `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`                                       // "tcp" (default), "udp"
+	DurationSeconds int64        `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 = default window, capped at the maximum
+	Operator        string       `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`                                       // Person the access is granted to
+	Reason          string       `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                           // e.g. a maintenance ticket
+}
+
+func (x *TemporaryAllowRequest) Reset() {
+	*x = TemporaryAllowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemporaryAllowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemporaryAllowRequest) ProtoMessage() {}
+
+func (x *TemporaryAllowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemporaryAllowRequest.ProtoReflect.Descriptor instead.
+func (*TemporaryAllowRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TemporaryAllowRequest) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *TemporaryAllowRequest) GetPorts() []*PortRange {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *TemporaryAllowRequest) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TemporaryAllowRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TemporaryAllowRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *TemporaryAllowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TemporaryAllowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GrantId   string        `protobuf:"bytes,3,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"` // Value of the grant's "temporary-allow" label
+	RuleIds   []string      `protobuf:"bytes,4,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	ExpiresAt int64         `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp the grant is revoked at
+	Priority  int32         `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`                    // Ahead of every rule matching overlapping traffic
+	Errors    []*FieldError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *TemporaryAllowResponse) Reset() {
+	*x = TemporaryAllowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemporaryAllowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemporaryAllowResponse) ProtoMessage() {}
+
+func (x *TemporaryAllowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemporaryAllowResponse.ProtoReflect.Descriptor instead.
+func (*TemporaryAllowResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TemporaryAllowResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TemporaryAllowResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TemporaryAllowResponse) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *TemporaryAllowResponse) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+func (x *TemporaryAllowResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *TemporaryAllowResponse) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TemporaryAllowResponse) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BlockASNRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asn         string `protobuf:"bytes,1,opt,name=asn,proto3" json:"asn,omitempty"`                 // e.g. "AS64496" or "64496"
+	Direction   string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`     // "inbound" (default): traffic from the ASN's prefixes, "outbound": traffic to them
+	Priority    int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`      // Lower number = higher priority
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // Empty = "Block AS64496 (<AS name>)"
+	Owner       string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *BlockASNRequest) Reset() {
+	*x = BlockASNRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockASNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockASNRequest) ProtoMessage() {}
+
+func (x *BlockASNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockASNRequest.ProtoReflect.Descriptor instead.
+func (*BlockASNRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlockASNRequest) GetAsn() string {
+	if x != nil {
+		return x.Asn
+	}
+	return ""
+}
+
+func (x *BlockASNRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *BlockASNRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BlockASNRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BlockASNRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// Verdict of packets no rule matches, on one interface or all of them
+type DefaultPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"` // Empty = interfaces without their own policy
+	Inbound   string `protobuf:"bytes,2,opt,name=inbound,proto3" json:"inbound,omitempty"`     // "allow", "drop" or "builtin"
+	Outbound  string `protobuf:"bytes,3,opt,name=outbound,proto3" json:"outbound,omitempty"`   // "allow", "drop" or "builtin" (pass)
+}
+
+func (x *DefaultPolicy) Reset() {
+	*x = DefaultPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultPolicy) ProtoMessage() {}
+
+func (x *DefaultPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultPolicy.ProtoReflect.Descriptor instead.
+func (*DefaultPolicy) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DefaultPolicy) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *DefaultPolicy) GetInbound() string {
+	if x != nil {
+		return x.Inbound
+	}
+	return ""
+}
+
+func (x *DefaultPolicy) GetOutbound() string {
+	if x != nil {
+		return x.Outbound
+	}
+	return ""
+}
+
+type SetDefaultPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"` // Empty = all interfaces
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // "inbound", "outbound", "both"
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`       // "allow", "drop", "builtin" restores the built-in defaults
+}
+
+func (x *SetDefaultPolicyRequest) Reset() {
+	*x = SetDefaultPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPolicyRequest) ProtoMessage() {}
+
+func (x *SetDefaultPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetDefaultPolicyRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *SetDefaultPolicyRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *SetDefaultPolicyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type DefaultPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Policies []*DefaultPolicy `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"` // Every configured policy, after the change
+}
+
+func (x *DefaultPolicyResponse) Reset() {
+	*x = DefaultPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultPolicyResponse) ProtoMessage() {}
+
+func (x *DefaultPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DefaultPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DefaultPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DefaultPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DefaultPolicyResponse) GetPolicies() []*DefaultPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SearchRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Hits to return, 0 = 50
+}
+
+func (x *SearchRulesRequest) Reset() {
+	*x = SearchRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRulesRequest) ProtoMessage() {}
+
+func (x *SearchRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRulesRequest.ProtoReflect.Descriptor instead.
+func (*SearchRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchRulesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRulesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits  []*RuleSearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`    // Best score first, then evaluation order
+	Count int32            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Matching rules before the limit
+}
+
+func (x *SearchRulesResponse) Reset() {
+	*x = SearchRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRulesResponse) ProtoMessage() {}
+
+func (x *SearchRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRulesResponse.ProtoReflect.Descriptor instead.
+func (*SearchRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchRulesResponse) GetHits() []*RuleSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchRulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RuleSearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule   *Rule    `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"` // Fields the query matched in
+	Score  float64  `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *RuleSearchHit) Reset() {
+	*x = RuleSearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSearchHit) ProtoMessage() {}
+
+func (x *RuleSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSearchHit.ProtoReflect.Descriptor instead.
+func (*RuleSearchHit) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RuleSearchHit) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RuleSearchHit) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RuleSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetInterfaceStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InterfaceName string `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"` // Empty = all interfaces
+}
+
+func (x *GetInterfaceStatsRequest) Reset() {
+	*x = GetInterfaceStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInterfaceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterfaceStatsRequest) ProtoMessage() {}
+
+func (x *GetInterfaceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterfaceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetInterfaceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetInterfaceStatsRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigData []byte `protobuf:"bytes,1,opt,name=config_data,json=configData,proto3" json:"config_data,omitempty"` // Configuration backup data
+	Force      bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                            // Force restore even if validation fails
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreRequest) GetConfigData() []byte {
+	if x != nil {
+		return x.ConfigData
+	}
+	return nil
+}
+
+func (x *RestoreRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RuleId    string        `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Rule      *Rule         `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`            // Returned rule (for get/update operations)
+	Errors    []*FieldError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`        // Invalid fields when validation failed
+	Duplicate bool          `protobuf:"varint,6,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // AddRule found an identical rule; rule_id is that rule
+}
+
+func (x *RuleResponse) Reset() {
+	*x = RuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleResponse) ProtoMessage() {}
+
+func (x *RuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleResponse.ProtoReflect.Descriptor instead.
+func (*RuleResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RuleResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *RuleResponse) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *RuleResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// A validation error of one rule field
+type FieldError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // Rule field, e.g. "src_ip", "dst_port", "priority"
+	Message     string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Code        string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`               // Error catalog code, e.g. "E2101"
+	Remediation string `protobuf:"bytes,4,opt,name=remediation,proto3" json:"remediation,omitempty"` // How to fix the field
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FieldError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FieldError) GetRemediation() string {
+	if x != nil {
+		return x.Remediation
+	}
+	return ""
+}
+
+type RulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules           []*Rule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Count           int32            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPages      int32            `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // For pagination
+	CurrentPage     int32            `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	DefaultPolicies []*DefaultPolicy `protobuf:"bytes,5,rep,name=default_policies,json=defaultPolicies,proto3" json:"default_policies,omitempty"` // Verdicts of packets no rule matches
+}
+
+func (x *RulesResponse) Reset() {
+	*x = RulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesResponse) ProtoMessage() {}
+
+func (x *RulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesResponse.ProtoReflect.Descriptor instead.
+func (*RulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RulesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RulesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *RulesResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *RulesResponse) GetDefaultPolicies() []*DefaultPolicy {
+	if x != nil {
+		return x.DefaultPolicies
+	}
+	return nil
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode int32  `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // Error code for programmatic handling
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+type InterfaceStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interfaces []*InterfaceStats `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Timestamp  int64             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *InterfaceStatsResponse) Reset() {
+	*x = InterfaceStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceStatsResponse) ProtoMessage() {}
+
+func (x *InterfaceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceStatsResponse.ProtoReflect.Descriptor instead.
+func (*InterfaceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InterfaceStatsResponse) GetInterfaces() []*InterfaceStats {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *InterfaceStatsResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SystemInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	System *SystemInfo `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Stats  *Statistics `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *SystemInfoResponse) Reset() {
+	*x = SystemInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfoResponse) ProtoMessage() {}
+
+func (x *SystemInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*SystemInfoResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SystemInfoResponse) GetSystem() *SystemInfo {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *SystemInfoResponse) GetStats() *Statistics {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ConfigData []byte `protobuf:"bytes,3,opt,name=config_data,json=configData,proto3" json:"config_data,omitempty"` // Configuration backup data
+	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                    // Backup timestamp
+	Checksum   string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`                       // SHA256 checksum
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BackupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BackupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BackupResponse) GetConfigData() []byte {
+	if x != nil {
+		return x.ConfigData
+	}
+	return nil
+}
+
+func (x *BackupResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BackupResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // e.g., "lan", "wan", "dmz"
+	Interfaces  []string `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"` // Member interfaces, e.g., "eth0"
+	Prefixes    []string `protobuf:"bytes,3,rep,name=prefixes,proto3" json:"prefixes,omitempty"`     // Member prefixes in CIDR notation
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Zone) Reset() {
+	*x = Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zone) ProtoMessage() {}
+
+func (x *Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Zone) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Zone) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *Zone) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ZonePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromZone    string `protobuf:"bytes,2,opt,name=from_zone,json=fromZone,proto3" json:"from_zone,omitempty"`
+	ToZone      string `protobuf:"bytes,3,opt,name=to_zone,json=toZone,proto3" json:"to_zone,omitempty"`
+	Action      string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                   // "allow", "drop", "redirect"
+	Protocol    string `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`               // "tcp", "udp", "icmp", "any"
+	DstPort     int32  `protobuf:"varint,6,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"` // 0 = any port
+	Priority    int32  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`              // Lower number = higher priority
+	Enabled     bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ZonePolicy) Reset() {
+	*x = ZonePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZonePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonePolicy) ProtoMessage() {}
+
+func (x *ZonePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonePolicy.ProtoReflect.Descriptor instead.
+func (*ZonePolicy) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ZonePolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ZonePolicy) GetFromZone() string {
+	if x != nil {
+		return x.FromZone
+	}
+	return ""
+}
+
+func (x *ZonePolicy) GetToZone() string {
+	if x != nil {
+		return x.ToZone
+	}
+	return ""
+}
+
+func (x *ZonePolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ZonePolicy) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ZonePolicy) GetDstPort() int32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *ZonePolicy) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ZonePolicy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ZonePolicy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zone *Zone `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"` // Creates the zone or replaces an existing one
+}
+
+func (x *SetZoneRequest) Reset() {
+	*x = SetZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetZoneRequest) ProtoMessage() {}
+
+func (x *SetZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetZoneRequest.ProtoReflect.Descriptor instead.
+func (*SetZoneRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetZoneRequest) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type DeleteZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteZoneRequest) Reset() {
+	*x = DeleteZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneRequest) ProtoMessage() {}
+
+func (x *DeleteZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteZoneRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ZonesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zones    []*Zone       `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	Policies []*ZonePolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *ZonesResponse) Reset() {
+	*x = ZonesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonesResponse) ProtoMessage() {}
+
+func (x *ZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonesResponse.ProtoReflect.Descriptor instead.
+func (*ZonesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ZonesResponse) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *ZonesResponse) GetPolicies() []*ZonePolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type AddZonePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *ZonePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *AddZonePolicyRequest) Reset() {
+	*x = AddZonePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddZonePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddZonePolicyRequest) ProtoMessage() {}
+
+func (x *AddZonePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddZonePolicyRequest.ProtoReflect.Descriptor instead.
+func (*AddZonePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddZonePolicyRequest) GetPolicy() *ZonePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ZonePolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PolicyId string `protobuf:"bytes,3,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+}
+
+func (x *ZonePolicyResponse) Reset() {
+	*x = ZonePolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZonePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonePolicyResponse) ProtoMessage() {}
+
+func (x *ZonePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonePolicyResponse.ProtoReflect.Descriptor instead.
+func (*ZonePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ZonePolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ZonePolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ZonePolicyResponse) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type DeleteZonePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyId string `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+}
+
+func (x *DeleteZonePolicyRequest) Reset() {
+	*x = DeleteZonePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteZonePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZonePolicyRequest) ProtoMessage() {}
+
+func (x *DeleteZonePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZonePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteZonePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteZonePolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type ExplainZonePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromZone string `protobuf:"bytes,1,opt,name=from_zone,json=fromZone,proto3" json:"from_zone,omitempty"` // Empty = any zone
+	ToZone   string `protobuf:"bytes,2,opt,name=to_zone,json=toZone,proto3" json:"to_zone,omitempty"`       // Empty = any zone
+}
+
+func (x *ExplainZonePolicyRequest) Reset() {
+	*x = ExplainZonePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainZonePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainZonePolicyRequest) ProtoMessage() {}
+
+func (x *ExplainZonePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainZonePolicyRequest.ProtoReflect.Descriptor instead.
+func (*ExplainZonePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExplainZonePolicyRequest) GetFromZone() string {
+	if x != nil {
+		return x.FromZone
+	}
+	return ""
+}
+
+func (x *ExplainZonePolicyRequest) GetToZone() string {
+	if x != nil {
+		return x.ToZone
+	}
+	return ""
+}
+
+type ZonePolicyExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy        *ZonePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	CompiledRules []*Rule     `protobuf:"bytes,2,rep,name=compiled_rules,json=compiledRules,proto3" json:"compiled_rules,omitempty"` // Concrete rules pushed to the data plane
+}
+
+func (x *ZonePolicyExplanation) Reset() {
+	*x = ZonePolicyExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZonePolicyExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonePolicyExplanation) ProtoMessage() {}
+
+func (x *ZonePolicyExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonePolicyExplanation.ProtoReflect.Descriptor instead.
+func (*ZonePolicyExplanation) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ZonePolicyExplanation) GetPolicy() *ZonePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *ZonePolicyExplanation) GetCompiledRules() []*Rule {
+	if x != nil {
+		return x.CompiledRules
+	}
+	return nil
+}
+
+type ExplainZonePolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies          []*ZonePolicyExplanation `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	CompiledRuleCount int32                    `protobuf:"varint,2,opt,name=compiled_rule_count,json=compiledRuleCount,proto3" json:"compiled_rule_count,omitempty"`
+}
+
+func (x *ExplainZonePolicyResponse) Reset() {
+	*x = ExplainZonePolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainZonePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainZonePolicyResponse) ProtoMessage() {}
+
+func (x *ExplainZonePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainZonePolicyResponse.ProtoReflect.Descriptor instead.
+func (*ExplainZonePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExplainZonePolicyResponse) GetPolicies() []*ZonePolicyExplanation {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ExplainZonePolicyResponse) GetCompiledRuleCount() int32 {
+	if x != nil {
+		return x.CompiledRuleCount
+	}
+	return 0
+}
+
+type ServicePort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol  string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`                     // "tcp", "udp", "icmp"
+	PortStart int32  `protobuf:"varint,2,opt,name=port_start,json=portStart,proto3" json:"port_start,omitempty"` // 0 = any port
+	PortEnd   int32  `protobuf:"varint,3,opt,name=port_end,json=portEnd,proto3" json:"port_end,omitempty"`       // Inclusive, 0 = same as port_start
+}
+
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePort) ProtoMessage() {}
+
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ServicePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServicePort) GetPortStart() int32 {
+	if x != nil {
+		return x.PortStart
+	}
+	return 0
+}
+
+func (x *ServicePort) GetPortEnd() int32 {
+	if x != nil {
+		return x.PortEnd
+	}
+	return 0
+}
+
+type Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g., "web", "voip"
+	Ports       []*ServicePort `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	Description string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Service) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // Creates the service or replaces an existing one
+}
+
+func (x *SetServiceRequest) Reset() {
+	*x = SetServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServiceRequest) ProtoMessage() {}
+
+func (x *SetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServiceRequest.ProtoReflect.Descriptor instead.
+func (*SetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetServiceRequest) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type GetServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetServiceRequest) Reset() {
+	*x = GetServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRequest) ProtoMessage() {}
+
+func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Service *Service `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	RuleIds []string `protobuf:"bytes,4,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"` // Rules using the service
+}
+
+func (x *ServiceResponse) Reset() {
+	*x = ServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceResponse) ProtoMessage() {}
+
+func (x *ServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceResponse.ProtoReflect.Descriptor instead.
+func (*ServiceResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ServiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ServiceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ServiceResponse) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ServiceResponse) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ServicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *ServicesResponse) Reset() {
+	*x = ServicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicesResponse) ProtoMessage() {}
+
+func (x *ServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicesResponse.ProtoReflect.Descriptor instead.
+func (*ServicesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type AddressObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // e.g., "billing-servers"
+	Prefixes    []string `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"` // CIDR notation
+	Members     []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`   // Nested address objects (makes this a group)
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AddressObject) Reset() {
+	*x = AddressObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressObject) ProtoMessage() {}
+
+func (x *AddressObject) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressObject.ProtoReflect.Descriptor instead.
+func (*AddressObject) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddressObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddressObject) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *AddressObject) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AddressObject) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetAddressObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object *AddressObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"` // Creates the object or replaces an existing one
+}
+
+func (x *SetAddressObjectRequest) Reset() {
+	*x = SetAddressObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAddressObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAddressObjectRequest) ProtoMessage() {}
+
+func (x *SetAddressObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAddressObjectRequest.ProtoReflect.Descriptor instead.
+func (*SetAddressObjectRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetAddressObjectRequest) GetObject() *AddressObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type GetAddressObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetAddressObjectRequest) Reset() {
+	*x = GetAddressObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAddressObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressObjectRequest) ProtoMessage() {}
+
+func (x *GetAddressObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressObjectRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetAddressObjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddressObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Object   *AddressObject `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Prefixes []string       `protobuf:"bytes,4,rep,name=prefixes,proto3" json:"prefixes,omitempty"`              // Resolved through nested objects
+	RuleIds  []string       `protobuf:"bytes,5,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"` // Rules referencing the object, directly or via groups
+}
+
+func (x *AddressObjectResponse) Reset() {
+	*x = AddressObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressObjectResponse) ProtoMessage() {}
+
+func (x *AddressObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressObjectResponse.ProtoReflect.Descriptor instead.
+func (*AddressObjectResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddressObjectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddressObjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddressObjectResponse) GetObject() *AddressObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *AddressObjectResponse) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *AddressObjectResponse) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type DeleteAddressObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteAddressObjectRequest) Reset() {
+	*x = DeleteAddressObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAddressObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressObjectRequest) ProtoMessage() {}
+
+func (x *DeleteAddressObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressObjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressObjectRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteAddressObjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AddressObjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objects []*AddressObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (x *AddressObjectsResponse) Reset() {
+	*x = AddressObjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressObjectsResponse) ProtoMessage() {}
+
+func (x *AddressObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressObjectsResponse.ProtoReflect.Descriptor instead.
+func (*AddressObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddressObjectsResponse) GetObjects() []*AddressObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type WhereUsedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Object name
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "address", "service" (empty = both)
+}
+
+func (x *WhereUsedRequest) Reset() {
+	*x = WhereUsedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhereUsedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhereUsedRequest) ProtoMessage() {}
+
+func (x *WhereUsedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhereUsedRequest.ProtoReflect.Descriptor instead.
+func (*WhereUsedRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WhereUsedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WhereUsedRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type WhereUsedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleIds []string `protobuf:"bytes,1,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"` // Rules referencing the object, directly or via groups
+	Groups  []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`                  // Address groups containing the object
+}
+
+func (x *WhereUsedResponse) Reset() {
+	*x = WhereUsedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhereUsedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhereUsedResponse) ProtoMessage() {}
+
+func (x *WhereUsedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhereUsedResponse.ProtoReflect.Descriptor instead.
+func (*WhereUsedResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WhereUsedResponse) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+func (x *WhereUsedResponse) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type IPSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Output only: referenced by rules as src_set / dst_set
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                // "hash" (host addresses) or "lpm" (prefixes)
+	Family      string `protobuf:"bytes,4,opt,name=family,proto3" json:"family,omitempty"`                            // "ipv4" or "ipv6"
+	MaxEntries  uint32 `protobuf:"varint,5,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"` // 0 = default
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Entries     uint32 `protobuf:"varint,7,opt,name=entries,proto3" json:"entries,omitempty"` // Output only: addresses or prefixes in the set
+	Profile     string `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`  // Output only: protection profile maintaining the set, which cannot be changed directly
+	Feed        string `protobuf:"bytes,9,opt,name=feed,proto3" json:"feed,omitempty"`        // Output only: threat feed maintaining the set, which cannot be changed directly
+}
+
+func (x *IPSet) Reset() {
+	*x = IPSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPSet) ProtoMessage() {}
+
+func (x *IPSet) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPSet.ProtoReflect.Descriptor instead.
+func (*IPSet) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *IPSet) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IPSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IPSet) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IPSet) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *IPSet) GetMaxEntries() uint32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *IPSet) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IPSet) GetEntries() uint32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *IPSet) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *IPSet) GetFeed() string {
+	if x != nil {
+		return x.Feed
+	}
+	return ""
+}
+
+type CreateIPSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set *IPSet `protobuf:"bytes,1,opt,name=set,proto3" json:"set,omitempty"`
+}
+
+func (x *CreateIPSetRequest) Reset() {
+	*x = CreateIPSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIPSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIPSetRequest) ProtoMessage() {}
+
+func (x *CreateIPSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIPSetRequest.ProtoReflect.Descriptor instead.
+func (*CreateIPSetRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateIPSetRequest) GetSet() *IPSet {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+type IPSetEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SetId     uint32   `protobuf:"varint,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"` // Addresses, or CIDR prefixes for "lpm" sets
+}
+
+func (x *IPSetEntriesRequest) Reset() {
+	*x = IPSetEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPSetEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPSetEntriesRequest) ProtoMessage() {}
+
+func (x *IPSetEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPSetEntriesRequest.ProtoReflect.Descriptor instead.
+func (*IPSetEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IPSetEntriesRequest) GetSetId() uint32 {
+	if x != nil {
+		return x.SetId
+	}
+	return 0
+}
+
+func (x *IPSetEntriesRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type IPSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Set     *IPSet `protobuf:"bytes,3,opt,name=set,proto3" json:"set,omitempty"`
+	Changed uint32 `protobuf:"varint,4,opt,name=changed,proto3" json:"changed,omitempty"` // Entries added or removed; duplicates and absent entries are skipped
+}
+
+func (x *IPSetResponse) Reset() {
+	*x = IPSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPSetResponse) ProtoMessage() {}
+
+func (x *IPSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPSetResponse.ProtoReflect.Descriptor instead.
+func (*IPSetResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *IPSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IPSetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IPSetResponse) GetSet() *IPSet {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *IPSetResponse) GetChanged() uint32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+type DeleteIPSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SetId uint32 `protobuf:"varint,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+}
+
+func (x *DeleteIPSetRequest) Reset() {
+	*x = DeleteIPSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIPSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIPSetRequest) ProtoMessage() {}
+
+func (x *DeleteIPSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIPSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIPSetRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteIPSetRequest) GetSetId() uint32 {
+	if x != nil {
+		return x.SetId
+	}
+	return 0
+}
+
+type IPSetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*IPSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *IPSetsResponse) Reset() {
+	*x = IPSetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPSetsResponse) ProtoMessage() {}
+
+func (x *IPSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPSetsResponse.ProtoReflect.Descriptor instead.
+func (*IPSetsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *IPSetsResponse) GetSets() []*IPSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+// A threat intelligence feed: an external blocklist downloaded
+// periodically into an IPv4 and an IPv6 LPM set that rules reference
+type Feed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url             string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Description     string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IntervalSeconds uint32 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // Time between downloads
+	MaxEntries      uint32 `protobuf:"varint,5,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`                // Per set
+	Ipv4SetId       uint32 `protobuf:"varint,6,opt,name=ipv4_set_id,json=ipv4SetId,proto3" json:"ipv4_set_id,omitempty"`                 // Reference as src_set / dst_set
+	Ipv6SetId       uint32 `protobuf:"varint,7,opt,name=ipv6_set_id,json=ipv6SetId,proto3" json:"ipv6_set_id,omitempty"`
+	Ipv4Entries     uint32 `protobuf:"varint,8,opt,name=ipv4_entries,json=ipv4Entries,proto3" json:"ipv4_entries,omitempty"`
+	Ipv6Entries     uint32 `protobuf:"varint,9,opt,name=ipv6_entries,json=ipv6Entries,proto3" json:"ipv6_entries,omitempty"`
+	UpdatedAt       int64  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp the entries last changed, 0 = never
+	CheckedAt       int64  `protobuf:"varint,11,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Unix timestamp of the latest successful download, 0 = never
+	Downloads       uint64 `protobuf:"varint,12,opt,name=downloads,proto3" json:"downloads,omitempty"`                  // Successful downloads, including unchanged ones
+	Failures        uint64 `protobuf:"varint,13,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastError       string `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`           // Error of the latest download, empty after a success
+	SkippedLines    uint32 `protobuf:"varint,15,opt,name=skipped_lines,json=skippedLines,proto3" json:"skipped_lines,omitempty"` // Lines of the latest download that held no usable address
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Feed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Feed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Feed) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Feed) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Feed) GetMaxEntries() uint32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *Feed) GetIpv4SetId() uint32 {
+	if x != nil {
+		return x.Ipv4SetId
+	}
+	return 0
+}
+
+func (x *Feed) GetIpv6SetId() uint32 {
+	if x != nil {
+		return x.Ipv6SetId
+	}
+	return 0
+}
+
+func (x *Feed) GetIpv4Entries() uint32 {
+	if x != nil {
+		return x.Ipv4Entries
+	}
+	return 0
+}
+
+func (x *Feed) GetIpv6Entries() uint32 {
+	if x != nil {
+		return x.Ipv6Entries
+	}
+	return 0
+}
+
+func (x *Feed) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Feed) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *Feed) GetDownloads() uint64 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
+
+func (x *Feed) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Feed) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Feed) GetSkippedLines() uint32 {
+	if x != nil {
+		return x.SkippedLines
+	}
+	return 0
+}
+
+type FeedsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Feeds []*Feed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+}
+
+func (x *FeedsResponse) Reset() {
+	*x = FeedsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedsResponse) ProtoMessage() {}
+
+func (x *FeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedsResponse.ProtoReflect.Descriptor instead.
+func (*FeedsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FeedsResponse) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+// Policy federation. A global policy service exports the rules its
+// CERBERUS_FEDERATION_EXPORT selector matches as the baseline; regional
+// controllers install them next to their local rules, ahead of them or
+// behind them by their precedence.
+type WatchBaselineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Site string `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"` // Name of the subscribing site
+}
+
+func (x *WatchBaselineRequest) Reset() {
+	*x = WatchBaselineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBaselineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBaselineRequest) ProtoMessage() {}
+
+func (x *WatchBaselineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBaselineRequest.ProtoReflect.Descriptor instead.
+func (*WatchBaselineRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *WatchBaselineRequest) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+// The baseline as of one revision, sent on subscribing and on every change
+type Baseline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision    string   `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`                           // Digest of the rules, equal for equal baselines
+	Rules       []*Rule  `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`                                 // Service and address references resolved
+	Skipped     []string `protobuf:"bytes,3,rep,name=skipped,proto3" json:"skipped,omitempty"`                             // Selected rules not exported, with the reason
+	PublishedAt int64    `protobuf:"varint,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // Unix timestamp
+}
+
+func (x *Baseline) Reset() {
+	*x = Baseline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Baseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Baseline) ProtoMessage() {}
+
+func (x *Baseline) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Baseline.ProtoReflect.Descriptor instead.
+func (*Baseline) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Baseline) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *Baseline) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Baseline) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *Baseline) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+// A baseline rule and a local rule of a site overlapping with different
+// actions; the winner's action applies where both match
+type FederationOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaselineRuleId string `protobuf:"bytes,1,opt,name=baseline_rule_id,json=baselineRuleId,proto3" json:"baseline_rule_id,omitempty"` // ID of the rule on the global policy service
+	LocalRuleId    string `protobuf:"bytes,2,opt,name=local_rule_id,json=localRuleId,proto3" json:"local_rule_id,omitempty"`
+	Kind           string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`     // shadowed: the winner covers the other rule; conflict: partial overlap
+	Winner         string `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"` // global or local
+	Message        string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FederationOverride) Reset() {
+	*x = FederationOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationOverride) ProtoMessage() {}
+
+func (x *FederationOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationOverride.ProtoReflect.Descriptor instead.
+func (*FederationOverride) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FederationOverride) GetBaselineRuleId() string {
+	if x != nil {
+		return x.BaselineRuleId
+	}
+	return ""
+}
+
+func (x *FederationOverride) GetLocalRuleId() string {
+	if x != nil {
+		return x.LocalRuleId
+	}
+	return ""
+}
+
+func (x *FederationOverride) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FederationOverride) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *FederationOverride) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SiteStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Site          string                `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	Revision      string                `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`                     // Baseline revision installed
+	AppliedAt     int64                 `protobuf:"varint,3,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"` // Unix timestamp the revision was installed
+	BaselineRules int32                 `protobuf:"varint,4,opt,name=baseline_rules,json=baselineRules,proto3" json:"baseline_rules,omitempty"`
+	LocalRules    int32                 `protobuf:"varint,5,opt,name=local_rules,json=localRules,proto3" json:"local_rules,omitempty"`
+	Precedence    string                `protobuf:"bytes,6,opt,name=precedence,proto3" json:"precedence,omitempty"` // global: baseline rules first; local: local rules first
+	Overrides     []*FederationOverride `protobuf:"bytes,7,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	Error         string                `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                              // Why the latest baseline was not installed
+	ReportedAt    int64                 `protobuf:"varint,9,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"` // Unix timestamp, set by the global policy service
+	Connected     bool                  `protobuf:"varint,10,opt,name=connected,proto3" json:"connected,omitempty"`                    // Subscribed right now, set by the global policy service
+}
+
+func (x *SiteStatus) Reset() {
+	*x = SiteStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteStatus) ProtoMessage() {}
+
+func (x *SiteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteStatus.ProtoReflect.Descriptor instead.
+func (*SiteStatus) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SiteStatus) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetAppliedAt() int64 {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return 0
+}
+
+func (x *SiteStatus) GetBaselineRules() int32 {
+	if x != nil {
+		return x.BaselineRules
+	}
+	return 0
+}
+
+func (x *SiteStatus) GetLocalRules() int32 {
+	if x != nil {
+		return x.LocalRules
+	}
+	return 0
+}
+
+func (x *SiteStatus) GetPrecedence() string {
+	if x != nil {
+		return x.Precedence
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetOverrides() []*FederationOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *SiteStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetReportedAt() int64 {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return 0
+}
+
+func (x *SiteStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type FederationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportSelector string        `protobuf:"bytes,1,opt,name=export_selector,json=exportSelector,proto3" json:"export_selector,omitempty"` // Rules exported as the baseline, empty = none
+	Revision       string        `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`                                   // Revision of the exported baseline
+	Sites          []*SiteStatus `protobuf:"bytes,3,rep,name=sites,proto3" json:"sites,omitempty"`                                         // Sites subscribed or reporting, by name
+	Upstream       string        `protobuf:"bytes,4,opt,name=upstream,proto3" json:"upstream,omitempty"`                                   // Global policy service subscribed to, empty = none
+	Connected      bool          `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Site           *SiteStatus   `protobuf:"bytes,6,opt,name=site,proto3" json:"site,omitempty"` // This controller's own site status
+}
+
+func (x *FederationStatus) Reset() {
+	*x = FederationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationStatus) ProtoMessage() {}
+
+func (x *FederationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationStatus.ProtoReflect.Descriptor instead.
+func (*FederationStatus) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FederationStatus) GetExportSelector() string {
+	if x != nil {
+		return x.ExportSelector
+	}
+	return ""
+}
+
+func (x *FederationStatus) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *FederationStatus) GetSites() []*SiteStatus {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *FederationStatus) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
+}
+
+func (x *FederationStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *FederationStatus) GetSite() *SiteStatus {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+// A database file downloaded on a schedule. Downloads are verified against
+// the published checksum and signature, where configured, and validated
+// by loading them before they replace the file; the replaced file is kept
+// for rollback.
+type DatabaseUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "geoip" or "asn"
+	Path              string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Url               string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ChecksumUrl       string            `protobuf:"bytes,4,opt,name=checksum_url,json=checksumUrl,proto3" json:"checksum_url,omitempty"`                                                                // Empty = not checked
+	SignatureUrl      string            `protobuf:"bytes,5,opt,name=signature_url,json=signatureUrl,proto3" json:"signature_url,omitempty"`                                                             // Empty = not checked
+	LastAttempt       int64             `protobuf:"varint,6,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`                                                               // Unix timestamp, 0 = never
+	LastSuccess       int64             `protobuf:"varint,7,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`                                                               // Unix timestamp of the latest check ending with a verified file in use
+	LastChange        int64             `protobuf:"varint,8,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`                                                                  // Unix timestamp a new version last went in use
+	LastError         string            `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`                                                                      // Error of the latest attempt, empty after a success
+	Results           map[string]uint64 `protobuf:"bytes,10,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Attempts by result: "updated", "unchanged", "failed", "rejected", "rolled_back", "reverted"
+	RollbackAvailable bool              `protobuf:"varint,11,opt,name=rollback_available,json=rollbackAvailable,proto3" json:"rollback_available,omitempty"`                                            // A previous version is kept
+}
+
+func (x *DatabaseUpdate) Reset() {
+	*x = DatabaseUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseUpdate) ProtoMessage() {}
+
+func (x *DatabaseUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseUpdate.ProtoReflect.Descriptor instead.
+func (*DatabaseUpdate) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DatabaseUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetChecksumUrl() string {
+	if x != nil {
+		return x.ChecksumUrl
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetSignatureUrl() string {
+	if x != nil {
+		return x.SignatureUrl
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetLastAttempt() int64 {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return 0
+}
+
+func (x *DatabaseUpdate) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *DatabaseUpdate) GetLastChange() int64 {
+	if x != nil {
+		return x.LastChange
+	}
+	return 0
+}
+
+func (x *DatabaseUpdate) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DatabaseUpdate) GetResults() map[string]uint64 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DatabaseUpdate) GetRollbackAvailable() bool {
+	if x != nil {
+		return x.RollbackAvailable
+	}
+	return false
+}
+
+type DatabaseUpdatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates []*DatabaseUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *DatabaseUpdatesResponse) Reset() {
+	*x = DatabaseUpdatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseUpdatesResponse) ProtoMessage() {}
+
+func (x *DatabaseUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DatabaseUpdatesResponse) GetUpdates() []*DatabaseUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type RollbackDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "geoip" or "asn"
+}
+
+func (x *RollbackDatabaseRequest) Reset() {
+	*x = RollbackDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackDatabaseRequest) ProtoMessage() {}
+
+func (x *RollbackDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*RollbackDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RollbackDatabaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Service protection profiles. A profile bundles the protections of a
+// service and is attached to destinations; inbound packets starting a new
+// flow to an attached destination that no rule drops are checked against
+// it. Zero limits are off.
+type ProtectionProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g., "web-frontend"
+	Description        string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MaxConnections     uint32   `protobuf:"varint,3,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`               // Tracked flows to the destination
+	SynRate            uint32   `protobuf:"varint,4,opt,name=syn_rate,json=synRate,proto3" json:"syn_rate,omitempty"`                                    // TCP SYNs per second to the destination
+	SynBurst           uint32   `protobuf:"varint,5,opt,name=syn_burst,json=synBurst,proto3" json:"syn_burst,omitempty"`                                 // SYNs above the rate allowed at once, 0 = the rate
+	IdleTimeoutSeconds uint32   `protobuf:"varint,6,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"` // Flows idle this long are removed
+	SlowTimeoutSeconds uint32   `protobuf:"varint,7,opt,name=slow_timeout_seconds,json=slowTimeoutSeconds,proto3" json:"slow_timeout_seconds,omitempty"` // Flows this old moving less than min_bytes_per_second are removed
+	MinBytesPerSecond  uint32   `protobuf:"varint,8,opt,name=min_bytes_per_second,json=minBytesPerSecond,proto3" json:"min_bytes_per_second,omitempty"`
+	AllowedCountries   []string `protobuf:"bytes,9,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"` // ISO 3166 codes of allowed sources, empty = any; needs a GeoIP database
+}
+
+func (x *ProtectionProfile) Reset() {
+	*x = ProtectionProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtectionProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectionProfile) ProtoMessage() {}
+
+func (x *ProtectionProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectionProfile.ProtoReflect.Descriptor instead.
+func (*ProtectionProfile) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ProtectionProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtectionProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProtectionProfile) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetSynRate() uint32 {
+	if x != nil {
+		return x.SynRate
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetSynBurst() uint32 {
+	if x != nil {
+		return x.SynBurst
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetIdleTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetSlowTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.SlowTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetMinBytesPerSecond() uint32 {
+	if x != nil {
+		return x.MinBytesPerSecond
+	}
+	return 0
+}
+
+func (x *ProtectionProfile) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+type SetProtectionProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *ProtectionProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"` // Creates the profile or replaces an existing one
+}
+
+func (x *SetProtectionProfileRequest) Reset() {
+	*x = SetProtectionProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProtectionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtectionProfileRequest) ProtoMessage() {}
+
+func (x *SetProtectionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtectionProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetProtectionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SetProtectionProfileRequest) GetProfile() *ProtectionProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ProtectionProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message      string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Profile      *ProtectionProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Destinations []string           `protobuf:"bytes,4,rep,name=destinations,proto3" json:"destinations,omitempty"` // Destinations the profile is attached to
+}
+
+func (x *ProtectionProfileResponse) Reset() {
+	*x = ProtectionProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtectionProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectionProfileResponse) ProtoMessage() {}
+
+func (x *ProtectionProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectionProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProtectionProfileResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ProtectionProfileResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProtectionProfileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProtectionProfileResponse) GetProfile() *ProtectionProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ProtectionProfileResponse) GetDestinations() []string {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+type DeleteProtectionProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteProtectionProfileRequest) Reset() {
+	*x = DeleteProtectionProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProtectionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProtectionProfileRequest) ProtoMessage() {}
+
+func (x *DeleteProtectionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProtectionProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProtectionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteProtectionProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A destination is "address:port", "[address]:port" for IPv6, or a bare
+// address to protect every port
+type AttachProtectionProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Profile     string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"` // Replaces the profile attached before, if any
+}
+
+func (x *AttachProtectionProfileRequest) Reset() {
+	*x = AttachProtectionProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachProtectionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachProtectionProfileRequest) ProtoMessage() {}
+
+func (x *AttachProtectionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachProtectionProfileRequest.ProtoReflect.Descriptor instead.
+func (*AttachProtectionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AttachProtectionProfileRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *AttachProtectionProfileRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type DetachProtectionProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *DetachProtectionProfileRequest) Reset() {
+	*x = DetachProtectionProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachProtectionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachProtectionProfileRequest) ProtoMessage() {}
+
+func (x *DetachProtectionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachProtectionProfileRequest.ProtoReflect.Descriptor instead.
+func (*DetachProtectionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DetachProtectionProfileRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type ProtectionAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination     string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Profile         string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Connections     uint32 `protobuf:"varint,3,opt,name=connections,proto3" json:"connections,omitempty"`                                // Tracked flows at the latest sweep
+	CountryDrops    uint64 `protobuf:"varint,4,opt,name=country_drops,json=countryDrops,proto3" json:"country_drops,omitempty"`          // Sources outside the allowed countries
+	SynDrops        uint64 `protobuf:"varint,5,opt,name=syn_drops,json=synDrops,proto3" json:"syn_drops,omitempty"`                      // SYNs above the rate
+	ConnectionDrops uint64 `protobuf:"varint,6,opt,name=connection_drops,json=connectionDrops,proto3" json:"connection_drops,omitempty"` // New flows above max_connections
+	StrayDrops      uint64 `protobuf:"varint,7,opt,name=stray_drops,json=strayDrops,proto3" json:"stray_drops,omitempty"`                // TCP packets of no tracked flow, e.g. of removed flows
+	RemovedIdle     uint64 `protobuf:"varint,8,opt,name=removed_idle,json=removedIdle,proto3" json:"removed_idle,omitempty"`             // Flows removed by the idle timeout
+	RemovedSlow     uint64 `protobuf:"varint,9,opt,name=removed_slow,json=removedSlow,proto3" json:"removed_slow,omitempty"`             // Flows removed by the slow timeout
+}
+
+func (x *ProtectionAttachment) Reset() {
+	*x = ProtectionAttachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtectionAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectionAttachment) ProtoMessage() {}
+
+func (x *ProtectionAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectionAttachment.ProtoReflect.Descriptor instead.
+func (*ProtectionAttachment) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ProtectionAttachment) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ProtectionAttachment) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ProtectionAttachment) GetConnections() uint32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetCountryDrops() uint64 {
+	if x != nil {
+		return x.CountryDrops
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetSynDrops() uint64 {
+	if x != nil {
+		return x.SynDrops
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetConnectionDrops() uint64 {
+	if x != nil {
+		return x.ConnectionDrops
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetStrayDrops() uint64 {
+	if x != nil {
+		return x.StrayDrops
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetRemovedIdle() uint64 {
+	if x != nil {
+		return x.RemovedIdle
+	}
+	return 0
+}
+
+func (x *ProtectionAttachment) GetRemovedSlow() uint64 {
+	if x != nil {
+		return x.RemovedSlow
+	}
+	return 0
+}
+
+type ProtectionProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles    []*ProtectionProfile    `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Attachments []*ProtectionAttachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (x *ProtectionProfilesResponse) Reset() {
+	*x = ProtectionProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtectionProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtectionProfilesResponse) ProtoMessage() {}
+
+func (x *ProtectionProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtectionProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ProtectionProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ProtectionProfilesResponse) GetProfiles() []*ProtectionProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *ProtectionProfilesResponse) GetAttachments() []*ProtectionAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Tunnel termination. Encapsulated packets between the local and remote
+// endpoints of a tunnel are counted against it in both directions; rules
+// with protocol "gre", "ipip" or "ipv6" filter them by their outer headers,
+// or, with inspect_inner, rules filter them by the packet they carry.
+type Tunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // e.g., "branch-office"
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                      // "gre", "ipip" (IPv4 payload) or "ipv6" (IPv6 payload, RFC 4213)
+	Local        string `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`                                    // Host address terminating the tunnel
+	Remote       string `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`                                  // Peer address, same family as local
+	InspectInner bool   `protobuf:"varint,5,opt,name=inspect_inner,json=inspectInner,proto3" json:"inspect_inner,omitempty"` // Classify and filter by the inner headers
+	Description  string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Id           uint32 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`                // Output only
+	Packets      uint64 `protobuf:"varint,8,opt,name=packets,proto3" json:"packets,omitempty"`      // Output only: both directions
+	Bytes        uint64 `protobuf:"varint,9,opt,name=bytes,proto3" json:"bytes,omitempty"`          // Output only
+	Inspected    uint64 `protobuf:"varint,10,opt,name=inspected,proto3" json:"inspected,omitempty"` // Output only: classified by their inner headers
+	Malformed    uint64 `protobuf:"varint,11,opt,name=malformed,proto3" json:"malformed,omitempty"` // Output only: inner packet truncated or not IP, filtered by the outer headers
+	Dropped      uint64 `protobuf:"varint,12,opt,name=dropped,proto3" json:"dropped,omitempty"`     // Output only
+}
+
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tunnel) ProtoMessage() {}
+
+func (x *Tunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Tunnel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tunnel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Tunnel) GetLocal() string {
+	if x != nil {
+		return x.Local
+	}
+	return ""
+}
+
+func (x *Tunnel) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *Tunnel) GetInspectInner() bool {
+	if x != nil {
+		return x.InspectInner
+	}
+	return false
+}
+
+func (x *Tunnel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tunnel) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tunnel) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *Tunnel) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Tunnel) GetInspected() uint64 {
+	if x != nil {
+		return x.Inspected
+	}
+	return 0
+}
+
+func (x *Tunnel) GetMalformed() uint64 {
+	if x != nil {
+		return x.Malformed
+	}
+	return 0
+}
+
+func (x *Tunnel) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type SetTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tunnel *Tunnel `protobuf:"bytes,1,opt,name=tunnel,proto3" json:"tunnel,omitempty"` // Creates the tunnel or replaces one of the same name
+}
+
+func (x *SetTunnelRequest) Reset() {
+	*x = SetTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTunnelRequest) ProtoMessage() {}
+
+func (x *SetTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTunnelRequest.ProtoReflect.Descriptor instead.
+func (*SetTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetTunnelRequest) GetTunnel() *Tunnel {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+type TunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tunnel  *Tunnel `protobuf:"bytes,3,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+}
+
+func (x *TunnelResponse) Reset() {
+	*x = TunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelResponse) ProtoMessage() {}
+
+func (x *TunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelResponse.ProtoReflect.Descriptor instead.
+func (*TunnelResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TunnelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TunnelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TunnelResponse) GetTunnel() *Tunnel {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+type DeleteTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteTunnelRequest) Reset() {
+	*x = DeleteTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTunnelRequest) ProtoMessage() {}
+
+func (x *DeleteTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTunnelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteTunnelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tunnels []*Tunnel `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *TunnelsResponse) Reset() {
+	*x = TunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelsResponse) ProtoMessage() {}
+
+func (x *TunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelsResponse.ProtoReflect.Descriptor instead.
+func (*TunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TunnelsResponse) GetTunnels() []*Tunnel {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+type MulticastMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                          // Host that reported the membership
+	Interface  string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`                      // Where the report was seen
+	Protocol   string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`                        // "igmp" or "mld"
+	Version    int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                         // IGMP 1-3, MLD 1-2
+	LastReport int64  `protobuf:"varint,5,opt,name=last_report,json=lastReport,proto3" json:"last_report,omitempty"` // Unix time; members expire without a report for 260s
+	Local      bool   `protobuf:"varint,6,opt,name=local,proto3" json:"local,omitempty"`                             // Reported by this host
+}
+
+func (x *MulticastMember) Reset() {
+	*x = MulticastMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastMember) ProtoMessage() {}
+
+func (x *MulticastMember) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastMember.ProtoReflect.Descriptor instead.
+func (*MulticastMember) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *MulticastMember) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MulticastMember) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *MulticastMember) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MulticastMember) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MulticastMember) GetLastReport() int64 {
+	if x != nil {
+		return x.LastReport
+	}
+	return 0
+}
+
+func (x *MulticastMember) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type MulticastGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group   string             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"` // Group address
+	Members []*MulticastMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Packets uint64             `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"` // Arriving for the group, dropped ones included
+	Bytes   uint64             `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Dropped uint64             `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *MulticastGroup) Reset() {
+	*x = MulticastGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastGroup) ProtoMessage() {}
+
+func (x *MulticastGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastGroup.ProtoReflect.Descriptor instead.
+func (*MulticastGroup) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MulticastGroup) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *MulticastGroup) GetMembers() []*MulticastMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *MulticastGroup) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *MulticastGroup) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *MulticastGroup) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type MulticastGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*MulticastGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *MulticastGroupsResponse) Reset() {
+	*x = MulticastGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastGroupsResponse) ProtoMessage() {}
+
+func (x *MulticastGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastGroupsResponse.ProtoReflect.Descriptor instead.
+func (*MulticastGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *MulticastGroupsResponse) GetGroups() []*MulticastGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// NAT64 (RFC 6146). IPv6 packets to an address of a NAT64 prefix that
+// rules pass are translated to IPv4, to the address embedded in the last
+// 32 bits, from the prefix's pool address; replies are translated back
+// before rules see them, so rules match the IPv6 side only. The DNS64
+// proxy (CERBERUS_DNS64_LISTEN) synthesizes AAAA records in the first
+// prefix for names that only have A records.
+type NAT64Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix          string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"` // IPv6 /96, e.g. "64:ff9b::/96"
+	Pool            string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`     // IPv4 source address of translated packets, routed to this host
+	Description     string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Id              uint32 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`                                                  // Output only
+	PacketsOut      uint64 `protobuf:"varint,5,opt,name=packets_out,json=packetsOut,proto3" json:"packets_out,omitempty"`                // Output only: IPv6 packets translated to IPv4
+	PacketsIn       uint64 `protobuf:"varint,6,opt,name=packets_in,json=packetsIn,proto3" json:"packets_in,omitempty"`                   // Output only: IPv4 replies translated to IPv6
+	SessionsCreated uint64 `protobuf:"varint,7,opt,name=sessions_created,json=sessionsCreated,proto3" json:"sessions_created,omitempty"` // Output only
+	Failed          uint64 `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`                                          // Output only: left untranslated, e.g. no free pool port
+	Sessions        uint32 `protobuf:"varint,9,opt,name=sessions,proto3" json:"sessions,omitempty"`                                      // Output only: sessions currently open
+}
+
+func (x *NAT64Prefix) Reset() {
+	*x = NAT64Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NAT64Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NAT64Prefix) ProtoMessage() {}
+
+func (x *NAT64Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NAT64Prefix.ProtoReflect.Descriptor instead.
+func (*NAT64Prefix) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *NAT64Prefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *NAT64Prefix) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *NAT64Prefix) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NAT64Prefix) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NAT64Prefix) GetPacketsOut() uint64 {
+	if x != nil {
+		return x.PacketsOut
+	}
+	return 0
+}
+
+func (x *NAT64Prefix) GetPacketsIn() uint64 {
+	if x != nil {
+		return x.PacketsIn
+	}
+	return 0
+}
+
+func (x *NAT64Prefix) GetSessionsCreated() uint64 {
+	if x != nil {
+		return x.SessionsCreated
+	}
+	return 0
+}
+
+func (x *NAT64Prefix) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *NAT64Prefix) GetSessions() uint32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+type SetNAT64PrefixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix *NAT64Prefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"` // Creates the prefix or replaces the one with the same prefix
+}
+
+func (x *SetNAT64PrefixRequest) Reset() {
+	*x = SetNAT64PrefixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNAT64PrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNAT64PrefixRequest) ProtoMessage() {}
+
+func (x *SetNAT64PrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNAT64PrefixRequest.ProtoReflect.Descriptor instead.
+func (*SetNAT64PrefixRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SetNAT64PrefixRequest) GetPrefix() *NAT64Prefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+type NAT64PrefixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Prefix  *NAT64Prefix `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *NAT64PrefixResponse) Reset() {
+	*x = NAT64PrefixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NAT64PrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NAT64PrefixResponse) ProtoMessage() {}
+
+func (x *NAT64PrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NAT64PrefixResponse.ProtoReflect.Descriptor instead.
+func (*NAT64PrefixResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *NAT64PrefixResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NAT64PrefixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NAT64PrefixResponse) GetPrefix() *NAT64Prefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+type DeleteNAT64PrefixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *DeleteNAT64PrefixRequest) Reset() {
+	*x = DeleteNAT64PrefixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNAT64PrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNAT64PrefixRequest) ProtoMessage() {}
+
+func (x *DeleteNAT64PrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNAT64PrefixRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNAT64PrefixRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeleteNAT64PrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type NAT64PrefixesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefixes []*NAT64Prefix `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *NAT64PrefixesResponse) Reset() {
+	*x = NAT64PrefixesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NAT64PrefixesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NAT64PrefixesResponse) ProtoMessage() {}
+
+func (x *NAT64PrefixesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NAT64PrefixesResponse.ProtoReflect.Descriptor instead.
+func (*NAT64PrefixesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *NAT64PrefixesResponse) GetPrefixes() []*NAT64Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type ListNAT64SessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = default limit (1000)
+}
+
+func (x *ListNAT64SessionsRequest) Reset() {
+	*x = ListNAT64SessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNAT64SessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNAT64SessionsRequest) ProtoMessage() {}
+
+func (x *ListNAT64SessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNAT64SessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNAT64SessionsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListNAT64SessionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NAT64Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol    string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`                        // "tcp", "udp" or "icmp"
+	Client      string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`                            // IPv6 client
+	ClientPort  uint32 `protobuf:"varint,3,opt,name=client_port,json=clientPort,proto3" json:"client_port,omitempty"` // ICMP echo identifier for ICMP
+	Server      string `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`                            // IPv4 server
+	ServerPort  uint32 `protobuf:"varint,5,opt,name=server_port,json=serverPort,proto3" json:"server_port,omitempty"`
+	Pool        string `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolPort    uint32 `protobuf:"varint,7,opt,name=pool_port,json=poolPort,proto3" json:"pool_port,omitempty"`
+	Prefix      string `protobuf:"bytes,8,opt,name=prefix,proto3" json:"prefix,omitempty"` // NAT64 prefix the client used
+	IdleSeconds int64  `protobuf:"varint,9,opt,name=idle_seconds,json=idleSeconds,proto3" json:"idle_seconds,omitempty"`
+}
+
+func (x *NAT64Session) Reset() {
+	*x = NAT64Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NAT64Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NAT64Session) ProtoMessage() {}
+
+func (x *NAT64Session) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NAT64Session.ProtoReflect.Descriptor instead.
+func (*NAT64Session) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *NAT64Session) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NAT64Session) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *NAT64Session) GetClientPort() uint32 {
+	if x != nil {
+		return x.ClientPort
+	}
+	return 0
+}
+
+func (x *NAT64Session) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *NAT64Session) GetServerPort() uint32 {
+	if x != nil {
+		return x.ServerPort
+	}
+	return 0
+}
+
+func (x *NAT64Session) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *NAT64Session) GetPoolPort() uint32 {
+	if x != nil {
+		return x.PoolPort
+	}
+	return 0
+}
+
+func (x *NAT64Session) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *NAT64Session) GetIdleSeconds() int64 {
+	if x != nil {
+		return x.IdleSeconds
+	}
+	return 0
+}
+
+type NAT64SessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions  []*NAT64Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Truncated bool            `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *NAT64SessionsResponse) Reset() {
+	*x = NAT64SessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NAT64SessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NAT64SessionsResponse) ProtoMessage() {}
+
+func (x *NAT64SessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NAT64SessionsResponse.ProtoReflect.Descriptor instead.
+func (*NAT64SessionsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *NAT64SessionsResponse) GetSessions() []*NAT64Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *NAT64SessionsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// NAT rules. A "dnat" rule forwards TCP or UDP packets to a public
+// address and port to an internal address and port: the destination is
+// rewritten before rules see the packet, so rules match the internal side,
+// and the flow table keeps the public side to rewrite the source of
+// replies back. The internal host must route its replies through this
+// host, and forwarding must be enabled for internal hosts that are not
+// local.
+//
+// A "snat" rule lets the hosts of an internal prefix share public_ip: TCP
+// and UDP packets from the prefix leaving by the interface get public_ip
+// and a port of the rule's range as their source, allocated per session
+// and released once the session is idle for its timeout. A "masquerade"
+// rule does the same with the interface's own address of the prefix's
+// family, followed when it changes. Packets XDP redirects and ICMP are not
+// translated.
+type NatRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                         // Output only
+	Type            string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                      // "dnat" (default), "snat" or "masquerade"
+	Protocol        string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`                              // "tcp" or "udp"; source NAT also "any", the default
+	PublicIp        string `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`              // dnat: address clients connect to, routed to this host; snat: shared source; masquerade: output only
+	PublicPort      uint32 `protobuf:"varint,5,opt,name=public_port,json=publicPort,proto3" json:"public_port,omitempty"`       // dnat only
+	InternalIp      string `protobuf:"bytes,6,opt,name=internal_ip,json=internalIp,proto3" json:"internal_ip,omitempty"`        // dnat only, same family as public_ip
+	InternalPort    uint32 `protobuf:"varint,7,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"` // dnat only, 0 = public_port
+	Description     string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Translated      uint64 `protobuf:"varint,9,opt,name=translated,proto3" json:"translated,omitempty"`           // Output only: packets rewritten to the internal side, or from it for source NAT
+	Replies         uint64 `protobuf:"varint,10,opt,name=replies,proto3" json:"replies,omitempty"`                // Output only: replies rewritten back
+	Failed          uint64 `protobuf:"varint,11,opt,name=failed,proto3" json:"failed,omitempty"`                  // Output only: left untranslated, e.g. fragments or no free port
+	Source          string `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`                   // Source NAT: internal prefix, e.g. "192.168.1.0/24"
+	Interface       string `protobuf:"bytes,13,opt,name=interface,proto3" json:"interface,omitempty"`             // Source NAT: interface the packets leave by
+	PortMin         uint32 `protobuf:"varint,14,opt,name=port_min,json=portMin,proto3" json:"port_min,omitempty"` // Source NAT: public port range, 0 = 1024 to 65535
+	PortMax         uint32 `protobuf:"varint,15,opt,name=port_max,json=portMax,proto3" json:"port_max,omitempty"`
+	TcpTimeout      uint32 `protobuf:"varint,16,opt,name=tcp_timeout,json=tcpTimeout,proto3" json:"tcp_timeout,omitempty"`                // Source NAT: seconds a TCP session may be idle, 0 = 7440
+	UdpTimeout      uint32 `protobuf:"varint,17,opt,name=udp_timeout,json=udpTimeout,proto3" json:"udp_timeout,omitempty"`                // Source NAT: seconds a UDP session may be idle, 0 = 300
+	Sessions        uint32 `protobuf:"varint,18,opt,name=sessions,proto3" json:"sessions,omitempty"`                                      // Output only: open source NAT sessions
+	SessionsCreated uint64 `protobuf:"varint,19,opt,name=sessions_created,json=sessionsCreated,proto3" json:"sessions_created,omitempty"` // Output only
+	PortsInUse      uint32 `protobuf:"varint,20,opt,name=ports_in_use,json=portsInUse,proto3" json:"ports_in_use,omitempty"`              // Output only: public ports held by open sessions
+}
+
+func (x *NatRule) Reset() {
+	*x = NatRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatRule) ProtoMessage() {}
+
+func (x *NatRule) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatRule.ProtoReflect.Descriptor instead.
+func (*NatRule) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *NatRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NatRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NatRule) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NatRule) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *NatRule) GetPublicPort() uint32 {
+	if x != nil {
+		return x.PublicPort
+	}
+	return 0
+}
+
+func (x *NatRule) GetInternalIp() string {
+	if x != nil {
+		return x.InternalIp
+	}
+	return ""
+}
+
+func (x *NatRule) GetInternalPort() uint32 {
+	if x != nil {
+		return x.InternalPort
+	}
+	return 0
+}
+
+func (x *NatRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NatRule) GetTranslated() uint64 {
+	if x != nil {
+		return x.Translated
+	}
+	return 0
+}
+
+func (x *NatRule) GetReplies() uint64 {
+	if x != nil {
+		return x.Replies
+	}
+	return 0
+}
+
+func (x *NatRule) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *NatRule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NatRule) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *NatRule) GetPortMin() uint32 {
+	if x != nil {
+		return x.PortMin
+	}
+	return 0
+}
+
+func (x *NatRule) GetPortMax() uint32 {
+	if x != nil {
+		return x.PortMax
+	}
+	return 0
+}
+
+func (x *NatRule) GetTcpTimeout() uint32 {
+	if x != nil {
+		return x.TcpTimeout
+	}
+	return 0
+}
+
+func (x *NatRule) GetUdpTimeout() uint32 {
+	if x != nil {
+		return x.UdpTimeout
+	}
+	return 0
+}
+
+func (x *NatRule) GetSessions() uint32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *NatRule) GetSessionsCreated() uint64 {
+	if x != nil {
+		return x.SessionsCreated
+	}
+	return 0
+}
+
+func (x *NatRule) GetPortsInUse() uint32 {
+	if x != nil {
+		return x.PortsInUse
+	}
+	return 0
+}
+
+type AddNatRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *NatRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *AddNatRuleRequest) Reset() {
+	*x = AddNatRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNatRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNatRuleRequest) ProtoMessage() {}
+
+func (x *AddNatRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNatRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddNatRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AddNatRuleRequest) GetRule() *NatRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type NatRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule    *NatRule `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *NatRuleResponse) Reset() {
+	*x = NatRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatRuleResponse) ProtoMessage() {}
+
+func (x *NatRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatRuleResponse.ProtoReflect.Descriptor instead.
+func (*NatRuleResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *NatRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NatRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NatRuleResponse) GetRule() *NatRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteNatRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteNatRuleRequest) Reset() {
+	*x = DeleteNatRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNatRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNatRuleRequest) ProtoMessage() {}
+
+func (x *DeleteNatRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNatRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNatRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeleteNatRuleRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type NatRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules           []*NatRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Sessions        uint32     `protobuf:"varint,2,opt,name=sessions,proto3" json:"sessions,omitempty"`                                      // Open source NAT sessions of every rule
+	SessionCapacity uint32     `protobuf:"varint,3,opt,name=session_capacity,json=sessionCapacity,proto3" json:"session_capacity,omitempty"` // Sessions the data plane holds before evicting the oldest
+}
+
+func (x *NatRulesResponse) Reset() {
+	*x = NatRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatRulesResponse) ProtoMessage() {}
+
+func (x *NatRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatRulesResponse.ProtoReflect.Descriptor instead.
+func (*NatRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *NatRulesResponse) GetRules() []*NatRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *NatRulesResponse) GetSessions() uint32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *NatRulesResponse) GetSessionCapacity() uint32 {
+	if x != nil {
+		return x.SessionCapacity
+	}
+	return 0
+}
+
+type ListNatSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                 // 0 = default limit (1000)
+	RuleId uint32 `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // 0 = every rule
+}
+
+func (x *ListNatSessionsRequest) Reset() {
+	*x = ListNatSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNatSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNatSessionsRequest) ProtoMessage() {}
+
+func (x *ListNatSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNatSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNatSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListNatSessionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNatSessionsRequest) GetRuleId() uint32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// An open source NAT session: internal_ip:internal_port talking to
+// remote_ip:remote_port as public_ip:public_port
+type NatSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId       uint32 `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Protocol     string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	InternalIp   string `protobuf:"bytes,3,opt,name=internal_ip,json=internalIp,proto3" json:"internal_ip,omitempty"`
+	InternalPort uint32 `protobuf:"varint,4,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"`
+	RemoteIp     string `protobuf:"bytes,5,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	RemotePort   uint32 `protobuf:"varint,6,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	PublicIp     string `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	PublicPort   uint32 `protobuf:"varint,8,opt,name=public_port,json=publicPort,proto3" json:"public_port,omitempty"`
+	IdleSeconds  int64  `protobuf:"varint,9,opt,name=idle_seconds,json=idleSeconds,proto3" json:"idle_seconds,omitempty"`
+}
+
+func (x *NatSession) Reset() {
+	*x = NatSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatSession) ProtoMessage() {}
+
+func (x *NatSession) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatSession.ProtoReflect.Descriptor instead.
+func (*NatSession) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *NatSession) GetRuleId() uint32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *NatSession) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NatSession) GetInternalIp() string {
+	if x != nil {
+		return x.InternalIp
+	}
+	return ""
+}
+
+func (x *NatSession) GetInternalPort() uint32 {
+	if x != nil {
+		return x.InternalPort
+	}
+	return 0
+}
+
+func (x *NatSession) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *NatSession) GetRemotePort() uint32 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *NatSession) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *NatSession) GetPublicPort() uint32 {
+	if x != nil {
+		return x.PublicPort
+	}
+	return 0
+}
+
+func (x *NatSession) GetIdleSeconds() int64 {
+	if x != nil {
+		return x.IdleSeconds
+	}
+	return 0
+}
+
+type NatSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions  []*NatSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Truncated bool          `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *NatSessionsResponse) Reset() {
+	*x = NatSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatSessionsResponse) ProtoMessage() {}
+
+func (x *NatSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatSessionsResponse.ProtoReflect.Descriptor instead.
+func (*NatSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *NatSessionsResponse) GetSessions() []*NatSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *NatSessionsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// DNS filtering. Plain UDP queries to port 53 that the rules pass are
+// matched against the domains of every list: the longest listed suffix of
+// the queried name decides, an allow list winning a domain both kinds
+// list. Blocked queries are dropped or answered with NXDOMAIN
+// (CERBERUS_DNS_BLOCK), and reported as DNS_QUERY_BLOCKED events.
+type DomainList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action      string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`   // "allow" or "deny"
+	Domains     []string `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"` // e.g. "example.com", also matching its subdomains
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Id          uint32   `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`           // Output only
+	Matches     uint64   `protobuf:"varint,6,opt,name=matches,proto3" json:"matches,omitempty"` // Output only: reported queries that matched the list
+}
+
+func (x *DomainList) Reset() {
+	*x = DomainList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainList) ProtoMessage() {}
+
+func (x *DomainList) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainList.ProtoReflect.Descriptor instead.
+func (*DomainList) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DomainList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DomainList) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DomainList) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *DomainList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DomainList) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DomainList) GetMatches() uint64 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type SetDomainListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List *DomainList `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"` // Creates the list or replaces the one with the same name
+}
+
+func (x *SetDomainListRequest) Reset() {
+	*x = SetDomainListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDomainListRequest) ProtoMessage() {}
+
+func (x *SetDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDomainListRequest.ProtoReflect.Descriptor instead.
+func (*SetDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SetDomainListRequest) GetList() *DomainList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DomainListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	List    *DomainList `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *DomainListResponse) Reset() {
+	*x = DomainListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainListResponse) ProtoMessage() {}
+
+func (x *DomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainListResponse.ProtoReflect.Descriptor instead.
+func (*DomainListResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DomainListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DomainListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DomainListResponse) GetList() *DomainList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DeleteDomainListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteDomainListRequest) Reset() {
+	*x = DeleteDomainListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainListRequest) ProtoMessage() {}
+
+func (x *DeleteDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DeleteDomainListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DomainListsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists         []*DomainList `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	Enabled       bool          `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`                                 // Queries are checked: some list exists
+	Block         string        `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`                                      // "drop" or "nxdomain"
+	Report        string        `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`                                    // Queries reported: "none", "blocked" or "all"
+	DefaultAction string        `protobuf:"bytes,5,opt,name=default_action,json=defaultAction,proto3" json:"default_action,omitempty"` // Of queries no list matches: "allow" or "deny"
+	Queries       uint64        `protobuf:"varint,6,opt,name=queries,proto3" json:"queries,omitempty"`                                 // Queries checked by the data plane
+	Allowed       uint64        `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty"`                                 // Matched an allow list
+	Blocked       uint64        `protobuf:"varint,8,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Malformed     uint64        `protobuf:"varint,9,opt,name=malformed,proto3" json:"malformed,omitempty"` // Passed unchecked
+	Lost          uint64        `protobuf:"varint,10,opt,name=lost,proto3" json:"lost,omitempty"`          // Not reported, the ring buffer was full
+}
+
+func (x *DomainListsResponse) Reset() {
+	*x = DomainListsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainListsResponse) ProtoMessage() {}
+
+func (x *DomainListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainListsResponse.ProtoReflect.Descriptor instead.
+func (*DomainListsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DomainListsResponse) GetLists() []*DomainList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *DomainListsResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DomainListsResponse) GetBlock() string {
+	if x != nil {
+		return x.Block
+	}
+	return ""
+}
+
+func (x *DomainListsResponse) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *DomainListsResponse) GetDefaultAction() string {
+	if x != nil {
+		return x.DefaultAction
+	}
+	return ""
+}
+
+func (x *DomainListsResponse) GetQueries() uint64 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *DomainListsResponse) GetAllowed() uint64 {
+	if x != nil {
+		return x.Allowed
+	}
+	return 0
+}
+
+func (x *DomainListsResponse) GetBlocked() uint64 {
+	if x != nil {
+		return x.Blocked
+	}
+	return 0
+}
+
+func (x *DomainListsResponse) GetMalformed() uint64 {
+	if x != nil {
+		return x.Malformed
+	}
+	return 0
+}
+
+func (x *DomainListsResponse) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+// TLS fingerprinting. The ClientHellos of TCP flows the rules pass are
+// fingerprinted with JA3 and JA4 and matched against every rule; a
+// fingerprint both a block and an alert rule list is blocked. Later
+// packets of blocked flows are dropped, so the handshake never completes,
+// and TLS_FINGERPRINT_BLOCKED or TLS_FINGERPRINT_MATCHED events published.
+type TLSFingerprintRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "block" or "alert"
+	// JA3 MD5 hashes, e.g. "e7d705a3286e19ea42f587b344ee6865", or JA4
+	// fingerprints, e.g. "t13d1516h2_8daaf6152771_e5627efa2ab1"
+	Fingerprints []string `protobuf:"bytes,3,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+	Description  string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Matches      uint64   `protobuf:"varint,5,opt,name=matches,proto3" json:"matches,omitempty"` // Output only: ClientHellos that matched the rule
+}
+
+func (x *TLSFingerprintRule) Reset() {
+	*x = TLSFingerprintRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSFingerprintRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSFingerprintRule) ProtoMessage() {}
+
+func (x *TLSFingerprintRule) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSFingerprintRule.ProtoReflect.Descriptor instead.
+func (*TLSFingerprintRule) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TLSFingerprintRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TLSFingerprintRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TLSFingerprintRule) GetFingerprints() []string {
+	if x != nil {
+		return x.Fingerprints
+	}
+	return nil
+}
+
+func (x *TLSFingerprintRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TLSFingerprintRule) GetMatches() uint64 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type SetTLSFingerprintRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *TLSFingerprintRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // Creates the rule or replaces the one with the same name
+}
+
+func (x *SetTLSFingerprintRuleRequest) Reset() {
+	*x = SetTLSFingerprintRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTLSFingerprintRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTLSFingerprintRuleRequest) ProtoMessage() {}
+
+func (x *SetTLSFingerprintRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTLSFingerprintRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetTLSFingerprintRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetTLSFingerprintRuleRequest) GetRule() *TLSFingerprintRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type TLSFingerprintRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool                `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule    *TLSFingerprintRule `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *TLSFingerprintRuleResponse) Reset() {
+	*x = TLSFingerprintRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSFingerprintRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSFingerprintRuleResponse) ProtoMessage() {}
+
+func (x *TLSFingerprintRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSFingerprintRuleResponse.ProtoReflect.Descriptor instead.
+func (*TLSFingerprintRuleResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *TLSFingerprintRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TLSFingerprintRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TLSFingerprintRuleResponse) GetRule() *TLSFingerprintRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteTLSFingerprintRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteTLSFingerprintRuleRequest) Reset() {
+	*x = DeleteTLSFingerprintRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTLSFingerprintRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTLSFingerprintRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTLSFingerprintRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTLSFingerprintRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTLSFingerprintRuleRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *DeleteTLSFingerprintRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TLSFingerprintRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules          []*TLSFingerprintRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Enabled        bool                  `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"` // ClientHellos are reported: some rule exists or all are
+	Report         string                `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`    // Reported as events: "matched" or "all"
+	Hellos         uint64                `protobuf:"varint,4,opt,name=hellos,proto3" json:"hellos,omitempty"`   // ClientHellos the data plane reported
+	Fingerprinted  uint64                `protobuf:"varint,5,opt,name=fingerprinted,proto3" json:"fingerprinted,omitempty"`
+	Truncated      uint64                `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"` // Longer than the segment they start in, not fingerprinted
+	Lost           uint64                `protobuf:"varint,7,opt,name=lost,proto3" json:"lost,omitempty"`           // Not reported, the ring buffer was full
+	BlockedFlows   uint64                `protobuf:"varint,8,opt,name=blocked_flows,json=blockedFlows,proto3" json:"blocked_flows,omitempty"`
+	DroppedPackets uint64                `protobuf:"varint,9,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"` // Later packets of blocked flows
+}
+
+func (x *TLSFingerprintRulesResponse) Reset() {
+	*x = TLSFingerprintRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSFingerprintRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSFingerprintRulesResponse) ProtoMessage() {}
+
+func (x *TLSFingerprintRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSFingerprintRulesResponse.ProtoReflect.Descriptor instead.
+func (*TLSFingerprintRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *TLSFingerprintRulesResponse) GetRules() []*TLSFingerprintRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *TLSFingerprintRulesResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TLSFingerprintRulesResponse) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *TLSFingerprintRulesResponse) GetHellos() uint64 {
+	if x != nil {
+		return x.Hellos
+	}
+	return 0
+}
+
+func (x *TLSFingerprintRulesResponse) GetFingerprinted() uint64 {
+	if x != nil {
+		return x.Fingerprinted
+	}
+	return 0
+}
+
+func (x *TLSFingerprintRulesResponse) GetTruncated() uint64 {
+	if x != nil {
+		return x.Truncated
+	}
+	return 0
+}
+
+func (x *TLSFingerprintRulesResponse) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *TLSFingerprintRulesResponse) GetBlockedFlows() uint64 {
+	if x != nil {
+		return x.BlockedFlows
+	}
+	return 0
+}
+
+func (x *TLSFingerprintRulesResponse) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+// SYN flood mitigation. New TCP SYNs the rules pass are counted by
+// destination address; a destination receiving more than syn_threshold in
+// a second is mitigated for hold_seconds after that last happened, and
+// SYN_FLOOD_DETECTED and SYN_FLOOD_ENDED events published. New SYNs to it
+// are rate limited, or answered with a SYN cookie: the data plane replies
+// with a SYN-ACK acknowledging a cookie, a real client returns it in an
+// RST and retries its SYN about a second later, which passes. Sources
+// that returned a cookie pass for verified_seconds. Cookies are answered
+// on the XDP path only; elsewhere syn_cookie falls back to the rate.
+type MitigationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode            string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                               // "off", "rate_limit" or "syn_cookie"
+	SynThreshold    uint32 `protobuf:"varint,2,opt,name=syn_threshold,json=synThreshold,proto3" json:"syn_threshold,omitempty"`          // SYNs per second to one destination that start mitigating it, 0 = 1000
+	HoldSeconds     uint32 `protobuf:"varint,3,opt,name=hold_seconds,json=holdSeconds,proto3" json:"hold_seconds,omitempty"`             // 0 = 60
+	SynRate         uint32 `protobuf:"varint,4,opt,name=syn_rate,json=synRate,proto3" json:"syn_rate,omitempty"`                         // SYNs per second passed to a mitigated destination when rate limiting, 0 = 100
+	SynBurst        uint32 `protobuf:"varint,5,opt,name=syn_burst,json=synBurst,proto3" json:"syn_burst,omitempty"`                      // SYNs above the rate allowed at once, 0 = the rate
+	VerifiedSeconds uint32 `protobuf:"varint,6,opt,name=verified_seconds,json=verifiedSeconds,proto3" json:"verified_seconds,omitempty"` // 0 = 300
+}
+
+func (x *MitigationConfig) Reset() {
+	*x = MitigationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MitigationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MitigationConfig) ProtoMessage() {}
+
+func (x *MitigationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MitigationConfig.ProtoReflect.Descriptor instead.
+func (*MitigationConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *MitigationConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MitigationConfig) GetSynThreshold() uint32 {
+	if x != nil {
+		return x.SynThreshold
+	}
+	return 0
+}
+
+func (x *MitigationConfig) GetHoldSeconds() uint32 {
+	if x != nil {
+		return x.HoldSeconds
+	}
+	return 0
+}
+
+func (x *MitigationConfig) GetSynRate() uint32 {
+	if x != nil {
+		return x.SynRate
+	}
+	return 0
+}
+
+func (x *MitigationConfig) GetSynBurst() uint32 {
+	if x != nil {
+		return x.SynBurst
+	}
+	return 0
+}
+
+func (x *MitigationConfig) GetVerifiedSeconds() uint32 {
+	if x != nil {
+		return x.VerifiedSeconds
+	}
+	return 0
+}
+
+type SetMitigationConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *MitigationConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetMitigationConfigRequest) Reset() {
+	*x = SetMitigationConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMitigationConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMitigationConfigRequest) ProtoMessage() {}
+
+func (x *SetMitigationConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMitigationConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetMitigationConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SetMitigationConfigRequest) GetConfig() *MitigationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type MitigatedDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SynRate  uint64 `protobuf:"varint,2,opt,name=syn_rate,json=synRate,proto3" json:"syn_rate,omitempty"` // SYNs in the last complete second
+	Since    int64  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`                    // Unix timestamp mitigation started
+	Until    int64  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                    // Unix timestamp it ends unless the threshold is exceeded again
+	Syns     uint64 `protobuf:"varint,5,opt,name=syns,proto3" json:"syns,omitempty"`                      // Counters since the destination was first seen
+	Dropped  uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`                // Rate limited
+	Cookies  uint64 `protobuf:"varint,7,opt,name=cookies,proto3" json:"cookies,omitempty"`                // SYNs answered with a cookie
+	Verified uint64 `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`              // Sources that returned one
+}
+
+func (x *MitigatedDestination) Reset() {
+	*x = MitigatedDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MitigatedDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MitigatedDestination) ProtoMessage() {}
+
+func (x *MitigatedDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MitigatedDestination.ProtoReflect.Descriptor instead.
+func (*MitigatedDestination) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *MitigatedDestination) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MitigatedDestination) GetSynRate() uint64 {
+	if x != nil {
+		return x.SynRate
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetSyns() uint64 {
+	if x != nil {
+		return x.Syns
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetCookies() uint64 {
+	if x != nil {
+		return x.Cookies
+	}
+	return 0
+}
+
+func (x *MitigatedDestination) GetVerified() uint64 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+type MitigationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success        bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config         *MitigationConfig       `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Destinations   []*MitigatedDestination `protobuf:"bytes,4,rep,name=destinations,proto3" json:"destinations,omitempty"` // Mitigated now, by address
+	Syns           uint64                  `protobuf:"varint,5,opt,name=syns,proto3" json:"syns,omitempty"`                // New SYNs counted
+	Dropped        uint64                  `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Cookies        uint64                  `protobuf:"varint,7,opt,name=cookies,proto3" json:"cookies,omitempty"`
+	Verified       uint64                  `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	InvalidCookies uint64                  `protobuf:"varint,9,opt,name=invalid_cookies,json=invalidCookies,proto3" json:"invalid_cookies,omitempty"` // RSTs to mitigated destinations returning a wrong cookie
+}
+
+func (x *MitigationStatusResponse) Reset() {
+	*x = MitigationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MitigationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MitigationStatusResponse) ProtoMessage() {}
+
+func (x *MitigationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MitigationStatusResponse.ProtoReflect.Descriptor instead.
+func (*MitigationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *MitigationStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MitigationStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MitigationStatusResponse) GetConfig() *MitigationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *MitigationStatusResponse) GetDestinations() []*MitigatedDestination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *MitigationStatusResponse) GetSyns() uint64 {
+	if x != nil {
+		return x.Syns
+	}
+	return 0
+}
+
+func (x *MitigationStatusResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *MitigationStatusResponse) GetCookies() uint64 {
+	if x != nil {
+		return x.Cookies
+	}
+	return 0
+}
+
+func (x *MitigationStatusResponse) GetVerified() uint64 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+func (x *MitigationStatusResponse) GetInvalidCookies() uint64 {
+	if x != nil {
+		return x.InvalidCookies
+	}
+	return 0
+}
+
+// Per-source rate limits. Every packet the rules pass is counted against
+// its source address, and so is every new connection; a source above
+// either rate has the excess dropped. Sources are tracked in a table of
+// the most recently seen, whose forgotten entries start over. Zero rates
+// are off.
+type SourceLimitConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PacketsPerSecond     uint32 `protobuf:"varint,1,opt,name=packets_per_second,json=packetsPerSecond,proto3" json:"packets_per_second,omitempty"`
+	PacketBurst          uint32 `protobuf:"varint,2,opt,name=packet_burst,json=packetBurst,proto3" json:"packet_burst,omitempty"` // Packets above the rate allowed at once, 0 = the rate
+	ConnectionsPerSecond uint32 `protobuf:"varint,3,opt,name=connections_per_second,json=connectionsPerSecond,proto3" json:"connections_per_second,omitempty"`
+	ConnectionBurst      uint32 `protobuf:"varint,4,opt,name=connection_burst,json=connectionBurst,proto3" json:"connection_burst,omitempty"` // 0 = the rate
+}
+
+func (x *SourceLimitConfig) Reset() {
+	*x = SourceLimitConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceLimitConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceLimitConfig) ProtoMessage() {}
+
+func (x *SourceLimitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceLimitConfig.ProtoReflect.Descriptor instead.
+func (*SourceLimitConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *SourceLimitConfig) GetPacketsPerSecond() uint32 {
+	if x != nil {
+		return x.PacketsPerSecond
+	}
+	return 0
+}
+
+func (x *SourceLimitConfig) GetPacketBurst() uint32 {
+	if x != nil {
+		return x.PacketBurst
+	}
+	return 0
+}
+
+func (x *SourceLimitConfig) GetConnectionsPerSecond() uint32 {
+	if x != nil {
+		return x.ConnectionsPerSecond
+	}
+	return 0
+}
+
+func (x *SourceLimitConfig) GetConnectionBurst() uint32 {
+	if x != nil {
+		return x.ConnectionBurst
+	}
+	return 0
+}
+
+type SetSourceLimitConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *SourceLimitConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetSourceLimitConfigRequest) Reset() {
+	*x = SetSourceLimitConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSourceLimitConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSourceLimitConfigRequest) ProtoMessage() {}
+
+func (x *SetSourceLimitConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSourceLimitConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetSourceLimitConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *SetSourceLimitConfigRequest) GetConfig() *SourceLimitConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// A source the rate limits do not apply to until expires_at
+type SourceExemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                       // Host address
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+}
+
+func (x *SourceExemption) Reset() {
+	*x = SourceExemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceExemption) ProtoMessage() {}
+
+func (x *SourceExemption) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceExemption.ProtoReflect.Descriptor instead.
+func (*SourceExemption) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *SourceExemption) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SourceExemption) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SourceExemption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SourceExemption) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SourceLimitStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success            bool               `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message            string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config             *SourceLimitConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Exemptions         []*SourceExemption `protobuf:"bytes,4,rep,name=exemptions,proto3" json:"exemptions,omitempty"` // By address
+	Packets            uint64             `protobuf:"varint,5,opt,name=packets,proto3" json:"packets,omitempty"`      // Counted against a source
+	Connections        uint64             `protobuf:"varint,6,opt,name=connections,proto3" json:"connections,omitempty"`
+	DroppedPackets     uint64             `protobuf:"varint,7,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`             // Over the packet rate
+	DroppedConnections uint64             `protobuf:"varint,8,opt,name=dropped_connections,json=droppedConnections,proto3" json:"dropped_connections,omitempty"` // Over the connection rate
+	ExemptPackets      uint64             `protobuf:"varint,9,opt,name=exempt_packets,json=exemptPackets,proto3" json:"exempt_packets,omitempty"`                // Passed unlimited from exempt sources
+}
+
+func (x *SourceLimitStatusResponse) Reset() {
+	*x = SourceLimitStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceLimitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceLimitStatusResponse) ProtoMessage() {}
+
+func (x *SourceLimitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceLimitStatusResponse.ProtoReflect.Descriptor instead.
+func (*SourceLimitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *SourceLimitStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SourceLimitStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SourceLimitStatusResponse) GetConfig() *SourceLimitConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SourceLimitStatusResponse) GetExemptions() []*SourceExemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
+func (x *SourceLimitStatusResponse) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *SourceLimitStatusResponse) GetConnections() uint64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *SourceLimitStatusResponse) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *SourceLimitStatusResponse) GetDroppedConnections() uint64 {
+	if x != nil {
+		return x.DroppedConnections
+	}
+	return 0
+}
+
+func (x *SourceLimitStatusResponse) GetExemptPackets() uint64 {
+	if x != nil {
+		return x.ExemptPackets
+	}
+	return 0
+}
+
+type ListTopOffendersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit   int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`     // 0 = 10
+	Seconds int64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"` // Sources that had packets dropped this long ago at most, 0 = 60
+}
+
+func (x *ListTopOffendersRequest) Reset() {
+	*x = ListTopOffendersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopOffendersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopOffendersRequest) ProtoMessage() {}
+
+func (x *ListTopOffendersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopOffendersRequest.ProtoReflect.Descriptor instead.
+func (*ListTopOffendersRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListTopOffendersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTopOffendersRequest) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type SourceOffender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PacketRate         uint64 `protobuf:"varint,2,opt,name=packet_rate,json=packetRate,proto3" json:"packet_rate,omitempty"` // Packets in the last complete second
+	Packets            uint64 `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`                         // Counters since the source was last tracked
+	Connections        uint64 `protobuf:"varint,4,opt,name=connections,proto3" json:"connections,omitempty"`
+	DroppedPackets     uint64 `protobuf:"varint,5,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`
+	DroppedConnections uint64 `protobuf:"varint,6,opt,name=dropped_connections,json=droppedConnections,proto3" json:"dropped_connections,omitempty"`
+	LastDrop           int64  `protobuf:"varint,7,opt,name=last_drop,json=lastDrop,proto3" json:"last_drop,omitempty"` // Unix timestamp
+}
+
+func (x *SourceOffender) Reset() {
+	*x = SourceOffender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceOffender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceOffender) ProtoMessage() {}
+
+func (x *SourceOffender) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceOffender.ProtoReflect.Descriptor instead.
+func (*SourceOffender) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SourceOffender) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SourceOffender) GetPacketRate() uint64 {
+	if x != nil {
+		return x.PacketRate
+	}
+	return 0
+}
+
+func (x *SourceOffender) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *SourceOffender) GetConnections() uint64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *SourceOffender) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *SourceOffender) GetDroppedConnections() uint64 {
+	if x != nil {
+		return x.DroppedConnections
+	}
+	return 0
+}
+
+func (x *SourceOffender) GetLastDrop() int64 {
+	if x != nil {
+		return x.LastDrop
+	}
+	return 0
+}
+
+type TopOffendersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Offenders []*SourceOffender `protobuf:"bytes,3,rep,name=offenders,proto3" json:"offenders,omitempty"` // Most dropped first
+}
+
+func (x *TopOffendersResponse) Reset() {
+	*x = TopOffendersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopOffendersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopOffendersResponse) ProtoMessage() {}
+
+func (x *TopOffendersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopOffendersResponse.ProtoReflect.Descriptor instead.
+func (*TopOffendersResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *TopOffendersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TopOffendersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TopOffendersResponse) GetOffenders() []*SourceOffender {
+	if x != nil {
+		return x.Offenders
+	}
+	return nil
+}
+
+type ExemptSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TtlSeconds int64  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 = 3600
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ExemptSourceRequest) Reset() {
+	*x = ExemptSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExemptSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExemptSourceRequest) ProtoMessage() {}
+
+func (x *ExemptSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExemptSourceRequest.ProtoReflect.Descriptor instead.
+func (*ExemptSourceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ExemptSourceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExemptSourceRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ExemptSourceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SourceExemptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Exemption *SourceExemption `protobuf:"bytes,3,opt,name=exemption,proto3" json:"exemption,omitempty"`
+}
+
+func (x *SourceExemptionResponse) Reset() {
+	*x = SourceExemptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceExemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceExemptionResponse) ProtoMessage() {}
+
+func (x *SourceExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceExemptionResponse.ProtoReflect.Descriptor instead.
+func (*SourceExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SourceExemptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SourceExemptionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SourceExemptionResponse) GetExemption() *SourceExemption {
+	if x != nil {
+		return x.Exemption
+	}
+	return nil
+}
+
+type DeleteSourceExemptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *DeleteSourceExemptionRequest) Reset() {
+	*x = DeleteSourceExemptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSourceExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSourceExemptionRequest) ProtoMessage() {}
+
+func (x *DeleteSourceExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSourceExemptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSourceExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DeleteSourceExemptionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Automatic blocking. Sampled drops and AUTH_FAILED events are counted by
+// source address over window_seconds; a source exceeding a threshold is
+// blocked by an inbound drop rule expiring after block_seconds, multiplied
+// by backoff_factor for every earlier block still remembered, up to
+// max_block_seconds. Blocks are forgotten forget_seconds after they end.
+// AUTO_BLOCKED and AUTO_UNBLOCKED events report every block and its end.
+type AutoBlockConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DropThreshold        uint32   `protobuf:"varint,2,opt,name=drop_threshold,json=dropThreshold,proto3" json:"drop_threshold,omitempty"`                        // Estimated dropped packets, 0 = drops not counted
+	AuthFailureThreshold uint32   `protobuf:"varint,3,opt,name=auth_failure_threshold,json=authFailureThreshold,proto3" json:"auth_failure_threshold,omitempty"` // 0 = auth failures not counted
+	WindowSeconds        uint32   `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`                        // 0 = 60
+	BlockSeconds         uint32   `protobuf:"varint,5,opt,name=block_seconds,json=blockSeconds,proto3" json:"block_seconds,omitempty"`                           // 0 = 600
+	BackoffFactor        uint32   `protobuf:"varint,6,opt,name=backoff_factor,json=backoffFactor,proto3" json:"backoff_factor,omitempty"`                        // 0 = 2, 1 = no backoff
+	MaxBlockSeconds      uint32   `protobuf:"varint,7,opt,name=max_block_seconds,json=maxBlockSeconds,proto3" json:"max_block_seconds,omitempty"`                // 0 = 7 days
+	ForgetSeconds        uint32   `protobuf:"varint,8,opt,name=forget_seconds,json=forgetSeconds,proto3" json:"forget_seconds,omitempty"`                        // 0 = 1 day
+	Exempt               []string `protobuf:"bytes,9,rep,name=exempt,proto3" json:"exempt,omitempty"`                                                            // Prefixes never blocked
+	MaxBlocks            uint32   `protobuf:"varint,10,opt,name=max_blocks,json=maxBlocks,proto3" json:"max_blocks,omitempty"`                                   // Blocks in effect at most, 0 = 10000
+}
+
+func (x *AutoBlockConfig) Reset() {
+	*x = AutoBlockConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoBlockConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoBlockConfig) ProtoMessage() {}
+
+func (x *AutoBlockConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoBlockConfig.ProtoReflect.Descriptor instead.
+func (*AutoBlockConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AutoBlockConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AutoBlockConfig) GetDropThreshold() uint32 {
+	if x != nil {
+		return x.DropThreshold
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetAuthFailureThreshold() uint32 {
+	if x != nil {
+		return x.AuthFailureThreshold
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetBlockSeconds() uint32 {
+	if x != nil {
+		return x.BlockSeconds
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetBackoffFactor() uint32 {
+	if x != nil {
+		return x.BackoffFactor
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetMaxBlockSeconds() uint32 {
+	if x != nil {
+		return x.MaxBlockSeconds
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetForgetSeconds() uint32 {
+	if x != nil {
+		return x.ForgetSeconds
+	}
+	return 0
+}
+
+func (x *AutoBlockConfig) GetExempt() []string {
+	if x != nil {
+		return x.Exempt
+	}
+	return nil
+}
+
+func (x *AutoBlockConfig) GetMaxBlocks() uint32 {
+	if x != nil {
+		return x.MaxBlocks
+	}
+	return 0
+}
+
+type SetAutoBlockConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *AutoBlockConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetAutoBlockConfigRequest) Reset() {
+	*x = SetAutoBlockConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoBlockConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoBlockConfigRequest) ProtoMessage() {}
+
+func (x *SetAutoBlockConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoBlockConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoBlockConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SetAutoBlockConfigRequest) GetConfig() *AutoBlockConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type AutoBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // "drops" or "auth_failures"
+	RuleId    string `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	BlockedAt int64  `protobuf:"varint,4,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"` // Unix timestamp
+	ExpiresAt int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	Offense   uint32 `protobuf:"varint,6,opt,name=offense,proto3" json:"offense,omitempty"`                      // 1 for a first block, higher when backed off
+}
+
+func (x *AutoBlock) Reset() {
+	*x = AutoBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoBlock) ProtoMessage() {}
+
+func (x *AutoBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoBlock.ProtoReflect.Descriptor instead.
+func (*AutoBlock) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AutoBlock) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AutoBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AutoBlock) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AutoBlock) GetBlockedAt() int64 {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return 0
+}
+
+func (x *AutoBlock) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *AutoBlock) GetOffense() uint32 {
+	if x != nil {
+		return x.Offense
+	}
+	return 0
+}
+
+type AutoBlockStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success        bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config         *AutoBlockConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Blocks         []*AutoBlock     `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"` // In effect, by address
+	TrackedSources uint64           `protobuf:"varint,5,opt,name=tracked_sources,json=trackedSources,proto3" json:"tracked_sources,omitempty"`
+	BlockedTotal   uint64           `protobuf:"varint,6,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"` // Blocks since the control plane started
+}
+
+func (x *AutoBlockStatusResponse) Reset() {
+	*x = AutoBlockStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoBlockStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoBlockStatusResponse) ProtoMessage() {}
+
+func (x *AutoBlockStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoBlockStatusResponse.ProtoReflect.Descriptor instead.
+func (*AutoBlockStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *AutoBlockStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AutoBlockStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AutoBlockStatusResponse) GetConfig() *AutoBlockConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AutoBlockStatusResponse) GetBlocks() []*AutoBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *AutoBlockStatusResponse) GetTrackedSources() uint64 {
+	if x != nil {
+		return x.TrackedSources
+	}
+	return 0
+}
+
+func (x *AutoBlockStatusResponse) GetBlockedTotal() uint64 {
+	if x != nil {
+		return x.BlockedTotal
+	}
+	return 0
+}
+
+type ReleaseAutoBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ReleaseAutoBlockRequest) Reset() {
+	*x = ReleaseAutoBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseAutoBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAutoBlockRequest) ProtoMessage() {}
+
+func (x *ReleaseAutoBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAutoBlockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAutoBlockRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ReleaseAutoBlockRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Event replay: archived events from start to end fed through automatic
+// blocking with another config, nothing being blocked, to compare what it
+// would have blocked with what was blocked at the time
+type ReplayEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  int64            `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`  // Unix timestamp, 0 = an hour before end
+	End    int64            `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`      // Unix timestamp, 0 = now
+	Config *AutoBlockConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"` // Unset = the current config; always enabled
+	Limit  uint32           `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`  // Blocks listed at most, 0 = 1000
+}
+
+func (x *ReplayEventsRequest) Reset() {
+	*x = ReplayEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEventsRequest) ProtoMessage() {}
+
+func (x *ReplayEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayEventsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ReplayEventsRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReplayEventsRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ReplayEventsRequest) GetConfig() *AutoBlockConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ReplayEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success        bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EventsReplayed uint64           `protobuf:"varint,3,opt,name=events_replayed,json=eventsReplayed,proto3" json:"events_replayed,omitempty"` // Archived events read
+	EventsCounted  uint64           `protobuf:"varint,4,opt,name=events_counted,json=eventsCounted,proto3" json:"events_counted,omitempty"`    // Counted against a source
+	Blocks         []*AutoBlock     `protobuf:"bytes,5,rep,name=blocks,proto3" json:"blocks,omitempty"`                                        // In the order they would have been made, rule_id unset
+	BlocksTotal    uint64           `protobuf:"varint,6,opt,name=blocks_total,json=blocksTotal,proto3" json:"blocks_total,omitempty"`          // Including those over limit
+	RecordedBlocks uint64           `protobuf:"varint,7,opt,name=recorded_blocks,json=recordedBlocks,proto3" json:"recorded_blocks,omitempty"` // AUTO_BLOCKED events archived in the window
+	ReplayOnly     []string         `protobuf:"bytes,8,rep,name=replay_only,json=replayOnly,proto3" json:"replay_only,omitempty"`              // Sources the replay blocks but were not blocked
+	RecordedOnly   []string         `protobuf:"bytes,9,rep,name=recorded_only,json=recordedOnly,proto3" json:"recorded_only,omitempty"`        // Sources blocked but not by the replay
+	Config         *AutoBlockConfig `protobuf:"bytes,10,opt,name=config,proto3" json:"config,omitempty"`                                       // Config replayed, defaults filled in
+}
+
+func (x *ReplayEventsResponse) Reset() {
+	*x = ReplayEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEventsResponse) ProtoMessage() {}
+
+func (x *ReplayEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayEventsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ReplayEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReplayEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplayEventsResponse) GetEventsReplayed() uint64 {
+	if x != nil {
+		return x.EventsReplayed
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetEventsCounted() uint64 {
+	if x != nil {
+		return x.EventsCounted
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetBlocks() []*AutoBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ReplayEventsResponse) GetBlocksTotal() uint64 {
+	if x != nil {
+		return x.BlocksTotal
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetRecordedBlocks() uint64 {
+	if x != nil {
+		return x.RecordedBlocks
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetReplayOnly() []string {
+	if x != nil {
+		return x.ReplayOnly
+	}
+	return nil
+}
+
+func (x *ReplayEventsResponse) GetRecordedOnly() []string {
+	if x != nil {
+		return x.RecordedOnly
+	}
+	return nil
+}
+
+func (x *ReplayEventsResponse) GetConfig() *AutoBlockConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// MSS clamping of the TCP SYNs and SYN-ACKs an interface passes: an MSS
+// option above the target is lowered to it, so that peers behind a tunnel
+// or a link with a smaller MTU size their segments to fit. Without a
+// target the interface MTU sets it (path MTU clamping), read when the
+// config is set or restored.
+type MSSClamp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mss       uint32 `protobuf:"varint,1,opt,name=mss,proto3" json:"mss,omitempty"`            // Target of IPv4 SYNs, 0 = the interface MTU less 40
+	Mss6      uint32 `protobuf:"varint,2,opt,name=mss6,proto3" json:"mss6,omitempty"`          // Target of IPv6 SYNs, 0 = mss less 20, or the MTU less 60
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"` // "inbound", "outbound" or "both", empty = both
+}
+
+func (x *MSSClamp) Reset() {
+	*x = MSSClamp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSSClamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSSClamp) ProtoMessage() {}
+
+func (x *MSSClamp) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSSClamp.ProtoReflect.Descriptor instead.
+func (*MSSClamp) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *MSSClamp) GetMss() uint32 {
+	if x != nil {
+		return x.Mss
+	}
+	return 0
+}
+
+func (x *MSSClamp) GetMss6() uint32 {
+	if x != nil {
+		return x.Mss6
+	}
+	return 0
+}
+
+func (x *MSSClamp) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+// Ingress policer of an interface: packets it receives over either ceiling
+// are dropped before parsing and rule evaluation, so that a volumetric
+// flood cannot overload the rest of the pipeline. Each ceiling is a token
+// bucket shared by every CPU; zero rates are off.
+type Policer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PacketsPerSecond uint32 `protobuf:"varint,1,opt,name=packets_per_second,json=packetsPerSecond,proto3" json:"packets_per_second,omitempty"`
+	PacketBurst      uint32 `protobuf:"varint,2,opt,name=packet_burst,json=packetBurst,proto3" json:"packet_burst,omitempty"` // Packets above the rate allowed at once, 0 = the rate
+	BitsPerSecond    uint64 `protobuf:"varint,3,opt,name=bits_per_second,json=bitsPerSecond,proto3" json:"bits_per_second,omitempty"`
+	BurstBytes       uint64 `protobuf:"varint,4,opt,name=burst_bytes,json=burstBytes,proto3" json:"burst_bytes,omitempty"` // 0 = a second at the rate, at least 65536
+}
+
+func (x *Policer) Reset() {
+	*x = Policer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Policer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policer) ProtoMessage() {}
+
+func (x *Policer) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policer.ProtoReflect.Descriptor instead.
+func (*Policer) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *Policer) GetPacketsPerSecond() uint32 {
+	if x != nil {
+		return x.PacketsPerSecond
+	}
+	return 0
+}
+
+func (x *Policer) GetPacketBurst() uint32 {
+	if x != nil {
+		return x.PacketBurst
+	}
+	return 0
+}
+
+func (x *Policer) GetBitsPerSecond() uint64 {
+	if x != nil {
+		return x.BitsPerSecond
+	}
+	return 0
+}
+
+func (x *Policer) GetBurstBytes() uint64 {
+	if x != nil {
+		return x.BurstBytes
+	}
+	return 0
+}
+
+type InterfaceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface      string    `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`                                   // Interface name, e.g., "wg0"
+	MssClamp       *MSSClamp `protobuf:"bytes,2,opt,name=mss_clamp,json=mssClamp,proto3" json:"mss_clamp,omitempty"`                     // Unset = no clamping
+	Mtu            uint32    `protobuf:"varint,3,opt,name=mtu,proto3" json:"mtu,omitempty"`                                              // Output only: MTU when the config was applied
+	EffectiveMss   uint32    `protobuf:"varint,4,opt,name=effective_mss,json=effectiveMss,proto3" json:"effective_mss,omitempty"`        // Output only: targets applied to IPv4 and IPv6 SYNs
+	EffectiveMss6  uint32    `protobuf:"varint,5,opt,name=effective_mss6,json=effectiveMss6,proto3" json:"effective_mss6,omitempty"`     // Output only
+	MssSyns        uint64    `protobuf:"varint,6,opt,name=mss_syns,json=mssSyns,proto3" json:"mss_syns,omitempty"`                       // Output only: SYNs inspected
+	MssClamped     uint64    `protobuf:"varint,7,opt,name=mss_clamped,json=mssClamped,proto3" json:"mss_clamped,omitempty"`              // Output only: SYNs whose MSS was lowered
+	MssMissing     uint64    `protobuf:"varint,8,opt,name=mss_missing,json=mssMissing,proto3" json:"mss_missing,omitempty"`              // Output only: SYNs without an MSS option
+	Policer        *Policer  `protobuf:"bytes,9,opt,name=policer,proto3" json:"policer,omitempty"`                                       // Unset = no policing
+	PolicedPackets uint64    `protobuf:"varint,10,opt,name=policed_packets,json=policedPackets,proto3" json:"policed_packets,omitempty"` // Output only: packets dropped by the policer
+	PolicedBytes   uint64    `protobuf:"varint,11,opt,name=policed_bytes,json=policedBytes,proto3" json:"policed_bytes,omitempty"`       // Output only
+}
+
+func (x *InterfaceConfig) Reset() {
+	*x = InterfaceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceConfig) ProtoMessage() {}
+
+func (x *InterfaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceConfig.ProtoReflect.Descriptor instead.
+func (*InterfaceConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *InterfaceConfig) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *InterfaceConfig) GetMssClamp() *MSSClamp {
+	if x != nil {
+		return x.MssClamp
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetEffectiveMss() uint32 {
+	if x != nil {
+		return x.EffectiveMss
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetEffectiveMss6() uint32 {
+	if x != nil {
+		return x.EffectiveMss6
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetMssSyns() uint64 {
+	if x != nil {
+		return x.MssSyns
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetMssClamped() uint64 {
+	if x != nil {
+		return x.MssClamped
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetMssMissing() uint64 {
+	if x != nil {
+		return x.MssMissing
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetPolicer() *Policer {
+	if x != nil {
+		return x.Policer
+	}
+	return nil
+}
+
+func (x *InterfaceConfig) GetPolicedPackets() uint64 {
+	if x != nil {
+		return x.PolicedPackets
+	}
+	return 0
+}
+
+func (x *InterfaceConfig) GetPolicedBytes() uint64 {
+	if x != nil {
+		return x.PolicedBytes
+	}
+	return 0
+}
+
+type SetInterfaceConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *InterfaceConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"` // Replaces the interface's config
+}
+
+func (x *SetInterfaceConfigRequest) Reset() {
+	*x = SetInterfaceConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInterfaceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInterfaceConfigRequest) ProtoMessage() {}
+
+func (x *SetInterfaceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInterfaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfaceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SetInterfaceConfigRequest) GetConfig() *InterfaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type InterfaceConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool             `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config  *InterfaceConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *InterfaceConfigResponse) Reset() {
+	*x = InterfaceConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceConfigResponse) ProtoMessage() {}
+
+func (x *InterfaceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceConfigResponse.ProtoReflect.Descriptor instead.
+func (*InterfaceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *InterfaceConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InterfaceConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InterfaceConfigResponse) GetConfig() *InterfaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DeleteInterfaceConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+}
+
+func (x *DeleteInterfaceConfigRequest) Reset() {
+	*x = DeleteInterfaceConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInterfaceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterfaceConfigRequest) ProtoMessage() {}
+
+func (x *DeleteInterfaceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterfaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInterfaceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *DeleteInterfaceConfigRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+type InterfaceConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interfaces []*InterfaceConfig `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"` // By name
+}
+
+func (x *InterfaceConfigsResponse) Reset() {
+	*x = InterfaceConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceConfigsResponse) ProtoMessage() {}
+
+func (x *InterfaceConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceConfigsResponse.ProtoReflect.Descriptor instead.
+func (*InterfaceConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *InterfaceConfigsResponse) GetInterfaces() []*InterfaceConfig {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// Port scan detection. The data plane counts the distinct ports each
+// source tries new TCP or UDP connections to over window_seconds; every
+// interval the control plane raises a THREAT_DETECTION event for each
+// source reaching port_threshold and, with quarantine, blocks it like
+// automatic blocking does, for its block_seconds and backoff.
+type PortScanConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled       bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PortThreshold uint32   `protobuf:"varint,2,opt,name=port_threshold,json=portThreshold,proto3" json:"port_threshold,omitempty"` // Distinct ports, 0 = 100
+	WindowSeconds uint32   `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"` // 0 = 60
+	Quarantine    bool     `protobuf:"varint,4,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	Exempt        []string `protobuf:"bytes,5,rep,name=exempt,proto3" json:"exempt,omitempty"` // Prefixes never reported
+}
+
+func (x *PortScanConfig) Reset() {
+	*x = PortScanConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortScanConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortScanConfig) ProtoMessage() {}
+
+func (x *PortScanConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortScanConfig.ProtoReflect.Descriptor instead.
+func (*PortScanConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *PortScanConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PortScanConfig) GetPortThreshold() uint32 {
+	if x != nil {
+		return x.PortThreshold
+	}
+	return 0
+}
+
+func (x *PortScanConfig) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *PortScanConfig) GetQuarantine() bool {
+	if x != nil {
+		return x.Quarantine
+	}
+	return false
+}
+
+func (x *PortScanConfig) GetExempt() []string {
+	if x != nil {
+		return x.Exempt
+	}
+	return nil
+}
+
+type SetPortScanConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *PortScanConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetPortScanConfigRequest) Reset() {
+	*x = SetPortScanConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPortScanConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPortScanConfigRequest) ProtoMessage() {}
+
+func (x *SetPortScanConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPortScanConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetPortScanConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *SetPortScanConfigRequest) GetConfig() *PortScanConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PortScanner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Ports       uint64 `protobuf:"varint,2,opt,name=ports,proto3" json:"ports,omitempty"`                       // Distinct ports in the current or last window
+	Attempts    uint64 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`                 // Connection attempts in the current window
+	LastSeen    int64  `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // Unix timestamp
+	Quarantined bool   `protobuf:"varint,5,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+}
+
+func (x *PortScanner) Reset() {
+	*x = PortScanner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortScanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortScanner) ProtoMessage() {}
+
+func (x *PortScanner) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortScanner.ProtoReflect.Descriptor instead.
+func (*PortScanner) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *PortScanner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PortScanner) GetPorts() uint64 {
+	if x != nil {
+		return x.Ports
+	}
+	return 0
+}
+
+func (x *PortScanner) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PortScanner) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PortScanner) GetQuarantined() bool {
+	if x != nil {
+		return x.Quarantined
+	}
+	return false
+}
+
+type PortScanStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config     *PortScanConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Scanners   []*PortScanner  `protobuf:"bytes,4,rep,name=scanners,proto3" json:"scanners,omitempty"`      // Sources over the threshold, most ports first
+	Sources    uint64          `protobuf:"varint,5,opt,name=sources,proto3" json:"sources,omitempty"`       // Sources the data plane tracks
+	Attempts   uint64          `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`     // Connection attempts counted
+	Detections uint64          `protobuf:"varint,7,opt,name=detections,proto3" json:"detections,omitempty"` // THREAT_DETECTION events since start
+}
+
+func (x *PortScanStatusResponse) Reset() {
+	*x = PortScanStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortScanStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortScanStatusResponse) ProtoMessage() {}
+
+func (x *PortScanStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortScanStatusResponse.ProtoReflect.Descriptor instead.
+func (*PortScanStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *PortScanStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PortScanStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PortScanStatusResponse) GetConfig() *PortScanConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PortScanStatusResponse) GetScanners() []*PortScanner {
+	if x != nil {
+		return x.Scanners
+	}
+	return nil
+}
+
+func (x *PortScanStatusResponse) GetSources() uint64 {
+	if x != nil {
+		return x.Sources
+	}
+	return 0
+}
+
+func (x *PortScanStatusResponse) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PortScanStatusResponse) GetDetections() uint64 {
+	if x != nil {
+		return x.Detections
+	}
+	return 0
+}
+
+// Packet normalization. Inbound IP packets are checked right after
+// parsing, before DNAT and rules, and dropped for each check that is on:
+// sources in the bogon list (martians, documentation and reserved ranges,
+// plus private and shared space with drop_private), IPv4 header and TCP,
+// UDP or ICMP checksums that do not verify, and TCP flag combinations no
+// stack sends (NULL, XMAS, SYN with FIN or RST, FIN or PSH or URG without
+// ACK). Every interval the control plane raises a PACKET_NORMALIZED event
+// for each source and reason that had packets dropped.
+type NormalizationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DropBogons           bool     `protobuf:"varint,1,opt,name=drop_bogons,json=dropBogons,proto3" json:"drop_bogons,omitempty"`
+	DropPrivate          bool     `protobuf:"varint,2,opt,name=drop_private,json=dropPrivate,proto3" json:"drop_private,omitempty"`                              // With drop_bogons: RFC 1918, RFC 6598 and ULA sources too
+	DropInvalidChecksums bool     `protobuf:"varint,3,opt,name=drop_invalid_checksums,json=dropInvalidChecksums,proto3" json:"drop_invalid_checksums,omitempty"` // Segments longer than 1536 bytes are not verified
+	DropInvalidTcpFlags  bool     `protobuf:"varint,4,opt,name=drop_invalid_tcp_flags,json=dropInvalidTcpFlags,proto3" json:"drop_invalid_tcp_flags,omitempty"`
+	Exempt               []string `protobuf:"bytes,5,rep,name=exempt,proto3" json:"exempt,omitempty"` // Source prefixes never dropped as bogons
+}
+
+func (x *NormalizationConfig) Reset() {
+	*x = NormalizationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizationConfig) ProtoMessage() {}
+
+func (x *NormalizationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizationConfig.ProtoReflect.Descriptor instead.
+func (*NormalizationConfig) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *NormalizationConfig) GetDropBogons() bool {
+	if x != nil {
+		return x.DropBogons
+	}
+	return false
+}
+
+func (x *NormalizationConfig) GetDropPrivate() bool {
+	if x != nil {
+		return x.DropPrivate
+	}
+	return false
+}
+
+func (x *NormalizationConfig) GetDropInvalidChecksums() bool {
+	if x != nil {
+		return x.DropInvalidChecksums
+	}
+	return false
+}
+
+func (x *NormalizationConfig) GetDropInvalidTcpFlags() bool {
+	if x != nil {
+		return x.DropInvalidTcpFlags
+	}
+	return false
+}
+
+func (x *NormalizationConfig) GetExempt() []string {
+	if x != nil {
+		return x.Exempt
+	}
+	return nil
+}
+
+type SetNormalizationConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *NormalizationConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetNormalizationConfigRequest) Reset() {
+	*x = SetNormalizationConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNormalizationConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNormalizationConfigRequest) ProtoMessage() {}
+
+func (x *SetNormalizationConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNormalizationConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetNormalizationConfigRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *SetNormalizationConfigRequest) GetConfig() *NormalizationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type NormalizationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success     bool                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message     string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Config      *NormalizationConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Bogons      uint64               `protobuf:"varint,4,opt,name=bogons,proto3" json:"bogons,omitempty"` // Packets dropped, by reason
+	IpChecksums uint64               `protobuf:"varint,5,opt,name=ip_checksums,json=ipChecksums,proto3" json:"ip_checksums,omitempty"`
+	L4Checksums uint64               `protobuf:"varint,6,opt,name=l4_checksums,json=l4Checksums,proto3" json:"l4_checksums,omitempty"`
+	TcpNull     uint64               `protobuf:"varint,7,opt,name=tcp_null,json=tcpNull,proto3" json:"tcp_null,omitempty"`
+	TcpXmas     uint64               `protobuf:"varint,8,opt,name=tcp_xmas,json=tcpXmas,proto3" json:"tcp_xmas,omitempty"`
+	TcpFlags    uint64               `protobuf:"varint,9,opt,name=tcp_flags,json=tcpFlags,proto3" json:"tcp_flags,omitempty"` // Other illegal combinations
+	Events      uint64               `protobuf:"varint,10,opt,name=events,proto3" json:"events,omitempty"`                    // PACKET_NORMALIZED events since start
+}
+
+func (x *NormalizationStatusResponse) Reset() {
+	*x = NormalizationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizationStatusResponse) ProtoMessage() {}
+
+func (x *NormalizationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizationStatusResponse.ProtoReflect.Descriptor instead.
+func (*NormalizationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *NormalizationStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *NormalizationStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NormalizationStatusResponse) GetConfig() *NormalizationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *NormalizationStatusResponse) GetBogons() uint64 {
+	if x != nil {
+		return x.Bogons
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetIpChecksums() uint64 {
+	if x != nil {
+		return x.IpChecksums
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetL4Checksums() uint64 {
+	if x != nil {
+		return x.L4Checksums
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetTcpNull() uint64 {
+	if x != nil {
+		return x.TcpNull
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetTcpXmas() uint64 {
+	if x != nil {
+		return x.TcpXmas
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetTcpFlags() uint64 {
+	if x != nil {
+		return x.TcpFlags
+	}
+	return 0
+}
+
+func (x *NormalizationStatusResponse) GetEvents() uint64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+type PolicyDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules          []*Rule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Zones          []*Zone          `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	ZonePolicies   []*ZonePolicy    `protobuf:"bytes,3,rep,name=zone_policies,json=zonePolicies,proto3" json:"zone_policies,omitempty"`
+	Services       []*Service       `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	AddressObjects []*AddressObject `protobuf:"bytes,5,rep,name=address_objects,json=addressObjects,proto3" json:"address_objects,omitempty"`
+	Namespaces     []*Namespace     `protobuf:"bytes,6,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	VfPolicies     []*VFPolicy      `protobuf:"bytes,7,rep,name=vf_policies,json=vfPolicies,proto3" json:"vf_policies,omitempty"`
+}
+
+func (x *PolicyDocument) Reset() {
+	*x = PolicyDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDocument) ProtoMessage() {}
+
+func (x *PolicyDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDocument.ProtoReflect.Descriptor instead.
+func (*PolicyDocument) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *PolicyDocument) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetZonePolicies() []*ZonePolicy {
+	if x != nil {
+		return x.ZonePolicies
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetAddressObjects() []*AddressObject {
+	if x != nil {
+		return x.AddressObjects
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *PolicyDocument) GetVfPolicies() []*VFPolicy {
+	if x != nil {
+		return x.VfPolicies
+	}
+	return nil
+}
+
+type PlanApplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PolicyDocument `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Candidate policy replacing the current one
+}
+
+func (x *PlanApplyRequest) Reset() {
+	*x = PlanApplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanApplyRequest) ProtoMessage() {}
+
+func (x *PlanApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanApplyRequest.ProtoReflect.Descriptor instead.
+func (*PlanApplyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *PlanApplyRequest) GetPolicy() *PolicyDocument {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type RuleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Before *Rule `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After  *Rule `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *RuleChange) Reset() {
+	*x = RuleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleChange) ProtoMessage() {}
+
+func (x *RuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleChange.ProtoReflect.Descriptor instead.
+func (*RuleChange) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RuleChange) GetBefore() *Rule {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RuleChange) GetAfter() *Rule {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type PlanApplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid     bool          `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`         // False if the candidate fails validation
+	Errors    []string      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`        // Validation errors, one per offending item
+	Adds      []*Rule       `protobuf:"bytes,3,rep,name=adds,proto3" json:"adds,omitempty"`            // Data plane entries that would be added
+	Removes   []*Rule       `protobuf:"bytes,4,rep,name=removes,proto3" json:"removes,omitempty"`      // Data plane entries that would be removed
+	Modifies  []*RuleChange `protobuf:"bytes,5,rep,name=modifies,proto3" json:"modifies,omitempty"`    // Entries whose match or action changes
+	Unchanged int32         `protobuf:"varint,6,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // Entries left untouched
+}
+
+func (x *PlanApplyResponse) Reset() {
+	*x = PlanApplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanApplyResponse) ProtoMessage() {}
+
+func (x *PlanApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanApplyResponse.ProtoReflect.Descriptor instead.
+func (*PlanApplyResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *PlanApplyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *PlanApplyResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *PlanApplyResponse) GetAdds() []*Rule {
+	if x != nil {
+		return x.Adds
+	}
+	return nil
+}
+
+func (x *PlanApplyResponse) GetRemoves() []*Rule {
+	if x != nil {
+		return x.Removes
+	}
+	return nil
+}
+
+func (x *PlanApplyResponse) GetModifies() []*RuleChange {
+	if x != nil {
+		return x.Modifies
+	}
+	return nil
+}
+
+func (x *PlanApplyResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type AnalyzeRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PolicyDocument `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Candidate policy to analyze, empty = the running policy
+}
+
+func (x *AnalyzeRulesRequest) Reset() {
+	*x = AnalyzeRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRulesRequest) ProtoMessage() {}
+
+func (x *AnalyzeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRulesRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AnalyzeRulesRequest) GetPolicy() *PolicyDocument {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type RuleFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind         string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                                    // "shadowed", "redundant" or "conflict"
+	RuleId       string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                  // Rule the entry was compiled from
+	EntryId      string `protobuf:"bytes,3,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`               // Compiled entry; differs from rule_id for object references and zone policies
+	OtherRuleId  string `protobuf:"bytes,4,opt,name=other_rule_id,json=otherRuleId,proto3" json:"other_rule_id,omitempty"` // Earlier rule that covers or overlaps it
+	OtherEntryId string `protobuf:"bytes,5,opt,name=other_entry_id,json=otherEntryId,proto3" json:"other_entry_id,omitempty"`
+	Message      string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RuleFinding) Reset() {
+	*x = RuleFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleFinding) ProtoMessage() {}
+
+func (x *RuleFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleFinding.ProtoReflect.Descriptor instead.
+func (*RuleFinding) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *RuleFinding) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RuleFinding) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleFinding) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *RuleFinding) GetOtherRuleId() string {
+	if x != nil {
+		return x.OtherRuleId
+	}
+	return ""
+}
+
+func (x *RuleFinding) GetOtherEntryId() string {
+	if x != nil {
+		return x.OtherEntryId
+	}
+	return ""
+}
+
+func (x *RuleFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AnalyzeRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid    bool           `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`      // False if the candidate fails validation
+	Errors   []string       `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`     // Validation errors, one per offending item
+	Findings []*RuleFinding `protobuf:"bytes,3,rep,name=findings,proto3" json:"findings,omitempty"` // In evaluation order
+	Entries  int32          `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`  // Compiled entries analyzed
+	Version  uint64         `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`  // Policy version analyzed, 0 for a candidate
+}
+
+func (x *AnalyzeRulesResponse) Reset() {
+	*x = AnalyzeRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRulesResponse) ProtoMessage() {}
+
+func (x *AnalyzeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRulesResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *AnalyzeRulesResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *AnalyzeRulesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *AnalyzeRulesResponse) GetFindings() []*RuleFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *AnalyzeRulesResponse) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *AnalyzeRulesResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StartShadowPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PolicyDocument `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Candidate policy to count verdicts of, replacing any running one
+}
+
+func (x *StartShadowPolicyRequest) Reset() {
+	*x = StartShadowPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartShadowPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShadowPolicyRequest) ProtoMessage() {}
+
+func (x *StartShadowPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShadowPolicyRequest.ProtoReflect.Descriptor instead.
+func (*StartShadowPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *StartShadowPolicyRequest) GetPolicy() *PolicyDocument {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Sampled packets the active and the candidate policy decide differently
+// on, grouped by the verdicts and the deciding rules
+type ShadowDisagreement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveVerdict string `protobuf:"bytes,1,opt,name=active_verdict,json=activeVerdict,proto3" json:"active_verdict,omitempty"` // "drop", "pass" or "redirect"
+	ShadowVerdict string `protobuf:"bytes,2,opt,name=shadow_verdict,json=shadowVerdict,proto3" json:"shadow_verdict,omitempty"`
+	ActiveRuleId  string `protobuf:"bytes,3,opt,name=active_rule_id,json=activeRuleId,proto3" json:"active_rule_id,omitempty"` // Deciding entry of the active policy, empty = the defaults
+	ShadowRuleId  string `protobuf:"bytes,4,opt,name=shadow_rule_id,json=shadowRuleId,proto3" json:"shadow_rule_id,omitempty"` // Deciding entry of the candidate, empty = the defaults
+	Samples       uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	Packets       uint64 `protobuf:"varint,6,opt,name=packets,proto3" json:"packets,omitempty"`                      // Estimated, samples weighted by their sample rate
+	FirstSeen     int64  `protobuf:"varint,7,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"` // Unix timestamps
+	LastSeen      int64  `protobuf:"varint,8,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Example       *Event `protobuf:"bytes,9,opt,name=example,proto3" json:"example,omitempty"` // Latest sample of the group
+}
+
+func (x *ShadowDisagreement) Reset() {
+	*x = ShadowDisagreement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowDisagreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowDisagreement) ProtoMessage() {}
+
+func (x *ShadowDisagreement) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowDisagreement.ProtoReflect.Descriptor instead.
+func (*ShadowDisagreement) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ShadowDisagreement) GetActiveVerdict() string {
+	if x != nil {
+		return x.ActiveVerdict
+	}
+	return ""
+}
+
+func (x *ShadowDisagreement) GetShadowVerdict() string {
+	if x != nil {
+		return x.ShadowVerdict
+	}
+	return ""
+}
+
+func (x *ShadowDisagreement) GetActiveRuleId() string {
+	if x != nil {
+		return x.ActiveRuleId
+	}
+	return ""
+}
+
+func (x *ShadowDisagreement) GetShadowRuleId() string {
+	if x != nil {
+		return x.ShadowRuleId
+	}
+	return ""
+}
+
+func (x *ShadowDisagreement) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ShadowDisagreement) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ShadowDisagreement) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *ShadowDisagreement) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *ShadowDisagreement) GetExample() *Event {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+type ShadowPolicyReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success               bool                  `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message               string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errors                []string              `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`                         // Validation errors of a rejected candidate
+	Running               bool                  `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`                      // False if no candidate is being evaluated
+	StartedAt             int64                 `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // Unix timestamp
+	Entries               int32                 `protobuf:"varint,6,opt,name=entries,proto3" json:"entries,omitempty"`                      // Compiled entries of the candidate
+	Samples               uint64                `protobuf:"varint,7,opt,name=samples,proto3" json:"samples,omitempty"`                      // Samples both policies were evaluated on
+	Packets               uint64                `protobuf:"varint,8,opt,name=packets,proto3" json:"packets,omitempty"`                      // Estimated packets they stand for
+	AgreedPackets         uint64                `protobuf:"varint,9,opt,name=agreed_packets,json=agreedPackets,proto3" json:"agreed_packets,omitempty"`
+	DisagreedPackets      uint64                `protobuf:"varint,10,opt,name=disagreed_packets,json=disagreedPackets,proto3" json:"disagreed_packets,omitempty"`
+	UncomparedSamples     uint64                `protobuf:"varint,11,opt,name=uncompared_samples,json=uncomparedSamples,proto3" json:"uncompared_samples,omitempty"`               // Samples whose live verdict the active policy does not explain (decided before the rules, or under an older version)
+	OtherDisagreedPackets uint64                `protobuf:"varint,12,opt,name=other_disagreed_packets,json=otherDisagreedPackets,proto3" json:"other_disagreed_packets,omitempty"` // Disagreements past the group limit, counted only
+	Disagreements         []*ShadowDisagreement `protobuf:"bytes,13,rep,name=disagreements,proto3" json:"disagreements,omitempty"`                                                 // Most packets first
+}
+
+func (x *ShadowPolicyReport) Reset() {
+	*x = ShadowPolicyReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowPolicyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowPolicyReport) ProtoMessage() {}
+
+func (x *ShadowPolicyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowPolicyReport.ProtoReflect.Descriptor instead.
+func (*ShadowPolicyReport) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ShadowPolicyReport) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ShadowPolicyReport) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ShadowPolicyReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ShadowPolicyReport) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ShadowPolicyReport) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetAgreedPackets() uint64 {
+	if x != nil {
+		return x.AgreedPackets
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetDisagreedPackets() uint64 {
+	if x != nil {
+		return x.DisagreedPackets
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetUncomparedSamples() uint64 {
+	if x != nil {
+		return x.UncomparedSamples
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetOtherDisagreedPackets() uint64 {
+	if x != nil {
+		return x.OtherDisagreedPackets
+	}
+	return 0
+}
+
+func (x *ShadowPolicyReport) GetDisagreements() []*ShadowDisagreement {
+	if x != nil {
+		return x.Disagreements
+	}
+	return nil
+}
+
+type Namespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // e.g., "blue", "container-web"
+	NetnsPath   string   `protobuf:"bytes,2,opt,name=netns_path,json=netnsPath,proto3" json:"netns_path,omitempty"` // e.g., "/var/run/netns/blue" or "/proc/<pid>/ns/net"
+	PinPath     string   `protobuf:"bytes,3,opt,name=pin_path,json=pinPath,proto3" json:"pin_path,omitempty"`       // bpffs directory of the instance's maps, empty = default
+	Interfaces  []string `protobuf:"bytes,4,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                // Interfaces inside the namespace running XDP
+	Description string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Simulated   bool     `protobuf:"varint,6,opt,name=simulated,proto3" json:"simulated,omitempty"` // Output only: no pinned maps found
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetNetnsPath() string {
+	if x != nil {
+		return x.NetnsPath
+	}
+	return ""
+}
+
+func (x *Namespace) GetPinPath() string {
+	if x != nil {
+		return x.PinPath
+	}
+	return ""
+}
+
+func (x *Namespace) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Namespace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Namespace) GetSimulated() bool {
+	if x != nil {
+		return x.Simulated
+	}
+	return false
+}
+
+type AttachNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Attaches the namespace or replaces an existing one
+}
+
+func (x *AttachNamespaceRequest) Reset() {
+	*x = AttachNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachNamespaceRequest) ProtoMessage() {}
+
+func (x *AttachNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*AttachNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *AttachNamespaceRequest) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type DetachNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DetachNamespaceRequest) Reset() {
+	*x = DetachNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachNamespaceRequest) ProtoMessage() {}
+
+func (x *DetachNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DetachNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *DetachNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NamespacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces []*Namespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *NamespacesResponse) Reset() {
+	*x = NamespacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacesResponse) ProtoMessage() {}
+
+func (x *NamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacesResponse.ProtoReflect.Descriptor instead.
+func (*NamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *NamespacesResponse) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type NamespaceStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Empty = host namespace
+}
+
+func (x *NamespaceStatsRequest) Reset() {
+	*x = NamespaceStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStatsRequest) ProtoMessage() {}
+
+func (x *NamespaceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStatsRequest.ProtoReflect.Descriptor instead.
+func (*NamespaceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *NamespaceStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VirtualFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index       int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PciAddress  string `protobuf:"bytes,2,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"` // e.g., "0000:3b:02.1"
+	Netdev      string `protobuf:"bytes,3,opt,name=netdev,proto3" json:"netdev,omitempty"`                           // VF netdev in the host, empty once passed to a VM
+	Representor string `protobuf:"bytes,4,opt,name=representor,proto3" json:"representor,omitempty"`                 // switchdev representor, e.g., "enp3s0f0_3"
+	Attached    bool   `protobuf:"varint,5,opt,name=attached,proto3" json:"attached,omitempty"`                      // A VF policy is attached
+}
+
+func (x *VirtualFunction) Reset() {
+	*x = VirtualFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualFunction) ProtoMessage() {}
+
+func (x *VirtualFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualFunction.ProtoReflect.Descriptor instead.
+func (*VirtualFunction) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *VirtualFunction) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *VirtualFunction) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *VirtualFunction) GetNetdev() string {
+	if x != nil {
+		return x.Netdev
+	}
+	return ""
+}
+
+func (x *VirtualFunction) GetRepresentor() string {
+	if x != nil {
+		return x.Representor
+	}
+	return ""
+}
+
+func (x *VirtualFunction) GetAttached() bool {
+	if x != nil {
+		return x.Attached
+	}
+	return false
+}
+
+type PhysicalFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // PF netdev, e.g., "enp3s0f0"
+	Driver     string             `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	TotalVfs   int32              `protobuf:"varint,3,opt,name=total_vfs,json=totalVfs,proto3" json:"total_vfs,omitempty"`
+	NumVfs     int32              `protobuf:"varint,4,opt,name=num_vfs,json=numVfs,proto3" json:"num_vfs,omitempty"`
+	Switchdev  bool               `protobuf:"varint,5,opt,name=switchdev,proto3" json:"switchdev,omitempty"`                     // eswitch in switchdev mode, representors available
+	XdpOffload bool               `protobuf:"varint,6,opt,name=xdp_offload,json=xdpOffload,proto3" json:"xdp_offload,omitempty"` // Driver can run XDP in the NIC
+	Vfs        []*VirtualFunction `protobuf:"bytes,7,rep,name=vfs,proto3" json:"vfs,omitempty"`
+}
+
+func (x *PhysicalFunction) Reset() {
+	*x = PhysicalFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhysicalFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhysicalFunction) ProtoMessage() {}
+
+func (x *PhysicalFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhysicalFunction.ProtoReflect.Descriptor instead.
+func (*PhysicalFunction) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *PhysicalFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PhysicalFunction) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *PhysicalFunction) GetTotalVfs() int32 {
+	if x != nil {
+		return x.TotalVfs
+	}
+	return 0
+}
+
+func (x *PhysicalFunction) GetNumVfs() int32 {
+	if x != nil {
+		return x.NumVfs
+	}
+	return 0
+}
+
+func (x *PhysicalFunction) GetSwitchdev() bool {
+	if x != nil {
+		return x.Switchdev
+	}
+	return false
+}
+
+func (x *PhysicalFunction) GetXdpOffload() bool {
+	if x != nil {
+		return x.XdpOffload
+	}
+	return false
+}
+
+func (x *PhysicalFunction) GetVfs() []*VirtualFunction {
+	if x != nil {
+		return x.Vfs
+	}
+	return nil
+}
+
+type SRIOVDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pfs      []*PhysicalFunction `protobuf:"bytes,1,rep,name=pfs,proto3" json:"pfs,omitempty"`
+	Policies []*VFPolicy         `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"` // Attached VF policies
+}
+
+func (x *SRIOVDevicesResponse) Reset() {
+	*x = SRIOVDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRIOVDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRIOVDevicesResponse) ProtoMessage() {}
+
+func (x *SRIOVDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRIOVDevicesResponse.ProtoReflect.Descriptor instead.
+func (*SRIOVDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *SRIOVDevicesResponse) GetPfs() []*PhysicalFunction {
+	if x != nil {
+		return x.Pfs
+	}
+	return nil
+}
+
+func (x *SRIOVDevicesResponse) GetPolicies() []*VFPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type VFPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pf          string `protobuf:"bytes,1,opt,name=pf,proto3" json:"pf,omitempty"`
+	Vf          int32  `protobuf:"varint,2,opt,name=vf,proto3" json:"vf,omitempty"`
+	Representor string `protobuf:"bytes,3,opt,name=representor,proto3" json:"representor,omitempty"`        // Output only: interface running XDP
+	PinPath     string `protobuf:"bytes,4,opt,name=pin_path,json=pinPath,proto3" json:"pin_path,omitempty"` // bpffs directory of the VF's maps, empty = default
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Simulated   bool   `protobuf:"varint,6,opt,name=simulated,proto3" json:"simulated,omitempty"` // Output only: no pinned maps found
+}
+
+func (x *VFPolicy) Reset() {
+	*x = VFPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VFPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VFPolicy) ProtoMessage() {}
+
+func (x *VFPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VFPolicy.ProtoReflect.Descriptor instead.
+func (*VFPolicy) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *VFPolicy) GetPf() string {
+	if x != nil {
+		return x.Pf
+	}
+	return ""
+}
+
+func (x *VFPolicy) GetVf() int32 {
+	if x != nil {
+		return x.Vf
+	}
+	return 0
+}
+
+func (x *VFPolicy) GetRepresentor() string {
+	if x != nil {
+		return x.Representor
+	}
+	return ""
+}
+
+func (x *VFPolicy) GetPinPath() string {
+	if x != nil {
+		return x.PinPath
+	}
+	return ""
+}
+
+func (x *VFPolicy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VFPolicy) GetSimulated() bool {
+	if x != nil {
+		return x.Simulated
+	}
+	return false
+}
+
+type AttachVFPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *VFPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Attaches the VF or replaces an existing attachment
+}
+
+func (x *AttachVFPolicyRequest) Reset() {
+	*x = AttachVFPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachVFPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachVFPolicyRequest) ProtoMessage() {}
+
+func (x *AttachVFPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachVFPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AttachVFPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *AttachVFPolicyRequest) GetPolicy() *VFPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DetachVFPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pf string `protobuf:"bytes,1,opt,name=pf,proto3" json:"pf,omitempty"`
+	Vf int32  `protobuf:"varint,2,opt,name=vf,proto3" json:"vf,omitempty"`
+}
+
+func (x *DetachVFPolicyRequest) Reset() {
+	*x = DetachVFPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachVFPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachVFPolicyRequest) ProtoMessage() {}
+
+func (x *DetachVFPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachVFPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DetachVFPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *DetachVFPolicyRequest) GetPf() string {
+	if x != nil {
+		return x.Pf
+	}
+	return ""
+}
+
+func (x *DetachVFPolicyRequest) GetVf() int32 {
+	if x != nil {
+		return x.Vf
+	}
+	return 0
+}
+
+type VFStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pf string `protobuf:"bytes,1,opt,name=pf,proto3" json:"pf,omitempty"`
+	Vf int32  `protobuf:"varint,2,opt,name=vf,proto3" json:"vf,omitempty"`
+}
+
+func (x *VFStatsRequest) Reset() {
+	*x = VFStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VFStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VFStatsRequest) ProtoMessage() {}
+
+func (x *VFStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VFStatsRequest.ProtoReflect.Descriptor instead.
+func (*VFStatsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *VFStatsRequest) GetPf() string {
+	if x != nil {
+		return x.Pf
+	}
+	return ""
+}
+
+func (x *VFStatsRequest) GetVf() int32 {
+	if x != nil {
+		return x.Vf
+	}
+	return 0
+}
+
+type VFStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pf        string      `protobuf:"bytes,1,opt,name=pf,proto3" json:"pf,omitempty"`
+	Vf        int32       `protobuf:"varint,2,opt,name=vf,proto3" json:"vf,omitempty"`
+	Dataplane *Statistics `protobuf:"bytes,3,opt,name=dataplane,proto3" json:"dataplane,omitempty"`                   // Verdicts of the VF's data plane
+	RxPackets uint64      `protobuf:"varint,4,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"` // Representor counters
+	TxPackets uint64      `protobuf:"varint,5,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	RxBytes   uint64      `protobuf:"varint,6,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes   uint64      `protobuf:"varint,7,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxDropped uint64      `protobuf:"varint,8,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+}
+
+func (x *VFStats) Reset() {
+	*x = VFStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VFStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VFStats) ProtoMessage() {}
+
+func (x *VFStats) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VFStats.ProtoReflect.Descriptor instead.
+func (*VFStats) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *VFStats) GetPf() string {
+	if x != nil {
+		return x.Pf
+	}
+	return ""
+}
+
+func (x *VFStats) GetVf() int32 {
+	if x != nil {
+		return x.Vf
+	}
+	return 0
+}
+
+func (x *VFStats) GetDataplane() *Statistics {
+	if x != nil {
+		return x.Dataplane
+	}
+	return nil
+}
+
+func (x *VFStats) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *VFStats) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *VFStats) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *VFStats) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *VFStats) GetRxDropped() uint64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+type InterfaceOffload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope     string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // Data plane: empty = host, namespace name or VF key
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Driver    string `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Requested string `protobuf:"bytes,4,opt,name=requested,proto3" json:"requested,omitempty"` // "none", "xdp", "flow"
+	Active    string `protobuf:"bytes,5,opt,name=active,proto3" json:"active,omitempty"`       // Mode verified on the interface
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`       // Why active differs from requested
+}
+
+func (x *InterfaceOffload) Reset() {
+	*x = InterfaceOffload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceOffload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceOffload) ProtoMessage() {}
+
+func (x *InterfaceOffload) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceOffload.ProtoReflect.Descriptor instead.
+func (*InterfaceOffload) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *InterfaceOffload) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *InterfaceOffload) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *InterfaceOffload) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *InterfaceOffload) GetRequested() string {
+	if x != nil {
+		return x.Requested
+	}
+	return ""
+}
+
+func (x *InterfaceOffload) GetActive() string {
+	if x != nil {
+		return x.Active
+	}
+	return ""
+}
+
+func (x *InterfaceOffload) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OffloadStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requested  string              `protobuf:"bytes,1,opt,name=requested,proto3" json:"requested,omitempty"`
+	Interfaces []*InterfaceOffload `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *OffloadStatusResponse) Reset() {
+	*x = OffloadStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OffloadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffloadStatusResponse) ProtoMessage() {}
+
+func (x *OffloadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffloadStatusResponse.ProtoReflect.Descriptor instead.
+func (*OffloadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *OffloadStatusResponse) GetRequested() string {
+	if x != nil {
+		return x.Requested
+	}
+	return ""
+}
+
+func (x *OffloadStatusResponse) GetInterfaces() []*InterfaceOffload {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Family    string `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`                   // "ipv4", "ipv6"
+	Protocol  string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`               // "tcp", "udp", "icmp" or the protocol number
+	SrcIp     string `protobuf:"bytes,3,opt,name=src_ip,json=srcIp,proto3" json:"src_ip,omitempty"`        // Originator of the flow
+	SrcPort   int32  `protobuf:"varint,4,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"` // ICMP echo identifier for ICMP
+	DstIp     string `protobuf:"bytes,5,opt,name=dst_ip,json=dstIp,proto3" json:"dst_ip,omitempty"`
+	DstPort   int32  `protobuf:"varint,6,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"`
+	State     string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`      // "new", "established", "closing"
+	Packets   uint64 `protobuf:"varint,8,opt,name=packets,proto3" json:"packets,omitempty"` // Both directions
+	Bytes     uint64 `protobuf:"varint,9,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	IdleMs    int64  `protobuf:"varint,10,opt,name=idle_ms,json=idleMs,proto3" json:"idle_ms,omitempty"`            // Since the last packet
+	NatRuleId uint32 `protobuf:"varint,11,opt,name=nat_rule_id,json=natRuleId,proto3" json:"nat_rule_id,omitempty"` // NAT rule that rewrote dst_ip and dst_port, 0 = none
+	NatIp     string `protobuf:"bytes,12,opt,name=nat_ip,json=natIp,proto3" json:"nat_ip,omitempty"`                // Destination before the rewrite, empty without NAT
+	NatPort   int32  `protobuf:"varint,13,opt,name=nat_port,json=natPort,proto3" json:"nat_port,omitempty"`
+}
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *Connection) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *Connection) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Connection) GetSrcIp() string {
+	if x != nil {
+		return x.SrcIp
+	}
+	return ""
+}
+
+func (x *Connection) GetSrcPort() int32 {
+	if x != nil {
+		return x.SrcPort
+	}
+	return 0
+}
+
+func (x *Connection) GetDstIp() string {
+	if x != nil {
+		return x.DstIp
+	}
+	return ""
+}
+
+func (x *Connection) GetDstPort() int32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *Connection) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Connection) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *Connection) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Connection) GetIdleMs() int64 {
+	if x != nil {
+		return x.IdleMs
+	}
+	return 0
+}
+
+func (x *Connection) GetNatRuleId() uint32 {
+	if x != nil {
+		return x.NatRuleId
+	}
+	return 0
+}
+
+func (x *Connection) GetNatIp() string {
+	if x != nil {
+		return x.NatIp
+	}
+	return ""
+}
+
+func (x *Connection) GetNatPort() int32 {
+	if x != nil {
+		return x.NatPort
+	}
+	return 0
+}
+
+type ListConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope     string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`          // Data plane: empty = host, namespace name or VF key
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`         // 0 = all
+	Anonymize bool   `protobuf:"varint,3,opt,name=anonymize,proto3" json:"anonymize,omitempty"` // Anonymize addresses as configured with CERBERUS_ANONYMIZE
+}
+
+func (x *ListConnectionsRequest) Reset() {
+	*x = ListConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsRequest) ProtoMessage() {}
+
+func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *ListConnectionsRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ListConnectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConnectionsRequest) GetAnonymize() bool {
+	if x != nil {
+		return x.Anonymize
+	}
+	return false
+}
+
+type ConnectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connections []*Connection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	Count       int32         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`       // Tracked flows, may exceed the connections returned
+	Capacity    int32         `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"` // Flow table size
+}
+
+func (x *ConnectionsResponse) Reset() {
+	*x = ConnectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionsResponse) ProtoMessage() {}
+
+func (x *ConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *ConnectionsResponse) GetConnections() []*Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *ConnectionsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ConnectionsResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type KillConnectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope      string      `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Connection *Connection `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"` // Protocol, addresses and ports identify the flow in either direction
+}
+
+func (x *KillConnectionRequest) Reset() {
+	*x = KillConnectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillConnectionRequest) ProtoMessage() {}
+
+func (x *KillConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillConnectionRequest.ProtoReflect.Descriptor instead.
+func (*KillConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *KillConnectionRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *KillConnectionRequest) GetConnection() *Connection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type StatsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start      int64  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`          // Unix timestamp, 0 = 24 hours ago
+	End        int64  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`              // Unix timestamp, 0 = now
+	Resolution string `protobuf:"bytes,3,opt,name=resolution,proto3" json:"resolution,omitempty"` // "minute", "hour", "day"; empty = finest covering start
+}
+
+func (x *StatsHistoryRequest) Reset() {
+	*x = StatsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHistoryRequest) ProtoMessage() {}
+
+func (x *StatsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*StatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *StatsHistoryRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *StatsHistoryRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *StatsHistoryRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type StatsPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Start of the interval
+	Pass      uint64  `protobuf:"varint,2,opt,name=pass,proto3" json:"pass,omitempty"`           // Packets in the interval
+	Drop      uint64  `protobuf:"varint,3,opt,name=drop,proto3" json:"drop,omitempty"`
+	Redirect  uint64  `protobuf:"varint,4,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	Error     uint64  `protobuf:"varint,5,opt,name=error,proto3" json:"error,omitempty"`
+	AvgPps    float64 `protobuf:"fixed64,6,opt,name=avg_pps,json=avgPps,proto3" json:"avg_pps,omitempty"`
+	MaxPps    float64 `protobuf:"fixed64,7,opt,name=max_pps,json=maxPps,proto3" json:"max_pps,omitempty"` // Highest one-minute rate in the interval
+}
+
+func (x *StatsPoint) Reset() {
+	*x = StatsPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsPoint) ProtoMessage() {}
+
+func (x *StatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsPoint.ProtoReflect.Descriptor instead.
+func (*StatsPoint) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *StatsPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetPass() uint64 {
+	if x != nil {
+		return x.Pass
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetDrop() uint64 {
+	if x != nil {
+		return x.Drop
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetRedirect() uint64 {
+	if x != nil {
+		return x.Redirect
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetError() uint64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetAvgPps() float64 {
+	if x != nil {
+		return x.AvgPps
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetMaxPps() float64 {
+	if x != nil {
+		return x.MaxPps
+	}
+	return 0
+}
+
+type StatsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resolution string        `protobuf:"bytes,1,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Step       int64         `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"` // Interval length in seconds
+	Points     []*StatsPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *StatsHistoryResponse) Reset() {
+	*x = StatsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHistoryResponse) ProtoMessage() {}
+
+func (x *StatsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *StatsHistoryResponse) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *StatsHistoryResponse) GetStep() int64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *StatsHistoryResponse) GetPoints() []*StatsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type HistoricalMatchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId      string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                 // Existing rule to evaluate, or
+	Rule        *Rule  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`                                   // Candidate rule; action defaults to "drop"
+	Days        int32  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                                  // Days back including today, 0 = 7
+	SampleLimit int32  `protobuf:"varint,4,opt,name=sample_limit,json=sampleLimit,proto3" json:"sample_limit,omitempty"` // Matching flows to return, largest first, 0 = 10
+	// Filter expression over the flows' family, protocol, src_ip, src_port,
+	// dst_ip, dst_port, packets, bytes, first_seen and last_seen, e.g.
+	// dst_port == 22 && src_ip in 10.0.0.0/8. Only flows it selects are
+	// matched; with neither rule_id nor rule it selects the flows counted.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *HistoricalMatchesRequest) Reset() {
+	*x = HistoricalMatchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalMatchesRequest) ProtoMessage() {}
+
+func (x *HistoricalMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalMatchesRequest.ProtoReflect.Descriptor instead.
+func (*HistoricalMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *HistoricalMatchesRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *HistoricalMatchesRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *HistoricalMatchesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *HistoricalMatchesRequest) GetSampleLimit() int32 {
+	if x != nil {
+		return x.SampleLimit
+	}
+	return 0
+}
+
+func (x *HistoricalMatchesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type DayMatches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`    // YYYY-MM-DD, UTC
+	Flows          int64  `protobuf:"varint,2,opt,name=flows,proto3" json:"flows,omitempty"` // Flows archived that day
+	MatchedFlows   int64  `protobuf:"varint,3,opt,name=matched_flows,json=matchedFlows,proto3" json:"matched_flows,omitempty"`
+	MatchedPackets uint64 `protobuf:"varint,4,opt,name=matched_packets,json=matchedPackets,proto3" json:"matched_packets,omitempty"`
+	MatchedBytes   uint64 `protobuf:"varint,5,opt,name=matched_bytes,json=matchedBytes,proto3" json:"matched_bytes,omitempty"`
+}
+
+func (x *DayMatches) Reset() {
+	*x = DayMatches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DayMatches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayMatches) ProtoMessage() {}
+
+func (x *DayMatches) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayMatches.ProtoReflect.Descriptor instead.
+func (*DayMatches) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *DayMatches) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DayMatches) GetFlows() int64 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *DayMatches) GetMatchedFlows() int64 {
+	if x != nil {
+		return x.MatchedFlows
+	}
+	return 0
+}
+
+func (x *DayMatches) GetMatchedPackets() uint64 {
+	if x != nil {
+		return x.MatchedPackets
+	}
+	return 0
+}
+
+func (x *DayMatches) GetMatchedBytes() uint64 {
+	if x != nil {
+		return x.MatchedBytes
+	}
+	return 0
+}
+
+type HistoricalMatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days         []*DayMatches `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"` // Oldest first, days without an archive omitted
+	TotalFlows   int64         `protobuf:"varint,2,opt,name=total_flows,json=totalFlows,proto3" json:"total_flows,omitempty"`
+	MatchedFlows int64         `protobuf:"varint,3,opt,name=matched_flows,json=matchedFlows,proto3" json:"matched_flows,omitempty"`
+	Samples      []*Connection `protobuf:"bytes,4,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *HistoricalMatchesResponse) Reset() {
+	*x = HistoricalMatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoricalMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalMatchesResponse) ProtoMessage() {}
+
+func (x *HistoricalMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalMatchesResponse.ProtoReflect.Descriptor instead.
+func (*HistoricalMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *HistoricalMatchesResponse) GetDays() []*DayMatches {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *HistoricalMatchesResponse) GetTotalFlows() int64 {
+	if x != nil {
+		return x.TotalFlows
+	}
+	return 0
+}
+
+func (x *HistoricalMatchesResponse) GetMatchedFlows() int64 {
+	if x != nil {
+		return x.MatchedFlows
+	}
+	return 0
+}
+
+func (x *HistoricalMatchesResponse) GetSamples() []*Connection {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type AggregateFlowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keys flows are grouped by: src_prefix, dst_prefix, src_ip, dst_ip,
+	// src_port, dst_port, protocol, family, src_country, dst_country. None
+	// sums every flow into one group.
+	GroupBy       []string `protobuf:"bytes,1,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Ipv4PrefixLen uint32   `protobuf:"varint,2,opt,name=ipv4_prefix_len,json=ipv4PrefixLen,proto3" json:"ipv4_prefix_len,omitempty"` // Bits of the src_prefix and dst_prefix keys, 0 = 24
+	Ipv6PrefixLen uint32   `protobuf:"varint,3,opt,name=ipv6_prefix_len,json=ipv6PrefixLen,proto3" json:"ipv6_prefix_len,omitempty"` // 0 = 64
+	BucketSeconds int64    `protobuf:"varint,4,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`   // Time buckets of each group by first_seen, 0 = none
+	Days          int32    `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                                          // Days back including today, 0 = 7
+	OrderBy       string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                      // "bytes" (default), "packets" or "flows"
+	Limit         int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                                        // Groups returned, largest first, 0 = 10
+	Filter        string   `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`                                       // Flows aggregated, as in HistoricalMatchesRequest
+}
+
+func (x *AggregateFlowsRequest) Reset() {
+	*x = AggregateFlowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateFlowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateFlowsRequest) ProtoMessage() {}
+
+func (x *AggregateFlowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateFlowsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateFlowsRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *AggregateFlowsRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateFlowsRequest) GetIpv4PrefixLen() uint32 {
+	if x != nil {
+		return x.Ipv4PrefixLen
+	}
+	return 0
+}
+
+func (x *AggregateFlowsRequest) GetIpv6PrefixLen() uint32 {
+	if x != nil {
+		return x.Ipv6PrefixLen
+	}
+	return 0
+}
+
+func (x *AggregateFlowsRequest) GetBucketSeconds() int64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+func (x *AggregateFlowsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AggregateFlowsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *AggregateFlowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AggregateFlowsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type FlowBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start   int64  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // Unix timestamp of the bucket start
+	Flows   int64  `protobuf:"varint,2,opt,name=flows,proto3" json:"flows,omitempty"`
+	Packets uint64 `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *FlowBucket) Reset() {
+	*x = FlowBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowBucket) ProtoMessage() {}
+
+func (x *FlowBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowBucket.ProtoReflect.Descriptor instead.
+func (*FlowBucket) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *FlowBucket) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *FlowBucket) GetFlows() int64 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *FlowBucket) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *FlowBucket) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type FlowGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     map[string]string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // group_by key to value; "" = unknown country
+	Flows   int64             `protobuf:"varint,2,opt,name=flows,proto3" json:"flows,omitempty"`
+	Packets uint64            `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64            `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Buckets []*FlowBucket     `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"` // Oldest first, empty buckets omitted
+}
+
+func (x *FlowGroup) Reset() {
+	*x = FlowGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowGroup) ProtoMessage() {}
+
+func (x *FlowGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowGroup.ProtoReflect.Descriptor instead.
+func (*FlowGroup) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *FlowGroup) GetKey() map[string]string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *FlowGroup) GetFlows() int64 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *FlowGroup) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *FlowGroup) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *FlowGroup) GetBuckets() []*FlowBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type AggregateFlowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups     []*FlowGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`                            // Largest first
+	Other      *FlowGroup   `protobuf:"bytes,2,opt,name=other,proto3" json:"other,omitempty"`                              // Sum of the groups beyond the limit, if any
+	Total      *FlowGroup   `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`                              // Sum of every flow aggregated
+	GroupCount int64        `protobuf:"varint,4,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"` // Groups before the limit
+	Days       []string     `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`                                // Days read, oldest first, YYYY-MM-DD UTC
+}
+
+func (x *AggregateFlowsResponse) Reset() {
+	*x = AggregateFlowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateFlowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateFlowsResponse) ProtoMessage() {}
+
+func (x *AggregateFlowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateFlowsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateFlowsResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *AggregateFlowsResponse) GetGroups() []*FlowGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AggregateFlowsResponse) GetOther() *FlowGroup {
+	if x != nil {
+		return x.Other
+	}
+	return nil
+}
+
+func (x *AggregateFlowsResponse) GetTotal() *FlowGroup {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *AggregateFlowsResponse) GetGroupCount() int64 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *AggregateFlowsResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type PipelineStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`    // "parse", "conntrack", "ipset", "rules", "actions"
+	Index     int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"` // Slot in the program array, in packet order
+	Enabled   bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ProgramId uint32 `protobuf:"varint,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"` // Kernel program ID, 0 when disabled or simulated
+	Object    string `protobuf:"bytes,5,opt,name=object,proto3" json:"object,omitempty"`                         // Object file the program was loaded from
+	UpdatedAt int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp of the last change
+	Packets   uint64 `protobuf:"varint,7,opt,name=packets,proto3" json:"packets,omitempty"`                      // Packets that entered the stage
+}
+
+func (x *PipelineStage) Reset() {
+	*x = PipelineStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStage) ProtoMessage() {}
+
+func (x *PipelineStage) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStage.ProtoReflect.Descriptor instead.
+func (*PipelineStage) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *PipelineStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PipelineStage) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PipelineStage) GetProgramId() uint32 {
+	if x != nil {
+		return x.ProgramId
+	}
+	return 0
+}
+
+func (x *PipelineStage) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *PipelineStage) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+type GetPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // Data plane: empty = host, namespace name or VF key
+}
+
+func (x *GetPipelineRequest) Reset() {
+	*x = GetPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRequest) ProtoMessage() {}
+
+func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetPipelineRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type UpdatePipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope  string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Stage  string `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "enable", "disable", "upgrade"
+	Object string `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"` // Object file to load the stage from, empty = the loaded XDP object
+}
+
+func (x *UpdatePipelineRequest) Reset() {
+	*x = UpdatePipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *UpdatePipelineRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *UpdatePipelineRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *UpdatePipelineRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UpdatePipelineRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type PipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success       bool                 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Active        bool                 `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"` // Packets run through the pipeline: the parse stage is enabled
+	Stages        []*PipelineStage     `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages,omitempty"`
+	InlinePackets uint64               `protobuf:"varint,5,opt,name=inline_packets,json=inlinePackets,proto3" json:"inline_packets,omitempty"` // Packets filtered without the pipeline
+	Dispositions  []*PacketDisposition `protobuf:"bytes,6,rep,name=dispositions,proto3" json:"dispositions,omitempty"`                         // Only those that occurred
+}
+
+func (x *PipelineResponse) Reset() {
+	*x = PipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineResponse) ProtoMessage() {}
+
+func (x *PipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineResponse.ProtoReflect.Descriptor instead.
+func (*PipelineResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *PipelineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PipelineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PipelineResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PipelineResponse) GetStages() []*PipelineStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *PipelineResponse) GetInlinePackets() uint64 {
+	if x != nil {
+		return x.InlinePackets
+	}
+	return 0
+}
+
+func (x *PipelineResponse) GetDispositions() []*PacketDisposition {
+	if x != nil {
+		return x.Dispositions
+	}
+	return nil
+}
+
+// Packets that got a verdict for the same reason
+type PacketDisposition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disposition string `protobuf:"bytes,1,opt,name=disposition,proto3" json:"disposition,omitempty"` // "parse_error", "non_ip", "rule", "conntrack", "ipset", "default", "degraded"
+	Verdict     string `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"`         // "aborted", "drop", "pass", "tx", "redirect"
+	Packets     uint64 `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`
+}
+
+func (x *PacketDisposition) Reset() {
+	*x = PacketDisposition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketDisposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketDisposition) ProtoMessage() {}
+
+func (x *PacketDisposition) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketDisposition.ProtoReflect.Descriptor instead.
+func (*PacketDisposition) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *PacketDisposition) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *PacketDisposition) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *PacketDisposition) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+type ExplainVerdictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // ID of a recent PACKET_SAMPLE event with verdict "drop"
+}
+
+func (x *ExplainVerdictRequest) Reset() {
+	*x = ExplainVerdictRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainVerdictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainVerdictRequest) ProtoMessage() {}
+
+func (x *ExplainVerdictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainVerdictRequest.ProtoReflect.Descriptor instead.
+func (*ExplainVerdictRequest) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *ExplainVerdictRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ExplainVerdictResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success              bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`             // Why the packet was dropped, or why it cannot be explained
+	Event                *Event `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                 // The sampled drop
+	Verdict              string `protobuf:"bytes,4,opt,name=verdict,proto3" json:"verdict,omitempty"`             // Verdict of the running policy for the packet
+	Stage                string `protobuf:"bytes,5,opt,name=stage,proto3" json:"stage,omitempty"`                 // Pipeline stage deciding it: "rules", or "actions" for built-in defaults
+	Disposition          string `protobuf:"bytes,6,opt,name=disposition,proto3" json:"disposition,omitempty"`     // As in PipelineResponse.dispositions
+	RuleId               string `protobuf:"bytes,7,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Matching rule, empty when none matched
+	Rule                 *Rule  `protobuf:"bytes,8,opt,name=rule,proto3" json:"rule,omitempty"`
+	ConnState            string `protobuf:"bytes,9,opt,name=conn_state,json=connState,proto3" json:"conn_state,omitempty"`                                      // Connection state of the packet when sampled
+	PolicyVersion        uint64 `protobuf:"varint,10,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`                        // Running policy version
+	SampledPolicyVersion uint64 `protobuf:"varint,11,opt,name=sampled_policy_version,json=sampledPolicyVersion,proto3" json:"sampled_policy_version,omitempty"` // Policy version when the packet was sampled
+	Generation           uint32 `protobuf:"varint,12,opt,name=generation,proto3" json:"generation,omitempty"`                                                   // Active rule set generation of the host data plane
+	Degradation          string `protobuf:"bytes,13,opt,name=degradation,proto3" json:"degradation,omitempty"`                                                  // Degradation stage when the packet was sampled
+	Consistent           bool   `protobuf:"varint,14,opt,name=consistent,proto3" json:"consistent,omitempty"`                                                   // The running policy still drops the packet
+}
+
+func (x *ExplainVerdictResponse) Reset() {
+	*x = ExplainVerdictResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_firewall_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainVerdictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainVerdictResponse) ProtoMessage() {}
+
+func (x *ExplainVerdictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_firewall_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainVerdictResponse.ProtoReflect.Descriptor instead.
+func (*ExplainVerdictResponse) Descriptor() ([]byte, []int) {
+	return file_firewall_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *ExplainVerdictResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExplainVerdictResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ExplainVerdictResponse) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *ExplainVerdictResponse) GetConnState() string {
+	if x != nil {
+		return x.ConnState
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetPolicyVersion() uint64 {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return 0
+}
+
+func (x *ExplainVerdictResponse) GetSampledPolicyVersion() uint64 {
+	if x != nil {
+		return x.SampledPolicyVersion
+	}
+	return 0
+}
+
+func (x *ExplainVerdictResponse) GetGeneration() uint32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ExplainVerdictResponse) GetDegradation() string {
+	if x != nil {
+		return x.Degradation
+	}
+	return ""
+}
+
+func (x *ExplainVerdictResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+var File_firewall_proto protoreflect.FileDescriptor
+
+var file_firewall_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x07, 0x0a,
+	0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xef, 0x0b, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x70, 0x12, 0x15,
+	0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x73, 0x74, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x67,
+	0x65, 0x6f, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08,
+	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x66, 0x75, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x66, 0x75, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0e,
+	0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x73, 0x72,
+	0x63, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x64, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x66, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x76, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x69, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x74, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x69, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x35,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x24, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x72, 0x63, 0x53, 0x65, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x64, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x26,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x29,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x72, 0x63, 0x4d, 0x61, 0x63, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x73, 0x74, 0x4d, 0x61, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x72, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x72, 0x63, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x72,
+	0x63, 0x41, 0x73, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x6e, 0x18,
+	0x30, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x73, 0x74, 0x41, 0x73, 0x6e, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xd7, 0x03,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x8e, 0x06,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x6d, 0x62, 0x70, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x4d, 0x62, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x55, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x65, 0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x6c,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x19,
+	0x50, 0x75, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x16, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xf8, 0x02, 0x0a, 0x0e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x76, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x62, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x53, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22,
+	0x3e, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x92, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x01,
+	0x0a, 0x14, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0xa7, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x79, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x46,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0xed, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x83, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x69, 0x70, 0x76, 0x36, 0x22, 0xed, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x50, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x31, 0x0a, 0x13, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdd, 0x01, 0x0a, 0x15, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x70, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x16, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x53, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a,
+	0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x5b, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74,
+	0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x0d,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x25, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x41, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xd1,
+	0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0x72, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0x63, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x73, 0x0a, 0x16, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x74, 0x0a, 0x12,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x22, 0x78, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9,
+	0x01, 0x0a, 0x0a, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x7a,
+	0x6f, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x0d,
+	0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x65, 0x0a, 0x12, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5a, 0x6f,
+	0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f,
+	0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x38, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x19, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x22, 0x6f, 0x0a,
+	0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a,
+	0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22,
+	0x2a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x7b, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2d, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a,
+	0x15, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x57, 0x68, 0x65, 0x72, 0x65,
+	0x55, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x57, 0x68, 0x65, 0x72, 0x65, 0x55, 0x73, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x05,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x65, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64,
+	0x22, 0x3a, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x22, 0x4a, 0x0a, 0x13,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x49, 0x50, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24,
+	0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52,
+	0x03, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x2b,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x0e, 0x49,
+	0x50, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52,
+	0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0xdc, 0x03, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x70, 0x76, 0x34, 0x53, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x70, 0x76, 0x36, 0x53, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x76,
+	0x36, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x22, 0x2a,
+	0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x08, 0x42,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xd7, 0x02, 0x0a, 0x0a, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xed,
+	0x01, 0x0a, 0x10, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x22, 0xc7,
+	0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x55, 0x72, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xec, 0x02, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x73, 0x79, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6e,
+	0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x79,
+	0x6e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69,
+	0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x22, 0xad, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x34, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x1e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x42, 0x0a, 0x1e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x02, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x72, 0x6f, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x5f, 0x64, 0x72, 0x6f,
+	0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x44, 0x72, 0x6f,
+	0x70, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x74, 0x72, 0x61, 0x79, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x79, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f,
+	0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x53, 0x6c, 0x6f, 0x77, 0x22, 0x9d, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x43, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x06, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61,
+	0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d,
+	0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x22, 0x3f, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0x71, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x06,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x40, 0x0a, 0x0f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0xa8, 0x01, 0x0a,
+	0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x0b, 0x4e, 0x41, 0x54, 0x36,
+	0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x41, 0x54, 0x36, 0x34,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36,
+	0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22,
+	0x7b, 0x0a, 0x13, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x32, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x22, 0x4d, 0x0a, 0x15, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22,
+	0x30, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x88, 0x02, 0x0a, 0x0c, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x69, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x6c, 0x0a, 0x15,
+	0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xd8, 0x04, 0x0a, 0x07, 0x4e,
+	0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63,
+	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x64, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x75, 0x64, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f,
+	0x75, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x49, 0x6e, 0x55, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4e, 0x61, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x6f, 0x0a, 0x0f, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+	0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x01,
+	0x0a, 0x10, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x47, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xa6,
+	0x02, 0x0a, 0x0a, 0x4e, 0x61, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x4e, 0x61, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x61, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0x43, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x12, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x2d,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb3, 0x02,
+	0x0a, 0x13, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c,
+	0x6f, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x54, 0x4c, 0x53,
+	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1a,
+	0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x22, 0x35, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x4c, 0x53,
+	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x1b, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c,
+	0x6f, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79,
+	0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x79, 0x6e, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x73, 0x79, 0x6e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x74, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xdb, 0x01, 0x0a, 0x14, 0x4d,
+	0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x79, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xd9, 0x02, 0x0a, 0x18, 0x4d, 0x69, 0x74,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1b,
+	0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x82, 0x03, 0x0a, 0x19, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x44, 0x72, 0x6f, 0x70, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x54, 0x6f, 0x70,
+	0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x68, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x85, 0x03, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a,
+	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x51, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xae, 0x01, 0x0a, 0x09,
+	0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x02, 0x0a,
+	0x17, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x92, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x2e,
+	0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4e, 0x0a, 0x08, 0x4d, 0x53, 0x53, 0x43, 0x6c, 0x61,
+	0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6d, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x73, 0x73, 0x36, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x6d, 0x73, 0x73, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x75,
+	0x72, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x69,
+	0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9c, 0x03, 0x0a,
+	0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x09, 0x6d, 0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x53, 0x53, 0x43, 0x6c, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x73, 0x73, 0x43, 0x6c, 0x61,
+	0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6d, 0x74, 0x75, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6d, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x73, 0x73, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x73, 0x73, 0x36,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x6d, 0x73, 0x73, 0x53, 0x79, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x73, 0x73, 0x5f, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x6d, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x73, 0x73, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6d, 0x73, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a,
+	0x07, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x19, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x83,
+	0x01, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x3c, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xb0, 0x01, 0x0a,
+	0x0e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x71, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x22,
+	0x4f, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63,
+	0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x98, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x8d, 0x02, 0x0a, 0x16,
+	0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x34, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x08, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x13,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x62, 0x6f, 0x67, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x42, 0x6f,
+	0x67, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x72, 0x6f, 0x70,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x72, 0x6f, 0x70, 0x5f,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x12, 0x33, 0x0a,
+	0x16, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x63,
+	0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64,
+	0x72, 0x6f, 0x70, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x63, 0x70, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x59, 0x0a, 0x1d, 0x53, 0x65,
+	0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd4, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6f, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x70, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x34, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x34, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x63, 0x70, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x63, 0x70, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x63, 0x70, 0x5f, 0x78, 0x6d, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x63, 0x70, 0x58, 0x6d, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x87, 0x03, 0x0a,
+	0x0e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0c, 0x7a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x0b, 0x76, 0x66, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x46, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x76, 0x66, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x60, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x22, 0xe8, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x61, 0x64, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x13,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6c,
+	0x65, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xcc, 0x02, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x76,
+	0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x64, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x64, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72,
+	0x65, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xb9, 0x01, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22, 0x4e, 0x0a,
+	0x16, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2c, 0x0a,
+	0x16, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x12, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x10, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x76, 0x66, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x56, 0x66, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x66, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x56, 0x66, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x64, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x64, 0x65, 0x76, 0x12, 0x1f, 0x0a, 0x0b,
+	0x78, 0x64, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x78, 0x64, 0x70, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2e, 0x0a,
+	0x03, 0x76, 0x66, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x76, 0x66, 0x73, 0x22, 0x7a, 0x0a,
+	0x14, 0x53, 0x52, 0x49, 0x4f, 0x56, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x70, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x70, 0x66, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x46, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x56, 0x46,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x70, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x76, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x69, 0x6e, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x46, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x46, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x37, 0x0a, 0x15, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x46, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x70, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x76, 0x66, 0x22, 0x30, 0x0a, 0x0e, 0x56, 0x46, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x70, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x76, 0x66, 0x22, 0xf3, 0x01, 0x0a, 0x07, 0x56, 0x46, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x70, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x76, 0x66, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xac, 0x01, 0x0a,
+	0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x15, 0x4f,
+	0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4f, 0x66,
+	0x66, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x22, 0xd5, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x73, 0x74, 0x49, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x69, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x61,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x5f, 0x69,
+	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x74, 0x49, 0x70, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x61, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6e, 0x61, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x62, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x22, 0x82, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x22, 0x66, 0x0a, 0x15, 0x4b, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72,
+	0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x61, 0x76, 0x67, 0x50, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x50,
+	0x70, 0x73, 0x22, 0x7b, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x2f,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0xa9, 0x01, 0x0a, 0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x0a,
+	0x44, 0x61, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x19, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x15,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62,
+	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f,
+	0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x76, 0x36,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0xef,
+	0x01, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xd9, 0x01, 0x0a, 0x16, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x6c,
+	0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xc3, 0x01, 0x0a,
+	0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x73,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x10, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x69, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x32,
+	0x0a, 0x15, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xe6, 0x03, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x56, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x32, 0x99, 0x4c, 0x0a, 0x0f,
+	0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x41, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12,
+	0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x53, 0x4e, 0x12, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x53, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x10, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x50, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x22,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x50, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x50, 0x75, 0x73,
+	0x68, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0f,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x5a, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x12,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x6f, 0x6e, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5a, 0x6f, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5a, 0x6f, 0x6e, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x57, 0x68,
+	0x65, 0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x49, 0x50, 0x53, 0x65, 0x74,
+	0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x50, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x50, 0x53, 0x65,
+	0x74, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50, 0x53, 0x65, 0x74, 0x12, 0x1f,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x50, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x53,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x17, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x17,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x53,
+	0x65, 0x74, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x41, 0x54,
+	0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x41, 0x54,
+	0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x41, 0x54, 0x36, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x41, 0x64, 0x64,
+	0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+	0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x61, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x61, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x29, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x2c, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x74, 0x69,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69,
+	0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x45, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x29, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
+	0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2a, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x47, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x23,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63,
+	0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x52, 0x49, 0x4f, 0x56, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x52, 0x49, 0x4f, 0x56, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x46,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x46, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x56, 0x46, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x46,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x56, 0x46, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x46, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x46, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x12, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x72,
+	0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x4b, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x25,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12,
+	0x22, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x22,
+	0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x34, 0x72, 0x62, 0x61, 0x34, 0x73, 0x2f, 0x43, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x75, 0x73, 0x2d, 0x56, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_firewall_proto_rawDescOnce sync.Once
+	file_firewall_proto_rawDescData = file_firewall_proto_rawDesc
+)
+
+func file_firewall_proto_rawDescGZIP() []byte {
+	file_firewall_proto_rawDescOnce.Do(func() {
+		file_firewall_proto_rawDescData = protoimpl.X.CompressGZIP(file_firewall_proto_rawDescData)
+	})
+	return file_firewall_proto_rawDescData
+}
+
+var file_firewall_proto_msgTypes = make([]protoimpl.MessageInfo, 221)
+var file_firewall_proto_goTypes = []any{
+	(*Empty)(nil),                           // 0: cerberus.v1.Empty
+	(*Rule)(nil),                            // 1: cerberus.v1.Rule
+	(*PortRange)(nil),                       // 2: cerberus.v1.PortRange
+	(*Event)(nil),                           // 3: cerberus.v1.Event
+	(*SubscribeEventsRequest)(nil),          // 4: cerberus.v1.SubscribeEventsRequest
+	(*Statistics)(nil),                      // 5: cerberus.v1.Statistics
+	(*ProtocolStats)(nil),                   // 6: cerberus.v1.ProtocolStats
+	(*ExtensionMetric)(nil),                 // 7: cerberus.v1.ExtensionMetric
+	(*ExtensionStats)(nil),                  // 8: cerberus.v1.ExtensionStats
+	(*PushExtensionStatsRequest)(nil),       // 9: cerberus.v1.PushExtensionStatsRequest
+	(*ExtensionStatsResponse)(nil),          // 10: cerberus.v1.ExtensionStatsResponse
+	(*InterfaceStats)(nil),                  // 11: cerberus.v1.InterfaceStats
+	(*SystemInfo)(nil),                      // 12: cerberus.v1.SystemInfo
+	(*AddRuleRequest)(nil),                  // 13: cerberus.v1.AddRuleRequest
+	(*UpdateRuleRequest)(nil),               // 14: cerberus.v1.UpdateRuleRequest
+	(*DeleteRuleRequest)(nil),               // 15: cerberus.v1.DeleteRuleRequest
+	(*GetRuleRequest)(nil),                  // 16: cerberus.v1.GetRuleRequest
+	(*ReplaceRulesRequest)(nil),             // 17: cerberus.v1.ReplaceRulesRequest
+	(*ReplaceRulesResponse)(nil),            // 18: cerberus.v1.ReplaceRulesResponse
+	(*ReorderRulesRequest)(nil),             // 19: cerberus.v1.ReorderRulesRequest
+	(*ReorderRulesResponse)(nil),            // 20: cerberus.v1.ReorderRulesResponse
+	(*ImportRulesRequest)(nil),              // 21: cerberus.v1.ImportRulesRequest
+	(*ImportRulesResponse)(nil),             // 22: cerberus.v1.ImportRulesResponse
+	(*ExportRulesRequest)(nil),              // 23: cerberus.v1.ExportRulesRequest
+	(*ExportRulesResponse)(nil),             // 24: cerberus.v1.ExportRulesResponse
+	(*ImportNFTablesRequest)(nil),           // 25: cerberus.v1.ImportNFTablesRequest
+	(*ImportNFTablesResponse)(nil),          // 26: cerberus.v1.ImportNFTablesResponse
+	(*ImportIPTablesRequest)(nil),           // 27: cerberus.v1.ImportIPTablesRequest
+	(*ImportIPTablesResponse)(nil),          // 28: cerberus.v1.ImportIPTablesResponse
+	(*GetRulesRequest)(nil),                 // 29: cerberus.v1.GetRulesRequest
+	(*RulesByLabelRequest)(nil),             // 30: cerberus.v1.RulesByLabelRequest
+	(*RulesByLabelResponse)(nil),            // 31: cerberus.v1.RulesByLabelResponse
+	(*TemporaryAllowRequest)(nil),           // 32: cerberus.v1.TemporaryAllowRequest
+	(*TemporaryAllowResponse)(nil),          // 33: cerberus.v1.TemporaryAllowResponse
+	(*BlockASNRequest)(nil),                 // 34: cerberus.v1.BlockASNRequest
+	(*DefaultPolicy)(nil),                   // 35: cerberus.v1.DefaultPolicy
+	(*SetDefaultPolicyRequest)(nil),         // 36: cerberus.v1.SetDefaultPolicyRequest
+	(*DefaultPolicyResponse)(nil),           // 37: cerberus.v1.DefaultPolicyResponse
+	(*SearchRulesRequest)(nil),              // 38: cerberus.v1.SearchRulesRequest
+	(*SearchRulesResponse)(nil),             // 39: cerberus.v1.SearchRulesResponse
+	(*RuleSearchHit)(nil),                   // 40: cerberus.v1.RuleSearchHit
+	(*GetInterfaceStatsRequest)(nil),        // 41: cerberus.v1.GetInterfaceStatsRequest
+	(*RestoreRequest)(nil),                  // 42: cerberus.v1.RestoreRequest
+	(*RuleResponse)(nil),                    // 43: cerberus.v1.RuleResponse
+	(*FieldError)(nil),                      // 44: cerberus.v1.FieldError
+	(*RulesResponse)(nil),                   // 45: cerberus.v1.RulesResponse
+	(*StatusResponse)(nil),                  // 46: cerberus.v1.StatusResponse
+	(*InterfaceStatsResponse)(nil),          // 47: cerberus.v1.InterfaceStatsResponse
+	(*SystemInfoResponse)(nil),              // 48: cerberus.v1.SystemInfoResponse
+	(*BackupResponse)(nil),                  // 49: cerberus.v1.BackupResponse
+	(*Zone)(nil),                            // 50: cerberus.v1.Zone
+	(*ZonePolicy)(nil),                      // 51: cerberus.v1.ZonePolicy
+	(*SetZoneRequest)(nil),                  // 52: cerberus.v1.SetZoneRequest
+	(*DeleteZoneRequest)(nil),               // 53: cerberus.v1.DeleteZoneRequest
+	(*ZonesResponse)(nil),                   // 54: cerberus.v1.ZonesResponse
+	(*AddZonePolicyRequest)(nil),            // 55: cerberus.v1.AddZonePolicyRequest
+	(*ZonePolicyResponse)(nil),              // 56: cerberus.v1.ZonePolicyResponse
+	(*DeleteZonePolicyRequest)(nil),         // 57: cerberus.v1.DeleteZonePolicyRequest
+	(*ExplainZonePolicyRequest)(nil),        // 58: cerberus.v1.ExplainZonePolicyRequest
+	(*ZonePolicyExplanation)(nil),           // 59: cerberus.v1.ZonePolicyExplanation
+	(*ExplainZonePolicyResponse)(nil),       // 60: cerberus.v1.ExplainZonePolicyResponse
+	(*ServicePort)(nil),                     // 61: cerberus.v1.ServicePort
+	(*Service)(nil),                         // 62: cerberus.v1.Service
+	(*SetServiceRequest)(nil),               // 63: cerberus.v1.SetServiceRequest
+	(*GetServiceRequest)(nil),               // 64: cerberus.v1.GetServiceRequest
+	(*ServiceResponse)(nil),                 // 65: cerberus.v1.ServiceResponse
+	(*DeleteServiceRequest)(nil),            // 66: cerberus.v1.DeleteServiceRequest
+	(*ServicesResponse)(nil),                // 67: cerberus.v1.ServicesResponse
+	(*AddressObject)(nil),                   // 68: cerberus.v1.AddressObject
+	(*SetAddressObjectRequest)(nil),         // 69: cerberus.v1.SetAddressObjectRequest
+	(*GetAddressObjectRequest)(nil),         // 70: cerberus.v1.GetAddressObjectRequest
+	(*AddressObjectResponse)(nil),           // 71: cerberus.v1.AddressObjectResponse
+	(*DeleteAddressObjectRequest)(nil),      // 72: cerberus.v1.DeleteAddressObjectRequest
+	(*AddressObjectsResponse)(nil),          // 73: cerberus.v1.AddressObjectsResponse
+	(*WhereUsedRequest)(nil),                // 74: cerberus.v1.WhereUsedRequest
+	(*WhereUsedResponse)(nil),               // 75: cerberus.v1.WhereUsedResponse
+	(*IPSet)(nil),                           // 76: cerberus.v1.IPSet
+	(*CreateIPSetRequest)(nil),              // 77: cerberus.v1.CreateIPSetRequest
+	(*IPSetEntriesRequest)(nil),             // 78: cerberus.v1.IPSetEntriesRequest
+	(*IPSetResponse)(nil),                   // 79: cerberus.v1.IPSetResponse
+	(*DeleteIPSetRequest)(nil),              // 80: cerberus.v1.DeleteIPSetRequest
+	(*IPSetsResponse)(nil),                  // 81: cerberus.v1.IPSetsResponse
+	(*Feed)(nil),                            // 82: cerberus.v1.Feed
+	(*FeedsResponse)(nil),                   // 83: cerberus.v1.FeedsResponse
+	(*WatchBaselineRequest)(nil),            // 84: cerberus.v1.WatchBaselineRequest
+	(*Baseline)(nil),                        // 85: cerberus.v1.Baseline
+	(*FederationOverride)(nil),              // 86: cerberus.v1.FederationOverride
+	(*SiteStatus)(nil),                      // 87: cerberus.v1.SiteStatus
+	(*FederationStatus)(nil),                // 88: cerberus.v1.FederationStatus
+	(*DatabaseUpdate)(nil),                  // 89: cerberus.v1.DatabaseUpdate
+	(*DatabaseUpdatesResponse)(nil),         // 90: cerberus.v1.DatabaseUpdatesResponse
+	(*RollbackDatabaseRequest)(nil),         // 91: cerberus.v1.RollbackDatabaseRequest
+	(*ProtectionProfile)(nil),               // 92: cerberus.v1.ProtectionProfile
+	(*SetProtectionProfileRequest)(nil),     // 93: cerberus.v1.SetProtectionProfileRequest
+	(*ProtectionProfileResponse)(nil),       // 94: cerberus.v1.ProtectionProfileResponse
+	(*DeleteProtectionProfileRequest)(nil),  // 95: cerberus.v1.DeleteProtectionProfileRequest
+	(*AttachProtectionProfileRequest)(nil),  // 96: cerberus.v1.AttachProtectionProfileRequest
+	(*DetachProtectionProfileRequest)(nil),  // 97: cerberus.v1.DetachProtectionProfileRequest
+	(*ProtectionAttachment)(nil),            // 98: cerberus.v1.ProtectionAttachment
+	(*ProtectionProfilesResponse)(nil),      // 99: cerberus.v1.ProtectionProfilesResponse
+	(*Tunnel)(nil),                          // 100: cerberus.v1.Tunnel
+	(*SetTunnelRequest)(nil),                // 101: cerberus.v1.SetTunnelRequest
+	(*TunnelResponse)(nil),                  // 102: cerberus.v1.TunnelResponse
+	(*DeleteTunnelRequest)(nil),             // 103: cerberus.v1.DeleteTunnelRequest
+	(*TunnelsResponse)(nil),                 // 104: cerberus.v1.TunnelsResponse
+	(*MulticastMember)(nil),                 // 105: cerberus.v1.MulticastMember
+	(*MulticastGroup)(nil),                  // 106: cerberus.v1.MulticastGroup
+	(*MulticastGroupsResponse)(nil),         // 107: cerberus.v1.MulticastGroupsResponse
+	(*NAT64Prefix)(nil),                     // 108: cerberus.v1.NAT64Prefix
+	(*SetNAT64PrefixRequest)(nil),           // 109: cerberus.v1.SetNAT64PrefixRequest
+	(*NAT64PrefixResponse)(nil),             // 110: cerberus.v1.NAT64PrefixResponse
+	(*DeleteNAT64PrefixRequest)(nil),        // 111: cerberus.v1.DeleteNAT64PrefixRequest
+	(*NAT64PrefixesResponse)(nil),           // 112: cerberus.v1.NAT64PrefixesResponse
+	(*ListNAT64SessionsRequest)(nil),        // 113: cerberus.v1.ListNAT64SessionsRequest
+	(*NAT64Session)(nil),                    // 114: cerberus.v1.NAT64Session
+	(*NAT64SessionsResponse)(nil),           // 115: cerberus.v1.NAT64SessionsResponse
+	(*NatRule)(nil),                         // 116: cerberus.v1.NatRule
+	(*AddNatRuleRequest)(nil),               // 117: cerberus.v1.AddNatRuleRequest
+	(*NatRuleResponse)(nil),                 // 118: cerberus.v1.NatRuleResponse
+	(*DeleteNatRuleRequest)(nil),            // 119: cerberus.v1.DeleteNatRuleRequest
+	(*NatRulesResponse)(nil),                // 120: cerberus.v1.NatRulesResponse
+	(*ListNatSessionsRequest)(nil),          // 121: cerberus.v1.ListNatSessionsRequest
+	(*NatSession)(nil),                      // 122: cerberus.v1.NatSession
+	(*NatSessionsResponse)(nil),             // 123: cerberus.v1.NatSessionsResponse
+	(*DomainList)(nil),                      // 124: cerberus.v1.DomainList
+	(*SetDomainListRequest)(nil),            // 125: cerberus.v1.SetDomainListRequest
+	(*DomainListResponse)(nil),              // 126: cerberus.v1.DomainListResponse
+	(*DeleteDomainListRequest)(nil),         // 127: cerberus.v1.DeleteDomainListRequest
+	(*DomainListsResponse)(nil),             // 128: cerberus.v1.DomainListsResponse
+	(*TLSFingerprintRule)(nil),              // 129: cerberus.v1.TLSFingerprintRule
+	(*SetTLSFingerprintRuleRequest)(nil),    // 130: cerberus.v1.SetTLSFingerprintRuleRequest
+	(*TLSFingerprintRuleResponse)(nil),      // 131: cerberus.v1.TLSFingerprintRuleResponse
+	(*DeleteTLSFingerprintRuleRequest)(nil), // 132: cerberus.v1.DeleteTLSFingerprintRuleRequest
+	(*TLSFingerprintRulesResponse)(nil),     // 133: cerberus.v1.TLSFingerprintRulesResponse
+	(*MitigationConfig)(nil),                // 134: cerberus.v1.MitigationConfig
+	(*SetMitigationConfigRequest)(nil),      // 135: cerberus.v1.SetMitigationConfigRequest
+	(*MitigatedDestination)(nil),            // 136: cerberus.v1.MitigatedDestination
+	(*MitigationStatusResponse)(nil),        // 137: cerberus.v1.MitigationStatusResponse
+	(*SourceLimitConfig)(nil),               // 138: cerberus.v1.SourceLimitConfig
+	(*SetSourceLimitConfigRequest)(nil),     // 139: cerberus.v1.SetSourceLimitConfigRequest
+	(*SourceExemption)(nil),                 // 140: cerberus.v1.SourceExemption
+	(*SourceLimitStatusResponse)(nil),       // 141: cerberus.v1.SourceLimitStatusResponse
+	(*ListTopOffendersRequest)(nil),         // 142: cerberus.v1.ListTopOffendersRequest
+	(*SourceOffender)(nil),                  // 143: cerberus.v1.SourceOffender
+	(*TopOffendersResponse)(nil),            // 144: cerberus.v1.TopOffendersResponse
+	(*ExemptSourceRequest)(nil),             // 145: cerberus.v1.ExemptSourceRequest
+	(*SourceExemptionResponse)(nil),         // 146: cerberus.v1.SourceExemptionResponse
+	(*DeleteSourceExemptionRequest)(nil),    // 147: cerberus.v1.DeleteSourceExemptionRequest
+	(*AutoBlockConfig)(nil),                 // 148: cerberus.v1.AutoBlockConfig
+	(*SetAutoBlockConfigRequest)(nil),       // 149: cerberus.v1.SetAutoBlockConfigRequest
+	(*AutoBlock)(nil),                       // 150: cerberus.v1.AutoBlock
+	(*AutoBlockStatusResponse)(nil),         // 151: cerberus.v1.AutoBlockStatusResponse
+	(*ReleaseAutoBlockRequest)(nil),         // 152: cerberus.v1.ReleaseAutoBlockRequest
+	(*ReplayEventsRequest)(nil),             // 153: cerberus.v1.ReplayEventsRequest
+	(*ReplayEventsResponse)(nil),            // 154: cerberus.v1.ReplayEventsResponse
+	(*MSSClamp)(nil),                        // 155: cerberus.v1.MSSClamp
+	(*Policer)(nil),                         // 156: cerberus.v1.Policer
+	(*InterfaceConfig)(nil),                 // 157: cerberus.v1.InterfaceConfig
+	(*SetInterfaceConfigRequest)(nil),       // 158: cerberus.v1.SetInterfaceConfigRequest
+	(*InterfaceConfigResponse)(nil),         // 159: cerberus.v1.InterfaceConfigResponse
+	(*DeleteInterfaceConfigRequest)(nil),    // 160: cerberus.v1.DeleteInterfaceConfigRequest
+	(*InterfaceConfigsResponse)(nil),        // 161: cerberus.v1.InterfaceConfigsResponse
+	(*PortScanConfig)(nil),                  // 162: cerberus.v1.PortScanConfig
+	(*SetPortScanConfigRequest)(nil),        // 163: cerberus.v1.SetPortScanConfigRequest
+	(*PortScanner)(nil),                     // 164: cerberus.v1.PortScanner
+	(*PortScanStatusResponse)(nil),          // 165: cerberus.v1.PortScanStatusResponse
+	(*NormalizationConfig)(nil),             // 166: cerberus.v1.NormalizationConfig
+	(*SetNormalizationConfigRequest)(nil),   // 167: cerberus.v1.SetNormalizationConfigRequest
+	(*NormalizationStatusResponse)(nil),     // 168: cerberus.v1.NormalizationStatusResponse
+	(*PolicyDocument)(nil),                  // 169: cerberus.v1.PolicyDocument
+	(*PlanApplyRequest)(nil),                // 170: cerberus.v1.PlanApplyRequest
+	(*RuleChange)(nil),                      // 171: cerberus.v1.RuleChange
+	(*PlanApplyResponse)(nil),               // 172: cerberus.v1.PlanApplyResponse
+	(*AnalyzeRulesRequest)(nil),             // 173: cerberus.v1.AnalyzeRulesRequest
+	(*RuleFinding)(nil),                     // 174: cerberus.v1.RuleFinding
+	(*AnalyzeRulesResponse)(nil),            // 175: cerberus.v1.AnalyzeRulesResponse
+	(*StartShadowPolicyRequest)(nil),        // 176: cerberus.v1.StartShadowPolicyRequest
+	(*ShadowDisagreement)(nil),              // 177: cerberus.v1.ShadowDisagreement
+	(*ShadowPolicyReport)(nil),              // 178: cerberus.v1.ShadowPolicyReport
+	(*Namespace)(nil),                       // 179: cerberus.v1.Namespace
+	(*AttachNamespaceRequest)(nil),          // 180: cerberus.v1.AttachNamespaceRequest
+	(*DetachNamespaceRequest)(nil),          // 181: cerberus.v1.DetachNamespaceRequest
+	(*NamespacesResponse)(nil),              // 182: cerberus.v1.NamespacesResponse
+	(*NamespaceStatsRequest)(nil),           // 183: cerberus.v1.NamespaceStatsRequest
+	(*VirtualFunction)(nil),                 // 184: cerberus.v1.VirtualFunction
+	(*PhysicalFunction)(nil),                // 185: cerberus.v1.PhysicalFunction
+	(*SRIOVDevicesResponse)(nil),            // 186: cerberus.v1.SRIOVDevicesResponse
+	(*VFPolicy)(nil),                        // 187: cerberus.v1.VFPolicy
+	(*AttachVFPolicyRequest)(nil),           // 188: cerberus.v1.AttachVFPolicyRequest
+	(*DetachVFPolicyRequest)(nil),           // 189: cerberus.v1.DetachVFPolicyRequest
+	(*VFStatsRequest)(nil),                  // 190: cerberus.v1.VFStatsRequest
+	(*VFStats)(nil),                         // 191: cerberus.v1.VFStats
+	(*InterfaceOffload)(nil),                // 192: cerberus.v1.InterfaceOffload
+	(*OffloadStatusResponse)(nil),           // 193: cerberus.v1.OffloadStatusResponse
+	(*Connection)(nil),                      // 194: cerberus.v1.Connection
+	(*ListConnectionsRequest)(nil),          // 195: cerberus.v1.ListConnectionsRequest
+	(*ConnectionsResponse)(nil),             // 196: cerberus.v1.ConnectionsResponse
+	(*KillConnectionRequest)(nil),           // 197: cerberus.v1.KillConnectionRequest
+	(*StatsHistoryRequest)(nil),             // 198: cerberus.v1.StatsHistoryRequest
+	(*StatsPoint)(nil),                      // 199: cerberus.v1.StatsPoint
+	(*StatsHistoryResponse)(nil),            // 200: cerberus.v1.StatsHistoryResponse
+	(*HistoricalMatchesRequest)(nil),        // 201: cerberus.v1.HistoricalMatchesRequest
+	(*DayMatches)(nil),                      // 202: cerberus.v1.DayMatches
+	(*HistoricalMatchesResponse)(nil),       // 203: cerberus.v1.HistoricalMatchesResponse
+	(*AggregateFlowsRequest)(nil),           // 204: cerberus.v1.AggregateFlowsRequest
+	(*FlowBucket)(nil),                      // 205: cerberus.v1.FlowBucket
+	(*FlowGroup)(nil),                       // 206: cerberus.v1.FlowGroup
+	(*AggregateFlowsResponse)(nil),          // 207: cerberus.v1.AggregateFlowsResponse
+	(*PipelineStage)(nil),                   // 208: cerberus.v1.PipelineStage
+	(*GetPipelineRequest)(nil),              // 209: cerberus.v1.GetPipelineRequest
+	(*UpdatePipelineRequest)(nil),           // 210: cerberus.v1.UpdatePipelineRequest
+	(*PipelineResponse)(nil),                // 211: cerberus.v1.PipelineResponse
+	(*PacketDisposition)(nil),               // 212: cerberus.v1.PacketDisposition
+	(*ExplainVerdictRequest)(nil),           // 213: cerberus.v1.ExplainVerdictRequest
+	(*ExplainVerdictResponse)(nil),          // 214: cerberus.v1.ExplainVerdictResponse
+	nil,                                     // 215: cerberus.v1.Rule.LabelsEntry
+	nil,                                     // 216: cerberus.v1.Event.MetadataEntry
+	nil,                                     // 217: cerberus.v1.ExtensionMetric.LabelsEntry
+	nil,                                     // 218: cerberus.v1.ReorderRulesRequest.PrioritiesEntry
+	nil,                                     // 219: cerberus.v1.DatabaseUpdate.ResultsEntry
+	nil,                                     // 220: cerberus.v1.FlowGroup.KeyEntry
+}
+var file_firewall_proto_depIdxs = []int32{
+	2,   // 0: cerberus.v1.Rule.src_port_range:type_name -> cerberus.v1.PortRange
+	2,   // 1: cerberus.v1.Rule.dst_port_range:type_name -> cerberus.v1.PortRange
+	215, // 2: cerberus.v1.Rule.labels:type_name -> cerberus.v1.Rule.LabelsEntry
+	216, // 3: cerberus.v1.Event.metadata:type_name -> cerberus.v1.Event.MetadataEntry
+	11,  // 4: cerberus.v1.Statistics.interfaces:type_name -> cerberus.v1.InterfaceStats
+	35,  // 5: cerberus.v1.Statistics.default_policies:type_name -> cerberus.v1.DefaultPolicy
+	6,   // 6: cerberus.v1.Statistics.protocols:type_name -> cerberus.v1.ProtocolStats
+	8,   // 7: cerberus.v1.Statistics.extensions:type_name -> cerberus.v1.ExtensionStats
+	217, // 8: cerberus.v1.ExtensionMetric.labels:type_name -> cerberus.v1.ExtensionMetric.LabelsEntry
+	7,   // 9: cerberus.v1.ExtensionStats.metrics:type_name -> cerberus.v1.ExtensionMetric
+	7,   // 10: cerberus.v1.PushExtensionStatsRequest.metrics:type_name -> cerberus.v1.ExtensionMetric
+	8,   // 11: cerberus.v1.ExtensionStatsResponse.sources:type_name -> cerberus.v1.ExtensionStats
+	1,   // 12: cerberus.v1.AddRuleRequest.rule:type_name -> cerberus.v1.Rule
+	1,   // 13: cerberus.v1.UpdateRuleRequest.rule:type_name -> cerberus.v1.Rule
+	1,   // 14: cerberus.v1.ReplaceRulesRequest.rules:type_name -> cerberus.v1.Rule
+	218, // 15: cerberus.v1.ReorderRulesRequest.priorities:type_name -> cerberus.v1.ReorderRulesRequest.PrioritiesEntry
+	1,   // 16: cerberus.v1.ImportRulesRequest.rules:type_name -> cerberus.v1.Rule
+	1,   // 17: cerberus.v1.ImportNFTablesResponse.rules:type_name -> cerberus.v1.Rule
+	1,   // 18: cerberus.v1.ImportIPTablesResponse.rules:type_name -> cerberus.v1.Rule
+	2,   // 19: cerberus.v1.TemporaryAllowRequest.ports:type_name -> cerberus.v1.PortRange
+	44,  // 20: cerberus.v1.TemporaryAllowResponse.errors:type_name -> cerberus.v1.FieldError
+	35,  // 21: cerberus.v1.DefaultPolicyResponse.policies:type_name -> cerberus.v1.DefaultPolicy
+	40,  // 22: cerberus.v1.SearchRulesResponse.hits:type_name -> cerberus.v1.RuleSearchHit
+	1,   // 23: cerberus.v1.RuleSearchHit.rule:type_name -> cerberus.v1.Rule
+	1,   // 24: cerberus.v1.RuleResponse.rule:type_name -> cerberus.v1.Rule
+	44,  // 25: cerberus.v1.RuleResponse.errors:type_name -> cerberus.v1.FieldError
+	1,   // 26: cerberus.v1.RulesResponse.rules:type_name -> cerberus.v1.Rule
+	35,  // 27: cerberus.v1.RulesResponse.default_policies:type_name -> cerberus.v1.DefaultPolicy
+	11,  // 28: cerberus.v1.InterfaceStatsResponse.interfaces:type_name -> cerberus.v1.InterfaceStats
+	12,  // 29: cerberus.v1.SystemInfoResponse.system:type_name -> cerberus.v1.SystemInfo
+	5,   // 30: cerberus.v1.SystemInfoResponse.stats:type_name -> cerberus.v1.Statistics
+	50,  // 31: cerberus.v1.SetZoneRequest.zone:type_name -> cerberus.v1.Zone
+	50,  // 32: cerberus.v1.ZonesResponse.zones:type_name -> cerberus.v1.Zone
+	51,  // 33: cerberus.v1.ZonesResponse.policies:type_name -> cerberus.v1.ZonePolicy
+	51,  // 34: cerberus.v1.AddZonePolicyRequest.policy:type_name -> cerberus.v1.ZonePolicy
+	51,  // 35: cerberus.v1.ZonePolicyExplanation.policy:type_name -> cerberus.v1.ZonePolicy
+	1,   // 36: cerberus.v1.ZonePolicyExplanation.compiled_rules:type_name -> cerberus.v1.Rule
+	59,  // 37: cerberus.v1.ExplainZonePolicyResponse.policies:type_name -> cerberus.v1.ZonePolicyExplanation
+	61,  // 38: cerberus.v1.Service.ports:type_name -> cerberus.v1.ServicePort
+	62,  // 39: cerberus.v1.SetServiceRequest.service:type_name -> cerberus.v1.Service
+	62,  // 40: cerberus.v1.ServiceResponse.service:type_name -> cerberus.v1.Service
+	62,  // 41: cerberus.v1.ServicesResponse.services:type_name -> cerberus.v1.Service
+	68,  // 42: cerberus.v1.SetAddressObjectRequest.object:type_name -> cerberus.v1.AddressObject
+	68,  // 43: cerberus.v1.AddressObjectResponse.object:type_name -> cerberus.v1.AddressObject
+	68,  // 44: cerberus.v1.AddressObjectsResponse.objects:type_name -> cerberus.v1.AddressObject
+	76,  // 45: cerberus.v1.CreateIPSetRequest.set:type_name -> cerberus.v1.IPSet
+	76,  // 46: cerberus.v1.IPSetResponse.set:type_name -> cerberus.v1.IPSet
+	76,  // 47: cerberus.v1.IPSetsResponse.sets:type_name -> cerberus.v1.IPSet
+	82,  // 48: cerberus.v1.FeedsResponse.feeds:type_name -> cerberus.v1.Feed
+	1,   // 49: cerberus.v1.Baseline.rules:type_name -> cerberus.v1.Rule
+	86,  // 50: cerberus.v1.SiteStatus.overrides:type_name -> cerberus.v1.FederationOverride
+	87,  // 51: cerberus.v1.FederationStatus.sites:type_name -> cerberus.v1.SiteStatus
+	87,  // 52: cerberus.v1.FederationStatus.site:type_name -> cerberus.v1.SiteStatus
+	219, // 53: cerberus.v1.DatabaseUpdate.results:type_name -> cerberus.v1.DatabaseUpdate.ResultsEntry
+	89,  // 54: cerberus.v1.DatabaseUpdatesResponse.updates:type_name -> cerberus.v1.DatabaseUpdate
+	92,  // 55: cerberus.v1.SetProtectionProfileRequest.profile:type_name -> cerberus.v1.ProtectionProfile
+	92,  // 56: cerberus.v1.ProtectionProfileResponse.profile:type_name -> cerberus.v1.ProtectionProfile
+	92,  // 57: cerberus.v1.ProtectionProfilesResponse.profiles:type_name -> cerberus.v1.ProtectionProfile
+	98,  // 58: cerberus.v1.ProtectionProfilesResponse.attachments:type_name -> cerberus.v1.ProtectionAttachment
+	100, // 59: cerberus.v1.SetTunnelRequest.tunnel:type_name -> cerberus.v1.Tunnel
+	100, // 60: cerberus.v1.TunnelResponse.tunnel:type_name -> cerberus.v1.Tunnel
+	100, // 61: cerberus.v1.TunnelsResponse.tunnels:type_name -> cerberus.v1.Tunnel
+	105, // 62: cerberus.v1.MulticastGroup.members:type_name -> cerberus.v1.MulticastMember
+	106, // 63: cerberus.v1.MulticastGroupsResponse.groups:type_name -> cerberus.v1.MulticastGroup
+	108, // 64: cerberus.v1.SetNAT64PrefixRequest.prefix:type_name -> cerberus.v1.NAT64Prefix
+	108, // 65: cerberus.v1.NAT64PrefixResponse.prefix:type_name -> cerberus.v1.NAT64Prefix
+	108, // 66: cerberus.v1.NAT64PrefixesResponse.prefixes:type_name -> cerberus.v1.NAT64Prefix
+	114, // 67: cerberus.v1.NAT64SessionsResponse.sessions:type_name -> cerberus.v1.NAT64Session
+	116, // 68: cerberus.v1.AddNatRuleRequest.rule:type_name -> cerberus.v1.NatRule
+	116, // 69: cerberus.v1.NatRuleResponse.rule:type_name -> cerberus.v1.NatRule
+	116, // 70: cerberus.v1.NatRulesResponse.rules:type_name -> cerberus.v1.NatRule
+	122, // 71: cerberus.v1.NatSessionsResponse.sessions:type_name -> cerberus.v1.NatSession
+	124, // 72: cerberus.v1.SetDomainListRequest.list:type_name -> cerberus.v1.DomainList
+	124, // 73: cerberus.v1.DomainListResponse.list:type_name -> cerberus.v1.DomainList
+	124, // 74: cerberus.v1.DomainListsResponse.lists:type_name -> cerberus.v1.DomainList
+	129, // 75: cerberus.v1.SetTLSFingerprintRuleRequest.rule:type_name -> cerberus.v1.TLSFingerprintRule
+	129, // 76: cerberus.v1.TLSFingerprintRuleResponse.rule:type_name -> cerberus.v1.TLSFingerprintRule
+	129, // 77: cerberus.v1.TLSFingerprintRulesResponse.rules:type_name -> cerberus.v1.TLSFingerprintRule
+	134, // 78: cerberus.v1.SetMitigationConfigRequest.config:type_name -> cerberus.v1.MitigationConfig
+	134, // 79: cerberus.v1.MitigationStatusResponse.config:type_name -> cerberus.v1.MitigationConfig
+	136, // 80: cerberus.v1.MitigationStatusResponse.destinations:type_name -> cerberus.v1.MitigatedDestination
+	138, // 81: cerberus.v1.SetSourceLimitConfigRequest.config:type_name -> cerberus.v1.SourceLimitConfig
+	138, // 82: cerberus.v1.SourceLimitStatusResponse.config:type_name -> cerberus.v1.SourceLimitConfig
+	140, // 83: cerberus.v1.SourceLimitStatusResponse.exemptions:type_name -> cerberus.v1.SourceExemption
+	143, // 84: cerberus.v1.TopOffendersResponse.offenders:type_name -> cerberus.v1.SourceOffender
+	140, // 85: cerberus.v1.SourceExemptionResponse.exemption:type_name -> cerberus.v1.SourceExemption
+	148, // 86: cerberus.v1.SetAutoBlockConfigRequest.config:type_name -> cerberus.v1.AutoBlockConfig
+	148, // 87: cerberus.v1.AutoBlockStatusResponse.config:type_name -> cerberus.v1.AutoBlockConfig
+	150, // 88: cerberus.v1.AutoBlockStatusResponse.blocks:type_name -> cerberus.v1.AutoBlock
+	148, // 89: cerberus.v1.ReplayEventsRequest.config:type_name -> cerberus.v1.AutoBlockConfig
+	150, // 90: cerberus.v1.ReplayEventsResponse.blocks:type_name -> cerberus.v1.AutoBlock
+	148, // 91: cerberus.v1.ReplayEventsResponse.config:type_name -> cerberus.v1.AutoBlockConfig
+	155, // 92: cerberus.v1.InterfaceConfig.mss_clamp:type_name -> cerberus.v1.MSSClamp
+	156, // 93: cerberus.v1.InterfaceConfig.policer:type_name -> cerberus.v1.Policer
+	157, // 94: cerberus.v1.SetInterfaceConfigRequest.config:type_name -> cerberus.v1.InterfaceConfig
+	157, // 95: cerberus.v1.InterfaceConfigResponse.config:type_name -> cerberus.v1.InterfaceConfig
+	157, // 96: cerberus.v1.InterfaceConfigsResponse.interfaces:type_name -> cerberus.v1.InterfaceConfig
+	162, // 97: cerberus.v1.SetPortScanConfigRequest.config:type_name -> cerberus.v1.PortScanConfig
+	162, // 98: cerberus.v1.PortScanStatusResponse.config:type_name -> cerberus.v1.PortScanConfig
+	164, // 99: cerberus.v1.PortScanStatusResponse.scanners:type_name -> cerberus.v1.PortScanner
+	166, // 100: cerberus.v1.SetNormalizationConfigRequest.config:type_name -> cerberus.v1.NormalizationConfig
+	166, // 101: cerberus.v1.NormalizationStatusResponse.config:type_name -> cerberus.v1.NormalizationConfig
+	1,   // 102: cerberus.v1.PolicyDocument.rules:type_name -> cerberus.v1.Rule
+	50,  // 103: cerberus.v1.PolicyDocument.zones:type_name -> cerberus.v1.Zone
+	51,  // 104: cerberus.v1.PolicyDocument.zone_policies:type_name -> cerberus.v1.ZonePolicy
+	62,  // 105: cerberus.v1.PolicyDocument.services:type_name -> cerberus.v1.Service
+	68,  // 106: cerberus.v1.PolicyDocument.address_objects:type_name -> cerberus.v1.AddressObject
+	179, // 107: cerberus.v1.PolicyDocument.namespaces:type_name -> cerberus.v1.Namespace
+	187, // 108: cerberus.v1.PolicyDocument.vf_policies:type_name -> cerberus.v1.VFPolicy
+	169, // 109: cerberus.v1.PlanApplyRequest.policy:type_name -> cerberus.v1.PolicyDocument
+	1,   // 110: cerberus.v1.RuleChange.before:type_name -> cerberus.v1.Rule
+	1,   // 111: cerberus.v1.RuleChange.after:type_name -> cerberus.v1.Rule
+	1,   // 112: cerberus.v1.PlanApplyResponse.adds:type_name -> cerberus.v1.Rule
+	1,   // 113: cerberus.v1.PlanApplyResponse.removes:type_name -> cerberus.v1.Rule
+	171, // 114: cerberus.v1.PlanApplyResponse.modifies:type_name -> cerberus.v1.RuleChange
+	169, // 115: cerberus.v1.AnalyzeRulesRequest.policy:type_name -> cerberus.v1.PolicyDocument
+	174, // 116: cerberus.v1.AnalyzeRulesResponse.findings:type_name -> cerberus.v1.RuleFinding
+	169, // 117: cerberus.v1.StartShadowPolicyRequest.policy:type_name -> cerberus.v1.PolicyDocument
+	3,   // 118: cerberus.v1.ShadowDisagreement.example:type_name -> cerberus.v1.Event
+	177, // 119: cerberus.v1.ShadowPolicyReport.disagreements:type_name -> cerberus.v1.ShadowDisagreement
+	179, // 120: cerberus.v1.AttachNamespaceRequest.namespace:type_name -> cerberus.v1.Namespace
+	179, // 121: cerberus.v1.NamespacesResponse.namespaces:type_name -> cerberus.v1.Namespace
+	184, // 122: cerberus.v1.PhysicalFunction.vfs:type_name -> cerberus.v1.VirtualFunction
+	185, // 123: cerberus.v1.SRIOVDevicesResponse.pfs:type_name -> cerberus.v1.PhysicalFunction
+	187, // 124: cerberus.v1.SRIOVDevicesResponse.policies:type_name -> cerberus.v1.VFPolicy
+	187, // 125: cerberus.v1.AttachVFPolicyRequest.policy:type_name -> cerberus.v1.VFPolicy
+	5,   // 126: cerberus.v1.VFStats.dataplane:type_name -> cerberus.v1.Statistics
+	192, // 127: cerberus.v1.OffloadStatusResponse.interfaces:type_name -> cerberus.v1.InterfaceOffload
+	194, // 128: cerberus.v1.ConnectionsResponse.connections:type_name -> cerberus.v1.Connection
+	194, // 129: cerberus.v1.KillConnectionRequest.connection:type_name -> cerberus.v1.Connection
+	199, // 130: cerberus.v1.StatsHistoryResponse.points:type_name -> cerberus.v1.StatsPoint
+	1,   // 131: cerberus.v1.HistoricalMatchesRequest.rule:type_name -> cerberus.v1.Rule
+	202, // 132: cerberus.v1.HistoricalMatchesResponse.days:type_name -> cerberus.v1.DayMatches
+	194, // 133: cerberus.v1.HistoricalMatchesResponse.samples:type_name -> cerberus.v1.Connection
+	220, // 134: cerberus.v1.FlowGroup.key:type_name -> cerberus.v1.FlowGroup.KeyEntry
+	205, // 135: cerberus.v1.FlowGroup.buckets:type_name -> cerberus.v1.FlowBucket
+	206, // 136: cerberus.v1.AggregateFlowsResponse.groups:type_name -> cerberus.v1.FlowGroup
+	206, // 137: cerberus.v1.AggregateFlowsResponse.other:type_name -> cerberus.v1.FlowGroup
+	206, // 138: cerberus.v1.AggregateFlowsResponse.total:type_name -> cerberus.v1.FlowGroup
+	208, // 139: cerberus.v1.PipelineResponse.stages:type_name -> cerberus.v1.PipelineStage
+	212, // 140: cerberus.v1.PipelineResponse.dispositions:type_name -> cerberus.v1.PacketDisposition
+	3,   // 141: cerberus.v1.ExplainVerdictResponse.event:type_name -> cerberus.v1.Event
+	1,   // 142: cerberus.v1.ExplainVerdictResponse.rule:type_name -> cerberus.v1.Rule
+	13,  // 143: cerberus.v1.FirewallControl.AddRule:input_type -> cerberus.v1.AddRuleRequest
+	15,  // 144: cerberus.v1.FirewallControl.DeleteRule:input_type -> cerberus.v1.DeleteRuleRequest
+	14,  // 145: cerberus.v1.FirewallControl.UpdateRule:input_type -> cerberus.v1.UpdateRuleRequest
+	29,  // 146: cerberus.v1.FirewallControl.GetRules:input_type -> cerberus.v1.GetRulesRequest
+	16,  // 147: cerberus.v1.FirewallControl.GetRule:input_type -> cerberus.v1.GetRuleRequest
+	17,  // 148: cerberus.v1.FirewallControl.ReplaceRules:input_type -> cerberus.v1.ReplaceRulesRequest
+	19,  // 149: cerberus.v1.FirewallControl.ReorderRules:input_type -> cerberus.v1.ReorderRulesRequest
+	38,  // 150: cerberus.v1.FirewallControl.SearchRules:input_type -> cerberus.v1.SearchRulesRequest
+	30,  // 151: cerberus.v1.FirewallControl.DeleteRulesByLabel:input_type -> cerberus.v1.RulesByLabelRequest
+	30,  // 152: cerberus.v1.FirewallControl.DisableRulesByLabel:input_type -> cerberus.v1.RulesByLabelRequest
+	30,  // 153: cerberus.v1.FirewallControl.EnableRulesByLabel:input_type -> cerberus.v1.RulesByLabelRequest
+	32,  // 154: cerberus.v1.FirewallControl.TemporaryAllow:input_type -> cerberus.v1.TemporaryAllowRequest
+	34,  // 155: cerberus.v1.FirewallControl.BlockASN:input_type -> cerberus.v1.BlockASNRequest
+	36,  // 156: cerberus.v1.FirewallControl.SetDefaultPolicy:input_type -> cerberus.v1.SetDefaultPolicyRequest
+	21,  // 157: cerberus.v1.FirewallControl.ImportRules:input_type -> cerberus.v1.ImportRulesRequest
+	23,  // 158: cerberus.v1.FirewallControl.ExportRules:input_type -> cerberus.v1.ExportRulesRequest
+	25,  // 159: cerberus.v1.FirewallControl.ImportNFTables:input_type -> cerberus.v1.ImportNFTablesRequest
+	27,  // 160: cerberus.v1.FirewallControl.ImportIPTables:input_type -> cerberus.v1.ImportIPTablesRequest
+	0,   // 161: cerberus.v1.FirewallControl.GetStats:input_type -> cerberus.v1.Empty
+	41,  // 162: cerberus.v1.FirewallControl.GetInterfaceStats:input_type -> cerberus.v1.GetInterfaceStatsRequest
+	198, // 163: cerberus.v1.FirewallControl.GetStatsHistory:input_type -> cerberus.v1.StatsHistoryRequest
+	9,   // 164: cerberus.v1.FirewallControl.PushExtensionStats:input_type -> cerberus.v1.PushExtensionStatsRequest
+	0,   // 165: cerberus.v1.FirewallControl.GetExtensionStats:input_type -> cerberus.v1.Empty
+	0,   // 166: cerberus.v1.FirewallControl.StreamEvents:input_type -> cerberus.v1.Empty
+	4,   // 167: cerberus.v1.FirewallControl.SubscribeEvents:input_type -> cerberus.v1.SubscribeEventsRequest
+	0,   // 168: cerberus.v1.FirewallControl.GetSystemInfo:input_type -> cerberus.v1.Empty
+	0,   // 169: cerberus.v1.FirewallControl.RestartDataPlane:input_type -> cerberus.v1.Empty
+	0,   // 170: cerberus.v1.FirewallControl.BackupConfig:input_type -> cerberus.v1.Empty
+	42,  // 171: cerberus.v1.FirewallControl.RestoreConfig:input_type -> cerberus.v1.RestoreRequest
+	52,  // 172: cerberus.v1.FirewallControl.SetZone:input_type -> cerberus.v1.SetZoneRequest
+	53,  // 173: cerberus.v1.FirewallControl.DeleteZone:input_type -> cerberus.v1.DeleteZoneRequest
+	0,   // 174: cerberus.v1.FirewallControl.ListZones:input_type -> cerberus.v1.Empty
+	55,  // 175: cerberus.v1.FirewallControl.AddZonePolicy:input_type -> cerberus.v1.AddZonePolicyRequest
+	57,  // 176: cerberus.v1.FirewallControl.DeleteZonePolicy:input_type -> cerberus.v1.DeleteZonePolicyRequest
+	58,  // 177: cerberus.v1.FirewallControl.ExplainZonePolicy:input_type -> cerberus.v1.ExplainZonePolicyRequest
+	63,  // 178: cerberus.v1.FirewallControl.SetService:input_type -> cerberus.v1.SetServiceRequest
+	64,  // 179: cerberus.v1.FirewallControl.GetService:input_type -> cerberus.v1.GetServiceRequest
+	66,  // 180: cerberus.v1.FirewallControl.DeleteService:input_type -> cerberus.v1.DeleteServiceRequest
+	0,   // 181: cerberus.v1.FirewallControl.ListServices:input_type -> cerberus.v1.Empty
+	69,  // 182: cerberus.v1.FirewallControl.SetAddressObject:input_type -> cerberus.v1.SetAddressObjectRequest
+	70,  // 183: cerberus.v1.FirewallControl.GetAddressObject:input_type -> cerberus.v1.GetAddressObjectRequest
+	72,  // 184: cerberus.v1.FirewallControl.DeleteAddressObject:input_type -> cerberus.v1.DeleteAddressObjectRequest
+	0,   // 185: cerberus.v1.FirewallControl.ListAddressObjects:input_type -> cerberus.v1.Empty
+	74,  // 186: cerberus.v1.FirewallControl.WhereUsed:input_type -> cerberus.v1.WhereUsedRequest
+	77,  // 187: cerberus.v1.FirewallControl.CreateIPSet:input_type -> cerberus.v1.CreateIPSetRequest
+	78,  // 188: cerberus.v1.FirewallControl.AddToIPSet:input_type -> cerberus.v1.IPSetEntriesRequest
+	78,  // 189: cerberus.v1.FirewallControl.RemoveFromIPSet:input_type -> cerberus.v1.IPSetEntriesRequest
+	80,  // 190: cerberus.v1.FirewallControl.DeleteIPSet:input_type -> cerberus.v1.DeleteIPSetRequest
+	0,   // 191: cerberus.v1.FirewallControl.ListIPSets:input_type -> cerberus.v1.Empty
+	0,   // 192: cerberus.v1.FirewallControl.ListFeeds:input_type -> cerberus.v1.Empty
+	84,  // 193: cerberus.v1.FirewallControl.WatchBaseline:input_type -> cerberus.v1.WatchBaselineRequest
+	87,  // 194: cerberus.v1.FirewallControl.ReportSiteStatus:input_type -> cerberus.v1.SiteStatus
+	0,   // 195: cerberus.v1.FirewallControl.GetFederationStatus:input_type -> cerberus.v1.Empty
+	0,   // 196: cerberus.v1.FirewallControl.ListDatabaseUpdates:input_type -> cerberus.v1.Empty
+	91,  // 197: cerberus.v1.FirewallControl.RollbackDatabase:input_type -> cerberus.v1.RollbackDatabaseRequest
+	93,  // 198: cerberus.v1.FirewallControl.SetProtectionProfile:input_type -> cerberus.v1.SetProtectionProfileRequest
+	95,  // 199: cerberus.v1.FirewallControl.DeleteProtectionProfile:input_type -> cerberus.v1.DeleteProtectionProfileRequest
+	0,   // 200: cerberus.v1.FirewallControl.ListProtectionProfiles:input_type -> cerberus.v1.Empty
+	96,  // 201: cerberus.v1.FirewallControl.AttachProtectionProfile:input_type -> cerberus.v1.AttachProtectionProfileRequest
+	97,  // 202: cerberus.v1.FirewallControl.DetachProtectionProfile:input_type -> cerberus.v1.DetachProtectionProfileRequest
+	101, // 203: cerberus.v1.FirewallControl.SetTunnel:input_type -> cerberus.v1.SetTunnelRequest
+	103, // 204: cerberus.v1.FirewallControl.DeleteTunnel:input_type -> cerberus.v1.DeleteTunnelRequest
+	0,   // 205: cerberus.v1.FirewallControl.ListTunnels:input_type -> cerberus.v1.Empty
+	0,   // 206: cerberus.v1.FirewallControl.ListMulticastGroups:input_type -> cerberus.v1.Empty
+	109, // 207: cerberus.v1.FirewallControl.SetNAT64Prefix:input_type -> cerberus.v1.SetNAT64PrefixRequest
+	111, // 208: cerberus.v1.FirewallControl.DeleteNAT64Prefix:input_type -> cerberus.v1.DeleteNAT64PrefixRequest
+	0,   // 209: cerberus.v1.FirewallControl.ListNAT64Prefixes:input_type -> cerberus.v1.Empty
+	113, // 210: cerberus.v1.FirewallControl.ListNAT64Sessions:input_type -> cerberus.v1.ListNAT64SessionsRequest
+	117, // 211: cerberus.v1.FirewallControl.AddNatRule:input_type -> cerberus.v1.AddNatRuleRequest
+	119, // 212: cerberus.v1.FirewallControl.DeleteNatRule:input_type -> cerberus.v1.DeleteNatRuleRequest
+	0,   // 213: cerberus.v1.FirewallControl.ListNatRules:input_type -> cerberus.v1.Empty
+	121, // 214: cerberus.v1.FirewallControl.ListNatSessions:input_type -> cerberus.v1.ListNatSessionsRequest
+	125, // 215: cerberus.v1.FirewallControl.SetDomainList:input_type -> cerberus.v1.SetDomainListRequest
+	127, // 216: cerberus.v1.FirewallControl.DeleteDomainList:input_type -> cerberus.v1.DeleteDomainListRequest
+	0,   // 217: cerberus.v1.FirewallControl.ListDomainLists:input_type -> cerberus.v1.Empty
+	130, // 218: cerberus.v1.FirewallControl.SetTLSFingerprintRule:input_type -> cerberus.v1.SetTLSFingerprintRuleRequest
+	132, // 219: cerberus.v1.FirewallControl.DeleteTLSFingerprintRule:input_type -> cerberus.v1.DeleteTLSFingerprintRuleRequest
+	0,   // 220: cerberus.v1.FirewallControl.ListTLSFingerprintRules:input_type -> cerberus.v1.Empty
+	135, // 221: cerberus.v1.FirewallControl.SetMitigationConfig:input_type -> cerberus.v1.SetMitigationConfigRequest
+	0,   // 222: cerberus.v1.FirewallControl.GetMitigationStatus:input_type -> cerberus.v1.Empty
+	139, // 223: cerberus.v1.FirewallControl.SetSourceLimitConfig:input_type -> cerberus.v1.SetSourceLimitConfigRequest
+	0,   // 224: cerberus.v1.FirewallControl.GetSourceLimitStatus:input_type -> cerberus.v1.Empty
+	142, // 225: cerberus.v1.FirewallControl.ListTopOffenders:input_type -> cerberus.v1.ListTopOffendersRequest
+	145, // 226: cerberus.v1.FirewallControl.ExemptSource:input_type -> cerberus.v1.ExemptSourceRequest
+	147, // 227: cerberus.v1.FirewallControl.DeleteSourceExemption:input_type -> cerberus.v1.DeleteSourceExemptionRequest
+	149, // 228: cerberus.v1.FirewallControl.SetAutoBlockConfig:input_type -> cerberus.v1.SetAutoBlockConfigRequest
+	0,   // 229: cerberus.v1.FirewallControl.GetAutoBlockStatus:input_type -> cerberus.v1.Empty
+	152, // 230: cerberus.v1.FirewallControl.ReleaseAutoBlock:input_type -> cerberus.v1.ReleaseAutoBlockRequest
+	153, // 231: cerberus.v1.FirewallControl.ReplayEvents:input_type -> cerberus.v1.ReplayEventsRequest
+	158, // 232: cerberus.v1.FirewallControl.SetInterfaceConfig:input_type -> cerberus.v1.SetInterfaceConfigRequest
+	160, // 233: cerberus.v1.FirewallControl.DeleteInterfaceConfig:input_type -> cerberus.v1.DeleteInterfaceConfigRequest
+	0,   // 234: cerberus.v1.FirewallControl.ListInterfaceConfigs:input_type -> cerberus.v1.Empty
+	163, // 235: cerberus.v1.FirewallControl.SetPortScanConfig:input_type -> cerberus.v1.SetPortScanConfigRequest
+	0,   // 236: cerberus.v1.FirewallControl.GetPortScanStatus:input_type -> cerberus.v1.Empty
+	167, // 237: cerberus.v1.FirewallControl.SetNormalizationConfig:input_type -> cerberus.v1.SetNormalizationConfigRequest
+	0,   // 238: cerberus.v1.FirewallControl.GetNormalizationStatus:input_type -> cerberus.v1.Empty
+	170, // 239: cerberus.v1.FirewallControl.PlanApply:input_type -> cerberus.v1.PlanApplyRequest
+	173, // 240: cerberus.v1.FirewallControl.AnalyzeRules:input_type -> cerberus.v1.AnalyzeRulesRequest
+	176, // 241: cerberus.v1.FirewallControl.StartShadowPolicy:input_type -> cerberus.v1.StartShadowPolicyRequest
+	0,   // 242: cerberus.v1.FirewallControl.StopShadowPolicy:input_type -> cerberus.v1.Empty
+	0,   // 243: cerberus.v1.FirewallControl.GetShadowPolicyReport:input_type -> cerberus.v1.Empty
+	180, // 244: cerberus.v1.FirewallControl.AttachNamespace:input_type -> cerberus.v1.AttachNamespaceRequest
+	181, // 245: cerberus.v1.FirewallControl.DetachNamespace:input_type -> cerberus.v1.DetachNamespaceRequest
+	0,   // 246: cerberus.v1.FirewallControl.ListNamespaces:input_type -> cerberus.v1.Empty
+	183, // 247: cerberus.v1.FirewallControl.GetNamespaceStats:input_type -> cerberus.v1.NamespaceStatsRequest
+	0,   // 248: cerberus.v1.FirewallControl.ListSRIOVDevices:input_type -> cerberus.v1.Empty
+	188, // 249: cerberus.v1.FirewallControl.AttachVFPolicy:input_type -> cerberus.v1.AttachVFPolicyRequest
+	189, // 250: cerberus.v1.FirewallControl.DetachVFPolicy:input_type -> cerberus.v1.DetachVFPolicyRequest
+	190, // 251: cerberus.v1.FirewallControl.GetVFStats:input_type -> cerberus.v1.VFStatsRequest
+	0,   // 252: cerberus.v1.FirewallControl.GetOffloadStatus:input_type -> cerberus.v1.Empty
+	195, // 253: cerberus.v1.FirewallControl.ListConnections:input_type -> cerberus.v1.ListConnectionsRequest
+	197, // 254: cerberus.v1.FirewallControl.KillConnection:input_type -> cerberus.v1.KillConnectionRequest
+	201, // 255: cerberus.v1.FirewallControl.QueryHistoricalMatches:input_type -> cerberus.v1.HistoricalMatchesRequest
+	204, // 256: cerberus.v1.FirewallControl.AggregateFlows:input_type -> cerberus.v1.AggregateFlowsRequest
+	209, // 257: cerberus.v1.FirewallControl.GetPipeline:input_type -> cerberus.v1.GetPipelineRequest
+	210, // 258: cerberus.v1.FirewallControl.UpdatePipeline:input_type -> cerberus.v1.UpdatePipelineRequest
+	213, // 259: cerberus.v1.FirewallControl.ExplainVerdict:input_type -> cerberus.v1.ExplainVerdictRequest
+	43,  // 260: cerberus.v1.FirewallControl.AddRule:output_type -> cerberus.v1.RuleResponse
+	46,  // 261: cerberus.v1.FirewallControl.DeleteRule:output_type -> cerberus.v1.StatusResponse
+	43,  // 262: cerberus.v1.FirewallControl.UpdateRule:output_type -> cerberus.v1.RuleResponse
+	45,  // 263: cerberus.v1.FirewallControl.GetRules:output_type -> cerberus.v1.RulesResponse
+	43,  // 264: cerberus.v1.FirewallControl.GetRule:output_type -> cerberus.v1.RuleResponse
+	18,  // 265: cerberus.v1.FirewallControl.ReplaceRules:output_type -> cerberus.v1.ReplaceRulesResponse
+	20,  // 266: cerberus.v1.FirewallControl.ReorderRules:output_type -> cerberus.v1.ReorderRulesResponse
+	39,  // 267: cerberus.v1.FirewallControl.SearchRules:output_type -> cerberus.v1.SearchRulesResponse
+	31,  // 268: cerberus.v1.FirewallControl.DeleteRulesByLabel:output_type -> cerberus.v1.RulesByLabelResponse
+	31,  // 269: cerberus.v1.FirewallControl.DisableRulesByLabel:output_type -> cerberus.v1.RulesByLabelResponse
+	31,  // 270: cerberus.v1.FirewallControl.EnableRulesByLabel:output_type -> cerberus.v1.RulesByLabelResponse
+	33,  // 271: cerberus.v1.FirewallControl.TemporaryAllow:output_type -> cerberus.v1.TemporaryAllowResponse
+	43,  // 272: cerberus.v1.FirewallControl.BlockASN:output_type -> cerberus.v1.RuleResponse
+	37,  // 273: cerberus.v1.FirewallControl.SetDefaultPolicy:output_type -> cerberus.v1.DefaultPolicyResponse
+	22,  // 274: cerberus.v1.FirewallControl.ImportRules:output_type -> cerberus.v1.ImportRulesResponse
+	24,  // 275: cerberus.v1.FirewallControl.ExportRules:output_type -> cerberus.v1.ExportRulesResponse
+	26,  // 276: cerberus.v1.FirewallControl.ImportNFTables:output_type -> cerberus.v1.ImportNFTablesResponse
+	28,  // 277: cerberus.v1.FirewallControl.ImportIPTables:output_type -> cerberus.v1.ImportIPTablesResponse
+	5,   // 278: cerberus.v1.FirewallControl.GetStats:output_type -> cerberus.v1.Statistics
+	47,  // 279: cerberus.v1.FirewallControl.GetInterfaceStats:output_type -> cerberus.v1.InterfaceStatsResponse
+	200, // 280: cerberus.v1.FirewallControl.GetStatsHistory:output_type -> cerberus.v1.StatsHistoryResponse
+	46,  // 281: cerberus.v1.FirewallControl.PushExtensionStats:output_type -> cerberus.v1.StatusResponse
+	10,  // 282: cerberus.v1.FirewallControl.GetExtensionStats:output_type -> cerberus.v1.ExtensionStatsResponse
+	3,   // 283: cerberus.v1.FirewallControl.StreamEvents:output_type -> cerberus.v1.Event
+	3,   // 284: cerberus.v1.FirewallControl.SubscribeEvents:output_type -> cerberus.v1.Event
+	48,  // 285: cerberus.v1.FirewallControl.GetSystemInfo:output_type -> cerberus.v1.SystemInfoResponse
+	46,  // 286: cerberus.v1.FirewallControl.RestartDataPlane:output_type -> cerberus.v1.StatusResponse
+	49,  // 287: cerberus.v1.FirewallControl.BackupConfig:output_type -> cerberus.v1.BackupResponse
+	46,  // 288: cerberus.v1.FirewallControl.RestoreConfig:output_type -> cerberus.v1.StatusResponse
+	46,  // 289: cerberus.v1.FirewallControl.SetZone:output_type -> cerberus.v1.StatusResponse
+	46,  // 290: cerberus.v1.FirewallControl.DeleteZone:output_type -> cerberus.v1.StatusResponse
+	54,  // 291: cerberus.v1.FirewallControl.ListZones:output_type -> cerberus.v1.ZonesResponse
+	56,  // 292: cerberus.v1.FirewallControl.AddZonePolicy:output_type -> cerberus.v1.ZonePolicyResponse
+	46,  // 293: cerberus.v1.FirewallControl.DeleteZonePolicy:output_type -> cerberus.v1.StatusResponse
+	60,  // 294: cerberus.v1.FirewallControl.ExplainZonePolicy:output_type -> cerberus.v1.ExplainZonePolicyResponse
+	46,  // 295: cerberus.v1.FirewallControl.SetService:output_type -> cerberus.v1.StatusResponse
+	65,  // 296: cerberus.v1.FirewallControl.GetService:output_type -> cerberus.v1.ServiceResponse
+	46,  // 297: cerberus.v1.FirewallControl.DeleteService:output_type -> cerberus.v1.StatusResponse
+	67,  // 298: cerberus.v1.FirewallControl.ListServices:output_type -> cerberus.v1.ServicesResponse
+	46,  // 299: cerberus.v1.FirewallControl.SetAddressObject:output_type -> cerberus.v1.StatusResponse
+	71,  // 300: cerberus.v1.FirewallControl.GetAddressObject:output_type -> cerberus.v1.AddressObjectResponse
+	46,  // 301: cerberus.v1.FirewallControl.DeleteAddressObject:output_type -> cerberus.v1.StatusResponse
+	73,  // 302: cerberus.v1.FirewallControl.ListAddressObjects:output_type -> cerberus.v1.AddressObjectsResponse
+	75,  // 303: cerberus.v1.FirewallControl.WhereUsed:output_type -> cerberus.v1.WhereUsedResponse
+	79,  // 304: cerberus.v1.FirewallControl.CreateIPSet:output_type -> cerberus.v1.IPSetResponse
+	79,  // 305: cerberus.v1.FirewallControl.AddToIPSet:output_type -> cerberus.v1.IPSetResponse
+	79,  // 306: cerberus.v1.FirewallControl.RemoveFromIPSet:output_type -> cerberus.v1.IPSetResponse
+	46,  // 307: cerberus.v1.FirewallControl.DeleteIPSet:output_type -> cerberus.v1.StatusResponse
+	81,  // 308: cerberus.v1.FirewallControl.ListIPSets:output_type -> cerberus.v1.IPSetsResponse
+	83,  // 309: cerberus.v1.FirewallControl.ListFeeds:output_type -> cerberus.v1.FeedsResponse
+	85,  // 310: cerberus.v1.FirewallControl.WatchBaseline:output_type -> cerberus.v1.Baseline
+	46,  // 311: cerberus.v1.FirewallControl.ReportSiteStatus:output_type -> cerberus.v1.StatusResponse
+	88,  // 312: cerberus.v1.FirewallControl.GetFederationStatus:output_type -> cerberus.v1.FederationStatus
+	90,  // 313: cerberus.v1.FirewallControl.ListDatabaseUpdates:output_type -> cerberus.v1.DatabaseUpdatesResponse
+	46,  // 314: cerberus.v1.FirewallControl.RollbackDatabase:output_type -> cerberus.v1.StatusResponse
+	94,  // 315: cerberus.v1.FirewallControl.SetProtectionProfile:output_type -> cerberus.v1.ProtectionProfileResponse
+	46,  // 316: cerberus.v1.FirewallControl.DeleteProtectionProfile:output_type -> cerberus.v1.StatusResponse
+	99,  // 317: cerberus.v1.FirewallControl.ListProtectionProfiles:output_type -> cerberus.v1.ProtectionProfilesResponse
+	46,  // 318: cerberus.v1.FirewallControl.AttachProtectionProfile:output_type -> cerberus.v1.StatusResponse
+	46,  // 319: cerberus.v1.FirewallControl.DetachProtectionProfile:output_type -> cerberus.v1.StatusResponse
+	102, // 320: cerberus.v1.FirewallControl.SetTunnel:output_type -> cerberus.v1.TunnelResponse
+	46,  // 321: cerberus.v1.FirewallControl.DeleteTunnel:output_type -> cerberus.v1.StatusResponse
+	104, // 322: cerberus.v1.FirewallControl.ListTunnels:output_type -> cerberus.v1.TunnelsResponse
+	107, // 323: cerberus.v1.FirewallControl.ListMulticastGroups:output_type -> cerberus.v1.MulticastGroupsResponse
+	110, // 324: cerberus.v1.FirewallControl.SetNAT64Prefix:output_type -> cerberus.v1.NAT64PrefixResponse
+	46,  // 325: cerberus.v1.FirewallControl.DeleteNAT64Prefix:output_type -> cerberus.v1.StatusResponse
+	112, // 326: cerberus.v1.FirewallControl.ListNAT64Prefixes:output_type -> cerberus.v1.NAT64PrefixesResponse
+	115, // 327: cerberus.v1.FirewallControl.ListNAT64Sessions:output_type -> cerberus.v1.NAT64SessionsResponse
+	118, // 328: cerberus.v1.FirewallControl.AddNatRule:output_type -> cerberus.v1.NatRuleResponse
+	46,  // 329: cerberus.v1.FirewallControl.DeleteNatRule:output_type -> cerberus.v1.StatusResponse
+	120, // 330: cerberus.v1.FirewallControl.ListNatRules:output_type -> cerberus.v1.NatRulesResponse
+	123, // 331: cerberus.v1.FirewallControl.ListNatSessions:output_type -> cerberus.v1.NatSessionsResponse
+	126, // 332: cerberus.v1.FirewallControl.SetDomainList:output_type -> cerberus.v1.DomainListResponse
+	46,  // 333: cerberus.v1.FirewallControl.DeleteDomainList:output_type -> cerberus.v1.StatusResponse
+	128, // 334: cerberus.v1.FirewallControl.ListDomainLists:output_type -> cerberus.v1.DomainListsResponse
+	131, // 335: cerberus.v1.FirewallControl.SetTLSFingerprintRule:output_type -> cerberus.v1.TLSFingerprintRuleResponse
+	46,  // 336: cerberus.v1.FirewallControl.DeleteTLSFingerprintRule:output_type -> cerberus.v1.StatusResponse
+	133, // 337: cerberus.v1.FirewallControl.ListTLSFingerprintRules:output_type -> cerberus.v1.TLSFingerprintRulesResponse
+	137, // 338: cerberus.v1.FirewallControl.SetMitigationConfig:output_type -> cerberus.v1.MitigationStatusResponse
+	137, // 339: cerberus.v1.FirewallControl.GetMitigationStatus:output_type -> cerberus.v1.MitigationStatusResponse
+	141, // 340: cerberus.v1.FirewallControl.SetSourceLimitConfig:output_type -> cerberus.v1.SourceLimitStatusResponse
+	141, // 341: cerberus.v1.FirewallControl.GetSourceLimitStatus:output_type -> cerberus.v1.SourceLimitStatusResponse
+	144, // 342: cerberus.v1.FirewallControl.ListTopOffenders:output_type -> cerberus.v1.TopOffendersResponse
+	146, // 343: cerberus.v1.FirewallControl.ExemptSource:output_type -> cerberus.v1.SourceExemptionResponse
+	46,  // 344: cerberus.v1.FirewallControl.DeleteSourceExemption:output_type -> cerberus.v1.StatusResponse
+	151, // 345: cerberus.v1.FirewallControl.SetAutoBlockConfig:output_type -> cerberus.v1.AutoBlockStatusResponse
+	151, // 346: cerberus.v1.FirewallControl.GetAutoBlockStatus:output_type -> cerberus.v1.AutoBlockStatusResponse
+	46,  // 347: cerberus.v1.FirewallControl.ReleaseAutoBlock:output_type -> cerberus.v1.StatusResponse
+	154, // 348: cerberus.v1.FirewallControl.ReplayEvents:output_type -> cerberus.v1.ReplayEventsResponse
+	159, // 349: cerberus.v1.FirewallControl.SetInterfaceConfig:output_type -> cerberus.v1.InterfaceConfigResponse
+	46,  // 350: cerberus.v1.FirewallControl.DeleteInterfaceConfig:output_type -> cerberus.v1.StatusResponse
+	161, // 351: cerberus.v1.FirewallControl.ListInterfaceConfigs:output_type -> cerberus.v1.InterfaceConfigsResponse
+	165, // 352: cerberus.v1.FirewallControl.SetPortScanConfig:output_type -> cerberus.v1.PortScanStatusResponse
+	165, // 353: cerberus.v1.FirewallControl.GetPortScanStatus:output_type -> cerberus.v1.PortScanStatusResponse
+	168, // 354: cerberus.v1.FirewallControl.SetNormalizationConfig:output_type -> cerberus.v1.NormalizationStatusResponse
+	168, // 355: cerberus.v1.FirewallControl.GetNormalizationStatus:output_type -> cerberus.v1.NormalizationStatusResponse
+	172, // 356: cerberus.v1.FirewallControl.PlanApply:output_type -> cerberus.v1.PlanApplyResponse
+	175, // 357: cerberus.v1.FirewallControl.AnalyzeRules:output_type -> cerberus.v1.AnalyzeRulesResponse
+	178, // 358: cerberus.v1.FirewallControl.StartShadowPolicy:output_type -> cerberus.v1.ShadowPolicyReport
+	178, // 359: cerberus.v1.FirewallControl.StopShadowPolicy:output_type -> cerberus.v1.ShadowPolicyReport
+	178, // 360: cerberus.v1.FirewallControl.GetShadowPolicyReport:output_type -> cerberus.v1.ShadowPolicyReport
+	46,  // 361: cerberus.v1.FirewallControl.AttachNamespace:output_type -> cerberus.v1.StatusResponse
+	46,  // 362: cerberus.v1.FirewallControl.DetachNamespace:output_type -> cerberus.v1.StatusResponse
+	182, // 363: cerberus.v1.FirewallControl.ListNamespaces:output_type -> cerberus.v1.NamespacesResponse
+	5,   // 364: cerberus.v1.FirewallControl.GetNamespaceStats:output_type -> cerberus.v1.Statistics
+	186, // 365: cerberus.v1.FirewallControl.ListSRIOVDevices:output_type -> cerberus.v1.SRIOVDevicesResponse
+	46,  // 366: cerberus.v1.FirewallControl.AttachVFPolicy:output_type -> cerberus.v1.StatusResponse
+	46,  // 367: cerberus.v1.FirewallControl.DetachVFPolicy:output_type -> cerberus.v1.StatusResponse
+	191, // 368: cerberus.v1.FirewallControl.GetVFStats:output_type -> cerberus.v1.VFStats
+	193, // 369: cerberus.v1.FirewallControl.GetOffloadStatus:output_type -> cerberus.v1.OffloadStatusResponse
+	196, // 370: cerberus.v1.FirewallControl.ListConnections:output_type -> cerberus.v1.ConnectionsResponse
+	46,  // 371: cerberus.v1.FirewallControl.KillConnection:output_type -> cerberus.v1.StatusResponse
+	203, // 372: cerberus.v1.FirewallControl.QueryHistoricalMatches:output_type -> cerberus.v1.HistoricalMatchesResponse
+	207, // 373: cerberus.v1.FirewallControl.AggregateFlows:output_type -> cerberus.v1.AggregateFlowsResponse
+	211, // 374: cerberus.v1.FirewallControl.GetPipeline:output_type -> cerberus.v1.PipelineResponse
+	211, // 375: cerberus.v1.FirewallControl.UpdatePipeline:output_type -> cerberus.v1.PipelineResponse
+	214, // 376: cerberus.v1.FirewallControl.ExplainVerdict:output_type -> cerberus.v1.ExplainVerdictResponse
+	260, // [260:377] is the sub-list for method output_type
+	143, // [143:260] is the sub-list for method input_type
+	143, // [143:143] is the sub-list for extension type_name
+	143, // [143:143] is the sub-list for extension extendee
+	0,   // [0:143] is the sub-list for field type_name
+}
+
+func init() { file_firewall_proto_init() }
+func file_firewall_proto_init() {
+	if File_firewall_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_firewall_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Statistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ProtocolStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtensionMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtensionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*PushExtensionStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtensionStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*AddRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ReorderRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportNFTablesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportNFTablesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportIPTablesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportIPTablesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RulesByLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*RulesByLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*TemporaryAllowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*TemporaryAllowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockASNRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*DefaultPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDefaultPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DefaultPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleSearchHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInterfaceStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*RulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SystemInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*BackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*Zone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ZonePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*SetZoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteZoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ZonesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*AddZonePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ZonePolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteZonePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainZonePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ZonePolicyExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainZonePolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ServicePort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*SetServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*GetServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*ServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAddressObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAddressObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAddressObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*AddressObjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*WhereUsedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*WhereUsedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*IPSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateIPSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*IPSetEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*IPSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteIPSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*IPSetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*Feed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*FeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchBaselineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*Baseline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*FederationOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*SiteStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*FederationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*DatabaseUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*DatabaseUpdatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*ProtectionProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*SetProtectionProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*ProtectionProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProtectionProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachProtectionProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachProtectionProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*ProtectionAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*ProtectionProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*Tunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*TunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*TunnelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*MulticastMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*MulticastGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*MulticastGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*NAT64Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*SetNAT64PrefixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*NAT64PrefixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteNAT64PrefixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*NAT64PrefixesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNAT64SessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*NAT64Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*NAT64SessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*NatRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*AddNatRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*NatRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteNatRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*NatRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*ListNatSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*NatSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*NatSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*DomainList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDomainListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*DomainListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDomainListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*DomainListsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSFingerprintRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTLSFingerprintRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSFingerprintRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteTLSFingerprintRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSFingerprintRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*MitigationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*SetMitigationConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*MitigatedDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*MitigationStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceLimitConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*SetSourceLimitConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceExemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceLimitStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTopOffendersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceOffender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*TopOffendersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[145].Exporter = func(v any, i int) any {
+			switch v := v.(*ExemptSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[146].Exporter = func(v any, i int) any {
+			switch v := v.(*SourceExemptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[147].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteSourceExemptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*AutoBlockConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[149].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAutoBlockConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[150].Exporter = func(v any, i int) any {
+			switch v := v.(*AutoBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[151].Exporter = func(v any, i int) any {
+			switch v := v.(*AutoBlockStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[152].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseAutoBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[153].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[154].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[155].Exporter = func(v any, i int) any {
+			switch v := v.(*MSSClamp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[156].Exporter = func(v any, i int) any {
+			switch v := v.(*Policer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[157].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[158].Exporter = func(v any, i int) any {
+			switch v := v.(*SetInterfaceConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[159].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[160].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteInterfaceConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[161].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[162].Exporter = func(v any, i int) any {
+			switch v := v.(*PortScanConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[163].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPortScanConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[164].Exporter = func(v any, i int) any {
+			switch v := v.(*PortScanner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[165].Exporter = func(v any, i int) any {
+			switch v := v.(*PortScanStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[166].Exporter = func(v any, i int) any {
+			switch v := v.(*NormalizationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[167].Exporter = func(v any, i int) any {
+			switch v := v.(*SetNormalizationConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[168].Exporter = func(v any, i int) any {
+			switch v := v.(*NormalizationStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[169].Exporter = func(v any, i int) any {
+			switch v := v.(*PolicyDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[170].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanApplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[171].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[172].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanApplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[173].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[174].Exporter = func(v any, i int) any {
+			switch v := v.(*RuleFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[175].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[176].Exporter = func(v any, i int) any {
+			switch v := v.(*StartShadowPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[177].Exporter = func(v any, i int) any {
+			switch v := v.(*ShadowDisagreement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[178].Exporter = func(v any, i int) any {
+			switch v := v.(*ShadowPolicyReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[179].Exporter = func(v any, i int) any {
+			switch v := v.(*Namespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[180].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[181].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[182].Exporter = func(v any, i int) any {
+			switch v := v.(*NamespacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[183].Exporter = func(v any, i int) any {
+			switch v := v.(*NamespaceStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[184].Exporter = func(v any, i int) any {
+			switch v := v.(*VirtualFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[185].Exporter = func(v any, i int) any {
+			switch v := v.(*PhysicalFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[186].Exporter = func(v any, i int) any {
+			switch v := v.(*SRIOVDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[187].Exporter = func(v any, i int) any {
+			switch v := v.(*VFPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[188].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachVFPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[189].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachVFPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[190].Exporter = func(v any, i int) any {
+			switch v := v.(*VFStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[191].Exporter = func(v any, i int) any {
+			switch v := v.(*VFStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[192].Exporter = func(v any, i int) any {
+			switch v := v.(*InterfaceOffload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[193].Exporter = func(v any, i int) any {
+			switch v := v.(*OffloadStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[194].Exporter = func(v any, i int) any {
+			switch v := v.(*Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[195].Exporter = func(v any, i int) any {
+			switch v := v.(*ListConnectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[196].Exporter = func(v any, i int) any {
+			switch v := v.(*ConnectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[197].Exporter = func(v any, i int) any {
+			switch v := v.(*KillConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[198].Exporter = func(v any, i int) any {
+			switch v := v.(*StatsHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[199].Exporter = func(v any, i int) any {
+			switch v := v.(*StatsPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[200].Exporter = func(v any, i int) any {
+			switch v := v.(*StatsHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[201].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoricalMatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[202].Exporter = func(v any, i int) any {
+			switch v := v.(*DayMatches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[203].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoricalMatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[204].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateFlowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[205].Exporter = func(v any, i int) any {
+			switch v := v.(*FlowBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[206].Exporter = func(v any, i int) any {
+			switch v := v.(*FlowGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[207].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateFlowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[208].Exporter = func(v any, i int) any {
+			switch v := v.(*PipelineStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[209].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[210].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[211].Exporter = func(v any, i int) any {
+			switch v := v.(*PipelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[212].Exporter = func(v any, i int) any {
+			switch v := v.(*PacketDisposition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[213].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainVerdictRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_firewall_proto_msgTypes[214].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainVerdictResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_firewall_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   221,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_firewall_proto_goTypes,
+		DependencyIndexes: file_firewall_proto_depIdxs,
+		MessageInfos:      file_firewall_proto_msgTypes,
+	}.Build()
+	File_firewall_proto = out.File
+	file_firewall_proto_rawDesc = nil
+	file_firewall_proto_goTypes = nil
+	file_firewall_proto_depIdxs = nil
+}
@@ -0,0 +1,4604 @@
+// SPDX-License-Identifier: Apache-2.0
+// Author: funcybot@gmail.com  Date: 2025-06-26
+// Cerberus-V gRPC API Schema
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: firewall.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FirewallControl_AddRule_FullMethodName                  = "/cerberus.v1.FirewallControl/AddRule"
+	FirewallControl_DeleteRule_FullMethodName               = "/cerberus.v1.FirewallControl/DeleteRule"
+	FirewallControl_UpdateRule_FullMethodName               = "/cerberus.v1.FirewallControl/UpdateRule"
+	FirewallControl_GetRules_FullMethodName                 = "/cerberus.v1.FirewallControl/GetRules"
+	FirewallControl_GetRule_FullMethodName                  = "/cerberus.v1.FirewallControl/GetRule"
+	FirewallControl_ReplaceRules_FullMethodName             = "/cerberus.v1.FirewallControl/ReplaceRules"
+	FirewallControl_ReorderRules_FullMethodName             = "/cerberus.v1.FirewallControl/ReorderRules"
+	FirewallControl_SearchRules_FullMethodName              = "/cerberus.v1.FirewallControl/SearchRules"
+	FirewallControl_DeleteRulesByLabel_FullMethodName       = "/cerberus.v1.FirewallControl/DeleteRulesByLabel"
+	FirewallControl_DisableRulesByLabel_FullMethodName      = "/cerberus.v1.FirewallControl/DisableRulesByLabel"
+	FirewallControl_EnableRulesByLabel_FullMethodName       = "/cerberus.v1.FirewallControl/EnableRulesByLabel"
+	FirewallControl_TemporaryAllow_FullMethodName           = "/cerberus.v1.FirewallControl/TemporaryAllow"
+	FirewallControl_BlockASN_FullMethodName                 = "/cerberus.v1.FirewallControl/BlockASN"
+	FirewallControl_SetDefaultPolicy_FullMethodName         = "/cerberus.v1.FirewallControl/SetDefaultPolicy"
+	FirewallControl_ImportRules_FullMethodName              = "/cerberus.v1.FirewallControl/ImportRules"
+	FirewallControl_ExportRules_FullMethodName              = "/cerberus.v1.FirewallControl/ExportRules"
+	FirewallControl_ImportNFTables_FullMethodName           = "/cerberus.v1.FirewallControl/ImportNFTables"
+	FirewallControl_ImportIPTables_FullMethodName           = "/cerberus.v1.FirewallControl/ImportIPTables"
+	FirewallControl_GetStats_FullMethodName                 = "/cerberus.v1.FirewallControl/GetStats"
+	FirewallControl_GetInterfaceStats_FullMethodName        = "/cerberus.v1.FirewallControl/GetInterfaceStats"
+	FirewallControl_GetStatsHistory_FullMethodName          = "/cerberus.v1.FirewallControl/GetStatsHistory"
+	FirewallControl_PushExtensionStats_FullMethodName       = "/cerberus.v1.FirewallControl/PushExtensionStats"
+	FirewallControl_GetExtensionStats_FullMethodName        = "/cerberus.v1.FirewallControl/GetExtensionStats"
+	FirewallControl_StreamEvents_FullMethodName             = "/cerberus.v1.FirewallControl/StreamEvents"
+	FirewallControl_SubscribeEvents_FullMethodName          = "/cerberus.v1.FirewallControl/SubscribeEvents"
+	FirewallControl_GetSystemInfo_FullMethodName            = "/cerberus.v1.FirewallControl/GetSystemInfo"
+	FirewallControl_RestartDataPlane_FullMethodName         = "/cerberus.v1.FirewallControl/RestartDataPlane"
+	FirewallControl_BackupConfig_FullMethodName             = "/cerberus.v1.FirewallControl/BackupConfig"
+	FirewallControl_RestoreConfig_FullMethodName            = "/cerberus.v1.FirewallControl/RestoreConfig"
+	FirewallControl_SetZone_FullMethodName                  = "/cerberus.v1.FirewallControl/SetZone"
+	FirewallControl_DeleteZone_FullMethodName               = "/cerberus.v1.FirewallControl/DeleteZone"
+	FirewallControl_ListZones_FullMethodName                = "/cerberus.v1.FirewallControl/ListZones"
+	FirewallControl_AddZonePolicy_FullMethodName            = "/cerberus.v1.FirewallControl/AddZonePolicy"
+	FirewallControl_DeleteZonePolicy_FullMethodName         = "/cerberus.v1.FirewallControl/DeleteZonePolicy"
+	FirewallControl_ExplainZonePolicy_FullMethodName        = "/cerberus.v1.FirewallControl/ExplainZonePolicy"
+	FirewallControl_SetService_FullMethodName               = "/cerberus.v1.FirewallControl/SetService"
+	FirewallControl_GetService_FullMethodName               = "/cerberus.v1.FirewallControl/GetService"
+	FirewallControl_DeleteService_FullMethodName            = "/cerberus.v1.FirewallControl/DeleteService"
+	FirewallControl_ListServices_FullMethodName             = "/cerberus.v1.FirewallControl/ListServices"
+	FirewallControl_SetAddressObject_FullMethodName         = "/cerberus.v1.FirewallControl/SetAddressObject"
+	FirewallControl_GetAddressObject_FullMethodName         = "/cerberus.v1.FirewallControl/GetAddressObject"
+	FirewallControl_DeleteAddressObject_FullMethodName      = "/cerberus.v1.FirewallControl/DeleteAddressObject"
+	FirewallControl_ListAddressObjects_FullMethodName       = "/cerberus.v1.FirewallControl/ListAddressObjects"
+	FirewallControl_WhereUsed_FullMethodName                = "/cerberus.v1.FirewallControl/WhereUsed"
+	FirewallControl_CreateIPSet_FullMethodName              = "/cerberus.v1.FirewallControl/CreateIPSet"
+	FirewallControl_AddToIPSet_FullMethodName               = "/cerberus.v1.FirewallControl/AddToIPSet"
+	FirewallControl_RemoveFromIPSet_FullMethodName          = "/cerberus.v1.FirewallControl/RemoveFromIPSet"
+	FirewallControl_DeleteIPSet_FullMethodName              = "/cerberus.v1.FirewallControl/DeleteIPSet"
+	FirewallControl_ListIPSets_FullMethodName               = "/cerberus.v1.FirewallControl/ListIPSets"
+	FirewallControl_ListFeeds_FullMethodName                = "/cerberus.v1.FirewallControl/ListFeeds"
+	FirewallControl_WatchBaseline_FullMethodName            = "/cerberus.v1.FirewallControl/WatchBaseline"
+	FirewallControl_ReportSiteStatus_FullMethodName         = "/cerberus.v1.FirewallControl/ReportSiteStatus"
+	FirewallControl_GetFederationStatus_FullMethodName      = "/cerberus.v1.FirewallControl/GetFederationStatus"
+	FirewallControl_ListDatabaseUpdates_FullMethodName      = "/cerberus.v1.FirewallControl/ListDatabaseUpdates"
+	FirewallControl_RollbackDatabase_FullMethodName         = "/cerberus.v1.FirewallControl/RollbackDatabase"
+	FirewallControl_SetProtectionProfile_FullMethodName     = "/cerberus.v1.FirewallControl/SetProtectionProfile"
+	FirewallControl_DeleteProtectionProfile_FullMethodName  = "/cerberus.v1.FirewallControl/DeleteProtectionProfile"
+	FirewallControl_ListProtectionProfiles_FullMethodName   = "/cerberus.v1.FirewallControl/ListProtectionProfiles"
+	FirewallControl_AttachProtectionProfile_FullMethodName  = "/cerberus.v1.FirewallControl/AttachProtectionProfile"
+	FirewallControl_DetachProtectionProfile_FullMethodName  = "/cerberus.v1.FirewallControl/DetachProtectionProfile"
+	FirewallControl_SetTunnel_FullMethodName                = "/cerberus.v1.FirewallControl/SetTunnel"
+	FirewallControl_DeleteTunnel_FullMethodName             = "/cerberus.v1.FirewallControl/DeleteTunnel"
+	FirewallControl_ListTunnels_FullMethodName              = "/cerberus.v1.FirewallControl/ListTunnels"
+	FirewallControl_ListMulticastGroups_FullMethodName      = "/cerberus.v1.FirewallControl/ListMulticastGroups"
+	FirewallControl_SetNAT64Prefix_FullMethodName           = "/cerberus.v1.FirewallControl/SetNAT64Prefix"
+	FirewallControl_DeleteNAT64Prefix_FullMethodName        = "/cerberus.v1.FirewallControl/DeleteNAT64Prefix"
+	FirewallControl_ListNAT64Prefixes_FullMethodName        = "/cerberus.v1.FirewallControl/ListNAT64Prefixes"
+	FirewallControl_ListNAT64Sessions_FullMethodName        = "/cerberus.v1.FirewallControl/ListNAT64Sessions"
+	FirewallControl_AddNatRule_FullMethodName               = "/cerberus.v1.FirewallControl/AddNatRule"
+	FirewallControl_DeleteNatRule_FullMethodName            = "/cerberus.v1.FirewallControl/DeleteNatRule"
+	FirewallControl_ListNatRules_FullMethodName             = "/cerberus.v1.FirewallControl/ListNatRules"
+	FirewallControl_ListNatSessions_FullMethodName          = "/cerberus.v1.FirewallControl/ListNatSessions"
+	FirewallControl_SetDomainList_FullMethodName            = "/cerberus.v1.FirewallControl/SetDomainList"
+	FirewallControl_DeleteDomainList_FullMethodName         = "/cerberus.v1.FirewallControl/DeleteDomainList"
+	FirewallControl_ListDomainLists_FullMethodName          = "/cerberus.v1.FirewallControl/ListDomainLists"
+	FirewallControl_SetTLSFingerprintRule_FullMethodName    = "/cerberus.v1.FirewallControl/SetTLSFingerprintRule"
+	FirewallControl_DeleteTLSFingerprintRule_FullMethodName = "/cerberus.v1.FirewallControl/DeleteTLSFingerprintRule"
+	FirewallControl_ListTLSFingerprintRules_FullMethodName  = "/cerberus.v1.FirewallControl/ListTLSFingerprintRules"
+	FirewallControl_SetMitigationConfig_FullMethodName      = "/cerberus.v1.FirewallControl/SetMitigationConfig"
+	FirewallControl_GetMitigationStatus_FullMethodName      = "/cerberus.v1.FirewallControl/GetMitigationStatus"
+	FirewallControl_SetSourceLimitConfig_FullMethodName     = "/cerberus.v1.FirewallControl/SetSourceLimitConfig"
+	FirewallControl_GetSourceLimitStatus_FullMethodName     = "/cerberus.v1.FirewallControl/GetSourceLimitStatus"
+	FirewallControl_ListTopOffenders_FullMethodName         = "/cerberus.v1.FirewallControl/ListTopOffenders"
+	FirewallControl_ExemptSource_FullMethodName             = "/cerberus.v1.FirewallControl/ExemptSource"
+	FirewallControl_DeleteSourceExemption_FullMethodName    = "/cerberus.v1.FirewallControl/DeleteSourceExemption"
+	FirewallControl_SetAutoBlockConfig_FullMethodName       = "/cerberus.v1.FirewallControl/SetAutoBlockConfig"
+	FirewallControl_GetAutoBlockStatus_FullMethodName       = "/cerberus.v1.FirewallControl/GetAutoBlockStatus"
+	FirewallControl_ReleaseAutoBlock_FullMethodName         = "/cerberus.v1.FirewallControl/ReleaseAutoBlock"
+	FirewallControl_ReplayEvents_FullMethodName             = "/cerberus.v1.FirewallControl/ReplayEvents"
+	FirewallControl_SetInterfaceConfig_FullMethodName       = "/cerberus.v1.FirewallControl/SetInterfaceConfig"
+	FirewallControl_DeleteInterfaceConfig_FullMethodName    = "/cerberus.v1.FirewallControl/DeleteInterfaceConfig"
+	FirewallControl_ListInterfaceConfigs_FullMethodName     = "/cerberus.v1.FirewallControl/ListInterfaceConfigs"
+	FirewallControl_SetPortScanConfig_FullMethodName        = "/cerberus.v1.FirewallControl/SetPortScanConfig"
+	FirewallControl_GetPortScanStatus_FullMethodName        = "/cerberus.v1.FirewallControl/GetPortScanStatus"
+	FirewallControl_SetNormalizationConfig_FullMethodName   = "/cerberus.v1.FirewallControl/SetNormalizationConfig"
+	FirewallControl_GetNormalizationStatus_FullMethodName   = "/cerberus.v1.FirewallControl/GetNormalizationStatus"
+	FirewallControl_PlanApply_FullMethodName                = "/cerberus.v1.FirewallControl/PlanApply"
+	FirewallControl_AnalyzeRules_FullMethodName             = "/cerberus.v1.FirewallControl/AnalyzeRules"
+	FirewallControl_StartShadowPolicy_FullMethodName        = "/cerberus.v1.FirewallControl/StartShadowPolicy"
+	FirewallControl_StopShadowPolicy_FullMethodName         = "/cerberus.v1.FirewallControl/StopShadowPolicy"
+	FirewallControl_GetShadowPolicyReport_FullMethodName    = "/cerberus.v1.FirewallControl/GetShadowPolicyReport"
+	FirewallControl_AttachNamespace_FullMethodName          = "/cerberus.v1.FirewallControl/AttachNamespace"
+	FirewallControl_DetachNamespace_FullMethodName          = "/cerberus.v1.FirewallControl/DetachNamespace"
+	FirewallControl_ListNamespaces_FullMethodName           = "/cerberus.v1.FirewallControl/ListNamespaces"
+	FirewallControl_GetNamespaceStats_FullMethodName        = "/cerberus.v1.FirewallControl/GetNamespaceStats"
+	FirewallControl_ListSRIOVDevices_FullMethodName         = "/cerberus.v1.FirewallControl/ListSRIOVDevices"
+	FirewallControl_AttachVFPolicy_FullMethodName           = "/cerberus.v1.FirewallControl/AttachVFPolicy"
+	FirewallControl_DetachVFPolicy_FullMethodName           = "/cerberus.v1.FirewallControl/DetachVFPolicy"
+	FirewallControl_GetVFStats_FullMethodName               = "/cerberus.v1.FirewallControl/GetVFStats"
+	FirewallControl_GetOffloadStatus_FullMethodName         = "/cerberus.v1.FirewallControl/GetOffloadStatus"
+	FirewallControl_ListConnections_FullMethodName          = "/cerberus.v1.FirewallControl/ListConnections"
+	FirewallControl_KillConnection_FullMethodName           = "/cerberus.v1.FirewallControl/KillConnection"
+	FirewallControl_QueryHistoricalMatches_FullMethodName   = "/cerberus.v1.FirewallControl/QueryHistoricalMatches"
+	FirewallControl_AggregateFlows_FullMethodName           = "/cerberus.v1.FirewallControl/AggregateFlows"
+	FirewallControl_GetPipeline_FullMethodName              = "/cerberus.v1.FirewallControl/GetPipeline"
+	FirewallControl_UpdatePipeline_FullMethodName           = "/cerberus.v1.FirewallControl/UpdatePipeline"
+	FirewallControl_ExplainVerdict_FullMethodName           = "/cerberus.v1.FirewallControl/ExplainVerdict"
+)
+
+// FirewallControlClient is the client API for FirewallControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Firewall Control Service
+type FirewallControlClient interface {
+	// Rule management
+	AddRule(ctx context.Context, in *AddRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error)
+	GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	ReplaceRules(ctx context.Context, in *ReplaceRulesRequest, opts ...grpc.CallOption) (*ReplaceRulesResponse, error)
+	ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ReorderRulesResponse, error)
+	SearchRules(ctx context.Context, in *SearchRulesRequest, opts ...grpc.CallOption) (*SearchRulesResponse, error)
+	DeleteRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error)
+	DisableRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error)
+	EnableRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error)
+	TemporaryAllow(ctx context.Context, in *TemporaryAllowRequest, opts ...grpc.CallOption) (*TemporaryAllowResponse, error)
+	BlockASN(ctx context.Context, in *BlockASNRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	SetDefaultPolicy(ctx context.Context, in *SetDefaultPolicyRequest, opts ...grpc.CallOption) (*DefaultPolicyResponse, error)
+	ImportRules(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRulesRequest, ImportRulesResponse], error)
+	ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error)
+	ImportNFTables(ctx context.Context, in *ImportNFTablesRequest, opts ...grpc.CallOption) (*ImportNFTablesResponse, error)
+	ImportIPTables(ctx context.Context, in *ImportIPTablesRequest, opts ...grpc.CallOption) (*ImportIPTablesResponse, error)
+	// Statistics and monitoring
+	GetStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Statistics, error)
+	GetInterfaceStats(ctx context.Context, in *GetInterfaceStatsRequest, opts ...grpc.CallOption) (*InterfaceStatsResponse, error)
+	GetStatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error)
+	PushExtensionStats(ctx context.Context, in *PushExtensionStatsRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetExtensionStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExtensionStatsResponse, error)
+	StreamEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	// System management
+	GetSystemInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SystemInfoResponse, error)
+	RestartDataPlane(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	BackupConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BackupResponse, error)
+	RestoreConfig(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Zone-based policy
+	SetZone(ctx context.Context, in *SetZoneRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListZones(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ZonesResponse, error)
+	AddZonePolicy(ctx context.Context, in *AddZonePolicyRequest, opts ...grpc.CallOption) (*ZonePolicyResponse, error)
+	DeleteZonePolicy(ctx context.Context, in *DeleteZonePolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ExplainZonePolicy(ctx context.Context, in *ExplainZonePolicyRequest, opts ...grpc.CallOption) (*ExplainZonePolicyResponse, error)
+	// Service objects
+	SetService(ctx context.Context, in *SetServiceRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error)
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListServices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServicesResponse, error)
+	// Address objects and groups
+	SetAddressObject(ctx context.Context, in *SetAddressObjectRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetAddressObject(ctx context.Context, in *GetAddressObjectRequest, opts ...grpc.CallOption) (*AddressObjectResponse, error)
+	DeleteAddressObject(ctx context.Context, in *DeleteAddressObjectRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListAddressObjects(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressObjectsResponse, error)
+	WhereUsed(ctx context.Context, in *WhereUsedRequest, opts ...grpc.CallOption) (*WhereUsedResponse, error)
+	// IP sets
+	CreateIPSet(ctx context.Context, in *CreateIPSetRequest, opts ...grpc.CallOption) (*IPSetResponse, error)
+	AddToIPSet(ctx context.Context, in *IPSetEntriesRequest, opts ...grpc.CallOption) (*IPSetResponse, error)
+	RemoveFromIPSet(ctx context.Context, in *IPSetEntriesRequest, opts ...grpc.CallOption) (*IPSetResponse, error)
+	DeleteIPSet(ctx context.Context, in *DeleteIPSetRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListIPSets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*IPSetsResponse, error)
+	// Threat intelligence feeds, configured with CERBERUS_FEEDS
+	ListFeeds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FeedsResponse, error)
+	// Policy federation: regional controllers subscribe to the baseline
+	// rules of a global policy service and report how their site applied them
+	WatchBaseline(ctx context.Context, in *WatchBaselineRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Baseline], error)
+	ReportSiteStatus(ctx context.Context, in *SiteStatus, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetFederationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FederationStatus, error)
+	// Scheduled downloads of the GeoIP and ASN databases
+	ListDatabaseUpdates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DatabaseUpdatesResponse, error)
+	RollbackDatabase(ctx context.Context, in *RollbackDatabaseRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Service protection profiles
+	SetProtectionProfile(ctx context.Context, in *SetProtectionProfileRequest, opts ...grpc.CallOption) (*ProtectionProfileResponse, error)
+	DeleteProtectionProfile(ctx context.Context, in *DeleteProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListProtectionProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProtectionProfilesResponse, error)
+	AttachProtectionProfile(ctx context.Context, in *AttachProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DetachProtectionProfile(ctx context.Context, in *DetachProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Tunnel termination
+	SetTunnel(ctx context.Context, in *SetTunnelRequest, opts ...grpc.CallOption) (*TunnelResponse, error)
+	DeleteTunnel(ctx context.Context, in *DeleteTunnelRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListTunnels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TunnelsResponse, error)
+	// Multicast groups joined through IGMP/MLD, with their counters
+	ListMulticastGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MulticastGroupsResponse, error)
+	// NAT64 prefixes and sessions of IPv6-only clients reaching IPv4 servers
+	SetNAT64Prefix(ctx context.Context, in *SetNAT64PrefixRequest, opts ...grpc.CallOption) (*NAT64PrefixResponse, error)
+	DeleteNAT64Prefix(ctx context.Context, in *DeleteNAT64PrefixRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListNAT64Prefixes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NAT64PrefixesResponse, error)
+	ListNAT64Sessions(ctx context.Context, in *ListNAT64SessionsRequest, opts ...grpc.CallOption) (*NAT64SessionsResponse, error)
+	// NAT rules: port forwarding of a public address and port to an internal one
+	AddNatRule(ctx context.Context, in *AddNatRuleRequest, opts ...grpc.CallOption) (*NatRuleResponse, error)
+	DeleteNatRule(ctx context.Context, in *DeleteNatRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListNatRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NatRulesResponse, error)
+	ListNatSessions(ctx context.Context, in *ListNatSessionsRequest, opts ...grpc.CallOption) (*NatSessionsResponse, error)
+	// DNS filtering of queries against allow and deny domain lists
+	SetDomainList(ctx context.Context, in *SetDomainListRequest, opts ...grpc.CallOption) (*DomainListResponse, error)
+	DeleteDomainList(ctx context.Context, in *DeleteDomainListRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListDomainLists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DomainListsResponse, error)
+	// TLS fingerprinting: rules blocking or alerting on JA3/JA4 fingerprints
+	SetTLSFingerprintRule(ctx context.Context, in *SetTLSFingerprintRuleRequest, opts ...grpc.CallOption) (*TLSFingerprintRuleResponse, error)
+	DeleteTLSFingerprintRule(ctx context.Context, in *DeleteTLSFingerprintRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListTLSFingerprintRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TLSFingerprintRulesResponse, error)
+	// SYN flood mitigation of destinations receiving SYNs above a threshold
+	SetMitigationConfig(ctx context.Context, in *SetMitigationConfigRequest, opts ...grpc.CallOption) (*MitigationStatusResponse, error)
+	GetMitigationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MitigationStatusResponse, error)
+	// Per-source rate limits of packets and new connections
+	SetSourceLimitConfig(ctx context.Context, in *SetSourceLimitConfigRequest, opts ...grpc.CallOption) (*SourceLimitStatusResponse, error)
+	GetSourceLimitStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SourceLimitStatusResponse, error)
+	ListTopOffenders(ctx context.Context, in *ListTopOffendersRequest, opts ...grpc.CallOption) (*TopOffendersResponse, error)
+	ExemptSource(ctx context.Context, in *ExemptSourceRequest, opts ...grpc.CallOption) (*SourceExemptionResponse, error)
+	DeleteSourceExemption(ctx context.Context, in *DeleteSourceExemptionRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Automatic blocking of sources with repeated drops or auth failures
+	SetAutoBlockConfig(ctx context.Context, in *SetAutoBlockConfigRequest, opts ...grpc.CallOption) (*AutoBlockStatusResponse, error)
+	GetAutoBlockStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AutoBlockStatusResponse, error)
+	ReleaseAutoBlock(ctx context.Context, in *ReleaseAutoBlockRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error)
+	// Per-interface settings: MSS clamping of TCP SYNs
+	SetInterfaceConfig(ctx context.Context, in *SetInterfaceConfigRequest, opts ...grpc.CallOption) (*InterfaceConfigResponse, error)
+	DeleteInterfaceConfig(ctx context.Context, in *DeleteInterfaceConfigRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListInterfaceConfigs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InterfaceConfigsResponse, error)
+	// Port scan detection
+	SetPortScanConfig(ctx context.Context, in *SetPortScanConfigRequest, opts ...grpc.CallOption) (*PortScanStatusResponse, error)
+	GetPortScanStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PortScanStatusResponse, error)
+	// Packet normalization: bogon sources, invalid checksums, illegal TCP flags
+	SetNormalizationConfig(ctx context.Context, in *SetNormalizationConfigRequest, opts ...grpc.CallOption) (*NormalizationStatusResponse, error)
+	GetNormalizationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NormalizationStatusResponse, error)
+	// Change planning
+	PlanApply(ctx context.Context, in *PlanApplyRequest, opts ...grpc.CallOption) (*PlanApplyResponse, error)
+	AnalyzeRules(ctx context.Context, in *AnalyzeRulesRequest, opts ...grpc.CallOption) (*AnalyzeRulesResponse, error)
+	// Shadow enforcement: a candidate policy evaluated against live samples
+	StartShadowPolicy(ctx context.Context, in *StartShadowPolicyRequest, opts ...grpc.CallOption) (*ShadowPolicyReport, error)
+	StopShadowPolicy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShadowPolicyReport, error)
+	GetShadowPolicyReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShadowPolicyReport, error)
+	// Network namespaces (containers, VRFs) with their own data plane
+	AttachNamespace(ctx context.Context, in *AttachNamespaceRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DetachNamespace(ctx context.Context, in *DetachNamespaceRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListNamespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error)
+	GetNamespaceStats(ctx context.Context, in *NamespaceStatsRequest, opts ...grpc.CallOption) (*Statistics, error)
+	// SR-IOV virtual functions with per-VF data planes
+	ListSRIOVDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SRIOVDevicesResponse, error)
+	AttachVFPolicy(ctx context.Context, in *AttachVFPolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DetachVFPolicy(ctx context.Context, in *DetachVFPolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetVFStats(ctx context.Context, in *VFStatsRequest, opts ...grpc.CallOption) (*VFStats, error)
+	// Hardware offload verification
+	GetOffloadStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OffloadStatusResponse, error)
+	// Connection tracking
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ConnectionsResponse, error)
+	KillConnection(ctx context.Context, in *KillConnectionRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	QueryHistoricalMatches(ctx context.Context, in *HistoricalMatchesRequest, opts ...grpc.CallOption) (*HistoricalMatchesResponse, error)
+	AggregateFlows(ctx context.Context, in *AggregateFlowsRequest, opts ...grpc.CallOption) (*AggregateFlowsResponse, error)
+	// Tail-call pipeline of XDP stages
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error)
+	UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error)
+	// Verdict explanation of sampled drops
+	ExplainVerdict(ctx context.Context, in *ExplainVerdictRequest, opts ...grpc.CallOption) (*ExplainVerdictResponse, error)
+}
+
+type firewallControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFirewallControlClient(cc grpc.ClientConnInterface) FirewallControlClient {
+	return &firewallControlClient{cc}
+}
+
+func (c *firewallControlClient) AddRule(ctx context.Context, in *AddRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AddRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_UpdateRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetRules(ctx context.Context, in *GetRulesRequest, opts ...grpc.CallOption) (*RulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ReplaceRules(ctx context.Context, in *ReplaceRulesRequest, opts ...grpc.CallOption) (*ReplaceRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ReplaceRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ReorderRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ReorderRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SearchRules(ctx context.Context, in *SearchRulesRequest, opts ...grpc.CallOption) (*SearchRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SearchRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesByLabelResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteRulesByLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DisableRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesByLabelResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DisableRulesByLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) EnableRulesByLabel(ctx context.Context, in *RulesByLabelRequest, opts ...grpc.CallOption) (*RulesByLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RulesByLabelResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_EnableRulesByLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) TemporaryAllow(ctx context.Context, in *TemporaryAllowRequest, opts ...grpc.CallOption) (*TemporaryAllowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemporaryAllowResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_TemporaryAllow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) BlockASN(ctx context.Context, in *BlockASNRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_BlockASN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetDefaultPolicy(ctx context.Context, in *SetDefaultPolicyRequest, opts ...grpc.CallOption) (*DefaultPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultPolicyResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetDefaultPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ImportRules(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRulesRequest, ImportRulesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FirewallControl_ServiceDesc.Streams[0], FirewallControl_ImportRules_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRulesRequest, ImportRulesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_ImportRulesClient = grpc.ClientStreamingClient[ImportRulesRequest, ImportRulesResponse]
+
+func (c *firewallControlClient) ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ExportRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ImportNFTables(ctx context.Context, in *ImportNFTablesRequest, opts ...grpc.CallOption) (*ImportNFTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportNFTablesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ImportNFTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ImportIPTables(ctx context.Context, in *ImportIPTablesRequest, opts ...grpc.CallOption) (*ImportIPTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportIPTablesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ImportIPTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Statistics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Statistics)
+	err := c.cc.Invoke(ctx, FirewallControl_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetInterfaceStats(ctx context.Context, in *GetInterfaceStatsRequest, opts ...grpc.CallOption) (*InterfaceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterfaceStatsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetInterfaceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetStatsHistory(ctx context.Context, in *StatsHistoryRequest, opts ...grpc.CallOption) (*StatsHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsHistoryResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetStatsHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) PushExtensionStats(ctx context.Context, in *PushExtensionStatsRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_PushExtensionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetExtensionStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExtensionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtensionStatsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetExtensionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) StreamEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FirewallControl_ServiceDesc.Streams[1], FirewallControl_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_StreamEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *firewallControlClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FirewallControl_ServiceDesc.Streams[2], FirewallControl_SubscribeEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_SubscribeEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *firewallControlClient) GetSystemInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SystemInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemInfoResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetSystemInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) RestartDataPlane(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_RestartDataPlane_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) BackupConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_BackupConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) RestoreConfig(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_RestoreConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetZone(ctx context.Context, in *SetZoneRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListZones(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZonesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AddZonePolicy(ctx context.Context, in *AddZonePolicyRequest, opts ...grpc.CallOption) (*ZonePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZonePolicyResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AddZonePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteZonePolicy(ctx context.Context, in *DeleteZonePolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteZonePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ExplainZonePolicy(ctx context.Context, in *ExplainZonePolicyRequest, opts ...grpc.CallOption) (*ExplainZonePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainZonePolicyResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ExplainZonePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetService(ctx context.Context, in *SetServiceRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*ServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListServices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServicesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetAddressObject(ctx context.Context, in *SetAddressObjectRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetAddressObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetAddressObject(ctx context.Context, in *GetAddressObjectRequest, opts ...grpc.CallOption) (*AddressObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressObjectResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetAddressObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteAddressObject(ctx context.Context, in *DeleteAddressObjectRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteAddressObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListAddressObjects(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressObjectsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListAddressObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) WhereUsed(ctx context.Context, in *WhereUsedRequest, opts ...grpc.CallOption) (*WhereUsedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhereUsedResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_WhereUsed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) CreateIPSet(ctx context.Context, in *CreateIPSetRequest, opts ...grpc.CallOption) (*IPSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IPSetResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_CreateIPSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AddToIPSet(ctx context.Context, in *IPSetEntriesRequest, opts ...grpc.CallOption) (*IPSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IPSetResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AddToIPSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) RemoveFromIPSet(ctx context.Context, in *IPSetEntriesRequest, opts ...grpc.CallOption) (*IPSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IPSetResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_RemoveFromIPSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteIPSet(ctx context.Context, in *DeleteIPSetRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteIPSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListIPSets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*IPSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IPSetsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListIPSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListFeeds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeedsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) WatchBaseline(ctx context.Context, in *WatchBaselineRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Baseline], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FirewallControl_ServiceDesc.Streams[3], FirewallControl_WatchBaseline_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBaselineRequest, Baseline]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_WatchBaselineClient = grpc.ServerStreamingClient[Baseline]
+
+func (c *firewallControlClient) ReportSiteStatus(ctx context.Context, in *SiteStatus, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ReportSiteStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetFederationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FederationStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FederationStatus)
+	err := c.cc.Invoke(ctx, FirewallControl_GetFederationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListDatabaseUpdates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DatabaseUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseUpdatesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListDatabaseUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) RollbackDatabase(ctx context.Context, in *RollbackDatabaseRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_RollbackDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetProtectionProfile(ctx context.Context, in *SetProtectionProfileRequest, opts ...grpc.CallOption) (*ProtectionProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProtectionProfileResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetProtectionProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteProtectionProfile(ctx context.Context, in *DeleteProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteProtectionProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListProtectionProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProtectionProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProtectionProfilesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListProtectionProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AttachProtectionProfile(ctx context.Context, in *AttachProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AttachProtectionProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DetachProtectionProfile(ctx context.Context, in *DetachProtectionProfileRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DetachProtectionProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetTunnel(ctx context.Context, in *SetTunnelRequest, opts ...grpc.CallOption) (*TunnelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TunnelResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetTunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteTunnel(ctx context.Context, in *DeleteTunnelRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteTunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListTunnels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TunnelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TunnelsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListTunnels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListMulticastGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MulticastGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MulticastGroupsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListMulticastGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetNAT64Prefix(ctx context.Context, in *SetNAT64PrefixRequest, opts ...grpc.CallOption) (*NAT64PrefixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NAT64PrefixResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetNAT64Prefix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteNAT64Prefix(ctx context.Context, in *DeleteNAT64PrefixRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteNAT64Prefix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListNAT64Prefixes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NAT64PrefixesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NAT64PrefixesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListNAT64Prefixes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListNAT64Sessions(ctx context.Context, in *ListNAT64SessionsRequest, opts ...grpc.CallOption) (*NAT64SessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NAT64SessionsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListNAT64Sessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AddNatRule(ctx context.Context, in *AddNatRuleRequest, opts ...grpc.CallOption) (*NatRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NatRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AddNatRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteNatRule(ctx context.Context, in *DeleteNatRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteNatRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListNatRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NatRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NatRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListNatRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListNatSessions(ctx context.Context, in *ListNatSessionsRequest, opts ...grpc.CallOption) (*NatSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NatSessionsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListNatSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetDomainList(ctx context.Context, in *SetDomainListRequest, opts ...grpc.CallOption) (*DomainListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DomainListResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteDomainList(ctx context.Context, in *DeleteDomainListRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListDomainLists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DomainListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DomainListsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListDomainLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetTLSFingerprintRule(ctx context.Context, in *SetTLSFingerprintRuleRequest, opts ...grpc.CallOption) (*TLSFingerprintRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TLSFingerprintRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetTLSFingerprintRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteTLSFingerprintRule(ctx context.Context, in *DeleteTLSFingerprintRuleRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteTLSFingerprintRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListTLSFingerprintRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TLSFingerprintRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TLSFingerprintRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListTLSFingerprintRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetMitigationConfig(ctx context.Context, in *SetMitigationConfigRequest, opts ...grpc.CallOption) (*MitigationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MitigationStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetMitigationConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetMitigationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MitigationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MitigationStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetMitigationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetSourceLimitConfig(ctx context.Context, in *SetSourceLimitConfigRequest, opts ...grpc.CallOption) (*SourceLimitStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SourceLimitStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetSourceLimitConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetSourceLimitStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SourceLimitStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SourceLimitStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetSourceLimitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListTopOffenders(ctx context.Context, in *ListTopOffendersRequest, opts ...grpc.CallOption) (*TopOffendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopOffendersResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListTopOffenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ExemptSource(ctx context.Context, in *ExemptSourceRequest, opts ...grpc.CallOption) (*SourceExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SourceExemptionResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ExemptSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteSourceExemption(ctx context.Context, in *DeleteSourceExemptionRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteSourceExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetAutoBlockConfig(ctx context.Context, in *SetAutoBlockConfigRequest, opts ...grpc.CallOption) (*AutoBlockStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoBlockStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetAutoBlockConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetAutoBlockStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AutoBlockStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoBlockStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetAutoBlockStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ReleaseAutoBlock(ctx context.Context, in *ReleaseAutoBlockRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ReleaseAutoBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayEventsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ReplayEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetInterfaceConfig(ctx context.Context, in *SetInterfaceConfigRequest, opts ...grpc.CallOption) (*InterfaceConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterfaceConfigResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetInterfaceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DeleteInterfaceConfig(ctx context.Context, in *DeleteInterfaceConfigRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DeleteInterfaceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListInterfaceConfigs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InterfaceConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterfaceConfigsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListInterfaceConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetPortScanConfig(ctx context.Context, in *SetPortScanConfigRequest, opts ...grpc.CallOption) (*PortScanStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortScanStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetPortScanConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetPortScanStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PortScanStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortScanStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetPortScanStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) SetNormalizationConfig(ctx context.Context, in *SetNormalizationConfigRequest, opts ...grpc.CallOption) (*NormalizationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizationStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_SetNormalizationConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetNormalizationStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NormalizationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizationStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetNormalizationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) PlanApply(ctx context.Context, in *PlanApplyRequest, opts ...grpc.CallOption) (*PlanApplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanApplyResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_PlanApply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AnalyzeRules(ctx context.Context, in *AnalyzeRulesRequest, opts ...grpc.CallOption) (*AnalyzeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AnalyzeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) StartShadowPolicy(ctx context.Context, in *StartShadowPolicyRequest, opts ...grpc.CallOption) (*ShadowPolicyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShadowPolicyReport)
+	err := c.cc.Invoke(ctx, FirewallControl_StartShadowPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) StopShadowPolicy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShadowPolicyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShadowPolicyReport)
+	err := c.cc.Invoke(ctx, FirewallControl_StopShadowPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetShadowPolicyReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShadowPolicyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShadowPolicyReport)
+	err := c.cc.Invoke(ctx, FirewallControl_GetShadowPolicyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AttachNamespace(ctx context.Context, in *AttachNamespaceRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AttachNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DetachNamespace(ctx context.Context, in *DetachNamespaceRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DetachNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListNamespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespacesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetNamespaceStats(ctx context.Context, in *NamespaceStatsRequest, opts ...grpc.CallOption) (*Statistics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Statistics)
+	err := c.cc.Invoke(ctx, FirewallControl_GetNamespaceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListSRIOVDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SRIOVDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SRIOVDevicesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListSRIOVDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AttachVFPolicy(ctx context.Context, in *AttachVFPolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AttachVFPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) DetachVFPolicy(ctx context.Context, in *DetachVFPolicyRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_DetachVFPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetVFStats(ctx context.Context, in *VFStatsRequest, opts ...grpc.CallOption) (*VFStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VFStats)
+	err := c.cc.Invoke(ctx, FirewallControl_GetVFStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetOffloadStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OffloadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffloadStatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetOffloadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectionsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ListConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) KillConnection(ctx context.Context, in *KillConnectionRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_KillConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) QueryHistoricalMatches(ctx context.Context, in *HistoricalMatchesRequest, opts ...grpc.CallOption) (*HistoricalMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HistoricalMatchesResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_QueryHistoricalMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) AggregateFlows(ctx context.Context, in *AggregateFlowsRequest, opts ...grpc.CallOption) (*AggregateFlowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateFlowsResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_AggregateFlows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_GetPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) UpdatePipeline(ctx context.Context, in *UpdatePipelineRequest, opts ...grpc.CallOption) (*PipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PipelineResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_UpdatePipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallControlClient) ExplainVerdict(ctx context.Context, in *ExplainVerdictRequest, opts ...grpc.CallOption) (*ExplainVerdictResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainVerdictResponse)
+	err := c.cc.Invoke(ctx, FirewallControl_ExplainVerdict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FirewallControlServer is the server API for FirewallControl service.
+// All implementations must embed UnimplementedFirewallControlServer
+// for forward compatibility.
+//
+// Firewall Control Service
+type FirewallControlServer interface {
+	// Rule management
+	AddRule(context.Context, *AddRuleRequest) (*RuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*StatusResponse, error)
+	UpdateRule(context.Context, *UpdateRuleRequest) (*RuleResponse, error)
+	GetRules(context.Context, *GetRulesRequest) (*RulesResponse, error)
+	GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error)
+	ReplaceRules(context.Context, *ReplaceRulesRequest) (*ReplaceRulesResponse, error)
+	ReorderRules(context.Context, *ReorderRulesRequest) (*ReorderRulesResponse, error)
+	SearchRules(context.Context, *SearchRulesRequest) (*SearchRulesResponse, error)
+	DeleteRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error)
+	DisableRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error)
+	EnableRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error)
+	TemporaryAllow(context.Context, *TemporaryAllowRequest) (*TemporaryAllowResponse, error)
+	BlockASN(context.Context, *BlockASNRequest) (*RuleResponse, error)
+	SetDefaultPolicy(context.Context, *SetDefaultPolicyRequest) (*DefaultPolicyResponse, error)
+	ImportRules(grpc.ClientStreamingServer[ImportRulesRequest, ImportRulesResponse]) error
+	ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error)
+	ImportNFTables(context.Context, *ImportNFTablesRequest) (*ImportNFTablesResponse, error)
+	ImportIPTables(context.Context, *ImportIPTablesRequest) (*ImportIPTablesResponse, error)
+	// Statistics and monitoring
+	GetStats(context.Context, *Empty) (*Statistics, error)
+	GetInterfaceStats(context.Context, *GetInterfaceStatsRequest) (*InterfaceStatsResponse, error)
+	GetStatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error)
+	PushExtensionStats(context.Context, *PushExtensionStatsRequest) (*StatusResponse, error)
+	GetExtensionStats(context.Context, *Empty) (*ExtensionStatsResponse, error)
+	StreamEvents(*Empty, grpc.ServerStreamingServer[Event]) error
+	SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[Event]) error
+	// System management
+	GetSystemInfo(context.Context, *Empty) (*SystemInfoResponse, error)
+	RestartDataPlane(context.Context, *Empty) (*StatusResponse, error)
+	BackupConfig(context.Context, *Empty) (*BackupResponse, error)
+	RestoreConfig(context.Context, *RestoreRequest) (*StatusResponse, error)
+	// Zone-based policy
+	SetZone(context.Context, *SetZoneRequest) (*StatusResponse, error)
+	DeleteZone(context.Context, *DeleteZoneRequest) (*StatusResponse, error)
+	ListZones(context.Context, *Empty) (*ZonesResponse, error)
+	AddZonePolicy(context.Context, *AddZonePolicyRequest) (*ZonePolicyResponse, error)
+	DeleteZonePolicy(context.Context, *DeleteZonePolicyRequest) (*StatusResponse, error)
+	ExplainZonePolicy(context.Context, *ExplainZonePolicyRequest) (*ExplainZonePolicyResponse, error)
+	// Service objects
+	SetService(context.Context, *SetServiceRequest) (*StatusResponse, error)
+	GetService(context.Context, *GetServiceRequest) (*ServiceResponse, error)
+	DeleteService(context.Context, *DeleteServiceRequest) (*StatusResponse, error)
+	ListServices(context.Context, *Empty) (*ServicesResponse, error)
+	// Address objects and groups
+	SetAddressObject(context.Context, *SetAddressObjectRequest) (*StatusResponse, error)
+	GetAddressObject(context.Context, *GetAddressObjectRequest) (*AddressObjectResponse, error)
+	DeleteAddressObject(context.Context, *DeleteAddressObjectRequest) (*StatusResponse, error)
+	ListAddressObjects(context.Context, *Empty) (*AddressObjectsResponse, error)
+	WhereUsed(context.Context, *WhereUsedRequest) (*WhereUsedResponse, error)
+	// IP sets
+	CreateIPSet(context.Context, *CreateIPSetRequest) (*IPSetResponse, error)
+	AddToIPSet(context.Context, *IPSetEntriesRequest) (*IPSetResponse, error)
+	RemoveFromIPSet(context.Context, *IPSetEntriesRequest) (*IPSetResponse, error)
+	DeleteIPSet(context.Context, *DeleteIPSetRequest) (*StatusResponse, error)
+	ListIPSets(context.Context, *Empty) (*IPSetsResponse, error)
+	// Threat intelligence feeds, configured with CERBERUS_FEEDS
+	ListFeeds(context.Context, *Empty) (*FeedsResponse, error)
+	// Policy federation: regional controllers subscribe to the baseline
+	// rules of a global policy service and report how their site applied them
+	WatchBaseline(*WatchBaselineRequest, grpc.ServerStreamingServer[Baseline]) error
+	ReportSiteStatus(context.Context, *SiteStatus) (*StatusResponse, error)
+	GetFederationStatus(context.Context, *Empty) (*FederationStatus, error)
+	// Scheduled downloads of the GeoIP and ASN databases
+	ListDatabaseUpdates(context.Context, *Empty) (*DatabaseUpdatesResponse, error)
+	RollbackDatabase(context.Context, *RollbackDatabaseRequest) (*StatusResponse, error)
+	// Service protection profiles
+	SetProtectionProfile(context.Context, *SetProtectionProfileRequest) (*ProtectionProfileResponse, error)
+	DeleteProtectionProfile(context.Context, *DeleteProtectionProfileRequest) (*StatusResponse, error)
+	ListProtectionProfiles(context.Context, *Empty) (*ProtectionProfilesResponse, error)
+	AttachProtectionProfile(context.Context, *AttachProtectionProfileRequest) (*StatusResponse, error)
+	DetachProtectionProfile(context.Context, *DetachProtectionProfileRequest) (*StatusResponse, error)
+	// Tunnel termination
+	SetTunnel(context.Context, *SetTunnelRequest) (*TunnelResponse, error)
+	DeleteTunnel(context.Context, *DeleteTunnelRequest) (*StatusResponse, error)
+	ListTunnels(context.Context, *Empty) (*TunnelsResponse, error)
+	// Multicast groups joined through IGMP/MLD, with their counters
+	ListMulticastGroups(context.Context, *Empty) (*MulticastGroupsResponse, error)
+	// NAT64 prefixes and sessions of IPv6-only clients reaching IPv4 servers
+	SetNAT64Prefix(context.Context, *SetNAT64PrefixRequest) (*NAT64PrefixResponse, error)
+	DeleteNAT64Prefix(context.Context, *DeleteNAT64PrefixRequest) (*StatusResponse, error)
+	ListNAT64Prefixes(context.Context, *Empty) (*NAT64PrefixesResponse, error)
+	ListNAT64Sessions(context.Context, *ListNAT64SessionsRequest) (*NAT64SessionsResponse, error)
+	// NAT rules: port forwarding of a public address and port to an internal one
+	AddNatRule(context.Context, *AddNatRuleRequest) (*NatRuleResponse, error)
+	DeleteNatRule(context.Context, *DeleteNatRuleRequest) (*StatusResponse, error)
+	ListNatRules(context.Context, *Empty) (*NatRulesResponse, error)
+	ListNatSessions(context.Context, *ListNatSessionsRequest) (*NatSessionsResponse, error)
+	// DNS filtering of queries against allow and deny domain lists
+	SetDomainList(context.Context, *SetDomainListRequest) (*DomainListResponse, error)
+	DeleteDomainList(context.Context, *DeleteDomainListRequest) (*StatusResponse, error)
+	ListDomainLists(context.Context, *Empty) (*DomainListsResponse, error)
+	// TLS fingerprinting: rules blocking or alerting on JA3/JA4 fingerprints
+	SetTLSFingerprintRule(context.Context, *SetTLSFingerprintRuleRequest) (*TLSFingerprintRuleResponse, error)
+	DeleteTLSFingerprintRule(context.Context, *DeleteTLSFingerprintRuleRequest) (*StatusResponse, error)
+	ListTLSFingerprintRules(context.Context, *Empty) (*TLSFingerprintRulesResponse, error)
+	// SYN flood mitigation of destinations receiving SYNs above a threshold
+	SetMitigationConfig(context.Context, *SetMitigationConfigRequest) (*MitigationStatusResponse, error)
+	GetMitigationStatus(context.Context, *Empty) (*MitigationStatusResponse, error)
+	// Per-source rate limits of packets and new connections
+	SetSourceLimitConfig(context.Context, *SetSourceLimitConfigRequest) (*SourceLimitStatusResponse, error)
+	GetSourceLimitStatus(context.Context, *Empty) (*SourceLimitStatusResponse, error)
+	ListTopOffenders(context.Context, *ListTopOffendersRequest) (*TopOffendersResponse, error)
+	ExemptSource(context.Context, *ExemptSourceRequest) (*SourceExemptionResponse, error)
+	DeleteSourceExemption(context.Context, *DeleteSourceExemptionRequest) (*StatusResponse, error)
+	// Automatic blocking of sources with repeated drops or auth failures
+	SetAutoBlockConfig(context.Context, *SetAutoBlockConfigRequest) (*AutoBlockStatusResponse, error)
+	GetAutoBlockStatus(context.Context, *Empty) (*AutoBlockStatusResponse, error)
+	ReleaseAutoBlock(context.Context, *ReleaseAutoBlockRequest) (*StatusResponse, error)
+	ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error)
+	// Per-interface settings: MSS clamping of TCP SYNs
+	SetInterfaceConfig(context.Context, *SetInterfaceConfigRequest) (*InterfaceConfigResponse, error)
+	DeleteInterfaceConfig(context.Context, *DeleteInterfaceConfigRequest) (*StatusResponse, error)
+	ListInterfaceConfigs(context.Context, *Empty) (*InterfaceConfigsResponse, error)
+	// Port scan detection
+	SetPortScanConfig(context.Context, *SetPortScanConfigRequest) (*PortScanStatusResponse, error)
+	GetPortScanStatus(context.Context, *Empty) (*PortScanStatusResponse, error)
+	// Packet normalization: bogon sources, invalid checksums, illegal TCP flags
+	SetNormalizationConfig(context.Context, *SetNormalizationConfigRequest) (*NormalizationStatusResponse, error)
+	GetNormalizationStatus(context.Context, *Empty) (*NormalizationStatusResponse, error)
+	// Change planning
+	PlanApply(context.Context, *PlanApplyRequest) (*PlanApplyResponse, error)
+	AnalyzeRules(context.Context, *AnalyzeRulesRequest) (*AnalyzeRulesResponse, error)
+	// Shadow enforcement: a candidate policy evaluated against live samples
+	StartShadowPolicy(context.Context, *StartShadowPolicyRequest) (*ShadowPolicyReport, error)
+	StopShadowPolicy(context.Context, *Empty) (*ShadowPolicyReport, error)
+	GetShadowPolicyReport(context.Context, *Empty) (*ShadowPolicyReport, error)
+	// Network namespaces (containers, VRFs) with their own data plane
+	AttachNamespace(context.Context, *AttachNamespaceRequest) (*StatusResponse, error)
+	DetachNamespace(context.Context, *DetachNamespaceRequest) (*StatusResponse, error)
+	ListNamespaces(context.Context, *Empty) (*NamespacesResponse, error)
+	GetNamespaceStats(context.Context, *NamespaceStatsRequest) (*Statistics, error)
+	// SR-IOV virtual functions with per-VF data planes
+	ListSRIOVDevices(context.Context, *Empty) (*SRIOVDevicesResponse, error)
+	AttachVFPolicy(context.Context, *AttachVFPolicyRequest) (*StatusResponse, error)
+	DetachVFPolicy(context.Context, *DetachVFPolicyRequest) (*StatusResponse, error)
+	GetVFStats(context.Context, *VFStatsRequest) (*VFStats, error)
+	// Hardware offload verification
+	GetOffloadStatus(context.Context, *Empty) (*OffloadStatusResponse, error)
+	// Connection tracking
+	ListConnections(context.Context, *ListConnectionsRequest) (*ConnectionsResponse, error)
+	KillConnection(context.Context, *KillConnectionRequest) (*StatusResponse, error)
+	QueryHistoricalMatches(context.Context, *HistoricalMatchesRequest) (*HistoricalMatchesResponse, error)
+	AggregateFlows(context.Context, *AggregateFlowsRequest) (*AggregateFlowsResponse, error)
+	// Tail-call pipeline of XDP stages
+	GetPipeline(context.Context, *GetPipelineRequest) (*PipelineResponse, error)
+	UpdatePipeline(context.Context, *UpdatePipelineRequest) (*PipelineResponse, error)
+	// Verdict explanation of sampled drops
+	ExplainVerdict(context.Context, *ExplainVerdictRequest) (*ExplainVerdictResponse, error)
+	mustEmbedUnimplementedFirewallControlServer()
+}
+
+// UnimplementedFirewallControlServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFirewallControlServer struct{}
+
+func (UnimplementedFirewallControlServer) AddRule(context.Context, *AddRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteRule(context.Context, *DeleteRuleRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedFirewallControlServer) UpdateRule(context.Context, *UpdateRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedFirewallControlServer) GetRules(context.Context, *GetRulesRequest) (*RulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRules not implemented")
+}
+func (UnimplementedFirewallControlServer) GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (UnimplementedFirewallControlServer) ReplaceRules(context.Context, *ReplaceRulesRequest) (*ReplaceRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceRules not implemented")
+}
+func (UnimplementedFirewallControlServer) ReorderRules(context.Context, *ReorderRulesRequest) (*ReorderRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderRules not implemented")
+}
+func (UnimplementedFirewallControlServer) SearchRules(context.Context, *SearchRulesRequest) (*SearchRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRules not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRulesByLabel not implemented")
+}
+func (UnimplementedFirewallControlServer) DisableRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableRulesByLabel not implemented")
+}
+func (UnimplementedFirewallControlServer) EnableRulesByLabel(context.Context, *RulesByLabelRequest) (*RulesByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableRulesByLabel not implemented")
+}
+func (UnimplementedFirewallControlServer) TemporaryAllow(context.Context, *TemporaryAllowRequest) (*TemporaryAllowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemporaryAllow not implemented")
+}
+func (UnimplementedFirewallControlServer) BlockASN(context.Context, *BlockASNRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockASN not implemented")
+}
+func (UnimplementedFirewallControlServer) SetDefaultPolicy(context.Context, *SetDefaultPolicyRequest) (*DefaultPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultPolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) ImportRules(grpc.ClientStreamingServer[ImportRulesRequest, ImportRulesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRules not implemented")
+}
+func (UnimplementedFirewallControlServer) ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRules not implemented")
+}
+func (UnimplementedFirewallControlServer) ImportNFTables(context.Context, *ImportNFTablesRequest) (*ImportNFTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportNFTables not implemented")
+}
+func (UnimplementedFirewallControlServer) ImportIPTables(context.Context, *ImportIPTablesRequest) (*ImportIPTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportIPTables not implemented")
+}
+func (UnimplementedFirewallControlServer) GetStats(context.Context, *Empty) (*Statistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedFirewallControlServer) GetInterfaceStats(context.Context, *GetInterfaceStatsRequest) (*InterfaceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterfaceStats not implemented")
+}
+func (UnimplementedFirewallControlServer) GetStatsHistory(context.Context, *StatsHistoryRequest) (*StatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatsHistory not implemented")
+}
+func (UnimplementedFirewallControlServer) PushExtensionStats(context.Context, *PushExtensionStatsRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushExtensionStats not implemented")
+}
+func (UnimplementedFirewallControlServer) GetExtensionStats(context.Context, *Empty) (*ExtensionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExtensionStats not implemented")
+}
+func (UnimplementedFirewallControlServer) StreamEvents(*Empty, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedFirewallControlServer) SubscribeEvents(*SubscribeEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedFirewallControlServer) GetSystemInfo(context.Context, *Empty) (*SystemInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInfo not implemented")
+}
+func (UnimplementedFirewallControlServer) RestartDataPlane(context.Context, *Empty) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartDataPlane not implemented")
+}
+func (UnimplementedFirewallControlServer) BackupConfig(context.Context, *Empty) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) RestoreConfig(context.Context, *RestoreRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) SetZone(context.Context, *SetZoneRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetZone not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteZone(context.Context, *DeleteZoneRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZone not implemented")
+}
+func (UnimplementedFirewallControlServer) ListZones(context.Context, *Empty) (*ZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZones not implemented")
+}
+func (UnimplementedFirewallControlServer) AddZonePolicy(context.Context, *AddZonePolicyRequest) (*ZonePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddZonePolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteZonePolicy(context.Context, *DeleteZonePolicyRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZonePolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) ExplainZonePolicy(context.Context, *ExplainZonePolicyRequest) (*ExplainZonePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainZonePolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) SetService(context.Context, *SetServiceRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetService not implemented")
+}
+func (UnimplementedFirewallControlServer) GetService(context.Context, *GetServiceRequest) (*ServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteService(context.Context, *DeleteServiceRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedFirewallControlServer) ListServices(context.Context, *Empty) (*ServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedFirewallControlServer) SetAddressObject(context.Context, *SetAddressObjectRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressObject not implemented")
+}
+func (UnimplementedFirewallControlServer) GetAddressObject(context.Context, *GetAddressObjectRequest) (*AddressObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressObject not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteAddressObject(context.Context, *DeleteAddressObjectRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddressObject not implemented")
+}
+func (UnimplementedFirewallControlServer) ListAddressObjects(context.Context, *Empty) (*AddressObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressObjects not implemented")
+}
+func (UnimplementedFirewallControlServer) WhereUsed(context.Context, *WhereUsedRequest) (*WhereUsedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhereUsed not implemented")
+}
+func (UnimplementedFirewallControlServer) CreateIPSet(context.Context, *CreateIPSetRequest) (*IPSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIPSet not implemented")
+}
+func (UnimplementedFirewallControlServer) AddToIPSet(context.Context, *IPSetEntriesRequest) (*IPSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToIPSet not implemented")
+}
+func (UnimplementedFirewallControlServer) RemoveFromIPSet(context.Context, *IPSetEntriesRequest) (*IPSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromIPSet not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteIPSet(context.Context, *DeleteIPSetRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIPSet not implemented")
+}
+func (UnimplementedFirewallControlServer) ListIPSets(context.Context, *Empty) (*IPSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPSets not implemented")
+}
+func (UnimplementedFirewallControlServer) ListFeeds(context.Context, *Empty) (*FeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeds not implemented")
+}
+func (UnimplementedFirewallControlServer) WatchBaseline(*WatchBaselineRequest, grpc.ServerStreamingServer[Baseline]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBaseline not implemented")
+}
+func (UnimplementedFirewallControlServer) ReportSiteStatus(context.Context, *SiteStatus) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSiteStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) GetFederationStatus(context.Context, *Empty) (*FederationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFederationStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) ListDatabaseUpdates(context.Context, *Empty) (*DatabaseUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabaseUpdates not implemented")
+}
+func (UnimplementedFirewallControlServer) RollbackDatabase(context.Context, *RollbackDatabaseRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDatabase not implemented")
+}
+func (UnimplementedFirewallControlServer) SetProtectionProfile(context.Context, *SetProtectionProfileRequest) (*ProtectionProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtectionProfile not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteProtectionProfile(context.Context, *DeleteProtectionProfileRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProtectionProfile not implemented")
+}
+func (UnimplementedFirewallControlServer) ListProtectionProfiles(context.Context, *Empty) (*ProtectionProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtectionProfiles not implemented")
+}
+func (UnimplementedFirewallControlServer) AttachProtectionProfile(context.Context, *AttachProtectionProfileRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachProtectionProfile not implemented")
+}
+func (UnimplementedFirewallControlServer) DetachProtectionProfile(context.Context, *DetachProtectionProfileRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachProtectionProfile not implemented")
+}
+func (UnimplementedFirewallControlServer) SetTunnel(context.Context, *SetTunnelRequest) (*TunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTunnel not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteTunnel(context.Context, *DeleteTunnelRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTunnel not implemented")
+}
+func (UnimplementedFirewallControlServer) ListTunnels(context.Context, *Empty) (*TunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTunnels not implemented")
+}
+func (UnimplementedFirewallControlServer) ListMulticastGroups(context.Context, *Empty) (*MulticastGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMulticastGroups not implemented")
+}
+func (UnimplementedFirewallControlServer) SetNAT64Prefix(context.Context, *SetNAT64PrefixRequest) (*NAT64PrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNAT64Prefix not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteNAT64Prefix(context.Context, *DeleteNAT64PrefixRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNAT64Prefix not implemented")
+}
+func (UnimplementedFirewallControlServer) ListNAT64Prefixes(context.Context, *Empty) (*NAT64PrefixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNAT64Prefixes not implemented")
+}
+func (UnimplementedFirewallControlServer) ListNAT64Sessions(context.Context, *ListNAT64SessionsRequest) (*NAT64SessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNAT64Sessions not implemented")
+}
+func (UnimplementedFirewallControlServer) AddNatRule(context.Context, *AddNatRuleRequest) (*NatRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNatRule not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteNatRule(context.Context, *DeleteNatRuleRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNatRule not implemented")
+}
+func (UnimplementedFirewallControlServer) ListNatRules(context.Context, *Empty) (*NatRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNatRules not implemented")
+}
+func (UnimplementedFirewallControlServer) ListNatSessions(context.Context, *ListNatSessionsRequest) (*NatSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNatSessions not implemented")
+}
+func (UnimplementedFirewallControlServer) SetDomainList(context.Context, *SetDomainListRequest) (*DomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainList not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteDomainList(context.Context, *DeleteDomainListRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDomainList not implemented")
+}
+func (UnimplementedFirewallControlServer) ListDomainLists(context.Context, *Empty) (*DomainListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomainLists not implemented")
+}
+func (UnimplementedFirewallControlServer) SetTLSFingerprintRule(context.Context, *SetTLSFingerprintRuleRequest) (*TLSFingerprintRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTLSFingerprintRule not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteTLSFingerprintRule(context.Context, *DeleteTLSFingerprintRuleRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTLSFingerprintRule not implemented")
+}
+func (UnimplementedFirewallControlServer) ListTLSFingerprintRules(context.Context, *Empty) (*TLSFingerprintRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTLSFingerprintRules not implemented")
+}
+func (UnimplementedFirewallControlServer) SetMitigationConfig(context.Context, *SetMitigationConfigRequest) (*MitigationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMitigationConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) GetMitigationStatus(context.Context, *Empty) (*MitigationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMitigationStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) SetSourceLimitConfig(context.Context, *SetSourceLimitConfigRequest) (*SourceLimitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSourceLimitConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) GetSourceLimitStatus(context.Context, *Empty) (*SourceLimitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSourceLimitStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) ListTopOffenders(context.Context, *ListTopOffendersRequest) (*TopOffendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopOffenders not implemented")
+}
+func (UnimplementedFirewallControlServer) ExemptSource(context.Context, *ExemptSourceRequest) (*SourceExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExemptSource not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteSourceExemption(context.Context, *DeleteSourceExemptionRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSourceExemption not implemented")
+}
+func (UnimplementedFirewallControlServer) SetAutoBlockConfig(context.Context, *SetAutoBlockConfigRequest) (*AutoBlockStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoBlockConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) GetAutoBlockStatus(context.Context, *Empty) (*AutoBlockStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoBlockStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) ReleaseAutoBlock(context.Context, *ReleaseAutoBlockRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAutoBlock not implemented")
+}
+func (UnimplementedFirewallControlServer) ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayEvents not implemented")
+}
+func (UnimplementedFirewallControlServer) SetInterfaceConfig(context.Context, *SetInterfaceConfigRequest) (*InterfaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) DeleteInterfaceConfig(context.Context, *DeleteInterfaceConfigRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInterfaceConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) ListInterfaceConfigs(context.Context, *Empty) (*InterfaceConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInterfaceConfigs not implemented")
+}
+func (UnimplementedFirewallControlServer) SetPortScanConfig(context.Context, *SetPortScanConfigRequest) (*PortScanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPortScanConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) GetPortScanStatus(context.Context, *Empty) (*PortScanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortScanStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) SetNormalizationConfig(context.Context, *SetNormalizationConfigRequest) (*NormalizationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNormalizationConfig not implemented")
+}
+func (UnimplementedFirewallControlServer) GetNormalizationStatus(context.Context, *Empty) (*NormalizationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNormalizationStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) PlanApply(context.Context, *PlanApplyRequest) (*PlanApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanApply not implemented")
+}
+func (UnimplementedFirewallControlServer) AnalyzeRules(context.Context, *AnalyzeRulesRequest) (*AnalyzeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeRules not implemented")
+}
+func (UnimplementedFirewallControlServer) StartShadowPolicy(context.Context, *StartShadowPolicyRequest) (*ShadowPolicyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShadowPolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) StopShadowPolicy(context.Context, *Empty) (*ShadowPolicyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopShadowPolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) GetShadowPolicyReport(context.Context, *Empty) (*ShadowPolicyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShadowPolicyReport not implemented")
+}
+func (UnimplementedFirewallControlServer) AttachNamespace(context.Context, *AttachNamespaceRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachNamespace not implemented")
+}
+func (UnimplementedFirewallControlServer) DetachNamespace(context.Context, *DetachNamespaceRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachNamespace not implemented")
+}
+func (UnimplementedFirewallControlServer) ListNamespaces(context.Context, *Empty) (*NamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedFirewallControlServer) GetNamespaceStats(context.Context, *NamespaceStatsRequest) (*Statistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceStats not implemented")
+}
+func (UnimplementedFirewallControlServer) ListSRIOVDevices(context.Context, *Empty) (*SRIOVDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSRIOVDevices not implemented")
+}
+func (UnimplementedFirewallControlServer) AttachVFPolicy(context.Context, *AttachVFPolicyRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVFPolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) DetachVFPolicy(context.Context, *DetachVFPolicyRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVFPolicy not implemented")
+}
+func (UnimplementedFirewallControlServer) GetVFStats(context.Context, *VFStatsRequest) (*VFStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVFStats not implemented")
+}
+func (UnimplementedFirewallControlServer) GetOffloadStatus(context.Context, *Empty) (*OffloadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffloadStatus not implemented")
+}
+func (UnimplementedFirewallControlServer) ListConnections(context.Context, *ListConnectionsRequest) (*ConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedFirewallControlServer) KillConnection(context.Context, *KillConnectionRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillConnection not implemented")
+}
+func (UnimplementedFirewallControlServer) QueryHistoricalMatches(context.Context, *HistoricalMatchesRequest) (*HistoricalMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHistoricalMatches not implemented")
+}
+func (UnimplementedFirewallControlServer) AggregateFlows(context.Context, *AggregateFlowsRequest) (*AggregateFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateFlows not implemented")
+}
+func (UnimplementedFirewallControlServer) GetPipeline(context.Context, *GetPipelineRequest) (*PipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (UnimplementedFirewallControlServer) UpdatePipeline(context.Context, *UpdatePipelineRequest) (*PipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePipeline not implemented")
+}
+func (UnimplementedFirewallControlServer) ExplainVerdict(context.Context, *ExplainVerdictRequest) (*ExplainVerdictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainVerdict not implemented")
+}
+func (UnimplementedFirewallControlServer) mustEmbedUnimplementedFirewallControlServer() {}
+func (UnimplementedFirewallControlServer) testEmbeddedByValue()                         {}
+
+// UnsafeFirewallControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FirewallControlServer will
+// result in compilation errors.
+type UnsafeFirewallControlServer interface {
+	mustEmbedUnimplementedFirewallControlServer()
+}
+
+func RegisterFirewallControlServer(s grpc.ServiceRegistrar, srv FirewallControlServer) {
+	// If the following call pancis, it indicates UnimplementedFirewallControlServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FirewallControl_ServiceDesc, srv)
+}
+
+func _FirewallControl_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AddRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AddRule(ctx, req.(*AddRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetRules(ctx, req.(*GetRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ReplaceRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ReplaceRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ReplaceRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ReplaceRules(ctx, req.(*ReplaceRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ReorderRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ReorderRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ReorderRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ReorderRules(ctx, req.(*ReorderRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SearchRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SearchRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SearchRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SearchRules(ctx, req.(*SearchRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteRulesByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RulesByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteRulesByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteRulesByLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteRulesByLabel(ctx, req.(*RulesByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DisableRulesByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RulesByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DisableRulesByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DisableRulesByLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DisableRulesByLabel(ctx, req.(*RulesByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_EnableRulesByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RulesByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).EnableRulesByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_EnableRulesByLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).EnableRulesByLabel(ctx, req.(*RulesByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_TemporaryAllow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemporaryAllowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).TemporaryAllow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_TemporaryAllow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).TemporaryAllow(ctx, req.(*TemporaryAllowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_BlockASN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockASNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).BlockASN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_BlockASN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).BlockASN(ctx, req.(*BlockASNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetDefaultPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetDefaultPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetDefaultPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetDefaultPolicy(ctx, req.(*SetDefaultPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ImportRules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FirewallControlServer).ImportRules(&grpc.GenericServerStream[ImportRulesRequest, ImportRulesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_ImportRulesServer = grpc.ClientStreamingServer[ImportRulesRequest, ImportRulesResponse]
+
+func _FirewallControl_ExportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ExportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ExportRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ExportRules(ctx, req.(*ExportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ImportNFTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNFTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ImportNFTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ImportNFTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ImportNFTables(ctx, req.(*ImportNFTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ImportIPTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportIPTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ImportIPTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ImportIPTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ImportIPTables(ctx, req.(*ImportIPTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetInterfaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterfaceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetInterfaceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetInterfaceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetInterfaceStats(ctx, req.(*GetInterfaceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetStatsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetStatsHistory(ctx, req.(*StatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_PushExtensionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushExtensionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).PushExtensionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_PushExtensionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).PushExtensionStats(ctx, req.(*PushExtensionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetExtensionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetExtensionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetExtensionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetExtensionStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FirewallControlServer).StreamEvents(m, &grpc.GenericServerStream[Empty, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_StreamEventsServer = grpc.ServerStreamingServer[Event]
+
+func _FirewallControl_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FirewallControlServer).SubscribeEvents(m, &grpc.GenericServerStream[SubscribeEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_SubscribeEventsServer = grpc.ServerStreamingServer[Event]
+
+func _FirewallControl_GetSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetSystemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetSystemInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetSystemInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_RestartDataPlane_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).RestartDataPlane(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_RestartDataPlane_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).RestartDataPlane(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_BackupConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).BackupConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_BackupConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).BackupConfig(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_RestoreConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).RestoreConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_RestoreConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).RestoreConfig(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetZone(ctx, req.(*SetZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteZone(ctx, req.(*DeleteZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListZones(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AddZonePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddZonePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AddZonePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AddZonePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AddZonePolicy(ctx, req.(*AddZonePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteZonePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteZonePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteZonePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteZonePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteZonePolicy(ctx, req.(*DeleteZonePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ExplainZonePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainZonePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ExplainZonePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ExplainZonePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ExplainZonePolicy(ctx, req.(*ExplainZonePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetService(ctx, req.(*SetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListServices(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetAddressObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetAddressObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetAddressObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetAddressObject(ctx, req.(*SetAddressObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetAddressObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetAddressObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetAddressObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetAddressObject(ctx, req.(*GetAddressObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteAddressObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteAddressObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteAddressObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteAddressObject(ctx, req.(*DeleteAddressObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListAddressObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListAddressObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListAddressObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListAddressObjects(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_WhereUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhereUsedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).WhereUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_WhereUsed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).WhereUsed(ctx, req.(*WhereUsedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_CreateIPSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIPSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).CreateIPSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_CreateIPSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).CreateIPSet(ctx, req.(*CreateIPSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AddToIPSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSetEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AddToIPSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AddToIPSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AddToIPSet(ctx, req.(*IPSetEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_RemoveFromIPSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSetEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).RemoveFromIPSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_RemoveFromIPSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).RemoveFromIPSet(ctx, req.(*IPSetEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteIPSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIPSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteIPSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteIPSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteIPSet(ctx, req.(*DeleteIPSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListIPSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListIPSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListIPSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListIPSets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListFeeds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_WatchBaseline_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBaselineRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FirewallControlServer).WatchBaseline(m, &grpc.GenericServerStream[WatchBaselineRequest, Baseline]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallControl_WatchBaselineServer = grpc.ServerStreamingServer[Baseline]
+
+func _FirewallControl_ReportSiteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ReportSiteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ReportSiteStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ReportSiteStatus(ctx, req.(*SiteStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetFederationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetFederationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetFederationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetFederationStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListDatabaseUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListDatabaseUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListDatabaseUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListDatabaseUpdates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_RollbackDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).RollbackDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_RollbackDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).RollbackDatabase(ctx, req.(*RollbackDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetProtectionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProtectionProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetProtectionProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetProtectionProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetProtectionProfile(ctx, req.(*SetProtectionProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteProtectionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProtectionProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteProtectionProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteProtectionProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteProtectionProfile(ctx, req.(*DeleteProtectionProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListProtectionProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListProtectionProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListProtectionProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListProtectionProfiles(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AttachProtectionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachProtectionProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AttachProtectionProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AttachProtectionProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AttachProtectionProfile(ctx, req.(*AttachProtectionProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DetachProtectionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachProtectionProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DetachProtectionProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DetachProtectionProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DetachProtectionProfile(ctx, req.(*DetachProtectionProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetTunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetTunnel(ctx, req.(*SetTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteTunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteTunnel(ctx, req.(*DeleteTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListTunnels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListTunnels(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListMulticastGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListMulticastGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListMulticastGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListMulticastGroups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetNAT64Prefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNAT64PrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetNAT64Prefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetNAT64Prefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetNAT64Prefix(ctx, req.(*SetNAT64PrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteNAT64Prefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNAT64PrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteNAT64Prefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteNAT64Prefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteNAT64Prefix(ctx, req.(*DeleteNAT64PrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListNAT64Prefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListNAT64Prefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListNAT64Prefixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListNAT64Prefixes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListNAT64Sessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNAT64SessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListNAT64Sessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListNAT64Sessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListNAT64Sessions(ctx, req.(*ListNAT64SessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AddNatRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNatRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AddNatRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AddNatRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AddNatRule(ctx, req.(*AddNatRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteNatRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNatRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteNatRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteNatRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteNatRule(ctx, req.(*DeleteNatRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListNatRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListNatRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListNatRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListNatRules(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListNatSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNatSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListNatSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListNatSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListNatSessions(ctx, req.(*ListNatSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetDomainList(ctx, req.(*SetDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteDomainList(ctx, req.(*DeleteDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListDomainLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListDomainLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListDomainLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListDomainLists(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetTLSFingerprintRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTLSFingerprintRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetTLSFingerprintRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetTLSFingerprintRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetTLSFingerprintRule(ctx, req.(*SetTLSFingerprintRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteTLSFingerprintRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTLSFingerprintRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteTLSFingerprintRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteTLSFingerprintRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteTLSFingerprintRule(ctx, req.(*DeleteTLSFingerprintRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListTLSFingerprintRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListTLSFingerprintRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListTLSFingerprintRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListTLSFingerprintRules(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetMitigationConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMitigationConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetMitigationConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetMitigationConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetMitigationConfig(ctx, req.(*SetMitigationConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetMitigationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetMitigationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetMitigationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetMitigationStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetSourceLimitConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSourceLimitConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetSourceLimitConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetSourceLimitConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetSourceLimitConfig(ctx, req.(*SetSourceLimitConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetSourceLimitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetSourceLimitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetSourceLimitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetSourceLimitStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListTopOffenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopOffendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListTopOffenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListTopOffenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListTopOffenders(ctx, req.(*ListTopOffendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ExemptSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExemptSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ExemptSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ExemptSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ExemptSource(ctx, req.(*ExemptSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteSourceExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSourceExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteSourceExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteSourceExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteSourceExemption(ctx, req.(*DeleteSourceExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetAutoBlockConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoBlockConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetAutoBlockConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetAutoBlockConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetAutoBlockConfig(ctx, req.(*SetAutoBlockConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetAutoBlockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetAutoBlockStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetAutoBlockStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetAutoBlockStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ReleaseAutoBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAutoBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ReleaseAutoBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ReleaseAutoBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ReleaseAutoBlock(ctx, req.(*ReleaseAutoBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ReplayEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ReplayEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ReplayEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ReplayEvents(ctx, req.(*ReplayEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetInterfaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterfaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetInterfaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetInterfaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetInterfaceConfig(ctx, req.(*SetInterfaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DeleteInterfaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInterfaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DeleteInterfaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DeleteInterfaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DeleteInterfaceConfig(ctx, req.(*DeleteInterfaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListInterfaceConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListInterfaceConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListInterfaceConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListInterfaceConfigs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetPortScanConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPortScanConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetPortScanConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetPortScanConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetPortScanConfig(ctx, req.(*SetPortScanConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetPortScanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetPortScanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetPortScanStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetPortScanStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_SetNormalizationConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNormalizationConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).SetNormalizationConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_SetNormalizationConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).SetNormalizationConfig(ctx, req.(*SetNormalizationConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetNormalizationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetNormalizationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetNormalizationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetNormalizationStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_PlanApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).PlanApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_PlanApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).PlanApply(ctx, req.(*PlanApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AnalyzeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AnalyzeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AnalyzeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AnalyzeRules(ctx, req.(*AnalyzeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_StartShadowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShadowPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).StartShadowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_StartShadowPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).StartShadowPolicy(ctx, req.(*StartShadowPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_StopShadowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).StopShadowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_StopShadowPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).StopShadowPolicy(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetShadowPolicyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetShadowPolicyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetShadowPolicyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetShadowPolicyReport(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AttachNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AttachNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AttachNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AttachNamespace(ctx, req.(*AttachNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DetachNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DetachNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DetachNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DetachNamespace(ctx, req.(*DetachNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListNamespaces(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetNamespaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetNamespaceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetNamespaceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetNamespaceStats(ctx, req.(*NamespaceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListSRIOVDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListSRIOVDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListSRIOVDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListSRIOVDevices(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AttachVFPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachVFPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AttachVFPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AttachVFPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AttachVFPolicy(ctx, req.(*AttachVFPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_DetachVFPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachVFPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).DetachVFPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_DetachVFPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).DetachVFPolicy(ctx, req.(*DetachVFPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetVFStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VFStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetVFStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetVFStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetVFStats(ctx, req.(*VFStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetOffloadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetOffloadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetOffloadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetOffloadStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_KillConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).KillConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_KillConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).KillConnection(ctx, req.(*KillConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_QueryHistoricalMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoricalMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).QueryHistoricalMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_QueryHistoricalMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).QueryHistoricalMatches(ctx, req.(*HistoricalMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_AggregateFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).AggregateFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_AggregateFlows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).AggregateFlows(ctx, req.(*AggregateFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_GetPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_UpdatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).UpdatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_UpdatePipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).UpdatePipeline(ctx, req.(*UpdatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallControl_ExplainVerdict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainVerdictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallControlServer).ExplainVerdict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallControl_ExplainVerdict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallControlServer).ExplainVerdict(ctx, req.(*ExplainVerdictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FirewallControl_ServiceDesc is the grpc.ServiceDesc for FirewallControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FirewallControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cerberus.v1.FirewallControl",
+	HandlerType: (*FirewallControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddRule",
+			Handler:    _FirewallControl_AddRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _FirewallControl_DeleteRule_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _FirewallControl_UpdateRule_Handler,
+		},
+		{
+			MethodName: "GetRules",
+			Handler:    _FirewallControl_GetRules_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _FirewallControl_GetRule_Handler,
+		},
+		{
+			MethodName: "ReplaceRules",
+			Handler:    _FirewallControl_ReplaceRules_Handler,
+		},
+		{
+			MethodName: "ReorderRules",
+			Handler:    _FirewallControl_ReorderRules_Handler,
+		},
+		{
+			MethodName: "SearchRules",
+			Handler:    _FirewallControl_SearchRules_Handler,
+		},
+		{
+			MethodName: "DeleteRulesByLabel",
+			Handler:    _FirewallControl_DeleteRulesByLabel_Handler,
+		},
+		{
+			MethodName: "DisableRulesByLabel",
+			Handler:    _FirewallControl_DisableRulesByLabel_Handler,
+		},
+		{
+			MethodName: "EnableRulesByLabel",
+			Handler:    _FirewallControl_EnableRulesByLabel_Handler,
+		},
+		{
+			MethodName: "TemporaryAllow",
+			Handler:    _FirewallControl_TemporaryAllow_Handler,
+		},
+		{
+			MethodName: "BlockASN",
+			Handler:    _FirewallControl_BlockASN_Handler,
+		},
+		{
+			MethodName: "SetDefaultPolicy",
+			Handler:    _FirewallControl_SetDefaultPolicy_Handler,
+		},
+		{
+			MethodName: "ExportRules",
+			Handler:    _FirewallControl_ExportRules_Handler,
+		},
+		{
+			MethodName: "ImportNFTables",
+			Handler:    _FirewallControl_ImportNFTables_Handler,
+		},
+		{
+			MethodName: "ImportIPTables",
+			Handler:    _FirewallControl_ImportIPTables_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _FirewallControl_GetStats_Handler,
+		},
+		{
+			MethodName: "GetInterfaceStats",
+			Handler:    _FirewallControl_GetInterfaceStats_Handler,
+		},
+		{
+			MethodName: "GetStatsHistory",
+			Handler:    _FirewallControl_GetStatsHistory_Handler,
+		},
+		{
+			MethodName: "PushExtensionStats",
+			Handler:    _FirewallControl_PushExtensionStats_Handler,
+		},
+		{
+			MethodName: "GetExtensionStats",
+			Handler:    _FirewallControl_GetExtensionStats_Handler,
+		},
+		{
+			MethodName: "GetSystemInfo",
+			Handler:    _FirewallControl_GetSystemInfo_Handler,
+		},
+		{
+			MethodName: "RestartDataPlane",
+			Handler:    _FirewallControl_RestartDataPlane_Handler,
+		},
+		{
+			MethodName: "BackupConfig",
+			Handler:    _FirewallControl_BackupConfig_Handler,
+		},
+		{
+			MethodName: "RestoreConfig",
+			Handler:    _FirewallControl_RestoreConfig_Handler,
+		},
+		{
+			MethodName: "SetZone",
+			Handler:    _FirewallControl_SetZone_Handler,
+		},
+		{
+			MethodName: "DeleteZone",
+			Handler:    _FirewallControl_DeleteZone_Handler,
+		},
+		{
+			MethodName: "ListZones",
+			Handler:    _FirewallControl_ListZones_Handler,
+		},
+		{
+			MethodName: "AddZonePolicy",
+			Handler:    _FirewallControl_AddZonePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteZonePolicy",
+			Handler:    _FirewallControl_DeleteZonePolicy_Handler,
+		},
+		{
+			MethodName: "ExplainZonePolicy",
+			Handler:    _FirewallControl_ExplainZonePolicy_Handler,
+		},
+		{
+			MethodName: "SetService",
+			Handler:    _FirewallControl_SetService_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _FirewallControl_GetService_Handler,
+		},
+		{
+			MethodName: "DeleteService",
+			Handler:    _FirewallControl_DeleteService_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _FirewallControl_ListServices_Handler,
+		},
+		{
+			MethodName: "SetAddressObject",
+			Handler:    _FirewallControl_SetAddressObject_Handler,
+		},
+		{
+			MethodName: "GetAddressObject",
+			Handler:    _FirewallControl_GetAddressObject_Handler,
+		},
+		{
+			MethodName: "DeleteAddressObject",
+			Handler:    _FirewallControl_DeleteAddressObject_Handler,
+		},
+		{
+			MethodName: "ListAddressObjects",
+			Handler:    _FirewallControl_ListAddressObjects_Handler,
+		},
+		{
+			MethodName: "WhereUsed",
+			Handler:    _FirewallControl_WhereUsed_Handler,
+		},
+		{
+			MethodName: "CreateIPSet",
+			Handler:    _FirewallControl_CreateIPSet_Handler,
+		},
+		{
+			MethodName: "AddToIPSet",
+			Handler:    _FirewallControl_AddToIPSet_Handler,
+		},
+		{
+			MethodName: "RemoveFromIPSet",
+			Handler:    _FirewallControl_RemoveFromIPSet_Handler,
+		},
+		{
+			MethodName: "DeleteIPSet",
+			Handler:    _FirewallControl_DeleteIPSet_Handler,
+		},
+		{
+			MethodName: "ListIPSets",
+			Handler:    _FirewallControl_ListIPSets_Handler,
+		},
+		{
+			MethodName: "ListFeeds",
+			Handler:    _FirewallControl_ListFeeds_Handler,
+		},
+		{
+			MethodName: "ReportSiteStatus",
+			Handler:    _FirewallControl_ReportSiteStatus_Handler,
+		},
+		{
+			MethodName: "GetFederationStatus",
+			Handler:    _FirewallControl_GetFederationStatus_Handler,
+		},
+		{
+			MethodName: "ListDatabaseUpdates",
+			Handler:    _FirewallControl_ListDatabaseUpdates_Handler,
+		},
+		{
+			MethodName: "RollbackDatabase",
+			Handler:    _FirewallControl_RollbackDatabase_Handler,
+		},
+		{
+			MethodName: "SetProtectionProfile",
+			Handler:    _FirewallControl_SetProtectionProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProtectionProfile",
+			Handler:    _FirewallControl_DeleteProtectionProfile_Handler,
+		},
+		{
+			MethodName: "ListProtectionProfiles",
+			Handler:    _FirewallControl_ListProtectionProfiles_Handler,
+		},
+		{
+			MethodName: "AttachProtectionProfile",
+			Handler:    _FirewallControl_AttachProtectionProfile_Handler,
+		},
+		{
+			MethodName: "DetachProtectionProfile",
+			Handler:    _FirewallControl_DetachProtectionProfile_Handler,
+		},
+		{
+			MethodName: "SetTunnel",
+			Handler:    _FirewallControl_SetTunnel_Handler,
+		},
+		{
+			MethodName: "DeleteTunnel",
+			Handler:    _FirewallControl_DeleteTunnel_Handler,
+		},
+		{
+			MethodName: "ListTunnels",
+			Handler:    _FirewallControl_ListTunnels_Handler,
+		},
+		{
+			MethodName: "ListMulticastGroups",
+			Handler:    _FirewallControl_ListMulticastGroups_Handler,
+		},
+		{
+			MethodName: "SetNAT64Prefix",
+			Handler:    _FirewallControl_SetNAT64Prefix_Handler,
+		},
+		{
+			MethodName: "DeleteNAT64Prefix",
+			Handler:    _FirewallControl_DeleteNAT64Prefix_Handler,
+		},
+		{
+			MethodName: "ListNAT64Prefixes",
+			Handler:    _FirewallControl_ListNAT64Prefixes_Handler,
+		},
+		{
+			MethodName: "ListNAT64Sessions",
+			Handler:    _FirewallControl_ListNAT64Sessions_Handler,
+		},
+		{
+			MethodName: "AddNatRule",
+			Handler:    _FirewallControl_AddNatRule_Handler,
+		},
+		{
+			MethodName: "DeleteNatRule",
+			Handler:    _FirewallControl_DeleteNatRule_Handler,
+		},
+		{
+			MethodName: "ListNatRules",
+			Handler:    _FirewallControl_ListNatRules_Handler,
+		},
+		{
+			MethodName: "ListNatSessions",
+			Handler:    _FirewallControl_ListNatSessions_Handler,
+		},
+		{
+			MethodName: "SetDomainList",
+			Handler:    _FirewallControl_SetDomainList_Handler,
+		},
+		{
+			MethodName: "DeleteDomainList",
+			Handler:    _FirewallControl_DeleteDomainList_Handler,
+		},
+		{
+			MethodName: "ListDomainLists",
+			Handler:    _FirewallControl_ListDomainLists_Handler,
+		},
+		{
+			MethodName: "SetTLSFingerprintRule",
+			Handler:    _FirewallControl_SetTLSFingerprintRule_Handler,
+		},
+		{
+			MethodName: "DeleteTLSFingerprintRule",
+			Handler:    _FirewallControl_DeleteTLSFingerprintRule_Handler,
+		},
+		{
+			MethodName: "ListTLSFingerprintRules",
+			Handler:    _FirewallControl_ListTLSFingerprintRules_Handler,
+		},
+		{
+			MethodName: "SetMitigationConfig",
+			Handler:    _FirewallControl_SetMitigationConfig_Handler,
+		},
+		{
+			MethodName: "GetMitigationStatus",
+			Handler:    _FirewallControl_GetMitigationStatus_Handler,
+		},
+		{
+			MethodName: "SetSourceLimitConfig",
+			Handler:    _FirewallControl_SetSourceLimitConfig_Handler,
+		},
+		{
+			MethodName: "GetSourceLimitStatus",
+			Handler:    _FirewallControl_GetSourceLimitStatus_Handler,
+		},
+		{
+			MethodName: "ListTopOffenders",
+			Handler:    _FirewallControl_ListTopOffenders_Handler,
+		},
+		{
+			MethodName: "ExemptSource",
+			Handler:    _FirewallControl_ExemptSource_Handler,
+		},
+		{
+			MethodName: "DeleteSourceExemption",
+			Handler:    _FirewallControl_DeleteSourceExemption_Handler,
+		},
+		{
+			MethodName: "SetAutoBlockConfig",
+			Handler:    _FirewallControl_SetAutoBlockConfig_Handler,
+		},
+		{
+			MethodName: "GetAutoBlockStatus",
+			Handler:    _FirewallControl_GetAutoBlockStatus_Handler,
+		},
+		{
+			MethodName: "ReleaseAutoBlock",
+			Handler:    _FirewallControl_ReleaseAutoBlock_Handler,
+		},
+		{
+			MethodName: "ReplayEvents",
+			Handler:    _FirewallControl_ReplayEvents_Handler,
+		},
+		{
+			MethodName: "SetInterfaceConfig",
+			Handler:    _FirewallControl_SetInterfaceConfig_Handler,
+		},
+		{
+			MethodName: "DeleteInterfaceConfig",
+			Handler:    _FirewallControl_DeleteInterfaceConfig_Handler,
+		},
+		{
+			MethodName: "ListInterfaceConfigs",
+			Handler:    _FirewallControl_ListInterfaceConfigs_Handler,
+		},
+		{
+			MethodName: "SetPortScanConfig",
+			Handler:    _FirewallControl_SetPortScanConfig_Handler,
+		},
+		{
+			MethodName: "GetPortScanStatus",
+			Handler:    _FirewallControl_GetPortScanStatus_Handler,
+		},
+		{
+			MethodName: "SetNormalizationConfig",
+			Handler:    _FirewallControl_SetNormalizationConfig_Handler,
+		},
+		{
+			MethodName: "GetNormalizationStatus",
+			Handler:    _FirewallControl_GetNormalizationStatus_Handler,
+		},
+		{
+			MethodName: "PlanApply",
+			Handler:    _FirewallControl_PlanApply_Handler,
+		},
+		{
+			MethodName: "AnalyzeRules",
+			Handler:    _FirewallControl_AnalyzeRules_Handler,
+		},
+		{
+			MethodName: "StartShadowPolicy",
+			Handler:    _FirewallControl_StartShadowPolicy_Handler,
+		},
+		{
+			MethodName: "StopShadowPolicy",
+			Handler:    _FirewallControl_StopShadowPolicy_Handler,
+		},
+		{
+			MethodName: "GetShadowPolicyReport",
+			Handler:    _FirewallControl_GetShadowPolicyReport_Handler,
+		},
+		{
+			MethodName: "AttachNamespace",
+			Handler:    _FirewallControl_AttachNamespace_Handler,
+		},
+		{
+			MethodName: "DetachNamespace",
+			Handler:    _FirewallControl_DetachNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _FirewallControl_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "GetNamespaceStats",
+			Handler:    _FirewallControl_GetNamespaceStats_Handler,
+		},
+		{
+			MethodName: "ListSRIOVDevices",
+			Handler:    _FirewallControl_ListSRIOVDevices_Handler,
+		},
+		{
+			MethodName: "AttachVFPolicy",
+			Handler:    _FirewallControl_AttachVFPolicy_Handler,
+		},
+		{
+			MethodName: "DetachVFPolicy",
+			Handler:    _FirewallControl_DetachVFPolicy_Handler,
+		},
+		{
+			MethodName: "GetVFStats",
+			Handler:    _FirewallControl_GetVFStats_Handler,
+		},
+		{
+			MethodName: "GetOffloadStatus",
+			Handler:    _FirewallControl_GetOffloadStatus_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _FirewallControl_ListConnections_Handler,
+		},
+		{
+			MethodName: "KillConnection",
+			Handler:    _FirewallControl_KillConnection_Handler,
+		},
+		{
+			MethodName: "QueryHistoricalMatches",
+			Handler:    _FirewallControl_QueryHistoricalMatches_Handler,
+		},
+		{
+			MethodName: "AggregateFlows",
+			Handler:    _FirewallControl_AggregateFlows_Handler,
+		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _FirewallControl_GetPipeline_Handler,
+		},
+		{
+			MethodName: "UpdatePipeline",
+			Handler:    _FirewallControl_UpdatePipeline_Handler,
+		},
+		{
+			MethodName: "ExplainVerdict",
+			Handler:    _FirewallControl_ExplainVerdict_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportRules",
+			Handler:       _FirewallControl_ImportRules_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _FirewallControl_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _FirewallControl_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBaseline",
+			Handler:       _FirewallControl_WatchBaseline_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "firewall.proto",
+}
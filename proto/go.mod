@@ -1,5 +1,15 @@
-module proto
+module github.com/m4rba4s/Cerberus-V/proto
 
 go 1.21
 
-require google.golang.org/protobuf v1.32.0 
\ No newline at end of file
+require (
+	google.golang.org/grpc v1.65.0
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	golang.org/x/net v0.25.0 // indirect
+	golang.org/x/sys v0.20.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157 // indirect
+)
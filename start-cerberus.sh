@@ -66,7 +66,7 @@ cd ..
 sleep 3
 
 # Проверяем что Control Plane запустился
-if curl -s --max-time 2 http://localhost:50051/health >/dev/null; then
+if curl -s --max-time 2 http://localhost:50052/health >/dev/null; then
     echo "✅ Control Plane is healthy"
 else
     echo "❌ Control Plane failed to start"
@@ -123,7 +123,7 @@ sleep 5
 # Финальная проверка
 echo
 echo "🔍 Final health check..."
-if curl -s --max-time 2 http://localhost:50051/health >/dev/null; then
+if curl -s --max-time 2 http://localhost:50052/health >/dev/null; then
     echo "✅ Control Plane: OK"
 else
     echo "❌ Control Plane: FAILED"
@@ -141,8 +141,8 @@ echo "• 🎨 GUI Frontend:   http://localhost:${GUI_PORT:-5173}"
 echo "• 🔧 GUI Backend:    http://localhost:${port:-8000}"
 echo
 echo "📋 Quick Tests:"
-echo "curl http://localhost:50051/health"
-echo "curl http://localhost:50051/stats"
+echo "curl http://localhost:50052/health"
+echo "curl http://localhost:50052/stats"
 echo
 echo "📁 Logs:"
 echo "• Control Plane: control.log"
@@ -165,5 +165,5 @@ while true; do
     fi
     
     # Показываем статистику каждые 30 сек
-    echo "📊 $(date): Firewall active, $(curl -s http://localhost:50051/stats 2>/dev/null | grep -o '"TotalPackets":[0-9]*' | cut -d: -f2 || echo 0) packets processed"
+    echo "📊 $(date): Firewall active, $(curl -s http://localhost:50052/stats 2>/dev/null | grep -o '"total_packets":[0-9]*' | cut -d: -f2 || echo 0) packets processed"
 done 
\ No newline at end of file
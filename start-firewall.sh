@@ -16,10 +16,10 @@ go run . &
 echo "⏳ Waiting for startup..."
 sleep 3
 
-if curl -s --max-time 2 http://localhost:50051/health >/dev/null; then
+if curl -s --max-time 2 http://localhost:50052/health >/dev/null; then
     echo "✅ Firewall is running!"
     echo "📊 API: http://localhost:50051"
-    echo "🧪 Test: curl http://localhost:50051/stats"
+    echo "🧪 Test: curl http://localhost:50052/stats"
 else
     echo "❌ Failed to start"
     exit 1